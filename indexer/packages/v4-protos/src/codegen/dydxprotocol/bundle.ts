@@ -109,81 +109,84 @@ import * as _112 from "./stats/stats";
 import * as _113 from "./stats/tx";
 import * as _114 from "./subaccounts/asset_position";
 import * as _115 from "./subaccounts/genesis";
-import * as _116 from "./subaccounts/perpetual_position";
-import * as _117 from "./subaccounts/query";
-import * as _118 from "./subaccounts/streaming";
-import * as _119 from "./subaccounts/subaccount";
-import * as _120 from "./vault/genesis";
-import * as _121 from "./vault/params";
-import * as _122 from "./vault/query";
-import * as _123 from "./vault/share";
-import * as _124 from "./vault/tx";
-import * as _125 from "./vault/vault";
-import * as _126 from "./vest/genesis";
-import * as _127 from "./vest/query";
-import * as _128 from "./vest/tx";
-import * as _129 from "./vest/vest_entry";
-import * as _137 from "./accountplus/query.lcd";
-import * as _138 from "./affiliates/query.lcd";
-import * as _139 from "./assets/query.lcd";
-import * as _140 from "./blocktime/query.lcd";
-import * as _141 from "./bridge/query.lcd";
-import * as _142 from "./clob/query.lcd";
-import * as _143 from "./delaymsg/query.lcd";
-import * as _144 from "./epochs/query.lcd";
-import * as _145 from "./feetiers/query.lcd";
-import * as _146 from "./listing/query.lcd";
-import * as _147 from "./perpetuals/query.lcd";
-import * as _148 from "./prices/query.lcd";
-import * as _149 from "./ratelimit/query.lcd";
-import * as _150 from "./revshare/query.lcd";
-import * as _151 from "./rewards/query.lcd";
-import * as _152 from "./stats/query.lcd";
-import * as _153 from "./subaccounts/query.lcd";
-import * as _154 from "./vault/query.lcd";
-import * as _155 from "./vest/query.lcd";
-import * as _156 from "./accountplus/query.rpc.Query";
-import * as _157 from "./affiliates/query.rpc.Query";
-import * as _158 from "./assets/query.rpc.Query";
-import * as _159 from "./blocktime/query.rpc.Query";
-import * as _160 from "./bridge/query.rpc.Query";
-import * as _161 from "./clob/query.rpc.Query";
-import * as _162 from "./delaymsg/query.rpc.Query";
-import * as _163 from "./epochs/query.rpc.Query";
-import * as _164 from "./feetiers/query.rpc.Query";
-import * as _165 from "./govplus/query.rpc.Query";
-import * as _166 from "./listing/query.rpc.Query";
-import * as _167 from "./perpetuals/query.rpc.Query";
-import * as _168 from "./prices/query.rpc.Query";
-import * as _169 from "./ratelimit/query.rpc.Query";
-import * as _170 from "./revshare/query.rpc.Query";
-import * as _171 from "./rewards/query.rpc.Query";
-import * as _172 from "./sending/query.rpc.Query";
-import * as _173 from "./stats/query.rpc.Query";
-import * as _174 from "./subaccounts/query.rpc.Query";
-import * as _175 from "./vault/query.rpc.Query";
-import * as _176 from "./vest/query.rpc.Query";
-import * as _177 from "./accountplus/tx.rpc.msg";
-import * as _178 from "./affiliates/tx.rpc.msg";
-import * as _179 from "./blocktime/tx.rpc.msg";
-import * as _180 from "./bridge/tx.rpc.msg";
-import * as _181 from "./clob/tx.rpc.msg";
-import * as _182 from "./delaymsg/tx.rpc.msg";
-import * as _183 from "./feetiers/tx.rpc.msg";
-import * as _184 from "./govplus/tx.rpc.msg";
-import * as _185 from "./listing/tx.rpc.msg";
-import * as _186 from "./perpetuals/tx.rpc.msg";
-import * as _187 from "./prices/tx.rpc.msg";
-import * as _188 from "./ratelimit/tx.rpc.msg";
-import * as _189 from "./revshare/tx.rpc.msg";
-import * as _190 from "./rewards/tx.rpc.msg";
-import * as _191 from "./sending/tx.rpc.msg";
-import * as _192 from "./stats/tx.rpc.msg";
-import * as _193 from "./vault/tx.rpc.msg";
-import * as _194 from "./vest/tx.rpc.msg";
-import * as _195 from "./lcd";
-import * as _196 from "./rpc.query";
-import * as _197 from "./rpc.tx";
+import * as _116 from "./subaccounts/params";
+import * as _117 from "./subaccounts/perpetual_position";
+import * as _118 from "./subaccounts/query";
+import * as _119 from "./subaccounts/streaming";
+import * as _120 from "./subaccounts/subaccount";
+import * as _121 from "./subaccounts/tx";
+import * as _122 from "./vault/genesis";
+import * as _123 from "./vault/params";
+import * as _124 from "./vault/query";
+import * as _125 from "./vault/share";
+import * as _126 from "./vault/tx";
+import * as _127 from "./vault/vault";
+import * as _128 from "./vest/genesis";
+import * as _129 from "./vest/query";
+import * as _130 from "./vest/tx";
+import * as _131 from "./vest/vest_entry";
+import * as _139 from "./accountplus/query.lcd";
+import * as _140 from "./affiliates/query.lcd";
+import * as _141 from "./assets/query.lcd";
+import * as _142 from "./blocktime/query.lcd";
+import * as _143 from "./bridge/query.lcd";
+import * as _144 from "./clob/query.lcd";
+import * as _145 from "./delaymsg/query.lcd";
+import * as _146 from "./epochs/query.lcd";
+import * as _147 from "./feetiers/query.lcd";
+import * as _148 from "./listing/query.lcd";
+import * as _149 from "./perpetuals/query.lcd";
+import * as _150 from "./prices/query.lcd";
+import * as _151 from "./ratelimit/query.lcd";
+import * as _152 from "./revshare/query.lcd";
+import * as _153 from "./rewards/query.lcd";
+import * as _154 from "./stats/query.lcd";
+import * as _155 from "./subaccounts/query.lcd";
+import * as _156 from "./vault/query.lcd";
+import * as _157 from "./vest/query.lcd";
+import * as _158 from "./accountplus/query.rpc.Query";
+import * as _159 from "./affiliates/query.rpc.Query";
+import * as _160 from "./assets/query.rpc.Query";
+import * as _161 from "./blocktime/query.rpc.Query";
+import * as _162 from "./bridge/query.rpc.Query";
+import * as _163 from "./clob/query.rpc.Query";
+import * as _164 from "./delaymsg/query.rpc.Query";
+import * as _165 from "./epochs/query.rpc.Query";
+import * as _166 from "./feetiers/query.rpc.Query";
+import * as _167 from "./govplus/query.rpc.Query";
+import * as _168 from "./listing/query.rpc.Query";
+import * as _169 from "./perpetuals/query.rpc.Query";
+import * as _170 from "./prices/query.rpc.Query";
+import * as _171 from "./ratelimit/query.rpc.Query";
+import * as _172 from "./revshare/query.rpc.Query";
+import * as _173 from "./rewards/query.rpc.Query";
+import * as _174 from "./sending/query.rpc.Query";
+import * as _175 from "./stats/query.rpc.Query";
+import * as _176 from "./subaccounts/query.rpc.Query";
+import * as _177 from "./vault/query.rpc.Query";
+import * as _178 from "./vest/query.rpc.Query";
+import * as _179 from "./accountplus/tx.rpc.msg";
+import * as _180 from "./affiliates/tx.rpc.msg";
+import * as _181 from "./blocktime/tx.rpc.msg";
+import * as _182 from "./bridge/tx.rpc.msg";
+import * as _183 from "./clob/tx.rpc.msg";
+import * as _184 from "./delaymsg/tx.rpc.msg";
+import * as _185 from "./feetiers/tx.rpc.msg";
+import * as _186 from "./govplus/tx.rpc.msg";
+import * as _187 from "./listing/tx.rpc.msg";
+import * as _188 from "./perpetuals/tx.rpc.msg";
+import * as _189 from "./prices/tx.rpc.msg";
+import * as _190 from "./ratelimit/tx.rpc.msg";
+import * as _191 from "./revshare/tx.rpc.msg";
+import * as _192 from "./rewards/tx.rpc.msg";
+import * as _193 from "./sending/tx.rpc.msg";
+import * as _194 from "./stats/tx.rpc.msg";
+import * as _195 from "./subaccounts/tx.rpc.msg";
+import * as _196 from "./vault/tx.rpc.msg";
+import * as _197 from "./vest/tx.rpc.msg";
+import * as _198 from "./lcd";
+import * as _199 from "./rpc.query";
+import * as _200 from "./rpc.tx";
 export namespace dydxprotocol {
   export const accountplus = { ..._5,
     ..._6,
@@ -191,33 +194,33 @@ export namespace dydxprotocol {
     ..._8,
     ..._9,
     ..._10,
-    ..._137,
-    ..._156,
-    ..._177
+    ..._139,
+    ..._158,
+    ..._179
   };
   export const affiliates = { ..._11,
     ..._12,
     ..._13,
     ..._14,
-    ..._138,
-    ..._157,
-    ..._178
+    ..._140,
+    ..._159,
+    ..._180
   };
   export const assets = { ..._15,
     ..._16,
     ..._17,
     ..._18,
-    ..._139,
-    ..._158
+    ..._141,
+    ..._160
   };
   export const blocktime = { ..._19,
     ..._20,
     ..._21,
     ..._22,
     ..._23,
-    ..._140,
-    ..._159,
-    ..._179
+    ..._142,
+    ..._161,
+    ..._181
   };
   export const bridge = { ..._24,
     ..._25,
@@ -225,9 +228,9 @@ export namespace dydxprotocol {
     ..._27,
     ..._28,
     ..._29,
-    ..._141,
-    ..._160,
-    ..._180
+    ..._143,
+    ..._162,
+    ..._182
   };
   export const clob = { ..._30,
     ..._31,
@@ -245,9 +248,9 @@ export namespace dydxprotocol {
     ..._43,
     ..._44,
     ..._45,
-    ..._142,
-    ..._161,
-    ..._181
+    ..._144,
+    ..._163,
+    ..._183
   };
   export namespace daemons {
     export const bridge = { ..._46
@@ -262,29 +265,29 @@ export namespace dydxprotocol {
     ..._51,
     ..._52,
     ..._53,
-    ..._143,
-    ..._162,
-    ..._182
+    ..._145,
+    ..._164,
+    ..._184
   };
   export const epochs = { ..._54,
     ..._55,
     ..._56,
-    ..._144,
-    ..._163
+    ..._146,
+    ..._165
   };
   export const feetiers = { ..._57,
     ..._58,
     ..._59,
     ..._60,
-    ..._145,
-    ..._164,
-    ..._183
+    ..._147,
+    ..._166,
+    ..._185
   };
   export const govplus = { ..._61,
     ..._62,
     ..._63,
-    ..._165,
-    ..._184
+    ..._167,
+    ..._186
   };
   export namespace indexer {
     export const events = { ..._64
@@ -311,18 +314,18 @@ export namespace dydxprotocol {
     ..._75,
     ..._76,
     ..._77,
-    ..._146,
-    ..._166,
-    ..._185
+    ..._148,
+    ..._168,
+    ..._187
   };
   export const perpetuals = { ..._78,
     ..._79,
     ..._80,
     ..._81,
     ..._82,
-    ..._147,
-    ..._167,
-    ..._186
+    ..._149,
+    ..._169,
+    ..._188
   };
   export const prices = { ..._83,
     ..._84,
@@ -330,9 +333,9 @@ export namespace dydxprotocol {
     ..._86,
     ..._87,
     ..._88,
-    ..._148,
-    ..._168,
-    ..._187
+    ..._150,
+    ..._170,
+    ..._189
   };
   export const ratelimit = { ..._89,
     ..._90,
@@ -340,43 +343,43 @@ export namespace dydxprotocol {
     ..._92,
     ..._93,
     ..._94,
-    ..._149,
-    ..._169,
-    ..._188
+    ..._151,
+    ..._171,
+    ..._190
   };
   export const revshare = { ..._95,
     ..._96,
     ..._97,
     ..._98,
     ..._99,
-    ..._150,
-    ..._170,
-    ..._189
+    ..._152,
+    ..._172,
+    ..._191
   };
   export const rewards = { ..._100,
     ..._101,
     ..._102,
     ..._103,
     ..._104,
-    ..._151,
-    ..._171,
-    ..._190
+    ..._153,
+    ..._173,
+    ..._192
   };
   export const sending = { ..._105,
     ..._106,
     ..._107,
     ..._108,
-    ..._172,
-    ..._191
+    ..._174,
+    ..._193
   };
   export const stats = { ..._109,
     ..._110,
     ..._111,
     ..._112,
     ..._113,
-    ..._152,
-    ..._173,
-    ..._192
+    ..._154,
+    ..._175,
+    ..._194
   };
   export const subaccounts = { ..._114,
     ..._115,
@@ -384,29 +387,32 @@ export namespace dydxprotocol {
     ..._117,
     ..._118,
     ..._119,
-    ..._153,
-    ..._174
-  };
-  export const vault = { ..._120,
+    ..._120,
     ..._121,
-    ..._122,
+    ..._155,
+    ..._176,
+    ..._195
+  };
+  export const vault = { ..._122,
     ..._123,
     ..._124,
     ..._125,
-    ..._154,
-    ..._175,
-    ..._193
-  };
-  export const vest = { ..._126,
+    ..._126,
     ..._127,
-    ..._128,
-    ..._129,
-    ..._155,
-    ..._176,
-    ..._194
+    ..._156,
+    ..._177,
+    ..._196
   };
-  export const ClientFactory = { ..._195,
-    ..._196,
+  export const vest = { ..._128,
+    ..._129,
+    ..._130,
+    ..._131,
+    ..._157,
+    ..._178,
     ..._197
   };
+  export const ClientFactory = { ..._198,
+    ..._199,
+    ..._200
+  };
 }
\ No newline at end of file
@@ -3,7 +3,7 @@ import { LiquidationsConfig, LiquidationsConfigSDKType } from "./liquidations_co
 import { BlockRateLimitConfiguration, BlockRateLimitConfigurationSDKType } from "./block_rate_limit_config";
 import { EquityTierLimitConfiguration, EquityTierLimitConfigurationSDKType } from "./equity_tier_limit_config";
 import * as _m0 from "protobufjs/minimal";
-import { DeepPartial } from "../../helpers";
+import { DeepPartial, Long } from "../../helpers";
 /** GenesisState defines the clob module's genesis state. */
 
 export interface GenesisState {
@@ -11,6 +11,12 @@ export interface GenesisState {
   liquidationsConfig?: LiquidationsConfig;
   blockRateLimitConfig?: BlockRateLimitConfiguration;
   equityTierLimitConfig?: EquityTierLimitConfiguration;
+  /**
+   * The maximum amount of quote quantums the insurance fund can pay out for
+   * liquidations per block. Zero means the outflow is not capped.
+   */
+
+  insuranceFundOutflowCap: Long;
 }
 /** GenesisState defines the clob module's genesis state. */
 
@@ -19,6 +25,12 @@ export interface GenesisStateSDKType {
   liquidations_config?: LiquidationsConfigSDKType;
   block_rate_limit_config?: BlockRateLimitConfigurationSDKType;
   equity_tier_limit_config?: EquityTierLimitConfigurationSDKType;
+  /**
+   * The maximum amount of quote quantums the insurance fund can pay out for
+   * liquidations per block. Zero means the outflow is not capped.
+   */
+
+  insurance_fund_outflow_cap: Long;
 }
 
 function createBaseGenesisState(): GenesisState {
@@ -26,7 +38,8 @@ function createBaseGenesisState(): GenesisState {
     clobPairs: [],
     liquidationsConfig: undefined,
     blockRateLimitConfig: undefined,
-    equityTierLimitConfig: undefined
+    equityTierLimitConfig: undefined,
+    insuranceFundOutflowCap: Long.UZERO
   };
 }
 
@@ -48,6 +61,10 @@ export const GenesisState = {
       EquityTierLimitConfiguration.encode(message.equityTierLimitConfig, writer.uint32(34).fork()).ldelim();
     }
 
+    if (!message.insuranceFundOutflowCap.isZero()) {
+      writer.uint32(40).uint64(message.insuranceFundOutflowCap);
+    }
+
     return writer;
   },
 
@@ -76,6 +93,10 @@ export const GenesisState = {
           message.equityTierLimitConfig = EquityTierLimitConfiguration.decode(reader, reader.uint32());
           break;
 
+        case 5:
+          message.insuranceFundOutflowCap = (reader.uint64() as Long);
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -91,6 +112,7 @@ export const GenesisState = {
     message.liquidationsConfig = object.liquidationsConfig !== undefined && object.liquidationsConfig !== null ? LiquidationsConfig.fromPartial(object.liquidationsConfig) : undefined;
     message.blockRateLimitConfig = object.blockRateLimitConfig !== undefined && object.blockRateLimitConfig !== null ? BlockRateLimitConfiguration.fromPartial(object.blockRateLimitConfig) : undefined;
     message.equityTierLimitConfig = object.equityTierLimitConfig !== undefined && object.equityTierLimitConfig !== null ? EquityTierLimitConfiguration.fromPartial(object.equityTierLimitConfig) : undefined;
+    message.insuranceFundOutflowCap = object.insuranceFundOutflowCap !== undefined && object.insuranceFundOutflowCap !== null ? Long.fromValue(object.insuranceFundOutflowCap) : Long.UZERO;
     return message;
   }
 
@@ -1,6 +1,6 @@
 import { Rpc } from "../../helpers";
 import * as _m0 from "protobufjs/minimal";
-import { MsgProposedOperations, MsgProposedOperationsResponse, MsgPlaceOrder, MsgPlaceOrderResponse, MsgCancelOrder, MsgCancelOrderResponse, MsgBatchCancel, MsgBatchCancelResponse, MsgCreateClobPair, MsgCreateClobPairResponse, MsgUpdateClobPair, MsgUpdateClobPairResponse, MsgUpdateEquityTierLimitConfiguration, MsgUpdateEquityTierLimitConfigurationResponse, MsgUpdateBlockRateLimitConfiguration, MsgUpdateBlockRateLimitConfigurationResponse, MsgUpdateLiquidationsConfig, MsgUpdateLiquidationsConfigResponse } from "./tx";
+import { MsgProposedOperations, MsgProposedOperationsResponse, MsgPlaceOrder, MsgPlaceOrderResponse, MsgCancelOrder, MsgCancelOrderResponse, MsgBatchCancel, MsgBatchCancelResponse, MsgCreateClobPair, MsgCreateClobPairResponse, MsgUpdateClobPair, MsgUpdateClobPairResponse, MsgUpdateEquityTierLimitConfiguration, MsgUpdateEquityTierLimitConfigurationResponse, MsgUpdateBlockRateLimitConfiguration, MsgUpdateBlockRateLimitConfigurationResponse, MsgUpdateLiquidationsConfig, MsgUpdateLiquidationsConfigResponse, MsgUpdateInsuranceFundOutflowCap, MsgUpdateInsuranceFundOutflowCapResponse } from "./tx";
 /** Msg defines the Msg service. */
 
 export interface Msg {
@@ -44,6 +44,12 @@ export interface Msg {
   /** UpdateLiquidationsConfig updates the liquidations configuration in state. */
 
   updateLiquidationsConfig(request: MsgUpdateLiquidationsConfig): Promise<MsgUpdateLiquidationsConfigResponse>;
+  /**
+   * UpdateInsuranceFundOutflowCap updates the maximum amount of quote quantums
+   * the insurance fund can pay out for liquidations per block.
+   */
+
+  updateInsuranceFundOutflowCap(request: MsgUpdateInsuranceFundOutflowCap): Promise<MsgUpdateInsuranceFundOutflowCapResponse>;
 }
 export class MsgClientImpl implements Msg {
   private readonly rpc: Rpc;
@@ -59,6 +65,7 @@ export class MsgClientImpl implements Msg {
     this.updateEquityTierLimitConfiguration = this.updateEquityTierLimitConfiguration.bind(this);
     this.updateBlockRateLimitConfiguration = this.updateBlockRateLimitConfiguration.bind(this);
     this.updateLiquidationsConfig = this.updateLiquidationsConfig.bind(this);
+    this.updateInsuranceFundOutflowCap = this.updateInsuranceFundOutflowCap.bind(this);
   }
 
   proposedOperations(request: MsgProposedOperations): Promise<MsgProposedOperationsResponse> {
@@ -115,4 +122,10 @@ export class MsgClientImpl implements Msg {
     return promise.then(data => MsgUpdateLiquidationsConfigResponse.decode(new _m0.Reader(data)));
   }
 
+  updateInsuranceFundOutflowCap(request: MsgUpdateInsuranceFundOutflowCap): Promise<MsgUpdateInsuranceFundOutflowCapResponse> {
+    const data = MsgUpdateInsuranceFundOutflowCap.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.clob.Msg", "UpdateInsuranceFundOutflowCap", data);
+    return promise.then(data => MsgUpdateInsuranceFundOutflowCapResponse.decode(new _m0.Reader(data)));
+  }
+
 }
\ No newline at end of file
@@ -7,7 +7,7 @@ import { LiquidationsConfig, LiquidationsConfigSDKType } from "./liquidations_co
 import { ClobMatch, ClobMatchSDKType } from "./matches";
 import { OrderRemoval, OrderRemovalSDKType } from "./order_removals";
 import * as _m0 from "protobufjs/minimal";
-import { DeepPartial } from "../../helpers";
+import { DeepPartial, Long } from "../../helpers";
 /** MsgCreateClobPair is a message used by x/gov for creating a new clob pair. */
 
 export interface MsgCreateClobPair {
@@ -382,6 +382,50 @@ export interface MsgUpdateLiquidationsConfigResponse {}
 /** MsgUpdateLiquidationsConfig is the Msg/LiquidationsConfig response type. */
 
 export interface MsgUpdateLiquidationsConfigResponseSDKType {}
+/**
+ * MsgUpdateInsuranceFundOutflowCap is a request type for updating the maximum
+ * amount of quote quantums the insurance fund can pay out for liquidations per
+ * block.
+ */
+
+export interface MsgUpdateInsuranceFundOutflowCap {
+  /** Authority is the address that may send this message. */
+  authority: string;
+  /**
+   * The maximum amount of quote quantums the insurance fund can pay out for
+   * liquidations per block. Zero removes the cap.
+   */
+
+  insuranceFundOutflowCap: Long;
+}
+/**
+ * MsgUpdateInsuranceFundOutflowCap is a request type for updating the maximum
+ * amount of quote quantums the insurance fund can pay out for liquidations per
+ * block.
+ */
+
+export interface MsgUpdateInsuranceFundOutflowCapSDKType {
+  /** Authority is the address that may send this message. */
+  authority: string;
+  /**
+   * The maximum amount of quote quantums the insurance fund can pay out for
+   * liquidations per block. Zero removes the cap.
+   */
+
+  insurance_fund_outflow_cap: Long;
+}
+/**
+ * MsgUpdateInsuranceFundOutflowCapResponse is the
+ * Msg/UpdateInsuranceFundOutflowCap response type.
+ */
+
+export interface MsgUpdateInsuranceFundOutflowCapResponse {}
+/**
+ * MsgUpdateInsuranceFundOutflowCapResponse is the
+ * Msg/UpdateInsuranceFundOutflowCap response type.
+ */
+
+export interface MsgUpdateInsuranceFundOutflowCapResponseSDKType {}
 
 function createBaseMsgCreateClobPair(): MsgCreateClobPair {
   return {
@@ -1335,4 +1379,93 @@ export const MsgUpdateLiquidationsConfigResponse = {
     return message;
   }
 
+};
+
+function createBaseMsgUpdateInsuranceFundOutflowCap(): MsgUpdateInsuranceFundOutflowCap {
+  return {
+    authority: "",
+    insuranceFundOutflowCap: Long.UZERO
+  };
+}
+
+export const MsgUpdateInsuranceFundOutflowCap = {
+  encode(message: MsgUpdateInsuranceFundOutflowCap, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.authority !== "") {
+      writer.uint32(10).string(message.authority);
+    }
+
+    if (!message.insuranceFundOutflowCap.isZero()) {
+      writer.uint32(16).uint64(message.insuranceFundOutflowCap);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgUpdateInsuranceFundOutflowCap {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgUpdateInsuranceFundOutflowCap();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.authority = reader.string();
+          break;
+
+        case 2:
+          message.insuranceFundOutflowCap = (reader.uint64() as Long);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MsgUpdateInsuranceFundOutflowCap>): MsgUpdateInsuranceFundOutflowCap {
+    const message = createBaseMsgUpdateInsuranceFundOutflowCap();
+    message.authority = object.authority ?? "";
+    message.insuranceFundOutflowCap = object.insuranceFundOutflowCap !== undefined && object.insuranceFundOutflowCap !== null ? Long.fromValue(object.insuranceFundOutflowCap) : Long.UZERO;
+    return message;
+  }
+
+};
+
+function createBaseMsgUpdateInsuranceFundOutflowCapResponse(): MsgUpdateInsuranceFundOutflowCapResponse {
+  return {};
+}
+
+export const MsgUpdateInsuranceFundOutflowCapResponse = {
+  encode(_: MsgUpdateInsuranceFundOutflowCapResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgUpdateInsuranceFundOutflowCapResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgUpdateInsuranceFundOutflowCapResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<MsgUpdateInsuranceFundOutflowCapResponse>): MsgUpdateInsuranceFundOutflowCapResponse {
+    const message = createBaseMsgUpdateInsuranceFundOutflowCapResponse();
+    return message;
+  }
+
 };
\ No newline at end of file
@@ -22,6 +22,13 @@ export interface Params {
    */
 
   minNumVotesPerSample: number;
+  /**
+   * Fee in parts-per-million of the notional of the change in open interest
+   * of a perpetual caused by a fill, accrued to the open interest imbalance
+   * pool of the perpetual. Zero disables the fee.
+   */
+
+  openInterestImbalanceFeePpm: number;
 }
 /** Params defines the parameters for x/perpetuals module. */
 
@@ -45,13 +52,21 @@ export interface ParamsSDKType {
    */
 
   min_num_votes_per_sample: number;
+  /**
+   * Fee in parts-per-million of the notional of the change in open interest
+   * of a perpetual caused by a fill, accrued to the open interest imbalance
+   * pool of the perpetual. Zero disables the fee.
+   */
+
+  open_interest_imbalance_fee_ppm: number;
 }
 
 function createBaseParams(): Params {
   return {
     fundingRateClampFactorPpm: 0,
     premiumVoteClampFactorPpm: 0,
-    minNumVotesPerSample: 0
+    minNumVotesPerSample: 0,
+    openInterestImbalanceFeePpm: 0
   };
 }
 
@@ -69,6 +84,10 @@ export const Params = {
       writer.uint32(24).uint32(message.minNumVotesPerSample);
     }
 
+    if (message.openInterestImbalanceFeePpm !== 0) {
+      writer.uint32(32).uint32(message.openInterestImbalanceFeePpm);
+    }
+
     return writer;
   },
 
@@ -93,6 +112,10 @@ export const Params = {
           message.minNumVotesPerSample = reader.uint32();
           break;
 
+        case 4:
+          message.openInterestImbalanceFeePpm = reader.uint32();
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -107,6 +130,7 @@ export const Params = {
     message.fundingRateClampFactorPpm = object.fundingRateClampFactorPpm ?? 0;
     message.premiumVoteClampFactorPpm = object.premiumVoteClampFactorPpm ?? 0;
     message.minNumVotesPerSample = object.minNumVotesPerSample ?? 0;
+    message.openInterestImbalanceFeePpm = object.openInterestImbalanceFeePpm ?? 0;
     return message;
   }
 
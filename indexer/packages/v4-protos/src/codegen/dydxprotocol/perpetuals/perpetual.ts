@@ -72,6 +72,31 @@ export interface Perpetual {
   /** Total size of open long contracts, measured in base_quantums. */
 
   openInterest: Uint8Array;
+  /**
+   * The cap on the absolute difference between the funding index of the
+   * perpetual and the funding index of a position that is applied when the
+   * position is settled. Zero means uncapped.
+   */
+
+  maxUnsettledFundingIndexDelta: Long;
+  /**
+   * The settlement price of the perpetual, in the exponent of its market
+   * price. Zero means the perpetual is not in settlement mode.
+   */
+
+  settlementPrice: Long;
+  /**
+   * The share of the open interest of the perpetual, in ppm, that the size of
+   * a position must exceed for the concentration margin add-on to apply.
+   */
+
+  concentrationThresholdPpm: number;
+  /**
+   * The maintenance margin fraction added to concentrated positions, in ppm
+   * of their notional. Zero means no concentration margin.
+   */
+
+  concentrationAddOnPpm: number;
 }
 /** Perpetual represents a perpetual on the dYdX exchange. */
 
@@ -87,6 +112,31 @@ export interface PerpetualSDKType {
   /** Total size of open long contracts, measured in base_quantums. */
 
   open_interest: Uint8Array;
+  /**
+   * The cap on the absolute difference between the funding index of the
+   * perpetual and the funding index of a position that is applied when the
+   * position is settled. Zero means uncapped.
+   */
+
+  max_unsettled_funding_index_delta: Long;
+  /**
+   * The settlement price of the perpetual, in the exponent of its market
+   * price. Zero means the perpetual is not in settlement mode.
+   */
+
+  settlement_price: Long;
+  /**
+   * The share of the open interest of the perpetual, in ppm, that the size of
+   * a position must exceed for the concentration margin add-on to apply.
+   */
+
+  concentration_threshold_ppm: number;
+  /**
+   * The maintenance margin fraction added to concentrated positions, in ppm
+   * of their notional. Zero means no concentration margin.
+   */
+
+  concentration_add_on_ppm: number;
 }
 /**
  * PerpetualParams represents the parameters of a perpetual on the dYdX
@@ -126,6 +176,12 @@ export interface PerpetualParams {
   /** The market type specifying if this perpetual is cross or isolated */
 
   marketType: PerpetualMarketType;
+  /**
+   * The contract type of the perpetual, `0` for linear and `1` for inverse.
+   * Defaults to linear.
+   */
+
+  perpetualType: number;
 }
 /**
  * PerpetualParams represents the parameters of a perpetual on the dYdX
@@ -165,6 +221,86 @@ export interface PerpetualParamsSDKType {
   /** The market type specifying if this perpetual is cross or isolated */
 
   market_type: PerpetualMarketTypeSDKType;
+  /**
+   * The contract type of the perpetual, `0` for linear and `1` for inverse.
+   * Defaults to linear.
+   */
+
+  perpetual_type: number;
+}
+/**
+ * FundingIndexSnapshot is the funding index of a perpetual recorded at the start
+ * of a funding-tick epoch.
+ */
+
+export interface FundingIndexSnapshot {
+  /** The block height at which the funding index was recorded. */
+  blockHeight: number;
+  /** The funding index of the perpetual as of the block height. */
+
+  fundingIndex: Uint8Array;
+}
+/**
+ * FundingIndexSnapshot is the funding index of a perpetual recorded at the start
+ * of a funding-tick epoch.
+ */
+
+export interface FundingIndexSnapshotSDKType {
+  /** The block height at which the funding index was recorded. */
+  block_height: number;
+  /** The funding index of the perpetual as of the block height. */
+
+  funding_index: Uint8Array;
+}
+/**
+ * MarginFractions are the margin fractions of a perpetual in effect at the
+ * start of a funding-tick epoch. Initial margin fractions include the scaling
+ * by the open interest of the perpetual.
+ */
+
+export interface MarginFractions {
+  /**
+   * The funding-tick epoch at the start of which the margin fractions were
+   * recorded.
+   */
+  epoch: number;
+  /** The initial margin fraction of long positions, in parts-per-million. */
+
+  initialMarginPpm: number;
+  /** The maintenance margin fraction of long positions, in parts-per-million. */
+
+  maintenanceMarginPpm: number;
+  /** The initial margin fraction of short positions, in parts-per-million. */
+
+  shortInitialMarginPpm: number;
+  /** The maintenance margin fraction of short positions, in parts-per-million. */
+
+  shortMaintenanceMarginPpm: number;
+}
+/**
+ * MarginFractions are the margin fractions of a perpetual in effect at the
+ * start of a funding-tick epoch. Initial margin fractions include the scaling
+ * by the open interest of the perpetual.
+ */
+
+export interface MarginFractionsSDKType {
+  /**
+   * The funding-tick epoch at the start of which the margin fractions were
+   * recorded.
+   */
+  epoch: number;
+  /** The initial margin fraction of long positions, in parts-per-million. */
+
+  initial_margin_ppm: number;
+  /** The maintenance margin fraction of long positions, in parts-per-million. */
+
+  maintenance_margin_ppm: number;
+  /** The initial margin fraction of short positions, in parts-per-million. */
+
+  short_initial_margin_ppm: number;
+  /** The maintenance margin fraction of short positions, in parts-per-million. */
+
+  short_maintenance_margin_ppm: number;
 }
 /** MarketPremiums stores a list of premiums for a single perpetual market. */
 
@@ -299,6 +435,35 @@ export interface LiquidityTier {
    */
 
   openInterestUpperCap: Long;
+  /**
+   * The margin fraction needed to open a short position, in parts-per-million.
+   * If zero, short positions use initial_margin_ppm.
+   */
+
+  shortInitialMarginPpm: number;
+  /**
+   * The fraction of the initial-margin that the maintenance-margin is for short
+   * positions, in parts-per-million. If zero, short positions use
+   * maintenance_fraction_ppm.
+   */
+
+  shortMaintenanceFractionPpm: number;
+  /**
+   * The maximum notional of a subaccount's position in a perpetual of this
+   * tier, in quote quantums. Updates that increase a position beyond this
+   * notional are rejected. If zero, positions are not capped.
+   */
+
+  maxPositionNotionalQuoteQuantums: Long;
+  /**
+   * The liquidation penalty of a liquidatable subaccount per unit of its
+   * maintenance margin deficit (its maintenance margin requirement minus its
+   * net collateral), in parts-per-million. The penalty never exceeds the
+   * net collateral of the subaccount. If non-zero, the penalty replaces the
+   * max liquidation fee of the liquidations config of the clob module.
+   */
+
+  liquidationPenaltyPpm: number;
 }
 /** LiquidityTier stores margin information. */
 
@@ -355,13 +520,46 @@ export interface LiquidityTierSDKType {
    */
 
   open_interest_upper_cap: Long;
+  /**
+   * The margin fraction needed to open a short position, in parts-per-million.
+   * If zero, short positions use initial_margin_ppm.
+   */
+
+  short_initial_margin_ppm: number;
+  /**
+   * The fraction of the initial-margin that the maintenance-margin is for short
+   * positions, in parts-per-million. If zero, short positions use
+   * maintenance_fraction_ppm.
+   */
+
+  short_maintenance_fraction_ppm: number;
+  /**
+   * The maximum notional of a subaccount's position in a perpetual of this
+   * tier, in quote quantums. Updates that increase a position beyond this
+   * notional are rejected. If zero, positions are not capped.
+   */
+
+  max_position_notional_quote_quantums: Long;
+  /**
+   * The liquidation penalty of a liquidatable subaccount per unit of its
+   * maintenance margin deficit (its maintenance margin requirement minus its
+   * net collateral), in parts-per-million. The penalty never exceeds the
+   * net collateral of the subaccount. If non-zero, the penalty replaces the
+   * max liquidation fee of the liquidations config of the clob module.
+   */
+
+  liquidation_penalty_ppm: number;
 }
 
 function createBasePerpetual(): Perpetual {
   return {
     params: undefined,
     fundingIndex: new Uint8Array(),
-    openInterest: new Uint8Array()
+    openInterest: new Uint8Array(),
+    maxUnsettledFundingIndexDelta: Long.UZERO,
+    settlementPrice: Long.UZERO,
+    concentrationThresholdPpm: 0,
+    concentrationAddOnPpm: 0
   };
 }
 
@@ -379,6 +577,22 @@ export const Perpetual = {
       writer.uint32(26).bytes(message.openInterest);
     }
 
+    if (!message.maxUnsettledFundingIndexDelta.isZero()) {
+      writer.uint32(32).uint64(message.maxUnsettledFundingIndexDelta);
+    }
+
+    if (!message.settlementPrice.isZero()) {
+      writer.uint32(40).uint64(message.settlementPrice);
+    }
+
+    if (message.concentrationThresholdPpm !== 0) {
+      writer.uint32(48).uint32(message.concentrationThresholdPpm);
+    }
+
+    if (message.concentrationAddOnPpm !== 0) {
+      writer.uint32(56).uint32(message.concentrationAddOnPpm);
+    }
+
     return writer;
   },
 
@@ -403,6 +617,22 @@ export const Perpetual = {
           message.openInterest = reader.bytes();
           break;
 
+        case 4:
+          message.maxUnsettledFundingIndexDelta = (reader.uint64() as Long);
+          break;
+
+        case 5:
+          message.settlementPrice = (reader.uint64() as Long);
+          break;
+
+        case 6:
+          message.concentrationThresholdPpm = reader.uint32();
+          break;
+
+        case 7:
+          message.concentrationAddOnPpm = reader.uint32();
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -417,6 +647,10 @@ export const Perpetual = {
     message.params = object.params !== undefined && object.params !== null ? PerpetualParams.fromPartial(object.params) : undefined;
     message.fundingIndex = object.fundingIndex ?? new Uint8Array();
     message.openInterest = object.openInterest ?? new Uint8Array();
+    message.maxUnsettledFundingIndexDelta = object.maxUnsettledFundingIndexDelta !== undefined && object.maxUnsettledFundingIndexDelta !== null ? Long.fromValue(object.maxUnsettledFundingIndexDelta) : Long.UZERO;
+    message.settlementPrice = object.settlementPrice !== undefined && object.settlementPrice !== null ? Long.fromValue(object.settlementPrice) : Long.UZERO;
+    message.concentrationThresholdPpm = object.concentrationThresholdPpm ?? 0;
+    message.concentrationAddOnPpm = object.concentrationAddOnPpm ?? 0;
     return message;
   }
 
@@ -430,7 +664,8 @@ function createBasePerpetualParams(): PerpetualParams {
     atomicResolution: 0,
     defaultFundingPpm: 0,
     liquidityTier: 0,
-    marketType: 0
+    marketType: 0,
+    perpetualType: 0
   };
 }
 
@@ -464,6 +699,10 @@ export const PerpetualParams = {
       writer.uint32(56).int32(message.marketType);
     }
 
+    if (message.perpetualType !== 0) {
+      writer.uint32(64).uint32(message.perpetualType);
+    }
+
     return writer;
   },
 
@@ -504,6 +743,10 @@ export const PerpetualParams = {
           message.marketType = (reader.int32() as any);
           break;
 
+        case 8:
+          message.perpetualType = reader.uint32();
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -522,6 +765,147 @@ export const PerpetualParams = {
     message.defaultFundingPpm = object.defaultFundingPpm ?? 0;
     message.liquidityTier = object.liquidityTier ?? 0;
     message.marketType = object.marketType ?? 0;
+    message.perpetualType = object.perpetualType ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseFundingIndexSnapshot(): FundingIndexSnapshot {
+  return {
+    blockHeight: 0,
+    fundingIndex: new Uint8Array()
+  };
+}
+
+export const FundingIndexSnapshot = {
+  encode(message: FundingIndexSnapshot, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.blockHeight !== 0) {
+      writer.uint32(8).uint32(message.blockHeight);
+    }
+
+    if (message.fundingIndex.length !== 0) {
+      writer.uint32(18).bytes(message.fundingIndex);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): FundingIndexSnapshot {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseFundingIndexSnapshot();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.blockHeight = reader.uint32();
+          break;
+
+        case 2:
+          message.fundingIndex = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<FundingIndexSnapshot>): FundingIndexSnapshot {
+    const message = createBaseFundingIndexSnapshot();
+    message.blockHeight = object.blockHeight ?? 0;
+    message.fundingIndex = object.fundingIndex ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseMarginFractions(): MarginFractions {
+  return {
+    epoch: 0,
+    initialMarginPpm: 0,
+    maintenanceMarginPpm: 0,
+    shortInitialMarginPpm: 0,
+    shortMaintenanceMarginPpm: 0
+  };
+}
+
+export const MarginFractions = {
+  encode(message: MarginFractions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.epoch !== 0) {
+      writer.uint32(8).uint32(message.epoch);
+    }
+
+    if (message.initialMarginPpm !== 0) {
+      writer.uint32(16).uint32(message.initialMarginPpm);
+    }
+
+    if (message.maintenanceMarginPpm !== 0) {
+      writer.uint32(24).uint32(message.maintenanceMarginPpm);
+    }
+
+    if (message.shortInitialMarginPpm !== 0) {
+      writer.uint32(32).uint32(message.shortInitialMarginPpm);
+    }
+
+    if (message.shortMaintenanceMarginPpm !== 0) {
+      writer.uint32(40).uint32(message.shortMaintenanceMarginPpm);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MarginFractions {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMarginFractions();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.epoch = reader.uint32();
+          break;
+
+        case 2:
+          message.initialMarginPpm = reader.uint32();
+          break;
+
+        case 3:
+          message.maintenanceMarginPpm = reader.uint32();
+          break;
+
+        case 4:
+          message.shortInitialMarginPpm = reader.uint32();
+          break;
+
+        case 5:
+          message.shortMaintenanceMarginPpm = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MarginFractions>): MarginFractions {
+    const message = createBaseMarginFractions();
+    message.epoch = object.epoch ?? 0;
+    message.initialMarginPpm = object.initialMarginPpm ?? 0;
+    message.maintenanceMarginPpm = object.maintenanceMarginPpm ?? 0;
+    message.shortInitialMarginPpm = object.shortInitialMarginPpm ?? 0;
+    message.shortMaintenanceMarginPpm = object.shortMaintenanceMarginPpm ?? 0;
     return message;
   }
 
@@ -658,7 +1042,11 @@ function createBaseLiquidityTier(): LiquidityTier {
     basePositionNotional: Long.UZERO,
     impactNotional: Long.UZERO,
     openInterestLowerCap: Long.UZERO,
-    openInterestUpperCap: Long.UZERO
+    openInterestUpperCap: Long.UZERO,
+    shortInitialMarginPpm: 0,
+    shortMaintenanceFractionPpm: 0,
+    maxPositionNotionalQuoteQuantums: Long.UZERO,
+    liquidationPenaltyPpm: 0
   };
 }
 
@@ -696,6 +1084,22 @@ export const LiquidityTier = {
       writer.uint32(64).uint64(message.openInterestUpperCap);
     }
 
+    if (message.shortInitialMarginPpm !== 0) {
+      writer.uint32(72).uint32(message.shortInitialMarginPpm);
+    }
+
+    if (message.shortMaintenanceFractionPpm !== 0) {
+      writer.uint32(80).uint32(message.shortMaintenanceFractionPpm);
+    }
+
+    if (!message.maxPositionNotionalQuoteQuantums.isZero()) {
+      writer.uint32(88).uint64(message.maxPositionNotionalQuoteQuantums);
+    }
+
+    if (message.liquidationPenaltyPpm !== 0) {
+      writer.uint32(96).uint32(message.liquidationPenaltyPpm);
+    }
+
     return writer;
   },
 
@@ -740,6 +1144,22 @@ export const LiquidityTier = {
           message.openInterestUpperCap = (reader.uint64() as Long);
           break;
 
+        case 9:
+          message.shortInitialMarginPpm = reader.uint32();
+          break;
+
+        case 10:
+          message.shortMaintenanceFractionPpm = reader.uint32();
+          break;
+
+        case 11:
+          message.maxPositionNotionalQuoteQuantums = (reader.uint64() as Long);
+          break;
+
+        case 12:
+          message.liquidationPenaltyPpm = reader.uint32();
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -759,6 +1179,10 @@ export const LiquidityTier = {
     message.impactNotional = object.impactNotional !== undefined && object.impactNotional !== null ? Long.fromValue(object.impactNotional) : Long.UZERO;
     message.openInterestLowerCap = object.openInterestLowerCap !== undefined && object.openInterestLowerCap !== null ? Long.fromValue(object.openInterestLowerCap) : Long.UZERO;
     message.openInterestUpperCap = object.openInterestUpperCap !== undefined && object.openInterestUpperCap !== null ? Long.fromValue(object.openInterestUpperCap) : Long.UZERO;
+    message.shortInitialMarginPpm = object.shortInitialMarginPpm ?? 0;
+    message.shortMaintenanceFractionPpm = object.shortMaintenanceFractionPpm ?? 0;
+    message.maxPositionNotionalQuoteQuantums = object.maxPositionNotionalQuoteQuantums !== undefined && object.maxPositionNotionalQuoteQuantums !== null ? Long.fromValue(object.maxPositionNotionalQuoteQuantums) : Long.UZERO;
+    message.liquidationPenaltyPpm = object.liquidationPenaltyPpm ?? 0;
     return message;
   }
 
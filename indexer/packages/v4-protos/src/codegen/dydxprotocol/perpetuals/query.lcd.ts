@@ -1,6 +1,6 @@
 import { setPaginationParams } from "../../helpers";
 import { LCDClient } from "@osmonauts/lcd";
-import { QueryPerpetualRequest, QueryPerpetualResponseSDKType, QueryAllPerpetualsRequest, QueryAllPerpetualsResponseSDKType, QueryAllLiquidityTiersRequest, QueryAllLiquidityTiersResponseSDKType, QueryPremiumVotesRequest, QueryPremiumVotesResponseSDKType, QueryPremiumSamplesRequest, QueryPremiumSamplesResponseSDKType, QueryParamsRequest, QueryParamsResponseSDKType, QueryNextPerpetualIdRequest, QueryNextPerpetualIdResponseSDKType } from "./query";
+import { QueryPerpetualRequest, QueryPerpetualResponseSDKType, QueryAllPerpetualsRequest, QueryAllPerpetualsResponseSDKType, QueryAllLiquidityTiersRequest, QueryAllLiquidityTiersResponseSDKType, QueryPremiumVotesRequest, QueryPremiumVotesResponseSDKType, QueryPremiumSamplesRequest, QueryPremiumSamplesResponseSDKType, QueryParamsRequest, QueryParamsResponseSDKType, QueryNextPerpetualIdRequest, QueryNextPerpetualIdResponseSDKType, QueryHistoricalFundingIndexRequest, QueryHistoricalFundingIndexResponseSDKType, QueryHistoricalMarginFractionsRequest, QueryHistoricalMarginFractionsResponseSDKType } from "./query";
 export class LCDQueryClient {
   req: LCDClient;
 
@@ -17,6 +17,8 @@ export class LCDQueryClient {
     this.premiumSamples = this.premiumSamples.bind(this);
     this.params = this.params.bind(this);
     this.nextPerpetualId = this.nextPerpetualId.bind(this);
+    this.historicalFundingIndex = this.historicalFundingIndex.bind(this);
+    this.historicalMarginFractions = this.historicalMarginFractions.bind(this);
   }
   /* Queries a Perpetual by id. */
 
@@ -87,5 +89,19 @@ export class LCDQueryClient {
     const endpoint = `dydxprotocol/perpetuals/next_perpetual_id`;
     return await this.req.get<QueryNextPerpetualIdResponseSDKType>(endpoint);
   }
+  /* Queries the funding index of a perpetual at a past block height. */
+
+
+  async historicalFundingIndex(params: QueryHistoricalFundingIndexRequest): Promise<QueryHistoricalFundingIndexResponseSDKType> {
+    const endpoint = `dydxprotocol/perpetuals/historical_funding_index/${params.perpetualId}/${params.height}`;
+    return await this.req.get<QueryHistoricalFundingIndexResponseSDKType>(endpoint);
+  }
+  /* Queries the margin fractions of a perpetual during a past funding-tick
+   epoch. */
+
+  async historicalMarginFractions(params: QueryHistoricalMarginFractionsRequest): Promise<QueryHistoricalMarginFractionsResponseSDKType> {
+    const endpoint = `dydxprotocol/perpetuals/historical_margin_fractions/${params.perpetualId}/${params.epoch}`;
+    return await this.req.get<QueryHistoricalMarginFractionsResponseSDKType>(endpoint);
+  }
 
 }
\ No newline at end of file
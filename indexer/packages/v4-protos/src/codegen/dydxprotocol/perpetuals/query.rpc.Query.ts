@@ -1,7 +1,7 @@
 import { Rpc } from "../../helpers";
 import * as _m0 from "protobufjs/minimal";
 import { QueryClient, createProtobufRpcClient } from "@cosmjs/stargate";
-import { QueryPerpetualRequest, QueryPerpetualResponse, QueryAllPerpetualsRequest, QueryAllPerpetualsResponse, QueryAllLiquidityTiersRequest, QueryAllLiquidityTiersResponse, QueryPremiumVotesRequest, QueryPremiumVotesResponse, QueryPremiumSamplesRequest, QueryPremiumSamplesResponse, QueryParamsRequest, QueryParamsResponse, QueryNextPerpetualIdRequest, QueryNextPerpetualIdResponse } from "./query";
+import { QueryPerpetualRequest, QueryPerpetualResponse, QueryAllPerpetualsRequest, QueryAllPerpetualsResponse, QueryAllLiquidityTiersRequest, QueryAllLiquidityTiersResponse, QueryPremiumVotesRequest, QueryPremiumVotesResponse, QueryPremiumSamplesRequest, QueryPremiumSamplesResponse, QueryParamsRequest, QueryParamsResponse, QueryNextPerpetualIdRequest, QueryNextPerpetualIdResponse, QueryHistoricalFundingIndexRequest, QueryHistoricalFundingIndexResponse, QueryHistoricalMarginFractionsRequest, QueryHistoricalMarginFractionsResponse } from "./query";
 /** Query defines the gRPC querier service. */
 
 export interface Query {
@@ -25,6 +25,15 @@ export interface Query {
   /** Queries the next perpetual id. */
 
   nextPerpetualId(request?: QueryNextPerpetualIdRequest): Promise<QueryNextPerpetualIdResponse>;
+  /** Queries the funding index of a perpetual at a past block height. */
+
+  historicalFundingIndex(request: QueryHistoricalFundingIndexRequest): Promise<QueryHistoricalFundingIndexResponse>;
+  /**
+   * Queries the margin fractions of a perpetual during a past funding-tick
+   * epoch.
+   */
+
+  historicalMarginFractions(request: QueryHistoricalMarginFractionsRequest): Promise<QueryHistoricalMarginFractionsResponse>;
 }
 export class QueryClientImpl implements Query {
   private readonly rpc: Rpc;
@@ -38,6 +47,8 @@ export class QueryClientImpl implements Query {
     this.premiumSamples = this.premiumSamples.bind(this);
     this.params = this.params.bind(this);
     this.nextPerpetualId = this.nextPerpetualId.bind(this);
+    this.historicalFundingIndex = this.historicalFundingIndex.bind(this);
+    this.historicalMarginFractions = this.historicalMarginFractions.bind(this);
   }
 
   perpetual(request: QueryPerpetualRequest): Promise<QueryPerpetualResponse> {
@@ -86,6 +97,18 @@ export class QueryClientImpl implements Query {
     return promise.then(data => QueryNextPerpetualIdResponse.decode(new _m0.Reader(data)));
   }
 
+  historicalFundingIndex(request: QueryHistoricalFundingIndexRequest): Promise<QueryHistoricalFundingIndexResponse> {
+    const data = QueryHistoricalFundingIndexRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.perpetuals.Query", "HistoricalFundingIndex", data);
+    return promise.then(data => QueryHistoricalFundingIndexResponse.decode(new _m0.Reader(data)));
+  }
+
+  historicalMarginFractions(request: QueryHistoricalMarginFractionsRequest): Promise<QueryHistoricalMarginFractionsResponse> {
+    const data = QueryHistoricalMarginFractionsRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.perpetuals.Query", "HistoricalMarginFractions", data);
+    return promise.then(data => QueryHistoricalMarginFractionsResponse.decode(new _m0.Reader(data)));
+  }
+
 }
 export const createRpcQueryExtension = (base: QueryClient) => {
   const rpc = createProtobufRpcClient(base);
@@ -117,6 +140,14 @@ export const createRpcQueryExtension = (base: QueryClient) => {
 
     nextPerpetualId(request?: QueryNextPerpetualIdRequest): Promise<QueryNextPerpetualIdResponse> {
       return queryService.nextPerpetualId(request);
+    },
+
+    historicalFundingIndex(request: QueryHistoricalFundingIndexRequest): Promise<QueryHistoricalFundingIndexResponse> {
+      return queryService.historicalFundingIndex(request);
+    },
+
+    historicalMarginFractions(request: QueryHistoricalMarginFractionsRequest): Promise<QueryHistoricalMarginFractionsResponse> {
+      return queryService.historicalMarginFractions(request);
     }
 
   };
@@ -1,5 +1,5 @@
 import { PageRequest, PageRequestSDKType, PageResponse, PageResponseSDKType } from "../../cosmos/base/query/v1beta1/pagination";
-import { Perpetual, PerpetualSDKType, LiquidityTier, LiquidityTierSDKType, PremiumStore, PremiumStoreSDKType } from "./perpetual";
+import { Perpetual, PerpetualSDKType, LiquidityTier, LiquidityTierSDKType, PremiumStore, PremiumStoreSDKType, FundingIndexSnapshot, FundingIndexSnapshotSDKType, MarginFractions, MarginFractionsSDKType } from "./perpetual";
 import { Params, ParamsSDKType } from "./params";
 import * as _m0 from "protobufjs/minimal";
 import { DeepPartial } from "../../helpers";
@@ -159,6 +159,76 @@ export interface QueryNextPerpetualIdResponseSDKType {
   /** QueryNextPerpetualIdResponse is the response type for the NextPerpetualId RPC */
   next_perpetual_id: number;
 }
+/**
+ * QueryHistoricalFundingIndexRequest is the request type for the
+ * HistoricalFundingIndex RPC method.
+ */
+
+export interface QueryHistoricalFundingIndexRequest {
+  perpetualId: number;
+  height: number;
+}
+/**
+ * QueryHistoricalFundingIndexRequest is the request type for the
+ * HistoricalFundingIndex RPC method.
+ */
+
+export interface QueryHistoricalFundingIndexRequestSDKType {
+  perpetual_id: number;
+  height: number;
+}
+/**
+ * QueryHistoricalFundingIndexResponse is the response type for the
+ * HistoricalFundingIndex RPC method. The snapshot is the latest one recorded at
+ * or before the requested height.
+ */
+
+export interface QueryHistoricalFundingIndexResponse {
+  snapshot?: FundingIndexSnapshot;
+}
+/**
+ * QueryHistoricalFundingIndexResponse is the response type for the
+ * HistoricalFundingIndex RPC method. The snapshot is the latest one recorded at
+ * or before the requested height.
+ */
+
+export interface QueryHistoricalFundingIndexResponseSDKType {
+  snapshot?: FundingIndexSnapshotSDKType;
+}
+/**
+ * QueryHistoricalMarginFractionsRequest is the request type for the
+ * HistoricalMarginFractions RPC method.
+ */
+
+export interface QueryHistoricalMarginFractionsRequest {
+  perpetualId: number;
+  epoch: number;
+}
+/**
+ * QueryHistoricalMarginFractionsRequest is the request type for the
+ * HistoricalMarginFractions RPC method.
+ */
+
+export interface QueryHistoricalMarginFractionsRequestSDKType {
+  perpetual_id: number;
+  epoch: number;
+}
+/**
+ * QueryHistoricalMarginFractionsResponse is the response type for the
+ * HistoricalMarginFractions RPC method.
+ */
+
+export interface QueryHistoricalMarginFractionsResponse {
+  marginFractions?: MarginFractions;
+}
+/**
+ * QueryHistoricalMarginFractionsResponse is the response type for the
+ * HistoricalMarginFractions RPC method.
+ */
+
+export interface QueryHistoricalMarginFractionsResponseSDKType {
+  margin_fractions?: MarginFractionsSDKType;
+}
 
 function createBaseQueryPerpetualRequest(): QueryPerpetualRequest {
   return {
@@ -764,4 +834,204 @@ export const QueryNextPerpetualIdResponse = {
     return message;
   }
 
+};
+
+function createBaseQueryHistoricalFundingIndexRequest(): QueryHistoricalFundingIndexRequest {
+  return {
+    perpetualId: 0,
+    height: 0
+  };
+}
+
+export const QueryHistoricalFundingIndexRequest = {
+  encode(message: QueryHistoricalFundingIndexRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.perpetualId !== 0) {
+      writer.uint32(8).uint32(message.perpetualId);
+    }
+
+    if (message.height !== 0) {
+      writer.uint32(16).uint32(message.height);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryHistoricalFundingIndexRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryHistoricalFundingIndexRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.perpetualId = reader.uint32();
+          break;
+
+        case 2:
+          message.height = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryHistoricalFundingIndexRequest>): QueryHistoricalFundingIndexRequest {
+    const message = createBaseQueryHistoricalFundingIndexRequest();
+    message.perpetualId = object.perpetualId ?? 0;
+    message.height = object.height ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryHistoricalFundingIndexResponse(): QueryHistoricalFundingIndexResponse {
+  return {
+    snapshot: undefined
+  };
+}
+
+export const QueryHistoricalFundingIndexResponse = {
+  encode(message: QueryHistoricalFundingIndexResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.snapshot !== undefined) {
+      FundingIndexSnapshot.encode(message.snapshot, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryHistoricalFundingIndexResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryHistoricalFundingIndexResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.snapshot = FundingIndexSnapshot.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryHistoricalFundingIndexResponse>): QueryHistoricalFundingIndexResponse {
+    const message = createBaseQueryHistoricalFundingIndexResponse();
+    message.snapshot = object.snapshot !== undefined && object.snapshot !== null ? FundingIndexSnapshot.fromPartial(object.snapshot) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseQueryHistoricalMarginFractionsRequest(): QueryHistoricalMarginFractionsRequest {
+  return {
+    perpetualId: 0,
+    epoch: 0
+  };
+}
+
+export const QueryHistoricalMarginFractionsRequest = {
+  encode(message: QueryHistoricalMarginFractionsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.perpetualId !== 0) {
+      writer.uint32(8).uint32(message.perpetualId);
+    }
+
+    if (message.epoch !== 0) {
+      writer.uint32(16).uint32(message.epoch);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryHistoricalMarginFractionsRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryHistoricalMarginFractionsRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.perpetualId = reader.uint32();
+          break;
+
+        case 2:
+          message.epoch = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryHistoricalMarginFractionsRequest>): QueryHistoricalMarginFractionsRequest {
+    const message = createBaseQueryHistoricalMarginFractionsRequest();
+    message.perpetualId = object.perpetualId ?? 0;
+    message.epoch = object.epoch ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryHistoricalMarginFractionsResponse(): QueryHistoricalMarginFractionsResponse {
+  return {
+    marginFractions: undefined
+  };
+}
+
+export const QueryHistoricalMarginFractionsResponse = {
+  encode(message: QueryHistoricalMarginFractionsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.marginFractions !== undefined) {
+      MarginFractions.encode(message.marginFractions, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryHistoricalMarginFractionsResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryHistoricalMarginFractionsResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.marginFractions = MarginFractions.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryHistoricalMarginFractionsResponse>): QueryHistoricalMarginFractionsResponse {
+    const message = createBaseQueryHistoricalMarginFractionsResponse();
+    message.marginFractions = object.marginFractions !== undefined && object.marginFractions !== null ? MarginFractions.fromPartial(object.marginFractions) : undefined;
+    return message;
+  }
+
 };
\ No newline at end of file
@@ -1,6 +1,6 @@
 import { Rpc } from "../../helpers";
 import * as _m0 from "protobufjs/minimal";
-import { MsgAddPremiumVotes, MsgAddPremiumVotesResponse, MsgCreatePerpetual, MsgCreatePerpetualResponse, MsgSetLiquidityTier, MsgSetLiquidityTierResponse, MsgUpdatePerpetualParams, MsgUpdatePerpetualParamsResponse, MsgUpdateParams, MsgUpdateParamsResponse } from "./tx";
+import { MsgAddPremiumVotes, MsgAddPremiumVotesResponse, MsgCreatePerpetual, MsgCreatePerpetualResponse, MsgSetLiquidityTier, MsgSetLiquidityTierResponse, MsgUpdatePerpetualParams, MsgUpdatePerpetualParamsResponse, MsgUpdateParams, MsgUpdateParamsResponse, MsgSetFundingRateCap, MsgSetFundingRateCapResponse, MsgSetMaxUnsettledFundingIndexDelta, MsgSetMaxUnsettledFundingIndexDeltaResponse, MsgSetSettlementPrice, MsgSetSettlementPriceResponse, MsgSetConcentrationMargin, MsgSetConcentrationMarginResponse } from "./tx";
 /** Msg defines the Msg service. */
 
 export interface Msg {
@@ -24,6 +24,27 @@ export interface Msg {
   /** UpdateParams updates the parameters of perpetuals module. */
 
   updateParams(request: MsgUpdateParams): Promise<MsgUpdateParamsResponse>;
+  /** SetFundingRateCap sets the cap on the funding rate of a perpetual. */
+
+  setFundingRateCap(request: MsgSetFundingRateCap): Promise<MsgSetFundingRateCapResponse>;
+  /**
+   * SetMaxUnsettledFundingIndexDelta sets the cap on the unsettled funding
+   * index delta of positions in a perpetual.
+   */
+
+  setMaxUnsettledFundingIndexDelta(request: MsgSetMaxUnsettledFundingIndexDelta): Promise<MsgSetMaxUnsettledFundingIndexDeltaResponse>;
+  /**
+   * SetSettlementPrice sets the settlement price of a perpetual that is being
+   * wound down.
+   */
+
+  setSettlementPrice(request: MsgSetSettlementPrice): Promise<MsgSetSettlementPriceResponse>;
+  /**
+   * SetConcentrationMargin sets the additional maintenance margin required of
+   * concentrated positions in a perpetual.
+   */
+
+  setConcentrationMargin(request: MsgSetConcentrationMargin): Promise<MsgSetConcentrationMarginResponse>;
 }
 export class MsgClientImpl implements Msg {
   private readonly rpc: Rpc;
@@ -35,6 +56,10 @@ export class MsgClientImpl implements Msg {
     this.setLiquidityTier = this.setLiquidityTier.bind(this);
     this.updatePerpetualParams = this.updatePerpetualParams.bind(this);
     this.updateParams = this.updateParams.bind(this);
+    this.setFundingRateCap = this.setFundingRateCap.bind(this);
+    this.setMaxUnsettledFundingIndexDelta = this.setMaxUnsettledFundingIndexDelta.bind(this);
+    this.setSettlementPrice = this.setSettlementPrice.bind(this);
+    this.setConcentrationMargin = this.setConcentrationMargin.bind(this);
   }
 
   addPremiumVotes(request: MsgAddPremiumVotes): Promise<MsgAddPremiumVotesResponse> {
@@ -67,4 +92,28 @@ export class MsgClientImpl implements Msg {
     return promise.then(data => MsgUpdateParamsResponse.decode(new _m0.Reader(data)));
   }
 
+  setFundingRateCap(request: MsgSetFundingRateCap): Promise<MsgSetFundingRateCapResponse> {
+    const data = MsgSetFundingRateCap.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.perpetuals.Msg", "SetFundingRateCap", data);
+    return promise.then(data => MsgSetFundingRateCapResponse.decode(new _m0.Reader(data)));
+  }
+
+  setMaxUnsettledFundingIndexDelta(request: MsgSetMaxUnsettledFundingIndexDelta): Promise<MsgSetMaxUnsettledFundingIndexDeltaResponse> {
+    const data = MsgSetMaxUnsettledFundingIndexDelta.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.perpetuals.Msg", "SetMaxUnsettledFundingIndexDelta", data);
+    return promise.then(data => MsgSetMaxUnsettledFundingIndexDeltaResponse.decode(new _m0.Reader(data)));
+  }
+
+  setSettlementPrice(request: MsgSetSettlementPrice): Promise<MsgSetSettlementPriceResponse> {
+    const data = MsgSetSettlementPrice.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.perpetuals.Msg", "SetSettlementPrice", data);
+    return promise.then(data => MsgSetSettlementPriceResponse.decode(new _m0.Reader(data)));
+  }
+
+  setConcentrationMargin(request: MsgSetConcentrationMargin): Promise<MsgSetConcentrationMarginResponse> {
+    const data = MsgSetConcentrationMargin.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.perpetuals.Msg", "SetConcentrationMargin", data);
+    return promise.then(data => MsgSetConcentrationMarginResponse.decode(new _m0.Reader(data)));
+  }
+
 }
\ No newline at end of file
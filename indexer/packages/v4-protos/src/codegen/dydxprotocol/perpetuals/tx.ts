@@ -1,7 +1,7 @@
 import { PerpetualParams, PerpetualParamsSDKType, LiquidityTier, LiquidityTierSDKType } from "./perpetual";
 import { Params, ParamsSDKType } from "./params";
 import * as _m0 from "protobufjs/minimal";
-import { DeepPartial } from "../../helpers";
+import { Long, DeepPartial } from "../../helpers";
 /** MsgCreatePerpetual is a message used by x/gov to create a new perpetual. */
 
 export interface MsgCreatePerpetual {
@@ -170,6 +170,206 @@ export interface MsgUpdateParamsResponse {}
 /** MsgUpdateParamsResponse defines the UpdateParams response type. */
 
 export interface MsgUpdateParamsResponseSDKType {}
+/**
+ * MsgSetFundingRateCap is a message used by x/gov to set the cap on the
+ * absolute funding rate of a perpetual.
+ */
+
+export interface MsgSetFundingRateCap {
+  /** The address that controls the module. */
+  authority: string;
+  /** The id of the perpetual to set the funding rate cap of. */
+
+  perpetualId: number;
+  /**
+   * The cap on the absolute funding rate, in parts-per-million. Zero removes
+   * the cap.
+   */
+
+  fundingRateCapPpm: number;
+}
+/**
+ * MsgSetFundingRateCap is a message used by x/gov to set the cap on the
+ * absolute funding rate of a perpetual.
+ */
+
+export interface MsgSetFundingRateCapSDKType {
+  /** The address that controls the module. */
+  authority: string;
+  /** The id of the perpetual to set the funding rate cap of. */
+
+  perpetual_id: number;
+  /**
+   * The cap on the absolute funding rate, in parts-per-million. Zero removes
+   * the cap.
+   */
+
+  funding_rate_cap_ppm: number;
+}
+/** MsgSetFundingRateCapResponse defines the SetFundingRateCap response type. */
+
+export interface MsgSetFundingRateCapResponse {}
+/** MsgSetFundingRateCapResponse defines the SetFundingRateCap response type. */
+
+export interface MsgSetFundingRateCapResponseSDKType {}
+/**
+ * MsgSetMaxUnsettledFundingIndexDelta is a message used by x/gov to set the
+ * cap on the unsettled funding index delta of positions in a perpetual.
+ */
+
+export interface MsgSetMaxUnsettledFundingIndexDelta {
+  /** The address that controls the module. */
+  authority: string;
+  /** The id of the perpetual to set the cap of. */
+
+  perpetualId: number;
+  /**
+   * The cap on the absolute difference between the funding index of the
+   * perpetual and the funding index of a position that is settled. Zero
+   * removes the cap.
+   */
+
+  maxUnsettledFundingIndexDelta: Long;
+}
+/**
+ * MsgSetMaxUnsettledFundingIndexDelta is a message used by x/gov to set the
+ * cap on the unsettled funding index delta of positions in a perpetual.
+ */
+
+export interface MsgSetMaxUnsettledFundingIndexDeltaSDKType {
+  /** The address that controls the module. */
+  authority: string;
+  /** The id of the perpetual to set the cap of. */
+
+  perpetual_id: number;
+  /**
+   * The cap on the absolute difference between the funding index of the
+   * perpetual and the funding index of a position that is settled. Zero
+   * removes the cap.
+   */
+
+  max_unsettled_funding_index_delta: Long;
+}
+/**
+ * MsgSetMaxUnsettledFundingIndexDeltaResponse defines the
+ * SetMaxUnsettledFundingIndexDelta response type.
+ */
+
+export interface MsgSetMaxUnsettledFundingIndexDeltaResponse {}
+/**
+ * MsgSetMaxUnsettledFundingIndexDeltaResponse defines the
+ * SetMaxUnsettledFundingIndexDelta response type.
+ */
+
+export interface MsgSetMaxUnsettledFundingIndexDeltaResponseSDKType {}
+/**
+ * MsgSetSettlementPrice is a message used by x/gov to put a perpetual that is
+ * being wound down into, or take it out of, settlement mode.
+ */
+
+export interface MsgSetSettlementPrice {
+  /** The address that controls the module. */
+  authority: string;
+  /** The id of the perpetual to set the settlement price of. */
+
+  perpetualId: number;
+  /**
+   * The price at which positions in the perpetual are valued, in the exponent
+   * of the market price of the perpetual. Zero takes the perpetual out of
+   * settlement mode.
+   */
+
+  settlementPrice: Long;
+}
+/**
+ * MsgSetSettlementPrice is a message used by x/gov to put a perpetual that is
+ * being wound down into, or take it out of, settlement mode.
+ */
+
+export interface MsgSetSettlementPriceSDKType {
+  /** The address that controls the module. */
+  authority: string;
+  /** The id of the perpetual to set the settlement price of. */
+
+  perpetual_id: number;
+  /**
+   * The price at which positions in the perpetual are valued, in the exponent
+   * of the market price of the perpetual. Zero takes the perpetual out of
+   * settlement mode.
+   */
+
+  settlement_price: Long;
+}
+/** MsgSetSettlementPriceResponse defines the SetSettlementPrice response type. */
+
+export interface MsgSetSettlementPriceResponse {}
+/** MsgSetSettlementPriceResponse defines the SetSettlementPrice response type. */
+
+export interface MsgSetSettlementPriceResponseSDKType {}
+/**
+ * MsgSetConcentrationMargin is a message used by x/gov to set the additional
+ * maintenance margin required of positions in a perpetual that make up a large
+ * share of its open interest.
+ */
+
+export interface MsgSetConcentrationMargin {
+  /** The address that controls the module. */
+  authority: string;
+  /** The id of the perpetual to set the concentration margin of. */
+
+  perpetualId: number;
+  /**
+   * The share of the open interest of the perpetual, in parts-per-million,
+   * that the size of a position must exceed for the add-on to apply.
+   */
+
+  thresholdPpm: number;
+  /**
+   * The maintenance margin fraction added to concentrated positions, in
+   * parts-per-million of their notional. Zero removes the concentration
+   * margin.
+   */
+
+  addOnPpm: number;
+}
+/**
+ * MsgSetConcentrationMargin is a message used by x/gov to set the additional
+ * maintenance margin required of positions in a perpetual that make up a large
+ * share of its open interest.
+ */
+
+export interface MsgSetConcentrationMarginSDKType {
+  /** The address that controls the module. */
+  authority: string;
+  /** The id of the perpetual to set the concentration margin of. */
+
+  perpetual_id: number;
+  /**
+   * The share of the open interest of the perpetual, in parts-per-million,
+   * that the size of a position must exceed for the add-on to apply.
+   */
+
+  threshold_ppm: number;
+  /**
+   * The maintenance margin fraction added to concentrated positions, in
+   * parts-per-million of their notional. Zero removes the concentration
+   * margin.
+   */
+
+  add_on_ppm: number;
+}
+/**
+ * MsgSetConcentrationMarginResponse defines the SetConcentrationMargin
+ * response type.
+ */
+
+export interface MsgSetConcentrationMarginResponse {}
+/**
+ * MsgSetConcentrationMarginResponse defines the SetConcentrationMargin
+ * response type.
+ */
+
+export interface MsgSetConcentrationMarginResponseSDKType {}
 
 function createBaseMsgCreatePerpetual(): MsgCreatePerpetual {
   return {
@@ -659,4 +859,410 @@ export const MsgUpdateParamsResponse = {
     return message;
   }
 
+};
+
+function createBaseMsgSetFundingRateCap(): MsgSetFundingRateCap {
+  return {
+    authority: "",
+    perpetualId: 0,
+    fundingRateCapPpm: 0
+  };
+}
+
+export const MsgSetFundingRateCap = {
+  encode(message: MsgSetFundingRateCap, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.authority !== "") {
+      writer.uint32(10).string(message.authority);
+    }
+
+    if (message.perpetualId !== 0) {
+      writer.uint32(16).uint32(message.perpetualId);
+    }
+
+    if (message.fundingRateCapPpm !== 0) {
+      writer.uint32(24).uint32(message.fundingRateCapPpm);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgSetFundingRateCap {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgSetFundingRateCap();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.authority = reader.string();
+          break;
+
+        case 2:
+          message.perpetualId = reader.uint32();
+          break;
+
+        case 3:
+          message.fundingRateCapPpm = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MsgSetFundingRateCap>): MsgSetFundingRateCap {
+    const message = createBaseMsgSetFundingRateCap();
+    message.authority = object.authority ?? "";
+    message.perpetualId = object.perpetualId ?? 0;
+    message.fundingRateCapPpm = object.fundingRateCapPpm ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseMsgSetFundingRateCapResponse(): MsgSetFundingRateCapResponse {
+  return {};
+}
+
+export const MsgSetFundingRateCapResponse = {
+  encode(_: MsgSetFundingRateCapResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgSetFundingRateCapResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgSetFundingRateCapResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<MsgSetFundingRateCapResponse>): MsgSetFundingRateCapResponse {
+    const message = createBaseMsgSetFundingRateCapResponse();
+    return message;
+  }
+
+};
+
+function createBaseMsgSetMaxUnsettledFundingIndexDelta(): MsgSetMaxUnsettledFundingIndexDelta {
+  return {
+    authority: "",
+    perpetualId: 0,
+    maxUnsettledFundingIndexDelta: Long.UZERO
+  };
+}
+
+export const MsgSetMaxUnsettledFundingIndexDelta = {
+  encode(message: MsgSetMaxUnsettledFundingIndexDelta, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.authority !== "") {
+      writer.uint32(10).string(message.authority);
+    }
+
+    if (message.perpetualId !== 0) {
+      writer.uint32(16).uint32(message.perpetualId);
+    }
+
+    if (!message.maxUnsettledFundingIndexDelta.isZero()) {
+      writer.uint32(24).uint64(message.maxUnsettledFundingIndexDelta);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgSetMaxUnsettledFundingIndexDelta {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgSetMaxUnsettledFundingIndexDelta();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.authority = reader.string();
+          break;
+
+        case 2:
+          message.perpetualId = reader.uint32();
+          break;
+
+        case 3:
+          message.maxUnsettledFundingIndexDelta = (reader.uint64() as Long);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MsgSetMaxUnsettledFundingIndexDelta>): MsgSetMaxUnsettledFundingIndexDelta {
+    const message = createBaseMsgSetMaxUnsettledFundingIndexDelta();
+    message.authority = object.authority ?? "";
+    message.perpetualId = object.perpetualId ?? 0;
+    message.maxUnsettledFundingIndexDelta = object.maxUnsettledFundingIndexDelta !== undefined && object.maxUnsettledFundingIndexDelta !== null ? Long.fromValue(object.maxUnsettledFundingIndexDelta) : Long.UZERO;
+    return message;
+  }
+
+};
+
+function createBaseMsgSetMaxUnsettledFundingIndexDeltaResponse(): MsgSetMaxUnsettledFundingIndexDeltaResponse {
+  return {};
+}
+
+export const MsgSetMaxUnsettledFundingIndexDeltaResponse = {
+  encode(_: MsgSetMaxUnsettledFundingIndexDeltaResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgSetMaxUnsettledFundingIndexDeltaResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgSetMaxUnsettledFundingIndexDeltaResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<MsgSetMaxUnsettledFundingIndexDeltaResponse>): MsgSetMaxUnsettledFundingIndexDeltaResponse {
+    const message = createBaseMsgSetMaxUnsettledFundingIndexDeltaResponse();
+    return message;
+  }
+
+};
+
+function createBaseMsgSetSettlementPrice(): MsgSetSettlementPrice {
+  return {
+    authority: "",
+    perpetualId: 0,
+    settlementPrice: Long.UZERO
+  };
+}
+
+export const MsgSetSettlementPrice = {
+  encode(message: MsgSetSettlementPrice, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.authority !== "") {
+      writer.uint32(10).string(message.authority);
+    }
+
+    if (message.perpetualId !== 0) {
+      writer.uint32(16).uint32(message.perpetualId);
+    }
+
+    if (!message.settlementPrice.isZero()) {
+      writer.uint32(24).uint64(message.settlementPrice);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgSetSettlementPrice {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgSetSettlementPrice();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.authority = reader.string();
+          break;
+
+        case 2:
+          message.perpetualId = reader.uint32();
+          break;
+
+        case 3:
+          message.settlementPrice = (reader.uint64() as Long);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MsgSetSettlementPrice>): MsgSetSettlementPrice {
+    const message = createBaseMsgSetSettlementPrice();
+    message.authority = object.authority ?? "";
+    message.perpetualId = object.perpetualId ?? 0;
+    message.settlementPrice = object.settlementPrice !== undefined && object.settlementPrice !== null ? Long.fromValue(object.settlementPrice) : Long.UZERO;
+    return message;
+  }
+
+};
+
+function createBaseMsgSetSettlementPriceResponse(): MsgSetSettlementPriceResponse {
+  return {};
+}
+
+export const MsgSetSettlementPriceResponse = {
+  encode(_: MsgSetSettlementPriceResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgSetSettlementPriceResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgSetSettlementPriceResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<MsgSetSettlementPriceResponse>): MsgSetSettlementPriceResponse {
+    const message = createBaseMsgSetSettlementPriceResponse();
+    return message;
+  }
+
+};
+
+function createBaseMsgSetConcentrationMargin(): MsgSetConcentrationMargin {
+  return {
+    authority: "",
+    perpetualId: 0,
+    thresholdPpm: 0,
+    addOnPpm: 0
+  };
+}
+
+export const MsgSetConcentrationMargin = {
+  encode(message: MsgSetConcentrationMargin, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.authority !== "") {
+      writer.uint32(10).string(message.authority);
+    }
+
+    if (message.perpetualId !== 0) {
+      writer.uint32(16).uint32(message.perpetualId);
+    }
+
+    if (message.thresholdPpm !== 0) {
+      writer.uint32(24).uint32(message.thresholdPpm);
+    }
+
+    if (message.addOnPpm !== 0) {
+      writer.uint32(32).uint32(message.addOnPpm);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgSetConcentrationMargin {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgSetConcentrationMargin();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.authority = reader.string();
+          break;
+
+        case 2:
+          message.perpetualId = reader.uint32();
+          break;
+
+        case 3:
+          message.thresholdPpm = reader.uint32();
+          break;
+
+        case 4:
+          message.addOnPpm = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MsgSetConcentrationMargin>): MsgSetConcentrationMargin {
+    const message = createBaseMsgSetConcentrationMargin();
+    message.authority = object.authority ?? "";
+    message.perpetualId = object.perpetualId ?? 0;
+    message.thresholdPpm = object.thresholdPpm ?? 0;
+    message.addOnPpm = object.addOnPpm ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseMsgSetConcentrationMarginResponse(): MsgSetConcentrationMarginResponse {
+  return {};
+}
+
+export const MsgSetConcentrationMarginResponse = {
+  encode(_: MsgSetConcentrationMarginResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgSetConcentrationMarginResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgSetConcentrationMarginResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<MsgSetConcentrationMarginResponse>): MsgSetConcentrationMarginResponse {
+    const message = createBaseMsgSetConcentrationMarginResponse();
+    return message;
+  }
+
 };
\ No newline at end of file
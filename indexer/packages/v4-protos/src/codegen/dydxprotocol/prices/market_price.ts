@@ -20,6 +20,23 @@ export interface MarketPrice {
    */
 
   price: Long;
+  /**
+   * Whether the market price is negative. If set, the market price is
+   * `-price * 10^exponent`. Defaults to `false`, a positive market price.
+   * 
+   * This value is config-only and can only be set in the genesis state. Oracle
+   * price updates only update `price` and keep the sign. Markets with a
+   * negative price cannot be used as the market of an asset.
+   */
+
+  negative: boolean;
+  /**
+   * The confidence interval of the market price, in the same units as
+   * `price`. The market price is expected to be within `confidence` of the
+   * reported price. Defaults to `0`, no uncertainty.
+   */
+
+  confidence: Long;
 }
 /** MarketPrice is used by the application to store/retrieve oracle price. */
 
@@ -41,13 +58,32 @@ export interface MarketPriceSDKType {
    */
 
   price: Long;
+  /**
+   * Whether the market price is negative. If set, the market price is
+   * `-price * 10^exponent`. Defaults to `false`, a positive market price.
+   * 
+   * This value is config-only and can only be set in the genesis state. Oracle
+   * price updates only update `price` and keep the sign. Markets with a
+   * negative price cannot be used as the market of an asset.
+   */
+
+  negative: boolean;
+  /**
+   * The confidence interval of the market price, in the same units as
+   * `price`. The market price is expected to be within `confidence` of the
+   * reported price. Defaults to `0`, no uncertainty.
+   */
+
+  confidence: Long;
 }
 
 function createBaseMarketPrice(): MarketPrice {
   return {
     id: 0,
     exponent: 0,
-    price: Long.UZERO
+    price: Long.UZERO,
+    negative: false,
+    confidence: Long.UZERO
   };
 }
 
@@ -65,6 +101,14 @@ export const MarketPrice = {
       writer.uint32(24).uint64(message.price);
     }
 
+    if (message.negative === true) {
+      writer.uint32(32).bool(message.negative);
+    }
+
+    if (!message.confidence.isZero()) {
+      writer.uint32(40).uint64(message.confidence);
+    }
+
     return writer;
   },
 
@@ -89,6 +133,14 @@ export const MarketPrice = {
           message.price = (reader.uint64() as Long);
           break;
 
+        case 4:
+          message.negative = reader.bool();
+          break;
+
+        case 5:
+          message.confidence = (reader.uint64() as Long);
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -103,6 +155,8 @@ export const MarketPrice = {
     message.id = object.id ?? 0;
     message.exponent = object.exponent ?? 0;
     message.price = object.price !== undefined && object.price !== null ? Long.fromValue(object.price) : Long.UZERO;
+    message.negative = object.negative ?? false;
+    message.confidence = object.confidence !== undefined && object.confidence !== null ? Long.fromValue(object.confidence) : Long.UZERO;
     return message;
   }
 
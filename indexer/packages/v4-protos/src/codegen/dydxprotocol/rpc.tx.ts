@@ -21,6 +21,7 @@ export const createRPCMsgClient = async ({
     rewards: new (await import("./rewards/tx.rpc.msg")).MsgClientImpl(rpc),
     sending: new (await import("./sending/tx.rpc.msg")).MsgClientImpl(rpc),
     stats: new (await import("./stats/tx.rpc.msg")).MsgClientImpl(rpc),
+    subaccounts: new (await import("./subaccounts/tx.rpc.msg")).MsgClientImpl(rpc),
     vault: new (await import("./vault/tx.rpc.msg")).MsgClientImpl(rpc),
     vest: new (await import("./vest/tx.rpc.msg")).MsgClientImpl(rpc)
   }
@@ -1,20 +1,53 @@
-import { Subaccount, SubaccountSDKType } from "./subaccount";
+import { Subaccount, SubaccountSDKType, SubaccountId, SubaccountIdSDKType } from "./subaccount";
+import { Params, ParamsSDKType } from "./params";
 import * as _m0 from "protobufjs/minimal";
 import { DeepPartial } from "../../helpers";
 /** GenesisState defines the subaccounts module's genesis state. */
 
 export interface GenesisState {
   subaccounts: Subaccount[];
+  /** The parameters of the module. */
+
+  params?: Params;
+  /** The self-imposed leverage caps of subaccounts. */
+
+  maxLeverages: SubaccountMaxLeverage[];
 }
 /** GenesisState defines the subaccounts module's genesis state. */
 
 export interface GenesisStateSDKType {
   subaccounts: SubaccountSDKType[];
+  /** The parameters of the module. */
+
+  params?: ParamsSDKType;
+  /** The self-imposed leverage caps of subaccounts. */
+
+  max_leverages: SubaccountMaxLeverageSDKType[];
+}
+/** SubaccountMaxLeverage is the self-imposed leverage cap of a subaccount. */
+
+export interface SubaccountMaxLeverage {
+  /** The subaccount the leverage cap applies to. */
+  subaccountId?: SubaccountId;
+  /** The leverage cap, in parts-per-million. */
+
+  maxLeveragePpm: number;
+}
+/** SubaccountMaxLeverage is the self-imposed leverage cap of a subaccount. */
+
+export interface SubaccountMaxLeverageSDKType {
+  /** The subaccount the leverage cap applies to. */
+  subaccount_id?: SubaccountIdSDKType;
+  /** The leverage cap, in parts-per-million. */
+
+  max_leverage_ppm: number;
 }
 
 function createBaseGenesisState(): GenesisState {
   return {
-    subaccounts: []
+    subaccounts: [],
+    params: undefined,
+    maxLeverages: []
   };
 }
 
@@ -24,6 +57,14 @@ export const GenesisState = {
       Subaccount.encode(v!, writer.uint32(10).fork()).ldelim();
     }
 
+    if (message.params !== undefined) {
+      Params.encode(message.params, writer.uint32(18).fork()).ldelim();
+    }
+
+    for (const v of message.maxLeverages) {
+      SubaccountMaxLeverage.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+
     return writer;
   },
 
@@ -40,6 +81,14 @@ export const GenesisState = {
           message.subaccounts.push(Subaccount.decode(reader, reader.uint32()));
           break;
 
+        case 2:
+          message.params = Params.decode(reader, reader.uint32());
+          break;
+
+        case 3:
+          message.maxLeverages.push(SubaccountMaxLeverage.decode(reader, reader.uint32()));
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -52,6 +101,63 @@ export const GenesisState = {
   fromPartial(object: DeepPartial<GenesisState>): GenesisState {
     const message = createBaseGenesisState();
     message.subaccounts = object.subaccounts?.map(e => Subaccount.fromPartial(e)) || [];
+    message.params = object.params !== undefined && object.params !== null ? Params.fromPartial(object.params) : undefined;
+    message.maxLeverages = object.maxLeverages?.map(e => SubaccountMaxLeverage.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
+function createBaseSubaccountMaxLeverage(): SubaccountMaxLeverage {
+  return {
+    subaccountId: undefined,
+    maxLeveragePpm: 0
+  };
+}
+
+export const SubaccountMaxLeverage = {
+  encode(message: SubaccountMaxLeverage, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.subaccountId !== undefined) {
+      SubaccountId.encode(message.subaccountId, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.maxLeveragePpm !== 0) {
+      writer.uint32(16).uint32(message.maxLeveragePpm);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SubaccountMaxLeverage {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSubaccountMaxLeverage();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.subaccountId = SubaccountId.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.maxLeveragePpm = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<SubaccountMaxLeverage>): SubaccountMaxLeverage {
+    const message = createBaseSubaccountMaxLeverage();
+    message.subaccountId = object.subaccountId !== undefined && object.subaccountId !== null ? SubaccountId.fromPartial(object.subaccountId) : undefined;
+    message.maxLeveragePpm = object.maxLeveragePpm ?? 0;
     return message;
   }
 
@@ -0,0 +1,209 @@
+import * as _m0 from "protobufjs/minimal";
+import { Long, DeepPartial } from "../../helpers";
+/** Params defines the parameters for x/subaccounts module. */
+
+export interface Params {
+  /**
+   * If true, only updates that reduce the risk of a subaccount are allowed
+   * and all withdrawals and transfers are blocked.
+   */
+  tradingHalted: boolean;
+  /**
+   * The number of blocks a subaccount must remain below its maintenance
+   * margin requirement before it can be liquidated. Zero disables the grace
+   * period.
+   */
+
+  liquidationGracePeriodBlocks: number;
+  /**
+   * The buffer, in parts-per-million of the initial margin requirement, that
+   * the net collateral of a subaccount must meet after an update that opens or
+   * increases a perpetual position. Zero disables the buffer.
+   */
+
+  initialMarginBufferPpm: number;
+  /**
+   * The maximum number of perpetual positions a subaccount may hold. Zero
+   * disables the limit.
+   */
+
+  maxPerpetualPositions: number;
+  /**
+   * If true, collateral held outside of the asset positions of subaccounts,
+   * such as staked tokens, counts towards their net collateral.
+   */
+
+  externalCollateralEnabled: boolean;
+  /**
+   * The maximum number of perpetual positions whose risk is computed by a
+   * sweep over all subaccounts in a single block. Sweeps that exceed the budget
+   * resume from where they stopped in the next block. Zero resets the budget
+   * to the default of 10,000.
+   */
+
+  riskSweepBudget: Long;
+  /**
+   * The threshold, in quote quantums, below which the absolute net collateral
+   * of a subaccount with no perpetual positions is treated as dust. Dust
+   * subaccounts are closed at the end of each block. Zero treats no subaccount
+   * as dust.
+   */
+
+  dustNcThreshold: Long;
+}
+/** Params defines the parameters for x/subaccounts module. */
+
+export interface ParamsSDKType {
+  /**
+   * If true, only updates that reduce the risk of a subaccount are allowed
+   * and all withdrawals and transfers are blocked.
+   */
+  trading_halted: boolean;
+  /**
+   * The number of blocks a subaccount must remain below its maintenance
+   * margin requirement before it can be liquidated. Zero disables the grace
+   * period.
+   */
+
+  liquidation_grace_period_blocks: number;
+  /**
+   * The buffer, in parts-per-million of the initial margin requirement, that
+   * the net collateral of a subaccount must meet after an update that opens or
+   * increases a perpetual position. Zero disables the buffer.
+   */
+
+  initial_margin_buffer_ppm: number;
+  /**
+   * The maximum number of perpetual positions a subaccount may hold. Zero
+   * disables the limit.
+   */
+
+  max_perpetual_positions: number;
+  /**
+   * If true, collateral held outside of the asset positions of subaccounts,
+   * such as staked tokens, counts towards their net collateral.
+   */
+
+  external_collateral_enabled: boolean;
+  /**
+   * The maximum number of perpetual positions whose risk is computed by a
+   * sweep over all subaccounts in a single block. Sweeps that exceed the budget
+   * resume from where they stopped in the next block. Zero resets the budget
+   * to the default of 10,000.
+   */
+
+  risk_sweep_budget: Long;
+  /**
+   * The threshold, in quote quantums, below which the absolute net collateral
+   * of a subaccount with no perpetual positions is treated as dust. Dust
+   * subaccounts are closed at the end of each block. Zero treats no subaccount
+   * as dust.
+   */
+
+  dust_nc_threshold: Long;
+}
+
+function createBaseParams(): Params {
+  return {
+    tradingHalted: false,
+    liquidationGracePeriodBlocks: 0,
+    initialMarginBufferPpm: 0,
+    maxPerpetualPositions: 0,
+    externalCollateralEnabled: false,
+    riskSweepBudget: Long.UZERO,
+    dustNcThreshold: Long.UZERO
+  };
+}
+
+export const Params = {
+  encode(message: Params, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.tradingHalted === true) {
+      writer.uint32(8).bool(message.tradingHalted);
+    }
+
+    if (message.liquidationGracePeriodBlocks !== 0) {
+      writer.uint32(16).uint32(message.liquidationGracePeriodBlocks);
+    }
+
+    if (message.initialMarginBufferPpm !== 0) {
+      writer.uint32(24).uint32(message.initialMarginBufferPpm);
+    }
+
+    if (message.maxPerpetualPositions !== 0) {
+      writer.uint32(32).uint32(message.maxPerpetualPositions);
+    }
+
+    if (message.externalCollateralEnabled === true) {
+      writer.uint32(40).bool(message.externalCollateralEnabled);
+    }
+
+    if (!message.riskSweepBudget.isZero()) {
+      writer.uint32(48).uint64(message.riskSweepBudget);
+    }
+
+    if (!message.dustNcThreshold.isZero()) {
+      writer.uint32(56).uint64(message.dustNcThreshold);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Params {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseParams();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.tradingHalted = reader.bool();
+          break;
+
+        case 2:
+          message.liquidationGracePeriodBlocks = reader.uint32();
+          break;
+
+        case 3:
+          message.initialMarginBufferPpm = reader.uint32();
+          break;
+
+        case 4:
+          message.maxPerpetualPositions = reader.uint32();
+          break;
+
+        case 5:
+          message.externalCollateralEnabled = reader.bool();
+          break;
+
+        case 6:
+          message.riskSweepBudget = (reader.uint64() as Long);
+          break;
+
+        case 7:
+          message.dustNcThreshold = (reader.uint64() as Long);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<Params>): Params {
+    const message = createBaseParams();
+    message.tradingHalted = object.tradingHalted ?? false;
+    message.liquidationGracePeriodBlocks = object.liquidationGracePeriodBlocks ?? 0;
+    message.initialMarginBufferPpm = object.initialMarginBufferPpm ?? 0;
+    message.maxPerpetualPositions = object.maxPerpetualPositions ?? 0;
+    message.externalCollateralEnabled = object.externalCollateralEnabled ?? false;
+    message.riskSweepBudget = object.riskSweepBudget !== undefined && object.riskSweepBudget !== null ? Long.fromValue(object.riskSweepBudget) : Long.UZERO;
+    message.dustNcThreshold = object.dustNcThreshold !== undefined && object.dustNcThreshold !== null ? Long.fromValue(object.dustNcThreshold) : Long.UZERO;
+    return message;
+  }
+
+};
\ No newline at end of file
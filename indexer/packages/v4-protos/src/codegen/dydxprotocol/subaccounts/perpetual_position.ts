@@ -20,6 +20,14 @@ export interface PerpetualPosition {
   /** The quote_balance of the `Perpetual`. */
 
   quoteBalance: Uint8Array;
+  /**
+   * The signed notional paid to open the position in quote quantums, updated
+   * using a weighted average on each fill. Positive for longs and negative for
+   * shorts. The average entry price of the position is `cost_basis / quantums`.
+   * Zero if the cost basis of the position is unknown.
+   */
+
+  costBasis: Uint8Array;
 }
 /**
  * PerpetualPositions are an account’s positions of a `Perpetual`.
@@ -41,6 +49,14 @@ export interface PerpetualPositionSDKType {
   /** The quote_balance of the `Perpetual`. */
 
   quote_balance: Uint8Array;
+  /**
+   * The signed notional paid to open the position in quote quantums, updated
+   * using a weighted average on each fill. Positive for longs and negative for
+   * shorts. The average entry price of the position is `cost_basis / quantums`.
+   * Zero if the cost basis of the position is unknown.
+   */
+
+  cost_basis: Uint8Array;
 }
 
 function createBasePerpetualPosition(): PerpetualPosition {
@@ -48,7 +64,8 @@ function createBasePerpetualPosition(): PerpetualPosition {
     perpetualId: 0,
     quantums: new Uint8Array(),
     fundingIndex: new Uint8Array(),
-    quoteBalance: new Uint8Array()
+    quoteBalance: new Uint8Array(),
+    costBasis: new Uint8Array()
   };
 }
 
@@ -70,6 +87,10 @@ export const PerpetualPosition = {
       writer.uint32(34).bytes(message.quoteBalance);
     }
 
+    if (message.costBasis.length !== 0) {
+      writer.uint32(42).bytes(message.costBasis);
+    }
+
     return writer;
   },
 
@@ -98,6 +119,10 @@ export const PerpetualPosition = {
           message.quoteBalance = reader.bytes();
           break;
 
+        case 5:
+          message.costBasis = reader.bytes();
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -113,6 +138,7 @@ export const PerpetualPosition = {
     message.quantums = object.quantums ?? new Uint8Array();
     message.fundingIndex = object.fundingIndex ?? new Uint8Array();
     message.quoteBalance = object.quoteBalance ?? new Uint8Array();
+    message.costBasis = object.costBasis ?? new Uint8Array();
     return message;
   }
 
@@ -1,6 +1,6 @@
 import { setPaginationParams } from "../../helpers";
 import { LCDClient } from "@osmonauts/lcd";
-import { QueryGetSubaccountRequest, QuerySubaccountResponseSDKType, QueryAllSubaccountRequest, QuerySubaccountAllResponseSDKType, QueryGetWithdrawalAndTransfersBlockedInfoRequest, QueryGetWithdrawalAndTransfersBlockedInfoResponseSDKType, QueryCollateralPoolAddressRequest, QueryCollateralPoolAddressResponseSDKType } from "./query";
+import { QueryGetSubaccountRequest, QuerySubaccountResponseSDKType, QueryAllSubaccountRequest, QuerySubaccountAllResponseSDKType, QueryGetWithdrawalAndTransfersBlockedInfoRequest, QueryGetWithdrawalAndTransfersBlockedInfoResponseSDKType, QueryCollateralPoolAddressRequest, QueryCollateralPoolAddressResponseSDKType, QueryRiskInputsRequest, QueryRiskInputsResponseSDKType, QuerySubaccountUtilizationRequest, QuerySubaccountUtilizationResponseSDKType, QueryTotalValueLockedRequest, QueryTotalValueLockedResponseSDKType, QuerySubaccountLiquidationPricesRequest, QuerySubaccountLiquidationPricesResponseSDKType, QueryTotalMaintenanceMarginRequest, QueryTotalMaintenanceMarginResponseSDKType, QueryPositionNotionalRequest, QueryPositionNotionalResponseSDKType, QueryMarketExponentMigrationImpactRequest, QueryMarketExponentMigrationImpactResponseSDKType, QueryRiskAtHeightRequest, QueryRiskAtHeightResponseSDKType, QueryHealthDistributionRequest, QueryHealthDistributionResponseSDKType, QueryMarketNcSensitivityRequest, QueryMarketNcSensitivityResponseSDKType, QueryWithdrawableCheckRequest, QueryWithdrawableCheckResponseSDKType, QueryRealizedPnlOnCloseRequest, QueryRealizedPnlOnCloseResponseSDKType, QueryProtocolNetDeltaRequest, QueryProtocolNetDeltaResponseSDKType, QueryFundingHistoryRequest, QueryFundingHistoryResponseSDKType, QueryActionToRestoreInitialMarginRequest, QueryActionToRestoreInitialMarginResponseSDKType, QueryLossBufferToLiquidationRequest, QueryLossBufferToLiquidationResponseSDKType, QueryMarketUnrealizedPnlRequest, QueryMarketUnrealizedPnlResponseSDKType, QueryAutoWithdrawableAccountsRequest, QueryAutoWithdrawableAccountsResponseSDKType, QueryCloseAllCostRequest, QueryCloseAllCostResponseSDKType, QueryLiquidatableAccountsRequest, QueryLiquidatableAccountsResponseSDKType, QueryBreakEvenPriceRequest, QueryBreakEvenPriceResponseSDKType, QueryFlatteningSizeRequest, QueryFlatteningSizeResponseSDKType, QueryMinLiquidatingPriceMoveRequest, QueryMinLiquidatingPriceMoveResponseSDKType } from "./query";
 export class LCDQueryClient {
   req: LCDClient;
 
@@ -14,6 +14,29 @@ export class LCDQueryClient {
     this.subaccountAll = this.subaccountAll.bind(this);
     this.getWithdrawalAndTransfersBlockedInfo = this.getWithdrawalAndTransfersBlockedInfo.bind(this);
     this.collateralPoolAddress = this.collateralPoolAddress.bind(this);
+    this.riskInputs = this.riskInputs.bind(this);
+    this.subaccountUtilization = this.subaccountUtilization.bind(this);
+    this.totalValueLocked = this.totalValueLocked.bind(this);
+    this.subaccountLiquidationPrices = this.subaccountLiquidationPrices.bind(this);
+    this.totalMaintenanceMargin = this.totalMaintenanceMargin.bind(this);
+    this.positionNotional = this.positionNotional.bind(this);
+    this.marketExponentMigrationImpact = this.marketExponentMigrationImpact.bind(this);
+    this.riskAtHeight = this.riskAtHeight.bind(this);
+    this.healthDistribution = this.healthDistribution.bind(this);
+    this.marketNcSensitivity = this.marketNcSensitivity.bind(this);
+    this.withdrawableCheck = this.withdrawableCheck.bind(this);
+    this.realizedPnlOnClose = this.realizedPnlOnClose.bind(this);
+    this.protocolNetDelta = this.protocolNetDelta.bind(this);
+    this.fundingHistory = this.fundingHistory.bind(this);
+    this.actionToRestoreInitialMargin = this.actionToRestoreInitialMargin.bind(this);
+    this.lossBufferToLiquidation = this.lossBufferToLiquidation.bind(this);
+    this.marketUnrealizedPnl = this.marketUnrealizedPnl.bind(this);
+    this.autoWithdrawableAccounts = this.autoWithdrawableAccounts.bind(this);
+    this.closeAllCost = this.closeAllCost.bind(this);
+    this.liquidatableAccounts = this.liquidatableAccounts.bind(this);
+    this.breakEvenPrice = this.breakEvenPrice.bind(this);
+    this.flatteningSize = this.flatteningSize.bind(this);
+    this.minLiquidatingPriceMove = this.minLiquidatingPriceMove.bind(this);
   }
   /* Queries a Subaccount by id */
 
@@ -54,5 +77,200 @@ export class LCDQueryClient {
     const endpoint = `dydxprotocol/subaccounts/collateral_pool_address/${params.perpetualId}`;
     return await this.req.get<QueryCollateralPoolAddressResponseSDKType>(endpoint);
   }
+  /* Queries the inputs used to compute the current risk of a subaccount, so
+   that off-chain tools can reproduce it exactly. */
+
+  async riskInputs(params: QueryRiskInputsRequest): Promise<QueryRiskInputsResponseSDKType> {
+    const endpoint = `dydxprotocol/subaccounts/risk_inputs/${params.owner}/${params.number}`;
+    return await this.req.get<QueryRiskInputsResponseSDKType>(endpoint);
+  }
+  /* Queries the ratio of the initial margin requirement of a subaccount to its
+   net collateral. */
+
+  async subaccountUtilization(params: QuerySubaccountUtilizationRequest): Promise<QuerySubaccountUtilizationResponseSDKType> {
+    const endpoint = `dydxprotocol/subaccounts/utilization/${params.owner}/${params.number}`;
+    return await this.req.get<QuerySubaccountUtilizationResponseSDKType>(endpoint);
+  }
+  /* Queries the total value locked across all subaccounts. */
+
+  async totalValueLocked(_params: QueryTotalValueLockedRequest = {}): Promise<QueryTotalValueLockedResponseSDKType> {
+    const endpoint = `dydxprotocol/subaccounts/total_value_locked`;
+    return await this.req.get<QueryTotalValueLockedResponseSDKType>(endpoint);
+  }
+  /* Queries the liquidation and bankruptcy prices of every open perpetual
+   position of a subaccount. */
+
+  async subaccountLiquidationPrices(params: QuerySubaccountLiquidationPricesRequest): Promise<QuerySubaccountLiquidationPricesResponseSDKType> {
+    const endpoint = `dydxprotocol/subaccounts/liquidation_prices/${params.owner}/${params.number}`;
+    return await this.req.get<QuerySubaccountLiquidationPricesResponseSDKType>(endpoint);
+  }
+  /* Queries the sum of the maintenance margin requirements of all subaccounts. */
+
+  async totalMaintenanceMargin(params: QueryTotalMaintenanceMarginRequest = {
+    byPerpetual: false
+  }): Promise<QueryTotalMaintenanceMarginResponseSDKType> {
+    const options: any = {
+      params: {}
+    };
+
+    if (typeof params?.byPerpetual !== "undefined") {
+      options.params.by_perpetual = params.byPerpetual;
+    }
+
+    const endpoint = `dydxprotocol/subaccounts/total_maintenance_margin`;
+    return await this.req.get<QueryTotalMaintenanceMarginResponseSDKType>(endpoint, options);
+  }
+  /* Queries the notional of the position of a subaccount in a perpetual. */
+
+  async positionNotional(params: QueryPositionNotionalRequest): Promise<QueryPositionNotionalResponseSDKType> {
+    const endpoint = `dydxprotocol/subaccounts/position_notional/${params.owner}/${params.number}/${params.perpetualId}`;
+    return await this.req.get<QueryPositionNotionalResponseSDKType>(endpoint);
+  }
+  /* Queries the impact of migrating the price exponent of a market on the
+   positions in its perpetuals. */
+
+  async marketExponentMigrationImpact(params: QueryMarketExponentMigrationImpactRequest): Promise<QueryMarketExponentMigrationImpactResponseSDKType> {
+    const endpoint = `dydxprotocol/subaccounts/exponent_migration_impact/${params.marketId}/${params.proposedExponent}`;
+    return await this.req.get<QueryMarketExponentMigrationImpactResponseSDKType>(endpoint);
+  }
+  /* Queries the risk of a subaccount as of a past block height. */
+
+  async riskAtHeight(params: QueryRiskAtHeightRequest): Promise<QueryRiskAtHeightResponseSDKType> {
+    const endpoint = `dydxprotocol/subaccounts/risk_at_height/${params.owner}/${params.number}/${params.height}`;
+    return await this.req.get<QueryRiskAtHeightResponseSDKType>(endpoint);
+  }
+  /* Queries the number of subaccounts in each health bucket. */
+
+  async healthDistribution(params: QueryHealthDistributionRequest = {
+    buckets: []
+  }): Promise<QueryHealthDistributionResponseSDKType> {
+    const options: any = {
+      params: {}
+    };
+
+    if (typeof params?.buckets !== "undefined") {
+      options.params.buckets = params.buckets;
+    }
+
+    const endpoint = `dydxprotocol/subaccounts/health_distribution`;
+    return await this.req.get<QueryHealthDistributionResponseSDKType>(endpoint, options);
+  }
+  /* Queries the sensitivity of the total net collateral of all subaccounts to
+   the market price of a perpetual. */
+
+  async marketNcSensitivity(params: QueryMarketNcSensitivityRequest): Promise<QueryMarketNcSensitivityResponseSDKType> {
+    const endpoint = `dydxprotocol/subaccounts/market_nc_sensitivity/${params.perpetualId}`;
+    return await this.req.get<QueryMarketNcSensitivityResponseSDKType>(endpoint);
+  }
+  /* Queries whether a withdrawal from a subaccount would be allowed, and the
+   maximum amount that can be withdrawn. */
+
+  async withdrawableCheck(params: QueryWithdrawableCheckRequest): Promise<QueryWithdrawableCheckResponseSDKType> {
+    const endpoint = `dydxprotocol/subaccounts/withdrawable_check/${params.owner}/${params.number}/${params.quoteQuantums}`;
+    return await this.req.get<QueryWithdrawableCheckResponseSDKType>(endpoint);
+  }
+  /* Queries the PnL a subaccount would realize by closing its position in a
+   perpetual. */
+
+  async realizedPnlOnClose(params: QueryRealizedPnlOnCloseRequest): Promise<QueryRealizedPnlOnCloseResponseSDKType> {
+    const endpoint = `dydxprotocol/subaccounts/realized_pnl_on_close/${params.owner}/${params.number}/${params.perpetualId}`;
+    return await this.req.get<QueryRealizedPnlOnCloseResponseSDKType>(endpoint);
+  }
+  /* Queries the net position of all subaccounts in a perpetual. */
+
+  async protocolNetDelta(params: QueryProtocolNetDeltaRequest): Promise<QueryProtocolNetDeltaResponseSDKType> {
+    const endpoint = `dydxprotocol/subaccounts/protocol_net_delta/${params.perpetualId}`;
+    return await this.req.get<QueryProtocolNetDeltaResponseSDKType>(endpoint);
+  }
+  /* Queries the funding settled for the position of a subaccount in a perpetual
+   during a range of funding-tick epochs. */
+
+  async fundingHistory(params: QueryFundingHistoryRequest): Promise<QueryFundingHistoryResponseSDKType> {
+    const options: any = {
+      params: {}
+    };
+
+    if (typeof params?.fromEpoch !== "undefined") {
+      options.params.from_epoch = params.fromEpoch;
+    }
+
+    if (typeof params?.toEpoch !== "undefined") {
+      options.params.to_epoch = params.toEpoch;
+    }
+
+    const endpoint = `dydxprotocol/subaccounts/funding_history/${params.owner}/${params.number}/${params.perpetualId}`;
+    return await this.req.get<QueryFundingHistoryResponseSDKType>(endpoint, options);
+  }
+  /* Queries the closes of the positions of a subaccount that each make it
+   initially collateralized. */
+
+  async actionToRestoreInitialMargin(params: QueryActionToRestoreInitialMarginRequest): Promise<QueryActionToRestoreInitialMarginResponseSDKType> {
+    const endpoint = `dydxprotocol/subaccounts/action_to_restore_initial_margin/${params.owner}/${params.number}`;
+    return await this.req.get<QueryActionToRestoreInitialMarginResponseSDKType>(endpoint);
+  }
+  /* Queries how much a subaccount can lose on its position in a perpetual before
+   it becomes liquidatable. */
+
+  async lossBufferToLiquidation(params: QueryLossBufferToLiquidationRequest): Promise<QueryLossBufferToLiquidationResponseSDKType> {
+    const endpoint = `dydxprotocol/subaccounts/loss_buffer_to_liquidation/${params.owner}/${params.number}/${params.perpetualId}`;
+    return await this.req.get<QueryLossBufferToLiquidationResponseSDKType>(endpoint);
+  }
+  /* Queries the unrealized PnL of the positions of all subaccounts in a
+   perpetual. */
+
+  async marketUnrealizedPnl(params: QueryMarketUnrealizedPnlRequest): Promise<QueryMarketUnrealizedPnlResponseSDKType> {
+    const endpoint = `dydxprotocol/subaccounts/market_unrealized_pnl/${params.perpetualId}`;
+    return await this.req.get<QueryMarketUnrealizedPnlResponseSDKType>(endpoint);
+  }
+  /* Queries the subaccounts whose free collateral exceeds a threshold. */
+
+  async autoWithdrawableAccounts(params: QueryAutoWithdrawableAccountsRequest = {
+    thresholdQuoteQuantums: undefined
+  }): Promise<QueryAutoWithdrawableAccountsResponseSDKType> {
+    const options: any = {
+      params: {}
+    };
+
+    if (typeof params?.thresholdQuoteQuantums !== "undefined") {
+      options.params.threshold_quote_quantums = params.thresholdQuoteQuantums;
+    }
+
+    const endpoint = `dydxprotocol/subaccounts/auto_withdrawable_accounts`;
+    return await this.req.get<QueryAutoWithdrawableAccountsResponseSDKType>(endpoint, options);
+  }
+  /* Queries the taker fees a subaccount would pay to close all of its positions. */
+
+  async closeAllCost(params: QueryCloseAllCostRequest): Promise<QueryCloseAllCostResponseSDKType> {
+    const endpoint = `dydxprotocol/subaccounts/close_all_cost/${params.owner}/${params.number}`;
+    return await this.req.get<QueryCloseAllCostResponseSDKType>(endpoint);
+  }
+  /* Queries the liquidatable subaccounts in the order in which they should be
+   liquidated. */
+
+  async liquidatableAccounts(_params: QueryLiquidatableAccountsRequest = {}): Promise<QueryLiquidatableAccountsResponseSDKType> {
+    const endpoint = `dydxprotocol/subaccounts/liquidatable_accounts`;
+    return await this.req.get<QueryLiquidatableAccountsResponseSDKType>(endpoint);
+  }
+  /* Queries the price at which a subaccount would realize zero PnL by closing
+   its position in a perpetual. */
+
+  async breakEvenPrice(params: QueryBreakEvenPriceRequest): Promise<QueryBreakEvenPriceResponseSDKType> {
+    const endpoint = `dydxprotocol/subaccounts/break_even_price/${params.owner}/${params.number}/${params.perpetualId}`;
+    return await this.req.get<QueryBreakEvenPriceResponseSDKType>(endpoint);
+  }
+  /* Queries the size of the trade that would make the net position of all
+   subaccounts of an owner in a perpetual zero. */
+
+  async flatteningSize(params: QueryFlatteningSizeRequest): Promise<QueryFlatteningSizeResponseSDKType> {
+    const endpoint = `dydxprotocol/subaccounts/flattening_size/${params.owner}/${params.perpetualId}`;
+    return await this.req.get<QueryFlatteningSizeResponseSDKType>(endpoint);
+  }
+  /* Queries the smallest adverse move in the oracle price of a perpetual that
+   makes a subaccount liquidatable. */
+
+  async minLiquidatingPriceMove(params: QueryMinLiquidatingPriceMoveRequest): Promise<QueryMinLiquidatingPriceMoveResponseSDKType> {
+    const endpoint = `dydxprotocol/subaccounts/min_liquidating_price_move/${params.perpetualId}`;
+    return await this.req.get<QueryMinLiquidatingPriceMoveResponseSDKType>(endpoint);
+  }
 
 }
\ No newline at end of file
@@ -1,7 +1,7 @@
 import { Rpc } from "../../helpers";
 import * as _m0 from "protobufjs/minimal";
 import { QueryClient, createProtobufRpcClient } from "@cosmjs/stargate";
-import { QueryGetSubaccountRequest, QuerySubaccountResponse, QueryAllSubaccountRequest, QuerySubaccountAllResponse, QueryGetWithdrawalAndTransfersBlockedInfoRequest, QueryGetWithdrawalAndTransfersBlockedInfoResponse, QueryCollateralPoolAddressRequest, QueryCollateralPoolAddressResponse } from "./query";
+import { QueryGetSubaccountRequest, QuerySubaccountResponse, QueryAllSubaccountRequest, QuerySubaccountAllResponse, QueryGetWithdrawalAndTransfersBlockedInfoRequest, QueryGetWithdrawalAndTransfersBlockedInfoResponse, QueryCollateralPoolAddressRequest, QueryCollateralPoolAddressResponse, QueryComputeRiskForHypotheticalRequest, QueryComputeRiskForHypotheticalResponse, QueryRiskInputsRequest, QueryRiskInputsResponse, QuerySubaccountUtilizationRequest, QuerySubaccountUtilizationResponse, QueryTotalValueLockedRequest, QueryTotalValueLockedResponse, QuerySubaccountLiquidationPricesRequest, QuerySubaccountLiquidationPricesResponse, QueryBasketShockToLiquidateRequest, QueryBasketShockToLiquidateResponse, QueryTotalMaintenanceMarginRequest, QueryTotalMaintenanceMarginResponse, QueryPositionNotionalRequest, QueryPositionNotionalResponse, QueryMarketExponentMigrationImpactRequest, QueryMarketExponentMigrationImpactResponse, QueryRiskAtHeightRequest, QueryRiskAtHeightResponse, QueryHealthDistributionRequest, QueryHealthDistributionResponse, QueryMarketNcSensitivityRequest, QueryMarketNcSensitivityResponse, QueryWithdrawableCheckRequest, QueryWithdrawableCheckResponse, QueryRealizedPnlOnCloseRequest, QueryRealizedPnlOnCloseResponse, QueryProtocolNetDeltaRequest, QueryProtocolNetDeltaResponse, QueryFundingHistoryRequest, QueryFundingHistoryResponse, QueryActionToRestoreInitialMarginRequest, QueryActionToRestoreInitialMarginResponse, QueryLossBufferToLiquidationRequest, QueryLossBufferToLiquidationResponse, QueryMarketUnrealizedPnlRequest, QueryMarketUnrealizedPnlResponse, QueryAutoWithdrawableAccountsRequest, QueryAutoWithdrawableAccountsResponse, QueryCloseAllCostRequest, QueryCloseAllCostResponse, QueryLiquidatableAccountsRequest, QueryLiquidatableAccountsResponse, QueryBreakEvenPriceRequest, QueryBreakEvenPriceResponse, QueryFlatteningSizeRequest, QueryFlatteningSizeResponse, QueryLiquidationProceedsEstimateRequest, QueryLiquidationProceedsEstimateResponse, QueryMarginDeltaForTierChangeRequest, QueryMarginDeltaForTierChangeResponse, QueryRiskUnderHistoricalShocksRequest, QueryRiskUnderHistoricalShocksResponse, QueryMinLiquidatingPriceMoveRequest, QueryMinLiquidatingPriceMoveResponse } from "./query";
 /** Query defines the gRPC querier service. */
 
 export interface Query {
@@ -19,6 +19,150 @@ export interface Query {
   /** Queries the collateral pool account address for a perpetual id. */
 
   collateralPoolAddress(request: QueryCollateralPoolAddressRequest): Promise<QueryCollateralPoolAddressResponse>;
+  /**
+   * Computes the risk of a hypothetical subaccount that is not stored, after
+   * applying a list of updates, at the current oracle prices.
+   */
+
+  computeRiskForHypothetical(request: QueryComputeRiskForHypotheticalRequest): Promise<QueryComputeRiskForHypotheticalResponse>;
+  /**
+   * Queries the inputs used to compute the current risk of a subaccount, so
+   * that off-chain tools can reproduce it exactly.
+   */
+
+  riskInputs(request: QueryRiskInputsRequest): Promise<QueryRiskInputsResponse>;
+  /**
+   * Queries the ratio of the initial margin requirement of a subaccount to its
+   * net collateral.
+   */
+
+  subaccountUtilization(request: QuerySubaccountUtilizationRequest): Promise<QuerySubaccountUtilizationResponse>;
+  /** Queries the total value locked across all subaccounts. */
+
+  totalValueLocked(request?: QueryTotalValueLockedRequest): Promise<QueryTotalValueLockedResponse>;
+  /**
+   * Queries the liquidation and bankruptcy prices of every open perpetual
+   * position of a subaccount.
+   */
+
+  subaccountLiquidationPrices(request: QuerySubaccountLiquidationPricesRequest): Promise<QuerySubaccountLiquidationPricesResponse>;
+  /**
+   * Computes the smallest shock to the prices of a correlated basket of
+   * perpetuals that makes a subaccount liquidatable.
+   */
+
+  basketShockToLiquidate(request: QueryBasketShockToLiquidateRequest): Promise<QueryBasketShockToLiquidateResponse>;
+  /** Queries the sum of the maintenance margin requirements of all subaccounts. */
+
+  totalMaintenanceMargin(request: QueryTotalMaintenanceMarginRequest): Promise<QueryTotalMaintenanceMarginResponse>;
+  /** Queries the notional of the position of a subaccount in a perpetual. */
+
+  positionNotional(request: QueryPositionNotionalRequest): Promise<QueryPositionNotionalResponse>;
+  /**
+   * Queries the impact of migrating the price exponent of a market on the
+   * positions in its perpetuals.
+   */
+
+  marketExponentMigrationImpact(request: QueryMarketExponentMigrationImpactRequest): Promise<QueryMarketExponentMigrationImpactResponse>;
+  /** Queries the risk of a subaccount as of a past block height. */
+
+  riskAtHeight(request: QueryRiskAtHeightRequest): Promise<QueryRiskAtHeightResponse>;
+  /** Queries the number of subaccounts in each health bucket. */
+
+  healthDistribution(request: QueryHealthDistributionRequest): Promise<QueryHealthDistributionResponse>;
+  /**
+   * Queries the sensitivity of the total net collateral of all subaccounts to
+   * the market price of a perpetual.
+   */
+
+  marketNcSensitivity(request: QueryMarketNcSensitivityRequest): Promise<QueryMarketNcSensitivityResponse>;
+  /**
+   * Queries whether a withdrawal from a subaccount would be allowed, and the
+   * maximum amount that can be withdrawn.
+   */
+
+  withdrawableCheck(request: QueryWithdrawableCheckRequest): Promise<QueryWithdrawableCheckResponse>;
+  /**
+   * Queries the PnL a subaccount would realize by closing its position in a
+   * perpetual.
+   */
+
+  realizedPnlOnClose(request: QueryRealizedPnlOnCloseRequest): Promise<QueryRealizedPnlOnCloseResponse>;
+  /** Queries the net position of all subaccounts in a perpetual. */
+
+  protocolNetDelta(request: QueryProtocolNetDeltaRequest): Promise<QueryProtocolNetDeltaResponse>;
+  /**
+   * Queries the funding settled for the position of a subaccount in a perpetual
+   * during a range of funding-tick epochs.
+   */
+
+  fundingHistory(request: QueryFundingHistoryRequest): Promise<QueryFundingHistoryResponse>;
+  /**
+   * Queries the closes of the positions of a subaccount that each make it
+   * initially collateralized.
+   */
+
+  actionToRestoreInitialMargin(request: QueryActionToRestoreInitialMarginRequest): Promise<QueryActionToRestoreInitialMarginResponse>;
+  /**
+   * Queries how much a subaccount can lose on its position in a perpetual before
+   * it becomes liquidatable.
+   */
+
+  lossBufferToLiquidation(request: QueryLossBufferToLiquidationRequest): Promise<QueryLossBufferToLiquidationResponse>;
+  /**
+   * Queries the unrealized PnL of the positions of all subaccounts in a
+   * perpetual.
+   */
+
+  marketUnrealizedPnl(request: QueryMarketUnrealizedPnlRequest): Promise<QueryMarketUnrealizedPnlResponse>;
+  /** Queries the subaccounts whose free collateral exceeds a threshold. */
+
+  autoWithdrawableAccounts(request: QueryAutoWithdrawableAccountsRequest): Promise<QueryAutoWithdrawableAccountsResponse>;
+  /** Queries the taker fees a subaccount would pay to close all of its positions. */
+
+  closeAllCost(request: QueryCloseAllCostRequest): Promise<QueryCloseAllCostResponse>;
+  /**
+   * Queries the liquidatable subaccounts in the order in which they should be
+   * liquidated.
+   */
+
+  liquidatableAccounts(request?: QueryLiquidatableAccountsRequest): Promise<QueryLiquidatableAccountsResponse>;
+  /**
+   * Queries the price at which a subaccount would realize zero PnL by closing
+   * its position in a perpetual.
+   */
+
+  breakEvenPrice(request: QueryBreakEvenPriceRequest): Promise<QueryBreakEvenPriceResponse>;
+  /**
+   * Queries the size of the trade that would make the net position of all
+   * subaccounts of an owner in a perpetual zero.
+   */
+
+  flatteningSize(request: QueryFlatteningSizeRequest): Promise<QueryFlatteningSizeResponse>;
+  /**
+   * Estimates the proceeds of liquidating the position of a subaccount in a
+   * perpetual against a depth model of the order book.
+   */
+
+  liquidationProceedsEstimate(request: QueryLiquidationProceedsEstimateRequest): Promise<QueryLiquidationProceedsEstimateResponse>;
+  /**
+   * Queries the change in the margin requirements of a subaccount if a perpetual
+   * were migrated to a proposed liquidity tier.
+   */
+
+  marginDeltaForTierChange(request: QueryMarginDeltaForTierChangeRequest): Promise<QueryMarginDeltaForTierChangeResponse>;
+  /**
+   * Computes the risk of a subaccount with each of its positions shocked by the
+   * worst historical single-day move of its market.
+   */
+
+  riskUnderHistoricalShocks(request: QueryRiskUnderHistoricalShocksRequest): Promise<QueryRiskUnderHistoricalShocksResponse>;
+  /**
+   * Queries the smallest adverse move in the oracle price of a perpetual that
+   * makes a subaccount liquidatable.
+   */
+
+  minLiquidatingPriceMove(request: QueryMinLiquidatingPriceMoveRequest): Promise<QueryMinLiquidatingPriceMoveResponse>;
 }
 export class QueryClientImpl implements Query {
   private readonly rpc: Rpc;
@@ -29,6 +173,34 @@ export class QueryClientImpl implements Query {
     this.subaccountAll = this.subaccountAll.bind(this);
     this.getWithdrawalAndTransfersBlockedInfo = this.getWithdrawalAndTransfersBlockedInfo.bind(this);
     this.collateralPoolAddress = this.collateralPoolAddress.bind(this);
+    this.computeRiskForHypothetical = this.computeRiskForHypothetical.bind(this);
+    this.riskInputs = this.riskInputs.bind(this);
+    this.subaccountUtilization = this.subaccountUtilization.bind(this);
+    this.totalValueLocked = this.totalValueLocked.bind(this);
+    this.subaccountLiquidationPrices = this.subaccountLiquidationPrices.bind(this);
+    this.basketShockToLiquidate = this.basketShockToLiquidate.bind(this);
+    this.totalMaintenanceMargin = this.totalMaintenanceMargin.bind(this);
+    this.positionNotional = this.positionNotional.bind(this);
+    this.marketExponentMigrationImpact = this.marketExponentMigrationImpact.bind(this);
+    this.riskAtHeight = this.riskAtHeight.bind(this);
+    this.healthDistribution = this.healthDistribution.bind(this);
+    this.marketNcSensitivity = this.marketNcSensitivity.bind(this);
+    this.withdrawableCheck = this.withdrawableCheck.bind(this);
+    this.realizedPnlOnClose = this.realizedPnlOnClose.bind(this);
+    this.protocolNetDelta = this.protocolNetDelta.bind(this);
+    this.fundingHistory = this.fundingHistory.bind(this);
+    this.actionToRestoreInitialMargin = this.actionToRestoreInitialMargin.bind(this);
+    this.lossBufferToLiquidation = this.lossBufferToLiquidation.bind(this);
+    this.marketUnrealizedPnl = this.marketUnrealizedPnl.bind(this);
+    this.autoWithdrawableAccounts = this.autoWithdrawableAccounts.bind(this);
+    this.closeAllCost = this.closeAllCost.bind(this);
+    this.liquidatableAccounts = this.liquidatableAccounts.bind(this);
+    this.breakEvenPrice = this.breakEvenPrice.bind(this);
+    this.flatteningSize = this.flatteningSize.bind(this);
+    this.liquidationProceedsEstimate = this.liquidationProceedsEstimate.bind(this);
+    this.marginDeltaForTierChange = this.marginDeltaForTierChange.bind(this);
+    this.riskUnderHistoricalShocks = this.riskUnderHistoricalShocks.bind(this);
+    this.minLiquidatingPriceMove = this.minLiquidatingPriceMove.bind(this);
   }
 
   subaccount(request: QueryGetSubaccountRequest): Promise<QuerySubaccountResponse> {
@@ -57,6 +229,174 @@ export class QueryClientImpl implements Query {
     return promise.then(data => QueryCollateralPoolAddressResponse.decode(new _m0.Reader(data)));
   }
 
+  computeRiskForHypothetical(request: QueryComputeRiskForHypotheticalRequest): Promise<QueryComputeRiskForHypotheticalResponse> {
+    const data = QueryComputeRiskForHypotheticalRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "ComputeRiskForHypothetical", data);
+    return promise.then(data => QueryComputeRiskForHypotheticalResponse.decode(new _m0.Reader(data)));
+  }
+
+  riskInputs(request: QueryRiskInputsRequest): Promise<QueryRiskInputsResponse> {
+    const data = QueryRiskInputsRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "RiskInputs", data);
+    return promise.then(data => QueryRiskInputsResponse.decode(new _m0.Reader(data)));
+  }
+
+  subaccountUtilization(request: QuerySubaccountUtilizationRequest): Promise<QuerySubaccountUtilizationResponse> {
+    const data = QuerySubaccountUtilizationRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "SubaccountUtilization", data);
+    return promise.then(data => QuerySubaccountUtilizationResponse.decode(new _m0.Reader(data)));
+  }
+
+  totalValueLocked(request: QueryTotalValueLockedRequest = {}): Promise<QueryTotalValueLockedResponse> {
+    const data = QueryTotalValueLockedRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "TotalValueLocked", data);
+    return promise.then(data => QueryTotalValueLockedResponse.decode(new _m0.Reader(data)));
+  }
+
+  subaccountLiquidationPrices(request: QuerySubaccountLiquidationPricesRequest): Promise<QuerySubaccountLiquidationPricesResponse> {
+    const data = QuerySubaccountLiquidationPricesRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "SubaccountLiquidationPrices", data);
+    return promise.then(data => QuerySubaccountLiquidationPricesResponse.decode(new _m0.Reader(data)));
+  }
+
+  basketShockToLiquidate(request: QueryBasketShockToLiquidateRequest): Promise<QueryBasketShockToLiquidateResponse> {
+    const data = QueryBasketShockToLiquidateRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "BasketShockToLiquidate", data);
+    return promise.then(data => QueryBasketShockToLiquidateResponse.decode(new _m0.Reader(data)));
+  }
+
+  totalMaintenanceMargin(request: QueryTotalMaintenanceMarginRequest): Promise<QueryTotalMaintenanceMarginResponse> {
+    const data = QueryTotalMaintenanceMarginRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "TotalMaintenanceMargin", data);
+    return promise.then(data => QueryTotalMaintenanceMarginResponse.decode(new _m0.Reader(data)));
+  }
+
+  positionNotional(request: QueryPositionNotionalRequest): Promise<QueryPositionNotionalResponse> {
+    const data = QueryPositionNotionalRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "PositionNotional", data);
+    return promise.then(data => QueryPositionNotionalResponse.decode(new _m0.Reader(data)));
+  }
+
+  marketExponentMigrationImpact(request: QueryMarketExponentMigrationImpactRequest): Promise<QueryMarketExponentMigrationImpactResponse> {
+    const data = QueryMarketExponentMigrationImpactRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "MarketExponentMigrationImpact", data);
+    return promise.then(data => QueryMarketExponentMigrationImpactResponse.decode(new _m0.Reader(data)));
+  }
+
+  riskAtHeight(request: QueryRiskAtHeightRequest): Promise<QueryRiskAtHeightResponse> {
+    const data = QueryRiskAtHeightRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "RiskAtHeight", data);
+    return promise.then(data => QueryRiskAtHeightResponse.decode(new _m0.Reader(data)));
+  }
+
+  healthDistribution(request: QueryHealthDistributionRequest): Promise<QueryHealthDistributionResponse> {
+    const data = QueryHealthDistributionRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "HealthDistribution", data);
+    return promise.then(data => QueryHealthDistributionResponse.decode(new _m0.Reader(data)));
+  }
+
+  marketNcSensitivity(request: QueryMarketNcSensitivityRequest): Promise<QueryMarketNcSensitivityResponse> {
+    const data = QueryMarketNcSensitivityRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "MarketNcSensitivity", data);
+    return promise.then(data => QueryMarketNcSensitivityResponse.decode(new _m0.Reader(data)));
+  }
+
+  withdrawableCheck(request: QueryWithdrawableCheckRequest): Promise<QueryWithdrawableCheckResponse> {
+    const data = QueryWithdrawableCheckRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "WithdrawableCheck", data);
+    return promise.then(data => QueryWithdrawableCheckResponse.decode(new _m0.Reader(data)));
+  }
+
+  realizedPnlOnClose(request: QueryRealizedPnlOnCloseRequest): Promise<QueryRealizedPnlOnCloseResponse> {
+    const data = QueryRealizedPnlOnCloseRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "RealizedPnlOnClose", data);
+    return promise.then(data => QueryRealizedPnlOnCloseResponse.decode(new _m0.Reader(data)));
+  }
+
+  protocolNetDelta(request: QueryProtocolNetDeltaRequest): Promise<QueryProtocolNetDeltaResponse> {
+    const data = QueryProtocolNetDeltaRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "ProtocolNetDelta", data);
+    return promise.then(data => QueryProtocolNetDeltaResponse.decode(new _m0.Reader(data)));
+  }
+
+  fundingHistory(request: QueryFundingHistoryRequest): Promise<QueryFundingHistoryResponse> {
+    const data = QueryFundingHistoryRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "FundingHistory", data);
+    return promise.then(data => QueryFundingHistoryResponse.decode(new _m0.Reader(data)));
+  }
+
+  actionToRestoreInitialMargin(request: QueryActionToRestoreInitialMarginRequest): Promise<QueryActionToRestoreInitialMarginResponse> {
+    const data = QueryActionToRestoreInitialMarginRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "ActionToRestoreInitialMargin", data);
+    return promise.then(data => QueryActionToRestoreInitialMarginResponse.decode(new _m0.Reader(data)));
+  }
+
+  lossBufferToLiquidation(request: QueryLossBufferToLiquidationRequest): Promise<QueryLossBufferToLiquidationResponse> {
+    const data = QueryLossBufferToLiquidationRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "LossBufferToLiquidation", data);
+    return promise.then(data => QueryLossBufferToLiquidationResponse.decode(new _m0.Reader(data)));
+  }
+
+  marketUnrealizedPnl(request: QueryMarketUnrealizedPnlRequest): Promise<QueryMarketUnrealizedPnlResponse> {
+    const data = QueryMarketUnrealizedPnlRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "MarketUnrealizedPnl", data);
+    return promise.then(data => QueryMarketUnrealizedPnlResponse.decode(new _m0.Reader(data)));
+  }
+
+  autoWithdrawableAccounts(request: QueryAutoWithdrawableAccountsRequest): Promise<QueryAutoWithdrawableAccountsResponse> {
+    const data = QueryAutoWithdrawableAccountsRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "AutoWithdrawableAccounts", data);
+    return promise.then(data => QueryAutoWithdrawableAccountsResponse.decode(new _m0.Reader(data)));
+  }
+
+  closeAllCost(request: QueryCloseAllCostRequest): Promise<QueryCloseAllCostResponse> {
+    const data = QueryCloseAllCostRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "CloseAllCost", data);
+    return promise.then(data => QueryCloseAllCostResponse.decode(new _m0.Reader(data)));
+  }
+
+  liquidatableAccounts(request: QueryLiquidatableAccountsRequest = {}): Promise<QueryLiquidatableAccountsResponse> {
+    const data = QueryLiquidatableAccountsRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "LiquidatableAccounts", data);
+    return promise.then(data => QueryLiquidatableAccountsResponse.decode(new _m0.Reader(data)));
+  }
+
+  breakEvenPrice(request: QueryBreakEvenPriceRequest): Promise<QueryBreakEvenPriceResponse> {
+    const data = QueryBreakEvenPriceRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "BreakEvenPrice", data);
+    return promise.then(data => QueryBreakEvenPriceResponse.decode(new _m0.Reader(data)));
+  }
+
+  flatteningSize(request: QueryFlatteningSizeRequest): Promise<QueryFlatteningSizeResponse> {
+    const data = QueryFlatteningSizeRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "FlatteningSize", data);
+    return promise.then(data => QueryFlatteningSizeResponse.decode(new _m0.Reader(data)));
+  }
+
+  liquidationProceedsEstimate(request: QueryLiquidationProceedsEstimateRequest): Promise<QueryLiquidationProceedsEstimateResponse> {
+    const data = QueryLiquidationProceedsEstimateRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "LiquidationProceedsEstimate", data);
+    return promise.then(data => QueryLiquidationProceedsEstimateResponse.decode(new _m0.Reader(data)));
+  }
+
+  marginDeltaForTierChange(request: QueryMarginDeltaForTierChangeRequest): Promise<QueryMarginDeltaForTierChangeResponse> {
+    const data = QueryMarginDeltaForTierChangeRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "MarginDeltaForTierChange", data);
+    return promise.then(data => QueryMarginDeltaForTierChangeResponse.decode(new _m0.Reader(data)));
+  }
+
+  riskUnderHistoricalShocks(request: QueryRiskUnderHistoricalShocksRequest): Promise<QueryRiskUnderHistoricalShocksResponse> {
+    const data = QueryRiskUnderHistoricalShocksRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "RiskUnderHistoricalShocks", data);
+    return promise.then(data => QueryRiskUnderHistoricalShocksResponse.decode(new _m0.Reader(data)));
+  }
+
+  minLiquidatingPriceMove(request: QueryMinLiquidatingPriceMoveRequest): Promise<QueryMinLiquidatingPriceMoveResponse> {
+    const data = QueryMinLiquidatingPriceMoveRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "MinLiquidatingPriceMove", data);
+    return promise.then(data => QueryMinLiquidatingPriceMoveResponse.decode(new _m0.Reader(data)));
+  }
+
 }
 export const createRpcQueryExtension = (base: QueryClient) => {
   const rpc = createProtobufRpcClient(base);
@@ -76,6 +416,118 @@ export const createRpcQueryExtension = (base: QueryClient) => {
 
     collateralPoolAddress(request: QueryCollateralPoolAddressRequest): Promise<QueryCollateralPoolAddressResponse> {
       return queryService.collateralPoolAddress(request);
+    },
+
+    computeRiskForHypothetical(request: QueryComputeRiskForHypotheticalRequest): Promise<QueryComputeRiskForHypotheticalResponse> {
+      return queryService.computeRiskForHypothetical(request);
+    },
+
+    riskInputs(request: QueryRiskInputsRequest): Promise<QueryRiskInputsResponse> {
+      return queryService.riskInputs(request);
+    },
+
+    subaccountUtilization(request: QuerySubaccountUtilizationRequest): Promise<QuerySubaccountUtilizationResponse> {
+      return queryService.subaccountUtilization(request);
+    },
+
+    totalValueLocked(request?: QueryTotalValueLockedRequest): Promise<QueryTotalValueLockedResponse> {
+      return queryService.totalValueLocked(request);
+    },
+
+    subaccountLiquidationPrices(request: QuerySubaccountLiquidationPricesRequest): Promise<QuerySubaccountLiquidationPricesResponse> {
+      return queryService.subaccountLiquidationPrices(request);
+    },
+
+    basketShockToLiquidate(request: QueryBasketShockToLiquidateRequest): Promise<QueryBasketShockToLiquidateResponse> {
+      return queryService.basketShockToLiquidate(request);
+    },
+
+    totalMaintenanceMargin(request: QueryTotalMaintenanceMarginRequest): Promise<QueryTotalMaintenanceMarginResponse> {
+      return queryService.totalMaintenanceMargin(request);
+    },
+
+    positionNotional(request: QueryPositionNotionalRequest): Promise<QueryPositionNotionalResponse> {
+      return queryService.positionNotional(request);
+    },
+
+    marketExponentMigrationImpact(request: QueryMarketExponentMigrationImpactRequest): Promise<QueryMarketExponentMigrationImpactResponse> {
+      return queryService.marketExponentMigrationImpact(request);
+    },
+
+    riskAtHeight(request: QueryRiskAtHeightRequest): Promise<QueryRiskAtHeightResponse> {
+      return queryService.riskAtHeight(request);
+    },
+
+    healthDistribution(request: QueryHealthDistributionRequest): Promise<QueryHealthDistributionResponse> {
+      return queryService.healthDistribution(request);
+    },
+
+    marketNcSensitivity(request: QueryMarketNcSensitivityRequest): Promise<QueryMarketNcSensitivityResponse> {
+      return queryService.marketNcSensitivity(request);
+    },
+
+    withdrawableCheck(request: QueryWithdrawableCheckRequest): Promise<QueryWithdrawableCheckResponse> {
+      return queryService.withdrawableCheck(request);
+    },
+
+    realizedPnlOnClose(request: QueryRealizedPnlOnCloseRequest): Promise<QueryRealizedPnlOnCloseResponse> {
+      return queryService.realizedPnlOnClose(request);
+    },
+
+    protocolNetDelta(request: QueryProtocolNetDeltaRequest): Promise<QueryProtocolNetDeltaResponse> {
+      return queryService.protocolNetDelta(request);
+    },
+
+    fundingHistory(request: QueryFundingHistoryRequest): Promise<QueryFundingHistoryResponse> {
+      return queryService.fundingHistory(request);
+    },
+
+    actionToRestoreInitialMargin(request: QueryActionToRestoreInitialMarginRequest): Promise<QueryActionToRestoreInitialMarginResponse> {
+      return queryService.actionToRestoreInitialMargin(request);
+    },
+
+    lossBufferToLiquidation(request: QueryLossBufferToLiquidationRequest): Promise<QueryLossBufferToLiquidationResponse> {
+      return queryService.lossBufferToLiquidation(request);
+    },
+
+    marketUnrealizedPnl(request: QueryMarketUnrealizedPnlRequest): Promise<QueryMarketUnrealizedPnlResponse> {
+      return queryService.marketUnrealizedPnl(request);
+    },
+
+    autoWithdrawableAccounts(request: QueryAutoWithdrawableAccountsRequest): Promise<QueryAutoWithdrawableAccountsResponse> {
+      return queryService.autoWithdrawableAccounts(request);
+    },
+
+    closeAllCost(request: QueryCloseAllCostRequest): Promise<QueryCloseAllCostResponse> {
+      return queryService.closeAllCost(request);
+    },
+
+    liquidatableAccounts(request?: QueryLiquidatableAccountsRequest): Promise<QueryLiquidatableAccountsResponse> {
+      return queryService.liquidatableAccounts(request);
+    },
+
+    breakEvenPrice(request: QueryBreakEvenPriceRequest): Promise<QueryBreakEvenPriceResponse> {
+      return queryService.breakEvenPrice(request);
+    },
+
+    flatteningSize(request: QueryFlatteningSizeRequest): Promise<QueryFlatteningSizeResponse> {
+      return queryService.flatteningSize(request);
+    },
+
+    liquidationProceedsEstimate(request: QueryLiquidationProceedsEstimateRequest): Promise<QueryLiquidationProceedsEstimateResponse> {
+      return queryService.liquidationProceedsEstimate(request);
+    },
+
+    marginDeltaForTierChange(request: QueryMarginDeltaForTierChangeRequest): Promise<QueryMarginDeltaForTierChangeResponse> {
+      return queryService.marginDeltaForTierChange(request);
+    },
+
+    riskUnderHistoricalShocks(request: QueryRiskUnderHistoricalShocksRequest): Promise<QueryRiskUnderHistoricalShocksResponse> {
+      return queryService.riskUnderHistoricalShocks(request);
+    },
+
+    minLiquidatingPriceMove(request: QueryMinLiquidatingPriceMoveRequest): Promise<QueryMinLiquidatingPriceMoveResponse> {
+      return queryService.minLiquidatingPriceMove(request);
     }
 
   };
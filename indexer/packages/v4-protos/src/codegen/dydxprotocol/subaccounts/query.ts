@@ -1,7 +1,9 @@
 import { PageRequest, PageRequestSDKType, PageResponse, PageResponseSDKType } from "../../cosmos/base/query/v1beta1/pagination";
-import { Subaccount, SubaccountSDKType } from "./subaccount";
+import { Perpetual, PerpetualSDKType, LiquidityTier, LiquidityTierSDKType } from "../perpetuals/perpetual";
+import { MarketPrice, MarketPriceSDKType } from "../prices/market_price";
+import { Subaccount, SubaccountSDKType, SubaccountId, SubaccountIdSDKType } from "./subaccount";
 import * as _m0 from "protobufjs/minimal";
-import { DeepPartial } from "../../helpers";
+import { DeepPartial, Long } from "../../helpers";
 /** QueryGetSubaccountRequest is request type for the Query RPC method. */
 
 export interface QueryGetSubaccountRequest {
@@ -130,16 +132,5451 @@ export interface QueryCollateralPoolAddressResponse {
 export interface QueryCollateralPoolAddressResponseSDKType {
   collateral_pool_address: string;
 }
+/**
+ * HypotheticalAssetUpdate is a change to an asset position of a hypothetical
+ * subaccount.
+ */
+
+export interface HypotheticalAssetUpdate {
+  /** The `Id` of the `Asset`. */
+
+  assetId: number;
+  /** The signed change in the size of the position in base quantums. */
+
+  quantumsDelta: Uint8Array;
+}
+/**
+ * HypotheticalAssetUpdate is a change to an asset position of a hypothetical
+ * subaccount.
+ */
+
+export interface HypotheticalAssetUpdateSDKType {
+  /** The `Id` of the `Asset`. */
+
+  asset_id: number;
+  /** The signed change in the size of the position in base quantums. */
+
+  quantums_delta: Uint8Array;
+}
+/**
+ * HypotheticalPerpetualUpdate is a change to a perpetual position of a
+ * hypothetical subaccount.
+ */
+
+export interface HypotheticalPerpetualUpdate {
+  /** The `Id` of the `Perpetual`. */
+
+  perpetualId: number;
+  /** The signed change in the size of the position in base quantums. */
+
+  quantumsDelta: Uint8Array;
+  /** The signed change in the quote balance of the position in quote quantums. */
+
+  quoteBalanceDelta: Uint8Array;
+}
+/**
+ * HypotheticalPerpetualUpdate is a change to a perpetual position of a
+ * hypothetical subaccount.
+ */
+
+export interface HypotheticalPerpetualUpdateSDKType {
+  /** The `Id` of the `Perpetual`. */
+
+  perpetual_id: number;
+  /** The signed change in the size of the position in base quantums. */
+
+  quantums_delta: Uint8Array;
+  /** The signed change in the quote balance of the position in quote quantums. */
+
+  quote_balance_delta: Uint8Array;
+}
+/**
+ * QueryComputeRiskForHypotheticalRequest is the request type for computing the
+ * risk of a hypothetical subaccount. The subaccount's positions are treated as
+ * settled.
+ */
+
+export interface QueryComputeRiskForHypotheticalRequest {
+  subaccount?: Subaccount;
+  assetUpdates: HypotheticalAssetUpdate[];
+  perpetualUpdates: HypotheticalPerpetualUpdate[];
+}
+/**
+ * QueryComputeRiskForHypotheticalRequest is the request type for computing the
+ * risk of a hypothetical subaccount. The subaccount's positions are treated as
+ * settled.
+ */
+
+export interface QueryComputeRiskForHypotheticalRequestSDKType {
+  subaccount?: SubaccountSDKType;
+  asset_updates: HypotheticalAssetUpdateSDKType[];
+  perpetual_updates: HypotheticalPerpetualUpdateSDKType[];
+}
+/**
+ * QueryComputeRiskForHypotheticalResponse is the response type for computing
+ * the risk of a hypothetical subaccount. All values are in quote quantums.
+ */
+
+export interface QueryComputeRiskForHypotheticalResponse {
+  netCollateral: Uint8Array;
+  initialMarginRequirement: Uint8Array;
+  maintenanceMarginRequirement: Uint8Array;
+}
+/**
+ * QueryComputeRiskForHypotheticalResponse is the response type for computing
+ * the risk of a hypothetical subaccount. All values are in quote quantums.
+ */
+
+export interface QueryComputeRiskForHypotheticalResponseSDKType {
+  net_collateral: Uint8Array;
+  initial_margin_requirement: Uint8Array;
+  maintenance_margin_requirement: Uint8Array;
+}
+/**
+ * QueryRiskInputsRequest is the request type for fetching the risk inputs of a
+ * subaccount.
+ */
+
+export interface QueryRiskInputsRequest {
+  owner: string;
+  number: number;
+}
+/**
+ * QueryRiskInputsRequest is the request type for fetching the risk inputs of a
+ * subaccount.
+ */
+
+export interface QueryRiskInputsRequestSDKType {
+  owner: string;
+  number: number;
+}
+/**
+ * PerpetualRiskInputs is the state of a perpetual used to compute the risk of
+ * a position in it.
+ */
+
+export interface PerpetualRiskInputs {
+  perpetual?: Perpetual;
+  marketPrice?: MarketPrice;
+  liquidityTier?: LiquidityTier;
+}
+/**
+ * PerpetualRiskInputs is the state of a perpetual used to compute the risk of
+ * a position in it.
+ */
+
+export interface PerpetualRiskInputsSDKType {
+  perpetual?: PerpetualSDKType;
+  market_price?: MarketPriceSDKType;
+  liquidity_tier?: LiquidityTierSDKType;
+}
+/**
+ * QueryRiskInputsResponse is the response type for fetching the risk inputs of
+ * a subaccount.
+ */
+
+export interface QueryRiskInputsResponse {
+  /**
+   * The subaccount with all outstanding funding applied to its USDC asset
+   * position.
+   */
+  settledSubaccount?: Subaccount;
+  /** The state of every perpetual the subaccount holds a position in. */
+
+  perpetuals: PerpetualRiskInputs[];
+  /**
+   * The collateral provided by sources outside of the subaccount, such as
+   * staked tokens, in quote quantums. Added to the net collateral.
+   */
+
+  externalCollateral: Uint8Array;
+  /**
+   * The collateral locked by pending withdrawals, in quote quantums.
+   * Subtracted from the net collateral.
+   */
+
+  lockedCollateral: Long;
+}
+/**
+ * QueryRiskInputsResponse is the response type for fetching the risk inputs of
+ * a subaccount.
+ */
+
+export interface QueryRiskInputsResponseSDKType {
+  /**
+   * The subaccount with all outstanding funding applied to its USDC asset
+   * position.
+   */
+  settled_subaccount?: SubaccountSDKType;
+  /** The state of every perpetual the subaccount holds a position in. */
+
+  perpetuals: PerpetualRiskInputsSDKType[];
+  /**
+   * The collateral provided by sources outside of the subaccount, such as
+   * staked tokens, in quote quantums. Added to the net collateral.
+   */
+
+  external_collateral: Uint8Array;
+  /**
+   * The collateral locked by pending withdrawals, in quote quantums.
+   * Subtracted from the net collateral.
+   */
+
+  locked_collateral: Long;
+}
+/**
+ * QuerySubaccountUtilizationRequest is the request type for fetching the
+ * utilization of a subaccount.
+ */
+
+export interface QuerySubaccountUtilizationRequest {
+  owner: string;
+  number: number;
+}
+/**
+ * QuerySubaccountUtilizationRequest is the request type for fetching the
+ * utilization of a subaccount.
+ */
+
+export interface QuerySubaccountUtilizationRequestSDKType {
+  owner: string;
+  number: number;
+}
+/**
+ * QuerySubaccountUtilizationResponse is the response type for fetching the
+ * utilization of a subaccount.
+ */
+
+export interface QuerySubaccountUtilizationResponse {
+  /**
+   * The ratio of the initial margin requirement of the subaccount to its net
+   * collateral, including unsettled funding and external collateral, in
+   * parts-per-million rounded up. Zero if the subaccount is over-utilized.
+   */
+  utilizationPpm: Long;
+  /** True if the subaccount has non-positive net collateral. */
+
+  overUtilized: boolean;
+}
+/**
+ * QuerySubaccountUtilizationResponse is the response type for fetching the
+ * utilization of a subaccount.
+ */
+
+export interface QuerySubaccountUtilizationResponseSDKType {
+  /**
+   * The ratio of the initial margin requirement of the subaccount to its net
+   * collateral, including unsettled funding and external collateral, in
+   * parts-per-million rounded up. Zero if the subaccount is over-utilized.
+   */
+  utilization_ppm: Long;
+  /** True if the subaccount has non-positive net collateral. */
+
+  over_utilized: boolean;
+}
+/**
+ * QueryTotalValueLockedRequest is the request type for fetching the total
+ * value locked across all subaccounts.
+ */
+
+export interface QueryTotalValueLockedRequest {}
+/**
+ * QueryTotalValueLockedRequest is the request type for fetching the total
+ * value locked across all subaccounts.
+ */
+
+export interface QueryTotalValueLockedRequestSDKType {}
+/**
+ * QueryTotalValueLockedResponse is the response type for fetching the total
+ * value locked across all subaccounts.
+ */
+
+export interface QueryTotalValueLockedResponse {
+  /**
+   * The sum of the net collateral of all subaccounts with positive net
+   * collateral, in quote quantums.
+   */
+  totalValueLocked: Uint8Array;
+  /**
+   * The sum of the net collateral of all subaccounts with non-positive net
+   * collateral, in quote quantums.
+   */
+
+  totalNegativeNetCollateral: Uint8Array;
+}
+/**
+ * QueryTotalValueLockedResponse is the response type for fetching the total
+ * value locked across all subaccounts.
+ */
+
+export interface QueryTotalValueLockedResponseSDKType {
+  /**
+   * The sum of the net collateral of all subaccounts with positive net
+   * collateral, in quote quantums.
+   */
+  total_value_locked: Uint8Array;
+  /**
+   * The sum of the net collateral of all subaccounts with non-positive net
+   * collateral, in quote quantums.
+   */
+
+  total_negative_net_collateral: Uint8Array;
+}
+/**
+ * PositionLiquidationPrices holds the oracle prices of a perpetual at which a
+ * subaccount becomes liquidatable and bankrupt. Both prices are marginal: each
+ * assumes that only the price of this perpetual moves while the prices of all
+ * other markets stay fixed.
+ */
+
+export interface PositionLiquidationPrices {
+  perpetualId: number;
+  /**
+   * The price at which the net collateral of the subaccount falls below its
+   * maintenance margin requirement. Only meaningful if
+   * `has_liquidation_price` is true.
+   */
+
+  liquidationPrice: Long;
+  hasLiquidationPrice: boolean;
+  /**
+   * The price at which the net collateral of the subaccount becomes
+   * negative. Only meaningful if `has_bankruptcy_price` is true.
+   */
+
+  bankruptcyPrice: Long;
+  hasBankruptcyPrice: boolean;
+}
+/**
+ * PositionLiquidationPrices holds the oracle prices of a perpetual at which a
+ * subaccount becomes liquidatable and bankrupt. Both prices are marginal: each
+ * assumes that only the price of this perpetual moves while the prices of all
+ * other markets stay fixed.
+ */
+
+export interface PositionLiquidationPricesSDKType {
+  perpetual_id: number;
+  /**
+   * The price at which the net collateral of the subaccount falls below its
+   * maintenance margin requirement. Only meaningful if
+   * `has_liquidation_price` is true.
+   */
+
+  liquidation_price: Long;
+  has_liquidation_price: boolean;
+  /**
+   * The price at which the net collateral of the subaccount becomes
+   * negative. Only meaningful if `has_bankruptcy_price` is true.
+   */
+
+  bankruptcy_price: Long;
+  has_bankruptcy_price: boolean;
+}
+/**
+ * QuerySubaccountLiquidationPricesRequest is the request type for fetching
+ * the liquidation prices of a subaccount.
+ */
+
+export interface QuerySubaccountLiquidationPricesRequest {
+  owner: string;
+  number: number;
+}
+/**
+ * QuerySubaccountLiquidationPricesRequest is the request type for fetching
+ * the liquidation prices of a subaccount.
+ */
+
+export interface QuerySubaccountLiquidationPricesRequestSDKType {
+  owner: string;
+  number: number;
+}
+/**
+ * QuerySubaccountLiquidationPricesResponse is the response type for fetching
+ * the liquidation prices of a subaccount.
+ */
+
+export interface QuerySubaccountLiquidationPricesResponse {
+  /** The prices of every open perpetual position, ordered by perpetual id. */
+  prices: PositionLiquidationPrices[];
+}
+/**
+ * QuerySubaccountLiquidationPricesResponse is the response type for fetching
+ * the liquidation prices of a subaccount.
+ */
+
+export interface QuerySubaccountLiquidationPricesResponseSDKType {
+  /** The prices of every open perpetual position, ordered by perpetual id. */
+  prices: PositionLiquidationPricesSDKType[];
+}
+/**
+ * BasketWeight is the weight of a perpetual in a correlated basket. A shock of
+ * `shock_ppm` to the basket moves the oracle price of the perpetual by
+ * `shock_ppm * weight_ppm / 1,000,000` parts-per-million.
+ */
+
+export interface BasketWeight {
+  perpetualId: number;
+  weightPpm: number;
+}
+/**
+ * BasketWeight is the weight of a perpetual in a correlated basket. A shock of
+ * `shock_ppm` to the basket moves the oracle price of the perpetual by
+ * `shock_ppm * weight_ppm / 1,000,000` parts-per-million.
+ */
+
+export interface BasketWeightSDKType {
+  perpetual_id: number;
+  weight_ppm: number;
+}
+/**
+ * QueryBasketShockToLiquidateRequest is the request type for computing the
+ * smallest basket shock that liquidates a subaccount.
+ */
+
+export interface QueryBasketShockToLiquidateRequest {
+  owner: string;
+  number: number;
+  /**
+   * The weight of each perpetual of the basket. If empty, the basket is every
+   * perpetual the subaccount has a position in, perfectly correlated.
+   */
+
+  weights: BasketWeight[];
+}
+/**
+ * QueryBasketShockToLiquidateRequest is the request type for computing the
+ * smallest basket shock that liquidates a subaccount.
+ */
+
+export interface QueryBasketShockToLiquidateRequestSDKType {
+  owner: string;
+  number: number;
+  /**
+   * The weight of each perpetual of the basket. If empty, the basket is every
+   * perpetual the subaccount has a position in, perfectly correlated.
+   */
+
+  weights: BasketWeightSDKType[];
+}
+/**
+ * QueryBasketShockToLiquidateResponse is the response type for computing the
+ * smallest basket shock that liquidates a subaccount. The risk is in quote
+ * quantums.
+ */
+
+export interface QueryBasketShockToLiquidateResponse {
+  /** False if no shock in either direction makes the subaccount liquidatable. */
+  found: boolean;
+  /**
+   * The smallest liquidating shock in parts-per-million. Negative shocks move
+   * the prices of perpetuals with positive weights down.
+   */
+
+  shockPpm: Long;
+  /** The risk of the subaccount under the shock. */
+
+  netCollateral: Uint8Array;
+  initialMarginRequirement: Uint8Array;
+  maintenanceMarginRequirement: Uint8Array;
+}
+/**
+ * QueryBasketShockToLiquidateResponse is the response type for computing the
+ * smallest basket shock that liquidates a subaccount. The risk is in quote
+ * quantums.
+ */
+
+export interface QueryBasketShockToLiquidateResponseSDKType {
+  /** False if no shock in either direction makes the subaccount liquidatable. */
+  found: boolean;
+  /**
+   * The smallest liquidating shock in parts-per-million. Negative shocks move
+   * the prices of perpetuals with positive weights down.
+   */
+
+  shock_ppm: Long;
+  /** The risk of the subaccount under the shock. */
+
+  net_collateral: Uint8Array;
+  initial_margin_requirement: Uint8Array;
+  maintenance_margin_requirement: Uint8Array;
+}
+/**
+ * PerpetualMaintenanceMargin is the total maintenance margin requirement of
+ * the positions in a perpetual, in quote quantums.
+ */
+
+export interface PerpetualMaintenanceMargin {
+  perpetualId: number;
+  maintenanceMargin: Uint8Array;
+}
+/**
+ * PerpetualMaintenanceMargin is the total maintenance margin requirement of
+ * the positions in a perpetual, in quote quantums.
+ */
+
+export interface PerpetualMaintenanceMarginSDKType {
+  perpetual_id: number;
+  maintenance_margin: Uint8Array;
+}
+/**
+ * QueryTotalMaintenanceMarginRequest is the request type for fetching the
+ * total maintenance margin requirement of all subaccounts.
+ */
+
+export interface QueryTotalMaintenanceMarginRequest {
+  /**
+   * Whether to also return the total maintenance margin requirement of the
+   * positions in each perpetual.
+   */
+  byPerpetual: boolean;
+}
+/**
+ * QueryTotalMaintenanceMarginRequest is the request type for fetching the
+ * total maintenance margin requirement of all subaccounts.
+ */
+
+export interface QueryTotalMaintenanceMarginRequestSDKType {
+  /**
+   * Whether to also return the total maintenance margin requirement of the
+   * positions in each perpetual.
+   */
+  by_perpetual: boolean;
+}
+/**
+ * QueryTotalMaintenanceMarginResponse is the response type for fetching the
+ * total maintenance margin requirement of all subaccounts. All values are in
+ * quote quantums.
+ */
+
+export interface QueryTotalMaintenanceMarginResponse {
+  total: Uint8Array;
+  /**
+   * The total maintenance margin requirement of the positions in each
+   * perpetual, ordered by perpetual id. Only set if `by_perpetual` is true.
+   */
+
+  perpetuals: PerpetualMaintenanceMargin[];
+}
+/**
+ * QueryTotalMaintenanceMarginResponse is the response type for fetching the
+ * total maintenance margin requirement of all subaccounts. All values are in
+ * quote quantums.
+ */
+
+export interface QueryTotalMaintenanceMarginResponseSDKType {
+  total: Uint8Array;
+  /**
+   * The total maintenance margin requirement of the positions in each
+   * perpetual, ordered by perpetual id. Only set if `by_perpetual` is true.
+   */
+
+  perpetuals: PerpetualMaintenanceMarginSDKType[];
+}
+/**
+ * QueryPositionNotionalRequest is the request type for fetching the notional
+ * of a position.
+ */
+
+export interface QueryPositionNotionalRequest {
+  owner: string;
+  number: number;
+  perpetualId: number;
+}
+/**
+ * QueryPositionNotionalRequest is the request type for fetching the notional
+ * of a position.
+ */
+
+export interface QueryPositionNotionalRequestSDKType {
+  owner: string;
+  number: number;
+  perpetual_id: number;
+}
+/**
+ * QueryPositionNotionalResponse is the response type for fetching the
+ * notional of a position.
+ */
+
+export interface QueryPositionNotionalResponse {
+  /**
+   * The absolute notional of the position at the current market price, in
+   * quote quantums. Zero if the subaccount has no position in the perpetual.
+   */
+  notional: Uint8Array;
+}
+/**
+ * QueryPositionNotionalResponse is the response type for fetching the
+ * notional of a position.
+ */
+
+export interface QueryPositionNotionalResponseSDKType {
+  /**
+   * The absolute notional of the position at the current market price, in
+   * quote quantums. Zero if the subaccount has no position in the perpetual.
+   */
+  notional: Uint8Array;
+}
+/**
+ * QueryMarketExponentMigrationImpactRequest is the request type for fetching
+ * the impact of a price exponent migration.
+ */
+
+export interface QueryMarketExponentMigrationImpactRequest {
+  marketId: number;
+  proposedExponent: number;
+}
+/**
+ * QueryMarketExponentMigrationImpactRequest is the request type for fetching
+ * the impact of a price exponent migration.
+ */
+
+export interface QueryMarketExponentMigrationImpactRequestSDKType {
+  market_id: number;
+  proposed_exponent: number;
+}
+/**
+ * QueryMarketExponentMigrationImpactResponse is the response type for
+ * fetching the impact of a price exponent migration.
+ */
+
+export interface QueryMarketExponentMigrationImpactResponse {
+  /**
+   * The aggregate notional of all positions in perpetuals of the market under
+   * the current exponent, in quote quantums.
+   */
+  currentNotional: Uint8Array;
+  /**
+   * The aggregate notional of the same positions under the proposed exponent
+   * with the same integer price, in quote quantums.
+   */
+
+  proposedNotional: Uint8Array;
+  /**
+   * The subaccounts that are not liquidatable under the current exponent but
+   * would be under the proposed exponent.
+   */
+
+  newlyLiquidatable: SubaccountId[];
+}
+/**
+ * QueryMarketExponentMigrationImpactResponse is the response type for
+ * fetching the impact of a price exponent migration.
+ */
+
+export interface QueryMarketExponentMigrationImpactResponseSDKType {
+  /**
+   * The aggregate notional of all positions in perpetuals of the market under
+   * the current exponent, in quote quantums.
+   */
+  current_notional: Uint8Array;
+  /**
+   * The aggregate notional of the same positions under the proposed exponent
+   * with the same integer price, in quote quantums.
+   */
+
+  proposed_notional: Uint8Array;
+  /**
+   * The subaccounts that are not liquidatable under the current exponent but
+   * would be under the proposed exponent.
+   */
+
+  newly_liquidatable: SubaccountIdSDKType[];
+}
+/**
+ * QueryRiskAtHeightRequest is the request type for fetching the risk of a
+ * subaccount at a height.
+ */
+
+export interface QueryRiskAtHeightRequest {
+  owner: string;
+  number: number;
+  /** Only heights whose state has not been pruned by the node can be queried. */
+
+  height: Long;
+}
+/**
+ * QueryRiskAtHeightRequest is the request type for fetching the risk of a
+ * subaccount at a height.
+ */
+
+export interface QueryRiskAtHeightRequestSDKType {
+  owner: string;
+  number: number;
+  /** Only heights whose state has not been pruned by the node can be queried. */
+
+  height: Long;
+}
+/**
+ * QueryRiskAtHeightResponse is the response type for fetching the risk of a
+ * subaccount at a height. The risk is in quote quantums.
+ */
+
+export interface QueryRiskAtHeightResponse {
+  netCollateral: Uint8Array;
+  initialMarginRequirement: Uint8Array;
+  maintenanceMarginRequirement: Uint8Array;
+}
+/**
+ * QueryRiskAtHeightResponse is the response type for fetching the risk of a
+ * subaccount at a height. The risk is in quote quantums.
+ */
+
+export interface QueryRiskAtHeightResponseSDKType {
+  net_collateral: Uint8Array;
+  initial_margin_requirement: Uint8Array;
+  maintenance_margin_requirement: Uint8Array;
+}
+/**
+ * QueryHealthDistributionRequest is the request type for fetching the
+ * distribution of the health of all subaccounts.
+ */
+
+export interface QueryHealthDistributionRequest {
+  /**
+   * The strictly ascending upper bounds of the health buckets, as health in
+   * parts-per-million.
+   */
+  buckets: number[];
+}
+/**
+ * QueryHealthDistributionRequest is the request type for fetching the
+ * distribution of the health of all subaccounts.
+ */
+
+export interface QueryHealthDistributionRequestSDKType {
+  /**
+   * The strictly ascending upper bounds of the health buckets, as health in
+   * parts-per-million.
+   */
+  buckets: number[];
+}
+/**
+ * QueryHealthDistributionResponse is the response type for fetching the
+ * distribution of the health of all subaccounts.
+ */
+
+export interface QueryHealthDistributionResponse {
+  /**
+   * The number of subaccounts in each bucket. The last of the `len(buckets) + 1`
+   * counts is the number of subaccounts with a health of at least the last
+   * bound, including subaccounts with no maintenance margin requirement.
+   */
+  counts: Long[];
+}
+/**
+ * QueryHealthDistributionResponse is the response type for fetching the
+ * distribution of the health of all subaccounts.
+ */
+
+export interface QueryHealthDistributionResponseSDKType {
+  /**
+   * The number of subaccounts in each bucket. The last of the `len(buckets) + 1`
+   * counts is the number of subaccounts with a health of at least the last
+   * bound, including subaccounts with no maintenance margin requirement.
+   */
+  counts: Long[];
+}
+/**
+ * QueryMarketNcSensitivityRequest is the request type for fetching the
+ * sensitivity of the total net collateral to the price of a perpetual.
+ */
+
+export interface QueryMarketNcSensitivityRequest {
+  perpetualId: number;
+}
+/**
+ * QueryMarketNcSensitivityRequest is the request type for fetching the
+ * sensitivity of the total net collateral to the price of a perpetual.
+ */
+
+export interface QueryMarketNcSensitivityRequestSDKType {
+  perpetual_id: number;
+}
+/**
+ * QueryMarketNcSensitivityResponse is the response type for fetching the
+ * sensitivity of the total net collateral to the price of a perpetual.
+ */
+
+export interface QueryMarketNcSensitivityResponse {
+  /** The net signed quantums of all positions in the perpetual. */
+  netQuantums: Uint8Array;
+  /**
+   * The exact change in the total net collateral in quote quantums when the
+   * market price moves up by one unit, formatted as an integer or as a
+   * fraction `a/b`.
+   */
+
+  ncPerPriceUnit: string;
+}
+/**
+ * QueryMarketNcSensitivityResponse is the response type for fetching the
+ * sensitivity of the total net collateral to the price of a perpetual.
+ */
+
+export interface QueryMarketNcSensitivityResponseSDKType {
+  /** The net signed quantums of all positions in the perpetual. */
+  net_quantums: Uint8Array;
+  /**
+   * The exact change in the total net collateral in quote quantums when the
+   * market price moves up by one unit, formatted as an integer or as a
+   * fraction `a/b`.
+   */
+
+  nc_per_price_unit: string;
+}
+/**
+ * QueryWithdrawableCheckRequest is the request type for checking a withdrawal
+ * from a subaccount.
+ */
+
+export interface QueryWithdrawableCheckRequest {
+  owner: string;
+  number: number;
+  /** The amount to withdraw in USDC quote quantums. */
+
+  quoteQuantums: Long;
+}
+/**
+ * QueryWithdrawableCheckRequest is the request type for checking a withdrawal
+ * from a subaccount.
+ */
+
+export interface QueryWithdrawableCheckRequestSDKType {
+  owner: string;
+  number: number;
+  /** The amount to withdraw in USDC quote quantums. */
+
+  quote_quantums: Long;
+}
+/**
+ * QueryWithdrawableCheckResponse is the response type for checking a
+ * withdrawal from a subaccount.
+ */
+
+export interface QueryWithdrawableCheckResponse {
+  /** Whether the withdrawal would leave the subaccount collateralized. */
+  allowed: boolean;
+  /** The maximum amount that can be withdrawn in USDC quote quantums. */
+
+  maxWithdrawable: Uint8Array;
+}
+/**
+ * QueryWithdrawableCheckResponse is the response type for checking a
+ * withdrawal from a subaccount.
+ */
+
+export interface QueryWithdrawableCheckResponseSDKType {
+  /** Whether the withdrawal would leave the subaccount collateralized. */
+  allowed: boolean;
+  /** The maximum amount that can be withdrawn in USDC quote quantums. */
+
+  max_withdrawable: Uint8Array;
+}
+/**
+ * QueryRealizedPnlOnCloseRequest is the request type for fetching the PnL of
+ * closing a position.
+ */
+
+export interface QueryRealizedPnlOnCloseRequest {
+  owner: string;
+  number: number;
+  perpetualId: number;
+}
+/**
+ * QueryRealizedPnlOnCloseRequest is the request type for fetching the PnL of
+ * closing a position.
+ */
+
+export interface QueryRealizedPnlOnCloseRequestSDKType {
+  owner: string;
+  number: number;
+  perpetual_id: number;
+}
+/**
+ * QueryRealizedPnlOnCloseResponse is the response type for fetching the PnL of
+ * closing a position.
+ */
+
+export interface QueryRealizedPnlOnCloseResponse {
+  /**
+   * The PnL of closing the position at the current market price in quote
+   * quantums, negative for a loss. Funding and fees are not included.
+   */
+  pnl: Uint8Array;
+}
+/**
+ * QueryRealizedPnlOnCloseResponse is the response type for fetching the PnL of
+ * closing a position.
+ */
+
+export interface QueryRealizedPnlOnCloseResponseSDKType {
+  /**
+   * The PnL of closing the position at the current market price in quote
+   * quantums, negative for a loss. Funding and fees are not included.
+   */
+  pnl: Uint8Array;
+}
+/**
+ * QueryProtocolNetDeltaRequest is the request type for fetching the net
+ * position of all subaccounts in a perpetual.
+ */
+
+export interface QueryProtocolNetDeltaRequest {
+  perpetualId: number;
+}
+/**
+ * QueryProtocolNetDeltaRequest is the request type for fetching the net
+ * position of all subaccounts in a perpetual.
+ */
+
+export interface QueryProtocolNetDeltaRequestSDKType {
+  perpetual_id: number;
+}
+/**
+ * QueryProtocolNetDeltaResponse is the response type for fetching the net
+ * position of all subaccounts in a perpetual.
+ */
+
+export interface QueryProtocolNetDeltaResponse {
+  /**
+   * The sum of the signed base quantums of the positions of all subaccounts in
+   * the perpetual. Nonzero if the protocol has residual exposure.
+   */
+  netDelta: Uint8Array;
+}
+/**
+ * QueryProtocolNetDeltaResponse is the response type for fetching the net
+ * position of all subaccounts in a perpetual.
+ */
+
+export interface QueryProtocolNetDeltaResponseSDKType {
+  /**
+   * The sum of the signed base quantums of the positions of all subaccounts in
+   * the perpetual. Nonzero if the protocol has residual exposure.
+   */
+  net_delta: Uint8Array;
+}
+/**
+ * EpochFunding is the funding settled for a perpetual position of a subaccount
+ * during a funding-tick epoch.
+ */
+
+export interface EpochFunding {
+  epoch: number;
+  /**
+   * The funding settled in quote quantums. Positive if the subaccount paid
+   * funding, and negative if the subaccount received funding.
+   */
+
+  fundingPaid: Uint8Array;
+}
+/**
+ * EpochFunding is the funding settled for a perpetual position of a subaccount
+ * during a funding-tick epoch.
+ */
+
+export interface EpochFundingSDKType {
+  epoch: number;
+  /**
+   * The funding settled in quote quantums. Positive if the subaccount paid
+   * funding, and negative if the subaccount received funding.
+   */
+
+  funding_paid: Uint8Array;
+}
+/**
+ * QueryFundingHistoryRequest is the request type for fetching the funding
+ * history of a position.
+ */
+
+export interface QueryFundingHistoryRequest {
+  owner: string;
+  number: number;
+  perpetualId: number;
+  /** The first funding-tick epoch of the range, inclusive. */
+
+  fromEpoch: number;
+  /**
+   * The last funding-tick epoch of the range, inclusive. The range can span at
+   * most `FundingHistoryRetentionEpochs` epochs.
+   */
+
+  toEpoch: number;
+}
+/**
+ * QueryFundingHistoryRequest is the request type for fetching the funding
+ * history of a position.
+ */
+
+export interface QueryFundingHistoryRequestSDKType {
+  owner: string;
+  number: number;
+  perpetual_id: number;
+  /** The first funding-tick epoch of the range, inclusive. */
+
+  from_epoch: number;
+  /**
+   * The last funding-tick epoch of the range, inclusive. The range can span at
+   * most `FundingHistoryRetentionEpochs` epochs.
+   */
+
+  to_epoch: number;
+}
+/**
+ * QueryFundingHistoryResponse is the response type for fetching the funding
+ * history of a position.
+ */
+
+export interface QueryFundingHistoryResponse {
+  /**
+   * The funding settled during each epoch of the range, in ascending order of
+   * epoch. Epochs during which no funding was settled are omitted.
+   */
+  entries: EpochFunding[];
+}
+/**
+ * QueryFundingHistoryResponse is the response type for fetching the funding
+ * history of a position.
+ */
+
+export interface QueryFundingHistoryResponseSDKType {
+  /**
+   * The funding settled during each epoch of the range, in ascending order of
+   * epoch. Epochs during which no funding was settled are omitted.
+   */
+  entries: EpochFundingSDKType[];
+}
+/**
+ * InitialMarginRestoringClose is a close of a perpetual position at the market
+ * price that makes a subaccount initially collateralized.
+ */
+
+export interface InitialMarginRestoringClose {
+  perpetualId: number;
+  /** The absolute number of base quantums of the position to close. */
+
+  quantums: Uint8Array;
+  /** The absolute notional of the closed quantums in quote quantums. */
+
+  absNotional: Uint8Array;
+}
+/**
+ * InitialMarginRestoringClose is a close of a perpetual position at the market
+ * price that makes a subaccount initially collateralized.
+ */
+
+export interface InitialMarginRestoringCloseSDKType {
+  perpetual_id: number;
+  /** The absolute number of base quantums of the position to close. */
+
+  quantums: Uint8Array;
+  /** The absolute notional of the closed quantums in quote quantums. */
+
+  abs_notional: Uint8Array;
+}
+/**
+ * QueryActionToRestoreInitialMarginRequest is the request type for fetching
+ * the closes that restore the initial margin of a subaccount.
+ */
+
+export interface QueryActionToRestoreInitialMarginRequest {
+  owner: string;
+  number: number;
+}
+/**
+ * QueryActionToRestoreInitialMarginRequest is the request type for fetching
+ * the closes that restore the initial margin of a subaccount.
+ */
+
+export interface QueryActionToRestoreInitialMarginRequestSDKType {
+  owner: string;
+  number: number;
+}
+/**
+ * QueryActionToRestoreInitialMarginResponse is the response type for fetching
+ * the closes that restore the initial margin of a subaccount.
+ */
+
+export interface QueryActionToRestoreInitialMarginResponse {
+  /**
+   * Whether the subaccount is already initially collateralized, in which
+   * case no closes are needed.
+   */
+  initiallyCollateralized: boolean;
+  /**
+   * The smallest close of each position that restores the initial margin of
+   * the subaccount, in ascending order of perpetual id. Positions that do not
+   * restore it even when fully closed are omitted.
+   */
+
+  closes: InitialMarginRestoringClose[];
+  /**
+   * The close in `closes` with the smallest notional, unset if there are no
+   * closes.
+   */
+
+  cheapest?: InitialMarginRestoringClose;
+}
+/**
+ * QueryActionToRestoreInitialMarginResponse is the response type for fetching
+ * the closes that restore the initial margin of a subaccount.
+ */
+
+export interface QueryActionToRestoreInitialMarginResponseSDKType {
+  /**
+   * Whether the subaccount is already initially collateralized, in which
+   * case no closes are needed.
+   */
+  initially_collateralized: boolean;
+  /**
+   * The smallest close of each position that restores the initial margin of
+   * the subaccount, in ascending order of perpetual id. Positions that do not
+   * restore it even when fully closed are omitted.
+   */
+
+  closes: InitialMarginRestoringCloseSDKType[];
+  /**
+   * The close in `closes` with the smallest notional, unset if there are no
+   * closes.
+   */
+
+  cheapest?: InitialMarginRestoringCloseSDKType;
+}
+/**
+ * QueryLossBufferToLiquidationRequest is the request type for fetching the
+ * loss buffer of a position to liquidation.
+ */
+
+export interface QueryLossBufferToLiquidationRequest {
+  owner: string;
+  number: number;
+  perpetualId: number;
+}
+/**
+ * QueryLossBufferToLiquidationRequest is the request type for fetching the
+ * loss buffer of a position to liquidation.
+ */
+
+export interface QueryLossBufferToLiquidationRequestSDKType {
+  owner: string;
+  number: number;
+  perpetual_id: number;
+}
+/**
+ * QueryLossBufferToLiquidationResponse is the response type for fetching the
+ * loss buffer of a position to liquidation.
+ */
+
+export interface QueryLossBufferToLiquidationResponse {
+  /**
+   * False if the subaccount has no position in the perpetual or if no price of
+   * the perpetual makes the subaccount liquidatable.
+   */
+  found: boolean;
+  /**
+   * The decrease in the net collateral of the subaccount in quote quantums
+   * when the price moves to the liquidation price.
+   */
+
+  lossBuffer: Uint8Array;
+  /** The distance from the current price to the liquidation price. */
+
+  priceMove: Long;
+}
+/**
+ * QueryLossBufferToLiquidationResponse is the response type for fetching the
+ * loss buffer of a position to liquidation.
+ */
+
+export interface QueryLossBufferToLiquidationResponseSDKType {
+  /**
+   * False if the subaccount has no position in the perpetual or if no price of
+   * the perpetual makes the subaccount liquidatable.
+   */
+  found: boolean;
+  /**
+   * The decrease in the net collateral of the subaccount in quote quantums
+   * when the price moves to the liquidation price.
+   */
+
+  loss_buffer: Uint8Array;
+  /** The distance from the current price to the liquidation price. */
+
+  price_move: Long;
+}
+/**
+ * QueryMarketUnrealizedPnlRequest is the request type for fetching the
+ * unrealized PnL of a market.
+ */
+
+export interface QueryMarketUnrealizedPnlRequest {
+  perpetualId: number;
+}
+/**
+ * QueryMarketUnrealizedPnlRequest is the request type for fetching the
+ * unrealized PnL of a market.
+ */
+
+export interface QueryMarketUnrealizedPnlRequestSDKType {
+  perpetual_id: number;
+}
+/**
+ * QueryMarketUnrealizedPnlResponse is the response type for fetching the
+ * unrealized PnL of a market.
+ */
+
+export interface QueryMarketUnrealizedPnlResponse {
+  /**
+   * The sum of the unrealized PnL of all positions in the perpetual in quote
+   * quantums. Positions whose cost basis is unknown are skipped.
+   */
+  pnl: Uint8Array;
+}
+/**
+ * QueryMarketUnrealizedPnlResponse is the response type for fetching the
+ * unrealized PnL of a market.
+ */
+
+export interface QueryMarketUnrealizedPnlResponseSDKType {
+  /**
+   * The sum of the unrealized PnL of all positions in the perpetual in quote
+   * quantums. Positions whose cost basis is unknown are skipped.
+   */
+  pnl: Uint8Array;
+}
+/**
+ * AutoWithdrawableAccount is a subaccount whose free collateral exceeds an
+ * auto-withdrawal threshold.
+ */
+
+export interface AutoWithdrawableAccount {
+  subaccountId?: SubaccountId;
+  /** The amount that can be withdrawn in quote quantums. */
+
+  quoteQuantums: Uint8Array;
+}
+/**
+ * AutoWithdrawableAccount is a subaccount whose free collateral exceeds an
+ * auto-withdrawal threshold.
+ */
+
+export interface AutoWithdrawableAccountSDKType {
+  subaccount_id?: SubaccountIdSDKType;
+  /** The amount that can be withdrawn in quote quantums. */
+
+  quote_quantums: Uint8Array;
+}
+/**
+ * QueryAutoWithdrawableAccountsRequest is the request type for fetching the
+ * subaccounts that can be auto-withdrawn from.
+ */
+
+export interface QueryAutoWithdrawableAccountsRequest {
+  /**
+   * Only subaccounts whose free collateral exceeds the threshold are
+   * returned.
+   */
+  thresholdQuoteQuantums: Long;
+}
+/**
+ * QueryAutoWithdrawableAccountsRequest is the request type for fetching the
+ * subaccounts that can be auto-withdrawn from.
+ */
+
+export interface QueryAutoWithdrawableAccountsRequestSDKType {
+  /**
+   * Only subaccounts whose free collateral exceeds the threshold are
+   * returned.
+   */
+  threshold_quote_quantums: Long;
+}
+/**
+ * QueryAutoWithdrawableAccountsResponse is the response type for fetching the
+ * subaccounts that can be auto-withdrawn from.
+ */
+
+export interface QueryAutoWithdrawableAccountsResponse {
+  /** The subaccounts in the order they are stored. */
+  accounts: AutoWithdrawableAccount[];
+}
+/**
+ * QueryAutoWithdrawableAccountsResponse is the response type for fetching the
+ * subaccounts that can be auto-withdrawn from.
+ */
+
+export interface QueryAutoWithdrawableAccountsResponseSDKType {
+  /** The subaccounts in the order they are stored. */
+  accounts: AutoWithdrawableAccountSDKType[];
+}
+/**
+ * QueryCloseAllCostRequest is the request type for fetching the cost of
+ * closing all positions of a subaccount.
+ */
+
+export interface QueryCloseAllCostRequest {
+  owner: string;
+  number: number;
+}
+/**
+ * QueryCloseAllCostRequest is the request type for fetching the cost of
+ * closing all positions of a subaccount.
+ */
+
+export interface QueryCloseAllCostRequestSDKType {
+  owner: string;
+  number: number;
+}
+/**
+ * QueryCloseAllCostResponse is the response type for fetching the cost of
+ * closing all positions of a subaccount.
+ */
+
+export interface QueryCloseAllCostResponse {
+  /**
+   * The total taker fees in quote quantums of closing all positions at their
+   * market prices.
+   */
+  fees: Uint8Array;
+  /**
+   * The net collateral of the subaccount after closing all positions
+   * and paying the fees.
+   */
+
+  postCloseNetCollateral: Uint8Array;
+}
+/**
+ * QueryCloseAllCostResponse is the response type for fetching the cost of
+ * closing all positions of a subaccount.
+ */
+
+export interface QueryCloseAllCostResponseSDKType {
+  /**
+   * The total taker fees in quote quantums of closing all positions at their
+   * market prices.
+   */
+  fees: Uint8Array;
+  /**
+   * The net collateral of the subaccount after closing all positions
+   * and paying the fees.
+   */
+
+  post_close_net_collateral: Uint8Array;
+}
+/**
+ * QueryLiquidatableAccountsRequest is the request type for fetching the
+ * liquidatable subaccounts.
+ */
+
+export interface QueryLiquidatableAccountsRequest {}
+/**
+ * QueryLiquidatableAccountsRequest is the request type for fetching the
+ * liquidatable subaccounts.
+ */
+
+export interface QueryLiquidatableAccountsRequestSDKType {}
+/**
+ * QueryLiquidatableAccountsResponse is the response type for fetching the
+ * liquidatable subaccounts.
+ */
+
+export interface QueryLiquidatableAccountsResponse {
+  /** The liquidatable subaccounts from most to least underwater. */
+  subaccountIds: SubaccountId[];
+}
+/**
+ * QueryLiquidatableAccountsResponse is the response type for fetching the
+ * liquidatable subaccounts.
+ */
+
+export interface QueryLiquidatableAccountsResponseSDKType {
+  /** The liquidatable subaccounts from most to least underwater. */
+  subaccount_ids: SubaccountIdSDKType[];
+}
+/**
+ * QueryBreakEvenPriceRequest is the request type for fetching the break-even
+ * price of a position.
+ */
+
+export interface QueryBreakEvenPriceRequest {
+  owner: string;
+  number: number;
+  perpetualId: number;
+}
+/**
+ * QueryBreakEvenPriceRequest is the request type for fetching the break-even
+ * price of a position.
+ */
+
+export interface QueryBreakEvenPriceRequestSDKType {
+  owner: string;
+  number: number;
+  perpetual_id: number;
+}
+/**
+ * QueryBreakEvenPriceResponse is the response type for fetching the break-even
+ * price of a position.
+ */
+
+export interface QueryBreakEvenPriceResponse {
+  /**
+   * The exact break-even price in quote quantums per base quantum, net of the
+   * funding accrued by the position, formatted as an integer or as a fraction
+   * `a/b`. Fees are not included.
+   */
+  price: string;
+}
+/**
+ * QueryBreakEvenPriceResponse is the response type for fetching the break-even
+ * price of a position.
+ */
+
+export interface QueryBreakEvenPriceResponseSDKType {
+  /**
+   * The exact break-even price in quote quantums per base quantum, net of the
+   * funding accrued by the position, formatted as an integer or as a fraction
+   * `a/b`. Fees are not included.
+   */
+  price: string;
+}
+/**
+ * QueryFlatteningSizeRequest is the request type for fetching the size of the
+ * trade that flattens the net position of an owner in a perpetual.
+ */
+
+export interface QueryFlatteningSizeRequest {
+  owner: string;
+  perpetualId: number;
+}
+/**
+ * QueryFlatteningSizeRequest is the request type for fetching the size of the
+ * trade that flattens the net position of an owner in a perpetual.
+ */
+
+export interface QueryFlatteningSizeRequestSDKType {
+  owner: string;
+  perpetual_id: number;
+}
+/**
+ * QueryFlatteningSizeResponse is the response type for fetching the size of
+ * the trade that flattens the net position of an owner in a perpetual.
+ */
+
+export interface QueryFlatteningSizeResponse {
+  /** The signed base quantums to trade, negative if the owner is net long. */
+  quantums: Uint8Array;
+  /**
+   * The absolute notional of the trade at the market price in quote
+   * quantums.
+   */
+
+  absNotional: Uint8Array;
+}
+/**
+ * QueryFlatteningSizeResponse is the response type for fetching the size of
+ * the trade that flattens the net position of an owner in a perpetual.
+ */
+
+export interface QueryFlatteningSizeResponseSDKType {
+  /** The signed base quantums to trade, negative if the owner is net long. */
+  quantums: Uint8Array;
+  /**
+   * The absolute notional of the trade at the market price in quote
+   * quantums.
+   */
+
+  abs_notional: Uint8Array;
+}
+/**
+ * LiquidationDepthLevel is a price level of a depth model of the order book of
+ * a perpetual.
+ */
+
+export interface LiquidationDepthLevel {
+  /**
+   * The price of the level, in the exponent of the market price of the
+   * perpetual.
+   */
+  price: Long;
+  /** The size available at the level, in base quantums. */
+
+  quantums: Long;
+}
+/**
+ * LiquidationDepthLevel is a price level of a depth model of the order book of
+ * a perpetual.
+ */
+
+export interface LiquidationDepthLevelSDKType {
+  /**
+   * The price of the level, in the exponent of the market price of the
+   * perpetual.
+   */
+  price: Long;
+  /** The size available at the level, in base quantums. */
+
+  quantums: Long;
+}
+/**
+ * QueryLiquidationProceedsEstimateRequest is the request type for estimating
+ * the proceeds of liquidating a position.
+ */
+
+export interface QueryLiquidationProceedsEstimateRequest {
+  owner: string;
+  number: number;
+  perpetualId: number;
+  /**
+   * The price levels of the side of the order book the liquidation would
+   * trade against, ordered from the best price to the worst.
+   */
+
+  depth: LiquidationDepthLevel[];
+  /**
+   * The maximum liquidation fee in parts-per-million of the notional of
+   * each fill.
+   */
+
+  maxLiquidationFeePpm: number;
+}
+/**
+ * QueryLiquidationProceedsEstimateRequest is the request type for estimating
+ * the proceeds of liquidating a position.
+ */
+
+export interface QueryLiquidationProceedsEstimateRequestSDKType {
+  owner: string;
+  number: number;
+  perpetual_id: number;
+  /**
+   * The price levels of the side of the order book the liquidation would
+   * trade against, ordered from the best price to the worst.
+   */
+
+  depth: LiquidationDepthLevelSDKType[];
+  /**
+   * The maximum liquidation fee in parts-per-million of the notional of
+   * each fill.
+   */
+
+  max_liquidation_fee_ppm: number;
+}
+/**
+ * QueryLiquidationProceedsEstimateResponse is the response type for estimating
+ * the proceeds of liquidating a position. Amounts are in quote quantums.
+ */
+
+export interface QueryLiquidationProceedsEstimateResponse {
+  /**
+   * The size of the position closed by the liquidation in base quantums.
+   * This is less than the size of the position if the depth does not have
+   * enough size to close it.
+   */
+  filledQuantums: Uint8Array;
+  /**
+   * The quote quantums received by the subaccount for the closed size,
+   * negative for short positions.
+   */
+
+  proceedsQuoteQuantums: Uint8Array;
+  /**
+   * The contribution of the liquidation to the insurance fund,
+   * negative if the insurance fund pays.
+   */
+
+  insuranceFundDeltaQuoteQuantums: Uint8Array;
+}
+/**
+ * QueryLiquidationProceedsEstimateResponse is the response type for estimating
+ * the proceeds of liquidating a position. Amounts are in quote quantums.
+ */
+
+export interface QueryLiquidationProceedsEstimateResponseSDKType {
+  /**
+   * The size of the position closed by the liquidation in base quantums.
+   * This is less than the size of the position if the depth does not have
+   * enough size to close it.
+   */
+  filled_quantums: Uint8Array;
+  /**
+   * The quote quantums received by the subaccount for the closed size,
+   * negative for short positions.
+   */
+
+  proceeds_quote_quantums: Uint8Array;
+  /**
+   * The contribution of the liquidation to the insurance fund,
+   * negative if the insurance fund pays.
+   */
+
+  insurance_fund_delta_quote_quantums: Uint8Array;
+}
+/**
+ * QueryMarginDeltaForTierChangeRequest is the request type for fetching the
+ * change in the margin requirements of a subaccount for a liquidity tier
+ * change.
+ */
+
+export interface QueryMarginDeltaForTierChangeRequest {
+  owner: string;
+  number: number;
+  perpetualId: number;
+  proposedTier?: LiquidityTier;
+}
+/**
+ * QueryMarginDeltaForTierChangeRequest is the request type for fetching the
+ * change in the margin requirements of a subaccount for a liquidity tier
+ * change.
+ */
+
+export interface QueryMarginDeltaForTierChangeRequestSDKType {
+  owner: string;
+  number: number;
+  perpetual_id: number;
+  proposed_tier?: LiquidityTierSDKType;
+}
+/**
+ * QueryMarginDeltaForTierChangeResponse is the response type for fetching the
+ * change in the margin requirements of a subaccount for a liquidity tier
+ * change. Deltas are in quote quantums and positive if the subaccount would
+ * need more margin.
+ */
+
+export interface QueryMarginDeltaForTierChangeResponse {
+  initialMarginRequirementDelta: Uint8Array;
+  maintenanceMarginRequirementDelta: Uint8Array;
+}
+/**
+ * QueryMarginDeltaForTierChangeResponse is the response type for fetching the
+ * change in the margin requirements of a subaccount for a liquidity tier
+ * change. Deltas are in quote quantums and positive if the subaccount would
+ * need more margin.
+ */
+
+export interface QueryMarginDeltaForTierChangeResponseSDKType {
+  initial_margin_requirement_delta: Uint8Array;
+  maintenance_margin_requirement_delta: Uint8Array;
+}
+/**
+ * HistoricalMove is the magnitude of the worst single-day move observed
+ * historically in the market of a perpetual. The move is applied against the
+ * position of the subaccount in the perpetual.
+ */
+
+export interface HistoricalMove {
+  perpetualId: number;
+  movePpm: number;
+}
+/**
+ * HistoricalMove is the magnitude of the worst single-day move observed
+ * historically in the market of a perpetual. The move is applied against the
+ * position of the subaccount in the perpetual.
+ */
+
+export interface HistoricalMoveSDKType {
+  perpetual_id: number;
+  move_ppm: number;
+}
+/**
+ * QueryRiskUnderHistoricalShocksRequest is the request type for computing the
+ * risk of a subaccount under historical shocks.
+ */
+
+export interface QueryRiskUnderHistoricalShocksRequest {
+  owner: string;
+  number: number;
+  /**
+   * The worst historical move of each perpetual. Perpetuals without a move
+   * are not shocked.
+   */
+
+  worstMoves: HistoricalMove[];
+}
+/**
+ * QueryRiskUnderHistoricalShocksRequest is the request type for computing the
+ * risk of a subaccount under historical shocks.
+ */
+
+export interface QueryRiskUnderHistoricalShocksRequestSDKType {
+  owner: string;
+  number: number;
+  /**
+   * The worst historical move of each perpetual. Perpetuals without a move
+   * are not shocked.
+   */
+
+  worst_moves: HistoricalMoveSDKType[];
+}
+/**
+ * QueryRiskUnderHistoricalShocksResponse is the response type for computing
+ * the risk of a subaccount under historical shocks. The risk is in quote
+ * quantums.
+ */
+
+export interface QueryRiskUnderHistoricalShocksResponse {
+  netCollateral: Uint8Array;
+  initialMarginRequirement: Uint8Array;
+  maintenanceMarginRequirement: Uint8Array;
+}
+/**
+ * QueryRiskUnderHistoricalShocksResponse is the response type for computing
+ * the risk of a subaccount under historical shocks. The risk is in quote
+ * quantums.
+ */
+
+export interface QueryRiskUnderHistoricalShocksResponseSDKType {
+  net_collateral: Uint8Array;
+  initial_margin_requirement: Uint8Array;
+  maintenance_margin_requirement: Uint8Array;
+}
+/**
+ * QueryMinLiquidatingPriceMoveRequest is the request type for fetching the
+ * smallest price move that liquidates a subaccount.
+ */
+
+export interface QueryMinLiquidatingPriceMoveRequest {
+  perpetualId: number;
+}
+/**
+ * QueryMinLiquidatingPriceMoveRequest is the request type for fetching the
+ * smallest price move that liquidates a subaccount.
+ */
+
+export interface QueryMinLiquidatingPriceMoveRequestSDKType {
+  perpetual_id: number;
+}
+/**
+ * QueryMinLiquidatingPriceMoveResponse is the response type for fetching the
+ * smallest price move that liquidates a subaccount.
+ */
+
+export interface QueryMinLiquidatingPriceMoveResponse {
+  /** False if no move in the price liquidates a subaccount. */
+  found: boolean;
+  /**
+   * The smallest adverse move in parts-per-million of the current price,
+   * rounded up. Zero if a subaccount is already liquidatable.
+   */
+
+  movePpm: Long;
+  /** The subaccount that becomes liquidatable first. */
+
+  subaccountId?: SubaccountId;
+}
+/**
+ * QueryMinLiquidatingPriceMoveResponse is the response type for fetching the
+ * smallest price move that liquidates a subaccount.
+ */
+
+export interface QueryMinLiquidatingPriceMoveResponseSDKType {
+  /** False if no move in the price liquidates a subaccount. */
+  found: boolean;
+  /**
+   * The smallest adverse move in parts-per-million of the current price,
+   * rounded up. Zero if a subaccount is already liquidatable.
+   */
+
+  move_ppm: Long;
+  /** The subaccount that becomes liquidatable first. */
+
+  subaccount_id?: SubaccountIdSDKType;
+}
+
+function createBaseQueryGetSubaccountRequest(): QueryGetSubaccountRequest {
+  return {
+    owner: "",
+    number: 0
+  };
+}
+
+export const QueryGetSubaccountRequest = {
+  encode(message: QueryGetSubaccountRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.owner !== "") {
+      writer.uint32(10).string(message.owner);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryGetSubaccountRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryGetSubaccountRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.owner = reader.string();
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryGetSubaccountRequest>): QueryGetSubaccountRequest {
+    const message = createBaseQueryGetSubaccountRequest();
+    message.owner = object.owner ?? "";
+    message.number = object.number ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQuerySubaccountResponse(): QuerySubaccountResponse {
+  return {
+    subaccount: undefined
+  };
+}
+
+export const QuerySubaccountResponse = {
+  encode(message: QuerySubaccountResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.subaccount !== undefined) {
+      Subaccount.encode(message.subaccount, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QuerySubaccountResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQuerySubaccountResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.subaccount = Subaccount.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QuerySubaccountResponse>): QuerySubaccountResponse {
+    const message = createBaseQuerySubaccountResponse();
+    message.subaccount = object.subaccount !== undefined && object.subaccount !== null ? Subaccount.fromPartial(object.subaccount) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseQueryAllSubaccountRequest(): QueryAllSubaccountRequest {
+  return {
+    pagination: undefined
+  };
+}
+
+export const QueryAllSubaccountRequest = {
+  encode(message: QueryAllSubaccountRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.pagination !== undefined) {
+      PageRequest.encode(message.pagination, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryAllSubaccountRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryAllSubaccountRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.pagination = PageRequest.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryAllSubaccountRequest>): QueryAllSubaccountRequest {
+    const message = createBaseQueryAllSubaccountRequest();
+    message.pagination = object.pagination !== undefined && object.pagination !== null ? PageRequest.fromPartial(object.pagination) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseQuerySubaccountAllResponse(): QuerySubaccountAllResponse {
+  return {
+    subaccount: [],
+    pagination: undefined
+  };
+}
+
+export const QuerySubaccountAllResponse = {
+  encode(message: QuerySubaccountAllResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.subaccount) {
+      Subaccount.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.pagination !== undefined) {
+      PageResponse.encode(message.pagination, writer.uint32(18).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QuerySubaccountAllResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQuerySubaccountAllResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.subaccount.push(Subaccount.decode(reader, reader.uint32()));
+          break;
+
+        case 2:
+          message.pagination = PageResponse.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QuerySubaccountAllResponse>): QuerySubaccountAllResponse {
+    const message = createBaseQuerySubaccountAllResponse();
+    message.subaccount = object.subaccount?.map(e => Subaccount.fromPartial(e)) || [];
+    message.pagination = object.pagination !== undefined && object.pagination !== null ? PageResponse.fromPartial(object.pagination) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseQueryGetWithdrawalAndTransfersBlockedInfoRequest(): QueryGetWithdrawalAndTransfersBlockedInfoRequest {
+  return {
+    perpetualId: 0
+  };
+}
+
+export const QueryGetWithdrawalAndTransfersBlockedInfoRequest = {
+  encode(message: QueryGetWithdrawalAndTransfersBlockedInfoRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.perpetualId !== 0) {
+      writer.uint32(8).uint32(message.perpetualId);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryGetWithdrawalAndTransfersBlockedInfoRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryGetWithdrawalAndTransfersBlockedInfoRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.perpetualId = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryGetWithdrawalAndTransfersBlockedInfoRequest>): QueryGetWithdrawalAndTransfersBlockedInfoRequest {
+    const message = createBaseQueryGetWithdrawalAndTransfersBlockedInfoRequest();
+    message.perpetualId = object.perpetualId ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryGetWithdrawalAndTransfersBlockedInfoResponse(): QueryGetWithdrawalAndTransfersBlockedInfoResponse {
+  return {
+    negativeTncSubaccountSeenAtBlock: 0,
+    chainOutageSeenAtBlock: 0,
+    withdrawalsAndTransfersUnblockedAtBlock: 0
+  };
+}
+
+export const QueryGetWithdrawalAndTransfersBlockedInfoResponse = {
+  encode(message: QueryGetWithdrawalAndTransfersBlockedInfoResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.negativeTncSubaccountSeenAtBlock !== 0) {
+      writer.uint32(8).uint32(message.negativeTncSubaccountSeenAtBlock);
+    }
+
+    if (message.chainOutageSeenAtBlock !== 0) {
+      writer.uint32(16).uint32(message.chainOutageSeenAtBlock);
+    }
+
+    if (message.withdrawalsAndTransfersUnblockedAtBlock !== 0) {
+      writer.uint32(24).uint32(message.withdrawalsAndTransfersUnblockedAtBlock);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryGetWithdrawalAndTransfersBlockedInfoResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryGetWithdrawalAndTransfersBlockedInfoResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.negativeTncSubaccountSeenAtBlock = reader.uint32();
+          break;
+
+        case 2:
+          message.chainOutageSeenAtBlock = reader.uint32();
+          break;
+
+        case 3:
+          message.withdrawalsAndTransfersUnblockedAtBlock = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryGetWithdrawalAndTransfersBlockedInfoResponse>): QueryGetWithdrawalAndTransfersBlockedInfoResponse {
+    const message = createBaseQueryGetWithdrawalAndTransfersBlockedInfoResponse();
+    message.negativeTncSubaccountSeenAtBlock = object.negativeTncSubaccountSeenAtBlock ?? 0;
+    message.chainOutageSeenAtBlock = object.chainOutageSeenAtBlock ?? 0;
+    message.withdrawalsAndTransfersUnblockedAtBlock = object.withdrawalsAndTransfersUnblockedAtBlock ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryCollateralPoolAddressRequest(): QueryCollateralPoolAddressRequest {
+  return {
+    perpetualId: 0
+  };
+}
+
+export const QueryCollateralPoolAddressRequest = {
+  encode(message: QueryCollateralPoolAddressRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.perpetualId !== 0) {
+      writer.uint32(8).uint32(message.perpetualId);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryCollateralPoolAddressRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryCollateralPoolAddressRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.perpetualId = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryCollateralPoolAddressRequest>): QueryCollateralPoolAddressRequest {
+    const message = createBaseQueryCollateralPoolAddressRequest();
+    message.perpetualId = object.perpetualId ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryCollateralPoolAddressResponse(): QueryCollateralPoolAddressResponse {
+  return {
+    collateralPoolAddress: ""
+  };
+}
+
+export const QueryCollateralPoolAddressResponse = {
+  encode(message: QueryCollateralPoolAddressResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.collateralPoolAddress !== "") {
+      writer.uint32(10).string(message.collateralPoolAddress);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryCollateralPoolAddressResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryCollateralPoolAddressResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.collateralPoolAddress = reader.string();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryCollateralPoolAddressResponse>): QueryCollateralPoolAddressResponse {
+    const message = createBaseQueryCollateralPoolAddressResponse();
+    message.collateralPoolAddress = object.collateralPoolAddress ?? "";
+    return message;
+  }
+
+};
+
+function createBaseHypotheticalAssetUpdate(): HypotheticalAssetUpdate {
+  return {
+    assetId: 0,
+    quantumsDelta: new Uint8Array()
+  };
+}
+
+export const HypotheticalAssetUpdate = {
+  encode(message: HypotheticalAssetUpdate, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.assetId !== 0) {
+      writer.uint32(8).uint32(message.assetId);
+    }
+
+    if (message.quantumsDelta.length !== 0) {
+      writer.uint32(18).bytes(message.quantumsDelta);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): HypotheticalAssetUpdate {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseHypotheticalAssetUpdate();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.assetId = reader.uint32();
+          break;
+
+        case 2:
+          message.quantumsDelta = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<HypotheticalAssetUpdate>): HypotheticalAssetUpdate {
+    const message = createBaseHypotheticalAssetUpdate();
+    message.assetId = object.assetId ?? 0;
+    message.quantumsDelta = object.quantumsDelta ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseHypotheticalPerpetualUpdate(): HypotheticalPerpetualUpdate {
+  return {
+    perpetualId: 0,
+    quantumsDelta: new Uint8Array(),
+    quoteBalanceDelta: new Uint8Array()
+  };
+}
+
+export const HypotheticalPerpetualUpdate = {
+  encode(message: HypotheticalPerpetualUpdate, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.perpetualId !== 0) {
+      writer.uint32(8).uint32(message.perpetualId);
+    }
+
+    if (message.quantumsDelta.length !== 0) {
+      writer.uint32(18).bytes(message.quantumsDelta);
+    }
+
+    if (message.quoteBalanceDelta.length !== 0) {
+      writer.uint32(26).bytes(message.quoteBalanceDelta);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): HypotheticalPerpetualUpdate {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseHypotheticalPerpetualUpdate();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.perpetualId = reader.uint32();
+          break;
+
+        case 2:
+          message.quantumsDelta = reader.bytes();
+          break;
+
+        case 3:
+          message.quoteBalanceDelta = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<HypotheticalPerpetualUpdate>): HypotheticalPerpetualUpdate {
+    const message = createBaseHypotheticalPerpetualUpdate();
+    message.perpetualId = object.perpetualId ?? 0;
+    message.quantumsDelta = object.quantumsDelta ?? new Uint8Array();
+    message.quoteBalanceDelta = object.quoteBalanceDelta ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseQueryComputeRiskForHypotheticalRequest(): QueryComputeRiskForHypotheticalRequest {
+  return {
+    subaccount: undefined,
+    assetUpdates: [],
+    perpetualUpdates: []
+  };
+}
+
+export const QueryComputeRiskForHypotheticalRequest = {
+  encode(message: QueryComputeRiskForHypotheticalRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.subaccount !== undefined) {
+      Subaccount.encode(message.subaccount, writer.uint32(10).fork()).ldelim();
+    }
+
+    for (const v of message.assetUpdates) {
+      HypotheticalAssetUpdate.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+
+    for (const v of message.perpetualUpdates) {
+      HypotheticalPerpetualUpdate.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryComputeRiskForHypotheticalRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryComputeRiskForHypotheticalRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.subaccount = Subaccount.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.assetUpdates.push(HypotheticalAssetUpdate.decode(reader, reader.uint32()));
+          break;
+
+        case 3:
+          message.perpetualUpdates.push(HypotheticalPerpetualUpdate.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryComputeRiskForHypotheticalRequest>): QueryComputeRiskForHypotheticalRequest {
+    const message = createBaseQueryComputeRiskForHypotheticalRequest();
+    message.subaccount = object.subaccount !== undefined && object.subaccount !== null ? Subaccount.fromPartial(object.subaccount) : undefined;
+    message.assetUpdates = object.assetUpdates?.map(e => HypotheticalAssetUpdate.fromPartial(e)) || [];
+    message.perpetualUpdates = object.perpetualUpdates?.map(e => HypotheticalPerpetualUpdate.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
+function createBaseQueryComputeRiskForHypotheticalResponse(): QueryComputeRiskForHypotheticalResponse {
+  return {
+    netCollateral: new Uint8Array(),
+    initialMarginRequirement: new Uint8Array(),
+    maintenanceMarginRequirement: new Uint8Array()
+  };
+}
+
+export const QueryComputeRiskForHypotheticalResponse = {
+  encode(message: QueryComputeRiskForHypotheticalResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.netCollateral.length !== 0) {
+      writer.uint32(10).bytes(message.netCollateral);
+    }
+
+    if (message.initialMarginRequirement.length !== 0) {
+      writer.uint32(18).bytes(message.initialMarginRequirement);
+    }
+
+    if (message.maintenanceMarginRequirement.length !== 0) {
+      writer.uint32(26).bytes(message.maintenanceMarginRequirement);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryComputeRiskForHypotheticalResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryComputeRiskForHypotheticalResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.netCollateral = reader.bytes();
+          break;
+
+        case 2:
+          message.initialMarginRequirement = reader.bytes();
+          break;
+
+        case 3:
+          message.maintenanceMarginRequirement = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryComputeRiskForHypotheticalResponse>): QueryComputeRiskForHypotheticalResponse {
+    const message = createBaseQueryComputeRiskForHypotheticalResponse();
+    message.netCollateral = object.netCollateral ?? new Uint8Array();
+    message.initialMarginRequirement = object.initialMarginRequirement ?? new Uint8Array();
+    message.maintenanceMarginRequirement = object.maintenanceMarginRequirement ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseQueryRiskInputsRequest(): QueryRiskInputsRequest {
+  return {
+    owner: "",
+    number: 0
+  };
+}
+
+export const QueryRiskInputsRequest = {
+  encode(message: QueryRiskInputsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.owner !== "") {
+      writer.uint32(10).string(message.owner);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryRiskInputsRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryRiskInputsRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.owner = reader.string();
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryRiskInputsRequest>): QueryRiskInputsRequest {
+    const message = createBaseQueryRiskInputsRequest();
+    message.owner = object.owner ?? "";
+    message.number = object.number ?? 0;
+    return message;
+  }
+
+};
+
+function createBasePerpetualRiskInputs(): PerpetualRiskInputs {
+  return {
+    perpetual: undefined,
+    marketPrice: undefined,
+    liquidityTier: undefined
+  };
+}
+
+export const PerpetualRiskInputs = {
+  encode(message: PerpetualRiskInputs, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.perpetual !== undefined) {
+      Perpetual.encode(message.perpetual, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.marketPrice !== undefined) {
+      MarketPrice.encode(message.marketPrice, writer.uint32(18).fork()).ldelim();
+    }
+
+    if (message.liquidityTier !== undefined) {
+      LiquidityTier.encode(message.liquidityTier, writer.uint32(26).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PerpetualRiskInputs {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePerpetualRiskInputs();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.perpetual = Perpetual.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.marketPrice = MarketPrice.decode(reader, reader.uint32());
+          break;
+
+        case 3:
+          message.liquidityTier = LiquidityTier.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<PerpetualRiskInputs>): PerpetualRiskInputs {
+    const message = createBasePerpetualRiskInputs();
+    message.perpetual = object.perpetual !== undefined && object.perpetual !== null ? Perpetual.fromPartial(object.perpetual) : undefined;
+    message.marketPrice = object.marketPrice !== undefined && object.marketPrice !== null ? MarketPrice.fromPartial(object.marketPrice) : undefined;
+    message.liquidityTier = object.liquidityTier !== undefined && object.liquidityTier !== null ? LiquidityTier.fromPartial(object.liquidityTier) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseQueryRiskInputsResponse(): QueryRiskInputsResponse {
+  return {
+    settledSubaccount: undefined,
+    perpetuals: [],
+    externalCollateral: new Uint8Array(),
+    lockedCollateral: Long.UZERO
+  };
+}
+
+export const QueryRiskInputsResponse = {
+  encode(message: QueryRiskInputsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.settledSubaccount !== undefined) {
+      Subaccount.encode(message.settledSubaccount, writer.uint32(10).fork()).ldelim();
+    }
+
+    for (const v of message.perpetuals) {
+      PerpetualRiskInputs.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+
+    if (message.externalCollateral.length !== 0) {
+      writer.uint32(26).bytes(message.externalCollateral);
+    }
+
+    if (!message.lockedCollateral.isZero()) {
+      writer.uint32(32).uint64(message.lockedCollateral);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryRiskInputsResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryRiskInputsResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.settledSubaccount = Subaccount.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.perpetuals.push(PerpetualRiskInputs.decode(reader, reader.uint32()));
+          break;
+
+        case 3:
+          message.externalCollateral = reader.bytes();
+          break;
+
+        case 4:
+          message.lockedCollateral = (reader.uint64() as Long);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryRiskInputsResponse>): QueryRiskInputsResponse {
+    const message = createBaseQueryRiskInputsResponse();
+    message.settledSubaccount = object.settledSubaccount !== undefined && object.settledSubaccount !== null ? Subaccount.fromPartial(object.settledSubaccount) : undefined;
+    message.perpetuals = object.perpetuals?.map(e => PerpetualRiskInputs.fromPartial(e)) || [];
+    message.externalCollateral = object.externalCollateral ?? new Uint8Array();
+    message.lockedCollateral = object.lockedCollateral !== undefined && object.lockedCollateral !== null ? Long.fromValue(object.lockedCollateral) : Long.UZERO;
+    return message;
+  }
+
+};
+
+function createBaseQuerySubaccountUtilizationRequest(): QuerySubaccountUtilizationRequest {
+  return {
+    owner: "",
+    number: 0
+  };
+}
+
+export const QuerySubaccountUtilizationRequest = {
+  encode(message: QuerySubaccountUtilizationRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.owner !== "") {
+      writer.uint32(10).string(message.owner);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QuerySubaccountUtilizationRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQuerySubaccountUtilizationRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.owner = reader.string();
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QuerySubaccountUtilizationRequest>): QuerySubaccountUtilizationRequest {
+    const message = createBaseQuerySubaccountUtilizationRequest();
+    message.owner = object.owner ?? "";
+    message.number = object.number ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQuerySubaccountUtilizationResponse(): QuerySubaccountUtilizationResponse {
+  return {
+    utilizationPpm: Long.UZERO,
+    overUtilized: false
+  };
+}
+
+export const QuerySubaccountUtilizationResponse = {
+  encode(message: QuerySubaccountUtilizationResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (!message.utilizationPpm.isZero()) {
+      writer.uint32(8).uint64(message.utilizationPpm);
+    }
+
+    if (message.overUtilized === true) {
+      writer.uint32(16).bool(message.overUtilized);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QuerySubaccountUtilizationResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQuerySubaccountUtilizationResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.utilizationPpm = (reader.uint64() as Long);
+          break;
+
+        case 2:
+          message.overUtilized = reader.bool();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QuerySubaccountUtilizationResponse>): QuerySubaccountUtilizationResponse {
+    const message = createBaseQuerySubaccountUtilizationResponse();
+    message.utilizationPpm = object.utilizationPpm !== undefined && object.utilizationPpm !== null ? Long.fromValue(object.utilizationPpm) : Long.UZERO;
+    message.overUtilized = object.overUtilized ?? false;
+    return message;
+  }
+
+};
+
+function createBaseQueryTotalValueLockedRequest(): QueryTotalValueLockedRequest {
+  return {};
+}
+
+export const QueryTotalValueLockedRequest = {
+  encode(_: QueryTotalValueLockedRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryTotalValueLockedRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryTotalValueLockedRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<QueryTotalValueLockedRequest>): QueryTotalValueLockedRequest {
+    const message = createBaseQueryTotalValueLockedRequest();
+    return message;
+  }
+
+};
+
+function createBaseQueryTotalValueLockedResponse(): QueryTotalValueLockedResponse {
+  return {
+    totalValueLocked: new Uint8Array(),
+    totalNegativeNetCollateral: new Uint8Array()
+  };
+}
+
+export const QueryTotalValueLockedResponse = {
+  encode(message: QueryTotalValueLockedResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.totalValueLocked.length !== 0) {
+      writer.uint32(10).bytes(message.totalValueLocked);
+    }
+
+    if (message.totalNegativeNetCollateral.length !== 0) {
+      writer.uint32(18).bytes(message.totalNegativeNetCollateral);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryTotalValueLockedResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryTotalValueLockedResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.totalValueLocked = reader.bytes();
+          break;
+
+        case 2:
+          message.totalNegativeNetCollateral = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryTotalValueLockedResponse>): QueryTotalValueLockedResponse {
+    const message = createBaseQueryTotalValueLockedResponse();
+    message.totalValueLocked = object.totalValueLocked ?? new Uint8Array();
+    message.totalNegativeNetCollateral = object.totalNegativeNetCollateral ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBasePositionLiquidationPrices(): PositionLiquidationPrices {
+  return {
+    perpetualId: 0,
+    liquidationPrice: Long.UZERO,
+    hasLiquidationPrice: false,
+    bankruptcyPrice: Long.UZERO,
+    hasBankruptcyPrice: false
+  };
+}
+
+export const PositionLiquidationPrices = {
+  encode(message: PositionLiquidationPrices, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.perpetualId !== 0) {
+      writer.uint32(8).uint32(message.perpetualId);
+    }
+
+    if (!message.liquidationPrice.isZero()) {
+      writer.uint32(16).uint64(message.liquidationPrice);
+    }
+
+    if (message.hasLiquidationPrice === true) {
+      writer.uint32(24).bool(message.hasLiquidationPrice);
+    }
+
+    if (!message.bankruptcyPrice.isZero()) {
+      writer.uint32(32).uint64(message.bankruptcyPrice);
+    }
+
+    if (message.hasBankruptcyPrice === true) {
+      writer.uint32(40).bool(message.hasBankruptcyPrice);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PositionLiquidationPrices {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePositionLiquidationPrices();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.perpetualId = reader.uint32();
+          break;
+
+        case 2:
+          message.liquidationPrice = (reader.uint64() as Long);
+          break;
+
+        case 3:
+          message.hasLiquidationPrice = reader.bool();
+          break;
+
+        case 4:
+          message.bankruptcyPrice = (reader.uint64() as Long);
+          break;
+
+        case 5:
+          message.hasBankruptcyPrice = reader.bool();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<PositionLiquidationPrices>): PositionLiquidationPrices {
+    const message = createBasePositionLiquidationPrices();
+    message.perpetualId = object.perpetualId ?? 0;
+    message.liquidationPrice = object.liquidationPrice !== undefined && object.liquidationPrice !== null ? Long.fromValue(object.liquidationPrice) : Long.UZERO;
+    message.hasLiquidationPrice = object.hasLiquidationPrice ?? false;
+    message.bankruptcyPrice = object.bankruptcyPrice !== undefined && object.bankruptcyPrice !== null ? Long.fromValue(object.bankruptcyPrice) : Long.UZERO;
+    message.hasBankruptcyPrice = object.hasBankruptcyPrice ?? false;
+    return message;
+  }
+
+};
+
+function createBaseQuerySubaccountLiquidationPricesRequest(): QuerySubaccountLiquidationPricesRequest {
+  return {
+    owner: "",
+    number: 0
+  };
+}
+
+export const QuerySubaccountLiquidationPricesRequest = {
+  encode(message: QuerySubaccountLiquidationPricesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.owner !== "") {
+      writer.uint32(10).string(message.owner);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QuerySubaccountLiquidationPricesRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQuerySubaccountLiquidationPricesRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.owner = reader.string();
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QuerySubaccountLiquidationPricesRequest>): QuerySubaccountLiquidationPricesRequest {
+    const message = createBaseQuerySubaccountLiquidationPricesRequest();
+    message.owner = object.owner ?? "";
+    message.number = object.number ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQuerySubaccountLiquidationPricesResponse(): QuerySubaccountLiquidationPricesResponse {
+  return {
+    prices: []
+  };
+}
+
+export const QuerySubaccountLiquidationPricesResponse = {
+  encode(message: QuerySubaccountLiquidationPricesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.prices) {
+      PositionLiquidationPrices.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QuerySubaccountLiquidationPricesResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQuerySubaccountLiquidationPricesResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.prices.push(PositionLiquidationPrices.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QuerySubaccountLiquidationPricesResponse>): QuerySubaccountLiquidationPricesResponse {
+    const message = createBaseQuerySubaccountLiquidationPricesResponse();
+    message.prices = object.prices?.map(e => PositionLiquidationPrices.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
+function createBaseBasketWeight(): BasketWeight {
+  return {
+    perpetualId: 0,
+    weightPpm: 0
+  };
+}
+
+export const BasketWeight = {
+  encode(message: BasketWeight, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.perpetualId !== 0) {
+      writer.uint32(8).uint32(message.perpetualId);
+    }
+
+    if (message.weightPpm !== 0) {
+      writer.uint32(16).int32(message.weightPpm);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): BasketWeight {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseBasketWeight();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.perpetualId = reader.uint32();
+          break;
+
+        case 2:
+          message.weightPpm = reader.int32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<BasketWeight>): BasketWeight {
+    const message = createBaseBasketWeight();
+    message.perpetualId = object.perpetualId ?? 0;
+    message.weightPpm = object.weightPpm ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryBasketShockToLiquidateRequest(): QueryBasketShockToLiquidateRequest {
+  return {
+    owner: "",
+    number: 0,
+    weights: []
+  };
+}
+
+export const QueryBasketShockToLiquidateRequest = {
+  encode(message: QueryBasketShockToLiquidateRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.owner !== "") {
+      writer.uint32(10).string(message.owner);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    for (const v of message.weights) {
+      BasketWeight.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryBasketShockToLiquidateRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryBasketShockToLiquidateRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.owner = reader.string();
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        case 3:
+          message.weights.push(BasketWeight.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryBasketShockToLiquidateRequest>): QueryBasketShockToLiquidateRequest {
+    const message = createBaseQueryBasketShockToLiquidateRequest();
+    message.owner = object.owner ?? "";
+    message.number = object.number ?? 0;
+    message.weights = object.weights?.map(e => BasketWeight.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
+function createBaseQueryBasketShockToLiquidateResponse(): QueryBasketShockToLiquidateResponse {
+  return {
+    found: false,
+    shockPpm: Long.ZERO,
+    netCollateral: new Uint8Array(),
+    initialMarginRequirement: new Uint8Array(),
+    maintenanceMarginRequirement: new Uint8Array()
+  };
+}
+
+export const QueryBasketShockToLiquidateResponse = {
+  encode(message: QueryBasketShockToLiquidateResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.found === true) {
+      writer.uint32(8).bool(message.found);
+    }
+
+    if (!message.shockPpm.isZero()) {
+      writer.uint32(16).int64(message.shockPpm);
+    }
+
+    if (message.netCollateral.length !== 0) {
+      writer.uint32(26).bytes(message.netCollateral);
+    }
+
+    if (message.initialMarginRequirement.length !== 0) {
+      writer.uint32(34).bytes(message.initialMarginRequirement);
+    }
+
+    if (message.maintenanceMarginRequirement.length !== 0) {
+      writer.uint32(42).bytes(message.maintenanceMarginRequirement);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryBasketShockToLiquidateResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryBasketShockToLiquidateResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.found = reader.bool();
+          break;
+
+        case 2:
+          message.shockPpm = (reader.int64() as Long);
+          break;
+
+        case 3:
+          message.netCollateral = reader.bytes();
+          break;
+
+        case 4:
+          message.initialMarginRequirement = reader.bytes();
+          break;
+
+        case 5:
+          message.maintenanceMarginRequirement = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryBasketShockToLiquidateResponse>): QueryBasketShockToLiquidateResponse {
+    const message = createBaseQueryBasketShockToLiquidateResponse();
+    message.found = object.found ?? false;
+    message.shockPpm = object.shockPpm !== undefined && object.shockPpm !== null ? Long.fromValue(object.shockPpm) : Long.ZERO;
+    message.netCollateral = object.netCollateral ?? new Uint8Array();
+    message.initialMarginRequirement = object.initialMarginRequirement ?? new Uint8Array();
+    message.maintenanceMarginRequirement = object.maintenanceMarginRequirement ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBasePerpetualMaintenanceMargin(): PerpetualMaintenanceMargin {
+  return {
+    perpetualId: 0,
+    maintenanceMargin: new Uint8Array()
+  };
+}
+
+export const PerpetualMaintenanceMargin = {
+  encode(message: PerpetualMaintenanceMargin, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.perpetualId !== 0) {
+      writer.uint32(8).uint32(message.perpetualId);
+    }
+
+    if (message.maintenanceMargin.length !== 0) {
+      writer.uint32(18).bytes(message.maintenanceMargin);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PerpetualMaintenanceMargin {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePerpetualMaintenanceMargin();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.perpetualId = reader.uint32();
+          break;
+
+        case 2:
+          message.maintenanceMargin = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<PerpetualMaintenanceMargin>): PerpetualMaintenanceMargin {
+    const message = createBasePerpetualMaintenanceMargin();
+    message.perpetualId = object.perpetualId ?? 0;
+    message.maintenanceMargin = object.maintenanceMargin ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseQueryTotalMaintenanceMarginRequest(): QueryTotalMaintenanceMarginRequest {
+  return {
+    byPerpetual: false
+  };
+}
+
+export const QueryTotalMaintenanceMarginRequest = {
+  encode(message: QueryTotalMaintenanceMarginRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.byPerpetual === true) {
+      writer.uint32(8).bool(message.byPerpetual);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryTotalMaintenanceMarginRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryTotalMaintenanceMarginRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.byPerpetual = reader.bool();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryTotalMaintenanceMarginRequest>): QueryTotalMaintenanceMarginRequest {
+    const message = createBaseQueryTotalMaintenanceMarginRequest();
+    message.byPerpetual = object.byPerpetual ?? false;
+    return message;
+  }
+
+};
+
+function createBaseQueryTotalMaintenanceMarginResponse(): QueryTotalMaintenanceMarginResponse {
+  return {
+    total: new Uint8Array(),
+    perpetuals: []
+  };
+}
+
+export const QueryTotalMaintenanceMarginResponse = {
+  encode(message: QueryTotalMaintenanceMarginResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.total.length !== 0) {
+      writer.uint32(10).bytes(message.total);
+    }
+
+    for (const v of message.perpetuals) {
+      PerpetualMaintenanceMargin.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryTotalMaintenanceMarginResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryTotalMaintenanceMarginResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.total = reader.bytes();
+          break;
+
+        case 2:
+          message.perpetuals.push(PerpetualMaintenanceMargin.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryTotalMaintenanceMarginResponse>): QueryTotalMaintenanceMarginResponse {
+    const message = createBaseQueryTotalMaintenanceMarginResponse();
+    message.total = object.total ?? new Uint8Array();
+    message.perpetuals = object.perpetuals?.map(e => PerpetualMaintenanceMargin.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
+function createBaseQueryPositionNotionalRequest(): QueryPositionNotionalRequest {
+  return {
+    owner: "",
+    number: 0,
+    perpetualId: 0
+  };
+}
+
+export const QueryPositionNotionalRequest = {
+  encode(message: QueryPositionNotionalRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.owner !== "") {
+      writer.uint32(10).string(message.owner);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    if (message.perpetualId !== 0) {
+      writer.uint32(24).uint32(message.perpetualId);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryPositionNotionalRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryPositionNotionalRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.owner = reader.string();
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        case 3:
+          message.perpetualId = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryPositionNotionalRequest>): QueryPositionNotionalRequest {
+    const message = createBaseQueryPositionNotionalRequest();
+    message.owner = object.owner ?? "";
+    message.number = object.number ?? 0;
+    message.perpetualId = object.perpetualId ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryPositionNotionalResponse(): QueryPositionNotionalResponse {
+  return {
+    notional: new Uint8Array()
+  };
+}
+
+export const QueryPositionNotionalResponse = {
+  encode(message: QueryPositionNotionalResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.notional.length !== 0) {
+      writer.uint32(10).bytes(message.notional);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryPositionNotionalResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryPositionNotionalResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.notional = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryPositionNotionalResponse>): QueryPositionNotionalResponse {
+    const message = createBaseQueryPositionNotionalResponse();
+    message.notional = object.notional ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseQueryMarketExponentMigrationImpactRequest(): QueryMarketExponentMigrationImpactRequest {
+  return {
+    marketId: 0,
+    proposedExponent: 0
+  };
+}
+
+export const QueryMarketExponentMigrationImpactRequest = {
+  encode(message: QueryMarketExponentMigrationImpactRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.marketId !== 0) {
+      writer.uint32(8).uint32(message.marketId);
+    }
+
+    if (message.proposedExponent !== 0) {
+      writer.uint32(16).int32(message.proposedExponent);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryMarketExponentMigrationImpactRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryMarketExponentMigrationImpactRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.marketId = reader.uint32();
+          break;
+
+        case 2:
+          message.proposedExponent = reader.int32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryMarketExponentMigrationImpactRequest>): QueryMarketExponentMigrationImpactRequest {
+    const message = createBaseQueryMarketExponentMigrationImpactRequest();
+    message.marketId = object.marketId ?? 0;
+    message.proposedExponent = object.proposedExponent ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryMarketExponentMigrationImpactResponse(): QueryMarketExponentMigrationImpactResponse {
+  return {
+    currentNotional: new Uint8Array(),
+    proposedNotional: new Uint8Array(),
+    newlyLiquidatable: []
+  };
+}
+
+export const QueryMarketExponentMigrationImpactResponse = {
+  encode(message: QueryMarketExponentMigrationImpactResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.currentNotional.length !== 0) {
+      writer.uint32(10).bytes(message.currentNotional);
+    }
+
+    if (message.proposedNotional.length !== 0) {
+      writer.uint32(18).bytes(message.proposedNotional);
+    }
+
+    for (const v of message.newlyLiquidatable) {
+      SubaccountId.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryMarketExponentMigrationImpactResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryMarketExponentMigrationImpactResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.currentNotional = reader.bytes();
+          break;
+
+        case 2:
+          message.proposedNotional = reader.bytes();
+          break;
+
+        case 3:
+          message.newlyLiquidatable.push(SubaccountId.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryMarketExponentMigrationImpactResponse>): QueryMarketExponentMigrationImpactResponse {
+    const message = createBaseQueryMarketExponentMigrationImpactResponse();
+    message.currentNotional = object.currentNotional ?? new Uint8Array();
+    message.proposedNotional = object.proposedNotional ?? new Uint8Array();
+    message.newlyLiquidatable = object.newlyLiquidatable?.map(e => SubaccountId.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
+function createBaseQueryRiskAtHeightRequest(): QueryRiskAtHeightRequest {
+  return {
+    owner: "",
+    number: 0,
+    height: Long.ZERO
+  };
+}
+
+export const QueryRiskAtHeightRequest = {
+  encode(message: QueryRiskAtHeightRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.owner !== "") {
+      writer.uint32(10).string(message.owner);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    if (!message.height.isZero()) {
+      writer.uint32(24).int64(message.height);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryRiskAtHeightRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryRiskAtHeightRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.owner = reader.string();
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        case 3:
+          message.height = (reader.int64() as Long);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryRiskAtHeightRequest>): QueryRiskAtHeightRequest {
+    const message = createBaseQueryRiskAtHeightRequest();
+    message.owner = object.owner ?? "";
+    message.number = object.number ?? 0;
+    message.height = object.height !== undefined && object.height !== null ? Long.fromValue(object.height) : Long.ZERO;
+    return message;
+  }
+
+};
+
+function createBaseQueryRiskAtHeightResponse(): QueryRiskAtHeightResponse {
+  return {
+    netCollateral: new Uint8Array(),
+    initialMarginRequirement: new Uint8Array(),
+    maintenanceMarginRequirement: new Uint8Array()
+  };
+}
+
+export const QueryRiskAtHeightResponse = {
+  encode(message: QueryRiskAtHeightResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.netCollateral.length !== 0) {
+      writer.uint32(10).bytes(message.netCollateral);
+    }
+
+    if (message.initialMarginRequirement.length !== 0) {
+      writer.uint32(18).bytes(message.initialMarginRequirement);
+    }
+
+    if (message.maintenanceMarginRequirement.length !== 0) {
+      writer.uint32(26).bytes(message.maintenanceMarginRequirement);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryRiskAtHeightResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryRiskAtHeightResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.netCollateral = reader.bytes();
+          break;
+
+        case 2:
+          message.initialMarginRequirement = reader.bytes();
+          break;
+
+        case 3:
+          message.maintenanceMarginRequirement = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryRiskAtHeightResponse>): QueryRiskAtHeightResponse {
+    const message = createBaseQueryRiskAtHeightResponse();
+    message.netCollateral = object.netCollateral ?? new Uint8Array();
+    message.initialMarginRequirement = object.initialMarginRequirement ?? new Uint8Array();
+    message.maintenanceMarginRequirement = object.maintenanceMarginRequirement ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseQueryHealthDistributionRequest(): QueryHealthDistributionRequest {
+  return {
+    buckets: []
+  };
+}
+
+export const QueryHealthDistributionRequest = {
+  encode(message: QueryHealthDistributionRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    writer.uint32(10).fork();
+
+    for (const v of message.buckets) {
+      writer.uint32(v);
+    }
+
+    writer.ldelim();
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryHealthDistributionRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryHealthDistributionRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          if ((tag & 7) === 2) {
+            const end2 = reader.uint32() + reader.pos;
+
+            while (reader.pos < end2) {
+              message.buckets.push(reader.uint32());
+            }
+          } else {
+            message.buckets.push(reader.uint32());
+          }
+
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryHealthDistributionRequest>): QueryHealthDistributionRequest {
+    const message = createBaseQueryHealthDistributionRequest();
+    message.buckets = object.buckets?.map(e => e) || [];
+    return message;
+  }
+
+};
+
+function createBaseQueryHealthDistributionResponse(): QueryHealthDistributionResponse {
+  return {
+    counts: []
+  };
+}
+
+export const QueryHealthDistributionResponse = {
+  encode(message: QueryHealthDistributionResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    writer.uint32(10).fork();
+
+    for (const v of message.counts) {
+      writer.uint64(v);
+    }
+
+    writer.ldelim();
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryHealthDistributionResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryHealthDistributionResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          if ((tag & 7) === 2) {
+            const end2 = reader.uint32() + reader.pos;
+
+            while (reader.pos < end2) {
+              message.counts.push((reader.uint64() as Long));
+            }
+          } else {
+            message.counts.push((reader.uint64() as Long));
+          }
+
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryHealthDistributionResponse>): QueryHealthDistributionResponse {
+    const message = createBaseQueryHealthDistributionResponse();
+    message.counts = object.counts?.map(e => Long.fromValue(e)) || [];
+    return message;
+  }
+
+};
+
+function createBaseQueryMarketNcSensitivityRequest(): QueryMarketNcSensitivityRequest {
+  return {
+    perpetualId: 0
+  };
+}
+
+export const QueryMarketNcSensitivityRequest = {
+  encode(message: QueryMarketNcSensitivityRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.perpetualId !== 0) {
+      writer.uint32(8).uint32(message.perpetualId);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryMarketNcSensitivityRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryMarketNcSensitivityRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.perpetualId = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryMarketNcSensitivityRequest>): QueryMarketNcSensitivityRequest {
+    const message = createBaseQueryMarketNcSensitivityRequest();
+    message.perpetualId = object.perpetualId ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryMarketNcSensitivityResponse(): QueryMarketNcSensitivityResponse {
+  return {
+    netQuantums: new Uint8Array(),
+    ncPerPriceUnit: ""
+  };
+}
+
+export const QueryMarketNcSensitivityResponse = {
+  encode(message: QueryMarketNcSensitivityResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.netQuantums.length !== 0) {
+      writer.uint32(10).bytes(message.netQuantums);
+    }
+
+    if (message.ncPerPriceUnit !== "") {
+      writer.uint32(18).string(message.ncPerPriceUnit);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryMarketNcSensitivityResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryMarketNcSensitivityResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.netQuantums = reader.bytes();
+          break;
+
+        case 2:
+          message.ncPerPriceUnit = reader.string();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryMarketNcSensitivityResponse>): QueryMarketNcSensitivityResponse {
+    const message = createBaseQueryMarketNcSensitivityResponse();
+    message.netQuantums = object.netQuantums ?? new Uint8Array();
+    message.ncPerPriceUnit = object.ncPerPriceUnit ?? "";
+    return message;
+  }
+
+};
+
+function createBaseQueryWithdrawableCheckRequest(): QueryWithdrawableCheckRequest {
+  return {
+    owner: "",
+    number: 0,
+    quoteQuantums: Long.UZERO
+  };
+}
+
+export const QueryWithdrawableCheckRequest = {
+  encode(message: QueryWithdrawableCheckRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.owner !== "") {
+      writer.uint32(10).string(message.owner);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    if (!message.quoteQuantums.isZero()) {
+      writer.uint32(24).uint64(message.quoteQuantums);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryWithdrawableCheckRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryWithdrawableCheckRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.owner = reader.string();
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        case 3:
+          message.quoteQuantums = (reader.uint64() as Long);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryWithdrawableCheckRequest>): QueryWithdrawableCheckRequest {
+    const message = createBaseQueryWithdrawableCheckRequest();
+    message.owner = object.owner ?? "";
+    message.number = object.number ?? 0;
+    message.quoteQuantums = object.quoteQuantums !== undefined && object.quoteQuantums !== null ? Long.fromValue(object.quoteQuantums) : Long.UZERO;
+    return message;
+  }
+
+};
+
+function createBaseQueryWithdrawableCheckResponse(): QueryWithdrawableCheckResponse {
+  return {
+    allowed: false,
+    maxWithdrawable: new Uint8Array()
+  };
+}
+
+export const QueryWithdrawableCheckResponse = {
+  encode(message: QueryWithdrawableCheckResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.allowed === true) {
+      writer.uint32(8).bool(message.allowed);
+    }
+
+    if (message.maxWithdrawable.length !== 0) {
+      writer.uint32(18).bytes(message.maxWithdrawable);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryWithdrawableCheckResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryWithdrawableCheckResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.allowed = reader.bool();
+          break;
+
+        case 2:
+          message.maxWithdrawable = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryWithdrawableCheckResponse>): QueryWithdrawableCheckResponse {
+    const message = createBaseQueryWithdrawableCheckResponse();
+    message.allowed = object.allowed ?? false;
+    message.maxWithdrawable = object.maxWithdrawable ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseQueryRealizedPnlOnCloseRequest(): QueryRealizedPnlOnCloseRequest {
+  return {
+    owner: "",
+    number: 0,
+    perpetualId: 0
+  };
+}
+
+export const QueryRealizedPnlOnCloseRequest = {
+  encode(message: QueryRealizedPnlOnCloseRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.owner !== "") {
+      writer.uint32(10).string(message.owner);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    if (message.perpetualId !== 0) {
+      writer.uint32(24).uint32(message.perpetualId);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryRealizedPnlOnCloseRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryRealizedPnlOnCloseRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.owner = reader.string();
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        case 3:
+          message.perpetualId = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryRealizedPnlOnCloseRequest>): QueryRealizedPnlOnCloseRequest {
+    const message = createBaseQueryRealizedPnlOnCloseRequest();
+    message.owner = object.owner ?? "";
+    message.number = object.number ?? 0;
+    message.perpetualId = object.perpetualId ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryRealizedPnlOnCloseResponse(): QueryRealizedPnlOnCloseResponse {
+  return {
+    pnl: new Uint8Array()
+  };
+}
+
+export const QueryRealizedPnlOnCloseResponse = {
+  encode(message: QueryRealizedPnlOnCloseResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.pnl.length !== 0) {
+      writer.uint32(10).bytes(message.pnl);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryRealizedPnlOnCloseResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryRealizedPnlOnCloseResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.pnl = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryRealizedPnlOnCloseResponse>): QueryRealizedPnlOnCloseResponse {
+    const message = createBaseQueryRealizedPnlOnCloseResponse();
+    message.pnl = object.pnl ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseQueryProtocolNetDeltaRequest(): QueryProtocolNetDeltaRequest {
+  return {
+    perpetualId: 0
+  };
+}
+
+export const QueryProtocolNetDeltaRequest = {
+  encode(message: QueryProtocolNetDeltaRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.perpetualId !== 0) {
+      writer.uint32(8).uint32(message.perpetualId);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryProtocolNetDeltaRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryProtocolNetDeltaRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.perpetualId = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryProtocolNetDeltaRequest>): QueryProtocolNetDeltaRequest {
+    const message = createBaseQueryProtocolNetDeltaRequest();
+    message.perpetualId = object.perpetualId ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryProtocolNetDeltaResponse(): QueryProtocolNetDeltaResponse {
+  return {
+    netDelta: new Uint8Array()
+  };
+}
+
+export const QueryProtocolNetDeltaResponse = {
+  encode(message: QueryProtocolNetDeltaResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.netDelta.length !== 0) {
+      writer.uint32(10).bytes(message.netDelta);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryProtocolNetDeltaResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryProtocolNetDeltaResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.netDelta = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryProtocolNetDeltaResponse>): QueryProtocolNetDeltaResponse {
+    const message = createBaseQueryProtocolNetDeltaResponse();
+    message.netDelta = object.netDelta ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseEpochFunding(): EpochFunding {
+  return {
+    epoch: 0,
+    fundingPaid: new Uint8Array()
+  };
+}
+
+export const EpochFunding = {
+  encode(message: EpochFunding, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.epoch !== 0) {
+      writer.uint32(8).uint32(message.epoch);
+    }
+
+    if (message.fundingPaid.length !== 0) {
+      writer.uint32(18).bytes(message.fundingPaid);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): EpochFunding {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseEpochFunding();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.epoch = reader.uint32();
+          break;
+
+        case 2:
+          message.fundingPaid = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<EpochFunding>): EpochFunding {
+    const message = createBaseEpochFunding();
+    message.epoch = object.epoch ?? 0;
+    message.fundingPaid = object.fundingPaid ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseQueryFundingHistoryRequest(): QueryFundingHistoryRequest {
+  return {
+    owner: "",
+    number: 0,
+    perpetualId: 0,
+    fromEpoch: 0,
+    toEpoch: 0
+  };
+}
+
+export const QueryFundingHistoryRequest = {
+  encode(message: QueryFundingHistoryRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.owner !== "") {
+      writer.uint32(10).string(message.owner);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    if (message.perpetualId !== 0) {
+      writer.uint32(24).uint32(message.perpetualId);
+    }
+
+    if (message.fromEpoch !== 0) {
+      writer.uint32(32).uint32(message.fromEpoch);
+    }
+
+    if (message.toEpoch !== 0) {
+      writer.uint32(40).uint32(message.toEpoch);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryFundingHistoryRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryFundingHistoryRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.owner = reader.string();
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        case 3:
+          message.perpetualId = reader.uint32();
+          break;
+
+        case 4:
+          message.fromEpoch = reader.uint32();
+          break;
+
+        case 5:
+          message.toEpoch = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryFundingHistoryRequest>): QueryFundingHistoryRequest {
+    const message = createBaseQueryFundingHistoryRequest();
+    message.owner = object.owner ?? "";
+    message.number = object.number ?? 0;
+    message.perpetualId = object.perpetualId ?? 0;
+    message.fromEpoch = object.fromEpoch ?? 0;
+    message.toEpoch = object.toEpoch ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryFundingHistoryResponse(): QueryFundingHistoryResponse {
+  return {
+    entries: []
+  };
+}
+
+export const QueryFundingHistoryResponse = {
+  encode(message: QueryFundingHistoryResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.entries) {
+      EpochFunding.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryFundingHistoryResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryFundingHistoryResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.entries.push(EpochFunding.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryFundingHistoryResponse>): QueryFundingHistoryResponse {
+    const message = createBaseQueryFundingHistoryResponse();
+    message.entries = object.entries?.map(e => EpochFunding.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
+function createBaseInitialMarginRestoringClose(): InitialMarginRestoringClose {
+  return {
+    perpetualId: 0,
+    quantums: new Uint8Array(),
+    absNotional: new Uint8Array()
+  };
+}
+
+export const InitialMarginRestoringClose = {
+  encode(message: InitialMarginRestoringClose, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.perpetualId !== 0) {
+      writer.uint32(8).uint32(message.perpetualId);
+    }
+
+    if (message.quantums.length !== 0) {
+      writer.uint32(18).bytes(message.quantums);
+    }
+
+    if (message.absNotional.length !== 0) {
+      writer.uint32(26).bytes(message.absNotional);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): InitialMarginRestoringClose {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseInitialMarginRestoringClose();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.perpetualId = reader.uint32();
+          break;
+
+        case 2:
+          message.quantums = reader.bytes();
+          break;
+
+        case 3:
+          message.absNotional = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<InitialMarginRestoringClose>): InitialMarginRestoringClose {
+    const message = createBaseInitialMarginRestoringClose();
+    message.perpetualId = object.perpetualId ?? 0;
+    message.quantums = object.quantums ?? new Uint8Array();
+    message.absNotional = object.absNotional ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseQueryActionToRestoreInitialMarginRequest(): QueryActionToRestoreInitialMarginRequest {
+  return {
+    owner: "",
+    number: 0
+  };
+}
+
+export const QueryActionToRestoreInitialMarginRequest = {
+  encode(message: QueryActionToRestoreInitialMarginRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.owner !== "") {
+      writer.uint32(10).string(message.owner);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryActionToRestoreInitialMarginRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryActionToRestoreInitialMarginRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.owner = reader.string();
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryActionToRestoreInitialMarginRequest>): QueryActionToRestoreInitialMarginRequest {
+    const message = createBaseQueryActionToRestoreInitialMarginRequest();
+    message.owner = object.owner ?? "";
+    message.number = object.number ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryActionToRestoreInitialMarginResponse(): QueryActionToRestoreInitialMarginResponse {
+  return {
+    initiallyCollateralized: false,
+    closes: [],
+    cheapest: undefined
+  };
+}
+
+export const QueryActionToRestoreInitialMarginResponse = {
+  encode(message: QueryActionToRestoreInitialMarginResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.initiallyCollateralized === true) {
+      writer.uint32(8).bool(message.initiallyCollateralized);
+    }
+
+    for (const v of message.closes) {
+      InitialMarginRestoringClose.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+
+    if (message.cheapest !== undefined) {
+      InitialMarginRestoringClose.encode(message.cheapest, writer.uint32(26).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryActionToRestoreInitialMarginResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryActionToRestoreInitialMarginResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.initiallyCollateralized = reader.bool();
+          break;
+
+        case 2:
+          message.closes.push(InitialMarginRestoringClose.decode(reader, reader.uint32()));
+          break;
+
+        case 3:
+          message.cheapest = InitialMarginRestoringClose.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryActionToRestoreInitialMarginResponse>): QueryActionToRestoreInitialMarginResponse {
+    const message = createBaseQueryActionToRestoreInitialMarginResponse();
+    message.initiallyCollateralized = object.initiallyCollateralized ?? false;
+    message.closes = object.closes?.map(e => InitialMarginRestoringClose.fromPartial(e)) || [];
+    message.cheapest = object.cheapest !== undefined && object.cheapest !== null ? InitialMarginRestoringClose.fromPartial(object.cheapest) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseQueryLossBufferToLiquidationRequest(): QueryLossBufferToLiquidationRequest {
+  return {
+    owner: "",
+    number: 0,
+    perpetualId: 0
+  };
+}
+
+export const QueryLossBufferToLiquidationRequest = {
+  encode(message: QueryLossBufferToLiquidationRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.owner !== "") {
+      writer.uint32(10).string(message.owner);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    if (message.perpetualId !== 0) {
+      writer.uint32(24).uint32(message.perpetualId);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryLossBufferToLiquidationRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryLossBufferToLiquidationRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.owner = reader.string();
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        case 3:
+          message.perpetualId = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryLossBufferToLiquidationRequest>): QueryLossBufferToLiquidationRequest {
+    const message = createBaseQueryLossBufferToLiquidationRequest();
+    message.owner = object.owner ?? "";
+    message.number = object.number ?? 0;
+    message.perpetualId = object.perpetualId ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryLossBufferToLiquidationResponse(): QueryLossBufferToLiquidationResponse {
+  return {
+    found: false,
+    lossBuffer: new Uint8Array(),
+    priceMove: Long.UZERO
+  };
+}
+
+export const QueryLossBufferToLiquidationResponse = {
+  encode(message: QueryLossBufferToLiquidationResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.found === true) {
+      writer.uint32(8).bool(message.found);
+    }
+
+    if (message.lossBuffer.length !== 0) {
+      writer.uint32(18).bytes(message.lossBuffer);
+    }
+
+    if (!message.priceMove.isZero()) {
+      writer.uint32(24).uint64(message.priceMove);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryLossBufferToLiquidationResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryLossBufferToLiquidationResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.found = reader.bool();
+          break;
+
+        case 2:
+          message.lossBuffer = reader.bytes();
+          break;
+
+        case 3:
+          message.priceMove = (reader.uint64() as Long);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryLossBufferToLiquidationResponse>): QueryLossBufferToLiquidationResponse {
+    const message = createBaseQueryLossBufferToLiquidationResponse();
+    message.found = object.found ?? false;
+    message.lossBuffer = object.lossBuffer ?? new Uint8Array();
+    message.priceMove = object.priceMove !== undefined && object.priceMove !== null ? Long.fromValue(object.priceMove) : Long.UZERO;
+    return message;
+  }
+
+};
+
+function createBaseQueryMarketUnrealizedPnlRequest(): QueryMarketUnrealizedPnlRequest {
+  return {
+    perpetualId: 0
+  };
+}
+
+export const QueryMarketUnrealizedPnlRequest = {
+  encode(message: QueryMarketUnrealizedPnlRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.perpetualId !== 0) {
+      writer.uint32(8).uint32(message.perpetualId);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryMarketUnrealizedPnlRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryMarketUnrealizedPnlRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.perpetualId = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryMarketUnrealizedPnlRequest>): QueryMarketUnrealizedPnlRequest {
+    const message = createBaseQueryMarketUnrealizedPnlRequest();
+    message.perpetualId = object.perpetualId ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryMarketUnrealizedPnlResponse(): QueryMarketUnrealizedPnlResponse {
+  return {
+    pnl: new Uint8Array()
+  };
+}
+
+export const QueryMarketUnrealizedPnlResponse = {
+  encode(message: QueryMarketUnrealizedPnlResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.pnl.length !== 0) {
+      writer.uint32(10).bytes(message.pnl);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryMarketUnrealizedPnlResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryMarketUnrealizedPnlResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.pnl = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryMarketUnrealizedPnlResponse>): QueryMarketUnrealizedPnlResponse {
+    const message = createBaseQueryMarketUnrealizedPnlResponse();
+    message.pnl = object.pnl ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseAutoWithdrawableAccount(): AutoWithdrawableAccount {
+  return {
+    subaccountId: undefined,
+    quoteQuantums: new Uint8Array()
+  };
+}
+
+export const AutoWithdrawableAccount = {
+  encode(message: AutoWithdrawableAccount, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.subaccountId !== undefined) {
+      SubaccountId.encode(message.subaccountId, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.quoteQuantums.length !== 0) {
+      writer.uint32(18).bytes(message.quoteQuantums);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AutoWithdrawableAccount {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAutoWithdrawableAccount();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.subaccountId = SubaccountId.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.quoteQuantums = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<AutoWithdrawableAccount>): AutoWithdrawableAccount {
+    const message = createBaseAutoWithdrawableAccount();
+    message.subaccountId = object.subaccountId !== undefined && object.subaccountId !== null ? SubaccountId.fromPartial(object.subaccountId) : undefined;
+    message.quoteQuantums = object.quoteQuantums ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseQueryAutoWithdrawableAccountsRequest(): QueryAutoWithdrawableAccountsRequest {
+  return {
+    thresholdQuoteQuantums: Long.UZERO
+  };
+}
+
+export const QueryAutoWithdrawableAccountsRequest = {
+  encode(message: QueryAutoWithdrawableAccountsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (!message.thresholdQuoteQuantums.isZero()) {
+      writer.uint32(8).uint64(message.thresholdQuoteQuantums);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryAutoWithdrawableAccountsRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryAutoWithdrawableAccountsRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.thresholdQuoteQuantums = (reader.uint64() as Long);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryAutoWithdrawableAccountsRequest>): QueryAutoWithdrawableAccountsRequest {
+    const message = createBaseQueryAutoWithdrawableAccountsRequest();
+    message.thresholdQuoteQuantums = object.thresholdQuoteQuantums !== undefined && object.thresholdQuoteQuantums !== null ? Long.fromValue(object.thresholdQuoteQuantums) : Long.UZERO;
+    return message;
+  }
+
+};
+
+function createBaseQueryAutoWithdrawableAccountsResponse(): QueryAutoWithdrawableAccountsResponse {
+  return {
+    accounts: []
+  };
+}
+
+export const QueryAutoWithdrawableAccountsResponse = {
+  encode(message: QueryAutoWithdrawableAccountsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.accounts) {
+      AutoWithdrawableAccount.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryAutoWithdrawableAccountsResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryAutoWithdrawableAccountsResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.accounts.push(AutoWithdrawableAccount.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryAutoWithdrawableAccountsResponse>): QueryAutoWithdrawableAccountsResponse {
+    const message = createBaseQueryAutoWithdrawableAccountsResponse();
+    message.accounts = object.accounts?.map(e => AutoWithdrawableAccount.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
+function createBaseQueryCloseAllCostRequest(): QueryCloseAllCostRequest {
+  return {
+    owner: "",
+    number: 0
+  };
+}
+
+export const QueryCloseAllCostRequest = {
+  encode(message: QueryCloseAllCostRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.owner !== "") {
+      writer.uint32(10).string(message.owner);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryCloseAllCostRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryCloseAllCostRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.owner = reader.string();
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryCloseAllCostRequest>): QueryCloseAllCostRequest {
+    const message = createBaseQueryCloseAllCostRequest();
+    message.owner = object.owner ?? "";
+    message.number = object.number ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryCloseAllCostResponse(): QueryCloseAllCostResponse {
+  return {
+    fees: new Uint8Array(),
+    postCloseNetCollateral: new Uint8Array()
+  };
+}
+
+export const QueryCloseAllCostResponse = {
+  encode(message: QueryCloseAllCostResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.fees.length !== 0) {
+      writer.uint32(10).bytes(message.fees);
+    }
+
+    if (message.postCloseNetCollateral.length !== 0) {
+      writer.uint32(18).bytes(message.postCloseNetCollateral);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryCloseAllCostResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryCloseAllCostResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.fees = reader.bytes();
+          break;
+
+        case 2:
+          message.postCloseNetCollateral = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryCloseAllCostResponse>): QueryCloseAllCostResponse {
+    const message = createBaseQueryCloseAllCostResponse();
+    message.fees = object.fees ?? new Uint8Array();
+    message.postCloseNetCollateral = object.postCloseNetCollateral ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseQueryLiquidatableAccountsRequest(): QueryLiquidatableAccountsRequest {
+  return {};
+}
+
+export const QueryLiquidatableAccountsRequest = {
+  encode(_: QueryLiquidatableAccountsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryLiquidatableAccountsRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryLiquidatableAccountsRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<QueryLiquidatableAccountsRequest>): QueryLiquidatableAccountsRequest {
+    const message = createBaseQueryLiquidatableAccountsRequest();
+    return message;
+  }
+
+};
+
+function createBaseQueryLiquidatableAccountsResponse(): QueryLiquidatableAccountsResponse {
+  return {
+    subaccountIds: []
+  };
+}
+
+export const QueryLiquidatableAccountsResponse = {
+  encode(message: QueryLiquidatableAccountsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.subaccountIds) {
+      SubaccountId.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryLiquidatableAccountsResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryLiquidatableAccountsResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.subaccountIds.push(SubaccountId.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryLiquidatableAccountsResponse>): QueryLiquidatableAccountsResponse {
+    const message = createBaseQueryLiquidatableAccountsResponse();
+    message.subaccountIds = object.subaccountIds?.map(e => SubaccountId.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
+function createBaseQueryBreakEvenPriceRequest(): QueryBreakEvenPriceRequest {
+  return {
+    owner: "",
+    number: 0,
+    perpetualId: 0
+  };
+}
+
+export const QueryBreakEvenPriceRequest = {
+  encode(message: QueryBreakEvenPriceRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.owner !== "") {
+      writer.uint32(10).string(message.owner);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    if (message.perpetualId !== 0) {
+      writer.uint32(24).uint32(message.perpetualId);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryBreakEvenPriceRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryBreakEvenPriceRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.owner = reader.string();
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        case 3:
+          message.perpetualId = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryBreakEvenPriceRequest>): QueryBreakEvenPriceRequest {
+    const message = createBaseQueryBreakEvenPriceRequest();
+    message.owner = object.owner ?? "";
+    message.number = object.number ?? 0;
+    message.perpetualId = object.perpetualId ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryBreakEvenPriceResponse(): QueryBreakEvenPriceResponse {
+  return {
+    price: ""
+  };
+}
+
+export const QueryBreakEvenPriceResponse = {
+  encode(message: QueryBreakEvenPriceResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.price !== "") {
+      writer.uint32(10).string(message.price);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryBreakEvenPriceResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryBreakEvenPriceResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.price = reader.string();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryBreakEvenPriceResponse>): QueryBreakEvenPriceResponse {
+    const message = createBaseQueryBreakEvenPriceResponse();
+    message.price = object.price ?? "";
+    return message;
+  }
+
+};
+
+function createBaseQueryFlatteningSizeRequest(): QueryFlatteningSizeRequest {
+  return {
+    owner: "",
+    perpetualId: 0
+  };
+}
+
+export const QueryFlatteningSizeRequest = {
+  encode(message: QueryFlatteningSizeRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.owner !== "") {
+      writer.uint32(10).string(message.owner);
+    }
+
+    if (message.perpetualId !== 0) {
+      writer.uint32(16).uint32(message.perpetualId);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryFlatteningSizeRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryFlatteningSizeRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.owner = reader.string();
+          break;
+
+        case 2:
+          message.perpetualId = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryFlatteningSizeRequest>): QueryFlatteningSizeRequest {
+    const message = createBaseQueryFlatteningSizeRequest();
+    message.owner = object.owner ?? "";
+    message.perpetualId = object.perpetualId ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryFlatteningSizeResponse(): QueryFlatteningSizeResponse {
+  return {
+    quantums: new Uint8Array(),
+    absNotional: new Uint8Array()
+  };
+}
+
+export const QueryFlatteningSizeResponse = {
+  encode(message: QueryFlatteningSizeResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.quantums.length !== 0) {
+      writer.uint32(10).bytes(message.quantums);
+    }
+
+    if (message.absNotional.length !== 0) {
+      writer.uint32(18).bytes(message.absNotional);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryFlatteningSizeResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryFlatteningSizeResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.quantums = reader.bytes();
+          break;
+
+        case 2:
+          message.absNotional = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryFlatteningSizeResponse>): QueryFlatteningSizeResponse {
+    const message = createBaseQueryFlatteningSizeResponse();
+    message.quantums = object.quantums ?? new Uint8Array();
+    message.absNotional = object.absNotional ?? new Uint8Array();
+    return message;
+  }
+
+};
 
-function createBaseQueryGetSubaccountRequest(): QueryGetSubaccountRequest {
+function createBaseLiquidationDepthLevel(): LiquidationDepthLevel {
+  return {
+    price: Long.UZERO,
+    quantums: Long.UZERO
+  };
+}
+
+export const LiquidationDepthLevel = {
+  encode(message: LiquidationDepthLevel, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (!message.price.isZero()) {
+      writer.uint32(8).uint64(message.price);
+    }
+
+    if (!message.quantums.isZero()) {
+      writer.uint32(16).uint64(message.quantums);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): LiquidationDepthLevel {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseLiquidationDepthLevel();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.price = (reader.uint64() as Long);
+          break;
+
+        case 2:
+          message.quantums = (reader.uint64() as Long);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<LiquidationDepthLevel>): LiquidationDepthLevel {
+    const message = createBaseLiquidationDepthLevel();
+    message.price = object.price !== undefined && object.price !== null ? Long.fromValue(object.price) : Long.UZERO;
+    message.quantums = object.quantums !== undefined && object.quantums !== null ? Long.fromValue(object.quantums) : Long.UZERO;
+    return message;
+  }
+
+};
+
+function createBaseQueryLiquidationProceedsEstimateRequest(): QueryLiquidationProceedsEstimateRequest {
   return {
     owner: "",
-    number: 0
+    number: 0,
+    perpetualId: 0,
+    depth: [],
+    maxLiquidationFeePpm: 0
   };
 }
 
-export const QueryGetSubaccountRequest = {
-  encode(message: QueryGetSubaccountRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const QueryLiquidationProceedsEstimateRequest = {
+  encode(message: QueryLiquidationProceedsEstimateRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.owner !== "") {
       writer.uint32(10).string(message.owner);
     }
@@ -148,13 +5585,25 @@ export const QueryGetSubaccountRequest = {
       writer.uint32(16).uint32(message.number);
     }
 
+    if (message.perpetualId !== 0) {
+      writer.uint32(24).uint32(message.perpetualId);
+    }
+
+    for (const v of message.depth) {
+      LiquidationDepthLevel.encode(v!, writer.uint32(34).fork()).ldelim();
+    }
+
+    if (message.maxLiquidationFeePpm !== 0) {
+      writer.uint32(40).uint32(message.maxLiquidationFeePpm);
+    }
+
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): QueryGetSubaccountRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryLiquidationProceedsEstimateRequest {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseQueryGetSubaccountRequest();
+    const message = createBaseQueryLiquidationProceedsEstimateRequest();
 
     while (reader.pos < end) {
       const tag = reader.uint32();
@@ -168,6 +5617,18 @@ export const QueryGetSubaccountRequest = {
           message.number = reader.uint32();
           break;
 
+        case 3:
+          message.perpetualId = reader.uint32();
+          break;
+
+        case 4:
+          message.depth.push(LiquidationDepthLevel.decode(reader, reader.uint32()));
+          break;
+
+        case 5:
+          message.maxLiquidationFeePpm = reader.uint32();
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -177,41 +5638,62 @@ export const QueryGetSubaccountRequest = {
     return message;
   },
 
-  fromPartial(object: DeepPartial<QueryGetSubaccountRequest>): QueryGetSubaccountRequest {
-    const message = createBaseQueryGetSubaccountRequest();
+  fromPartial(object: DeepPartial<QueryLiquidationProceedsEstimateRequest>): QueryLiquidationProceedsEstimateRequest {
+    const message = createBaseQueryLiquidationProceedsEstimateRequest();
     message.owner = object.owner ?? "";
     message.number = object.number ?? 0;
+    message.perpetualId = object.perpetualId ?? 0;
+    message.depth = object.depth?.map(e => LiquidationDepthLevel.fromPartial(e)) || [];
+    message.maxLiquidationFeePpm = object.maxLiquidationFeePpm ?? 0;
     return message;
   }
 
 };
 
-function createBaseQuerySubaccountResponse(): QuerySubaccountResponse {
+function createBaseQueryLiquidationProceedsEstimateResponse(): QueryLiquidationProceedsEstimateResponse {
   return {
-    subaccount: undefined
+    filledQuantums: new Uint8Array(),
+    proceedsQuoteQuantums: new Uint8Array(),
+    insuranceFundDeltaQuoteQuantums: new Uint8Array()
   };
 }
 
-export const QuerySubaccountResponse = {
-  encode(message: QuerySubaccountResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.subaccount !== undefined) {
-      Subaccount.encode(message.subaccount, writer.uint32(10).fork()).ldelim();
+export const QueryLiquidationProceedsEstimateResponse = {
+  encode(message: QueryLiquidationProceedsEstimateResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.filledQuantums.length !== 0) {
+      writer.uint32(10).bytes(message.filledQuantums);
+    }
+
+    if (message.proceedsQuoteQuantums.length !== 0) {
+      writer.uint32(18).bytes(message.proceedsQuoteQuantums);
+    }
+
+    if (message.insuranceFundDeltaQuoteQuantums.length !== 0) {
+      writer.uint32(26).bytes(message.insuranceFundDeltaQuoteQuantums);
     }
 
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): QuerySubaccountResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryLiquidationProceedsEstimateResponse {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseQuerySubaccountResponse();
+    const message = createBaseQueryLiquidationProceedsEstimateResponse();
 
     while (reader.pos < end) {
       const tag = reader.uint32();
 
       switch (tag >>> 3) {
         case 1:
-          message.subaccount = Subaccount.decode(reader, reader.uint32());
+          message.filledQuantums = reader.bytes();
+          break;
+
+        case 2:
+          message.proceedsQuoteQuantums = reader.bytes();
+          break;
+
+        case 3:
+          message.insuranceFundDeltaQuoteQuantums = reader.bytes();
           break;
 
         default:
@@ -223,40 +5705,69 @@ export const QuerySubaccountResponse = {
     return message;
   },
 
-  fromPartial(object: DeepPartial<QuerySubaccountResponse>): QuerySubaccountResponse {
-    const message = createBaseQuerySubaccountResponse();
-    message.subaccount = object.subaccount !== undefined && object.subaccount !== null ? Subaccount.fromPartial(object.subaccount) : undefined;
+  fromPartial(object: DeepPartial<QueryLiquidationProceedsEstimateResponse>): QueryLiquidationProceedsEstimateResponse {
+    const message = createBaseQueryLiquidationProceedsEstimateResponse();
+    message.filledQuantums = object.filledQuantums ?? new Uint8Array();
+    message.proceedsQuoteQuantums = object.proceedsQuoteQuantums ?? new Uint8Array();
+    message.insuranceFundDeltaQuoteQuantums = object.insuranceFundDeltaQuoteQuantums ?? new Uint8Array();
     return message;
   }
 
 };
 
-function createBaseQueryAllSubaccountRequest(): QueryAllSubaccountRequest {
+function createBaseQueryMarginDeltaForTierChangeRequest(): QueryMarginDeltaForTierChangeRequest {
   return {
-    pagination: undefined
+    owner: "",
+    number: 0,
+    perpetualId: 0,
+    proposedTier: undefined
   };
 }
 
-export const QueryAllSubaccountRequest = {
-  encode(message: QueryAllSubaccountRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.pagination !== undefined) {
-      PageRequest.encode(message.pagination, writer.uint32(10).fork()).ldelim();
+export const QueryMarginDeltaForTierChangeRequest = {
+  encode(message: QueryMarginDeltaForTierChangeRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.owner !== "") {
+      writer.uint32(10).string(message.owner);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    if (message.perpetualId !== 0) {
+      writer.uint32(24).uint32(message.perpetualId);
+    }
+
+    if (message.proposedTier !== undefined) {
+      LiquidityTier.encode(message.proposedTier, writer.uint32(34).fork()).ldelim();
     }
 
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): QueryAllSubaccountRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryMarginDeltaForTierChangeRequest {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseQueryAllSubaccountRequest();
+    const message = createBaseQueryMarginDeltaForTierChangeRequest();
 
     while (reader.pos < end) {
       const tag = reader.uint32();
 
       switch (tag >>> 3) {
         case 1:
-          message.pagination = PageRequest.decode(reader, reader.uint32());
+          message.owner = reader.string();
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        case 3:
+          message.perpetualId = reader.uint32();
+          break;
+
+        case 4:
+          message.proposedTier = LiquidityTier.decode(reader, reader.uint32());
           break;
 
         default:
@@ -268,49 +5779,52 @@ export const QueryAllSubaccountRequest = {
     return message;
   },
 
-  fromPartial(object: DeepPartial<QueryAllSubaccountRequest>): QueryAllSubaccountRequest {
-    const message = createBaseQueryAllSubaccountRequest();
-    message.pagination = object.pagination !== undefined && object.pagination !== null ? PageRequest.fromPartial(object.pagination) : undefined;
+  fromPartial(object: DeepPartial<QueryMarginDeltaForTierChangeRequest>): QueryMarginDeltaForTierChangeRequest {
+    const message = createBaseQueryMarginDeltaForTierChangeRequest();
+    message.owner = object.owner ?? "";
+    message.number = object.number ?? 0;
+    message.perpetualId = object.perpetualId ?? 0;
+    message.proposedTier = object.proposedTier !== undefined && object.proposedTier !== null ? LiquidityTier.fromPartial(object.proposedTier) : undefined;
     return message;
   }
 
 };
 
-function createBaseQuerySubaccountAllResponse(): QuerySubaccountAllResponse {
+function createBaseQueryMarginDeltaForTierChangeResponse(): QueryMarginDeltaForTierChangeResponse {
   return {
-    subaccount: [],
-    pagination: undefined
+    initialMarginRequirementDelta: new Uint8Array(),
+    maintenanceMarginRequirementDelta: new Uint8Array()
   };
 }
 
-export const QuerySubaccountAllResponse = {
-  encode(message: QuerySubaccountAllResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.subaccount) {
-      Subaccount.encode(v!, writer.uint32(10).fork()).ldelim();
+export const QueryMarginDeltaForTierChangeResponse = {
+  encode(message: QueryMarginDeltaForTierChangeResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.initialMarginRequirementDelta.length !== 0) {
+      writer.uint32(10).bytes(message.initialMarginRequirementDelta);
     }
 
-    if (message.pagination !== undefined) {
-      PageResponse.encode(message.pagination, writer.uint32(18).fork()).ldelim();
+    if (message.maintenanceMarginRequirementDelta.length !== 0) {
+      writer.uint32(18).bytes(message.maintenanceMarginRequirementDelta);
     }
 
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): QuerySubaccountAllResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryMarginDeltaForTierChangeResponse {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseQuerySubaccountAllResponse();
+    const message = createBaseQueryMarginDeltaForTierChangeResponse();
 
     while (reader.pos < end) {
       const tag = reader.uint32();
 
       switch (tag >>> 3) {
         case 1:
-          message.subaccount.push(Subaccount.decode(reader, reader.uint32()));
+          message.initialMarginRequirementDelta = reader.bytes();
           break;
 
         case 2:
-          message.pagination = PageResponse.decode(reader, reader.uint32());
+          message.maintenanceMarginRequirementDelta = reader.bytes();
           break;
 
         default:
@@ -322,34 +5836,39 @@ export const QuerySubaccountAllResponse = {
     return message;
   },
 
-  fromPartial(object: DeepPartial<QuerySubaccountAllResponse>): QuerySubaccountAllResponse {
-    const message = createBaseQuerySubaccountAllResponse();
-    message.subaccount = object.subaccount?.map(e => Subaccount.fromPartial(e)) || [];
-    message.pagination = object.pagination !== undefined && object.pagination !== null ? PageResponse.fromPartial(object.pagination) : undefined;
+  fromPartial(object: DeepPartial<QueryMarginDeltaForTierChangeResponse>): QueryMarginDeltaForTierChangeResponse {
+    const message = createBaseQueryMarginDeltaForTierChangeResponse();
+    message.initialMarginRequirementDelta = object.initialMarginRequirementDelta ?? new Uint8Array();
+    message.maintenanceMarginRequirementDelta = object.maintenanceMarginRequirementDelta ?? new Uint8Array();
     return message;
   }
 
 };
 
-function createBaseQueryGetWithdrawalAndTransfersBlockedInfoRequest(): QueryGetWithdrawalAndTransfersBlockedInfoRequest {
+function createBaseHistoricalMove(): HistoricalMove {
   return {
-    perpetualId: 0
+    perpetualId: 0,
+    movePpm: 0
   };
 }
 
-export const QueryGetWithdrawalAndTransfersBlockedInfoRequest = {
-  encode(message: QueryGetWithdrawalAndTransfersBlockedInfoRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const HistoricalMove = {
+  encode(message: HistoricalMove, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.perpetualId !== 0) {
       writer.uint32(8).uint32(message.perpetualId);
     }
 
+    if (message.movePpm !== 0) {
+      writer.uint32(16).uint32(message.movePpm);
+    }
+
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): QueryGetWithdrawalAndTransfersBlockedInfoRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): HistoricalMove {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseQueryGetWithdrawalAndTransfersBlockedInfoRequest();
+    const message = createBaseHistoricalMove();
 
     while (reader.pos < end) {
       const tag = reader.uint32();
@@ -359,6 +5878,10 @@ export const QueryGetWithdrawalAndTransfersBlockedInfoRequest = {
           message.perpetualId = reader.uint32();
           break;
 
+        case 2:
+          message.movePpm = reader.uint32();
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -368,58 +5891,59 @@ export const QueryGetWithdrawalAndTransfersBlockedInfoRequest = {
     return message;
   },
 
-  fromPartial(object: DeepPartial<QueryGetWithdrawalAndTransfersBlockedInfoRequest>): QueryGetWithdrawalAndTransfersBlockedInfoRequest {
-    const message = createBaseQueryGetWithdrawalAndTransfersBlockedInfoRequest();
+  fromPartial(object: DeepPartial<HistoricalMove>): HistoricalMove {
+    const message = createBaseHistoricalMove();
     message.perpetualId = object.perpetualId ?? 0;
+    message.movePpm = object.movePpm ?? 0;
     return message;
   }
 
 };
 
-function createBaseQueryGetWithdrawalAndTransfersBlockedInfoResponse(): QueryGetWithdrawalAndTransfersBlockedInfoResponse {
+function createBaseQueryRiskUnderHistoricalShocksRequest(): QueryRiskUnderHistoricalShocksRequest {
   return {
-    negativeTncSubaccountSeenAtBlock: 0,
-    chainOutageSeenAtBlock: 0,
-    withdrawalsAndTransfersUnblockedAtBlock: 0
+    owner: "",
+    number: 0,
+    worstMoves: []
   };
 }
 
-export const QueryGetWithdrawalAndTransfersBlockedInfoResponse = {
-  encode(message: QueryGetWithdrawalAndTransfersBlockedInfoResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.negativeTncSubaccountSeenAtBlock !== 0) {
-      writer.uint32(8).uint32(message.negativeTncSubaccountSeenAtBlock);
+export const QueryRiskUnderHistoricalShocksRequest = {
+  encode(message: QueryRiskUnderHistoricalShocksRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.owner !== "") {
+      writer.uint32(10).string(message.owner);
     }
 
-    if (message.chainOutageSeenAtBlock !== 0) {
-      writer.uint32(16).uint32(message.chainOutageSeenAtBlock);
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
     }
 
-    if (message.withdrawalsAndTransfersUnblockedAtBlock !== 0) {
-      writer.uint32(24).uint32(message.withdrawalsAndTransfersUnblockedAtBlock);
+    for (const v of message.worstMoves) {
+      HistoricalMove.encode(v!, writer.uint32(26).fork()).ldelim();
     }
 
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): QueryGetWithdrawalAndTransfersBlockedInfoResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryRiskUnderHistoricalShocksRequest {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseQueryGetWithdrawalAndTransfersBlockedInfoResponse();
+    const message = createBaseQueryRiskUnderHistoricalShocksRequest();
 
     while (reader.pos < end) {
       const tag = reader.uint32();
 
       switch (tag >>> 3) {
         case 1:
-          message.negativeTncSubaccountSeenAtBlock = reader.uint32();
+          message.owner = reader.string();
           break;
 
         case 2:
-          message.chainOutageSeenAtBlock = reader.uint32();
+          message.number = reader.uint32();
           break;
 
         case 3:
-          message.withdrawalsAndTransfersUnblockedAtBlock = reader.uint32();
+          message.worstMoves.push(HistoricalMove.decode(reader, reader.uint32()));
           break;
 
         default:
@@ -431,24 +5955,89 @@ export const QueryGetWithdrawalAndTransfersBlockedInfoResponse = {
     return message;
   },
 
-  fromPartial(object: DeepPartial<QueryGetWithdrawalAndTransfersBlockedInfoResponse>): QueryGetWithdrawalAndTransfersBlockedInfoResponse {
-    const message = createBaseQueryGetWithdrawalAndTransfersBlockedInfoResponse();
-    message.negativeTncSubaccountSeenAtBlock = object.negativeTncSubaccountSeenAtBlock ?? 0;
-    message.chainOutageSeenAtBlock = object.chainOutageSeenAtBlock ?? 0;
-    message.withdrawalsAndTransfersUnblockedAtBlock = object.withdrawalsAndTransfersUnblockedAtBlock ?? 0;
+  fromPartial(object: DeepPartial<QueryRiskUnderHistoricalShocksRequest>): QueryRiskUnderHistoricalShocksRequest {
+    const message = createBaseQueryRiskUnderHistoricalShocksRequest();
+    message.owner = object.owner ?? "";
+    message.number = object.number ?? 0;
+    message.worstMoves = object.worstMoves?.map(e => HistoricalMove.fromPartial(e)) || [];
     return message;
   }
 
 };
 
-function createBaseQueryCollateralPoolAddressRequest(): QueryCollateralPoolAddressRequest {
+function createBaseQueryRiskUnderHistoricalShocksResponse(): QueryRiskUnderHistoricalShocksResponse {
+  return {
+    netCollateral: new Uint8Array(),
+    initialMarginRequirement: new Uint8Array(),
+    maintenanceMarginRequirement: new Uint8Array()
+  };
+}
+
+export const QueryRiskUnderHistoricalShocksResponse = {
+  encode(message: QueryRiskUnderHistoricalShocksResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.netCollateral.length !== 0) {
+      writer.uint32(10).bytes(message.netCollateral);
+    }
+
+    if (message.initialMarginRequirement.length !== 0) {
+      writer.uint32(18).bytes(message.initialMarginRequirement);
+    }
+
+    if (message.maintenanceMarginRequirement.length !== 0) {
+      writer.uint32(26).bytes(message.maintenanceMarginRequirement);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryRiskUnderHistoricalShocksResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryRiskUnderHistoricalShocksResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.netCollateral = reader.bytes();
+          break;
+
+        case 2:
+          message.initialMarginRequirement = reader.bytes();
+          break;
+
+        case 3:
+          message.maintenanceMarginRequirement = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryRiskUnderHistoricalShocksResponse>): QueryRiskUnderHistoricalShocksResponse {
+    const message = createBaseQueryRiskUnderHistoricalShocksResponse();
+    message.netCollateral = object.netCollateral ?? new Uint8Array();
+    message.initialMarginRequirement = object.initialMarginRequirement ?? new Uint8Array();
+    message.maintenanceMarginRequirement = object.maintenanceMarginRequirement ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseQueryMinLiquidatingPriceMoveRequest(): QueryMinLiquidatingPriceMoveRequest {
   return {
     perpetualId: 0
   };
 }
 
-export const QueryCollateralPoolAddressRequest = {
-  encode(message: QueryCollateralPoolAddressRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const QueryMinLiquidatingPriceMoveRequest = {
+  encode(message: QueryMinLiquidatingPriceMoveRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.perpetualId !== 0) {
       writer.uint32(8).uint32(message.perpetualId);
     }
@@ -456,10 +6045,10 @@ export const QueryCollateralPoolAddressRequest = {
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): QueryCollateralPoolAddressRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryMinLiquidatingPriceMoveRequest {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseQueryCollateralPoolAddressRequest();
+    const message = createBaseQueryMinLiquidatingPriceMoveRequest();
 
     while (reader.pos < end) {
       const tag = reader.uint32();
@@ -478,40 +6067,58 @@ export const QueryCollateralPoolAddressRequest = {
     return message;
   },
 
-  fromPartial(object: DeepPartial<QueryCollateralPoolAddressRequest>): QueryCollateralPoolAddressRequest {
-    const message = createBaseQueryCollateralPoolAddressRequest();
+  fromPartial(object: DeepPartial<QueryMinLiquidatingPriceMoveRequest>): QueryMinLiquidatingPriceMoveRequest {
+    const message = createBaseQueryMinLiquidatingPriceMoveRequest();
     message.perpetualId = object.perpetualId ?? 0;
     return message;
   }
 
 };
 
-function createBaseQueryCollateralPoolAddressResponse(): QueryCollateralPoolAddressResponse {
+function createBaseQueryMinLiquidatingPriceMoveResponse(): QueryMinLiquidatingPriceMoveResponse {
   return {
-    collateralPoolAddress: ""
+    found: false,
+    movePpm: Long.UZERO,
+    subaccountId: undefined
   };
 }
 
-export const QueryCollateralPoolAddressResponse = {
-  encode(message: QueryCollateralPoolAddressResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.collateralPoolAddress !== "") {
-      writer.uint32(10).string(message.collateralPoolAddress);
+export const QueryMinLiquidatingPriceMoveResponse = {
+  encode(message: QueryMinLiquidatingPriceMoveResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.found === true) {
+      writer.uint32(8).bool(message.found);
+    }
+
+    if (!message.movePpm.isZero()) {
+      writer.uint32(16).uint64(message.movePpm);
+    }
+
+    if (message.subaccountId !== undefined) {
+      SubaccountId.encode(message.subaccountId, writer.uint32(26).fork()).ldelim();
     }
 
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): QueryCollateralPoolAddressResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryMinLiquidatingPriceMoveResponse {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseQueryCollateralPoolAddressResponse();
+    const message = createBaseQueryMinLiquidatingPriceMoveResponse();
 
     while (reader.pos < end) {
       const tag = reader.uint32();
 
       switch (tag >>> 3) {
         case 1:
-          message.collateralPoolAddress = reader.string();
+          message.found = reader.bool();
+          break;
+
+        case 2:
+          message.movePpm = (reader.uint64() as Long);
+          break;
+
+        case 3:
+          message.subaccountId = SubaccountId.decode(reader, reader.uint32());
           break;
 
         default:
@@ -523,9 +6130,11 @@ export const QueryCollateralPoolAddressResponse = {
     return message;
   },
 
-  fromPartial(object: DeepPartial<QueryCollateralPoolAddressResponse>): QueryCollateralPoolAddressResponse {
-    const message = createBaseQueryCollateralPoolAddressResponse();
-    message.collateralPoolAddress = object.collateralPoolAddress ?? "";
+  fromPartial(object: DeepPartial<QueryMinLiquidatingPriceMoveResponse>): QueryMinLiquidatingPriceMoveResponse {
+    const message = createBaseQueryMinLiquidatingPriceMoveResponse();
+    message.found = object.found ?? false;
+    message.movePpm = object.movePpm !== undefined && object.movePpm !== null ? Long.fromValue(object.movePpm) : Long.UZERO;
+    message.subaccountId = object.subaccountId !== undefined && object.subaccountId !== null ? SubaccountId.fromPartial(object.subaccountId) : undefined;
     return message;
   }
 
@@ -0,0 +1,70 @@
+import { Rpc } from "../../helpers";
+import * as _m0 from "protobufjs/minimal";
+import { MsgUpdateParams, MsgUpdateParamsResponse, MsgSetMaxLeverage, MsgSetMaxLeverageResponse, MsgSetMarginMode, MsgSetMarginModeResponse, MsgRebalanceCollateral, MsgRebalanceCollateralResponse, MsgOpenHedgedPair, MsgOpenHedgedPairResponse } from "./tx";
+/** Msg defines the Msg service. */
+
+export interface Msg {
+  /** UpdateParams updates the Params in state. */
+  updateParams(request: MsgUpdateParams): Promise<MsgUpdateParamsResponse>;
+  /** SetMaxLeverage sets the self-imposed leverage cap of a subaccount. */
+
+  setMaxLeverage(request: MsgSetMaxLeverage): Promise<MsgSetMaxLeverageResponse>;
+  /** SetMarginMode switches a subaccount between cross and isolated margin. */
+
+  setMarginMode(request: MsgSetMarginMode): Promise<MsgSetMarginModeResponse>;
+  /**
+   * RebalanceCollateral moves USDC between a cross margin subaccount and an
+   * isolated position of the same owner.
+   */
+
+  rebalanceCollateral(request: MsgRebalanceCollateral): Promise<MsgRebalanceCollateralResponse>;
+  /**
+   * OpenHedgedPair atomically opens positions in two perpetuals of a
+   * subaccount.
+   */
+
+  openHedgedPair(request: MsgOpenHedgedPair): Promise<MsgOpenHedgedPairResponse>;
+}
+export class MsgClientImpl implements Msg {
+  private readonly rpc: Rpc;
+
+  constructor(rpc: Rpc) {
+    this.rpc = rpc;
+    this.updateParams = this.updateParams.bind(this);
+    this.setMaxLeverage = this.setMaxLeverage.bind(this);
+    this.setMarginMode = this.setMarginMode.bind(this);
+    this.rebalanceCollateral = this.rebalanceCollateral.bind(this);
+    this.openHedgedPair = this.openHedgedPair.bind(this);
+  }
+
+  updateParams(request: MsgUpdateParams): Promise<MsgUpdateParamsResponse> {
+    const data = MsgUpdateParams.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Msg", "UpdateParams", data);
+    return promise.then(data => MsgUpdateParamsResponse.decode(new _m0.Reader(data)));
+  }
+
+  setMaxLeverage(request: MsgSetMaxLeverage): Promise<MsgSetMaxLeverageResponse> {
+    const data = MsgSetMaxLeverage.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Msg", "SetMaxLeverage", data);
+    return promise.then(data => MsgSetMaxLeverageResponse.decode(new _m0.Reader(data)));
+  }
+
+  setMarginMode(request: MsgSetMarginMode): Promise<MsgSetMarginModeResponse> {
+    const data = MsgSetMarginMode.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Msg", "SetMarginMode", data);
+    return promise.then(data => MsgSetMarginModeResponse.decode(new _m0.Reader(data)));
+  }
+
+  rebalanceCollateral(request: MsgRebalanceCollateral): Promise<MsgRebalanceCollateralResponse> {
+    const data = MsgRebalanceCollateral.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Msg", "RebalanceCollateral", data);
+    return promise.then(data => MsgRebalanceCollateralResponse.decode(new _m0.Reader(data)));
+  }
+
+  openHedgedPair(request: MsgOpenHedgedPair): Promise<MsgOpenHedgedPairResponse> {
+    const data = MsgOpenHedgedPair.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Msg", "OpenHedgedPair", data);
+    return promise.then(data => MsgOpenHedgedPairResponse.decode(new _m0.Reader(data)));
+  }
+
+}
\ No newline at end of file
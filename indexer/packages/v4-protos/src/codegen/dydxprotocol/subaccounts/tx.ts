@@ -0,0 +1,758 @@
+import { Params, ParamsSDKType } from "./params";
+import { SubaccountId, SubaccountIdSDKType } from "./subaccount";
+import * as _m0 from "protobufjs/minimal";
+import { Long, DeepPartial } from "../../helpers";
+/** MsgUpdateParams is the Msg/UpdateParams request type. */
+
+export interface MsgUpdateParams {
+  authority: string;
+  /** The parameters to update. Each field must be set. */
+
+  params?: Params;
+}
+/** MsgUpdateParams is the Msg/UpdateParams request type. */
+
+export interface MsgUpdateParamsSDKType {
+  authority: string;
+  /** The parameters to update. Each field must be set. */
+
+  params?: ParamsSDKType;
+}
+/** MsgUpdateParamsResponse is the Msg/UpdateParams response type. */
+
+export interface MsgUpdateParamsResponse {}
+/** MsgUpdateParamsResponse is the Msg/UpdateParams response type. */
+
+export interface MsgUpdateParamsResponseSDKType {}
+/** MsgSetMaxLeverage sets the self-imposed leverage cap of a subaccount. */
+
+export interface MsgSetMaxLeverage {
+  /** The subaccount to set the leverage cap of. */
+  subaccountId?: SubaccountId;
+  /** The leverage cap, in parts-per-million. Zero removes the cap. */
+
+  maxLeveragePpm: number;
+}
+/** MsgSetMaxLeverage sets the self-imposed leverage cap of a subaccount. */
+
+export interface MsgSetMaxLeverageSDKType {
+  /** The subaccount to set the leverage cap of. */
+  subaccount_id?: SubaccountIdSDKType;
+  /** The leverage cap, in parts-per-million. Zero removes the cap. */
+
+  max_leverage_ppm: number;
+}
+/** MsgSetMaxLeverageResponse is the Msg/SetMaxLeverage response type. */
+
+export interface MsgSetMaxLeverageResponse {}
+/** MsgSetMaxLeverageResponse is the Msg/SetMaxLeverage response type. */
+
+export interface MsgSetMaxLeverageResponseSDKType {}
+/** MsgSetMarginMode switches a subaccount between cross and isolated margin. */
+
+export interface MsgSetMarginMode {
+  /** The subaccount to switch the margin mode of. */
+  subaccountId?: SubaccountId;
+  /**
+   * The margin mode to switch to: 0 for cross margin and 1 for isolated
+   * margin.
+   */
+
+  marginMode: number;
+}
+/** MsgSetMarginMode switches a subaccount between cross and isolated margin. */
+
+export interface MsgSetMarginModeSDKType {
+  /** The subaccount to switch the margin mode of. */
+  subaccount_id?: SubaccountIdSDKType;
+  /**
+   * The margin mode to switch to: 0 for cross margin and 1 for isolated
+   * margin.
+   */
+
+  margin_mode: number;
+}
+/** MsgSetMarginModeResponse is the Msg/SetMarginMode response type. */
+
+export interface MsgSetMarginModeResponse {}
+/** MsgSetMarginModeResponse is the Msg/SetMarginMode response type. */
+
+export interface MsgSetMarginModeResponseSDKType {}
+/**
+ * MsgRebalanceCollateral moves USDC between a cross margin subaccount and an
+ * isolated position of the same owner.
+ */
+
+export interface MsgRebalanceCollateral {
+  /** The cross margin subaccount. */
+  crossSubaccountId?: SubaccountId;
+  /** The subaccount holding the isolated position. */
+
+  isolatedSubaccountId?: SubaccountId;
+  /** The id of the isolated perpetual. */
+
+  perpetualId: number;
+  /** The amount of USDC to move, in quote quantums. Must be positive. */
+
+  quoteQuantums: Long;
+  /**
+   * If true, the USDC is moved from the isolated position to the cross margin
+   * subaccount. Otherwise, it is moved from the cross margin subaccount to the
+   * isolated position.
+   */
+
+  toCross: boolean;
+}
+/**
+ * MsgRebalanceCollateral moves USDC between a cross margin subaccount and an
+ * isolated position of the same owner.
+ */
+
+export interface MsgRebalanceCollateralSDKType {
+  /** The cross margin subaccount. */
+  cross_subaccount_id?: SubaccountIdSDKType;
+  /** The subaccount holding the isolated position. */
+
+  isolated_subaccount_id?: SubaccountIdSDKType;
+  /** The id of the isolated perpetual. */
+
+  perpetual_id: number;
+  /** The amount of USDC to move, in quote quantums. Must be positive. */
+
+  quote_quantums: Long;
+  /**
+   * If true, the USDC is moved from the isolated position to the cross margin
+   * subaccount. Otherwise, it is moved from the cross margin subaccount to the
+   * isolated position.
+   */
+
+  to_cross: boolean;
+}
+/** MsgRebalanceCollateralResponse is the Msg/RebalanceCollateral response type. */
+
+export interface MsgRebalanceCollateralResponse {}
+/** MsgRebalanceCollateralResponse is the Msg/RebalanceCollateral response type. */
+
+export interface MsgRebalanceCollateralResponseSDKType {}
+/** HedgedPairLeg is a change to a perpetual position of a hedged pair. */
+
+export interface HedgedPairLeg {
+  /** The id of the perpetual. */
+  perpetualId: number;
+  /** The signed change in the size of the position in base quantums. */
+
+  quantumsDelta: Uint8Array;
+  /** The signed change in the quote balance of the position in quote quantums. */
+
+  quoteBalanceDelta: Uint8Array;
+}
+/** HedgedPairLeg is a change to a perpetual position of a hedged pair. */
+
+export interface HedgedPairLegSDKType {
+  /** The id of the perpetual. */
+  perpetual_id: number;
+  /** The signed change in the size of the position in base quantums. */
+
+  quantums_delta: Uint8Array;
+  /** The signed change in the quote balance of the position in quote quantums. */
+
+  quote_balance_delta: Uint8Array;
+}
+/**
+ * MsgOpenHedgedPair atomically opens positions in two perpetuals of a
+ * subaccount. The legs are applied without a counterparty, so the message can
+ * only be submitted by the authority.
+ */
+
+export interface MsgOpenHedgedPair {
+  authority: string;
+  /** The subaccount to open the positions in. */
+
+  subaccountId?: SubaccountId;
+  /** The legs of the pair, which must be in different perpetuals. */
+
+  legA?: HedgedPairLeg;
+  legB?: HedgedPairLeg;
+}
+/**
+ * MsgOpenHedgedPair atomically opens positions in two perpetuals of a
+ * subaccount. The legs are applied without a counterparty, so the message can
+ * only be submitted by the authority.
+ */
+
+export interface MsgOpenHedgedPairSDKType {
+  authority: string;
+  /** The subaccount to open the positions in. */
+
+  subaccount_id?: SubaccountIdSDKType;
+  /** The legs of the pair, which must be in different perpetuals. */
+
+  leg_a?: HedgedPairLegSDKType;
+  leg_b?: HedgedPairLegSDKType;
+}
+/** MsgOpenHedgedPairResponse is the Msg/OpenHedgedPair response type. */
+
+export interface MsgOpenHedgedPairResponse {}
+/** MsgOpenHedgedPairResponse is the Msg/OpenHedgedPair response type. */
+
+export interface MsgOpenHedgedPairResponseSDKType {}
+
+function createBaseMsgUpdateParams(): MsgUpdateParams {
+  return {
+    authority: "",
+    params: undefined
+  };
+}
+
+export const MsgUpdateParams = {
+  encode(message: MsgUpdateParams, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.authority !== "") {
+      writer.uint32(10).string(message.authority);
+    }
+
+    if (message.params !== undefined) {
+      Params.encode(message.params, writer.uint32(18).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgUpdateParams {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgUpdateParams();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.authority = reader.string();
+          break;
+
+        case 2:
+          message.params = Params.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MsgUpdateParams>): MsgUpdateParams {
+    const message = createBaseMsgUpdateParams();
+    message.authority = object.authority ?? "";
+    message.params = object.params !== undefined && object.params !== null ? Params.fromPartial(object.params) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseMsgUpdateParamsResponse(): MsgUpdateParamsResponse {
+  return {};
+}
+
+export const MsgUpdateParamsResponse = {
+  encode(_: MsgUpdateParamsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgUpdateParamsResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgUpdateParamsResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<MsgUpdateParamsResponse>): MsgUpdateParamsResponse {
+    const message = createBaseMsgUpdateParamsResponse();
+    return message;
+  }
+
+};
+
+function createBaseMsgSetMaxLeverage(): MsgSetMaxLeverage {
+  return {
+    subaccountId: undefined,
+    maxLeveragePpm: 0
+  };
+}
+
+export const MsgSetMaxLeverage = {
+  encode(message: MsgSetMaxLeverage, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.subaccountId !== undefined) {
+      SubaccountId.encode(message.subaccountId, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.maxLeveragePpm !== 0) {
+      writer.uint32(16).uint32(message.maxLeveragePpm);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgSetMaxLeverage {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgSetMaxLeverage();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.subaccountId = SubaccountId.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.maxLeveragePpm = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MsgSetMaxLeverage>): MsgSetMaxLeverage {
+    const message = createBaseMsgSetMaxLeverage();
+    message.subaccountId = object.subaccountId !== undefined && object.subaccountId !== null ? SubaccountId.fromPartial(object.subaccountId) : undefined;
+    message.maxLeveragePpm = object.maxLeveragePpm ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseMsgSetMaxLeverageResponse(): MsgSetMaxLeverageResponse {
+  return {};
+}
+
+export const MsgSetMaxLeverageResponse = {
+  encode(_: MsgSetMaxLeverageResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgSetMaxLeverageResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgSetMaxLeverageResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<MsgSetMaxLeverageResponse>): MsgSetMaxLeverageResponse {
+    const message = createBaseMsgSetMaxLeverageResponse();
+    return message;
+  }
+
+};
+
+function createBaseMsgSetMarginMode(): MsgSetMarginMode {
+  return {
+    subaccountId: undefined,
+    marginMode: 0
+  };
+}
+
+export const MsgSetMarginMode = {
+  encode(message: MsgSetMarginMode, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.subaccountId !== undefined) {
+      SubaccountId.encode(message.subaccountId, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.marginMode !== 0) {
+      writer.uint32(16).uint32(message.marginMode);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgSetMarginMode {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgSetMarginMode();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.subaccountId = SubaccountId.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.marginMode = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MsgSetMarginMode>): MsgSetMarginMode {
+    const message = createBaseMsgSetMarginMode();
+    message.subaccountId = object.subaccountId !== undefined && object.subaccountId !== null ? SubaccountId.fromPartial(object.subaccountId) : undefined;
+    message.marginMode = object.marginMode ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseMsgSetMarginModeResponse(): MsgSetMarginModeResponse {
+  return {};
+}
+
+export const MsgSetMarginModeResponse = {
+  encode(_: MsgSetMarginModeResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgSetMarginModeResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgSetMarginModeResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<MsgSetMarginModeResponse>): MsgSetMarginModeResponse {
+    const message = createBaseMsgSetMarginModeResponse();
+    return message;
+  }
+
+};
+
+function createBaseMsgRebalanceCollateral(): MsgRebalanceCollateral {
+  return {
+    crossSubaccountId: undefined,
+    isolatedSubaccountId: undefined,
+    perpetualId: 0,
+    quoteQuantums: Long.UZERO,
+    toCross: false
+  };
+}
+
+export const MsgRebalanceCollateral = {
+  encode(message: MsgRebalanceCollateral, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.crossSubaccountId !== undefined) {
+      SubaccountId.encode(message.crossSubaccountId, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.isolatedSubaccountId !== undefined) {
+      SubaccountId.encode(message.isolatedSubaccountId, writer.uint32(18).fork()).ldelim();
+    }
+
+    if (message.perpetualId !== 0) {
+      writer.uint32(24).uint32(message.perpetualId);
+    }
+
+    if (!message.quoteQuantums.isZero()) {
+      writer.uint32(32).uint64(message.quoteQuantums);
+    }
+
+    if (message.toCross === true) {
+      writer.uint32(40).bool(message.toCross);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgRebalanceCollateral {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgRebalanceCollateral();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.crossSubaccountId = SubaccountId.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.isolatedSubaccountId = SubaccountId.decode(reader, reader.uint32());
+          break;
+
+        case 3:
+          message.perpetualId = reader.uint32();
+          break;
+
+        case 4:
+          message.quoteQuantums = (reader.uint64() as Long);
+          break;
+
+        case 5:
+          message.toCross = reader.bool();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MsgRebalanceCollateral>): MsgRebalanceCollateral {
+    const message = createBaseMsgRebalanceCollateral();
+    message.crossSubaccountId = object.crossSubaccountId !== undefined && object.crossSubaccountId !== null ? SubaccountId.fromPartial(object.crossSubaccountId) : undefined;
+    message.isolatedSubaccountId = object.isolatedSubaccountId !== undefined && object.isolatedSubaccountId !== null ? SubaccountId.fromPartial(object.isolatedSubaccountId) : undefined;
+    message.perpetualId = object.perpetualId ?? 0;
+    message.quoteQuantums = object.quoteQuantums !== undefined && object.quoteQuantums !== null ? Long.fromValue(object.quoteQuantums) : Long.UZERO;
+    message.toCross = object.toCross ?? false;
+    return message;
+  }
+
+};
+
+function createBaseMsgRebalanceCollateralResponse(): MsgRebalanceCollateralResponse {
+  return {};
+}
+
+export const MsgRebalanceCollateralResponse = {
+  encode(_: MsgRebalanceCollateralResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgRebalanceCollateralResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgRebalanceCollateralResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<MsgRebalanceCollateralResponse>): MsgRebalanceCollateralResponse {
+    const message = createBaseMsgRebalanceCollateralResponse();
+    return message;
+  }
+
+};
+
+function createBaseHedgedPairLeg(): HedgedPairLeg {
+  return {
+    perpetualId: 0,
+    quantumsDelta: new Uint8Array(),
+    quoteBalanceDelta: new Uint8Array()
+  };
+}
+
+export const HedgedPairLeg = {
+  encode(message: HedgedPairLeg, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.perpetualId !== 0) {
+      writer.uint32(8).uint32(message.perpetualId);
+    }
+
+    if (message.quantumsDelta.length !== 0) {
+      writer.uint32(18).bytes(message.quantumsDelta);
+    }
+
+    if (message.quoteBalanceDelta.length !== 0) {
+      writer.uint32(26).bytes(message.quoteBalanceDelta);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): HedgedPairLeg {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseHedgedPairLeg();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.perpetualId = reader.uint32();
+          break;
+
+        case 2:
+          message.quantumsDelta = reader.bytes();
+          break;
+
+        case 3:
+          message.quoteBalanceDelta = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<HedgedPairLeg>): HedgedPairLeg {
+    const message = createBaseHedgedPairLeg();
+    message.perpetualId = object.perpetualId ?? 0;
+    message.quantumsDelta = object.quantumsDelta ?? new Uint8Array();
+    message.quoteBalanceDelta = object.quoteBalanceDelta ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseMsgOpenHedgedPair(): MsgOpenHedgedPair {
+  return {
+    authority: "",
+    subaccountId: undefined,
+    legA: undefined,
+    legB: undefined
+  };
+}
+
+export const MsgOpenHedgedPair = {
+  encode(message: MsgOpenHedgedPair, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.authority !== "") {
+      writer.uint32(10).string(message.authority);
+    }
+
+    if (message.subaccountId !== undefined) {
+      SubaccountId.encode(message.subaccountId, writer.uint32(18).fork()).ldelim();
+    }
+
+    if (message.legA !== undefined) {
+      HedgedPairLeg.encode(message.legA, writer.uint32(26).fork()).ldelim();
+    }
+
+    if (message.legB !== undefined) {
+      HedgedPairLeg.encode(message.legB, writer.uint32(34).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgOpenHedgedPair {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgOpenHedgedPair();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.authority = reader.string();
+          break;
+
+        case 2:
+          message.subaccountId = SubaccountId.decode(reader, reader.uint32());
+          break;
+
+        case 3:
+          message.legA = HedgedPairLeg.decode(reader, reader.uint32());
+          break;
+
+        case 4:
+          message.legB = HedgedPairLeg.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MsgOpenHedgedPair>): MsgOpenHedgedPair {
+    const message = createBaseMsgOpenHedgedPair();
+    message.authority = object.authority ?? "";
+    message.subaccountId = object.subaccountId !== undefined && object.subaccountId !== null ? SubaccountId.fromPartial(object.subaccountId) : undefined;
+    message.legA = object.legA !== undefined && object.legA !== null ? HedgedPairLeg.fromPartial(object.legA) : undefined;
+    message.legB = object.legB !== undefined && object.legB !== null ? HedgedPairLeg.fromPartial(object.legB) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseMsgOpenHedgedPairResponse(): MsgOpenHedgedPairResponse {
+  return {};
+}
+
+export const MsgOpenHedgedPairResponse = {
+  encode(_: MsgOpenHedgedPairResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgOpenHedgedPairResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgOpenHedgedPairResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<MsgOpenHedgedPairResponse>): MsgOpenHedgedPairResponse {
+    const message = createBaseMsgOpenHedgedPairResponse();
+    return message;
+  }
+
+};
\ No newline at end of file
@@ -1,16 +1,16 @@
-import * as _131 from "./api/annotations";
-import * as _132 from "./api/http";
-import * as _133 from "./protobuf/descriptor";
-import * as _134 from "./protobuf/duration";
-import * as _135 from "./protobuf/timestamp";
-import * as _136 from "./protobuf/any";
+import * as _133 from "./api/annotations";
+import * as _134 from "./api/http";
+import * as _135 from "./protobuf/descriptor";
+import * as _136 from "./protobuf/duration";
+import * as _137 from "./protobuf/timestamp";
+import * as _138 from "./protobuf/any";
 export namespace google {
-  export const api = { ..._131,
-    ..._132
+  export const api = { ..._133,
+    ..._134
   };
-  export const protobuf = { ..._133,
-    ..._134,
-    ..._135,
-    ..._136
+  export const protobuf = { ..._135,
+    ..._136,
+    ..._137,
+    ..._138
   };
 }
\ No newline at end of file
@@ -485,6 +485,7 @@ func New(
 		indexer_manager.TransientStoreKey,
 		streaming.StreamingManagerTransientStoreKey,
 		perpetualsmoduletypes.TransientStoreKey,
+		satypes.TransientStoreKey,
 	)
 	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, clobmoduletypes.MemStoreKey)
 
@@ -717,6 +718,8 @@ func New(
 		keys[ratelimitmoduletypes.StoreKey],
 		app.BankKeeper,
 		app.BlockTimeKeeper,
+		// The subaccounts keeper is constructed below, so pass a reference to it.
+		&app.SubaccountsKeeper,
 		app.IBCKeeper.ChannelKeeper, // ICS4Wrapper
 		// set the governance and delaymsg module accounts as the authority for conducting upgrades
 		[]string{
@@ -1108,6 +1111,26 @@ func New(
 		app.BlockTimeKeeper,
 		app.IndexerEventManager,
 		app.FullNodeStreamingManager,
+		tkeys[satypes.TransientStoreKey],
+		// set the governance and delaymsg module accounts as the authority for updating the params
+		[]string{
+			lib.GovModuleAddress.String(),
+			delaymsgmoduletypes.ModuleAddress.String(),
+		},
+	)
+	app.SubaccountsKeeper.SetHistoricalMultiStoreProvider(
+		func(height int64) (storetypes.MultiStore, error) {
+			return app.CommitMultiStore().CacheMultiStoreWithVersion(height)
+		},
+	)
+	app.SubaccountsKeeper.SetFeeTiersKeeper(app.FeeTiersKeeper)
+	app.SubaccountsKeeper.SetCollateralSources(
+		subaccountsmodulekeeper.NewStakedTokenCollateralSource(
+			app.StakingKeeper,
+			app.PricesKeeper,
+			app.RewardsKeeper,
+			satypes.StakedTokenCollateralHaircutPpm,
+		),
 	)
 	subaccountsModule := subaccountsmodule.NewAppModule(
 		appCodec,
@@ -101,6 +101,15 @@ func NewInterfaceRegistry(addrPrefix string, valAddrPrefix string) (types.Interf
 				"dydxprotocol.listing.MsgCreateMarketPermissionless": getLegacyMsgSignerFn(
 					[]string{"subaccount_id", "owner"},
 				),
+				"dydxprotocol.subaccounts.MsgRebalanceCollateral": getLegacyMsgSignerFn(
+					[]string{"cross_subaccount_id", "owner"},
+				),
+				"dydxprotocol.subaccounts.MsgSetMarginMode": getLegacyMsgSignerFn(
+					[]string{"subaccount_id", "owner"},
+				),
+				"dydxprotocol.subaccounts.MsgSetMaxLeverage": getLegacyMsgSignerFn(
+					[]string{"subaccount_id", "owner"},
+				),
 
 				// App injected messages have no signers.
 				"dydxprotocol.bridge.MsgAcknowledgeBridges":  noSigners,
@@ -212,6 +212,8 @@ var (
 		"/dydxprotocol.clob.MsgUpdateClobPairResponse":                     {},
 		"/dydxprotocol.clob.MsgUpdateEquityTierLimitConfiguration":         {},
 		"/dydxprotocol.clob.MsgUpdateEquityTierLimitConfigurationResponse": {},
+		"/dydxprotocol.clob.MsgUpdateInsuranceFundOutflowCap":              {},
+		"/dydxprotocol.clob.MsgUpdateInsuranceFundOutflowCapResponse":      {},
 		"/dydxprotocol.clob.MsgUpdateLiquidationsConfig":                   {},
 		"/dydxprotocol.clob.MsgUpdateLiquidationsConfigResponse":           {},
 
@@ -238,16 +240,24 @@ var (
 		"/dydxprotocol.listing.MsgUpgradeIsolatedPerpetualToCrossResponse": {},
 
 		// perpetuals
-		"/dydxprotocol.perpetuals.MsgAddPremiumVotes":               {},
-		"/dydxprotocol.perpetuals.MsgAddPremiumVotesResponse":       {},
-		"/dydxprotocol.perpetuals.MsgCreatePerpetual":               {},
-		"/dydxprotocol.perpetuals.MsgCreatePerpetualResponse":       {},
-		"/dydxprotocol.perpetuals.MsgSetLiquidityTier":              {},
-		"/dydxprotocol.perpetuals.MsgSetLiquidityTierResponse":      {},
-		"/dydxprotocol.perpetuals.MsgUpdateParams":                  {},
-		"/dydxprotocol.perpetuals.MsgUpdateParamsResponse":          {},
-		"/dydxprotocol.perpetuals.MsgUpdatePerpetualParams":         {},
-		"/dydxprotocol.perpetuals.MsgUpdatePerpetualParamsResponse": {},
+		"/dydxprotocol.perpetuals.MsgAddPremiumVotes":                          {},
+		"/dydxprotocol.perpetuals.MsgAddPremiumVotesResponse":                  {},
+		"/dydxprotocol.perpetuals.MsgCreatePerpetual":                          {},
+		"/dydxprotocol.perpetuals.MsgCreatePerpetualResponse":                  {},
+		"/dydxprotocol.perpetuals.MsgSetConcentrationMargin":                   {},
+		"/dydxprotocol.perpetuals.MsgSetConcentrationMarginResponse":           {},
+		"/dydxprotocol.perpetuals.MsgSetFundingRateCap":                        {},
+		"/dydxprotocol.perpetuals.MsgSetFundingRateCapResponse":                {},
+		"/dydxprotocol.perpetuals.MsgSetLiquidityTier":                         {},
+		"/dydxprotocol.perpetuals.MsgSetLiquidityTierResponse":                 {},
+		"/dydxprotocol.perpetuals.MsgSetMaxUnsettledFundingIndexDelta":         {},
+		"/dydxprotocol.perpetuals.MsgSetMaxUnsettledFundingIndexDeltaResponse": {},
+		"/dydxprotocol.perpetuals.MsgSetSettlementPrice":                       {},
+		"/dydxprotocol.perpetuals.MsgSetSettlementPriceResponse":               {},
+		"/dydxprotocol.perpetuals.MsgUpdateParams":                             {},
+		"/dydxprotocol.perpetuals.MsgUpdateParamsResponse":                     {},
+		"/dydxprotocol.perpetuals.MsgUpdatePerpetualParams":                    {},
+		"/dydxprotocol.perpetuals.MsgUpdatePerpetualParamsResponse":            {},
 
 		// prices
 		"/dydxprotocol.prices.MsgCreateOracleMarket":         {},
@@ -275,6 +285,18 @@ var (
 		"/dydxprotocol.stats.MsgUpdateParams":         {},
 		"/dydxprotocol.stats.MsgUpdateParamsResponse": {},
 
+		// subaccounts
+		"/dydxprotocol.subaccounts.MsgOpenHedgedPair":              {},
+		"/dydxprotocol.subaccounts.MsgOpenHedgedPairResponse":      {},
+		"/dydxprotocol.subaccounts.MsgRebalanceCollateral":         {},
+		"/dydxprotocol.subaccounts.MsgRebalanceCollateralResponse": {},
+		"/dydxprotocol.subaccounts.MsgSetMarginMode":               {},
+		"/dydxprotocol.subaccounts.MsgSetMarginModeResponse":       {},
+		"/dydxprotocol.subaccounts.MsgSetMaxLeverage":              {},
+		"/dydxprotocol.subaccounts.MsgSetMaxLeverageResponse":      {},
+		"/dydxprotocol.subaccounts.MsgUpdateParams":                {},
+		"/dydxprotocol.subaccounts.MsgUpdateParamsResponse":        {},
+
 		// vault
 		"/dydxprotocol.vault.MsgAllocateToVault":                    {},
 		"/dydxprotocol.vault.MsgAllocateToVaultResponse":            {},
@@ -32,6 +32,7 @@ import (
 	rewards "github.com/dydxprotocol/v4-chain/protocol/x/rewards/types"
 	sending "github.com/dydxprotocol/v4-chain/protocol/x/sending/types"
 	stats "github.com/dydxprotocol/v4-chain/protocol/x/stats/types"
+	subaccounts "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	vault "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
 	vest "github.com/dydxprotocol/v4-chain/protocol/x/vest/types"
 )
@@ -140,6 +141,8 @@ var (
 		"/dydxprotocol.clob.MsgUpdateClobPairResponse":                     nil,
 		"/dydxprotocol.clob.MsgUpdateEquityTierLimitConfiguration":         &clob.MsgUpdateEquityTierLimitConfiguration{},
 		"/dydxprotocol.clob.MsgUpdateEquityTierLimitConfigurationResponse": nil,
+		"/dydxprotocol.clob.MsgUpdateInsuranceFundOutflowCap":              &clob.MsgUpdateInsuranceFundOutflowCap{},
+		"/dydxprotocol.clob.MsgUpdateInsuranceFundOutflowCapResponse":      nil,
 		"/dydxprotocol.clob.MsgUpdateLiquidationsConfig":                   &clob.MsgUpdateLiquidationsConfig{},
 		"/dydxprotocol.clob.MsgUpdateLiquidationsConfigResponse":           nil,
 
@@ -164,14 +167,22 @@ var (
 		"/dydxprotocol.listing.MsgUpgradeIsolatedPerpetualToCrossResponse": nil,
 
 		// perpetuals
-		"/dydxprotocol.perpetuals.MsgCreatePerpetual":               &perpetuals.MsgCreatePerpetual{},
-		"/dydxprotocol.perpetuals.MsgCreatePerpetualResponse":       nil,
-		"/dydxprotocol.perpetuals.MsgSetLiquidityTier":              &perpetuals.MsgSetLiquidityTier{},
-		"/dydxprotocol.perpetuals.MsgSetLiquidityTierResponse":      nil,
-		"/dydxprotocol.perpetuals.MsgUpdateParams":                  &perpetuals.MsgUpdateParams{},
-		"/dydxprotocol.perpetuals.MsgUpdateParamsResponse":          nil,
-		"/dydxprotocol.perpetuals.MsgUpdatePerpetualParams":         &perpetuals.MsgUpdatePerpetualParams{},
-		"/dydxprotocol.perpetuals.MsgUpdatePerpetualParamsResponse": nil,
+		"/dydxprotocol.perpetuals.MsgCreatePerpetual":                          &perpetuals.MsgCreatePerpetual{},
+		"/dydxprotocol.perpetuals.MsgCreatePerpetualResponse":                  nil,
+		"/dydxprotocol.perpetuals.MsgSetConcentrationMargin":                   &perpetuals.MsgSetConcentrationMargin{},
+		"/dydxprotocol.perpetuals.MsgSetConcentrationMarginResponse":           nil,
+		"/dydxprotocol.perpetuals.MsgSetFundingRateCap":                        &perpetuals.MsgSetFundingRateCap{},
+		"/dydxprotocol.perpetuals.MsgSetFundingRateCapResponse":                nil,
+		"/dydxprotocol.perpetuals.MsgSetLiquidityTier":                         &perpetuals.MsgSetLiquidityTier{},
+		"/dydxprotocol.perpetuals.MsgSetLiquidityTierResponse":                 nil,
+		"/dydxprotocol.perpetuals.MsgSetMaxUnsettledFundingIndexDelta":         &perpetuals.MsgSetMaxUnsettledFundingIndexDelta{},
+		"/dydxprotocol.perpetuals.MsgSetMaxUnsettledFundingIndexDeltaResponse": nil,
+		"/dydxprotocol.perpetuals.MsgSetSettlementPrice":                       &perpetuals.MsgSetSettlementPrice{},
+		"/dydxprotocol.perpetuals.MsgSetSettlementPriceResponse":               nil,
+		"/dydxprotocol.perpetuals.MsgUpdateParams":                             &perpetuals.MsgUpdateParams{},
+		"/dydxprotocol.perpetuals.MsgUpdateParamsResponse":                     nil,
+		"/dydxprotocol.perpetuals.MsgUpdatePerpetualParams":                    &perpetuals.MsgUpdatePerpetualParams{},
+		"/dydxprotocol.perpetuals.MsgUpdatePerpetualParamsResponse":            nil,
 
 		// prices
 		"/dydxprotocol.prices.MsgCreateOracleMarket":         &prices.MsgCreateOracleMarket{},
@@ -203,6 +214,12 @@ var (
 		"/dydxprotocol.stats.MsgUpdateParams":         &stats.MsgUpdateParams{},
 		"/dydxprotocol.stats.MsgUpdateParamsResponse": nil,
 
+		// subaccounts
+		"/dydxprotocol.subaccounts.MsgOpenHedgedPair":         &subaccounts.MsgOpenHedgedPair{},
+		"/dydxprotocol.subaccounts.MsgOpenHedgedPairResponse": nil,
+		"/dydxprotocol.subaccounts.MsgUpdateParams":           &subaccounts.MsgUpdateParams{},
+		"/dydxprotocol.subaccounts.MsgUpdateParamsResponse":   nil,
+
 		// vault
 		"/dydxprotocol.vault.MsgUnlockShares":                       &vault.MsgUnlockShares{},
 		"/dydxprotocol.vault.MsgUnlockSharesResponse":               nil,
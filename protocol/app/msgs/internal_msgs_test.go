@@ -96,6 +96,8 @@ func TestInternalMsgSamples_Gov_Key(t *testing.T) {
 		"/dydxprotocol.clob.MsgUpdateClobPairResponse",
 		"/dydxprotocol.clob.MsgUpdateEquityTierLimitConfiguration",
 		"/dydxprotocol.clob.MsgUpdateEquityTierLimitConfigurationResponse",
+		"/dydxprotocol.clob.MsgUpdateInsuranceFundOutflowCap",
+		"/dydxprotocol.clob.MsgUpdateInsuranceFundOutflowCapResponse",
 		"/dydxprotocol.clob.MsgUpdateLiquidationsConfig",
 		"/dydxprotocol.clob.MsgUpdateLiquidationsConfigResponse",
 
@@ -122,8 +124,16 @@ func TestInternalMsgSamples_Gov_Key(t *testing.T) {
 		// perpeutals
 		"/dydxprotocol.perpetuals.MsgCreatePerpetual",
 		"/dydxprotocol.perpetuals.MsgCreatePerpetualResponse",
+		"/dydxprotocol.perpetuals.MsgSetConcentrationMargin",
+		"/dydxprotocol.perpetuals.MsgSetConcentrationMarginResponse",
+		"/dydxprotocol.perpetuals.MsgSetFundingRateCap",
+		"/dydxprotocol.perpetuals.MsgSetFundingRateCapResponse",
 		"/dydxprotocol.perpetuals.MsgSetLiquidityTier",
 		"/dydxprotocol.perpetuals.MsgSetLiquidityTierResponse",
+		"/dydxprotocol.perpetuals.MsgSetMaxUnsettledFundingIndexDelta",
+		"/dydxprotocol.perpetuals.MsgSetMaxUnsettledFundingIndexDeltaResponse",
+		"/dydxprotocol.perpetuals.MsgSetSettlementPrice",
+		"/dydxprotocol.perpetuals.MsgSetSettlementPriceResponse",
 		"/dydxprotocol.perpetuals.MsgUpdateParams",
 		"/dydxprotocol.perpetuals.MsgUpdateParamsResponse",
 		"/dydxprotocol.perpetuals.MsgUpdatePerpetualParams",
@@ -159,6 +169,12 @@ func TestInternalMsgSamples_Gov_Key(t *testing.T) {
 		"/dydxprotocol.stats.MsgUpdateParams",
 		"/dydxprotocol.stats.MsgUpdateParamsResponse",
 
+		// subaccounts
+		"/dydxprotocol.subaccounts.MsgOpenHedgedPair",
+		"/dydxprotocol.subaccounts.MsgOpenHedgedPairResponse",
+		"/dydxprotocol.subaccounts.MsgUpdateParams",
+		"/dydxprotocol.subaccounts.MsgUpdateParamsResponse",
+
 		// vault
 		"/dydxprotocol.vault.MsgUnlockShares",
 		"/dydxprotocol.vault.MsgUnlockSharesResponse",
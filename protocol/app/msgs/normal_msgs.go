@@ -22,6 +22,7 @@ import (
 	clob "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	listing "github.com/dydxprotocol/v4-chain/protocol/x/listing/types"
 	sending "github.com/dydxprotocol/v4-chain/protocol/x/sending/types"
+	subaccounts "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	vault "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
 	marketmapmoduletypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 )
@@ -254,6 +255,14 @@ var (
 		"/dydxprotocol.sending.MsgWithdrawFromSubaccount":         &sending.MsgWithdrawFromSubaccount{},
 		"/dydxprotocol.sending.MsgWithdrawFromSubaccountResponse": nil,
 
+		// subaccounts
+		"/dydxprotocol.subaccounts.MsgRebalanceCollateral":         &subaccounts.MsgRebalanceCollateral{},
+		"/dydxprotocol.subaccounts.MsgRebalanceCollateralResponse": nil,
+		"/dydxprotocol.subaccounts.MsgSetMarginMode":               &subaccounts.MsgSetMarginMode{},
+		"/dydxprotocol.subaccounts.MsgSetMarginModeResponse":       nil,
+		"/dydxprotocol.subaccounts.MsgSetMaxLeverage":              &subaccounts.MsgSetMaxLeverage{},
+		"/dydxprotocol.subaccounts.MsgSetMaxLeverageResponse":      nil,
+
 		// vault
 		"/dydxprotocol.vault.MsgAllocateToVault":               &vault.MsgAllocateToVault{},
 		"/dydxprotocol.vault.MsgAllocateToVaultResponse":       nil,
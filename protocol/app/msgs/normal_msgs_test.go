@@ -167,6 +167,14 @@ func TestNormalMsgs_Key(t *testing.T) {
 		"/dydxprotocol.sending.MsgWithdrawFromSubaccount",
 		"/dydxprotocol.sending.MsgWithdrawFromSubaccountResponse",
 
+		// subaccounts
+		"/dydxprotocol.subaccounts.MsgRebalanceCollateral",
+		"/dydxprotocol.subaccounts.MsgRebalanceCollateralResponse",
+		"/dydxprotocol.subaccounts.MsgSetMarginMode",
+		"/dydxprotocol.subaccounts.MsgSetMarginModeResponse",
+		"/dydxprotocol.subaccounts.MsgSetMaxLeverage",
+		"/dydxprotocol.subaccounts.MsgSetMaxLeverageResponse",
+
 		// vault
 		"/dydxprotocol.vault.MsgAllocateToVault",
 		"/dydxprotocol.vault.MsgAllocateToVaultResponse",
@@ -11,6 +11,7 @@ import (
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	subaccountskeeper "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/keeper"
 
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -94,6 +95,7 @@ func CreateUpgradeHandler(
 	pricesKeeper pricestypes.PricesKeeper,
 	perpetualsKeeper perptypes.PerpetualsKeeper,
 	clobKeeper clobtypes.ClobKeeper,
+	subaccountsKeeper subaccountskeeper.Keeper,
 ) upgradetypes.UpgradeHandler {
 	return func(ctx context.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
 		sdkCtx := lib.UnwrapSDKContext(ctx, "app/upgrades")
@@ -102,6 +104,21 @@ func CreateUpgradeHandler(
 		// Set market, perpetual, and clob ids to a set number
 		setMarketListingBaseIds(sdkCtx, pricesKeeper, perpetualsKeeper, clobKeeper)
 
+		// Persist existing subaccount records in the current version of perpetual positions.
+		migrated, err := subaccountsKeeper.MigratePerpetualPositions(sdkCtx)
+		if err != nil {
+			return nil, err
+		}
+		sdkCtx.Logger().Info(fmt.Sprintf(
+			"Migrated %d subaccount records to the current perpetual position version",
+			migrated,
+		))
+
+		// Initialize the cost basis of existing perpetual positions from the current oracle prices.
+		if err := subaccountsKeeper.InitializeCostBasisForPerpetualPositions(sdkCtx); err != nil {
+			return nil, err
+		}
+
 		return mm.RunMigrations(ctx, configurator, vm)
 	}
 }
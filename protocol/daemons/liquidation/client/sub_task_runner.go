@@ -28,6 +28,9 @@ type SubTaskRunner interface {
 
 type SubTaskRunnerImpl struct {
 	lastLoopBlockHeight uint32
+	// lastLiquidatableSubaccountIds are the subaccounts found liquidatable in the last loop, used to report
+	// subaccounts that crossed their maintenance margin requirement. Nil before the first loop.
+	lastLiquidatableSubaccountIds []satypes.SubaccountId
 }
 
 // Ensure SubTaskRunnerImpl implements the SubTaskRunner interface.
@@ -88,6 +91,17 @@ func (s *SubTaskRunnerImpl) RunLiquidationDaemonTaskLoop(
 		return err
 	}
 
+	// Report subaccounts that crossed their maintenance margin requirement since the last loop. Nothing is
+	// reported on the first loop, since there is nothing to compare against.
+	if s.lastLiquidatableSubaccountIds != nil {
+		daemonClient.ReportMaintenanceMarginCrossings(
+			lastCommittedBlockHeight,
+			s.lastLiquidatableSubaccountIds,
+			liquidatableSubaccountIds,
+		)
+	}
+	s.lastLiquidatableSubaccountIds = liquidatableSubaccountIds
+
 	// Build a map of perpetual id to subaccounts with open positions in that perpetual.
 	subaccountOpenPositionInfo := daemonClient.GetSubaccountOpenPositionInfo(subaccounts)
 
@@ -252,6 +266,81 @@ func (c *Client) GetLiquidatableSubaccountIds(
 	return liquidatableSubaccountIds, negativeTncSubaccountIds, nil
 }
 
+// GetMaintenanceMarginCrossings returns the subaccounts that crossed their maintenance margin requirement
+// between two checks, given the subaccounts that were liquidatable at each check. Both returned lists are
+// in the order of the check they were found liquidatable in.
+func GetMaintenanceMarginCrossings(
+	previousLiquidatableSubaccountIds []satypes.SubaccountId,
+	liquidatableSubaccountIds []satypes.SubaccountId,
+) (
+	becameLiquidatable []satypes.SubaccountId,
+	becameHealthy []satypes.SubaccountId,
+) {
+	previous := make(map[satypes.SubaccountId]struct{}, len(previousLiquidatableSubaccountIds))
+	for _, subaccountId := range previousLiquidatableSubaccountIds {
+		previous[subaccountId] = struct{}{}
+	}
+	current := make(map[satypes.SubaccountId]struct{}, len(liquidatableSubaccountIds))
+	for _, subaccountId := range liquidatableSubaccountIds {
+		current[subaccountId] = struct{}{}
+	}
+
+	becameLiquidatable = make([]satypes.SubaccountId, 0)
+	for _, subaccountId := range liquidatableSubaccountIds {
+		if _, ok := previous[subaccountId]; !ok {
+			becameLiquidatable = append(becameLiquidatable, subaccountId)
+		}
+	}
+	becameHealthy = make([]satypes.SubaccountId, 0)
+	for _, subaccountId := range previousLiquidatableSubaccountIds {
+		if _, ok := current[subaccountId]; !ok {
+			becameHealthy = append(becameHealthy, subaccountId)
+		}
+	}
+	return becameLiquidatable, becameHealthy
+}
+
+// ReportMaintenanceMarginCrossings logs every subaccount that became liquidatable or stopped being
+// liquidatable between two checks and reports the number of each as metrics. This is only used for
+// alerting, so it does not affect which subaccounts are sent to the application.
+func (c *Client) ReportMaintenanceMarginCrossings(
+	blockHeight uint32,
+	previousLiquidatableSubaccountIds []satypes.SubaccountId,
+	liquidatableSubaccountIds []satypes.SubaccountId,
+) {
+	becameLiquidatable, becameHealthy := GetMaintenanceMarginCrossings(
+		previousLiquidatableSubaccountIds,
+		liquidatableSubaccountIds,
+	)
+	for _, subaccountId := range becameLiquidatable {
+		c.logger.Info(
+			"Subaccount became liquidatable",
+			"subaccountId", subaccountId,
+			"blockHeight", blockHeight,
+		)
+	}
+	for _, subaccountId := range becameHealthy {
+		c.logger.Info(
+			"Subaccount is no longer liquidatable",
+			"subaccountId", subaccountId,
+			"blockHeight", blockHeight,
+		)
+	}
+
+	telemetry.ModuleSetGauge(
+		metrics.LiquidationDaemon,
+		float32(len(becameLiquidatable)),
+		metrics.SubaccountsBecameLiquidatable,
+		metrics.Count,
+	)
+	telemetry.ModuleSetGauge(
+		metrics.LiquidationDaemon,
+		float32(len(becameHealthy)),
+		metrics.SubaccountsBecameHealthy,
+		metrics.Count,
+	)
+}
+
 // GetSubaccountOpenPositionInfo iterates over the given subaccounts and returns a map of
 // perpetual id to open position info.
 func (c *Client) GetSubaccountOpenPositionInfo(
@@ -784,3 +784,51 @@ func setupMockLiquidateSubaccountRequests(
 		}
 	}
 }
+
+func TestGetMaintenanceMarginCrossings(t *testing.T) {
+	tests := map[string]struct {
+		previousLiquidatableSubaccountIds []satypes.SubaccountId
+		liquidatableSubaccountIds         []satypes.SubaccountId
+
+		expectedBecameLiquidatable []satypes.SubaccountId
+		expectedBecameHealthy      []satypes.SubaccountId
+	}{
+		"No subaccounts liquidatable": {
+			previousLiquidatableSubaccountIds: []satypes.SubaccountId{},
+			liquidatableSubaccountIds:         []satypes.SubaccountId{},
+			expectedBecameLiquidatable:        []satypes.SubaccountId{},
+			expectedBecameHealthy:             []satypes.SubaccountId{},
+		},
+		"Same subaccounts liquidatable": {
+			previousLiquidatableSubaccountIds: []satypes.SubaccountId{constants.Alice_Num0, constants.Bob_Num0},
+			liquidatableSubaccountIds:         []satypes.SubaccountId{constants.Bob_Num0, constants.Alice_Num0},
+			expectedBecameLiquidatable:        []satypes.SubaccountId{},
+			expectedBecameHealthy:             []satypes.SubaccountId{},
+		},
+		"Subaccounts cross in both directions": {
+			previousLiquidatableSubaccountIds: []satypes.SubaccountId{
+				constants.Alice_Num0,
+				constants.Bob_Num0,
+				constants.Carl_Num0,
+			},
+			liquidatableSubaccountIds: []satypes.SubaccountId{
+				constants.Dave_Num0,
+				constants.Bob_Num0,
+				constants.Alice_Num1,
+			},
+			expectedBecameLiquidatable: []satypes.SubaccountId{constants.Dave_Num0, constants.Alice_Num1},
+			expectedBecameHealthy:      []satypes.SubaccountId{constants.Alice_Num0, constants.Carl_Num0},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			becameLiquidatable, becameHealthy := client.GetMaintenanceMarginCrossings(
+				tc.previousLiquidatableSubaccountIds,
+				tc.liquidatableSubaccountIds,
+			)
+			require.Equal(t, tc.expectedBecameLiquidatable, becameLiquidatable)
+			require.Equal(t, tc.expectedBecameHealthy, becameHealthy)
+		})
+	}
+}
@@ -59,9 +59,11 @@ func GetOrderRemovalReason(
 		return sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS, nil
 	}
 
-	switch orderStatus {
-	case clobtypes.Undercollateralized:
+	if orderStatus.IsCollateralCheckFailure() {
 		return sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_UNDERCOLLATERALIZED, nil
+	}
+
+	switch orderStatus {
 	case clobtypes.InternalError:
 		return sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_INTERNAL_ERROR, nil
 	case clobtypes.ImmediateOrCancelWouldRestOnBook:
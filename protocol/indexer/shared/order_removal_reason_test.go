@@ -40,6 +40,16 @@ func TestGetOrderRemovalReason_Success(t *testing.T) {
 			expectedReason: sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS,
 			expectedErr:    nil,
 		},
+		"Gets order removal reason for order status ViolatesTradingHalt": {
+			orderStatus:    clobtypes.ViolatesTradingHalt,
+			expectedReason: sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_UNDERCOLLATERALIZED,
+			expectedErr:    nil,
+		},
+		"Gets order removal reason for order status ViolatesMaxLeverage": {
+			orderStatus:    clobtypes.ViolatesMaxLeverage,
+			expectedReason: sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_UNDERCOLLATERALIZED,
+			expectedErr:    nil,
+		},
 		"Gets order removal reason for order error ErrFokOrderCouldNotBeFullyFilled": {
 			orderError:     clobtypes.ErrFokOrderCouldNotBeFullyFilled,
 			expectedReason: sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_FOK_ORDER_COULD_NOT_BE_FULLY_FULLED,
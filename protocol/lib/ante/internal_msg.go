@@ -31,6 +31,7 @@ import (
 	rewards "github.com/dydxprotocol/v4-chain/protocol/x/rewards/types"
 	sending "github.com/dydxprotocol/v4-chain/protocol/x/sending/types"
 	stats "github.com/dydxprotocol/v4-chain/protocol/x/stats/types"
+	subaccounts "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	vault "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
 	vest "github.com/dydxprotocol/v4-chain/protocol/x/vest/types"
 )
@@ -89,6 +90,7 @@ func IsInternalMsg(msg sdk.Msg) bool {
 		*clob.MsgUpdateBlockRateLimitConfiguration,
 		*clob.MsgUpdateClobPair,
 		*clob.MsgUpdateEquityTierLimitConfiguration,
+		*clob.MsgUpdateInsuranceFundOutflowCap,
 		*clob.MsgUpdateLiquidationsConfig,
 
 		// delaymsg
@@ -107,7 +109,11 @@ func IsInternalMsg(msg sdk.Msg) bool {
 
 		// perpetuals
 		*perpetuals.MsgCreatePerpetual,
+		*perpetuals.MsgSetConcentrationMargin,
+		*perpetuals.MsgSetFundingRateCap,
 		*perpetuals.MsgSetLiquidityTier,
+		*perpetuals.MsgSetMaxUnsettledFundingIndexDelta,
+		*perpetuals.MsgSetSettlementPrice,
 		*perpetuals.MsgUpdateParams,
 		*perpetuals.MsgUpdatePerpetualParams,
 
@@ -133,6 +139,10 @@ func IsInternalMsg(msg sdk.Msg) bool {
 		// stats
 		*stats.MsgUpdateParams,
 
+		// subaccounts
+		*subaccounts.MsgOpenHedgedPair,
+		*subaccounts.MsgUpdateParams,
+
 		// vault
 		*vault.MsgUnlockShares,
 		*vault.MsgUpdateDefaultQuotingParams,
@@ -1,9 +1,20 @@
 package margin
 
 import (
+	"fmt"
+	"math"
 	"math/big"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 )
 
+// MaxHealthPpm is the health of an account that has no maintenance margin requirement.
+const MaxHealthPpm = math.MaxUint64
+
+// OverUtilized is the utilization of an account with non-positive net collateral. The utilization of any other
+// account is never negative, so the two cannot be confused.
+const OverUtilized = -1
+
 // Risk is a struct to hold net collateral and margin requirements.
 // This can be applied to a single position or an entire account.
 type Risk struct {
@@ -37,6 +48,16 @@ func (a *Risk) IsInitialCollateralized() bool {
 	return a.NC.Cmp(a.IMR) >= 0
 }
 
+// IsInitialCollateralizedWithBuffer returns true if the account has enough net collateral to meet the
+// initial margin requirement increased by `bufferPpm` parts-per-million, i.e.
+// `NC >= IMR * (1 + bufferPpm / 1_000_000)`.
+func (a *Risk) IsInitialCollateralizedWithBuffer(bufferPpm uint32) bool {
+	// Checks the inequality without dividing.
+	ncPpm := new(big.Int).Mul(a.NC, lib.BigIntOneMillion())
+	bufferedImr := new(big.Int).Mul(a.IMR, new(big.Int).SetUint64(1_000_000+uint64(bufferPpm)))
+	return ncPpm.Cmp(bufferedImr) >= 0
+}
+
 // IsMaintenanceCollateralized returns true if the account has enough net collateral to meet the
 // maintenance margin requirement.
 func (a *Risk) IsMaintenanceCollateralized() bool {
@@ -53,6 +74,54 @@ func (a *Risk) IsLiquidatable() bool {
 	return a.MMR.Sign() > 0 && a.MMR.Cmp(a.NC) > 0
 }
 
+// HealthPpm returns the ratio of the account's net collateral to its maintenance margin requirement
+// in parts-per-million, rounded down. An account is liquidatable if its health is below 1_000_000.
+//
+// Note that the following special cases never divide by the maintenance margin requirement:
+// - Accounts with no maintenance margin requirement have a health of `MaxHealthPpm`.
+// - Accounts with non-positive net collateral and a maintenance margin requirement have a health of zero.
+func (a *Risk) HealthPpm() uint64 {
+	if a.MMR.Sign() == 0 {
+		return MaxHealthPpm
+	}
+	if a.NC.Sign() <= 0 {
+		return 0
+	}
+
+	healthPpm := new(big.Int).Mul(a.NC, lib.BigIntOneMillion())
+	healthPpm.Quo(healthPpm, a.MMR)
+	if !healthPpm.IsUint64() {
+		return MaxHealthPpm
+	}
+	return healthPpm.Uint64()
+}
+
+// Utilization returns the ratio of the account's initial margin requirement to its net collateral. An account
+// with a utilization above one is not initially collateralized.
+//
+// Accounts with non-positive net collateral are fully or over-utilized regardless of their initial margin
+// requirement and have a utilization of `OverUtilized`.
+func (a *Risk) Utilization() *big.Rat {
+	if a.NC.Sign() <= 0 {
+		return big.NewRat(OverUtilized, 1)
+	}
+	return new(big.Rat).SetFrac(a.IMR, a.NC)
+}
+
+// IsAtWarningLevel returns true if the account's net collateral is less than `warningLevelPpm`
+// parts-per-million of its maintenance margin requirement. Accounts with no maintenance margin
+// requirement are never at the warning level.
+func (a *Risk) IsAtWarningLevel(warningLevelPpm uint32) bool {
+	if a.MMR.Sign() == 0 {
+		return false
+	}
+
+	// Checks `NC / MMR < warningLevelPpm / 1_000_000` without dividing.
+	ncPpm := new(big.Int).Mul(a.NC, lib.BigIntOneMillion())
+	warningLevel := new(big.Int).Mul(a.MMR, new(big.Int).SetUint64(uint64(warningLevelPpm)))
+	return ncPpm.Cmp(warningLevel) < 0
+}
+
 // Cmp compares the risks of two accounts.
 // Returns -1 if a is less risky than b, 0 if they are equally risky, and 1 if a is more risky than b.
 
@@ -81,6 +150,13 @@ func (a *Risk) Cmp(b Risk) int {
 	return result
 }
 
+// String returns the canonical form `NC=<nc> IMR=<imr> MMR=<mmr>` of the risk, with every value in quote
+// quantums at full precision and `<nil>` for unset values, so that risks computed by different nodes
+// can be compared directly in logs.
+func (a Risk) String() string {
+	return fmt.Sprintf("NC=%s IMR=%s MMR=%s", a.NC.String(), a.IMR.String(), a.MMR.String())
+}
+
 func mustExist(i *big.Int) *big.Int {
 	if i == nil {
 		return new(big.Int)
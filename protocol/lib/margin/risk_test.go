@@ -1,6 +1,7 @@
 package margin_test
 
 import (
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -116,6 +117,62 @@ func TestRisk_IsInitialCollateralized(t *testing.T) {
 	}
 }
 
+func TestRisk_IsInitialCollateralizedWithBuffer(t *testing.T) {
+	tests := map[string]struct {
+		NC        *big.Int
+		IMR       *big.Int
+		bufferPpm uint32
+		expected  bool
+	}{
+		"NC > buffered IMR": {
+			NC:        big.NewInt(200),
+			IMR:       big.NewInt(100),
+			bufferPpm: 500_000,
+			expected:  true,
+		},
+		"NC = buffered IMR": {
+			NC:        big.NewInt(150),
+			IMR:       big.NewInt(100),
+			bufferPpm: 500_000,
+			expected:  true,
+		},
+		"IMR < NC < buffered IMR": {
+			NC:        big.NewInt(149),
+			IMR:       big.NewInt(100),
+			bufferPpm: 500_000,
+			expected:  false,
+		},
+		"NC = IMR, no buffer": {
+			NC:        big.NewInt(100),
+			IMR:       big.NewInt(100),
+			bufferPpm: 0,
+			expected:  true,
+		},
+		"NC = 0, IMR = 0": {
+			NC:        big.NewInt(0),
+			IMR:       big.NewInt(0),
+			bufferPpm: 500_000,
+			expected:  true,
+		},
+		"NC < 0, IMR = 0": {
+			NC:        big.NewInt(-100),
+			IMR:       big.NewInt(0),
+			bufferPpm: 500_000,
+			expected:  false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := margin.Risk{
+				IMR: tc.IMR,
+				NC:  tc.NC,
+			}
+			result := r.IsInitialCollateralizedWithBuffer(tc.bufferPpm)
+			require.Equal(t, tc.expected, result)
+		})
+	}
+}
+
 func TestRisk_IsMaintenanceCollateralized(t *testing.T) {
 	tests := map[string]struct {
 		NC       *big.Int
@@ -221,6 +278,186 @@ func TestRisk_IsLiquidatable(t *testing.T) {
 	}
 }
 
+func TestRisk_HealthPpm(t *testing.T) {
+	tests := map[string]struct {
+		NC       *big.Int
+		MMR      *big.Int
+		expected uint64
+	}{
+		"NC > 0, MMR = 0": {
+			NC:       big.NewInt(100),
+			MMR:      big.NewInt(0),
+			expected: margin.MaxHealthPpm,
+		},
+		"NC = 0, MMR = 0": {
+			NC:       big.NewInt(0),
+			MMR:      big.NewInt(0),
+			expected: margin.MaxHealthPpm,
+		},
+		"NC < 0, MMR = 0": {
+			NC:       big.NewInt(-100),
+			MMR:      big.NewInt(0),
+			expected: margin.MaxHealthPpm,
+		},
+		"NC = 0, MMR > 0": {
+			NC:       big.NewInt(0),
+			MMR:      big.NewInt(100),
+			expected: 0,
+		},
+		"NC < 0, MMR > 0": {
+			NC:       big.NewInt(-100),
+			MMR:      big.NewInt(100),
+			expected: 0,
+		},
+		"NC < MMR": {
+			NC:       big.NewInt(75),
+			MMR:      big.NewInt(100),
+			expected: 750_000,
+		},
+		"NC = MMR": {
+			NC:       big.NewInt(100),
+			MMR:      big.NewInt(100),
+			expected: 1_000_000,
+		},
+		"NC > MMR, rounds down": {
+			NC:       big.NewInt(200),
+			MMR:      big.NewInt(3),
+			expected: 66_666_666,
+		},
+		"health overflows uint64": {
+			NC:       new(big.Int).Lsh(big.NewInt(1), 64),
+			MMR:      big.NewInt(1),
+			expected: margin.MaxHealthPpm,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := margin.Risk{
+				MMR: tc.MMR,
+				NC:  tc.NC,
+			}
+			require.Equal(t, tc.expected, r.HealthPpm())
+		})
+	}
+}
+
+func TestRisk_Utilization(t *testing.T) {
+	tests := map[string]struct {
+		NC       *big.Int
+		IMR      *big.Int
+		expected *big.Rat
+	}{
+		"NC > 0, IMR = 0": {
+			NC:       big.NewInt(100),
+			IMR:      big.NewInt(0),
+			expected: big.NewRat(0, 1),
+		},
+		"NC > IMR": {
+			NC:       big.NewInt(300),
+			IMR:      big.NewInt(100),
+			expected: big.NewRat(1, 3),
+		},
+		"NC = IMR": {
+			NC:       big.NewInt(100),
+			IMR:      big.NewInt(100),
+			expected: big.NewRat(1, 1),
+		},
+		"0 < NC < IMR": {
+			NC:       big.NewInt(40),
+			IMR:      big.NewInt(100),
+			expected: big.NewRat(5, 2),
+		},
+		"NC = 0, IMR = 0": {
+			NC:       big.NewInt(0),
+			IMR:      big.NewInt(0),
+			expected: big.NewRat(margin.OverUtilized, 1),
+		},
+		"NC = 0, IMR > 0": {
+			NC:       big.NewInt(0),
+			IMR:      big.NewInt(100),
+			expected: big.NewRat(margin.OverUtilized, 1),
+		},
+		"NC < 0, IMR > 0": {
+			NC:       big.NewInt(-100),
+			IMR:      big.NewInt(100),
+			expected: big.NewRat(margin.OverUtilized, 1),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := margin.Risk{
+				IMR: tc.IMR,
+				NC:  tc.NC,
+			}
+			require.Zero(t, tc.expected.Cmp(r.Utilization()), r.Utilization().String())
+		})
+	}
+}
+
+func TestRisk_IsAtWarningLevel(t *testing.T) {
+	tests := map[string]struct {
+		NC              *big.Int
+		MMR             *big.Int
+		warningLevelPpm uint32
+		expected        bool
+	}{
+		"NC > 0, MMR = 0": {
+			NC:              big.NewInt(100),
+			MMR:             big.NewInt(0),
+			warningLevelPpm: 1_500_000,
+			expected:        false,
+		},
+		"NC < 0, MMR = 0": {
+			NC:              big.NewInt(-100),
+			MMR:             big.NewInt(0),
+			warningLevelPpm: 1_500_000,
+			expected:        false,
+		},
+		"NC < 0, MMR > 0": {
+			NC:              big.NewInt(-100),
+			MMR:             big.NewInt(100),
+			warningLevelPpm: 1_500_000,
+			expected:        true,
+		},
+		"NC below warning level": {
+			NC:              big.NewInt(149),
+			MMR:             big.NewInt(100),
+			warningLevelPpm: 1_500_000,
+			expected:        true,
+		},
+		"NC at warning level": {
+			NC:              big.NewInt(150),
+			MMR:             big.NewInt(100),
+			warningLevelPpm: 1_500_000,
+			expected:        false,
+		},
+		"NC above warning level": {
+			NC:              big.NewInt(151),
+			MMR:             big.NewInt(100),
+			warningLevelPpm: 1_500_000,
+			expected:        false,
+		},
+		"zero warning level": {
+			NC:              big.NewInt(0),
+			MMR:             big.NewInt(100),
+			warningLevelPpm: 0,
+			expected:        false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := margin.Risk{
+				MMR: tc.MMR,
+				NC:  tc.NC,
+			}
+			require.Equal(t, tc.expected, r.IsAtWarningLevel(tc.warningLevelPpm))
+		})
+	}
+}
+
 func TestRisk_Cmp(t *testing.T) {
 	tests := map[string]struct {
 		firstNC  *big.Int
@@ -361,3 +598,47 @@ func TestRisk_Cmp(t *testing.T) {
 		})
 	}
 }
+
+func TestRisk_String(t *testing.T) {
+	bigValue, ok := new(big.Int).SetString("-123456789012345678901234567890", 10)
+	require.True(t, ok)
+
+	tests := map[string]struct {
+		risk     margin.Risk
+		expected string
+	}{
+		"zero": {
+			risk:     margin.ZeroRisk(),
+			expected: "NC=0 IMR=0 MMR=0",
+		},
+		"positive": {
+			risk: margin.Risk{
+				NC:  big.NewInt(1_000_000),
+				IMR: big.NewInt(200_000),
+				MMR: big.NewInt(100_000),
+			},
+			expected: "NC=1000000 IMR=200000 MMR=100000",
+		},
+		"negative net collateral in full precision": {
+			risk: margin.Risk{
+				NC:  bigValue,
+				IMR: big.NewInt(200_000),
+				MMR: big.NewInt(100_000),
+			},
+			expected: "NC=-123456789012345678901234567890 IMR=200000 MMR=100000",
+		},
+		"nil fields": {
+			risk: margin.Risk{
+				NC: big.NewInt(-5),
+			},
+			expected: "NC=-5 IMR=<nil> MMR=<nil>",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.risk.String())
+			require.Equal(t, tc.expected, fmt.Sprint(tc.risk))
+		})
+	}
+}
@@ -321,6 +321,8 @@ const (
 	NegativeTncSubaccountIds                 = "negative_tnc_subaccount_ids"
 	PageLimit                                = "page_limit"
 	SendLiquidatableSubaccountIds            = "send_liquidatable_subaccount_ids"
+	SubaccountsBecameHealthy                 = "subaccounts_became_healthy"
+	SubaccountsBecameLiquidatable            = "subaccounts_became_liquidatable"
 	SubaccountsWithOpenPositions             = "subaccounts_with_open_positions"
 	SubaccountWithdrawalsAndTransfersBlocked = "subaccount_withdrawals_and_transfers_blocked"
 
@@ -339,6 +341,7 @@ const (
 	SubaccountMaxInsuranceLost            = "exceeds_subaccount_max_insurance_lost"
 	SubaccountMaxNotionalLiquidated       = "exceeds_subaccount_max_notional_liquidated"
 	LiquidationRequiresDeleveraging       = "liquidation_requires_deleveraging"
+	InsuranceFundOutflowCap               = "exceeds_insurance_fund_outflow_cap"
 	LiquidationMatchNegativeTNC           = "liquidation_match_negative_tnc"
 
 	// Deleveraging.
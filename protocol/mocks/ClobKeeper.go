@@ -332,6 +332,24 @@ func (_m *ClobKeeper) GetIndexerEventManager() indexer_manager.IndexerEventManag
 	return r0
 }
 
+// GetInsuranceFundOutflowCap provides a mock function with given fields: ctx
+func (_m *ClobKeeper) GetInsuranceFundOutflowCap(ctx types.Context) uint64 {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInsuranceFundOutflowCap")
+	}
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(types.Context) uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
 // GetLiquidationInsuranceFundDelta provides a mock function with given fields: ctx, subaccountId, perpetualId, isBuy, fillAmount, subticks
 func (_m *ClobKeeper) GetLiquidationInsuranceFundDelta(ctx types.Context, subaccountId subaccountstypes.SubaccountId, perpetualId uint32, isBuy bool, fillAmount uint64, subticks clobtypes.Subticks) (*big.Int, error) {
 	ret := _m.Called(ctx, subaccountId, perpetualId, isBuy, fillAmount, subticks)
@@ -1109,6 +1127,11 @@ func (_m *ClobKeeper) SendOrderbookUpdates(ctx types.Context, offchainUpdates *c
 	_m.Called(ctx, offchainUpdates)
 }
 
+// SetInsuranceFundOutflowCap provides a mock function with given fields: ctx, outflowCap
+func (_m *ClobKeeper) SetInsuranceFundOutflowCap(ctx types.Context, outflowCap uint64) {
+	_m.Called(ctx, outflowCap)
+}
+
 // SetLongTermOrderPlacement provides a mock function with given fields: ctx, order, blockHeight
 func (_m *ClobKeeper) SetLongTermOrderPlacement(ctx types.Context, order clobtypes.Order, blockHeight uint32) {
 	_m.Called(ctx, order, blockHeight)
@@ -268,6 +268,26 @@ func (_m *PerpetualsKeeper) GetPerpetual(ctx types.Context, id uint32) (perpetua
 	return r0, r1
 }
 
+// GetPerpetualsByLiquidityTier provides a mock function with given fields: ctx, liquidityTierId
+func (_m *PerpetualsKeeper) GetPerpetualsByLiquidityTier(ctx types.Context, liquidityTierId uint32) []uint32 {
+	ret := _m.Called(ctx, liquidityTierId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPerpetualsByLiquidityTier")
+	}
+
+	var r0 []uint32
+	if rf, ok := ret.Get(0).(func(types.Context, uint32) []uint32); ok {
+		r0 = rf(ctx, liquidityTierId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint32)
+		}
+	}
+
+	return r0
+}
+
 // HasAuthority provides a mock function with given fields: authority
 func (_m *PerpetualsKeeper) HasAuthority(authority string) bool {
 	ret := _m.Called(authority)
@@ -365,6 +385,42 @@ func (_m *PerpetualsKeeper) SendOIUpdatesToIndexer(ctx types.Context) {
 	_m.Called(ctx)
 }
 
+// SetConcentrationMargin provides a mock function with given fields: ctx, perpetualId, concentrationMargin
+func (_m *PerpetualsKeeper) SetConcentrationMargin(ctx types.Context, perpetualId uint32, concentrationMargin perpetualstypes.ConcentrationMargin) error {
+	ret := _m.Called(ctx, perpetualId, concentrationMargin)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetConcentrationMargin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, perpetualstypes.ConcentrationMargin) error); ok {
+		r0 = rf(ctx, perpetualId, concentrationMargin)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetFundingRateCapPpm provides a mock function with given fields: ctx, perpetualId, capPpm
+func (_m *PerpetualsKeeper) SetFundingRateCapPpm(ctx types.Context, perpetualId uint32, capPpm uint32) error {
+	ret := _m.Called(ctx, perpetualId, capPpm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetFundingRateCapPpm")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, uint32) error); ok {
+		r0 = rf(ctx, perpetualId, capPpm)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetLiquidityTier provides a mock function with given fields: ctx, id, name, initialMarginPpm, maintenanceFractionPpm, impactNotional, openInterestLowerCap, openInterestUpperCap
 func (_m *PerpetualsKeeper) SetLiquidityTier(ctx types.Context, id uint32, name string, initialMarginPpm uint32, maintenanceFractionPpm uint32, impactNotional uint64, openInterestLowerCap uint64, openInterestUpperCap uint64) (perpetualstypes.LiquidityTier, error) {
 	ret := _m.Called(ctx, id, name, initialMarginPpm, maintenanceFractionPpm, impactNotional, openInterestLowerCap, openInterestUpperCap)
@@ -393,6 +449,24 @@ func (_m *PerpetualsKeeper) SetLiquidityTier(ctx types.Context, id uint32, name
 	return r0, r1
 }
 
+// SetMaxUnsettledFundingIndexDelta provides a mock function with given fields: ctx, perpetualId, maxDelta
+func (_m *PerpetualsKeeper) SetMaxUnsettledFundingIndexDelta(ctx types.Context, perpetualId uint32, maxDelta uint64) error {
+	ret := _m.Called(ctx, perpetualId, maxDelta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMaxUnsettledFundingIndexDelta")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, uint64) error); ok {
+		r0 = rf(ctx, perpetualId, maxDelta)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetNextPerpetualID provides a mock function with given fields: ctx, nextID
 func (_m *PerpetualsKeeper) SetNextPerpetualID(ctx types.Context, nextID uint32) {
 	_m.Called(ctx, nextID)
@@ -444,6 +518,42 @@ func (_m *PerpetualsKeeper) SetPerpetualMarketType(ctx types.Context, id uint32,
 	return r0, r1
 }
 
+// SetSettlementPrice provides a mock function with given fields: ctx, perpetualId, settlementPrice
+func (_m *PerpetualsKeeper) SetSettlementPrice(ctx types.Context, perpetualId uint32, settlementPrice uint64) error {
+	ret := _m.Called(ctx, perpetualId, settlementPrice)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSettlementPrice")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, uint64) error); ok {
+		r0 = rf(ctx, perpetualId, settlementPrice)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ValidateAndSetLiquidityTier provides a mock function with given fields: ctx, liquidityTier
+func (_m *PerpetualsKeeper) ValidateAndSetLiquidityTier(ctx types.Context, liquidityTier perpetualstypes.LiquidityTier) error {
+	ret := _m.Called(ctx, liquidityTier)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateAndSetLiquidityTier")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, perpetualstypes.LiquidityTier) error); ok {
+		r0 = rf(ctx, liquidityTier)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ValidateAndSetPerpetual provides a mock function with given fields: ctx, perpetual
 func (_m *PerpetualsKeeper) ValidateAndSetPerpetual(ctx types.Context, perpetual perpetualstypes.Perpetual) error {
 	ret := _m.Called(ctx, perpetual)
@@ -30,6 +30,43 @@ type QueryClient struct {
 	mock.Mock
 }
 
+// ActionToRestoreInitialMargin provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) ActionToRestoreInitialMargin(ctx context.Context, in *subaccountstypes.QueryActionToRestoreInitialMarginRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryActionToRestoreInitialMarginResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ActionToRestoreInitialMargin")
+	}
+
+	var r0 *subaccountstypes.QueryActionToRestoreInitialMarginResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryActionToRestoreInitialMarginRequest, ...grpc.CallOption) (*subaccountstypes.QueryActionToRestoreInitialMarginResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryActionToRestoreInitialMarginRequest, ...grpc.CallOption) *subaccountstypes.QueryActionToRestoreInitialMarginResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QueryActionToRestoreInitialMarginResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryActionToRestoreInitialMarginRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddBridgeEvents provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) AddBridgeEvents(ctx context.Context, in *api.AddBridgeEventsRequest, opts ...grpc.CallOption) (*api.AddBridgeEventsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -252,8 +289,8 @@ func (_m *QueryClient) AllPerpetuals(ctx context.Context, in *perpetualstypes.Qu
 	return r0, r1
 }
 
-// BlockRateLimitConfiguration provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) BlockRateLimitConfiguration(ctx context.Context, in *clobtypes.QueryBlockRateLimitConfigurationRequest, opts ...grpc.CallOption) (*clobtypes.QueryBlockRateLimitConfigurationResponse, error) {
+// AutoWithdrawableAccounts provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) AutoWithdrawableAccounts(ctx context.Context, in *subaccountstypes.QueryAutoWithdrawableAccountsRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryAutoWithdrawableAccountsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -264,23 +301,23 @@ func (_m *QueryClient) BlockRateLimitConfiguration(ctx context.Context, in *clob
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for BlockRateLimitConfiguration")
+		panic("no return value specified for AutoWithdrawableAccounts")
 	}
 
-	var r0 *clobtypes.QueryBlockRateLimitConfigurationResponse
+	var r0 *subaccountstypes.QueryAutoWithdrawableAccountsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryBlockRateLimitConfigurationRequest, ...grpc.CallOption) (*clobtypes.QueryBlockRateLimitConfigurationResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryAutoWithdrawableAccountsRequest, ...grpc.CallOption) (*subaccountstypes.QueryAutoWithdrawableAccountsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryBlockRateLimitConfigurationRequest, ...grpc.CallOption) *clobtypes.QueryBlockRateLimitConfigurationResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryAutoWithdrawableAccountsRequest, ...grpc.CallOption) *subaccountstypes.QueryAutoWithdrawableAccountsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*clobtypes.QueryBlockRateLimitConfigurationResponse)
+			r0 = ret.Get(0).(*subaccountstypes.QueryAutoWithdrawableAccountsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryBlockRateLimitConfigurationRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryAutoWithdrawableAccountsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -289,8 +326,8 @@ func (_m *QueryClient) BlockRateLimitConfiguration(ctx context.Context, in *clob
 	return r0, r1
 }
 
-// ClobPair provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) ClobPair(ctx context.Context, in *clobtypes.QueryGetClobPairRequest, opts ...grpc.CallOption) (*clobtypes.QueryClobPairResponse, error) {
+// BasketShockToLiquidate provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) BasketShockToLiquidate(ctx context.Context, in *subaccountstypes.QueryBasketShockToLiquidateRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryBasketShockToLiquidateResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -301,23 +338,23 @@ func (_m *QueryClient) ClobPair(ctx context.Context, in *clobtypes.QueryGetClobP
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ClobPair")
+		panic("no return value specified for BasketShockToLiquidate")
 	}
 
-	var r0 *clobtypes.QueryClobPairResponse
+	var r0 *subaccountstypes.QueryBasketShockToLiquidateResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryGetClobPairRequest, ...grpc.CallOption) (*clobtypes.QueryClobPairResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryBasketShockToLiquidateRequest, ...grpc.CallOption) (*subaccountstypes.QueryBasketShockToLiquidateResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryGetClobPairRequest, ...grpc.CallOption) *clobtypes.QueryClobPairResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryBasketShockToLiquidateRequest, ...grpc.CallOption) *subaccountstypes.QueryBasketShockToLiquidateResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*clobtypes.QueryClobPairResponse)
+			r0 = ret.Get(0).(*subaccountstypes.QueryBasketShockToLiquidateResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryGetClobPairRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryBasketShockToLiquidateRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -326,8 +363,8 @@ func (_m *QueryClient) ClobPair(ctx context.Context, in *clobtypes.QueryGetClobP
 	return r0, r1
 }
 
-// ClobPairAll provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) ClobPairAll(ctx context.Context, in *clobtypes.QueryAllClobPairRequest, opts ...grpc.CallOption) (*clobtypes.QueryClobPairAllResponse, error) {
+// BlockRateLimitConfiguration provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) BlockRateLimitConfiguration(ctx context.Context, in *clobtypes.QueryBlockRateLimitConfigurationRequest, opts ...grpc.CallOption) (*clobtypes.QueryBlockRateLimitConfigurationResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -338,23 +375,23 @@ func (_m *QueryClient) ClobPairAll(ctx context.Context, in *clobtypes.QueryAllCl
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ClobPairAll")
+		panic("no return value specified for BlockRateLimitConfiguration")
 	}
 
-	var r0 *clobtypes.QueryClobPairAllResponse
+	var r0 *clobtypes.QueryBlockRateLimitConfigurationResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryAllClobPairRequest, ...grpc.CallOption) (*clobtypes.QueryClobPairAllResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryBlockRateLimitConfigurationRequest, ...grpc.CallOption) (*clobtypes.QueryBlockRateLimitConfigurationResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryAllClobPairRequest, ...grpc.CallOption) *clobtypes.QueryClobPairAllResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryBlockRateLimitConfigurationRequest, ...grpc.CallOption) *clobtypes.QueryBlockRateLimitConfigurationResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*clobtypes.QueryClobPairAllResponse)
+			r0 = ret.Get(0).(*clobtypes.QueryBlockRateLimitConfigurationResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryAllClobPairRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryBlockRateLimitConfigurationRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -363,8 +400,8 @@ func (_m *QueryClient) ClobPairAll(ctx context.Context, in *clobtypes.QueryAllCl
 	return r0, r1
 }
 
-// CollateralPoolAddress provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) CollateralPoolAddress(ctx context.Context, in *subaccountstypes.QueryCollateralPoolAddressRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryCollateralPoolAddressResponse, error) {
+// BreakEvenPrice provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) BreakEvenPrice(ctx context.Context, in *subaccountstypes.QueryBreakEvenPriceRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryBreakEvenPriceResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -375,23 +412,23 @@ func (_m *QueryClient) CollateralPoolAddress(ctx context.Context, in *subaccount
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CollateralPoolAddress")
+		panic("no return value specified for BreakEvenPrice")
 	}
 
-	var r0 *subaccountstypes.QueryCollateralPoolAddressResponse
+	var r0 *subaccountstypes.QueryBreakEvenPriceResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryCollateralPoolAddressRequest, ...grpc.CallOption) (*subaccountstypes.QueryCollateralPoolAddressResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryBreakEvenPriceRequest, ...grpc.CallOption) (*subaccountstypes.QueryBreakEvenPriceResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryCollateralPoolAddressRequest, ...grpc.CallOption) *subaccountstypes.QueryCollateralPoolAddressResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryBreakEvenPriceRequest, ...grpc.CallOption) *subaccountstypes.QueryBreakEvenPriceResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*subaccountstypes.QueryCollateralPoolAddressResponse)
+			r0 = ret.Get(0).(*subaccountstypes.QueryBreakEvenPriceResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryCollateralPoolAddressRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryBreakEvenPriceRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -400,8 +437,8 @@ func (_m *QueryClient) CollateralPoolAddress(ctx context.Context, in *subaccount
 	return r0, r1
 }
 
-// DowntimeParams provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) DowntimeParams(ctx context.Context, in *types.QueryDowntimeParamsRequest, opts ...grpc.CallOption) (*types.QueryDowntimeParamsResponse, error) {
+// ClobPair provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) ClobPair(ctx context.Context, in *clobtypes.QueryGetClobPairRequest, opts ...grpc.CallOption) (*clobtypes.QueryClobPairResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -412,23 +449,23 @@ func (_m *QueryClient) DowntimeParams(ctx context.Context, in *types.QueryDownti
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DowntimeParams")
+		panic("no return value specified for ClobPair")
 	}
 
-	var r0 *types.QueryDowntimeParamsResponse
+	var r0 *clobtypes.QueryClobPairResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryDowntimeParamsRequest, ...grpc.CallOption) (*types.QueryDowntimeParamsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryGetClobPairRequest, ...grpc.CallOption) (*clobtypes.QueryClobPairResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryDowntimeParamsRequest, ...grpc.CallOption) *types.QueryDowntimeParamsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryGetClobPairRequest, ...grpc.CallOption) *clobtypes.QueryClobPairResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.QueryDowntimeParamsResponse)
+			r0 = ret.Get(0).(*clobtypes.QueryClobPairResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryDowntimeParamsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryGetClobPairRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -437,8 +474,8 @@ func (_m *QueryClient) DowntimeParams(ctx context.Context, in *types.QueryDownti
 	return r0, r1
 }
 
-// EquityTierLimitConfiguration provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) EquityTierLimitConfiguration(ctx context.Context, in *clobtypes.QueryEquityTierLimitConfigurationRequest, opts ...grpc.CallOption) (*clobtypes.QueryEquityTierLimitConfigurationResponse, error) {
+// ClobPairAll provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) ClobPairAll(ctx context.Context, in *clobtypes.QueryAllClobPairRequest, opts ...grpc.CallOption) (*clobtypes.QueryClobPairAllResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -449,23 +486,23 @@ func (_m *QueryClient) EquityTierLimitConfiguration(ctx context.Context, in *clo
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for EquityTierLimitConfiguration")
+		panic("no return value specified for ClobPairAll")
 	}
 
-	var r0 *clobtypes.QueryEquityTierLimitConfigurationResponse
+	var r0 *clobtypes.QueryClobPairAllResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryEquityTierLimitConfigurationRequest, ...grpc.CallOption) (*clobtypes.QueryEquityTierLimitConfigurationResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryAllClobPairRequest, ...grpc.CallOption) (*clobtypes.QueryClobPairAllResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryEquityTierLimitConfigurationRequest, ...grpc.CallOption) *clobtypes.QueryEquityTierLimitConfigurationResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryAllClobPairRequest, ...grpc.CallOption) *clobtypes.QueryClobPairAllResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*clobtypes.QueryEquityTierLimitConfigurationResponse)
+			r0 = ret.Get(0).(*clobtypes.QueryClobPairAllResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryEquityTierLimitConfigurationRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryAllClobPairRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -474,8 +511,8 @@ func (_m *QueryClient) EquityTierLimitConfiguration(ctx context.Context, in *clo
 	return r0, r1
 }
 
-// GetWithdrawalAndTransfersBlockedInfo provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) GetWithdrawalAndTransfersBlockedInfo(ctx context.Context, in *subaccountstypes.QueryGetWithdrawalAndTransfersBlockedInfoRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryGetWithdrawalAndTransfersBlockedInfoResponse, error) {
+// CloseAllCost provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) CloseAllCost(ctx context.Context, in *subaccountstypes.QueryCloseAllCostRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryCloseAllCostResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -486,23 +523,23 @@ func (_m *QueryClient) GetWithdrawalAndTransfersBlockedInfo(ctx context.Context,
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetWithdrawalAndTransfersBlockedInfo")
+		panic("no return value specified for CloseAllCost")
 	}
 
-	var r0 *subaccountstypes.QueryGetWithdrawalAndTransfersBlockedInfoResponse
+	var r0 *subaccountstypes.QueryCloseAllCostResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryGetWithdrawalAndTransfersBlockedInfoRequest, ...grpc.CallOption) (*subaccountstypes.QueryGetWithdrawalAndTransfersBlockedInfoResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryCloseAllCostRequest, ...grpc.CallOption) (*subaccountstypes.QueryCloseAllCostResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryGetWithdrawalAndTransfersBlockedInfoRequest, ...grpc.CallOption) *subaccountstypes.QueryGetWithdrawalAndTransfersBlockedInfoResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryCloseAllCostRequest, ...grpc.CallOption) *subaccountstypes.QueryCloseAllCostResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*subaccountstypes.QueryGetWithdrawalAndTransfersBlockedInfoResponse)
+			r0 = ret.Get(0).(*subaccountstypes.QueryCloseAllCostResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryGetWithdrawalAndTransfersBlockedInfoRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryCloseAllCostRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -511,8 +548,8 @@ func (_m *QueryClient) GetWithdrawalAndTransfersBlockedInfo(ctx context.Context,
 	return r0, r1
 }
 
-// LiquidateSubaccounts provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) LiquidateSubaccounts(ctx context.Context, in *liquidationapi.LiquidateSubaccountsRequest, opts ...grpc.CallOption) (*liquidationapi.LiquidateSubaccountsResponse, error) {
+// CollateralPoolAddress provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) CollateralPoolAddress(ctx context.Context, in *subaccountstypes.QueryCollateralPoolAddressRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryCollateralPoolAddressResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -523,23 +560,23 @@ func (_m *QueryClient) LiquidateSubaccounts(ctx context.Context, in *liquidation
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LiquidateSubaccounts")
+		panic("no return value specified for CollateralPoolAddress")
 	}
 
-	var r0 *liquidationapi.LiquidateSubaccountsResponse
+	var r0 *subaccountstypes.QueryCollateralPoolAddressResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *liquidationapi.LiquidateSubaccountsRequest, ...grpc.CallOption) (*liquidationapi.LiquidateSubaccountsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryCollateralPoolAddressRequest, ...grpc.CallOption) (*subaccountstypes.QueryCollateralPoolAddressResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *liquidationapi.LiquidateSubaccountsRequest, ...grpc.CallOption) *liquidationapi.LiquidateSubaccountsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryCollateralPoolAddressRequest, ...grpc.CallOption) *subaccountstypes.QueryCollateralPoolAddressResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*liquidationapi.LiquidateSubaccountsResponse)
+			r0 = ret.Get(0).(*subaccountstypes.QueryCollateralPoolAddressResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *liquidationapi.LiquidateSubaccountsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryCollateralPoolAddressRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -548,8 +585,8 @@ func (_m *QueryClient) LiquidateSubaccounts(ctx context.Context, in *liquidation
 	return r0, r1
 }
 
-// LiquidationsConfiguration provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) LiquidationsConfiguration(ctx context.Context, in *clobtypes.QueryLiquidationsConfigurationRequest, opts ...grpc.CallOption) (*clobtypes.QueryLiquidationsConfigurationResponse, error) {
+// ComputeRiskForHypothetical provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) ComputeRiskForHypothetical(ctx context.Context, in *subaccountstypes.QueryComputeRiskForHypotheticalRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryComputeRiskForHypotheticalResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -560,23 +597,23 @@ func (_m *QueryClient) LiquidationsConfiguration(ctx context.Context, in *clobty
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LiquidationsConfiguration")
+		panic("no return value specified for ComputeRiskForHypothetical")
 	}
 
-	var r0 *clobtypes.QueryLiquidationsConfigurationResponse
+	var r0 *subaccountstypes.QueryComputeRiskForHypotheticalResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryLiquidationsConfigurationRequest, ...grpc.CallOption) (*clobtypes.QueryLiquidationsConfigurationResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryComputeRiskForHypotheticalRequest, ...grpc.CallOption) (*subaccountstypes.QueryComputeRiskForHypotheticalResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryLiquidationsConfigurationRequest, ...grpc.CallOption) *clobtypes.QueryLiquidationsConfigurationResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryComputeRiskForHypotheticalRequest, ...grpc.CallOption) *subaccountstypes.QueryComputeRiskForHypotheticalResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*clobtypes.QueryLiquidationsConfigurationResponse)
+			r0 = ret.Get(0).(*subaccountstypes.QueryComputeRiskForHypotheticalResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryLiquidationsConfigurationRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryComputeRiskForHypotheticalRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -585,8 +622,8 @@ func (_m *QueryClient) LiquidationsConfiguration(ctx context.Context, in *clobty
 	return r0, r1
 }
 
-// MarketParam provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) MarketParam(ctx context.Context, in *pricestypes.QueryMarketParamRequest, opts ...grpc.CallOption) (*pricestypes.QueryMarketParamResponse, error) {
+// DowntimeParams provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) DowntimeParams(ctx context.Context, in *types.QueryDowntimeParamsRequest, opts ...grpc.CallOption) (*types.QueryDowntimeParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -597,23 +634,23 @@ func (_m *QueryClient) MarketParam(ctx context.Context, in *pricestypes.QueryMar
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MarketParam")
+		panic("no return value specified for DowntimeParams")
 	}
 
-	var r0 *pricestypes.QueryMarketParamResponse
+	var r0 *types.QueryDowntimeParamsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *pricestypes.QueryMarketParamRequest, ...grpc.CallOption) (*pricestypes.QueryMarketParamResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryDowntimeParamsRequest, ...grpc.CallOption) (*types.QueryDowntimeParamsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *pricestypes.QueryMarketParamRequest, ...grpc.CallOption) *pricestypes.QueryMarketParamResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryDowntimeParamsRequest, ...grpc.CallOption) *types.QueryDowntimeParamsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pricestypes.QueryMarketParamResponse)
+			r0 = ret.Get(0).(*types.QueryDowntimeParamsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *pricestypes.QueryMarketParamRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryDowntimeParamsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -622,8 +659,8 @@ func (_m *QueryClient) MarketParam(ctx context.Context, in *pricestypes.QueryMar
 	return r0, r1
 }
 
-// MarketPrice provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) MarketPrice(ctx context.Context, in *pricestypes.QueryMarketPriceRequest, opts ...grpc.CallOption) (*pricestypes.QueryMarketPriceResponse, error) {
+// EquityTierLimitConfiguration provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) EquityTierLimitConfiguration(ctx context.Context, in *clobtypes.QueryEquityTierLimitConfigurationRequest, opts ...grpc.CallOption) (*clobtypes.QueryEquityTierLimitConfigurationResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -634,23 +671,23 @@ func (_m *QueryClient) MarketPrice(ctx context.Context, in *pricestypes.QueryMar
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MarketPrice")
+		panic("no return value specified for EquityTierLimitConfiguration")
 	}
 
-	var r0 *pricestypes.QueryMarketPriceResponse
+	var r0 *clobtypes.QueryEquityTierLimitConfigurationResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *pricestypes.QueryMarketPriceRequest, ...grpc.CallOption) (*pricestypes.QueryMarketPriceResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryEquityTierLimitConfigurationRequest, ...grpc.CallOption) (*clobtypes.QueryEquityTierLimitConfigurationResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *pricestypes.QueryMarketPriceRequest, ...grpc.CallOption) *pricestypes.QueryMarketPriceResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryEquityTierLimitConfigurationRequest, ...grpc.CallOption) *clobtypes.QueryEquityTierLimitConfigurationResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pricestypes.QueryMarketPriceResponse)
+			r0 = ret.Get(0).(*clobtypes.QueryEquityTierLimitConfigurationResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *pricestypes.QueryMarketPriceRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryEquityTierLimitConfigurationRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -659,8 +696,8 @@ func (_m *QueryClient) MarketPrice(ctx context.Context, in *pricestypes.QueryMar
 	return r0, r1
 }
 
-// MevNodeToNodeCalculation provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) MevNodeToNodeCalculation(ctx context.Context, in *clobtypes.MevNodeToNodeCalculationRequest, opts ...grpc.CallOption) (*clobtypes.MevNodeToNodeCalculationResponse, error) {
+// FlatteningSize provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) FlatteningSize(ctx context.Context, in *subaccountstypes.QueryFlatteningSizeRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryFlatteningSizeResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -671,23 +708,23 @@ func (_m *QueryClient) MevNodeToNodeCalculation(ctx context.Context, in *clobtyp
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MevNodeToNodeCalculation")
+		panic("no return value specified for FlatteningSize")
 	}
 
-	var r0 *clobtypes.MevNodeToNodeCalculationResponse
+	var r0 *subaccountstypes.QueryFlatteningSizeResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.MevNodeToNodeCalculationRequest, ...grpc.CallOption) (*clobtypes.MevNodeToNodeCalculationResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryFlatteningSizeRequest, ...grpc.CallOption) (*subaccountstypes.QueryFlatteningSizeResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.MevNodeToNodeCalculationRequest, ...grpc.CallOption) *clobtypes.MevNodeToNodeCalculationResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryFlatteningSizeRequest, ...grpc.CallOption) *subaccountstypes.QueryFlatteningSizeResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*clobtypes.MevNodeToNodeCalculationResponse)
+			r0 = ret.Get(0).(*subaccountstypes.QueryFlatteningSizeResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.MevNodeToNodeCalculationRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryFlatteningSizeRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -696,8 +733,8 @@ func (_m *QueryClient) MevNodeToNodeCalculation(ctx context.Context, in *clobtyp
 	return r0, r1
 }
 
-// NextClobPairId provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) NextClobPairId(ctx context.Context, in *clobtypes.QueryNextClobPairIdRequest, opts ...grpc.CallOption) (*clobtypes.QueryNextClobPairIdResponse, error) {
+// FundingHistory provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) FundingHistory(ctx context.Context, in *subaccountstypes.QueryFundingHistoryRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryFundingHistoryResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -708,23 +745,23 @@ func (_m *QueryClient) NextClobPairId(ctx context.Context, in *clobtypes.QueryNe
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for NextClobPairId")
+		panic("no return value specified for FundingHistory")
 	}
 
-	var r0 *clobtypes.QueryNextClobPairIdResponse
+	var r0 *subaccountstypes.QueryFundingHistoryResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryNextClobPairIdRequest, ...grpc.CallOption) (*clobtypes.QueryNextClobPairIdResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryFundingHistoryRequest, ...grpc.CallOption) (*subaccountstypes.QueryFundingHistoryResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryNextClobPairIdRequest, ...grpc.CallOption) *clobtypes.QueryNextClobPairIdResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryFundingHistoryRequest, ...grpc.CallOption) *subaccountstypes.QueryFundingHistoryResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*clobtypes.QueryNextClobPairIdResponse)
+			r0 = ret.Get(0).(*subaccountstypes.QueryFundingHistoryResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryNextClobPairIdRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryFundingHistoryRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -733,8 +770,8 @@ func (_m *QueryClient) NextClobPairId(ctx context.Context, in *clobtypes.QueryNe
 	return r0, r1
 }
 
-// NextMarketId provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) NextMarketId(ctx context.Context, in *pricestypes.QueryNextMarketIdRequest, opts ...grpc.CallOption) (*pricestypes.QueryNextMarketIdResponse, error) {
+// GetWithdrawalAndTransfersBlockedInfo provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) GetWithdrawalAndTransfersBlockedInfo(ctx context.Context, in *subaccountstypes.QueryGetWithdrawalAndTransfersBlockedInfoRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryGetWithdrawalAndTransfersBlockedInfoResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -745,23 +782,23 @@ func (_m *QueryClient) NextMarketId(ctx context.Context, in *pricestypes.QueryNe
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for NextMarketId")
+		panic("no return value specified for GetWithdrawalAndTransfersBlockedInfo")
 	}
 
-	var r0 *pricestypes.QueryNextMarketIdResponse
+	var r0 *subaccountstypes.QueryGetWithdrawalAndTransfersBlockedInfoResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *pricestypes.QueryNextMarketIdRequest, ...grpc.CallOption) (*pricestypes.QueryNextMarketIdResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryGetWithdrawalAndTransfersBlockedInfoRequest, ...grpc.CallOption) (*subaccountstypes.QueryGetWithdrawalAndTransfersBlockedInfoResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *pricestypes.QueryNextMarketIdRequest, ...grpc.CallOption) *pricestypes.QueryNextMarketIdResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryGetWithdrawalAndTransfersBlockedInfoRequest, ...grpc.CallOption) *subaccountstypes.QueryGetWithdrawalAndTransfersBlockedInfoResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pricestypes.QueryNextMarketIdResponse)
+			r0 = ret.Get(0).(*subaccountstypes.QueryGetWithdrawalAndTransfersBlockedInfoResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *pricestypes.QueryNextMarketIdRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryGetWithdrawalAndTransfersBlockedInfoRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -770,8 +807,8 @@ func (_m *QueryClient) NextMarketId(ctx context.Context, in *pricestypes.QueryNe
 	return r0, r1
 }
 
-// NextPerpetualId provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) NextPerpetualId(ctx context.Context, in *perpetualstypes.QueryNextPerpetualIdRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryNextPerpetualIdResponse, error) {
+// HealthDistribution provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) HealthDistribution(ctx context.Context, in *subaccountstypes.QueryHealthDistributionRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryHealthDistributionResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -782,23 +819,23 @@ func (_m *QueryClient) NextPerpetualId(ctx context.Context, in *perpetualstypes.
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for NextPerpetualId")
+		panic("no return value specified for HealthDistribution")
 	}
 
-	var r0 *perpetualstypes.QueryNextPerpetualIdResponse
+	var r0 *subaccountstypes.QueryHealthDistributionResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryNextPerpetualIdRequest, ...grpc.CallOption) (*perpetualstypes.QueryNextPerpetualIdResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryHealthDistributionRequest, ...grpc.CallOption) (*subaccountstypes.QueryHealthDistributionResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryNextPerpetualIdRequest, ...grpc.CallOption) *perpetualstypes.QueryNextPerpetualIdResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryHealthDistributionRequest, ...grpc.CallOption) *subaccountstypes.QueryHealthDistributionResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*perpetualstypes.QueryNextPerpetualIdResponse)
+			r0 = ret.Get(0).(*subaccountstypes.QueryHealthDistributionResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *perpetualstypes.QueryNextPerpetualIdRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryHealthDistributionRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -807,8 +844,8 @@ func (_m *QueryClient) NextPerpetualId(ctx context.Context, in *perpetualstypes.
 	return r0, r1
 }
 
-// Params provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) Params(ctx context.Context, in *perpetualstypes.QueryParamsRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryParamsResponse, error) {
+// HistoricalFundingIndex provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) HistoricalFundingIndex(ctx context.Context, in *perpetualstypes.QueryHistoricalFundingIndexRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryHistoricalFundingIndexResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -819,23 +856,23 @@ func (_m *QueryClient) Params(ctx context.Context, in *perpetualstypes.QueryPara
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Params")
+		panic("no return value specified for HistoricalFundingIndex")
 	}
 
-	var r0 *perpetualstypes.QueryParamsResponse
+	var r0 *perpetualstypes.QueryHistoricalFundingIndexResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryParamsRequest, ...grpc.CallOption) (*perpetualstypes.QueryParamsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryHistoricalFundingIndexRequest, ...grpc.CallOption) (*perpetualstypes.QueryHistoricalFundingIndexResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryParamsRequest, ...grpc.CallOption) *perpetualstypes.QueryParamsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryHistoricalFundingIndexRequest, ...grpc.CallOption) *perpetualstypes.QueryHistoricalFundingIndexResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*perpetualstypes.QueryParamsResponse)
+			r0 = ret.Get(0).(*perpetualstypes.QueryHistoricalFundingIndexResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *perpetualstypes.QueryParamsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *perpetualstypes.QueryHistoricalFundingIndexRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -844,8 +881,8 @@ func (_m *QueryClient) Params(ctx context.Context, in *perpetualstypes.QueryPara
 	return r0, r1
 }
 
-// Perpetual provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) Perpetual(ctx context.Context, in *perpetualstypes.QueryPerpetualRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryPerpetualResponse, error) {
+// HistoricalMarginFractions provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) HistoricalMarginFractions(ctx context.Context, in *perpetualstypes.QueryHistoricalMarginFractionsRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryHistoricalMarginFractionsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -856,23 +893,23 @@ func (_m *QueryClient) Perpetual(ctx context.Context, in *perpetualstypes.QueryP
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Perpetual")
+		panic("no return value specified for HistoricalMarginFractions")
 	}
 
-	var r0 *perpetualstypes.QueryPerpetualResponse
+	var r0 *perpetualstypes.QueryHistoricalMarginFractionsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryPerpetualRequest, ...grpc.CallOption) (*perpetualstypes.QueryPerpetualResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryHistoricalMarginFractionsRequest, ...grpc.CallOption) (*perpetualstypes.QueryHistoricalMarginFractionsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryPerpetualRequest, ...grpc.CallOption) *perpetualstypes.QueryPerpetualResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryHistoricalMarginFractionsRequest, ...grpc.CallOption) *perpetualstypes.QueryHistoricalMarginFractionsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*perpetualstypes.QueryPerpetualResponse)
+			r0 = ret.Get(0).(*perpetualstypes.QueryHistoricalMarginFractionsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *perpetualstypes.QueryPerpetualRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *perpetualstypes.QueryHistoricalMarginFractionsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -881,8 +918,8 @@ func (_m *QueryClient) Perpetual(ctx context.Context, in *perpetualstypes.QueryP
 	return r0, r1
 }
 
-// PremiumSamples provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) PremiumSamples(ctx context.Context, in *perpetualstypes.QueryPremiumSamplesRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryPremiumSamplesResponse, error) {
+// LiquidatableAccounts provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) LiquidatableAccounts(ctx context.Context, in *subaccountstypes.QueryLiquidatableAccountsRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryLiquidatableAccountsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -893,23 +930,23 @@ func (_m *QueryClient) PremiumSamples(ctx context.Context, in *perpetualstypes.Q
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PremiumSamples")
+		panic("no return value specified for LiquidatableAccounts")
 	}
 
-	var r0 *perpetualstypes.QueryPremiumSamplesResponse
+	var r0 *subaccountstypes.QueryLiquidatableAccountsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryPremiumSamplesRequest, ...grpc.CallOption) (*perpetualstypes.QueryPremiumSamplesResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryLiquidatableAccountsRequest, ...grpc.CallOption) (*subaccountstypes.QueryLiquidatableAccountsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryPremiumSamplesRequest, ...grpc.CallOption) *perpetualstypes.QueryPremiumSamplesResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryLiquidatableAccountsRequest, ...grpc.CallOption) *subaccountstypes.QueryLiquidatableAccountsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*perpetualstypes.QueryPremiumSamplesResponse)
+			r0 = ret.Get(0).(*subaccountstypes.QueryLiquidatableAccountsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *perpetualstypes.QueryPremiumSamplesRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryLiquidatableAccountsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -918,8 +955,8 @@ func (_m *QueryClient) PremiumSamples(ctx context.Context, in *perpetualstypes.Q
 	return r0, r1
 }
 
-// PremiumVotes provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) PremiumVotes(ctx context.Context, in *perpetualstypes.QueryPremiumVotesRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryPremiumVotesResponse, error) {
+// LiquidateSubaccounts provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) LiquidateSubaccounts(ctx context.Context, in *liquidationapi.LiquidateSubaccountsRequest, opts ...grpc.CallOption) (*liquidationapi.LiquidateSubaccountsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -930,23 +967,23 @@ func (_m *QueryClient) PremiumVotes(ctx context.Context, in *perpetualstypes.Que
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PremiumVotes")
+		panic("no return value specified for LiquidateSubaccounts")
 	}
 
-	var r0 *perpetualstypes.QueryPremiumVotesResponse
+	var r0 *liquidationapi.LiquidateSubaccountsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryPremiumVotesRequest, ...grpc.CallOption) (*perpetualstypes.QueryPremiumVotesResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *liquidationapi.LiquidateSubaccountsRequest, ...grpc.CallOption) (*liquidationapi.LiquidateSubaccountsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryPremiumVotesRequest, ...grpc.CallOption) *perpetualstypes.QueryPremiumVotesResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *liquidationapi.LiquidateSubaccountsRequest, ...grpc.CallOption) *liquidationapi.LiquidateSubaccountsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*perpetualstypes.QueryPremiumVotesResponse)
+			r0 = ret.Get(0).(*liquidationapi.LiquidateSubaccountsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *perpetualstypes.QueryPremiumVotesRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *liquidationapi.LiquidateSubaccountsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -955,8 +992,8 @@ func (_m *QueryClient) PremiumVotes(ctx context.Context, in *perpetualstypes.Que
 	return r0, r1
 }
 
-// PreviousBlockInfo provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) PreviousBlockInfo(ctx context.Context, in *types.QueryPreviousBlockInfoRequest, opts ...grpc.CallOption) (*types.QueryPreviousBlockInfoResponse, error) {
+// LiquidationProceedsEstimate provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) LiquidationProceedsEstimate(ctx context.Context, in *subaccountstypes.QueryLiquidationProceedsEstimateRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryLiquidationProceedsEstimateResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -967,23 +1004,23 @@ func (_m *QueryClient) PreviousBlockInfo(ctx context.Context, in *types.QueryPre
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PreviousBlockInfo")
+		panic("no return value specified for LiquidationProceedsEstimate")
 	}
 
-	var r0 *types.QueryPreviousBlockInfoResponse
+	var r0 *subaccountstypes.QueryLiquidationProceedsEstimateResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPreviousBlockInfoRequest, ...grpc.CallOption) (*types.QueryPreviousBlockInfoResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryLiquidationProceedsEstimateRequest, ...grpc.CallOption) (*subaccountstypes.QueryLiquidationProceedsEstimateResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPreviousBlockInfoRequest, ...grpc.CallOption) *types.QueryPreviousBlockInfoResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryLiquidationProceedsEstimateRequest, ...grpc.CallOption) *subaccountstypes.QueryLiquidationProceedsEstimateResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.QueryPreviousBlockInfoResponse)
+			r0 = ret.Get(0).(*subaccountstypes.QueryLiquidationProceedsEstimateResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryPreviousBlockInfoRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryLiquidationProceedsEstimateRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -992,9 +1029,897 @@ func (_m *QueryClient) PreviousBlockInfo(ctx context.Context, in *types.QueryPre
 	return r0, r1
 }
 
-// StatefulOrder provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) StatefulOrder(ctx context.Context, in *clobtypes.QueryStatefulOrderRequest, opts ...grpc.CallOption) (*clobtypes.QueryStatefulOrderResponse, error) {
-	_va := make([]interface{}, len(opts))
+// LiquidationsConfiguration provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) LiquidationsConfiguration(ctx context.Context, in *clobtypes.QueryLiquidationsConfigurationRequest, opts ...grpc.CallOption) (*clobtypes.QueryLiquidationsConfigurationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LiquidationsConfiguration")
+	}
+
+	var r0 *clobtypes.QueryLiquidationsConfigurationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryLiquidationsConfigurationRequest, ...grpc.CallOption) (*clobtypes.QueryLiquidationsConfigurationResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryLiquidationsConfigurationRequest, ...grpc.CallOption) *clobtypes.QueryLiquidationsConfigurationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QueryLiquidationsConfigurationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryLiquidationsConfigurationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LossBufferToLiquidation provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) LossBufferToLiquidation(ctx context.Context, in *subaccountstypes.QueryLossBufferToLiquidationRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryLossBufferToLiquidationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LossBufferToLiquidation")
+	}
+
+	var r0 *subaccountstypes.QueryLossBufferToLiquidationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryLossBufferToLiquidationRequest, ...grpc.CallOption) (*subaccountstypes.QueryLossBufferToLiquidationResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryLossBufferToLiquidationRequest, ...grpc.CallOption) *subaccountstypes.QueryLossBufferToLiquidationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QueryLossBufferToLiquidationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryLossBufferToLiquidationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarginDeltaForTierChange provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MarginDeltaForTierChange(ctx context.Context, in *subaccountstypes.QueryMarginDeltaForTierChangeRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryMarginDeltaForTierChangeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarginDeltaForTierChange")
+	}
+
+	var r0 *subaccountstypes.QueryMarginDeltaForTierChangeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryMarginDeltaForTierChangeRequest, ...grpc.CallOption) (*subaccountstypes.QueryMarginDeltaForTierChangeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryMarginDeltaForTierChangeRequest, ...grpc.CallOption) *subaccountstypes.QueryMarginDeltaForTierChangeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QueryMarginDeltaForTierChangeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryMarginDeltaForTierChangeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketExponentMigrationImpact provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MarketExponentMigrationImpact(ctx context.Context, in *subaccountstypes.QueryMarketExponentMigrationImpactRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryMarketExponentMigrationImpactResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarketExponentMigrationImpact")
+	}
+
+	var r0 *subaccountstypes.QueryMarketExponentMigrationImpactResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryMarketExponentMigrationImpactRequest, ...grpc.CallOption) (*subaccountstypes.QueryMarketExponentMigrationImpactResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryMarketExponentMigrationImpactRequest, ...grpc.CallOption) *subaccountstypes.QueryMarketExponentMigrationImpactResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QueryMarketExponentMigrationImpactResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryMarketExponentMigrationImpactRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketNcSensitivity provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MarketNcSensitivity(ctx context.Context, in *subaccountstypes.QueryMarketNcSensitivityRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryMarketNcSensitivityResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarketNcSensitivity")
+	}
+
+	var r0 *subaccountstypes.QueryMarketNcSensitivityResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryMarketNcSensitivityRequest, ...grpc.CallOption) (*subaccountstypes.QueryMarketNcSensitivityResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryMarketNcSensitivityRequest, ...grpc.CallOption) *subaccountstypes.QueryMarketNcSensitivityResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QueryMarketNcSensitivityResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryMarketNcSensitivityRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketParam provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MarketParam(ctx context.Context, in *pricestypes.QueryMarketParamRequest, opts ...grpc.CallOption) (*pricestypes.QueryMarketParamResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarketParam")
+	}
+
+	var r0 *pricestypes.QueryMarketParamResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pricestypes.QueryMarketParamRequest, ...grpc.CallOption) (*pricestypes.QueryMarketParamResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pricestypes.QueryMarketParamRequest, ...grpc.CallOption) *pricestypes.QueryMarketParamResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pricestypes.QueryMarketParamResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pricestypes.QueryMarketParamRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketPrice provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MarketPrice(ctx context.Context, in *pricestypes.QueryMarketPriceRequest, opts ...grpc.CallOption) (*pricestypes.QueryMarketPriceResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarketPrice")
+	}
+
+	var r0 *pricestypes.QueryMarketPriceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pricestypes.QueryMarketPriceRequest, ...grpc.CallOption) (*pricestypes.QueryMarketPriceResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pricestypes.QueryMarketPriceRequest, ...grpc.CallOption) *pricestypes.QueryMarketPriceResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pricestypes.QueryMarketPriceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pricestypes.QueryMarketPriceRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketUnrealizedPnl provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MarketUnrealizedPnl(ctx context.Context, in *subaccountstypes.QueryMarketUnrealizedPnlRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryMarketUnrealizedPnlResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarketUnrealizedPnl")
+	}
+
+	var r0 *subaccountstypes.QueryMarketUnrealizedPnlResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryMarketUnrealizedPnlRequest, ...grpc.CallOption) (*subaccountstypes.QueryMarketUnrealizedPnlResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryMarketUnrealizedPnlRequest, ...grpc.CallOption) *subaccountstypes.QueryMarketUnrealizedPnlResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QueryMarketUnrealizedPnlResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryMarketUnrealizedPnlRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MevNodeToNodeCalculation provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MevNodeToNodeCalculation(ctx context.Context, in *clobtypes.MevNodeToNodeCalculationRequest, opts ...grpc.CallOption) (*clobtypes.MevNodeToNodeCalculationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MevNodeToNodeCalculation")
+	}
+
+	var r0 *clobtypes.MevNodeToNodeCalculationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.MevNodeToNodeCalculationRequest, ...grpc.CallOption) (*clobtypes.MevNodeToNodeCalculationResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.MevNodeToNodeCalculationRequest, ...grpc.CallOption) *clobtypes.MevNodeToNodeCalculationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.MevNodeToNodeCalculationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.MevNodeToNodeCalculationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MinLiquidatingPriceMove provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MinLiquidatingPriceMove(ctx context.Context, in *subaccountstypes.QueryMinLiquidatingPriceMoveRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryMinLiquidatingPriceMoveResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MinLiquidatingPriceMove")
+	}
+
+	var r0 *subaccountstypes.QueryMinLiquidatingPriceMoveResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryMinLiquidatingPriceMoveRequest, ...grpc.CallOption) (*subaccountstypes.QueryMinLiquidatingPriceMoveResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryMinLiquidatingPriceMoveRequest, ...grpc.CallOption) *subaccountstypes.QueryMinLiquidatingPriceMoveResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QueryMinLiquidatingPriceMoveResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryMinLiquidatingPriceMoveRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NextClobPairId provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) NextClobPairId(ctx context.Context, in *clobtypes.QueryNextClobPairIdRequest, opts ...grpc.CallOption) (*clobtypes.QueryNextClobPairIdResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NextClobPairId")
+	}
+
+	var r0 *clobtypes.QueryNextClobPairIdResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryNextClobPairIdRequest, ...grpc.CallOption) (*clobtypes.QueryNextClobPairIdResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryNextClobPairIdRequest, ...grpc.CallOption) *clobtypes.QueryNextClobPairIdResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QueryNextClobPairIdResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryNextClobPairIdRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NextMarketId provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) NextMarketId(ctx context.Context, in *pricestypes.QueryNextMarketIdRequest, opts ...grpc.CallOption) (*pricestypes.QueryNextMarketIdResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NextMarketId")
+	}
+
+	var r0 *pricestypes.QueryNextMarketIdResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pricestypes.QueryNextMarketIdRequest, ...grpc.CallOption) (*pricestypes.QueryNextMarketIdResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pricestypes.QueryNextMarketIdRequest, ...grpc.CallOption) *pricestypes.QueryNextMarketIdResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pricestypes.QueryNextMarketIdResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pricestypes.QueryNextMarketIdRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NextPerpetualId provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) NextPerpetualId(ctx context.Context, in *perpetualstypes.QueryNextPerpetualIdRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryNextPerpetualIdResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NextPerpetualId")
+	}
+
+	var r0 *perpetualstypes.QueryNextPerpetualIdResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryNextPerpetualIdRequest, ...grpc.CallOption) (*perpetualstypes.QueryNextPerpetualIdResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryNextPerpetualIdRequest, ...grpc.CallOption) *perpetualstypes.QueryNextPerpetualIdResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*perpetualstypes.QueryNextPerpetualIdResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *perpetualstypes.QueryNextPerpetualIdRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Params provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) Params(ctx context.Context, in *perpetualstypes.QueryParamsRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryParamsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Params")
+	}
+
+	var r0 *perpetualstypes.QueryParamsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryParamsRequest, ...grpc.CallOption) (*perpetualstypes.QueryParamsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryParamsRequest, ...grpc.CallOption) *perpetualstypes.QueryParamsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*perpetualstypes.QueryParamsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *perpetualstypes.QueryParamsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Perpetual provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) Perpetual(ctx context.Context, in *perpetualstypes.QueryPerpetualRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryPerpetualResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Perpetual")
+	}
+
+	var r0 *perpetualstypes.QueryPerpetualResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryPerpetualRequest, ...grpc.CallOption) (*perpetualstypes.QueryPerpetualResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryPerpetualRequest, ...grpc.CallOption) *perpetualstypes.QueryPerpetualResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*perpetualstypes.QueryPerpetualResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *perpetualstypes.QueryPerpetualRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PositionNotional provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) PositionNotional(ctx context.Context, in *subaccountstypes.QueryPositionNotionalRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryPositionNotionalResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PositionNotional")
+	}
+
+	var r0 *subaccountstypes.QueryPositionNotionalResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryPositionNotionalRequest, ...grpc.CallOption) (*subaccountstypes.QueryPositionNotionalResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryPositionNotionalRequest, ...grpc.CallOption) *subaccountstypes.QueryPositionNotionalResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QueryPositionNotionalResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryPositionNotionalRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PremiumSamples provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) PremiumSamples(ctx context.Context, in *perpetualstypes.QueryPremiumSamplesRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryPremiumSamplesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PremiumSamples")
+	}
+
+	var r0 *perpetualstypes.QueryPremiumSamplesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryPremiumSamplesRequest, ...grpc.CallOption) (*perpetualstypes.QueryPremiumSamplesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryPremiumSamplesRequest, ...grpc.CallOption) *perpetualstypes.QueryPremiumSamplesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*perpetualstypes.QueryPremiumSamplesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *perpetualstypes.QueryPremiumSamplesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PremiumVotes provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) PremiumVotes(ctx context.Context, in *perpetualstypes.QueryPremiumVotesRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryPremiumVotesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PremiumVotes")
+	}
+
+	var r0 *perpetualstypes.QueryPremiumVotesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryPremiumVotesRequest, ...grpc.CallOption) (*perpetualstypes.QueryPremiumVotesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryPremiumVotesRequest, ...grpc.CallOption) *perpetualstypes.QueryPremiumVotesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*perpetualstypes.QueryPremiumVotesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *perpetualstypes.QueryPremiumVotesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreviousBlockInfo provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) PreviousBlockInfo(ctx context.Context, in *types.QueryPreviousBlockInfoRequest, opts ...grpc.CallOption) (*types.QueryPreviousBlockInfoResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviousBlockInfo")
+	}
+
+	var r0 *types.QueryPreviousBlockInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPreviousBlockInfoRequest, ...grpc.CallOption) (*types.QueryPreviousBlockInfoResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPreviousBlockInfoRequest, ...grpc.CallOption) *types.QueryPreviousBlockInfoResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryPreviousBlockInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryPreviousBlockInfoRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProtocolNetDelta provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) ProtocolNetDelta(ctx context.Context, in *subaccountstypes.QueryProtocolNetDeltaRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryProtocolNetDeltaResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProtocolNetDelta")
+	}
+
+	var r0 *subaccountstypes.QueryProtocolNetDeltaResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryProtocolNetDeltaRequest, ...grpc.CallOption) (*subaccountstypes.QueryProtocolNetDeltaResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryProtocolNetDeltaRequest, ...grpc.CallOption) *subaccountstypes.QueryProtocolNetDeltaResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QueryProtocolNetDeltaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryProtocolNetDeltaRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RealizedPnlOnClose provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) RealizedPnlOnClose(ctx context.Context, in *subaccountstypes.QueryRealizedPnlOnCloseRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryRealizedPnlOnCloseResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RealizedPnlOnClose")
+	}
+
+	var r0 *subaccountstypes.QueryRealizedPnlOnCloseResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryRealizedPnlOnCloseRequest, ...grpc.CallOption) (*subaccountstypes.QueryRealizedPnlOnCloseResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryRealizedPnlOnCloseRequest, ...grpc.CallOption) *subaccountstypes.QueryRealizedPnlOnCloseResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QueryRealizedPnlOnCloseResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryRealizedPnlOnCloseRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RiskAtHeight provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) RiskAtHeight(ctx context.Context, in *subaccountstypes.QueryRiskAtHeightRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryRiskAtHeightResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RiskAtHeight")
+	}
+
+	var r0 *subaccountstypes.QueryRiskAtHeightResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryRiskAtHeightRequest, ...grpc.CallOption) (*subaccountstypes.QueryRiskAtHeightResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryRiskAtHeightRequest, ...grpc.CallOption) *subaccountstypes.QueryRiskAtHeightResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QueryRiskAtHeightResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryRiskAtHeightRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RiskInputs provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) RiskInputs(ctx context.Context, in *subaccountstypes.QueryRiskInputsRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryRiskInputsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RiskInputs")
+	}
+
+	var r0 *subaccountstypes.QueryRiskInputsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryRiskInputsRequest, ...grpc.CallOption) (*subaccountstypes.QueryRiskInputsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryRiskInputsRequest, ...grpc.CallOption) *subaccountstypes.QueryRiskInputsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QueryRiskInputsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryRiskInputsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RiskUnderHistoricalShocks provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) RiskUnderHistoricalShocks(ctx context.Context, in *subaccountstypes.QueryRiskUnderHistoricalShocksRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryRiskUnderHistoricalShocksResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RiskUnderHistoricalShocks")
+	}
+
+	var r0 *subaccountstypes.QueryRiskUnderHistoricalShocksResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryRiskUnderHistoricalShocksRequest, ...grpc.CallOption) (*subaccountstypes.QueryRiskUnderHistoricalShocksResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryRiskUnderHistoricalShocksRequest, ...grpc.CallOption) *subaccountstypes.QueryRiskUnderHistoricalShocksResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QueryRiskUnderHistoricalShocksResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryRiskUnderHistoricalShocksRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatefulOrder provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) StatefulOrder(ctx context.Context, in *clobtypes.QueryStatefulOrderRequest, opts ...grpc.CallOption) (*clobtypes.QueryStatefulOrderResponse, error) {
+	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
 	}
@@ -1140,6 +2065,154 @@ func (_m *QueryClient) SubaccountAll(ctx context.Context, in *subaccountstypes.Q
 	return r0, r1
 }
 
+// SubaccountLiquidationPrices provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) SubaccountLiquidationPrices(ctx context.Context, in *subaccountstypes.QuerySubaccountLiquidationPricesRequest, opts ...grpc.CallOption) (*subaccountstypes.QuerySubaccountLiquidationPricesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubaccountLiquidationPrices")
+	}
+
+	var r0 *subaccountstypes.QuerySubaccountLiquidationPricesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QuerySubaccountLiquidationPricesRequest, ...grpc.CallOption) (*subaccountstypes.QuerySubaccountLiquidationPricesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QuerySubaccountLiquidationPricesRequest, ...grpc.CallOption) *subaccountstypes.QuerySubaccountLiquidationPricesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QuerySubaccountLiquidationPricesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QuerySubaccountLiquidationPricesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubaccountUtilization provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) SubaccountUtilization(ctx context.Context, in *subaccountstypes.QuerySubaccountUtilizationRequest, opts ...grpc.CallOption) (*subaccountstypes.QuerySubaccountUtilizationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubaccountUtilization")
+	}
+
+	var r0 *subaccountstypes.QuerySubaccountUtilizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QuerySubaccountUtilizationRequest, ...grpc.CallOption) (*subaccountstypes.QuerySubaccountUtilizationResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QuerySubaccountUtilizationRequest, ...grpc.CallOption) *subaccountstypes.QuerySubaccountUtilizationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QuerySubaccountUtilizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QuerySubaccountUtilizationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TotalMaintenanceMargin provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) TotalMaintenanceMargin(ctx context.Context, in *subaccountstypes.QueryTotalMaintenanceMarginRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryTotalMaintenanceMarginResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TotalMaintenanceMargin")
+	}
+
+	var r0 *subaccountstypes.QueryTotalMaintenanceMarginResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryTotalMaintenanceMarginRequest, ...grpc.CallOption) (*subaccountstypes.QueryTotalMaintenanceMarginResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryTotalMaintenanceMarginRequest, ...grpc.CallOption) *subaccountstypes.QueryTotalMaintenanceMarginResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QueryTotalMaintenanceMarginResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryTotalMaintenanceMarginRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TotalValueLocked provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) TotalValueLocked(ctx context.Context, in *subaccountstypes.QueryTotalValueLockedRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryTotalValueLockedResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TotalValueLocked")
+	}
+
+	var r0 *subaccountstypes.QueryTotalValueLockedResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryTotalValueLockedRequest, ...grpc.CallOption) (*subaccountstypes.QueryTotalValueLockedResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryTotalValueLockedRequest, ...grpc.CallOption) *subaccountstypes.QueryTotalValueLockedResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QueryTotalValueLockedResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryTotalValueLockedRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateMarketPrices provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) UpdateMarketPrices(ctx context.Context, in *pricefeedapi.UpdateMarketPricesRequest, opts ...grpc.CallOption) (*pricefeedapi.UpdateMarketPricesResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -1177,6 +2250,43 @@ func (_m *QueryClient) UpdateMarketPrices(ctx context.Context, in *pricefeedapi.
 	return r0, r1
 }
 
+// WithdrawableCheck provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) WithdrawableCheck(ctx context.Context, in *subaccountstypes.QueryWithdrawableCheckRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryWithdrawableCheckResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithdrawableCheck")
+	}
+
+	var r0 *subaccountstypes.QueryWithdrawableCheckResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryWithdrawableCheckRequest, ...grpc.CallOption) (*subaccountstypes.QueryWithdrawableCheckResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryWithdrawableCheckRequest, ...grpc.CallOption) *subaccountstypes.QueryWithdrawableCheckResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QueryWithdrawableCheckResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryWithdrawableCheckRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewQueryClient creates a new instance of QueryClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewQueryClient(t interface {
@@ -11,12 +11,10 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/encoding"
 	pricefeed_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/pricefeed"
 	pricestest "github.com/dydxprotocol/v4-chain/protocol/testutil/prices"
-	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
 	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
-	sendingtypes "github.com/dydxprotocol/v4-chain/protocol/x/sending/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	"github.com/stretchr/testify/require"
 )
@@ -25,7 +23,6 @@ const (
 	BlockTimeDuration             = 2 * time.Second
 	NumBlocksPerMinute            = int64(time.Minute / BlockTimeDuration) // 30
 	BlockHeightAtFirstFundingTick = 1000
-	TestTransferUsdcForSettlement = 10_000_000_000_000
 	TestMarketId                  = 0
 )
 
@@ -99,33 +96,6 @@ var (
 		1690002000+int64(epochstypes.FundingTickEpochDuration),
 		0,
 	)
-	// Test transfers to settle Alice and Bob's subaccount.
-	TestTranfers = []sendingtypes.MsgCreateTransfer{
-		{
-			Transfer: &sendingtypes.Transfer{
-				Sender:    constants.Dave_Num0,
-				Recipient: constants.Alice_Num0,
-				AssetId:   assettypes.AssetUsdc.Id,
-				Amount:    TestTransferUsdcForSettlement,
-			},
-		},
-		{
-			Transfer: &sendingtypes.Transfer{
-				Sender:    constants.Dave_Num0,
-				Recipient: constants.Bob_Num0,
-				AssetId:   assettypes.AssetUsdc.Id,
-				Amount:    TestTransferUsdcForSettlement,
-			},
-		},
-		{
-			Transfer: &sendingtypes.Transfer{
-				Sender:    constants.Dave_Num0,
-				Recipient: constants.Carl_Num0,
-				AssetId:   assettypes.AssetUsdc.Id,
-				Amount:    TestTransferUsdcForSettlement,
-			},
-		},
-	}
 )
 
 type expectedSettlements struct {
@@ -438,6 +408,17 @@ func TestFunding(t *testing.T) {
 				BlockTime: SecondFundingTick.Add(-BlockTimeDuration),
 			})
 
+			subaccsBeforeSettlement := []satypes.Subaccount{}
+			totalUsdcBalanceBeforeSettlement := int64(0)
+			for _, expectedSettlements := range tc.expectedSubaccountSettlements {
+				subaccBeforeSettlement := tApp.App.SubaccountsKeeper.GetSubaccount(ctx, expectedSettlements.SubaccountId)
+				// Before settlement, each perpetual position should have zero funding index, since these positions
+				// were opened when BTC perpetual has zero funding idnex.
+				require.Equal(t, int64(0), subaccBeforeSettlement.PerpetualPositions[0].FundingIndex.BigInt().Int64())
+				subaccsBeforeSettlement = append(subaccsBeforeSettlement, subaccBeforeSettlement)
+				totalUsdcBalanceBeforeSettlement += getSubaccountUsdcBalance(subaccBeforeSettlement)
+			}
+
 			// Build a DeliverTx override with MsgUpdateMarketPrices to update oracle price for funding index.
 			msgPriceUpdate := &pricestypes.MsgUpdateMarketPrices{
 				MarketPriceUpdates: []*pricestypes.MsgUpdateMarketPrices_MarketPrice{
@@ -464,36 +445,7 @@ func TestFunding(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, tc.expectedFundingIndex, btcPerp.FundingIndex.BigInt().Int64())
 
-			subaccsBeforeSettlement := []satypes.Subaccount{}
-			totalUsdcBalanceBeforeSettlement := int64(0)
-			for _, expectedSettlements := range tc.expectedSubaccountSettlements {
-				subaccBeforeSettlement := tApp.App.SubaccountsKeeper.GetSubaccount(ctx, expectedSettlements.SubaccountId)
-				// Before settlement, each perpetual position should have zero funding index, since these positions
-				// were opened when BTC perpetual has zero funding idnex.
-				require.Equal(t, int64(0), subaccBeforeSettlement.PerpetualPositions[0].FundingIndex.BigInt().Int64())
-				subaccsBeforeSettlement = append(subaccsBeforeSettlement, subaccBeforeSettlement)
-				totalUsdcBalanceBeforeSettlement += getSubaccountUsdcBalance(subaccBeforeSettlement)
-			}
-
-			// Send transfers from Dave to subaccounts that has positions, so that funding is settled for these accounts.
-			for _, transfer := range TestTranfers {
-				// Invoke CheckTx.
-				CheckTx_MsgDepositToSubaccount := testapp.MustMakeCheckTx(
-					ctx,
-					tApp.App,
-					testapp.MustMakeCheckTxOptions{
-						AccAddressForSigning: transfer.Transfer.Sender.Owner,
-						Gas:                  110_000,
-						FeeAmt:               constants.TestFeeCoins_5Cents,
-					},
-					&transfer,
-				)
-				resp := tApp.CheckTx(CheckTx_MsgDepositToSubaccount)
-				require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
-			}
-
-			ctx = tApp.AdvanceToBlock(uint32(ctx.BlockHeight()+1), testapp.AdvanceToBlockOptions{})
-
+			// Funding of all positions is settled at the end of the funding-tick block.
 			totalUsdcBalanceAfterSettlement := int64(0)
 			for i, expectedSettlements := range tc.expectedSubaccountSettlements {
 				subaccAfterSettlement := tApp.App.SubaccountsKeeper.GetSubaccount(
@@ -501,8 +453,6 @@ func TestFunding(t *testing.T) {
 					expectedSettlements.SubaccountId,
 				)
 
-				// Before settlement, each perpetual position should have zero funding index, since these positions
-				// were opened when BTC perpetual has zero funding idnex.
 				// TODO(CORE-723): Start with non-zero funding index on the perpetual.
 				require.Equal(t,
 					tc.expectedFundingIndex,
@@ -512,24 +462,20 @@ func TestFunding(t *testing.T) {
 
 				require.Equal(t,
 					getSubaccountUsdcBalance(subaccsBeforeSettlement[i])+expectedSettlements.Settlement,
-					getSubaccountUsdcBalance(subaccAfterSettlement)-TestTransferUsdcForSettlement,
+					getSubaccountUsdcBalance(subaccAfterSettlement),
 					"subaccount id: %v, expected settlement: %v, got settlement: %v,"+
-						"balance before settlement: %v, balance after (minus test transfer): %v",
+						"balance before settlement: %v, balance after: %v",
 					expectedSettlements.SubaccountId,
 					expectedSettlements.Settlement,
-					getSubaccountUsdcBalance(subaccAfterSettlement)-TestTransferUsdcForSettlement-
+					getSubaccountUsdcBalance(subaccAfterSettlement)-
 						getSubaccountUsdcBalance(subaccsBeforeSettlement[i]),
 					getSubaccountUsdcBalance(subaccsBeforeSettlement[i]),
-					getSubaccountUsdcBalance(subaccAfterSettlement)-TestTransferUsdcForSettlement,
+					getSubaccountUsdcBalance(subaccAfterSettlement),
 				)
 			}
 
-			// Check that the involved subaccounts has the same total balance before and after the transfer
-			// (besides transfers from Dave).
-			require.Equal(t,
-				totalUsdcBalanceBeforeSettlement,
-				totalUsdcBalanceAfterSettlement-TestTransferUsdcForSettlement*3,
-			)
+			// Check that the involved subaccounts has the same total balance before and after the settlement.
+			require.Equal(t, totalUsdcBalanceBeforeSettlement, totalUsdcBalanceAfterSettlement)
 		})
 	}
 }
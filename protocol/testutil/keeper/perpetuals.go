@@ -217,16 +217,7 @@ func CreateTestPerpetuals(t testing.TB, ctx sdk.Context, k *keeper.Keeper) {
 
 func CreateTestLiquidityTiers(t testing.TB, ctx sdk.Context, k *keeper.Keeper) {
 	for _, l := range constants.LiquidityTiers {
-		_, err := k.SetLiquidityTier(
-			ctx,
-			l.Id,
-			l.Name,
-			l.InitialMarginPpm,
-			l.MaintenanceFractionPpm,
-			l.ImpactNotional,
-			l.OpenInterestLowerCap,
-			l.OpenInterestUpperCap,
-		)
+		err := k.ValidateAndSetLiquidityTier(ctx, l)
 
 		require.NoError(t, err)
 	}
@@ -16,6 +16,7 @@ import (
 
 	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 
 	storetypes "cosmossdk.io/store/types"
@@ -26,6 +27,8 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	asskeeper "github.com/dydxprotocol/v4-chain/protocol/x/assets/keeper"
 	blocktimekeeper "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/keeper"
+	delaymsgtypes "github.com/dydxprotocol/v4-chain/protocol/x/delaymsg/types"
+	epochskeeper "github.com/dydxprotocol/v4-chain/protocol/x/epochs/keeper"
 	perpskeeper "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/keeper"
 	priceskeeper "github.com/dydxprotocol/v4-chain/protocol/x/prices/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/keeper"
@@ -44,6 +47,36 @@ func SubaccountsKeepers(t testing.TB, msgSenderEnabled bool) (
 	revShareKeeper *revsharekeeper.Keeper,
 	affiliatesKeeper *affiliateskeeper.Keeper,
 	storeKey storetypes.StoreKey,
+) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, accountKeeper, bankKeeper, assetsKeeper, blocktimeKeeper,
+		revShareKeeper, affiliatesKeeper, storeKey, _ = SubaccountsKeepersWithEpochs(t, msgSenderEnabled)
+	return ctx,
+		keeper,
+		pricesKeeper,
+		perpetualsKeeper,
+		accountKeeper,
+		bankKeeper,
+		assetsKeeper,
+		blocktimeKeeper,
+		revShareKeeper,
+		affiliatesKeeper,
+		storeKey
+}
+
+// SubaccountsKeepersWithEpochs is the same as `SubaccountsKeepers` but also returns the epochs keeper.
+func SubaccountsKeepersWithEpochs(t testing.TB, msgSenderEnabled bool) (
+	ctx sdk.Context,
+	keeper *keeper.Keeper,
+	pricesKeeper *priceskeeper.Keeper,
+	perpetualsKeeper *perpskeeper.Keeper,
+	accountKeeper *authkeeper.AccountKeeper,
+	bankKeeper *bankkeeper.BaseKeeper,
+	assetsKeeper *asskeeper.Keeper,
+	blocktimeKeeper *blocktimekeeper.Keeper,
+	revShareKeeper *revsharekeeper.Keeper,
+	affiliatesKeeper *affiliateskeeper.Keeper,
+	storeKey storetypes.StoreKey,
+	epochsKeeper *epochskeeper.Keeper,
 ) {
 	var mockTimeProvider *mocks.TimeProvider
 	ctx = initKeepers(t, func(
@@ -54,7 +87,7 @@ func SubaccountsKeepers(t testing.TB, msgSenderEnabled bool) (
 		transientStoreKey storetypes.StoreKey,
 	) []GenesisInitializer {
 		// Define necessary keepers here for unit tests
-		epochsKeeper, _ := createEpochsKeeper(stateStore, db, cdc)
+		epochsKeeper, _ = createEpochsKeeper(stateStore, db, cdc)
 
 		accountKeeper, _ = createAccountKeeper(
 			stateStore,
@@ -121,7 +154,8 @@ func SubaccountsKeepers(t testing.TB, msgSenderEnabled bool) (
 		blocktimeKeeper,
 		revShareKeeper,
 		affiliatesKeeper,
-		storeKey
+		storeKey,
+		epochsKeeper
 }
 
 func createSubaccountsKeeper(
@@ -152,6 +186,11 @@ func createSubaccountsKeeper(
 		btk,
 		mockIndexerEventsManager,
 		streaming.NewNoopGrpcStreamingManager(),
+		transientStoreKey,
+		[]string{
+			lib.GovModuleAddress.String(),
+			delaymsgtypes.ModuleAddress.String(),
+		},
 	)
 
 	return k, storeKey
@@ -59,6 +59,12 @@ func WithMarketType(marketType perptypes.PerpetualMarketType) PerpetualModifierO
 	}
 }
 
+func WithPerpetualType(perpetualType perptypes.PerpetualType) PerpetualModifierOption {
+	return func(cp *perptypes.Perpetual) {
+		cp.Params.PerpetualType = uint32(perpetualType)
+	}
+}
+
 // GeneratePerpetual returns a `Perpetual` object set to default values.
 // Passing in `PerpetualModifierOption` methods alters the value of the `Perpetual` returned.
 // It will start with the default, valid `Perpetual` value defined within the method
@@ -51,9 +51,24 @@ func CreateSinglePerpetualPosition(
 		Quantums:     dtypes.NewIntFromBigInt(quantums),
 		FundingIndex: dtypes.NewIntFromBigInt(fundingIndex),
 		QuoteBalance: dtypes.NewIntFromBigInt(quoteBalance),
+		CostBasis:    dtypes.NewInt(0),
 	}
 }
 
+// CreateSinglePerpetualPositionWithCostBasis is the same as `CreateSinglePerpetualPosition` but also
+// sets the cost basis of the position.
+func CreateSinglePerpetualPositionWithCostBasis(
+	perpetualId uint32,
+	quantums *big.Int,
+	fundingIndex *big.Int,
+	quoteBalance *big.Int,
+	costBasis *big.Int,
+) *satypes.PerpetualPosition {
+	position := CreateSinglePerpetualPosition(perpetualId, quantums, fundingIndex, quoteBalance)
+	position.CostBasis = dtypes.NewIntFromBigInt(costBasis)
+	return position
+}
+
 // Creates a copy of a subaccount and changes the USDC asset position size of the subaccount by the passed in delta.
 func ChangeUsdcBalance(subaccount satypes.Subaccount, deltaQuantums int64) satypes.Subaccount {
 	subaccountId := satypes.SubaccountId{
@@ -89,7 +104,7 @@ func ChangeUsdcBalance(subaccount satypes.Subaccount, deltaQuantums int64) satyp
 			perpetualPositions,
 			CreateSinglePerpetualPosition(
 				pp.PerpetualId,
-				pp.Quantums.BigInt(),
+				pp.GetBigQuantums(),
 				pp.FundingIndex.BigInt(),
 				pp.GetQuoteBalance(),
 			),
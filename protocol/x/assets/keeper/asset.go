@@ -78,7 +78,7 @@ func (k Keeper) CreateAsset(
 
 	// Validate market
 	if hasMarket {
-		if _, err := k.pricesKeeper.GetMarketPrice(ctx, marketId); err != nil {
+		if err := k.validateAssetMarket(ctx, marketId); err != nil {
 			return asset, err
 		}
 	} else if marketId > 0 {
@@ -110,6 +110,23 @@ func (k Keeper) CreateAsset(
 	return asset, nil
 }
 
+// validateAssetMarket returns an error if the market does not exist or has a negative price,
+// which is not supported for assets.
+func (k Keeper) validateAssetMarket(ctx sdk.Context, marketId uint32) error {
+	marketPrice, err := k.pricesKeeper.GetMarketPrice(ctx, marketId)
+	if err != nil {
+		return err
+	}
+	if marketPrice.Negative {
+		return errorsmod.Wrapf(
+			types.ErrNegativeMarketPrice,
+			"Market ID: %v",
+			marketId,
+		)
+	}
+	return nil
+}
+
 func (k Keeper) ModifyAsset(
 	ctx sdk.Context,
 	id uint32,
@@ -123,7 +140,7 @@ func (k Keeper) ModifyAsset(
 	}
 
 	// Validate market
-	if _, err := k.pricesKeeper.GetMarketPrice(ctx, marketId); err != nil {
+	if err := k.validateAssetMarket(ctx, marketId); err != nil {
 		return asset, err
 	}
 
@@ -155,6 +155,57 @@ func TestCreateAsset_MarketIdInvalid(t *testing.T) {
 	require.Len(t, keeper.GetAllAssets(ctx), 0)
 }
 
+func TestCreateAndModifyAsset_NegativeMarketPrice(t *testing.T) {
+	ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
+	_, err := createNAssets(t, ctx, keeper, pricesKeeper, 1)
+	require.NoError(t, err)
+	_, err = keepertest.CreateTestMarket(
+		t,
+		ctx,
+		pricesKeeper,
+		pricestypes.MarketParam{
+			Id:                 1,
+			Pair:               "1-1",
+			Exponent:           -1,
+			MinExchanges:       1,
+			MinPriceChangePpm:  1,
+			ExchangeConfigJson: "{}",
+		},
+		pricestypes.MarketPrice{
+			Id:       1,
+			Exponent: -1,
+			Price:    1_000,
+			Negative: true,
+		},
+	)
+	require.NoError(t, err)
+
+	// Throws error when creating asset for a market with a negative price.
+	_, err = keeper.CreateAsset(
+		ctx,
+		firstValidAssetId+1,
+		"foo-symbol", // symbol
+		"foo-denom",  // denom
+		-6,           // denomExponent
+		true,
+		uint32(1),
+		int32(-1),
+	)
+	require.EqualError(t, err, errorsmod.Wrap(types.ErrNegativeMarketPrice, "Market ID: 1").Error())
+
+	// Does not create an asset.
+	require.Len(t, keeper.GetAllAssets(ctx), 1)
+
+	// Throws error when modifying an asset to a market with a negative price.
+	_, err = keeper.ModifyAsset(
+		ctx,
+		firstValidAssetId,
+		true,
+		uint32(1),
+	)
+	require.ErrorIs(t, err, types.ErrNegativeMarketPrice)
+}
+
 func TestCreateAsset_AssetAlreadyExists(t *testing.T) {
 	ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
 
@@ -0,0 +1,26 @@
+package lib
+
+import (
+	"math/big"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+)
+
+// GetPriceImpactAdjustedCollateral returns the value of a collateral position of `bigQuantums` in an
+// asset after applying the haircut of the asset's price impact model, where `bigQuoteQuantums` is the
+// value of the position at the market price. The adjusted value is rounded down.
+//
+// TODO(DEC-581): apply when calculating the net collateral of non-USDC assets once multi-collateral
+// is supported.
+func GetPriceImpactAdjustedCollateral(
+	model types.LinearPriceImpact,
+	bigQuantums *big.Int,
+	bigQuoteQuantums *big.Int,
+) *big.Int {
+	haircutPpm := model.GetHaircutPpm(bigQuantums)
+	if haircutPpm.Sign() == 0 {
+		return new(big.Int).Set(bigQuoteQuantums)
+	}
+	return lib.BigMulPpm(bigQuoteQuantums, haircutPpm.Sub(lib.BigIntOneMillion(), haircutPpm), false)
+}
@@ -0,0 +1,74 @@
+package lib_test
+
+import (
+	"math/big"
+	"testing"
+
+	assetslib "github.com/dydxprotocol/v4-chain/protocol/x/assets/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPriceImpactAdjustedCollateral(t *testing.T) {
+	// The haircut grows by 10% for every 1,000,000 quantums above 1,000,000 quantums.
+	model := types.LinearPriceImpact{
+		ThresholdQuantums: 1_000_000,
+		ImpactPpm:         100_000,
+	}
+	tests := map[string]struct {
+		model            types.LinearPriceImpact
+		bigQuantums      *big.Int
+		bigQuoteQuantums *big.Int
+
+		expected *big.Int
+	}{
+		"small balance has no impact": {
+			model:            model,
+			bigQuantums:      big.NewInt(500_000),
+			bigQuoteQuantums: big.NewInt(5_000_000),
+			expected:         big.NewInt(5_000_000),
+		},
+		"balance at the threshold has no impact": {
+			model:            model,
+			bigQuantums:      big.NewInt(1_000_000),
+			bigQuoteQuantums: big.NewInt(10_000_000),
+			expected:         big.NewInt(10_000_000),
+		},
+		"large balance is impacted": {
+			model:            model,
+			bigQuantums:      big.NewInt(2_500_000),
+			bigQuoteQuantums: big.NewInt(25_000_000),
+			// Haircut of 15%.
+			expected: big.NewInt(21_250_000),
+		},
+		"adjusted value is rounded down": {
+			model:            model,
+			bigQuantums:      big.NewInt(1_000_001),
+			bigQuoteQuantums: big.NewInt(10_000_010),
+			// Haircut of 0.1 ppm, rounded up to 1 ppm.
+			expected: big.NewInt(9_999_999),
+		},
+		"haircut is capped at 100%": {
+			model:            model,
+			bigQuantums:      big.NewInt(12_000_000),
+			bigQuoteQuantums: big.NewInt(120_000_000),
+			expected:         big.NewInt(0),
+		},
+		"default model has no impact": {
+			model:            types.LinearPriceImpact{},
+			bigQuantums:      big.NewInt(12_000_000),
+			bigQuoteQuantums: big.NewInt(120_000_000),
+			expected:         big.NewInt(120_000_000),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(
+				t,
+				tc.expected,
+				assetslib.GetPriceImpactAdjustedCollateral(tc.model, tc.bigQuantums, tc.bigQuoteQuantums),
+			)
+		})
+	}
+}
@@ -18,6 +18,8 @@ var (
 	ErrInvalidDenomExponent         = errorsmod.Register(ModuleName, 11, "Invalid denom exponent")
 	ErrAssetAlreadyExists           = errorsmod.Register(ModuleName, 12, "Asset already exists")
 	ErrUnexpectedUsdcDenomExponent  = errorsmod.Register(ModuleName, 13, "USDC denom exponent is unexpected")
+	ErrInvalidPriceImpactModel      = errorsmod.Register(ModuleName, 14, "Invalid price impact model")
+	ErrNegativeMarketPrice          = errorsmod.Register(ModuleName, 15, "Market of an asset cannot have a negative price")
 
 	// Errors for Not Implemented
 	ErrNotImplementedMulticollateral = errorsmod.Register(ModuleName, 401, "Not Implemented: Multi-Collateral")
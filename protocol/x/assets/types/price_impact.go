@@ -0,0 +1,45 @@
+package types
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+// LinearPriceImpact is a price impact model for valuing collateral positions in an asset. Large
+// positions cannot be liquidated at the market price without slippage, so positions larger than
+// `ThresholdQuantums` are valued at a haircut that grows linearly with the size of the position:
+// the haircut increases by `ImpactPpm` for every `ThresholdQuantums` above the threshold, and is
+// capped at 100%.
+//
+// The zero value of `LinearPriceImpact` has no price impact.
+type LinearPriceImpact struct {
+	ThresholdQuantums uint64
+	ImpactPpm         uint32
+}
+
+// Validate checks that a price impact model with non-zero impact has a non-zero threshold.
+func (m LinearPriceImpact) Validate() error {
+	if m.ImpactPpm > 0 && m.ThresholdQuantums == 0 {
+		return errorsmod.Wrapf(
+			ErrInvalidPriceImpactModel,
+			"threshold quantums must be positive when impact ppm is %d",
+			m.ImpactPpm,
+		)
+	}
+	return nil
+}
+
+// GetHaircutPpm returns the haircut, in parts-per-million of the value of the position, for a position
+// of `bigQuantums`. The haircut is rounded up.
+func (m LinearPriceImpact) GetHaircutPpm(bigQuantums *big.Int) *big.Int {
+	threshold := lib.BigU(m.ThresholdQuantums)
+	excess := new(big.Int).Sub(new(big.Int).Abs(bigQuantums), threshold)
+	if m.ImpactPpm == 0 || excess.Sign() <= 0 {
+		return new(big.Int)
+	}
+
+	haircutPpm := lib.BigDivCeil(excess.Mul(excess, lib.BigU(m.ImpactPpm)), threshold)
+	return lib.BigMin(haircutPpm, lib.BigIntOneMillion())
+}
@@ -0,0 +1,40 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinearPriceImpact_Validate(t *testing.T) {
+	tests := map[string]struct {
+		model       types.LinearPriceImpact
+		expectedErr error
+	}{
+		"default model": {
+			model: types.LinearPriceImpact{},
+		},
+		"threshold without impact": {
+			model: types.LinearPriceImpact{ThresholdQuantums: 1_000_000},
+		},
+		"threshold with impact": {
+			model: types.LinearPriceImpact{ThresholdQuantums: 1_000_000, ImpactPpm: 100_000},
+		},
+		"impact without threshold": {
+			model:       types.LinearPriceImpact{ImpactPpm: 100_000},
+			expectedErr: types.ErrInvalidPriceImpactModel,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.model.Validate()
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -117,6 +117,29 @@ func EndBlocker(
 	// These triggered conditional orders will be placed in the `PrepareCheckState``.
 	processProposerMatchesEvents.ConditionalOrderIdsTriggeredInLastBlock = triggeredConditionalOrderIds
 
+	// Track the subaccounts that are below maintenance margin, so that the liquidation grace period of
+	// each subaccount starts from the first block it was seen undercollateralized. Tracking is best-effort,
+	// so its state changes are discarded on error instead of halting the chain.
+	trackCtx, writeCache := ctx.CacheContext()
+	if err := keeper.TrackUndercollateralizedSubaccounts(trackCtx); err != nil {
+		log.ErrorLogWithError(ctx, "Failed to track undercollateralized subaccounts", err)
+	} else {
+		writeCache()
+	}
+
+	// Close the subaccounts left with only a dust balance, so that they do not remain in state.
+	keeper.SweepDustSubaccounts(ctx)
+
+	// Settle the funding of all positions after each funding tick, so that the funding of positions in
+	// subaccounts that are not updated does not accumulate. Settlement is best-effort, so its state changes
+	// are discarded on error instead of halting the chain.
+	settleCtx, writeSettleCache := ctx.CacheContext()
+	if err := keeper.SettleFundingAfterFundingTick(settleCtx); err != nil {
+		log.ErrorLogWithError(ctx, "Failed to settle funding after funding tick", err)
+	} else {
+		writeSettleCache()
+	}
+
 	// Write the ProcessProposerMatchcesEvents with all the EndBlocker updates to state.
 	keeper.MustSetProcessProposerMatchesEvents(
 		ctx,
@@ -241,6 +264,14 @@ func PrepareCheckState(
 		keeper.GetSubaccountsWithPositionsInFinalSettlementMarkets(ctx)...,
 	)
 
+	// Add the positions of bankrupt subaccounts whose deficit the insurance fund cannot cover, so they are
+	// deleveraged rather than left as bad debt.
+	subaccountsToDeleverage = keeper.AppendUncoveredBankruptSubaccountsToDeleverage(
+		ctx,
+		keeper.DaemonLiquidationInfo.GetNegativeTncSubaccountIds(),
+		subaccountsToDeleverage,
+	)
+
 	// 7. Deleverage subaccounts.
 	// TODO(CLOB-1052) - decouple steps 6 and 7 by using DaemonLiquidationInfo.NegativeTncSubaccounts
 	// as the input for this function.
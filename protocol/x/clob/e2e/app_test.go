@@ -532,11 +532,12 @@ func TestHydrationWithMatchPreBlocker(t *testing.T) {
 			),
 		},
 		PerpetualPositions: []*satypes.PerpetualPosition{
-			testutil.CreateSinglePerpetualPosition(
+			testutil.CreateSinglePerpetualPositionWithCostBasis(
 				0,
 				big.NewInt(100_000_000),
 				big.NewInt(0),
 				big.NewInt(0),
+				big.NewInt(50_000_000_000),
 			),
 		},
 	}, carl)
@@ -551,11 +552,12 @@ func TestHydrationWithMatchPreBlocker(t *testing.T) {
 			),
 		},
 		PerpetualPositions: []*satypes.PerpetualPosition{
-			testutil.CreateSinglePerpetualPosition(
+			testutil.CreateSinglePerpetualPositionWithCostBasis(
 				0,
 				big.NewInt(-100_000_000),
 				big.NewInt(0),
 				big.NewInt(0),
+				big.NewInt(-50_000_000_000),
 			),
 		},
 	}, dave)
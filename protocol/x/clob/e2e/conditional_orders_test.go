@@ -565,11 +565,12 @@ func TestConditionalOrder(t *testing.T) {
 						),
 					},
 					PerpetualPositions: []*satypes.PerpetualPosition{
-						testutil.CreateSinglePerpetualPosition(
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
 							0,
 							big.NewInt(25_000_000),
 							big.NewInt(0),
 							big.NewInt(0),
+							big.NewInt(12_500_000_000),
 						),
 					},
 				},
@@ -608,11 +609,12 @@ func TestConditionalOrder(t *testing.T) {
 						),
 					},
 					PerpetualPositions: []*satypes.PerpetualPosition{
-						testutil.CreateSinglePerpetualPosition(
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
 							0,
 							big.NewInt(50_000_000),
 							big.NewInt(0),
 							big.NewInt(0),
+							big.NewInt(25_000_000_000),
 						),
 					},
 				},
@@ -689,11 +691,12 @@ func TestConditionalOrder(t *testing.T) {
 						),
 					},
 					PerpetualPositions: []*satypes.PerpetualPosition{
-						testutil.CreateSinglePerpetualPosition(
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
 							0,
 							big.NewInt(25_000_000),
 							big.NewInt(0),
 							big.NewInt(0),
+							big.NewInt(12_500_000_000),
 						),
 					},
 				},
@@ -1723,11 +1726,12 @@ func TestConditionalOrder_TriggeringUsingMatchedPrice(t *testing.T) {
 						),
 					},
 					PerpetualPositions: []*satypes.PerpetualPosition{
-						testutil.CreateSinglePerpetualPosition(
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
 							0,
 							big.NewInt(25_000_000),
 							big.NewInt(0),
 							big.NewInt(0),
+							big.NewInt(12_500_000_000),
 						),
 					},
 				},
@@ -1766,11 +1770,12 @@ func TestConditionalOrder_TriggeringUsingMatchedPrice(t *testing.T) {
 						),
 					},
 					PerpetualPositions: []*satypes.PerpetualPosition{
-						testutil.CreateSinglePerpetualPosition(
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
 							0,
 							big.NewInt(50_000_000),
 							big.NewInt(0),
 							big.NewInt(0),
+							big.NewInt(25_000_000_000),
 						),
 					},
 				},
@@ -1847,11 +1852,12 @@ func TestConditionalOrder_TriggeringUsingMatchedPrice(t *testing.T) {
 						),
 					},
 					PerpetualPositions: []*satypes.PerpetualPosition{
-						testutil.CreateSinglePerpetualPosition(
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
 							0,
 							big.NewInt(25_000_000),
 							big.NewInt(0),
 							big.NewInt(0),
+							big.NewInt(12_500_000_000),
 						),
 					},
 				},
@@ -79,11 +79,12 @@ func TestIsolatedSubaccountOrders(t *testing.T) {
 		},
 		PerpetualPositions: []*satypes.PerpetualPosition{
 			// Isolated perpetual position.
-			testutil.CreateSinglePerpetualPosition(
+			testutil.CreateSinglePerpetualPositionWithCostBasis(
 				uint32(3),
 				big.NewInt(int64(orderQuantums)),
 				big.NewInt(0),
 				big.NewInt(0),
+				big.NewInt(100),
 			),
 		},
 	}
@@ -102,11 +103,12 @@ func TestIsolatedSubaccountOrders(t *testing.T) {
 		},
 		PerpetualPositions: []*satypes.PerpetualPosition{
 			// Isolated perpetual position.
-			testutil.CreateSinglePerpetualPosition(
+			testutil.CreateSinglePerpetualPositionWithCostBasis(
 				uint32(3),
 				big.NewInt(-1*int64(orderQuantums)),
 				big.NewInt(0),
 				big.NewInt(0),
+				big.NewInt(-100),
 			),
 		},
 	}
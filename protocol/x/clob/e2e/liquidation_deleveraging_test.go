@@ -467,11 +467,12 @@ func TestLiquidationConfig(t *testing.T) {
 						),
 					},
 					PerpetualPositions: []*satypes.PerpetualPosition{
-						testutil.CreateSinglePerpetualPosition(
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
 							0,
 							big.NewInt(-10_000_000), // -0.1 BTC
 							big.NewInt(0),
 							big.NewInt(0),
+							big.NewInt(-5_050_000_000),
 						),
 					},
 				},
@@ -524,11 +525,12 @@ func TestLiquidationConfig(t *testing.T) {
 						),
 					},
 					PerpetualPositions: []*satypes.PerpetualPosition{
-						testutil.CreateSinglePerpetualPosition(
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
 							0,
 							big.NewInt(10_000_000), // 0.1 BTC
 							big.NewInt(0),
 							big.NewInt(0),
+							big.NewInt(4_950_000_000),
 						),
 					},
 				},
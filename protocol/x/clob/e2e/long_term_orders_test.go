@@ -619,12 +619,13 @@ func TestPlaceLongTermOrder(t *testing.T) {
 				{
 					Id: &constants.Alice_Num0,
 					PerpetualPositions: []*satypes.PerpetualPosition{
-						testutil.CreateSinglePerpetualPosition(
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
 							Clob_0.MustGetPerpetualId(),
 							big.NewInt(int64(
 								LongTermPlaceOrder_Alice_Num0_Id0_Clob0_Buy1_Price50000_GTBT5.Order.GetQuantums())),
 							big.NewInt(0),
 							big.NewInt(0),
+							big.NewInt(50_000_000_000),
 						),
 					},
 					AssetPositions: []*satypes.AssetPosition{
@@ -643,12 +644,13 @@ func TestPlaceLongTermOrder(t *testing.T) {
 				{
 					Id: &constants.Bob_Num0,
 					PerpetualPositions: []*satypes.PerpetualPosition{
-						testutil.CreateSinglePerpetualPosition(
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
 							Clob_0.MustGetPerpetualId(),
 							big.NewInt(-int64(
 								LongTermPlaceOrder_Alice_Num0_Id0_Clob0_Buy1_Price50000_GTBT5.Order.GetQuantums())),
 							big.NewInt(0),
 							big.NewInt(0),
+							big.NewInt(-50_000_000_000),
 						),
 					},
 					AssetPositions: []*satypes.AssetPosition{
@@ -935,12 +937,13 @@ func TestPlaceLongTermOrder(t *testing.T) {
 				{
 					Id: &constants.Alice_Num0,
 					PerpetualPositions: []*satypes.PerpetualPosition{
-						testutil.CreateSinglePerpetualPosition(
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
 							Clob_0.MustGetPerpetualId(),
 							big.NewInt(int64(
 								LongTermPlaceOrder_Alice_Num0_Id0_Clob0_Buy2_Price50000_GTBT5.Order.GetQuantums())),
 							big.NewInt(0),
 							big.NewInt(0),
+							big.NewInt(100_000_000_000),
 						),
 					},
 					AssetPositions: []*satypes.AssetPosition{
@@ -960,7 +963,7 @@ func TestPlaceLongTermOrder(t *testing.T) {
 				{
 					Id: &constants.Bob_Num0,
 					PerpetualPositions: []*satypes.PerpetualPosition{
-						testutil.CreateSinglePerpetualPosition(
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
 							Clob_0.MustGetPerpetualId(),
 							big.NewInt(-int64(
 								PlaceOrder_Bob_Num0_Id0_Clob0_Sell1_Price50000_GTB20.Order.GetQuantums()+
@@ -968,6 +971,7 @@ func TestPlaceLongTermOrder(t *testing.T) {
 							)),
 							big.NewInt(0),
 							big.NewInt(0),
+							big.NewInt(-100_000_000_000),
 						),
 					},
 					AssetPositions: []*satypes.AssetPosition{
@@ -1504,12 +1508,13 @@ func TestRegression_InvalidTimeInForce(t *testing.T) {
 				{
 					Id: &constants.Alice_Num0,
 					PerpetualPositions: []*satypes.PerpetualPosition{
-						testutil.CreateSinglePerpetualPosition(
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
 							Clob_0.MustGetPerpetualId(),
 							big.NewInt(int64(
 								Invalid_TIF_LongTermPlaceOrder_Alice_Num0_Id0_Clob0_Buy1_Price50000_GTBT5.Order.GetQuantums())),
 							big.NewInt(0),
 							big.NewInt(0),
+							big.NewInt(50_000_000_000),
 						),
 					},
 					AssetPositions: []*satypes.AssetPosition{
@@ -1528,12 +1533,13 @@ func TestRegression_InvalidTimeInForce(t *testing.T) {
 				{
 					Id: &constants.Bob_Num0,
 					PerpetualPositions: []*satypes.PerpetualPosition{
-						testutil.CreateSinglePerpetualPosition(
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
 							Clob_0.MustGetPerpetualId(),
 							big.NewInt(-int64(
 								Invalid_TIF_LongTermPlaceOrder_Alice_Num0_Id0_Clob0_Buy1_Price50000_GTBT5.Order.GetQuantums())),
 							big.NewInt(0),
 							big.NewInt(0),
+							big.NewInt(-50_000_000_000),
 						),
 					},
 					AssetPositions: []*satypes.AssetPosition{
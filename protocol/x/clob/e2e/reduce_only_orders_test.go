@@ -68,11 +68,12 @@ func TestReduceOnlyOrders(t *testing.T) {
 						),
 					},
 					PerpetualPositions: []*satypes.PerpetualPosition{
-						testutil.CreateSinglePerpetualPosition(
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
 							0,
 							big.NewInt(100),
 							big.NewInt(0),
 							big.NewInt(0),
+							big.NewInt(5_000_000_000),
 						),
 					},
 				},
@@ -131,11 +132,12 @@ func TestReduceOnlyOrders(t *testing.T) {
 						),
 					},
 					PerpetualPositions: []*satypes.PerpetualPosition{
-						testutil.CreateSinglePerpetualPosition(
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
 							0,
 							big.NewInt(100),
 							big.NewInt(0),
 							big.NewInt(0),
+							big.NewInt(5_000_000_000),
 						),
 					},
 				},
@@ -194,11 +196,12 @@ func TestReduceOnlyOrders(t *testing.T) {
 						),
 					},
 					PerpetualPositions: []*satypes.PerpetualPosition{
-						testutil.CreateSinglePerpetualPosition(
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
 							0,
 							big.NewInt(150),
 							big.NewInt(0),
 							big.NewInt(0),
+							big.NewInt(7_500_000_000),
 						),
 					},
 				},
@@ -266,11 +269,12 @@ func TestReduceOnlyOrders(t *testing.T) {
 						),
 					},
 					PerpetualPositions: []*satypes.PerpetualPosition{
-						testutil.CreateSinglePerpetualPosition(
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
 							0,
 							big.NewInt(25_000_000),
 							big.NewInt(0),
 							big.NewInt(0),
+							big.NewInt(125_000_000_000),
 						),
 					},
 				},
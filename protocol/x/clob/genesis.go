@@ -46,6 +46,8 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 		panic(err)
 	}
 
+	k.SetInsuranceFundOutflowCap(ctx, genState.InsuranceFundOutflowCap)
+
 	k.InitializeProcessProposerMatchesEvents(ctx)
 	k.ResetAllDeliveredOrderIds(ctx)
 }
@@ -66,5 +68,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	// Read the equity tier limit configuration from state.
 	genesis.EquityTierLimitConfig = k.GetEquityTierLimitConfiguration(ctx)
 
+	// Read the insurance fund outflow cap from state.
+	genesis.InsuranceFundOutflowCap = k.GetInsuranceFundOutflowCap(ctx)
+
 	return genesis
 }
@@ -139,6 +139,7 @@ func TestGenesis(t *testing.T) {
 					PositionBlockLimits:   constants.PositionBlockLimits_Default,
 					SubaccountBlockLimits: constants.SubaccountBlockLimits_Default,
 				},
+				InsuranceFundOutflowCap: 1_000_000_000,
 			},
 		},
 		"Genesis state is valid when bankruptcy adjustment ppm is greater than one million": {
@@ -482,6 +483,7 @@ func TestGenesis(t *testing.T) {
 			require.Equal(t, tc.genesis.LiquidationsConfig, got.LiquidationsConfig)
 			require.Equal(t, tc.genesis.BlockRateLimitConfig, got.BlockRateLimitConfig)
 			require.Equal(t, tc.genesis.EquityTierLimitConfig, got.EquityTierLimitConfig)
+			require.Equal(t, tc.genesis.InsuranceFundOutflowCap, got.InsuranceFundOutflowCap)
 		})
 	}
 }
@@ -500,6 +500,7 @@ func (k Keeper) ProcessDeleveraging(
 	offsettingSubaccountQuoteBalanceDelta := new(big.Int).Neg(deltaQuoteQuantums)
 	deleveragedSubaccountPerpetualQuantumsDelta := deltaBaseQuantums
 	offsettingSubaccountPerpetualQuantumsDelta := new(big.Int).Neg(deltaBaseQuantums)
+	fillQuoteQuantums := new(big.Int).Abs(deltaQuoteQuantums)
 
 	updates := []satypes.Update{
 		// Liquidated subaccount update.
@@ -512,8 +513,9 @@ func (k Keeper) ProcessDeleveraging(
 			},
 			PerpetualUpdates: []satypes.PerpetualUpdate{
 				{
-					PerpetualId:      perpetualId,
-					BigQuantumsDelta: deleveragedSubaccountPerpetualQuantumsDelta,
+					PerpetualId:          perpetualId,
+					BigQuantumsDelta:     deleveragedSubaccountPerpetualQuantumsDelta,
+					BigFillQuoteQuantums: fillQuoteQuantums,
 				},
 			},
 			SubaccountId: liquidatedSubaccountId,
@@ -528,8 +530,9 @@ func (k Keeper) ProcessDeleveraging(
 			},
 			PerpetualUpdates: []satypes.PerpetualUpdate{
 				{
-					PerpetualId:      perpetualId,
-					BigQuantumsDelta: offsettingSubaccountPerpetualQuantumsDelta,
+					PerpetualId:          perpetualId,
+					BigQuantumsDelta:     offsettingSubaccountPerpetualQuantumsDelta,
+					BigFillQuoteQuantums: fillQuoteQuantums,
 				},
 			},
 			SubaccountId: offsettingSubaccountId,
@@ -588,6 +591,62 @@ func (k Keeper) ProcessDeleveraging(
 	return nil
 }
 
+// AppendUncoveredBankruptSubaccountsToDeleverage appends to `subaccountsToDeleverage` the perpetual
+// positions of the subaccounts in `subaccountIds` that have negative net collateral which the insurance
+// fund of the position's perpetual cannot cover, skipping positions that are already being deleveraged.
+// Closing these positions through liquidations would leave bad debt, so they are deleveraged against
+// offsetting subaccounts instead. Subaccounts whose net collateral cannot be computed are logged and
+// skipped. This function is called in PrepareCheckState during the deleveraging step.
+func (k Keeper) AppendUncoveredBankruptSubaccountsToDeleverage(
+	ctx sdk.Context,
+	subaccountIds []satypes.SubaccountId,
+	subaccountsToDeleverage []subaccountToDeleverage,
+) []subaccountToDeleverage {
+	seen := make(map[subaccountToDeleverage]struct{}, len(subaccountsToDeleverage))
+	for _, s := range subaccountsToDeleverage {
+		seen[s] = struct{}{}
+	}
+
+	for _, subaccountId := range subaccountIds {
+		risk, err := k.subaccountsKeeper.GetNetCollateralAndMarginRequirements(
+			ctx,
+			satypes.Update{SubaccountId: subaccountId},
+		)
+		if err != nil {
+			log.ErrorLogWithError(
+				ctx,
+				"Failed to get net collateral of bankrupt subaccount",
+				err,
+				"subaccount_id",
+				subaccountId,
+			)
+			continue
+		}
+		if risk.NC.Sign() >= 0 {
+			continue
+		}
+
+		deficit := new(big.Int).Neg(risk.NC)
+		subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+		for _, position := range subaccount.PerpetualPositions {
+			insuranceFundBalance := k.subaccountsKeeper.GetInsuranceFundBalance(ctx, position.PerpetualId)
+			if insuranceFundBalance.Cmp(deficit) >= 0 {
+				continue
+			}
+			s := subaccountToDeleverage{
+				SubaccountId: subaccountId,
+				PerpetualId:  position.PerpetualId,
+			}
+			if _, ok := seen[s]; ok {
+				continue
+			}
+			seen[s] = struct{}{}
+			subaccountsToDeleverage = append(subaccountsToDeleverage, s)
+		}
+	}
+	return subaccountsToDeleverage
+}
+
 // GetSubaccountsWithPositionsInFinalSettlementMarkets uses the subaccountOpenPositionInfo returned from the
 // liquidations daemon to fetch subaccounts with open positions in final settlement markets. These subaccounts
 // will be deleveraged in either at the oracle price if non-negative TNC or at bankruptcy price if negative TNC. This
@@ -323,6 +323,104 @@ func TestCanDeleverageSubaccount(t *testing.T) {
 	}
 }
 
+func TestAppendUncoveredBankruptSubaccountsToDeleverage(t *testing.T) {
+	tests := map[string]struct {
+		// Setup
+		insuranceFundBalance *big.Int
+		oraclePrice          uint64
+
+		// Expectations.
+		expectedSubaccountIds []satypes.SubaccountId
+		expectedPerpetualIds  []uint32
+	}{
+		`Does not deleverage a subaccount with positive TNC`: {
+			insuranceFundBalance:  big.NewInt(0),
+			oraclePrice:           5_000_000_000, // $50,000 / BTC
+			expectedSubaccountIds: []satypes.SubaccountId{},
+			expectedPerpetualIds:  []uint32{},
+		},
+		`Does not deleverage a subaccount with negative TNC covered by the insurance fund`: {
+			insuranceFundBalance:  big.NewInt(1_000_000), // $1
+			oraclePrice:           5_500_000_000,         // $55,000 / BTC
+			expectedSubaccountIds: []satypes.SubaccountId{},
+			expectedPerpetualIds:  []uint32{},
+		},
+		`Deleverages a subaccount with negative TNC not covered by the insurance fund`: {
+			insuranceFundBalance:  big.NewInt(999_999), // $0.999999
+			oraclePrice:           5_500_000_000,       // $55,000 / BTC
+			expectedSubaccountIds: []satypes.SubaccountId{constants.Carl_Num0},
+			expectedPerpetualIds:  []uint32{0},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Setup keeper state.
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			bankMock := &mocks.BankKeeper{}
+			mockIndexerEventManager := &mocks.IndexerEventManager{}
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, bankMock, mockIndexerEventManager)
+
+			err := keepertest.CreateUsdcAsset(ks.Ctx, ks.AssetsKeeper)
+			require.NoError(t, err)
+
+			bankMock.On(
+				"GetBalance",
+				mock.Anything,
+				perptypes.InsuranceFundModuleAddress,
+				constants.Usdc.Denom,
+			).Return(
+				sdk.NewCoin(constants.Usdc.Denom, sdkmath.NewIntFromBigInt(tc.insuranceFundBalance)),
+			)
+
+			keepertest.CreateTestMarkets(t, ks.Ctx, ks.PricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ks.Ctx, ks.PerpetualsKeeper)
+
+			err = ks.PricesKeeper.UpdateMarketPrices(
+				ks.Ctx,
+				[]*pricestypes.MsgUpdateMarketPrices_MarketPrice{
+					{
+						MarketId: constants.BtcUsd.MarketId,
+						Price:    tc.oraclePrice,
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			perpetual := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err = ks.PerpetualsKeeper.CreatePerpetual(
+				ks.Ctx,
+				perpetual.Params.Id,
+				perpetual.Params.Ticker,
+				perpetual.Params.MarketId,
+				perpetual.Params.AtomicResolution,
+				perpetual.Params.DefaultFundingPpm,
+				perpetual.Params.LiquidityTier,
+				perpetual.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			// NC = $54,999 - 1 BTC.
+			ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, constants.Carl_Num0_1BTC_Short_54999USD)
+
+			// Duplicate subaccount ids are only deleveraged once.
+			subaccountsToDeleverage := ks.ClobKeeper.AppendUncoveredBankruptSubaccountsToDeleverage(
+				ks.Ctx,
+				[]satypes.SubaccountId{constants.Carl_Num0, constants.Carl_Num0},
+				nil,
+			)
+			subaccountIds := make([]satypes.SubaccountId, 0)
+			perpetualIds := make([]uint32, 0)
+			for _, s := range subaccountsToDeleverage {
+				subaccountIds = append(subaccountIds, s.SubaccountId)
+				perpetualIds = append(perpetualIds, s.PerpetualId)
+			}
+			require.Equal(t, tc.expectedSubaccountIds, subaccountIds)
+			require.Equal(t, tc.expectedPerpetualIds, perpetualIds)
+		})
+	}
+}
+
 func TestOffsetSubaccountPerpetualPosition(t *testing.T) {
 	tests := map[string]struct {
 		// Setup.
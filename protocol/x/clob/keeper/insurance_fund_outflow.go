@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetInsuranceFundOutflowCap returns the maximum amount of quote quantums the insurance fund can pay
+// out for liquidations per block. Zero means the outflow is not capped.
+func (k Keeper) GetInsuranceFundOutflowCap(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get([]byte(types.InsuranceFundOutflowCapKey))
+	if b == nil {
+		return 0
+	}
+
+	var outflowCap gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(b, &outflowCap)
+	return outflowCap.Value
+}
+
+// SetInsuranceFundOutflowCap sets the maximum amount of quote quantums the insurance fund can pay out
+// for liquidations per block. Setting it to zero removes the cap.
+func (k Keeper) SetInsuranceFundOutflowCap(ctx sdk.Context, outflowCap uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if outflowCap == 0 {
+		store.Delete([]byte(types.InsuranceFundOutflowCapKey))
+		return
+	}
+	store.Set(
+		[]byte(types.InsuranceFundOutflowCapKey),
+		k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: outflowCap}),
+	)
+}
+
+// GetInsuranceFundOutflow returns the quote quantums the insurance fund paid out for liquidations
+// in the current block.
+func (k Keeper) GetInsuranceFundOutflow(ctx sdk.Context) uint64 {
+	b := k.getTransientStore(ctx).Get([]byte(types.InsuranceFundOutflowKey))
+	if b == nil {
+		return 0
+	}
+
+	var outflow gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(b, &outflow)
+	return outflow.Value
+}
+
+// addInsuranceFundOutflow adds `quoteQuantums` to the quote quantums the insurance fund paid out for
+// liquidations in the current block.
+func (k Keeper) addInsuranceFundOutflow(ctx sdk.Context, quoteQuantums *big.Int) {
+	outflow := new(big.Int).Add(
+		new(big.Int).SetUint64(k.GetInsuranceFundOutflow(ctx)),
+		quoteQuantums,
+	)
+	if !outflow.IsUint64() {
+		// This should never happen, since the insurance fund outflow should never exceed the
+		// maximum possible insurance fund balance.
+		panic(
+			errorsmod.Wrapf(
+				satypes.ErrIntegerOverflow,
+				"Insurance fund outflow update %v overflows uint64",
+				quoteQuantums,
+			),
+		)
+	}
+	k.getTransientStore(ctx).Set(
+		[]byte(types.InsuranceFundOutflowKey),
+		k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: outflow.Uint64()}),
+	)
+}
+
+// validateLiquidationAgainstInsuranceFundOutflowCap returns an error if paying out the negative
+// `insuranceFundDelta` of a liquidation would take the insurance fund outflow of the current block
+// above the outflow cap.
+func (k Keeper) validateLiquidationAgainstInsuranceFundOutflowCap(
+	ctx sdk.Context,
+	insuranceFundDelta *big.Int,
+) error {
+	if insuranceFundDelta.Sign() >= 0 {
+		return nil
+	}
+
+	outflowCap := k.GetInsuranceFundOutflowCap(ctx)
+	if outflowCap == 0 {
+		return nil
+	}
+
+	outflow := new(big.Int).Sub(
+		new(big.Int).SetUint64(k.GetInsuranceFundOutflow(ctx)),
+		insuranceFundDelta,
+	)
+	if outflow.Cmp(new(big.Int).SetUint64(outflowCap)) > 0 {
+		return errorsmod.Wrapf(
+			types.ErrLiquidationExceedsInsuranceFundOutflowCap,
+			"Insurance fund outflow %v exceeds the outflow cap %v",
+			outflow.String(),
+			outflowCap,
+		)
+	}
+	return nil
+}
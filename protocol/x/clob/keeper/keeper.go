@@ -69,6 +69,10 @@ type (
 		placeCancelOrderRateLimiter rate_limit.RateLimiter[sdk.Msg]
 
 		DaemonLiquidationInfo *liquidationtypes.DaemonLiquidationInfo
+
+		// Subaccounts whose liquidations were deferred because the insurance fund reached its outflow cap,
+		// liquidated first in the next `PrepareCheckState`.
+		DeferredLiquidationQueue *types.DeferredLiquidationQueue
 	}
 )
 
@@ -135,6 +139,7 @@ func NewKeeper(
 		Flags:                       clobFlags,
 		placeCancelOrderRateLimiter: placeCancelOrderRateLimiter,
 		DaemonLiquidationInfo:       daemonLiquidationInfo,
+		DeferredLiquidationQueue:    types.NewDeferredLiquidationQueue(),
 		revshareKeeper:              revshareKeeper,
 		finalizeBlockEventStager: finalizeblock.NewEventStager[*types.ClobStagedFinalizeBlockEvent](
 			transientStoreKey,
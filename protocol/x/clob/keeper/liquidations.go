@@ -33,6 +33,10 @@ type subaccountToDeleverage struct {
 // liquidations per block. Subaccounts are selected with a pseudo-randomly generated offset. A slice
 // of subaccounts to deleverage is returned from this function, derived from liquidation orders that
 // failed to fill.
+//
+// Subaccounts whose liquidations were deferred by the insurance fund outflow cap in a previous call are
+// liquidated first, in the order they were deferred. Liquidations that exceed the outflow cap are not
+// deleveraged and are deferred to the next call instead.
 func (k Keeper) LiquidateSubaccountsAgainstOrderbook(
 	ctx sdk.Context,
 	subaccountIds []satypes.SubaccountId,
@@ -47,9 +51,11 @@ func (k Keeper) LiquidateSubaccountsAgainstOrderbook(
 		float32(len(subaccountIds)),
 	)
 
+	deferredSubaccountIds := k.DeferredLiquidationQueue.Drain()
+
 	// Early return if there are 0 subaccounts to liquidate.
 	numSubaccounts := len(subaccountIds)
-	if numSubaccounts == 0 {
+	if numSubaccounts == 0 && len(deferredSubaccountIds) == 0 {
 		return nil, nil
 	}
 
@@ -60,13 +66,24 @@ func (k Keeper) LiquidateSubaccountsAgainstOrderbook(
 		metrics.Latency,
 	)
 
+	// Deferred subaccounts count towards `MaxLiquidationAttemptsPerBlock`. Deferred subaccounts beyond the
+	// limit remain deferred to the next block.
+	maxLiquidationAttempts := int(k.Flags.MaxLiquidationAttemptsPerBlock)
+	if len(deferredSubaccountIds) > maxLiquidationAttempts {
+		k.DeferredLiquidationQueue.Append(deferredSubaccountIds[maxLiquidationAttempts:]...)
+		deferredSubaccountIds = deferredSubaccountIds[:maxLiquidationAttempts]
+	}
+
 	// Get the liquidation order for each subaccount.
-	// Process at-most `MaxLiquidationAttemptsPerBlock` subaccounts, starting from a pseudorandom location
-	// in the slice. Note `numSubaccounts` is guaranteed to be non-zero at this point, so `Intn` shouldn't panic.
+	// Process at-most `MaxLiquidationAttemptsPerBlock` subaccounts in total, starting from a pseudorandom
+	// location in the slice.
 	pseudoRand := k.GetPseudoRand(ctx)
 	liquidationOrders := make([]types.LiquidationOrder, 0)
-	numLiqOrders := lib.Min(numSubaccounts, int(k.Flags.MaxLiquidationAttemptsPerBlock))
-	indexOffset := pseudoRand.Intn(numSubaccounts)
+	numLiqOrders := lib.Min(numSubaccounts, maxLiquidationAttempts-len(deferredSubaccountIds))
+	indexOffset := 0
+	if numSubaccounts > 0 {
+		indexOffset = pseudoRand.Intn(numSubaccounts)
+	}
 
 	startGetLiquidationOrders := time.Now()
 	for i := 0; i < numLiqOrders; i++ {
@@ -102,9 +119,21 @@ func (k Keeper) LiquidateSubaccountsAgainstOrderbook(
 	// can alter quantity sizes of subsequent liquidation orders.
 	k.SortLiquidationOrders(ctx, liquidationOrders)
 
-	subaccountIdsToLiquidate := lib.MapSlice(liquidationOrders, func(order types.LiquidationOrder) satypes.SubaccountId {
-		return order.GetSubaccountId()
-	})
+	// Deferred subaccounts are liquidated ahead of the sorted subaccounts, in the order they were deferred.
+	subaccountIdsToLiquidate := make([]satypes.SubaccountId, 0, len(deferredSubaccountIds)+len(liquidationOrders))
+	seen := make(map[satypes.SubaccountId]struct{}, cap(subaccountIdsToLiquidate))
+	for _, subaccountId := range append(
+		deferredSubaccountIds,
+		lib.MapSlice(liquidationOrders, func(order types.LiquidationOrder) satypes.SubaccountId {
+			return order.GetSubaccountId()
+		})...,
+	) {
+		if _, ok := seen[subaccountId]; ok {
+			continue
+		}
+		seen[subaccountId] = struct{}{}
+		subaccountIdsToLiquidate = append(subaccountIdsToLiquidate, subaccountId)
+	}
 
 	// Attempt to place each liquidation order and perform deleveraging if necessary.
 	startPlaceLiquidationOrders := time.Now()
@@ -122,7 +151,7 @@ func (k Keeper) LiquidateSubaccountsAgainstOrderbook(
 			return nil, err
 		}
 
-		optimisticallyFilledQuantums, _, err := k.PlacePerpetualLiquidation(ctx, *liquidationOrder)
+		optimisticallyFilledQuantums, orderStatus, err := k.PlacePerpetualLiquidation(ctx, *liquidationOrder)
 		// Exception for liquidation which conflicts with clob pair status. This is expected for liquidations generated
 		// for subaccounts with open positions in final settlement markets.
 		if err != nil && !errors.Is(err, types.ErrLiquidationConflictsWithClobPairStatus) {
@@ -135,6 +164,13 @@ func (k Keeper) LiquidateSubaccountsAgainstOrderbook(
 			return nil, err
 		}
 
+		// The insurance fund reached its outflow cap for this block. Defer the liquidation to the next
+		// block instead of deleveraging the subaccount.
+		if orderStatus == types.LiquidationExceededInsuranceFundOutflowCap {
+			k.DeferredLiquidationQueue.Append(subaccountId)
+			continue
+		}
+
 		if optimisticallyFilledQuantums == 0 {
 			subaccountsToDeleverage = append(subaccountsToDeleverage, subaccountToDeleverage{
 				SubaccountId: liquidationOrder.GetSubaccountId(),
@@ -344,9 +380,9 @@ func (k Keeper) PlacePerpetualLiquidation(
 
 // IsLiquidatable returns true if the subaccount is able to be liquidated; that is,
 // if-and-only-if the maintenance margin requirement is non-zero and greater than the net collateral
-// of the subaccount.
-// If `GetNetCollateralAndMarginRequirements` returns an error, this function will return that
-// error to the caller.
+// of the subaccount, as returned by the subaccounts keeper's `GetRiskForSubaccount` including its external
+// and locked collateral, and the subaccount has remained so for the liquidation grace period.
+// If `GetRiskForSubaccount` returns an error, this function will return that error to the caller.
 func (k Keeper) IsLiquidatable(
 	ctx sdk.Context,
 	subaccountId satypes.SubaccountId,
@@ -354,15 +390,31 @@ func (k Keeper) IsLiquidatable(
 	bool,
 	error,
 ) {
-	risk, err := k.subaccountsKeeper.GetNetCollateralAndMarginRequirements(
-		ctx,
-		satypes.Update{SubaccountId: subaccountId},
-	)
+	risk, err := k.subaccountsKeeper.GetRiskForSubaccount(ctx, subaccountId, true)
 	if err != nil {
 		return false, err
 	}
 
-	return risk.IsLiquidatable(), nil
+	return risk.IsLiquidatable() && k.subaccountsKeeper.IsLiquidationGracePeriodElapsed(ctx, subaccountId), nil
+}
+
+// TrackUndercollateralizedSubaccounts records the first block at which each subaccount was seen below
+// maintenance margin and clears subaccounts that have recovered. See the subaccounts keeper's
+// `TrackUndercollateralizedSubaccounts`.
+func (k Keeper) TrackUndercollateralizedSubaccounts(ctx sdk.Context) error {
+	return k.subaccountsKeeper.TrackUndercollateralizedSubaccounts(ctx)
+}
+
+// SweepDustSubaccounts closes the subaccounts whose balance is below the dust threshold. See the
+// subaccounts keeper's `SweepDustSubaccounts`.
+func (k Keeper) SweepDustSubaccounts(ctx sdk.Context) []satypes.SubaccountId {
+	return k.subaccountsKeeper.SweepDustSubaccounts(ctx)
+}
+
+// SettleFundingAfterFundingTick settles the funding of all perpetual positions after each funding tick.
+// See the subaccounts keeper's `SettleFundingAfterFundingTick`.
+func (k Keeper) SettleFundingAfterFundingTick(ctx sdk.Context) error {
+	return k.subaccountsKeeper.SettleFundingAfterFundingTick(ctx)
 }
 
 // EnsureIsLiquidatable returns an error if the subaccount is not liquidatable.
@@ -635,6 +687,8 @@ func (k Keeper) GetFillablePrice(
 // GetLiquidationInsuranceFundDelta returns the net payment value between the liquidated account
 // and the insurance fund. Positive if the liquidated account pays fees to the insurance fund.
 // Negative if the insurance fund covers losses from the subaccount.
+// The fee is capped by the `MaxLiquidationFeePpm` of the liquidations config, or by the penalty of
+// `perplib.ComputeLiquidationPenalty` if the liquidity tier of the perpetual sets `LiquidationPenaltyPpm`.
 func (k Keeper) GetLiquidationInsuranceFundDelta(
 	ctx sdk.Context,
 	subaccountId satypes.SubaccountId,
@@ -706,6 +760,23 @@ func (k Keeper) GetLiquidationInsuranceFundDelta(
 		liquidationsConfig.MaxLiquidationFeePpm,
 	)
 
+	// If the liquidity tier of the perpetual charges a liquidation penalty, the penalty scaled with the
+	// maintenance margin deficit of the subaccount replaces the max liquidation fee.
+	_, _, liquidityTier, err := k.perpetualsKeeper.GetPerpetualAndMarketPriceAndLiquidityTier(ctx, perpetualId)
+	if err != nil {
+		return nil, err
+	}
+	if liquidityTier.LiquidationPenaltyPpm != 0 {
+		risk, err := k.subaccountsKeeper.GetNetCollateralAndMarginRequirements(
+			ctx,
+			satypes.Update{SubaccountId: subaccountId},
+		)
+		if err != nil {
+			return nil, err
+		}
+		maxLiquidationFeeQuoteQuantumsBig = perplib.ComputeLiquidationPenalty(risk, liquidityTier)
+	}
+
 	// The liquidation fee paid by the user is the minimum of the max liquidation fee and the
 	// leftover collateral from liquidating the position.
 	return lib.BigMin(
@@ -1112,6 +1183,11 @@ func (k Keeper) validateMatchedLiquidation(
 		)
 	}
 
+	// Validate that the insurance fund outflow of the current block does not exceed the outflow cap.
+	if err := k.validateLiquidationAgainstInsuranceFundOutflowCap(ctx, insuranceFundDelta); err != nil {
+		return nil, err
+	}
+
 	// Validate that total notional liquidated and total insurance funds lost do not exceed subaccount block limits.
 	if err := k.validateLiquidationAgainstSubaccountBlockLimits(
 		ctx,
@@ -63,7 +63,7 @@ func (k Keeper) MustUpdateSubaccountPerpetualLiquidated(
 }
 
 // UpdateSubaccountLiquidationInfo updates the total notional liquidated and total insurance lost
-// of the given subaccount for the current block.
+// of the given subaccount, and the insurance fund outflow, for the current block.
 func (k Keeper) UpdateSubaccountLiquidationInfo(
 	ctx sdk.Context,
 	subaccountId satypes.SubaccountId,
@@ -111,6 +111,7 @@ func (k Keeper) UpdateSubaccountLiquidationInfo(
 		}
 
 		subaccountLiquidationInfo.QuantumsInsuranceLost = updatedQuantumsInsuranceLost.Uint64()
+		k.addInsuranceFundOutflow(ctx, new(big.Int).Abs(insuranceFundDeltaQuoteQuantums))
 	}
 
 	store := k.getSubaccountLiquidationInfoStore(ctx)
@@ -2272,6 +2272,154 @@ func TestPlacePerpetualLiquidation_Deleveraging(t *testing.T) {
 	}
 }
 
+func TestLiquidateSubaccountsAgainstOrderbook_InsuranceFundOutflowCap(t *testing.T) {
+	memclob := memclob.NewMemClobPriceTimePriority(false)
+
+	bankKeeper := &mocks.BankKeeper{}
+	bankKeeper.On(
+		"SendCoinsFromModuleToModule",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil)
+	bankKeeper.On(
+		"SendCoins",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil)
+	bankKeeper.On(
+		"GetBalance",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(sdk.NewCoin("USDC", sdkmath.NewIntFromUint64(1_000_000_000_000)))
+
+	mockIndexerEventManager := &mocks.IndexerEventManager{}
+	mockIndexerEventManager.On("Enabled").Return(false)
+	mockIndexerEventManager.On("AddTxnEvent",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return()
+	ks := keepertest.NewClobKeepersTestContext(t, memclob, bankKeeper, mockIndexerEventManager)
+
+	ctx := ks.Ctx.WithIsCheckTx(true)
+
+	keepertest.CreateTestMarkets(t, ctx, ks.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, ks.PerpetualsKeeper)
+	require.NoError(t, ks.FeeTiersKeeper.SetPerpetualFeeParams(ctx, constants.PerpetualFeeParamsNoFee))
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, ks.AssetsKeeper))
+
+	perpetual := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+	_, err := ks.PerpetualsKeeper.CreatePerpetual(
+		ctx,
+		perpetual.Params.Id,
+		perpetual.Params.Ticker,
+		perpetual.Params.MarketId,
+		perpetual.Params.AtomicResolution,
+		perpetual.Params.DefaultFundingPpm,
+		perpetual.Params.LiquidityTier,
+		perpetual.Params.MarketType,
+	)
+	require.NoError(t, err)
+	perptest.SetUpDefaultPerpOIsForTest(t, ks.Ctx, ks.PerpetualsKeeper, []perptypes.Perpetual{perpetual})
+
+	// Both Carl subaccounts are bankrupt at $50,600 / BTC.
+	for _, subaccount := range []satypes.Subaccount{
+		constants.Carl_Num0_1BTC_Short_50499USD,
+		constants.Carl_Num1_1BTC_Short_50499USD,
+		constants.Dave_Num0_1BTC_Long_50000USD,
+	} {
+		ks.SubaccountsKeeper.SetSubaccount(ctx, subaccount)
+	}
+	require.NoError(
+		t,
+		ks.PricesKeeper.UpdateMarketPrices(
+			ctx,
+			[]*pricestypes.MsgUpdateMarketPrices_MarketPrice{
+				{
+					MarketId: constants.BtcUsd.MarketId,
+					Price:    5_060_000_000, // $50,600 / BTC
+				},
+			},
+		),
+	)
+
+	_, err = ks.ClobKeeper.CreatePerpetualClobPairAndMemStructs(
+		ctx,
+		constants.ClobPair_Btc.Id,
+		clobtest.MustPerpetualId(constants.ClobPair_Btc),
+		satypes.BaseQuantums(constants.ClobPair_Btc.StepBaseQuantums),
+		constants.ClobPair_Btc.QuantumConversionExponent,
+		constants.ClobPair_Btc.SubticksPerTick,
+		constants.ClobPair_Btc.Status,
+	)
+	require.NoError(t, err)
+	require.NoError(t, ks.ClobKeeper.InitializeLiquidationsConfig(ctx, constants.LiquidationsConfig_No_Limit))
+	ks.BlockTimeKeeper.SetPreviousBlockInfo(ctx, &blocktimetypes.BlockInfo{
+		Timestamp: time.Unix(5, 0),
+	})
+
+	// Place an order to sell 2 BTC at $50,500, which costs the insurance fund $1 per liquidated subaccount.
+	order := constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50500_GTB10
+	order.Quantums = 200_000_000
+	shortTermOrderPlacement := clobtest.NewShortTermOrderPlacementOperationRaw(order)
+	_, orderStatus, err := ks.ClobKeeper.PlaceShortTermOrder(
+		ctx.WithTxBytes(shortTermOrderPlacement.GetShortTermOrderPlacement()),
+		&types.MsgPlaceOrder{Order: order},
+	)
+	require.NoError(t, err)
+	require.Equal(t, types.Success, orderStatus)
+
+	// The cap covers the insurance fund payout of only one of the liquidations.
+	ks.ClobKeeper.SetInsuranceFundOutflowCap(ctx, 1_500_000) // $1.5
+
+	subaccountsToDeleverage, err := ks.ClobKeeper.LiquidateSubaccountsAgainstOrderbook(
+		ctx,
+		[]satypes.SubaccountId{constants.Carl_Num0, constants.Carl_Num1},
+	)
+	require.NoError(t, err)
+	require.Empty(t, subaccountsToDeleverage)
+	require.Equal(t, uint64(1_000_000), ks.ClobKeeper.GetInsuranceFundOutflow(ctx))
+
+	deferred := ks.ClobKeeper.DeferredLiquidationQueue.Drain()
+	require.Len(t, deferred, 1)
+	liquidated := constants.Carl_Num0
+	if deferred[0] == constants.Carl_Num0 {
+		liquidated = constants.Carl_Num1
+	}
+	require.Equal(
+		t,
+		types.SubaccountLiquidationInfo{
+			PerpetualsLiquidated:  []uint32{0},
+			NotionalLiquidated:    50_600_000_000, // $50,600
+			QuantumsInsuranceLost: 1_000_000,      // $1
+		},
+		ks.ClobKeeper.GetSubaccountLiquidationInfo(ctx, liquidated),
+	)
+	// The deferred liquidation did not fill, and is not deleveraged.
+	require.Equal(
+		t,
+		types.SubaccountLiquidationInfo{
+			PerpetualsLiquidated: []uint32{0},
+		},
+		ks.ClobKeeper.GetSubaccountLiquidationInfo(ctx, deferred[0]),
+	)
+
+	// Deferred subaccounts count towards the max liquidation attempts per block, and deferred subaccounts
+	// beyond the limit remain deferred.
+	ks.ClobKeeper.Flags.MaxLiquidationAttemptsPerBlock = 1
+	ks.ClobKeeper.DeferredLiquidationQueue.Append(constants.Dave_Num0, deferred[0])
+	subaccountsToDeleverage, err = ks.ClobKeeper.LiquidateSubaccountsAgainstOrderbook(
+		ctx,
+		[]satypes.SubaccountId{liquidated},
+	)
+	require.NoError(t, err)
+	require.Empty(t, subaccountsToDeleverage)
+	require.Equal(t, []satypes.SubaccountId{deferred[0]}, ks.ClobKeeper.DeferredLiquidationQueue.Drain())
+}
+
 func TestPlacePerpetualLiquidation_SendOffchainMessages(t *testing.T) {
 	indexerEventManager := &mocks.IndexerEventManager{}
 	for _, message := range constants.TestOffchainMessages {
@@ -2466,6 +2614,159 @@ func TestIsLiquidatable(t *testing.T) {
 	}
 }
 
+// fixedCollateral is a collateral source that provides a fixed amount of quote quantums to every subaccount.
+type fixedCollateral int64
+
+func (c fixedCollateral) GetCollateral(ctx sdk.Context, subaccountId satypes.SubaccountId) (*big.Int, error) {
+	return big.NewInt(int64(c)), nil
+}
+
+func TestIsLiquidatable_ExternalAndLockedCollateral(t *testing.T) {
+	tests := map[string]struct {
+		externalCollateralEnabled bool
+		lockedCollateral          uint64
+
+		expectedIsLiquidatable bool
+	}{
+		"Subaccount below maintenance margin requirements is liquidatable without external collateral": {
+			externalCollateralEnabled: false,
+			expectedIsLiquidatable:    true,
+		},
+		"Subaccount above maintenance margin requirements with external collateral is not liquidatable": {
+			externalCollateralEnabled: true,
+			expectedIsLiquidatable:    false,
+		},
+		"Subaccount above maintenance margin requirements with external collateral is liquidatable " +
+			"once collateral is locked": {
+			externalCollateralEnabled: true,
+			lockedCollateral:          2_000_000,
+			expectedIsLiquidatable:    true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Setup keeper state.
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+			keepertest.CreateTestMarkets(t, ks.Ctx, ks.PricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ks.Ctx, ks.PerpetualsKeeper)
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := ks.PerpetualsKeeper.CreatePerpetual(
+				ks.Ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			ks.SubaccountsKeeper.SetCollateralSources(fixedCollateral(constants.QuoteBalance_OneDollar * 2))
+			ks.SubaccountsKeeper.SetExternalCollateralEnabled(ks.Ctx, tc.externalCollateralEnabled)
+
+			// The subaccount is $1 below its maintenance margin requirement of $500.
+			subaccount := satypes.Subaccount{
+				Id: &satypes.SubaccountId{
+					Owner:  "liquidations_test",
+					Number: 0,
+				},
+				AssetPositions: testutil.CreateUsdcAssetPositions(
+					big.NewInt(constants.QuoteBalance_OneDollar * -4_501),
+				),
+				PerpetualPositions: []*satypes.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(
+						uint32(0),
+						big.NewInt(10_000_000), // 0.1 BTC, $5,000 notional.
+						big.NewInt(0),
+						big.NewInt(0),
+					),
+				},
+			}
+			ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, subaccount)
+			ks.SubaccountsKeeper.SetLockedCollateral(ks.Ctx, *subaccount.Id, tc.lockedCollateral)
+			isLiquidatable, err := ks.ClobKeeper.IsLiquidatable(ks.Ctx, *subaccount.Id)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedIsLiquidatable, isLiquidatable)
+		})
+	}
+}
+
+func TestIsLiquidatable_GracePeriod(t *testing.T) {
+	tests := map[string]struct {
+		// The USDC balance of the subaccount in each block, starting at block 10. The subaccount holds
+		// 1 BTC worth $50,000 with a maintenance margin requirement of $5,000.
+		quoteBalances []int64
+
+		// Expectations.
+		expectedIsLiquidatable []bool
+	}{
+		"Subaccount that recovers within the grace period is not liquidatable": {
+			quoteBalances: []int64{
+				-46_000_000_000, // NC = $4,000
+				-46_000_000_000, // NC = $4,000
+				-40_000_000_000, // NC = $10,000
+				-46_000_000_000, // NC = $4,000
+			},
+			expectedIsLiquidatable: []bool{false, false, false, false},
+		},
+		"Subaccount that stays below maintenance margin is liquidatable after the grace period": {
+			quoteBalances: []int64{
+				-46_000_000_000, // NC = $4,000
+				-46_000_000_000, // NC = $4,000
+				-46_000_000_000, // NC = $4,000
+				-46_000_000_000, // NC = $4,000
+			},
+			expectedIsLiquidatable: []bool{false, false, false, true},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Setup keeper state.
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+			keepertest.CreateTestMarkets(t, ks.Ctx, ks.PricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ks.Ctx, ks.PerpetualsKeeper)
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := ks.PerpetualsKeeper.CreatePerpetual(
+				ks.Ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			// A subaccount must remain below maintenance margin for 3 blocks before it is liquidatable.
+			ks.SubaccountsKeeper.SetLiquidationGracePeriodBlocks(ks.Ctx, 3)
+
+			subaccountId := satypes.SubaccountId{Owner: "liquidations_test", Number: 0}
+			for i, quoteBalance := range tc.quoteBalances {
+				ctx := ks.Ctx.WithBlockHeight(int64(10 + i))
+				ks.SubaccountsKeeper.SetSubaccount(ctx, satypes.Subaccount{
+					Id:             &subaccountId,
+					AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(quoteBalance)),
+					PerpetualPositions: []*satypes.PerpetualPosition{
+						&constants.PerpetualPosition_OneBTCLong,
+					},
+				})
+
+				isLiquidatable, err := ks.ClobKeeper.IsLiquidatable(ctx, subaccountId)
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedIsLiquidatable[i], isLiquidatable, "block %d", 10+i)
+
+				require.NoError(t, ks.ClobKeeper.TrackUndercollateralizedSubaccounts(ctx))
+			}
+		})
+	}
+}
+
 func TestGetBankruptcyPriceInQuoteQuantums(t *testing.T) {
 	tests := map[string]struct {
 		// Parameters.
@@ -3442,7 +3743,8 @@ func TestGetLiquidationInsuranceFundDelta(t *testing.T) {
 		fillAmount  uint64
 		subticks    types.Subticks
 
-		liquidationConfig *types.LiquidationsConfig
+		liquidationConfig     *types.LiquidationsConfig
+		liquidationPenaltyPpm uint32
 
 		// Perpetual and subaccount state.
 		perpetuals []perptypes.Perpetual
@@ -3478,6 +3780,32 @@ func TestGetLiquidationInsuranceFundDelta(t *testing.T) {
 			// liquidation fee is returned.
 			expectedLiquidationInsuranceFundDeltaBig: big.NewInt(28_050_000),
 		},
+		`Fully closing one long position above the bankruptcy price pays the liquidation penalty
+		when the liquidity tier sets LiquidationPenaltyPpm`: {
+			perpetualId: 0,
+			isBuy:       false,
+			fillAmount:  10_000_000,     // -0.1 BTC delta.
+			subticks:    50_000_000_000, // At the oracle price.
+
+			liquidationPenaltyPpm: 500_000,
+
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+			},
+
+			assetPositions: testutil.CreateUsdcAssetPositions(
+				big.NewInt(constants.QuoteBalance_OneDollar * -4_800),
+			),
+			perpetualPositions: []*satypes.PerpetualPosition{
+				&constants.PerpetualPosition_OneTenthBTCLong,
+			},
+
+			// Bankruptcy price in quote quantums is 4,800,000,000 quote quantums, leaving a surplus of
+			// 5,000,000,000 - 4,800,000,000 = 200,000,000 quote quantums.
+			// NC is 200,000,000 and MMR is 500,000,000, so the penalty is 50% of the deficit of 300,000,000,
+			// which replaces the max liquidation fee of abs(5,000,000,000) * 0.5% = 25,000,000.
+			expectedLiquidationInsuranceFundDeltaBig: big.NewInt(150_000_000),
+		},
 		`Fully closing one long position above the bankruptcy price pays max liquidation fee 
 		when MaxLiquidationFeePpm is 25_000`: {
 			perpetualId: 0,
@@ -3815,6 +4143,12 @@ func TestGetLiquidationInsuranceFundDelta(t *testing.T) {
 
 			// Create liquidity tiers.
 			keepertest.CreateTestLiquidityTiers(t, ks.Ctx, ks.PerpetualsKeeper)
+			if tc.liquidationPenaltyPpm != 0 {
+				liquidityTier, err := ks.PerpetualsKeeper.GetLiquidityTier(ks.Ctx, tc.perpetuals[0].Params.LiquidityTier)
+				require.NoError(t, err)
+				liquidityTier.LiquidationPenaltyPpm = tc.liquidationPenaltyPpm
+				require.NoError(t, ks.PerpetualsKeeper.ValidateAndSetLiquidityTier(ks.Ctx, liquidityTier))
+			}
 
 			// Create all perpetuals.
 			for _, p := range tc.perpetuals {
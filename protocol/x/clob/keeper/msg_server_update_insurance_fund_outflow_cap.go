@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// UpdateInsuranceFundOutflowCap updates the per-block insurance fund outflow cap in state.
+func (k msgServer) UpdateInsuranceFundOutflowCap(
+	goCtx context.Context,
+	msg *types.MsgUpdateInsuranceFundOutflowCap,
+) (resp *types.MsgUpdateInsuranceFundOutflowCapResponse, err error) {
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+
+	if !k.Keeper.HasAuthority(msg.Authority) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority %s",
+			msg.Authority,
+		)
+	}
+
+	k.Keeper.SetInsuranceFundOutflowCap(ctx, msg.InsuranceFundOutflowCap)
+	return &types.MsgUpdateInsuranceFundOutflowCapResponse{}, nil
+}
@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateInsuranceFundOutflowCap(t *testing.T) {
+	testCases := map[string]struct {
+		msg           *types.MsgUpdateInsuranceFundOutflowCap
+		expectedError error
+	}{
+		"Succeeds": {
+			msg: &types.MsgUpdateInsuranceFundOutflowCap{
+				Authority:               lib.GovModuleAddress.String(),
+				InsuranceFundOutflowCap: 1_000_000_000,
+			},
+		},
+		"Succeeds: removes the cap": {
+			msg: &types.MsgUpdateInsuranceFundOutflowCap{
+				Authority:               lib.GovModuleAddress.String(),
+				InsuranceFundOutflowCap: 0,
+			},
+		},
+		"Error: invalid authority": {
+			msg: &types.MsgUpdateInsuranceFundOutflowCap{
+				Authority:               "foobar",
+				InsuranceFundOutflowCap: 1_000_000_000,
+			},
+			expectedError: govtypes.ErrInvalidSigner,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+			ks.ClobKeeper.SetInsuranceFundOutflowCap(ks.Ctx, 500_000_000)
+
+			msgServer := keeper.NewMsgServerImpl(ks.ClobKeeper)
+			_, err := msgServer.UpdateInsuranceFundOutflowCap(ks.Ctx, tc.msg)
+
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				require.Equal(t, uint64(500_000_000), ks.ClobKeeper.GetInsuranceFundOutflowCap(ks.Ctx))
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.msg.InsuranceFundOutflowCap, ks.ClobKeeper.GetInsuranceFundOutflowCap(ks.Ctx))
+			}
+		})
+	}
+}
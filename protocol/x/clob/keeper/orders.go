@@ -999,7 +999,9 @@ func (k Keeper) MustValidateReduceOnlyOrder(
 }
 
 // AddOrderToOrderbookSubaccountUpdatesCheck performs checks on the subaccount updates that will occur
-// for orders to determine whether or not they may be added to the orderbook.
+// for orders to determine whether or not they may be added to the orderbook. The maker fee the subaccount
+// would pay if the order is filled is included in the updates, so the collateralization check uses the
+// net collateral after fees.
 func (k Keeper) AddOrderToOrderbookSubaccountUpdatesCheck(
 	ctx sdk.Context,
 	subaccountId satypes.SubaccountId,
@@ -2413,3 +2413,86 @@ func TestPlaceConditionalOrdersTriggeredInLastBlock(t *testing.T) {
 		})
 	}
 }
+
+func TestAddOrderToOrderbookSubaccountUpdatesCheck_Fees(t *testing.T) {
+	tests := map[string]struct {
+		feeParams feetypes.PerpetualFeeParams
+
+		expectedResult satypes.UpdateResult
+	}{
+		"Succeeds without fees if the subaccount is right at the initial margin ratio": {
+			feeParams:      constants.PerpetualFeeParamsNoFee,
+			expectedResult: satypes.Success,
+		},
+		"Fails if the maker fee would leave the subaccount below the initial margin ratio": {
+			feeParams: constants.PerpetualFeeParams,
+			// The maintenance margin requirement is also 100% of the notional.
+			expectedResult: satypes.NewlyBelowMaintenanceMargin,
+		},
+		"Succeeds with a maker rebate": {
+			feeParams:      constants.PerpetualFeeParamsMakerRebate,
+			expectedResult: satypes.Success,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			ks := keepertest.NewClobKeepersTestContext(
+				t,
+				memClob,
+				&mocks.BankKeeper{},
+				indexer_manager.NewIndexerEventManagerNoop(),
+			)
+			ctx := ks.Ctx.WithIsCheckTx(true)
+			keepertest.CreateTestMarkets(t, ctx, ks.PricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, ks.PerpetualsKeeper)
+			require.NoError(t, ks.FeeTiersKeeper.SetPerpetualFeeParams(ctx, tc.feeParams))
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, ks.AssetsKeeper))
+
+			p := constants.BtcUsd_100PercentMarginRequirement
+			_, err := ks.PerpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			clobPair := constants.ClobPair_Btc
+			_, err = ks.ClobKeeper.CreatePerpetualClobPairAndMemStructs(
+				ctx,
+				clobPair.Id,
+				clobtest.MustPerpetualId(clobPair),
+				satypes.BaseQuantums(clobPair.StepBaseQuantums),
+				clobPair.QuantumConversionExponent,
+				clobPair.SubticksPerTick,
+				clobPair.Status,
+			)
+			require.NoError(t, err)
+
+			// Buying 1 BTC at the oracle price with $50,000 of collateral leaves the subaccount right at its
+			// initial margin requirement before fees.
+			ks.SubaccountsKeeper.SetSubaccount(ctx, constants.Carl_Num0_50000USD)
+			order := constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price50000_GTB10
+
+			require.Equal(
+				t,
+				tc.expectedResult,
+				ks.ClobKeeper.AddOrderToOrderbookSubaccountUpdatesCheck(
+					ctx,
+					constants.Carl_Num0,
+					types.PendingOpenOrder{
+						RemainingQuantums: order.GetBaseQuantums(),
+						IsBuy:             order.IsBuy(),
+						Subticks:          order.GetOrderSubticks(),
+						ClobPairId:        order.GetClobPairId(),
+					},
+				),
+			)
+		})
+	}
+}
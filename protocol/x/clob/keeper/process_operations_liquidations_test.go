@@ -140,7 +140,7 @@ func TestProcessProposerMatches_Liquidation_Undercollateralized_Determinism(t *t
 			BlockHeight: 5,
 		},
 		expectedError: fmt.Errorf(
-			"Subaccount with id %v failed with UpdateResult: NewlyUndercollateralized:",
+			"Subaccount with id %v failed with UpdateResult: NewlyBelowMaintenanceMargin:",
 			constants.Carl_Num0,
 		),
 	}
@@ -1212,7 +1212,7 @@ func TestProcessProposerMatches_Liquidation_Failure(t *testing.T) {
 				),
 			},
 			expectedError: fmt.Errorf(
-				"Subaccount with id %v failed with UpdateResult: NewlyUndercollateralized",
+				"Subaccount with id %v failed with UpdateResult: NewlyBelowMaintenanceMargin",
 				constants.Carl_Num0,
 			),
 		},
@@ -1698,7 +1698,7 @@ func TestProcessProposerMatches_Liquidation_Validation_Failure(t *testing.T) {
 				),
 			},
 			expectedError: fmt.Errorf(
-				"Subaccount with id %v failed with UpdateResult: NewlyUndercollateralized",
+				"Subaccount with id %v failed with UpdateResult: NewlyBelowMaintenanceMargin",
 				constants.Carl_Num0,
 			),
 		},
@@ -378,8 +378,9 @@ func (k Keeper) persistMatchedOrders(
 			},
 			PerpetualUpdates: []satypes.PerpetualUpdate{
 				{
-					PerpetualId:      perpetualId,
-					BigQuantumsDelta: bigTakerPerpetualQuantumsDelta,
+					PerpetualId:          perpetualId,
+					BigQuantumsDelta:     bigTakerPerpetualQuantumsDelta,
+					BigFillQuoteQuantums: bigFillQuoteQuantums,
 				},
 			},
 			SubaccountId: matchWithOrders.TakerOrder.GetSubaccountId(),
@@ -394,8 +395,9 @@ func (k Keeper) persistMatchedOrders(
 			},
 			PerpetualUpdates: []satypes.PerpetualUpdate{
 				{
-					PerpetualId:      perpetualId,
-					BigQuantumsDelta: bigMakerPerpetualQuantumsDelta,
+					PerpetualId:          perpetualId,
+					BigQuantumsDelta:     bigMakerPerpetualQuantumsDelta,
+					BigFillQuoteQuantums: bigFillQuoteQuantums,
 				},
 			},
 			SubaccountId: matchWithOrders.MakerOrder.GetSubaccountId(),
@@ -580,7 +580,7 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 		}
 		// If stateful taker order fails collateralization checks while matching, add Order Removal
 		// to operations queue to forcefully remove the order from state.
-		if takerOrderStatus.OrderStatus == types.Undercollateralized && order.IsStatefulOrder() {
+		if takerOrderStatus.OrderStatus.IsCollateralCheckFailure() && order.IsStatefulOrder() {
 			if !m.operationsToPropose.IsOrderRemovalInOperationsQueue(order.OrderId) {
 				m.operationsToPropose.MustAddOrderRemovalToOperationsQueue(
 					order.OrderId,
@@ -1734,6 +1734,12 @@ func (m *MemClobPriceTimePriority) mustPerformTakerOrderMatching(
 				takerOrderStatus.OrderStatus = types.LiquidationExceededSubaccountMaxNotionalLiquidated
 				break
 			}
+			if errors.Is(err, types.ErrLiquidationExceedsInsuranceFundOutflowCap) {
+				// Insurance fund has reached its outflow cap for this block. Stop matching.
+				telemetry.IncrCounter(1, types.ModuleName, metrics.InsuranceFundOutflowCap, metrics.Count)
+				takerOrderStatus.OrderStatus = types.LiquidationExceededInsuranceFundOutflowCap
+				break
+			}
 			if errors.Is(err, types.ErrInsuranceFundHasInsufficientFunds) {
 				// Deleveraging is required. Stop matching.
 				telemetry.IncrCounter(1, types.ModuleName, metrics.LiquidationRequiresDeleveraging, metrics.Count)
@@ -2019,6 +2025,16 @@ func updateResultToOrderStatus(updateResult satypes.UpdateResult) types.OrderSta
 		return types.InternalError
 	case satypes.ViolatesIsolatedSubaccountConstraints:
 		return types.ViolatesIsolatedSubaccountConstraints
+	case satypes.ViolatesTradingHalt:
+		return types.ViolatesTradingHalt
+	case satypes.ViolatesMaxPositionNotional:
+		return types.ViolatesMaxPositionNotional
+	case satypes.ViolatesInitialMarginBuffer:
+		return types.ViolatesInitialMarginBuffer
+	case satypes.ViolatesMaxLeverage:
+		return types.ViolatesMaxLeverage
+	case satypes.ViolatesMaxPerpetualPositions:
+		return types.ViolatesMaxPerpetualPositions
 	default:
 		return types.Undercollateralized
 	}
@@ -2230,7 +2246,8 @@ func (m *MemClobPriceTimePriority) getImpactPriceSubticks(
 		if updateCheckResult == satypes.Success {
 			return true
 		} else if updateCheckResult == satypes.NewlyUndercollateralized ||
-			updateCheckResult == satypes.StillUndercollateralized {
+			updateCheckResult == satypes.StillUndercollateralized ||
+			updateCheckResult == satypes.NewlyBelowMaintenanceMargin {
 			return false
 		} else {
 			log.InfoLog(ctx, "AddOrderCheck returned unexpected result during collateralization check")
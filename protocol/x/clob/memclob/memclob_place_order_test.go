@@ -2320,6 +2320,38 @@ func TestPlaceOrder_MatchOrders_CollatCheckFailure(t *testing.T) {
 			expectedOperations:         []types.Operation{},
 			expectedInternalOperations: []types.InternalOperation{},
 		},
+		`Matching stops if taker order violates the trading halt, and the order status reports the trading halt`: {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num0_Id2_Clob1_Sell5_Price10_GTB15,
+			},
+			collateralizationCheckFailures: map[int]map[satypes.SubaccountId]satypes.UpdateResult{
+				0: {
+					constants.Bob_Num0: satypes.ViolatesTradingHalt,
+				},
+			},
+
+			order: constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22,
+
+			expectedFilledSize:    0,
+			expectedOrderStatus:   types.ViolatesTradingHalt,
+			expectedRemainingBids: []OrderWithRemainingSize{},
+			expectedRemainingAsks: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Alice_Num0_Id2_Clob1_Sell5_Price10_GTB15,
+					RemainingSize: 5,
+				},
+			},
+			expectedCollatCheck: []expectedMatch{
+				{
+					makerOrder:      &constants.Order_Alice_Num0_Id2_Clob1_Sell5_Price10_GTB15,
+					takerOrder:      &constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22,
+					matchedQuantums: 5,
+				},
+			},
+			expectedMatches:            []expectedMatch{},
+			expectedOperations:         []types.Operation{},
+			expectedInternalOperations: []types.InternalOperation{},
+		},
 		`Matching stops if taker order is partially filled then fails collateralization, and all filled maker orders are
 		removed from the book`: {
 			placedMatchableOrders: []types.MatchableOrder{
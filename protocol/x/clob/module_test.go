@@ -150,7 +150,7 @@ func TestAppModuleBasic_RegisterInterfaces(t *testing.T) {
 	// due to it using an unexported method on the interface thus we use reflection to access the field
 	// directly that contains the registrations.
 	fv := reflect.ValueOf(registry).Elem().FieldByName("implInterfaces")
-	require.Len(t, fv.MapKeys(), 18)
+	require.Len(t, fv.MapKeys(), 20)
 }
 
 func TestAppModuleBasic_DefaultGenesis(t *testing.T) {
@@ -170,7 +170,8 @@ func TestAppModuleBasic_DefaultGenesis(t *testing.T) {
 	expected += `"spread_to_maintenance_margin_ratio_ppm":100000}},"block_rate_limit_config":`
 	expected += `{"max_short_term_orders_and_cancels_per_n_blocks":[],"max_stateful_orders_per_n_blocks":[],`
 	expected += `"max_short_term_order_cancellations_per_n_blocks":[],"max_short_term_orders_per_n_blocks":[]},`
-	expected += `"equity_tier_limit_config":{"short_term_order_equity_tiers":[], "stateful_order_equity_tiers":[]}}`
+	expected += `"equity_tier_limit_config":{"short_term_order_equity_tiers":[], "stateful_order_equity_tiers":[]},`
+	expected += `"insurance_fund_outflow_cap":"0"}`
 
 	require.JSONEq(t, expected, string(json))
 }
@@ -442,7 +443,8 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 	expected += `{"limit":1000,"usd_tnc_required":"100000"}],"stateful_order_equity_tiers":[`
 	expected += `{"limit":0,"usd_tnc_required":"0"},{"limit":1,"usd_tnc_required":"20"},`
 	expected += `{"limit":5,"usd_tnc_required":"100"},{"limit":10,"usd_tnc_required":"1000"},`
-	expected += `{"limit":100,"usd_tnc_required":"10000"},{"limit":200,"usd_tnc_required":"100000"}]}}`
+	expected += `{"limit":100,"usd_tnc_required":"10000"},{"limit":200,"usd_tnc_required":"100000"}]},`
+	expected += `"insurance_fund_outflow_cap":"0"}`
 	require.JSONEq(t, expected, string(genesisJson))
 }
 
@@ -138,6 +138,8 @@ type ClobKeeper interface {
 		clobPair ClobPair,
 	) error
 	UpdateLiquidationsConfig(ctx sdk.Context, config LiquidationsConfig) error
+	GetInsuranceFundOutflowCap(ctx sdk.Context) uint64
+	SetInsuranceFundOutflowCap(ctx sdk.Context, outflowCap uint64)
 	// full node streaming
 	InitializeNewStreams(
 		ctx sdk.Context,
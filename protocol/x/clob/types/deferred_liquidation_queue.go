@@ -0,0 +1,38 @@
+package types
+
+import (
+	"sync"
+
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// DeferredLiquidationQueue maintains the subaccounts whose liquidations were deferred because the
+// insurance fund reached its outflow cap, in the order they were deferred. They are liquidated first
+// in the next `PrepareCheckState`. Methods are goroutine safe.
+type DeferredLiquidationQueue struct {
+	sync.Mutex                           // lock
+	subaccountIds []satypes.SubaccountId // subaccounts to liquidate
+}
+
+// NewDeferredLiquidationQueue creates a new empty `DeferredLiquidationQueue`.
+func NewDeferredLiquidationQueue() *DeferredLiquidationQueue {
+	return &DeferredLiquidationQueue{
+		subaccountIds: make([]satypes.SubaccountId, 0),
+	}
+}
+
+// Append adds `subaccountIds` to the end of the queue.
+func (q *DeferredLiquidationQueue) Append(subaccountIds ...satypes.SubaccountId) {
+	q.Lock()
+	defer q.Unlock()
+	q.subaccountIds = append(q.subaccountIds, subaccountIds...)
+}
+
+// Drain returns the subaccounts in the queue and empties it.
+func (q *DeferredLiquidationQueue) Drain() []satypes.SubaccountId {
+	q.Lock()
+	defer q.Unlock()
+	subaccountIds := q.subaccountIds
+	q.subaccountIds = make([]satypes.SubaccountId, 0)
+	return subaccountIds
+}
@@ -338,6 +338,11 @@ var (
 		1022,
 		"Liquidation conflicts with ClobPair status",
 	)
+	ErrLiquidationExceedsInsuranceFundOutflowCap = errorsmod.Register(
+		ModuleName,
+		1023,
+		"Liquidation exceeds the maximum insurance fund payout amount across all subaccounts per block",
+	)
 
 	// Advanced order type errors.
 	ErrFokOrderCouldNotBeFullyFilled = errorsmod.Register(
@@ -98,6 +98,24 @@ type SubaccountsKeeper interface {
 		revSharesForFill revsharetypes.RevSharesForFill,
 		fillForProcess FillForProcess,
 	) error
+	IsLiquidationGracePeriodElapsed(
+		ctx sdk.Context,
+		subaccountId satypes.SubaccountId,
+	) bool
+	GetRiskForSubaccount(
+		ctx sdk.Context,
+		subaccountId satypes.SubaccountId,
+		includeUnsettledFunding bool,
+	) (margin.Risk, error)
+	TrackUndercollateralizedSubaccounts(
+		ctx sdk.Context,
+	) error
+	SweepDustSubaccounts(
+		ctx sdk.Context,
+	) []satypes.SubaccountId
+	SettleFundingAfterFundingTick(
+		ctx sdk.Context,
+	) error
 }
 
 type AssetsKeeper interface {
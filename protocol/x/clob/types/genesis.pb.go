@@ -29,6 +29,9 @@ type GenesisState struct {
 	LiquidationsConfig    LiquidationsConfig           `protobuf:"bytes,2,opt,name=liquidations_config,json=liquidationsConfig,proto3" json:"liquidations_config"`
 	BlockRateLimitConfig  BlockRateLimitConfiguration  `protobuf:"bytes,3,opt,name=block_rate_limit_config,json=blockRateLimitConfig,proto3" json:"block_rate_limit_config"`
 	EquityTierLimitConfig EquityTierLimitConfiguration `protobuf:"bytes,4,opt,name=equity_tier_limit_config,json=equityTierLimitConfig,proto3" json:"equity_tier_limit_config"`
+	// The maximum amount of quote quantums the insurance fund can pay out for
+	// liquidations per block. Zero means the outflow is not capped.
+	InsuranceFundOutflowCap uint64 `protobuf:"varint,5,opt,name=insurance_fund_outflow_cap,json=insuranceFundOutflowCap,proto3" json:"insurance_fund_outflow_cap,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -92,6 +95,13 @@ func (m *GenesisState) GetEquityTierLimitConfig() EquityTierLimitConfiguration {
 	return EquityTierLimitConfiguration{}
 }
 
+func (m *GenesisState) GetInsuranceFundOutflowCap() uint64 {
+	if m != nil {
+		return m.InsuranceFundOutflowCap
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dydxprotocol.clob.GenesisState")
 }
@@ -99,30 +109,32 @@ func init() {
 func init() { proto.RegisterFile("dydxprotocol/clob/genesis.proto", fileDescriptor_2de77065a6fbee92) }
 
 var fileDescriptor_2de77065a6fbee92 = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x4b, 0xf3, 0x30,
-	0x1c, 0xc6, 0xdb, 0x77, 0xe3, 0x05, 0x33, 0x2f, 0xd6, 0x89, 0x65, 0x42, 0x37, 0x05, 0x61, 0x20,
-	0xb6, 0x32, 0xc5, 0xb3, 0x6c, 0x88, 0x97, 0x1d, 0xc6, 0xf4, 0x24, 0x42, 0x49, 0xb3, 0xd8, 0xfd,
-	0x59, 0xd6, 0x6c, 0x69, 0x2a, 0xdb, 0x67, 0xf0, 0xe2, 0xc7, 0xda, 0x71, 0x47, 0x4f, 0x22, 0xdb,
-	0x17, 0x91, 0xa6, 0x65, 0x6c, 0x34, 0xbb, 0x94, 0xf6, 0xe9, 0xef, 0x79, 0x9e, 0xe4, 0x9f, 0xa0,
-	0xfa, 0x60, 0x3e, 0x98, 0x4d, 0x04, 0x97, 0x9c, 0x70, 0xe6, 0x11, 0xc6, 0x03, 0x2f, 0xa4, 0x11,
-	0x8d, 0x21, 0x76, 0x95, 0x6a, 0x1d, 0x6d, 0x03, 0x6e, 0x0a, 0xd4, 0xaa, 0x21, 0x0f, 0xb9, 0x92,
-	0xbc, 0xf4, 0x2d, 0x03, 0x6b, 0x5e, 0x31, 0x29, 0x60, 0x9c, 0x8c, 0x7c, 0x81, 0x25, 0xf5, 0x19,
-	0x8c, 0x41, 0xfa, 0x84, 0x47, 0xef, 0x10, 0xe6, 0x86, 0xf3, 0xa2, 0x21, 0x7d, 0xf8, 0x13, 0x0c,
-	0x22, 0x47, 0x6e, 0x8a, 0x08, 0x9d, 0x26, 0x20, 0xe7, 0xbe, 0x04, 0x2a, 0x74, 0xa1, 0x57, 0x45,
-	0x07, 0x83, 0x69, 0x02, 0x03, 0x2c, 0x81, 0x47, 0xf1, 0x0e, 0x7c, 0xf1, 0x59, 0x42, 0x87, 0x4f,
-	0xd9, 0x6e, 0x9f, 0x25, 0x96, 0xd4, 0x7a, 0x40, 0x68, 0xb3, 0x84, 0xd8, 0x36, 0x1b, 0xa5, 0x66,
-	0xa5, 0x75, 0xe6, 0x16, 0x26, 0xe0, 0x76, 0x18, 0x0f, 0x7a, 0x18, 0x44, 0xbb, 0xbc, 0xf8, 0xa9,
-	0x1b, 0xfd, 0x03, 0x92, 0x7f, 0xc7, 0xd6, 0x1b, 0x3a, 0xd6, 0xf4, 0xd9, 0xff, 0x1a, 0x66, 0xb3,
-	0xd2, 0xba, 0xd4, 0x44, 0x75, 0xb7, 0xe8, 0x8e, 0x82, 0xf3, 0x50, 0x8b, 0x15, 0xfe, 0x58, 0x23,
-	0x74, 0xba, 0x67, 0xa6, 0x76, 0x49, 0x35, 0xb8, 0x9a, 0x86, 0x76, 0xea, 0xe8, 0x63, 0x49, 0xbb,
-	0x29, 0x9f, 0x25, 0x25, 0x42, 0xe5, 0xe6, 0x55, 0xd5, 0x40, 0x83, 0x58, 0x11, 0xb2, 0xf7, 0x0d,
-	0xdb, 0x2e, 0xab, 0x36, 0x4f, 0xd3, 0xf6, 0xa8, 0x2c, 0x2f, 0x40, 0xc5, 0xde, 0xba, 0x13, 0xaa,
-	0x63, 0xda, 0xbd, 0xc5, 0xca, 0x31, 0x97, 0x2b, 0xc7, 0xfc, 0x5d, 0x39, 0xe6, 0xd7, 0xda, 0x31,
-	0x96, 0x6b, 0xc7, 0xf8, 0x5e, 0x3b, 0xc6, 0xeb, 0x7d, 0x08, 0x72, 0x98, 0x04, 0x2e, 0xe1, 0xe3,
-	0xdd, 0x5b, 0xf6, 0x71, 0x77, 0x4d, 0x86, 0x18, 0x22, 0x6f, 0xa3, 0xcc, 0xb2, 0x33, 0x97, 0xf3,
-	0x09, 0x8d, 0x83, 0xff, 0x4a, 0xbe, 0xfd, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x44, 0xfb, 0x91, 0xc2,
-	0xe5, 0x02, 0x00, 0x00,
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x8b, 0xda, 0x40,
+	0x1c, 0xc5, 0x93, 0x6a, 0x0b, 0x1d, 0x7b, 0x69, 0x6a, 0x31, 0x58, 0x88, 0xb6, 0x50, 0x10, 0x4a,
+	0x93, 0x62, 0x4b, 0x2f, 0xbd, 0x14, 0xa5, 0xdd, 0x8b, 0xb0, 0xe2, 0xee, 0x69, 0x59, 0x18, 0x26,
+	0x93, 0x31, 0x0e, 0x8e, 0x33, 0x71, 0x32, 0xb3, 0xab, 0xdf, 0x62, 0x3f, 0x96, 0x47, 0x8f, 0x7b,
+	0x5a, 0x16, 0xfd, 0x22, 0x4b, 0x26, 0x41, 0x94, 0xc4, 0x4b, 0x48, 0xde, 0xfc, 0xde, 0x7b, 0x99,
+	0x3f, 0x7f, 0xd0, 0x89, 0xd6, 0xd1, 0x2a, 0x91, 0x42, 0x09, 0x2c, 0x58, 0x80, 0x99, 0x08, 0x83,
+	0x98, 0x70, 0x92, 0xd2, 0xd4, 0x37, 0xaa, 0xf3, 0xfe, 0x18, 0xf0, 0x33, 0xa0, 0xdd, 0x8c, 0x45,
+	0x2c, 0x8c, 0x14, 0x64, 0x6f, 0x39, 0xd8, 0x0e, 0xca, 0x49, 0x21, 0x13, 0x78, 0x0e, 0x25, 0x52,
+	0x04, 0x32, 0xba, 0xa0, 0x0a, 0x62, 0xc1, 0xa7, 0x34, 0x2e, 0x0c, 0x9f, 0xcb, 0x86, 0xec, 0x01,
+	0x13, 0x44, 0x65, 0x81, 0xfc, 0x28, 0x23, 0x64, 0xa9, 0xa9, 0x5a, 0x43, 0x45, 0x89, 0xac, 0x0a,
+	0xfd, 0x56, 0x76, 0x30, 0xba, 0xd4, 0x34, 0x42, 0x8a, 0x0a, 0x9e, 0x9e, 0xc0, 0x5f, 0x36, 0x35,
+	0xf0, 0xee, 0x22, 0xbf, 0xed, 0x95, 0x42, 0x8a, 0x38, 0x7f, 0x01, 0x38, 0xfc, 0x42, 0xea, 0xda,
+	0xdd, 0x5a, 0xaf, 0xd1, 0xff, 0xe4, 0x97, 0x26, 0xe0, 0x0f, 0x99, 0x08, 0xc7, 0x88, 0xca, 0x41,
+	0x7d, 0xf3, 0xd4, 0xb1, 0x26, 0x6f, 0x71, 0xf1, 0x9d, 0x3a, 0xb7, 0xe0, 0x43, 0x45, 0x9f, 0xfb,
+	0xaa, 0x6b, 0xf7, 0x1a, 0xfd, 0xaf, 0x15, 0x51, 0xa3, 0x23, 0x7a, 0x68, 0xe0, 0x22, 0xd4, 0x61,
+	0xa5, 0x13, 0x67, 0x0e, 0x5a, 0x67, 0x66, 0xea, 0xd6, 0x4c, 0x83, 0x5f, 0xd1, 0x30, 0xc8, 0x1c,
+	0x13, 0xa4, 0xc8, 0x28, 0xe3, 0xf3, 0x24, 0x2d, 0x4d, 0x6e, 0x51, 0xd5, 0x0c, 0x2b, 0x10, 0x87,
+	0x03, 0xf7, 0xdc, 0xb0, 0xdd, 0xba, 0x69, 0x0b, 0x2a, 0xda, 0xfe, 0x19, 0xcb, 0x35, 0x25, 0xf2,
+	0x6c, 0xdd, 0x47, 0x52, 0xc5, 0x38, 0x7f, 0x40, 0x9b, 0xf2, 0x54, 0x4b, 0xc4, 0x31, 0x81, 0x53,
+	0xcd, 0x23, 0x28, 0xb4, 0x9a, 0x32, 0x71, 0x0f, 0x31, 0x4a, 0xdc, 0xd7, 0x5d, 0xbb, 0x57, 0x9f,
+	0xb4, 0x0e, 0xc4, 0x7f, 0xcd, 0xa3, 0xcb, 0xfc, 0x7c, 0x88, 0x92, 0xc1, 0x78, 0xb3, 0xf3, 0xec,
+	0xed, 0xce, 0xb3, 0x9f, 0x77, 0x9e, 0xfd, 0xb0, 0xf7, 0xac, 0xed, 0xde, 0xb3, 0x1e, 0xf7, 0x9e,
+	0x75, 0xf3, 0x3b, 0xa6, 0x6a, 0xa6, 0x43, 0x1f, 0x8b, 0xc5, 0xe9, 0x8a, 0xde, 0xfd, 0xfa, 0x8e,
+	0x67, 0x88, 0xf2, 0xe0, 0xa0, 0xac, 0xf2, 0x85, 0x51, 0xeb, 0x84, 0xa4, 0xe1, 0x1b, 0x23, 0xff,
+	0x7c, 0x19, 0x00, 0xd9, 0x6d, 0xe0, 0x13, 0x22, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -145,6 +157,11 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InsuranceFundOutflowCap != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.InsuranceFundOutflowCap))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size, err := m.EquityTierLimitConfig.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -221,6 +238,9 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.EquityTierLimitConfig.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.InsuranceFundOutflowCap != 0 {
+		n += 1 + sovGenesis(uint64(m.InsuranceFundOutflowCap))
+	}
 	return n
 }
 
@@ -392,6 +412,25 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundOutflowCap", wireType)
+			}
+			m.InsuranceFundOutflowCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InsuranceFundOutflowCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
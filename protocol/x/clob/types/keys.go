@@ -42,6 +42,10 @@ const (
 	// BlockRateLimitConfigKey is the key to retrieve the block rate limit configuration.
 	BlockRateLimitConfigKey = "RateLimCfg"
 
+	// InsuranceFundOutflowCapKey is the key to retrieve the maximum amount of quote quantums the insurance
+	// fund can pay out for liquidations per block.
+	InsuranceFundOutflowCapKey = "InsFundOutflowCap"
+
 	// ClobPairKeyPrefix is the prefix to retrieve all ClobPair
 	ClobPairKeyPrefix = "Clob:"
 
@@ -111,6 +115,10 @@ const (
 	// for a subaccount within the last block.
 	SubaccountLiquidationInfoKeyPrefix = "SaLiqInfo:"
 
+	// InsuranceFundOutflowKey is the key to retrieve the quote quantums the insurance fund paid out for
+	// liquidations within the last block.
+	InsuranceFundOutflowKey = "InsFundOutflow"
+
 	// NextStatefulOrderBlockTransactionIndexKey is the transient store key that stores the next
 	// transaction index to use for the next newly-placed stateful order.
 	NextStatefulOrderBlockTransactionIndexKey = "NextTxIdx"
@@ -0,0 +1,24 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgUpdateInsuranceFundOutflowCap{}
+
+// ValidateBasic returns an error if the authority is not a valid bech32 address.
+func (msg *MsgUpdateInsuranceFundOutflowCap) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(
+			ErrInvalidAuthority,
+			fmt.Sprintf(
+				"authority '%s' must be a valid bech32 address, but got error '%v'",
+				msg.Authority,
+				err.Error(),
+			),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateInsuranceFundOutflowCap_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg           types.MsgUpdateInsuranceFundOutflowCap
+		expectedError string
+	}{
+		"valid": {
+			msg: types.MsgUpdateInsuranceFundOutflowCap{
+				Authority:               constants.AliceAccAddress.String(),
+				InsuranceFundOutflowCap: 1_000_000_000,
+			},
+		},
+		"invalid authority": {
+			msg: types.MsgUpdateInsuranceFundOutflowCap{
+				InsuranceFundOutflowCap: 1_000_000_000,
+			},
+			expectedError: "Authority is invalid",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -149,6 +149,25 @@ const (
 	// PostOnlyWouldCrossMakerOrder indicates that matching the post only taker order would cross the
 	// orderbook, and was therefore canceled.
 	PostOnlyWouldCrossMakerOrder
+	// LiquidationExceededInsuranceFundOutflowCap indicates that the liquidation order could not be
+	// matched because it exceeded the maximum funds lost for the insurance fund across all subaccounts
+	// in this block. The liquidation is deferred to the next block.
+	LiquidationExceededInsuranceFundOutflowCap
+	// ViolatesTradingHalt indicates that matching the order would increase the risk of the subaccount
+	// while trading is halted.
+	ViolatesTradingHalt
+	// ViolatesMaxPositionNotional indicates that matching the order would increase the position of the
+	// subaccount beyond the maximum position notional of the liquidity tier of the perpetual.
+	ViolatesMaxPositionNotional
+	// ViolatesInitialMarginBuffer indicates that matching the order would leave the net collateral of the
+	// subaccount below its initial margin requirement plus the initial margin buffer.
+	ViolatesInitialMarginBuffer
+	// ViolatesMaxLeverage indicates that matching the order would leave the leverage of the subaccount
+	// above its self-imposed leverage cap.
+	ViolatesMaxLeverage
+	// ViolatesMaxPerpetualPositions indicates that matching the order would open more perpetual positions
+	// in the subaccount than the maximum number of perpetual positions.
+	ViolatesMaxPerpetualPositions
 )
 
 // String returns a string representation of this `OrderStatus` enum.
@@ -172,6 +191,18 @@ func (os OrderStatus) String() string {
 		return "LiquidationExceededSubaccountMaxInsuranceLost"
 	case ViolatesIsolatedSubaccountConstraints:
 		return "ViolatesIsolatedSubaccountConstraints"
+	case LiquidationExceededInsuranceFundOutflowCap:
+		return "LiquidationExceededInsuranceFundOutflowCap"
+	case ViolatesTradingHalt:
+		return "ViolatesTradingHalt"
+	case ViolatesMaxPositionNotional:
+		return "ViolatesMaxPositionNotional"
+	case ViolatesInitialMarginBuffer:
+		return "ViolatesInitialMarginBuffer"
+	case ViolatesMaxLeverage:
+		return "ViolatesMaxLeverage"
+	case ViolatesMaxPerpetualPositions:
+		return "ViolatesMaxPerpetualPositions"
 	default:
 		return "Unknown"
 	}
@@ -182,6 +213,23 @@ func (os OrderStatus) IsSuccess() bool {
 	return os == Success
 }
 
+// IsCollateralCheckFailure returns `true` if this `OrderStatus` enum indicates the order failed the
+// collateralization checks of the subaccount updates while matching or when placed on the orderbook,
+// else returns `false`.
+func (os OrderStatus) IsCollateralCheckFailure() bool {
+	switch os {
+	case Undercollateralized,
+		ViolatesTradingHalt,
+		ViolatesMaxPositionNotional,
+		ViolatesInitialMarginBuffer,
+		ViolatesMaxLeverage,
+		ViolatesMaxPerpetualPositions:
+		return true
+	default:
+		return false
+	}
+}
+
 // FillType represents the type of the fill.
 type FillType uint
 
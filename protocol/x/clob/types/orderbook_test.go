@@ -83,6 +83,31 @@ func TestString(t *testing.T) {
 
 			expectedString: "ViolatesIsolatedSubaccountConstraints",
 		},
+		"Order status is ViolatesTradingHalt": {
+			orderStatus: types.ViolatesTradingHalt,
+
+			expectedString: "ViolatesTradingHalt",
+		},
+		"Order status is ViolatesMaxPositionNotional": {
+			orderStatus: types.ViolatesMaxPositionNotional,
+
+			expectedString: "ViolatesMaxPositionNotional",
+		},
+		"Order status is ViolatesInitialMarginBuffer": {
+			orderStatus: types.ViolatesInitialMarginBuffer,
+
+			expectedString: "ViolatesInitialMarginBuffer",
+		},
+		"Order status is ViolatesMaxLeverage": {
+			orderStatus: types.ViolatesMaxLeverage,
+
+			expectedString: "ViolatesMaxLeverage",
+		},
+		"Order status is ViolatesMaxPerpetualPositions": {
+			orderStatus: types.ViolatesMaxPerpetualPositions,
+
+			expectedString: "ViolatesMaxPerpetualPositions",
+		},
 		"Order status is unknown enum value": {
 			orderStatus: 999,
 
@@ -135,3 +160,50 @@ func TestIsSuccess(t *testing.T) {
 		})
 	}
 }
+
+func TestIsCollateralCheckFailure(t *testing.T) {
+	tests := map[string]struct {
+		// Parameters.
+		orderStatus types.OrderStatus
+
+		// Expectations.
+		expectedIsCollateralCheckFailure bool
+	}{
+		"Order status of Success is not a collateral check failure": {
+			orderStatus: types.Success,
+
+			expectedIsCollateralCheckFailure: false,
+		},
+		"Order status of Undercollateralized is a collateral check failure": {
+			orderStatus: types.Undercollateralized,
+
+			expectedIsCollateralCheckFailure: true,
+		},
+		"Order status of ViolatesTradingHalt is a collateral check failure": {
+			orderStatus: types.ViolatesTradingHalt,
+
+			expectedIsCollateralCheckFailure: true,
+		},
+		"Order status of ViolatesMaxPerpetualPositions is a collateral check failure": {
+			orderStatus: types.ViolatesMaxPerpetualPositions,
+
+			expectedIsCollateralCheckFailure: true,
+		},
+		"Order status of ViolatesIsolatedSubaccountConstraints is not a collateral check failure": {
+			orderStatus: types.ViolatesIsolatedSubaccountConstraints,
+
+			expectedIsCollateralCheckFailure: false,
+		},
+		"Order status of InternalError is not a collateral check failure": {
+			orderStatus: types.InternalError,
+
+			expectedIsCollateralCheckFailure: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expectedIsCollateralCheckFailure, tc.orderStatus.IsCollateralCheckFailure())
+		})
+	}
+}
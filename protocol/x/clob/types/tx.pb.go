@@ -1092,6 +1092,104 @@ func (m *MsgUpdateLiquidationsConfigResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateLiquidationsConfigResponse proto.InternalMessageInfo
 
+// MsgUpdateInsuranceFundOutflowCap is a request type for updating the maximum
+// amount of quote quantums the insurance fund can pay out for liquidations per
+// block.
+type MsgUpdateInsuranceFundOutflowCap struct {
+	// Authority is the address that may send this message.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The maximum amount of quote quantums the insurance fund can pay out for
+	// liquidations per block. Zero removes the cap.
+	InsuranceFundOutflowCap uint64 `protobuf:"varint,2,opt,name=insurance_fund_outflow_cap,json=insuranceFundOutflowCap,proto3" json:"insurance_fund_outflow_cap,omitempty"`
+}
+
+func (m *MsgUpdateInsuranceFundOutflowCap) Reset()         { *m = MsgUpdateInsuranceFundOutflowCap{} }
+func (m *MsgUpdateInsuranceFundOutflowCap) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateInsuranceFundOutflowCap) ProtoMessage()    {}
+func (*MsgUpdateInsuranceFundOutflowCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{20}
+}
+func (m *MsgUpdateInsuranceFundOutflowCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateInsuranceFundOutflowCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateInsuranceFundOutflowCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateInsuranceFundOutflowCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateInsuranceFundOutflowCap.Merge(m, src)
+}
+func (m *MsgUpdateInsuranceFundOutflowCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateInsuranceFundOutflowCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateInsuranceFundOutflowCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateInsuranceFundOutflowCap proto.InternalMessageInfo
+
+func (m *MsgUpdateInsuranceFundOutflowCap) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateInsuranceFundOutflowCap) GetInsuranceFundOutflowCap() uint64 {
+	if m != nil {
+		return m.InsuranceFundOutflowCap
+	}
+	return 0
+}
+
+// MsgUpdateInsuranceFundOutflowCapResponse is the
+// Msg/UpdateInsuranceFundOutflowCap response type.
+type MsgUpdateInsuranceFundOutflowCapResponse struct {
+}
+
+func (m *MsgUpdateInsuranceFundOutflowCapResponse) Reset() {
+	*m = MsgUpdateInsuranceFundOutflowCapResponse{}
+}
+func (m *MsgUpdateInsuranceFundOutflowCapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateInsuranceFundOutflowCapResponse) ProtoMessage()    {}
+func (*MsgUpdateInsuranceFundOutflowCapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{21}
+}
+func (m *MsgUpdateInsuranceFundOutflowCapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateInsuranceFundOutflowCapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateInsuranceFundOutflowCapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateInsuranceFundOutflowCapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateInsuranceFundOutflowCapResponse.Merge(m, src)
+}
+func (m *MsgUpdateInsuranceFundOutflowCapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateInsuranceFundOutflowCapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateInsuranceFundOutflowCapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateInsuranceFundOutflowCapResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateClobPair)(nil), "dydxprotocol.clob.MsgCreateClobPair")
 	proto.RegisterType((*MsgCreateClobPairResponse)(nil), "dydxprotocol.clob.MsgCreateClobPairResponse")
@@ -1113,84 +1211,91 @@ func init() {
 	proto.RegisterType((*MsgUpdateBlockRateLimitConfigurationResponse)(nil), "dydxprotocol.clob.MsgUpdateBlockRateLimitConfigurationResponse")
 	proto.RegisterType((*MsgUpdateLiquidationsConfig)(nil), "dydxprotocol.clob.MsgUpdateLiquidationsConfig")
 	proto.RegisterType((*MsgUpdateLiquidationsConfigResponse)(nil), "dydxprotocol.clob.MsgUpdateLiquidationsConfigResponse")
+	proto.RegisterType((*MsgUpdateInsuranceFundOutflowCap)(nil), "dydxprotocol.clob.MsgUpdateInsuranceFundOutflowCap")
+	proto.RegisterType((*MsgUpdateInsuranceFundOutflowCapResponse)(nil), "dydxprotocol.clob.MsgUpdateInsuranceFundOutflowCapResponse")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/clob/tx.proto", fileDescriptor_19b9e2c0de4ab64a) }
 
 var fileDescriptor_19b9e2c0de4ab64a = []byte{
-	// 1149 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4d, 0x4f, 0x24, 0x45,
-	0x18, 0x9e, 0x5e, 0xd4, 0x85, 0x17, 0x86, 0x85, 0x5a, 0x56, 0x66, 0x1b, 0x19, 0x86, 0x11, 0xc8,
-	0xac, 0x2e, 0x33, 0x2b, 0x6e, 0xd0, 0x68, 0xfc, 0x1a, 0xb2, 0x1b, 0x48, 0x96, 0x00, 0x0d, 0x26,
-	0x46, 0x4d, 0x3a, 0x3d, 0xdd, 0xc5, 0x50, 0xd9, 0xee, 0xa9, 0xa1, 0xab, 0x07, 0xe1, 0xba, 0xbf,
-	0xc0, 0xbb, 0x31, 0xfa, 0x13, 0x3c, 0xec, 0xc1, 0xbb, 0x97, 0x3d, 0x6e, 0x3c, 0x6d, 0xa2, 0x51,
-	0x03, 0x07, 0xff, 0x86, 0xe9, 0xaa, 0xee, 0x9a, 0xea, 0xed, 0xee, 0x61, 0x24, 0x1e, 0xbc, 0x40,
-	0x57, 0xd5, 0xf3, 0x7e, 0x3c, 0xcf, 0xfb, 0xd6, 0xc7, 0x80, 0xee, 0x9c, 0x39, 0xa7, 0x5d, 0x9f,
-	0x06, 0xd4, 0xa6, 0x6e, 0xc3, 0x76, 0x69, 0xab, 0x11, 0x9c, 0xd6, 0xf9, 0x04, 0x9a, 0x56, 0xd7,
-	0xea, 0xe1, 0x9a, 0x7e, 0xdb, 0xa6, 0xcc, 0xa3, 0xcc, 0xe4, 0xb3, 0x0d, 0x31, 0x10, 0x68, 0x7d,
-	0x56, 0x8c, 0x1a, 0x1e, 0x6b, 0x37, 0x4e, 0xde, 0x09, 0xff, 0x45, 0x0b, 0x33, 0x6d, 0xda, 0xa6,
-	0xc2, 0x20, 0xfc, 0x8a, 0x66, 0x1b, 0xe9, 0xc0, 0x2d, 0x97, 0xda, 0x8f, 0x4d, 0xdf, 0x0a, 0xb0,
-	0xe9, 0x12, 0x8f, 0x04, 0xa6, 0x4d, 0x3b, 0x87, 0x24, 0x76, 0xb3, 0x98, 0x36, 0x08, 0xff, 0x98,
-	0x5d, 0x8b, 0xf8, 0x11, 0xe4, 0x5e, 0x1a, 0x82, 0x8f, 0x7b, 0x24, 0x38, 0x33, 0x03, 0x82, 0xfd,
-	0x2c, 0xa7, 0x0b, 0x69, 0x0b, 0xcf, 0x0a, 0xec, 0x23, 0x1c, 0xb3, 0x9a, 0x4f, 0x03, 0xa8, 0xef,
-	0xe0, 0x38, 0xe2, 0x4a, 0xce, 0xb2, 0xe9, 0x63, 0x8f, 0x9e, 0x58, 0x6e, 0xec, 0xe6, 0xed, 0x34,
-	0xce, 0x25, 0xc7, 0x3d, 0xe2, 0x58, 0x01, 0xa1, 0x1d, 0x96, 0x4c, 0xea, 0x4e, 0x02, 0xcc, 0x7a,
-	0x2d, 0xcb, 0xb6, 0x69, 0xaf, 0x13, 0x30, 0xe5, 0x5b, 0x40, 0xab, 0xdf, 0x69, 0x30, 0xbd, 0xcd,
-	0xda, 0x1b, 0x3e, 0xb6, 0x02, 0xbc, 0xe1, 0xd2, 0xd6, 0xae, 0x45, 0x7c, 0xb4, 0x0e, 0x63, 0x56,
-	0x2f, 0x38, 0xa2, 0x3e, 0x09, 0xce, 0x4a, 0x5a, 0x45, 0xab, 0x8d, 0x35, 0x4b, 0xbf, 0x3e, 0x5d,
-	0x9d, 0x89, 0xea, 0xf5, 0x99, 0xe3, 0xf8, 0x98, 0xb1, 0xfd, 0xc0, 0x27, 0x9d, 0xb6, 0xd1, 0x87,
-	0xa2, 0x8f, 0x61, 0x4c, 0x4a, 0x5a, 0xba, 0x56, 0xd1, 0x6a, 0xe3, 0x6b, 0x73, 0xf5, 0x54, 0x13,
-	0xd4, 0xe3, 0x38, 0xcd, 0x57, 0x9e, 0xfd, 0xb1, 0x50, 0x30, 0x46, 0xed, 0x68, 0xfc, 0xc1, 0xe4,
-	0x93, 0xbf, 0x7f, 0x7a, 0xab, 0xef, 0xaf, 0x3a, 0x07, 0xb7, 0x53, 0xc9, 0x19, 0x98, 0x75, 0x69,
-	0x87, 0xe1, 0x2a, 0x81, 0x5b, 0xdb, 0xac, 0xbd, 0xeb, 0xd3, 0x2e, 0x65, 0xd8, 0xd9, 0xe9, 0x62,
-	0x5f, 0x68, 0x81, 0x76, 0x61, 0x8a, 0xca, 0x91, 0x79, 0xdc, 0xc3, 0x3d, 0x5c, 0xd2, 0x2a, 0x23,
-	0xb5, 0xf1, 0xb5, 0x85, 0x8c, 0x64, 0xa4, 0xa1, 0x61, 0x7d, 0x13, 0x25, 0x74, 0xa3, 0x6f, 0xbe,
-	0x17, 0x5a, 0x57, 0x17, 0x60, 0x3e, 0x33, 0x94, 0xcc, 0xe5, 0x01, 0x14, 0x43, 0x80, 0x6b, 0xd9,
-	0x78, 0x27, 0x2c, 0x1f, 0xba, 0x0f, 0xaf, 0xf2, 0x3a, 0x72, 0xf5, 0xc6, 0xd7, 0x4a, 0x59, 0x81,
-	0xc3, 0xf5, 0x28, 0xa2, 0x00, 0x57, 0x67, 0x05, 0x25, 0xe9, 0x46, 0xfa, 0xff, 0x59, 0x83, 0xc9,
-	0x50, 0x09, 0xab, 0x63, 0x63, 0x57, 0x44, 0xf8, 0x10, 0x46, 0x45, 0xa7, 0x10, 0x27, 0x0a, 0xa2,
-	0xe7, 0x05, 0xd9, 0x72, 0xa2, 0x30, 0xd7, 0xa9, 0x18, 0xa2, 0x15, 0x98, 0x6c, 0x53, 0xea, 0x98,
-	0x01, 0x71, 0x4d, 0xbe, 0x6b, 0x78, 0xb5, 0x8a, 0x9b, 0x05, 0x63, 0x22, 0x9c, 0x3f, 0x20, 0x6e,
-	0x33, 0x9c, 0x45, 0x0d, 0xb8, 0x99, 0xc4, 0x99, 0x01, 0xf1, 0x70, 0x69, 0xa4, 0xa2, 0xd5, 0xae,
-	0x6f, 0x16, 0x8c, 0x29, 0x15, 0x7c, 0x40, 0x3c, 0xdc, 0x9c, 0x52, 0x1c, 0xd3, 0x0e, 0xa6, 0x87,
-	0xd5, 0x12, 0xbc, 0x9e, 0xcc, 0x5c, 0x92, 0xfa, 0x5d, 0x90, 0x6a, 0x86, 0xfb, 0x45, 0xac, 0xa3,
-	0x3d, 0x28, 0xf6, 0x5b, 0xb4, 0xcf, 0x6c, 0x25, 0xc9, 0x4c, 0xe9, 0xe8, 0xfa, 0xbe, 0xfc, 0x96,
-	0x2c, 0x27, 0x98, 0x32, 0x87, 0xf6, 0x00, 0xb1, 0x23, 0xea, 0x07, 0x66, 0x80, 0x7d, 0xcf, 0xb4,
-	0x79, 0x1c, 0x56, 0xba, 0xc6, 0xfb, 0x61, 0x3e, 0xb7, 0x2c, 0x61, 0x4e, 0x91, 0xbb, 0x29, 0x6e,
-	0x7e, 0x80, 0x7d, 0x4f, 0x24, 0xc9, 0xd0, 0x52, 0x4a, 0xbd, 0x50, 0x90, 0x62, 0x52, 0xbb, 0xea,
-	0x36, 0x40, 0xdf, 0x17, 0xaa, 0xc0, 0x84, 0xdc, 0x1a, 0x31, 0xb1, 0xa2, 0x01, 0x71, 0xeb, 0x6f,
-	0x39, 0x68, 0x1e, 0xc0, 0x76, 0x09, 0xe6, 0xbc, 0x45, 0x82, 0x45, 0x63, 0x4c, 0xcc, 0x6c, 0x39,
-	0xac, 0xfa, 0x54, 0xe3, 0x42, 0x2a, 0x6a, 0xc5, 0x42, 0xa2, 0x1d, 0x98, 0x51, 0x28, 0xb2, 0x9e,
-	0x6d, 0x63, 0xec, 0x60, 0x27, 0x6a, 0xfa, 0xc1, 0x24, 0x0d, 0x24, 0xe9, 0xed, 0xc7, 0x86, 0x68,
-	0x0b, 0xa6, 0x15, 0x87, 0x87, 0x16, 0x71, 0xb1, 0x33, 0x94, 0x64, 0xc6, 0x0d, 0xe9, 0xed, 0x21,
-	0xb7, 0x8a, 0x0f, 0x98, 0xcf, 0xbb, 0xce, 0xff, 0xf7, 0x80, 0x49, 0x26, 0x27, 0xfb, 0xf3, 0x85,
-	0x06, 0x13, 0xea, 0xe9, 0x10, 0x6e, 0x6a, 0x7e, 0xb8, 0x47, 0x5d, 0xf9, 0x46, 0x4e, 0xe4, 0xed,
-	0x10, 0xb3, 0x59, 0x30, 0x04, 0x18, 0x7d, 0x04, 0xba, 0x22, 0xa6, 0xd8, 0xb3, 0xdd, 0x70, 0x8b,
-	0x7b, 0xb8, 0x13, 0x70, 0x12, 0x13, 0x9b, 0x05, 0x63, 0x56, 0x0a, 0xc7, 0xd5, 0xdc, 0x8d, 0x01,
-	0xe8, 0x21, 0x14, 0x13, 0x37, 0x02, 0xef, 0xb5, 0x9c, 0xa3, 0x4c, 0x6c, 0x2f, 0x0e, 0x0b, 0xb7,
-	0x32, 0x55, 0xc6, 0xcd, 0x71, 0x18, 0x93, 0xc7, 0x5a, 0xf5, 0x4f, 0x0d, 0x96, 0x25, 0xf1, 0x07,
-	0xfc, 0x8a, 0x3b, 0x20, 0xd8, 0x7f, 0x14, 0x5e, 0x70, 0x1b, 0xfc, 0x2a, 0xe9, 0x09, 0xe4, 0x95,
-	0x2b, 0xd5, 0x81, 0x52, 0xde, 0xd5, 0x19, 0x15, 0xae, 0x91, 0xc1, 0x60, 0x50, 0x2a, 0x51, 0x31,
-	0x6f, 0xe1, 0x2c, 0x4c, 0xaa, 0xb2, 0x0d, 0x58, 0x1d, 0x8a, 0xa0, 0xac, 0xf6, 0x6f, 0x1a, 0x2c,
-	0x49, 0x0b, 0xbe, 0x83, 0x0d, 0x2b, 0xc0, 0xff, 0xa1, 0x22, 0x8f, 0x61, 0x36, 0xe7, 0x81, 0x12,
-	0x95, 0xb4, 0x9e, 0x21, 0xc8, 0x80, 0x44, 0x22, 0x3d, 0x66, 0x5a, 0x19, 0x90, 0x94, 0x1c, 0x75,
-	0xb8, 0x3b, 0x0c, 0x39, 0xa9, 0xc6, 0x2f, 0x1a, 0xcc, 0x49, 0x83, 0x47, 0xca, 0x4b, 0x43, 0xc0,
-	0xaf, 0x2c, 0xc2, 0xd7, 0x70, 0x33, 0xe3, 0xdd, 0x12, 0x75, 0xc4, 0x72, 0x86, 0x00, 0xe9, 0xd8,
-	0x11, 0x6f, 0xe4, 0xa6, 0x56, 0x52, 0xac, 0x97, 0xe1, 0xcd, 0x01, 0x24, 0x62, 0xb2, 0x6b, 0x3f,
-	0x8c, 0xc2, 0xc8, 0x36, 0x6b, 0xa3, 0x2e, 0xa0, 0x8c, 0xe7, 0x44, 0x2d, 0x23, 0xab, 0xcc, 0xd7,
-	0x80, 0x7e, 0x6f, 0x58, 0xa4, 0x3c, 0xb9, 0xbf, 0x00, 0x50, 0x1e, 0x0d, 0x95, 0x1c, 0x7b, 0x89,
-	0xd0, 0x6b, 0x97, 0x21, 0xa4, 0xe7, 0xaf, 0x60, 0x5c, 0x7d, 0x2d, 0x2c, 0x66, 0x1b, 0x2a, 0x10,
-	0xfd, 0xce, 0xa5, 0x10, 0xd5, 0xb9, 0x7a, 0x6b, 0xe7, 0x38, 0x57, 0x20, 0x79, 0xce, 0xb3, 0x6e,
-	0x33, 0x07, 0x26, 0x5f, 0x7a, 0x8e, 0x2e, 0xe5, 0x64, 0x96, 0x40, 0xe9, 0x77, 0x87, 0x41, 0xa9,
-	0x51, 0x5e, 0xba, 0x93, 0x72, 0xa2, 0x24, 0x51, 0x79, 0x51, 0xb2, 0xaf, 0x10, 0xf4, 0xa3, 0x06,
-	0xd5, 0x21, 0x0e, 0xd9, 0xf7, 0x07, 0x39, 0x1d, 0x64, 0xa9, 0x7f, 0x7a, 0x55, 0x4b, 0x99, 0xe2,
-	0xf7, 0x1a, 0x2c, 0x5e, 0x7e, 0xe8, 0xbd, 0x37, 0x28, 0xce, 0x00, 0x43, 0xfd, 0x93, 0x2b, 0x1a,
-	0xca, 0xfc, 0x9e, 0x68, 0x50, 0xca, 0x3d, 0x86, 0xea, 0x83, 0xbc, 0xa7, 0xf1, 0xfa, 0xfa, 0xbf,
-	0xc3, 0xc7, 0x49, 0x34, 0x77, 0x9f, 0x9d, 0x97, 0xb5, 0xe7, 0xe7, 0x65, 0xed, 0xaf, 0xf3, 0xb2,
-	0xf6, 0xed, 0x45, 0xb9, 0xf0, 0xfc, 0xa2, 0x5c, 0x78, 0x71, 0x51, 0x2e, 0x7c, 0xb9, 0xde, 0x26,
-	0xc1, 0x51, 0xaf, 0x55, 0xb7, 0xa9, 0x97, 0xfc, 0x45, 0x7a, 0x72, 0x7f, 0xd5, 0x3e, 0xb2, 0x48,
-	0xa7, 0x21, 0x67, 0x4e, 0xa3, 0x9f, 0xc7, 0x67, 0x5d, 0xcc, 0x5a, 0xaf, 0xf1, 0xe9, 0x77, 0xff,
-	0x09, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x64, 0x28, 0x2e, 0x40, 0x0f, 0x00, 0x00,
+	// 1223 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x4f, 0xdc, 0x46,
+	0x14, 0x5e, 0x87, 0xb4, 0xc9, 0x3e, 0x58, 0x02, 0x0e, 0x94, 0x8d, 0x29, 0xcb, 0xb2, 0x05, 0xb4,
+	0xa4, 0xb0, 0x9b, 0x92, 0x88, 0x56, 0x45, 0xfd, 0xb5, 0x28, 0x08, 0xa4, 0x20, 0xc0, 0x50, 0xa9,
+	0x6a, 0x2b, 0x59, 0x5e, 0x7b, 0x58, 0x46, 0xb1, 0x3d, 0xc6, 0x63, 0x13, 0xb8, 0xe6, 0x2f, 0x68,
+	0xcf, 0x55, 0xa5, 0xde, 0x7a, 0xad, 0xd4, 0x1c, 0x7a, 0xef, 0x25, 0xc7, 0xa8, 0xa7, 0x48, 0xad,
+	0xda, 0x0a, 0x0e, 0xfd, 0x37, 0x2a, 0x8f, 0xed, 0x59, 0x3b, 0xb6, 0x97, 0xed, 0xaa, 0x87, 0x5c,
+	0xc0, 0x33, 0xf3, 0xbd, 0x1f, 0xdf, 0xf7, 0x66, 0xde, 0x0c, 0x80, 0xa4, 0x9f, 0xeb, 0x67, 0xb6,
+	0x43, 0x5c, 0xa2, 0x11, 0xa3, 0xa9, 0x19, 0xa4, 0xdd, 0x74, 0xcf, 0x1a, 0x6c, 0x42, 0x1c, 0x8f,
+	0xaf, 0x35, 0xfc, 0x35, 0xe9, 0x8e, 0x46, 0xa8, 0x49, 0xa8, 0xc2, 0x66, 0x9b, 0xc1, 0x20, 0x40,
+	0x4b, 0x53, 0xc1, 0xa8, 0x69, 0xd2, 0x4e, 0xf3, 0xf4, 0x3d, 0xff, 0x57, 0xb8, 0x30, 0xd1, 0x21,
+	0x1d, 0x12, 0x18, 0xf8, 0x5f, 0xe1, 0x6c, 0x33, 0x1d, 0xb8, 0x6d, 0x10, 0xed, 0xb1, 0xe2, 0xa8,
+	0x2e, 0x52, 0x0c, 0x6c, 0x62, 0x57, 0xd1, 0x88, 0x75, 0x84, 0x23, 0x37, 0x73, 0x69, 0x03, 0xff,
+	0x87, 0x62, 0xab, 0xd8, 0x09, 0x21, 0xf7, 0xd2, 0x10, 0x74, 0xe2, 0x61, 0xf7, 0x5c, 0x71, 0x31,
+	0x72, 0xb2, 0x9c, 0xce, 0xa6, 0x2d, 0x4c, 0xd5, 0xd5, 0x8e, 0x51, 0xc4, 0x6a, 0x26, 0x0d, 0x20,
+	0x8e, 0x8e, 0xa2, 0x88, 0x8b, 0x39, 0xcb, 0x8a, 0x83, 0x4c, 0x72, 0xaa, 0x1a, 0x91, 0x9b, 0x77,
+	0xd3, 0x38, 0x03, 0x9f, 0x78, 0x58, 0x57, 0x5d, 0x4c, 0x2c, 0x9a, 0x4c, 0x6a, 0x29, 0x01, 0xa6,
+	0x5e, 0x5b, 0xd5, 0x34, 0xe2, 0x59, 0x2e, 0x8d, 0x7d, 0x07, 0xd0, 0xda, 0x77, 0x02, 0x8c, 0xef,
+	0xd0, 0xce, 0x86, 0x83, 0x54, 0x17, 0x6d, 0x18, 0xa4, 0xbd, 0xa7, 0x62, 0x47, 0x5c, 0x83, 0xa2,
+	0xea, 0xb9, 0xc7, 0xc4, 0xc1, 0xee, 0x79, 0x59, 0xa8, 0x0a, 0xf5, 0x62, 0xab, 0xfc, 0xdb, 0xb3,
+	0x95, 0x89, 0xb0, 0x5e, 0x9f, 0xe9, 0xba, 0x83, 0x28, 0x3d, 0x70, 0x1d, 0x6c, 0x75, 0xe4, 0x2e,
+	0x54, 0xfc, 0x18, 0x8a, 0x5c, 0xd2, 0xf2, 0xb5, 0xaa, 0x50, 0x1f, 0x5e, 0x9d, 0x6e, 0xa4, 0x36,
+	0x41, 0x23, 0x8a, 0xd3, 0xba, 0xfe, 0xfc, 0xcf, 0xd9, 0x82, 0x7c, 0x53, 0x0b, 0xc7, 0x1f, 0x8e,
+	0x3e, 0xfd, 0xe7, 0xa7, 0xbb, 0x5d, 0x7f, 0xb5, 0x69, 0xb8, 0x93, 0x4a, 0x4e, 0x46, 0xd4, 0x26,
+	0x16, 0x45, 0x35, 0x0c, 0x93, 0x3b, 0xb4, 0xb3, 0xe7, 0x10, 0x9b, 0x50, 0xa4, 0xef, 0xda, 0xc8,
+	0x09, 0xb4, 0x10, 0xf7, 0x60, 0x8c, 0xf0, 0x91, 0x72, 0xe2, 0x21, 0x0f, 0x95, 0x85, 0xea, 0x50,
+	0x7d, 0x78, 0x75, 0x36, 0x23, 0x19, 0x6e, 0x28, 0xab, 0x4f, 0xc2, 0x84, 0x6e, 0x75, 0xcd, 0xf7,
+	0x7d, 0xeb, 0xda, 0x2c, 0xcc, 0x64, 0x86, 0xe2, 0xb9, 0x3c, 0x84, 0x92, 0x0f, 0x30, 0x54, 0x0d,
+	0xed, 0xfa, 0xe5, 0x13, 0x1f, 0xc0, 0x1b, 0xac, 0x8e, 0x4c, 0xbd, 0xe1, 0xd5, 0x72, 0x56, 0x60,
+	0x7f, 0x3d, 0x8c, 0x18, 0x80, 0x6b, 0x53, 0x30, 0x99, 0x70, 0xc3, 0xfd, 0xff, 0x22, 0xc0, 0xa8,
+	0xaf, 0x84, 0x6a, 0x69, 0xc8, 0x08, 0x22, 0xac, 0xc3, 0xcd, 0x60, 0xa7, 0x60, 0x3d, 0x0c, 0x22,
+	0xe5, 0x05, 0xd9, 0xd6, 0xc3, 0x30, 0x37, 0x48, 0x30, 0x14, 0x17, 0x61, 0xb4, 0x43, 0x88, 0xae,
+	0xb8, 0xd8, 0x50, 0xd8, 0xa9, 0x61, 0xd5, 0x2a, 0x6d, 0x15, 0xe4, 0x11, 0x7f, 0xfe, 0x10, 0x1b,
+	0x2d, 0x7f, 0x56, 0x6c, 0xc2, 0xed, 0x24, 0x4e, 0x71, 0xb1, 0x89, 0xca, 0x43, 0x55, 0xa1, 0x7e,
+	0x63, 0xab, 0x20, 0x8f, 0xc5, 0xc1, 0x87, 0xd8, 0x44, 0xad, 0xb1, 0x98, 0x63, 0x62, 0x21, 0x72,
+	0x54, 0x2b, 0xc3, 0x5b, 0xc9, 0xcc, 0x39, 0xa9, 0x3f, 0x02, 0x52, 0x2d, 0xff, 0xbc, 0x04, 0xeb,
+	0xe2, 0x3e, 0x94, 0xba, 0x5b, 0xb4, 0xcb, 0x6c, 0x31, 0xc9, 0xac, 0x0b, 0xa1, 0x8d, 0x03, 0xfe,
+	0xcd, 0x59, 0x8e, 0xd0, 0xd8, 0x9c, 0xb8, 0x0f, 0x22, 0x3d, 0x26, 0x8e, 0xab, 0xb8, 0xc8, 0x31,
+	0x15, 0x8d, 0xc5, 0xa1, 0xe5, 0x6b, 0x6c, 0x3f, 0xcc, 0xe4, 0x96, 0xc5, 0xcf, 0x29, 0x74, 0x37,
+	0xc6, 0xcc, 0x0f, 0x91, 0x63, 0x06, 0x49, 0x52, 0x71, 0x3e, 0xa5, 0x9e, 0x2f, 0x48, 0x29, 0xa9,
+	0x5d, 0x6d, 0x07, 0xa0, 0xeb, 0x4b, 0xac, 0xc2, 0x08, 0x3f, 0x1a, 0x11, 0xb1, 0x92, 0x0c, 0xd1,
+	0xd6, 0xdf, 0xd6, 0xc5, 0x19, 0x00, 0xcd, 0xc0, 0x88, 0xf1, 0x0e, 0x12, 0x2c, 0xc9, 0xc5, 0x60,
+	0x66, 0x5b, 0xa7, 0xb5, 0x67, 0x02, 0x13, 0x32, 0xa6, 0x56, 0x24, 0xa4, 0xb8, 0x0b, 0x13, 0x31,
+	0x8a, 0xd4, 0xd3, 0x34, 0x84, 0x74, 0xa4, 0x97, 0x85, 0x3e, 0x48, 0xca, 0x22, 0xa7, 0x77, 0x10,
+	0x19, 0x8a, 0xdb, 0x30, 0x1e, 0x73, 0x78, 0xa4, 0x62, 0x03, 0xe9, 0x7d, 0x49, 0x26, 0xdf, 0xe2,
+	0xde, 0x36, 0x99, 0x55, 0xd4, 0x60, 0x3e, 0xb7, 0xf5, 0xd7, 0xb7, 0xc1, 0x24, 0x93, 0xe3, 0xfb,
+	0xf3, 0xa5, 0x00, 0x23, 0xf1, 0xee, 0xe0, 0x1f, 0x6a, 0xd6, 0xdc, 0xc3, 0x5d, 0xf9, 0x76, 0x4e,
+	0xe4, 0x1d, 0x1f, 0xb3, 0x55, 0x90, 0x03, 0xb0, 0xf8, 0x11, 0x48, 0x31, 0x31, 0x83, 0x33, 0x6b,
+	0xfb, 0x47, 0xdc, 0x44, 0x96, 0xcb, 0x48, 0x8c, 0x6c, 0x15, 0xe4, 0x29, 0x2e, 0x1c, 0x53, 0x73,
+	0x2f, 0x02, 0x88, 0x9b, 0x50, 0x4a, 0xdc, 0x08, 0x6c, 0xaf, 0xe5, 0xb4, 0xb2, 0xe0, 0x78, 0x31,
+	0x98, 0x7f, 0x94, 0x49, 0x6c, 0xdc, 0x1a, 0x86, 0x22, 0x6f, 0x6b, 0xb5, 0xbf, 0x04, 0x58, 0xe0,
+	0xc4, 0x1f, 0xb2, 0x2b, 0xee, 0x10, 0x23, 0xe7, 0x91, 0x7f, 0xc1, 0x6d, 0xb0, 0xab, 0xc4, 0x0b,
+	0x90, 0x03, 0x57, 0xca, 0x82, 0x72, 0xde, 0xd5, 0x19, 0x16, 0xae, 0x99, 0xc1, 0xa0, 0x57, 0x2a,
+	0x61, 0x31, 0x27, 0x51, 0x16, 0x26, 0x55, 0xd9, 0x26, 0xac, 0xf4, 0x45, 0x90, 0x57, 0xfb, 0x77,
+	0x01, 0xe6, 0xb9, 0x05, 0x3b, 0xc1, 0xb2, 0xea, 0xa2, 0xff, 0x51, 0x91, 0xc7, 0x30, 0x95, 0xf3,
+	0x40, 0x09, 0x4b, 0xda, 0xc8, 0x10, 0xa4, 0x47, 0x22, 0xa1, 0x1e, 0x13, 0xed, 0x0c, 0x48, 0x4a,
+	0x8e, 0x06, 0x2c, 0xf7, 0x43, 0x8e, 0xab, 0xf1, 0xab, 0x00, 0xd3, 0xdc, 0xe0, 0x51, 0xec, 0xa5,
+	0x11, 0xc0, 0x07, 0x16, 0xe1, 0x6b, 0xb8, 0x9d, 0xf1, 0x6e, 0x09, 0x77, 0xc4, 0x42, 0x86, 0x00,
+	0xe9, 0xd8, 0x21, 0x6f, 0xd1, 0x48, 0xad, 0xa4, 0x58, 0x2f, 0xc0, 0x3b, 0x3d, 0x48, 0x70, 0xb2,
+	0x3f, 0x0a, 0x50, 0xe5, 0xb8, 0x6d, 0x8b, 0x7a, 0x8e, 0xdf, 0x5f, 0x37, 0x3d, 0x4b, 0xdf, 0xf5,
+	0xdc, 0x23, 0x83, 0x3c, 0xd9, 0x50, 0xed, 0x81, 0x19, 0xaf, 0x83, 0x84, 0x23, 0x97, 0xca, 0x91,
+	0x67, 0xe9, 0x0a, 0x09, 0x9c, 0x2a, 0x9a, 0x6a, 0x33, 0xe2, 0xd7, 0xe5, 0x29, 0x9c, 0x1d, 0x34,
+	0x45, 0xe8, 0x2e, 0xd4, 0xaf, 0x4a, 0x34, 0x62, 0xb5, 0xfa, 0x73, 0x11, 0x86, 0x76, 0x68, 0x47,
+	0xb4, 0x41, 0xcc, 0x78, 0x24, 0xd5, 0x33, 0xb4, 0xce, 0x7c, 0xe3, 0x48, 0xf7, 0xfa, 0x45, 0xf2,
+	0xfb, 0xe8, 0x0b, 0x80, 0xd8, 0x53, 0xa8, 0x9a, 0x63, 0xcf, 0x11, 0x52, 0xfd, 0x2a, 0x04, 0xf7,
+	0xfc, 0x15, 0x0c, 0xc7, 0xdf, 0x40, 0x73, 0xd9, 0x86, 0x31, 0x88, 0xb4, 0x74, 0x25, 0x24, 0xee,
+	0x3c, 0xfe, 0x16, 0xc9, 0x71, 0x1e, 0x83, 0x48, 0x4b, 0x57, 0x42, 0xb8, 0x73, 0x1d, 0x46, 0x5f,
+	0x79, 0x64, 0xcf, 0xe7, 0x64, 0x96, 0x40, 0x49, 0xcb, 0xfd, 0xa0, 0xe2, 0x51, 0x5e, 0xb9, 0x69,
+	0x73, 0xa2, 0x24, 0x51, 0xd2, 0x72, 0x3f, 0x28, 0x1e, 0xe5, 0x07, 0x01, 0x6a, 0x7d, 0x5c, 0x1d,
+	0x1f, 0xf4, 0x72, 0xda, 0xcb, 0x52, 0xfa, 0x74, 0x50, 0x4b, 0x9e, 0xe2, 0xf7, 0x02, 0xcc, 0x5d,
+	0xdd, 0xca, 0xdf, 0xef, 0x15, 0xa7, 0x87, 0xa1, 0xf4, 0xc9, 0x80, 0x86, 0x3c, 0xbf, 0xa7, 0x02,
+	0x94, 0x73, 0x9b, 0x6b, 0xa3, 0x97, 0xf7, 0x34, 0x5e, 0x5a, 0xfb, 0x6f, 0x78, 0x9e, 0xc4, 0xb7,
+	0x02, 0xcc, 0xf4, 0x6e, 0x7a, 0xf7, 0x7b, 0x79, 0xce, 0x31, 0x92, 0xd6, 0x07, 0x30, 0x8a, 0x72,
+	0x6a, 0xed, 0x3d, 0xbf, 0xa8, 0x08, 0x2f, 0x2e, 0x2a, 0xc2, 0xdf, 0x17, 0x15, 0xe1, 0x9b, 0xcb,
+	0x4a, 0xe1, 0xc5, 0x65, 0xa5, 0xf0, 0xf2, 0xb2, 0x52, 0xf8, 0x72, 0xad, 0x83, 0xdd, 0x63, 0xaf,
+	0xdd, 0xd0, 0x88, 0x99, 0xfc, 0xdb, 0xff, 0xf4, 0xc1, 0x8a, 0x76, 0xac, 0x62, 0xab, 0xc9, 0x67,
+	0xce, 0xc2, 0x7f, 0x44, 0x9c, 0xdb, 0x88, 0xb6, 0xdf, 0x64, 0xd3, 0xf7, 0xff, 0x1d, 0x00, 0x53,
+	0xc8, 0x83, 0xa2, 0xaa, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1229,6 +1334,9 @@ type MsgClient interface {
 	UpdateBlockRateLimitConfiguration(ctx context.Context, in *MsgUpdateBlockRateLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateBlockRateLimitConfigurationResponse, error)
 	// UpdateLiquidationsConfig updates the liquidations configuration in state.
 	UpdateLiquidationsConfig(ctx context.Context, in *MsgUpdateLiquidationsConfig, opts ...grpc.CallOption) (*MsgUpdateLiquidationsConfigResponse, error)
+	// UpdateInsuranceFundOutflowCap updates the maximum amount of quote quantums
+	// the insurance fund can pay out for liquidations per block.
+	UpdateInsuranceFundOutflowCap(ctx context.Context, in *MsgUpdateInsuranceFundOutflowCap, opts ...grpc.CallOption) (*MsgUpdateInsuranceFundOutflowCapResponse, error)
 }
 
 type msgClient struct {
@@ -1320,6 +1428,15 @@ func (c *msgClient) UpdateLiquidationsConfig(ctx context.Context, in *MsgUpdateL
 	return out, nil
 }
 
+func (c *msgClient) UpdateInsuranceFundOutflowCap(ctx context.Context, in *MsgUpdateInsuranceFundOutflowCap, opts ...grpc.CallOption) (*MsgUpdateInsuranceFundOutflowCapResponse, error) {
+	out := new(MsgUpdateInsuranceFundOutflowCapResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateInsuranceFundOutflowCap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ProposedOperations is a temporary message used by block proposers
@@ -1346,6 +1463,9 @@ type MsgServer interface {
 	UpdateBlockRateLimitConfiguration(context.Context, *MsgUpdateBlockRateLimitConfiguration) (*MsgUpdateBlockRateLimitConfigurationResponse, error)
 	// UpdateLiquidationsConfig updates the liquidations configuration in state.
 	UpdateLiquidationsConfig(context.Context, *MsgUpdateLiquidationsConfig) (*MsgUpdateLiquidationsConfigResponse, error)
+	// UpdateInsuranceFundOutflowCap updates the maximum amount of quote quantums
+	// the insurance fund can pay out for liquidations per block.
+	UpdateInsuranceFundOutflowCap(context.Context, *MsgUpdateInsuranceFundOutflowCap) (*MsgUpdateInsuranceFundOutflowCapResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1379,6 +1499,9 @@ func (*UnimplementedMsgServer) UpdateBlockRateLimitConfiguration(ctx context.Con
 func (*UnimplementedMsgServer) UpdateLiquidationsConfig(ctx context.Context, req *MsgUpdateLiquidationsConfig) (*MsgUpdateLiquidationsConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateLiquidationsConfig not implemented")
 }
+func (*UnimplementedMsgServer) UpdateInsuranceFundOutflowCap(ctx context.Context, req *MsgUpdateInsuranceFundOutflowCap) (*MsgUpdateInsuranceFundOutflowCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInsuranceFundOutflowCap not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1546,6 +1669,24 @@ func _Msg_UpdateLiquidationsConfig_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateInsuranceFundOutflowCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateInsuranceFundOutflowCap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateInsuranceFundOutflowCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/UpdateInsuranceFundOutflowCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateInsuranceFundOutflowCap(ctx, req.(*MsgUpdateInsuranceFundOutflowCap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.clob.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1586,6 +1727,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateLiquidationsConfig",
 			Handler:    _Msg_UpdateLiquidationsConfig_Handler,
 		},
+		{
+			MethodName: "UpdateInsuranceFundOutflowCap",
+			Handler:    _Msg_UpdateInsuranceFundOutflowCap_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/clob/tx.proto",
@@ -2351,6 +2496,64 @@ func (m *MsgUpdateLiquidationsConfigResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateInsuranceFundOutflowCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateInsuranceFundOutflowCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateInsuranceFundOutflowCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InsuranceFundOutflowCap != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.InsuranceFundOutflowCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateInsuranceFundOutflowCapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateInsuranceFundOutflowCapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateInsuranceFundOutflowCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2675,6 +2878,31 @@ func (m *MsgUpdateLiquidationsConfigResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateInsuranceFundOutflowCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.InsuranceFundOutflowCap != 0 {
+		n += 1 + sovTx(uint64(m.InsuranceFundOutflowCap))
+	}
+	return n
+}
+
+func (m *MsgUpdateInsuranceFundOutflowCapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4489,6 +4717,157 @@ func (m *MsgUpdateLiquidationsConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateInsuranceFundOutflowCap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateInsuranceFundOutflowCap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateInsuranceFundOutflowCap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundOutflowCap", wireType)
+			}
+			m.InsuranceFundOutflowCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InsuranceFundOutflowCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateInsuranceFundOutflowCapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateInsuranceFundOutflowCapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateInsuranceFundOutflowCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
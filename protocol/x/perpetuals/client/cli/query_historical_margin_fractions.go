@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryHistoricalMarginFractions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-historical-margin-fractions [perpetual-id] [epoch]",
+		Short: "Get the margin fractions of a perpetual during a past funding-tick epoch",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argPerpetualId, err := cast.ToUint32E(args[0])
+			if err != nil {
+				return err
+			}
+			argEpoch, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryHistoricalMarginFractionsRequest{
+				PerpetualId: argPerpetualId,
+				Epoch:       argEpoch,
+			}
+
+			res, err := queryClient.HistoricalMarginFractions(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
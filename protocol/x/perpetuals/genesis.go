@@ -19,16 +19,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	// Create all liquidity tiers.
 	for _, elem := range genState.LiquidityTiers {
-		_, err := k.SetLiquidityTier(
-			ctx,
-			elem.Id,
-			elem.Name,
-			elem.InitialMarginPpm,
-			elem.MaintenanceFractionPpm,
-			elem.ImpactNotional,
-			elem.OpenInterestLowerCap,
-			elem.OpenInterestUpperCap,
-		)
+		err := k.ValidateAndSetLiquidityTier(ctx, elem)
 
 		if err != nil {
 			panic(err)
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// HistoricalFundingIndex returns the funding index of a perpetual as of a past block height. Only heights
+// within the last `FundingIndexSnapshotRetentionEpochs` funding-tick epochs can be queried.
+func (k Keeper) HistoricalFundingIndex(
+	c context.Context,
+	req *types.QueryHistoricalFundingIndexRequest,
+) (*types.QueryHistoricalFundingIndexResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	if int64(req.Height) > ctx.BlockHeight() {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"height %d is after the current block height %d",
+			req.Height,
+			ctx.BlockHeight(),
+		)
+	}
+
+	snapshot, err := k.GetHistoricalFundingIndex(ctx, req.PerpetualId, req.Height)
+	if err != nil {
+		if errors.Is(err, types.ErrPerpetualDoesNotExist) || errors.Is(err, types.ErrFundingIndexSnapshotNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHistoricalFundingIndexResponse{Snapshot: snapshot}, nil
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+)
+
+func TestHistoricalFundingIndex(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+	p := constants.BtcUsd_NegativeDefaultFunding_10AtomicResolution
+	_, err := pc.PerpetualsKeeper.CreatePerpetual(
+		pc.Ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.MarketType,
+	)
+	require.NoError(t, err)
+	processFundingTickEpochs(t, pc, 3)
+	ctx := pc.Ctx.WithBlockHeight(35)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryHistoricalFundingIndexRequest
+		response *types.QueryHistoricalFundingIndexResponse
+		err      error
+	}{
+		{
+			desc: "Success",
+			request: &types.QueryHistoricalFundingIndexRequest{
+				PerpetualId: p.Params.Id,
+				Height:      25,
+			},
+			response: &types.QueryHistoricalFundingIndexResponse{
+				Snapshot: types.FundingIndexSnapshot{
+					BlockHeight:  20,
+					FundingIndex: dtypes.NewInt(-1_250),
+				},
+			},
+		},
+		{
+			desc: "Height before the first funding tick",
+			request: &types.QueryHistoricalFundingIndexRequest{
+				PerpetualId: p.Params.Id,
+				Height:      5,
+			},
+			err: status.Error(
+				codes.NotFound,
+				types.ErrFundingIndexSnapshotNotFound.Wrapf("perpetual id = %d, height = %d", p.Params.Id, 5).Error(),
+			),
+		},
+		{
+			desc: "Height after the current block height",
+			request: &types.QueryHistoricalFundingIndexRequest{
+				PerpetualId: p.Params.Id,
+				Height:      36,
+			},
+			err: status.Error(codes.InvalidArgument, "height 36 is after the current block height 35"),
+		},
+		{
+			desc: "Perpetual not found",
+			request: &types.QueryHistoricalFundingIndexRequest{
+				PerpetualId: 1000,
+				Height:      25,
+			},
+			err: status.Error(codes.NotFound, types.ErrPerpetualDoesNotExist.Wrap("1000").Error()),
+		},
+		{
+			desc: "Nil request",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := pc.PerpetualsKeeper.HistoricalFundingIndex(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// HistoricalMarginFractions returns the margin fractions of a perpetual that were in effect during a past
+// funding-tick epoch. Only the last `MarginFractionsRetentionEpochs` funding-tick epochs can be queried.
+func (k Keeper) HistoricalMarginFractions(
+	c context.Context,
+	req *types.QueryHistoricalMarginFractionsRequest,
+) (*types.QueryHistoricalMarginFractionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	fractions, err := k.GetHistoricalMarginFractions(ctx, req.PerpetualId, req.Epoch)
+	if err != nil {
+		if errors.Is(err, types.ErrPerpetualDoesNotExist) || errors.Is(err, types.ErrMarginFractionsNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHistoricalMarginFractionsResponse{MarginFractions: fractions}, nil
+}
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+)
+
+func TestHistoricalMarginFractions(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+	p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+	_, err := pc.PerpetualsKeeper.CreatePerpetual(
+		pc.Ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.MarketType,
+	)
+	require.NoError(t, err)
+	for _, epochInfo := range []epochstypes.EpochInfo{
+		{
+			Name:          string(epochstypes.FundingTickEpochInfoName),
+			Duration:      3600,
+			NextTick:      3600,
+			IsInitialized: true,
+		},
+		{
+			Name:     string(epochstypes.FundingSampleEpochInfoName),
+			Duration: 60,
+		},
+	} {
+		require.NoError(t, pc.EpochsKeeper.CreateEpochInfo(pc.Ctx, epochInfo))
+	}
+	ctx := pc.Ctx.WithBlockHeight(10).WithBlockTime(time.Unix(3600, 0))
+	started, err := pc.EpochsKeeper.MaybeStartNextEpoch(ctx, epochstypes.FundingTickEpochInfoName)
+	require.NoError(t, err)
+	require.True(t, started)
+	pc.PerpetualsKeeper.MaybeProcessNewFundingTickEpoch(ctx)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryHistoricalMarginFractionsRequest
+		response *types.QueryHistoricalMarginFractionsResponse
+		err      error
+	}{
+		{
+			desc: "Success",
+			request: &types.QueryHistoricalMarginFractionsRequest{
+				PerpetualId: p.Params.Id,
+				Epoch:       1,
+			},
+			response: &types.QueryHistoricalMarginFractionsResponse{
+				MarginFractions: types.MarginFractions{
+					Epoch:                     1,
+					InitialMarginPpm:          200_000,
+					MaintenanceMarginPpm:      100_000,
+					ShortInitialMarginPpm:     200_000,
+					ShortMaintenanceMarginPpm: 100_000,
+				},
+			},
+		},
+		{
+			desc: "Epoch without margin fractions",
+			request: &types.QueryHistoricalMarginFractionsRequest{
+				PerpetualId: p.Params.Id,
+				Epoch:       2,
+			},
+			err: status.Error(
+				codes.NotFound,
+				types.ErrMarginFractionsNotFound.Wrapf("perpetual id = %d, epoch = %d", p.Params.Id, 2).Error(),
+			),
+		},
+		{
+			desc: "Perpetual not found",
+			request: &types.QueryHistoricalMarginFractionsRequest{
+				PerpetualId: 1000,
+				Epoch:       1,
+			},
+			err: status.Error(codes.NotFound, types.ErrPerpetualDoesNotExist.Wrap("1000").Error()),
+		},
+		{
+			desc: "Nil request",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := pc.PerpetualsKeeper.HistoricalMarginFractions(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
@@ -24,7 +24,7 @@ func (k msgServer) CreatePerpetual(
 
 	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
 
-	_, err := k.Keeper.CreatePerpetual(
+	perpetual, err := k.Keeper.CreatePerpetual(
 		ctx,
 		msg.Params.Id,
 		msg.Params.Ticker,
@@ -38,5 +38,13 @@ func (k msgServer) CreatePerpetual(
 		return &types.MsgCreatePerpetualResponse{}, err
 	}
 
+	// `CreatePerpetual` creates linear perpetuals.
+	if msg.Params.PerpetualType != perpetual.Params.PerpetualType {
+		perpetual.Params.PerpetualType = msg.Params.PerpetualType
+		if err := k.Keeper.ValidateAndSetPerpetual(ctx, perpetual); err != nil {
+			return &types.MsgCreatePerpetualResponse{}, err
+		}
+	}
+
 	return &types.MsgCreatePerpetualResponse{}, nil
 }
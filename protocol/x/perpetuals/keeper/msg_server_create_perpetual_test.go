@@ -30,6 +30,11 @@ func TestCreatePerpetual(t *testing.T) {
 		perptest.WithMarketId(2),
 		perptest.WithMarketType(types.PerpetualMarketType_PERPETUAL_MARKET_TYPE_ISOLATED),
 	)
+	testPerpInverse := *perptest.GeneratePerpetual(
+		perptest.WithId(4),
+		perptest.WithMarketId(2),
+		perptest.WithPerpetualType(types.InversePerpetual),
+	)
 	testMarket1 := *pricestest.GenerateMarketParamPrice(pricestest.WithId(1))
 	testMarket2 := *pricestest.GenerateMarketParamPrice(pricestest.WithId(2))
 	testCases := map[string]struct {
@@ -92,6 +97,27 @@ func TestCreatePerpetual(t *testing.T) {
 			},
 			expectedPerpetuals: []types.Perpetual{testPerpIsolated},
 		},
+		"Succeeds: create new inverse perpetual": {
+			setup: func(
+				t *testing.T,
+				ctx sdk.Context,
+				perpKeeper *perpkeeper.Keeper,
+				pricesKeeper *priceskeeper.Keeper,
+			) {
+				keepertest.CreateTestLiquidityTiers(t, ctx, perpKeeper)
+				keepertest.CreateTestPriceMarkets(
+					t,
+					ctx,
+					pricesKeeper,
+					[]pricestypes.MarketParamPrice{testMarket2},
+				)
+			},
+			msg: &types.MsgCreatePerpetual{
+				Authority: lib.GovModuleAddress.String(),
+				Params:    testPerpInverse.Params,
+			},
+			expectedPerpetuals: []types.Perpetual{testPerpInverse},
+		},
 		"Failure: new perpetual id already exists in state": {
 			setup: func(t *testing.T, ctx sdk.Context, perpKeeper *perpkeeper.Keeper, pricesKeeper *priceskeeper.Keeper) {
 				keepertest.CreateTestPricesAndPerpetualMarkets(
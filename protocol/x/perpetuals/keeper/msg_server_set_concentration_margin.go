@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+)
+
+func (k msgServer) SetConcentrationMargin(
+	goCtx context.Context,
+	msg *types.MsgSetConcentrationMargin,
+) (*types.MsgSetConcentrationMarginResponse, error) {
+	if !k.Keeper.HasAuthority(msg.Authority) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority %s",
+			msg.Authority,
+		)
+	}
+
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+
+	if err := k.Keeper.SetConcentrationMargin(
+		ctx,
+		msg.PerpetualId,
+		types.ConcentrationMargin{
+			ThresholdPpm: msg.ThresholdPpm,
+			AddOnPpm:     msg.AddOnPpm,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetConcentrationMarginResponse{}, nil
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	perptest "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
+	pricestest "github.com/dydxprotocol/v4-chain/protocol/testutil/prices"
+	perpkeeper "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServerSetConcentrationMargin(t *testing.T) {
+	testPerp := *perptest.GeneratePerpetual(
+		perptest.WithId(1),
+		perptest.WithMarketId(1),
+		perptest.WithTicker("ETH-USD"),
+		perptest.WithLiquidityTier(1),
+	)
+	testMarket := *pricestest.GenerateMarketParamPrice(pricestest.WithId(1), pricestest.WithPair("0-0"))
+
+	initialConcentrationMargin := types.ConcentrationMargin{ThresholdPpm: 100_000, AddOnPpm: 50_000}
+
+	tests := map[string]struct {
+		msg                         *types.MsgSetConcentrationMargin
+		expectedConcentrationMargin types.ConcentrationMargin
+		expectedErr                 string
+	}{
+		"Success: update concentration margin": {
+			msg: &types.MsgSetConcentrationMargin{
+				Authority:    lib.GovModuleAddress.String(),
+				PerpetualId:  testPerp.Params.Id,
+				ThresholdPpm: 200_000,
+				AddOnPpm:     25_000,
+			},
+			expectedConcentrationMargin: types.ConcentrationMargin{ThresholdPpm: 200_000, AddOnPpm: 25_000},
+		},
+		"Success: zero add-on removes concentration margin": {
+			msg: &types.MsgSetConcentrationMargin{
+				Authority:    lib.GovModuleAddress.String(),
+				PerpetualId:  testPerp.Params.Id,
+				ThresholdPpm: 200_000,
+			},
+		},
+		"Failure: perpetual does not exist": {
+			msg: &types.MsgSetConcentrationMargin{
+				Authority:    lib.GovModuleAddress.String(),
+				PerpetualId:  testPerp.Params.Id + 1,
+				ThresholdPpm: 200_000,
+				AddOnPpm:     25_000,
+			},
+			expectedConcentrationMargin: initialConcentrationMargin,
+			expectedErr:                 "Perpetual does not exist",
+		},
+		"Failure: invalid authority": {
+			msg: &types.MsgSetConcentrationMargin{
+				Authority:    constants.BobAccAddress.String(),
+				PerpetualId:  testPerp.Params.Id,
+				ThresholdPpm: 200_000,
+				AddOnPpm:     25_000,
+			},
+			expectedConcentrationMargin: initialConcentrationMargin,
+			expectedErr:                 "invalid authority",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pc := keepertest.PerpetualsKeepers(t)
+			keepertest.CreateTestPricesAndPerpetualMarkets(
+				t,
+				pc.Ctx,
+				pc.PerpetualsKeeper,
+				pc.PricesKeeper,
+				[]types.Perpetual{testPerp},
+				[]pricestypes.MarketParamPrice{testMarket},
+			)
+			require.NoError(
+				t,
+				pc.PerpetualsKeeper.SetConcentrationMargin(pc.Ctx, testPerp.Params.Id, initialConcentrationMargin),
+			)
+
+			msgServer := perpkeeper.NewMsgServerImpl(pc.PerpetualsKeeper)
+
+			_, err := msgServer.SetConcentrationMargin(pc.Ctx, tc.msg)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(
+				t,
+				tc.expectedConcentrationMargin,
+				pc.PerpetualsKeeper.GetConcentrationMargin(pc.Ctx, testPerp.Params.Id),
+			)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+)
+
+func (k msgServer) SetFundingRateCap(
+	goCtx context.Context,
+	msg *types.MsgSetFundingRateCap,
+) (*types.MsgSetFundingRateCapResponse, error) {
+	if !k.Keeper.HasAuthority(msg.Authority) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority %s",
+			msg.Authority,
+		)
+	}
+
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+
+	if err := k.Keeper.SetFundingRateCapPpm(ctx, msg.PerpetualId, msg.FundingRateCapPpm); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetFundingRateCapResponse{}, nil
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	perptest "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
+	pricestest "github.com/dydxprotocol/v4-chain/protocol/testutil/prices"
+	perpkeeper "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetFundingRateCap(t *testing.T) {
+	testPerp := *perptest.GeneratePerpetual(
+		perptest.WithId(1),
+		perptest.WithMarketId(1),
+		perptest.WithTicker("ETH-USD"),
+		perptest.WithLiquidityTier(1),
+	)
+	testMarket := *pricestest.GenerateMarketParamPrice(pricestest.WithId(1), pricestest.WithPair("0-0"))
+
+	tests := map[string]struct {
+		initialCapPpm  uint32
+		msg            *types.MsgSetFundingRateCap
+		expectedCapPpm uint32
+		expectedErr    string
+	}{
+		"Success: set cap": {
+			msg: &types.MsgSetFundingRateCap{
+				Authority:         lib.GovModuleAddress.String(),
+				PerpetualId:       testPerp.Params.Id,
+				FundingRateCapPpm: 10_000,
+			},
+			expectedCapPpm: 10_000,
+		},
+		"Success: zero removes cap": {
+			initialCapPpm: 10_000,
+			msg: &types.MsgSetFundingRateCap{
+				Authority:   lib.GovModuleAddress.String(),
+				PerpetualId: testPerp.Params.Id,
+			},
+		},
+		"Failure: perpetual does not exist": {
+			initialCapPpm: 10_000,
+			msg: &types.MsgSetFundingRateCap{
+				Authority:         lib.GovModuleAddress.String(),
+				PerpetualId:       testPerp.Params.Id + 1,
+				FundingRateCapPpm: 20_000,
+			},
+			expectedCapPpm: 10_000,
+			expectedErr:    "Perpetual does not exist",
+		},
+		"Failure: invalid authority": {
+			initialCapPpm: 10_000,
+			msg: &types.MsgSetFundingRateCap{
+				Authority:         constants.BobAccAddress.String(),
+				PerpetualId:       testPerp.Params.Id,
+				FundingRateCapPpm: 20_000,
+			},
+			expectedCapPpm: 10_000,
+			expectedErr:    "invalid authority",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pc := keepertest.PerpetualsKeepers(t)
+			keepertest.CreateTestPricesAndPerpetualMarkets(
+				t,
+				pc.Ctx,
+				pc.PerpetualsKeeper,
+				pc.PricesKeeper,
+				[]types.Perpetual{testPerp},
+				[]pricestypes.MarketParamPrice{testMarket},
+			)
+			require.NoError(
+				t,
+				pc.PerpetualsKeeper.SetFundingRateCapPpm(pc.Ctx, testPerp.Params.Id, tc.initialCapPpm),
+			)
+
+			msgServer := perpkeeper.NewMsgServerImpl(pc.PerpetualsKeeper)
+
+			_, err := msgServer.SetFundingRateCap(pc.Ctx, tc.msg)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			capPpm, found := pc.PerpetualsKeeper.GetFundingRateCapPpm(pc.Ctx, testPerp.Params.Id)
+			require.Equal(t, tc.expectedCapPpm != 0, found)
+			require.Equal(t, tc.expectedCapPpm, capPpm)
+		})
+	}
+}
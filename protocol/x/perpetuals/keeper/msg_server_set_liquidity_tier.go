@@ -23,16 +23,7 @@ func (k msgServer) SetLiquidityTier(
 
 	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
 
-	if _, err := k.Keeper.SetLiquidityTier(
-		ctx,
-		msg.LiquidityTier.Id,
-		msg.LiquidityTier.Name,
-		msg.LiquidityTier.InitialMarginPpm,
-		msg.LiquidityTier.MaintenanceFractionPpm,
-		msg.LiquidityTier.ImpactNotional,
-		msg.LiquidityTier.OpenInterestLowerCap,
-		msg.LiquidityTier.OpenInterestUpperCap,
-	); err != nil {
+	if err := k.Keeper.ValidateAndSetLiquidityTier(ctx, msg.LiquidityTier); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	perptest "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
+	pricestest "github.com/dydxprotocol/v4-chain/protocol/testutil/prices"
+	perpkeeper "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServerSetSettlementPrice(t *testing.T) {
+	testPerp := *perptest.GeneratePerpetual(
+		perptest.WithId(1),
+		perptest.WithMarketId(1),
+		perptest.WithTicker("ETH-USD"),
+		perptest.WithLiquidityTier(1),
+	)
+	testMarket := *pricestest.GenerateMarketParamPrice(pricestest.WithId(1), pricestest.WithPair("0-0"))
+
+	tests := map[string]struct {
+		initialSettlementPrice  uint64
+		msg                     *types.MsgSetSettlementPrice
+		expectedSettlementPrice uint64
+		expectedErr             string
+	}{
+		"Success: enter settlement mode": {
+			msg: &types.MsgSetSettlementPrice{
+				Authority:       lib.GovModuleAddress.String(),
+				PerpetualId:     testPerp.Params.Id,
+				SettlementPrice: 5_000_000_000,
+			},
+			expectedSettlementPrice: 5_000_000_000,
+		},
+		"Success: zero leaves settlement mode": {
+			initialSettlementPrice: 5_000_000_000,
+			msg: &types.MsgSetSettlementPrice{
+				Authority:   lib.GovModuleAddress.String(),
+				PerpetualId: testPerp.Params.Id,
+			},
+		},
+		"Failure: perpetual does not exist": {
+			initialSettlementPrice: 5_000_000_000,
+			msg: &types.MsgSetSettlementPrice{
+				Authority:       lib.GovModuleAddress.String(),
+				PerpetualId:     testPerp.Params.Id + 1,
+				SettlementPrice: 6_000_000_000,
+			},
+			expectedSettlementPrice: 5_000_000_000,
+			expectedErr:             "Perpetual does not exist",
+		},
+		"Failure: invalid authority": {
+			initialSettlementPrice: 5_000_000_000,
+			msg: &types.MsgSetSettlementPrice{
+				Authority:       constants.BobAccAddress.String(),
+				PerpetualId:     testPerp.Params.Id,
+				SettlementPrice: 6_000_000_000,
+			},
+			expectedSettlementPrice: 5_000_000_000,
+			expectedErr:             "invalid authority",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pc := keepertest.PerpetualsKeepers(t)
+			keepertest.CreateTestPricesAndPerpetualMarkets(
+				t,
+				pc.Ctx,
+				pc.PerpetualsKeeper,
+				pc.PricesKeeper,
+				[]types.Perpetual{testPerp},
+				[]pricestypes.MarketParamPrice{testMarket},
+			)
+			require.NoError(
+				t,
+				pc.PerpetualsKeeper.SetSettlementPrice(pc.Ctx, testPerp.Params.Id, tc.initialSettlementPrice),
+			)
+
+			msgServer := perpkeeper.NewMsgServerImpl(pc.PerpetualsKeeper)
+
+			_, err := msgServer.SetSettlementPrice(pc.Ctx, tc.msg)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(
+				t,
+				tc.expectedSettlementPrice,
+				pc.PerpetualsKeeper.GetSettlementPrice(pc.Ctx, testPerp.Params.Id),
+			)
+		})
+	}
+}
@@ -612,6 +612,16 @@ func (k Keeper) GetRemoveSampleTailsFunc(
 	}
 }
 
+// GetFundingTickEpoch returns the current funding-tick epoch. Returns false if the funding-tick epoch
+// info does not exist.
+func (k Keeper) GetFundingTickEpoch(ctx sdk.Context) (epoch uint32, found bool) {
+	epochInfo, found := k.epochsKeeper.GetEpochInfo(ctx, epochstypes.FundingTickEpochInfoName)
+	if !found {
+		return 0, false
+	}
+	return epochInfo.CurrentEpoch, true
+}
+
 // MaybeProcessNewFundingTickEpoch processes funding ticks if the current block
 // is the start of a new funding-tick epoch. Otherwise, do nothing.
 func (k Keeper) MaybeProcessNewFundingTickEpoch(ctx sdk.Context) {
@@ -712,6 +722,16 @@ func (k Keeper) MaybeProcessNewFundingTickEpoch(ctx sdk.Context) {
 			fundingRateUpperBoundPpm,
 		)
 
+		// Clamp funding rate to the perpetual's funding rate cap, if one is set.
+		if capPpm, found := k.GetFundingRateCapPpm(ctx, perp.Params.Id); found {
+			bigCapPpm := lib.BigU(capPpm)
+			bigFundingRatePpm = lib.BigIntClamp(
+				bigFundingRatePpm,
+				new(big.Int).Neg(bigCapPpm),
+				bigCapPpm,
+			)
+		}
+
 		// Emit clamped funding rate.
 		telemetry.SetGaugeWithLabels(
 			[]string{
@@ -765,6 +785,10 @@ func (k Keeper) MaybeProcessNewFundingTickEpoch(ctx sdk.Context) {
 		if err != nil {
 			panic(err)
 		}
+		k.setFundingIndexSnapshot(ctx, perp, fundingTickEpochInfo.CurrentEpoch)
+		if err := k.setMarginFractions(ctx, perp, liquidityTier, fundingTickEpochInfo.CurrentEpoch); err != nil {
+			panic(err)
+		}
 		newFundingRatesAndIndicesForEvent = append(newFundingRatesAndIndicesForEvent, indexerevents.FundingUpdateV1{
 			PerpetualId:     perp.Params.Id,
 			FundingValuePpm: int32(bigFundingRatePpm.Int64()),
@@ -1042,6 +1066,19 @@ func (k Keeper) ModifyOpenInterest(
 	perpetual.OpenInterest = dtypes.NewIntFromBigInt(bigOpenInterest)
 	k.setPerpetual(ctx, perpetual)
 
+	// Every fill has a buyer and a seller, so the long and short open interest of a perpetual are always
+	// equal, and the imbalance that a fill introduces is its change in open interest.
+	if feePpm := k.GetParams(ctx).OpenInterestImbalanceFeePpm; feePpm != 0 {
+		if _, err := k.AccrueOpenInterestImbalanceFee(
+			ctx,
+			perpetualId,
+			openInterestDeltaBaseQuantums,
+			feePpm,
+		); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -1308,6 +1345,8 @@ func (k Keeper) HasLiquidityTier(
 }
 
 // `SetLiquidityTier` sets a liquidity tier in the store (i.e. updates if `id` exists and creates otherwise).
+// Fields of the liquidity tier that are not arguments take their zero values; use
+// `ValidateAndSetLiquidityTier` to set every field.
 // Returns an error if any of its fields fails validation.
 func (k Keeper) SetLiquidityTier(
 	ctx sdk.Context,
@@ -1333,9 +1372,24 @@ func (k Keeper) SetLiquidityTier(
 		OpenInterestUpperCap:   openInterestUpperCap,
 	}
 
+	if err := k.ValidateAndSetLiquidityTier(ctx, liquidityTier); err != nil {
+		return liquidityTier, err
+	}
+	return liquidityTier, nil
+}
+
+// `ValidateAndSetLiquidityTier` sets a liquidity tier in the store (i.e. updates if its id exists and
+// creates otherwise). Returns an error if any of its fields fails validation.
+func (k Keeper) ValidateAndSetLiquidityTier(
+	ctx sdk.Context,
+	liquidityTier types.LiquidityTier,
+) error {
+	// The deprecated base position notional is not stored.
+	liquidityTier.BasePositionNotional = 0 //nolint:staticcheck
+
 	// Validate liquidity tier's fields.
 	if err := liquidityTier.Validate(); err != nil {
-		return liquidityTier, err
+		return err
 	}
 
 	// Set liquidity tier in store.
@@ -1348,17 +1402,17 @@ func (k Keeper) SetLiquidityTier(
 		indexerevents.LiquidityTierEventVersion,
 		indexer_manager.GetBytes(
 			indexerevents.NewLiquidityTierUpsertEvent(
-				id,
-				name,
-				initialMarginPpm,
-				maintenanceFractionPpm,
-				openInterestLowerCap,
-				openInterestUpperCap,
+				liquidityTier.Id,
+				liquidityTier.Name,
+				liquidityTier.InitialMarginPpm,
+				liquidityTier.MaintenanceFractionPpm,
+				liquidityTier.OpenInterestLowerCap,
+				liquidityTier.OpenInterestUpperCap,
 			),
 		),
 	)
 
-	return liquidityTier, nil
+	return nil
 }
 
 // `GetLiquidityTier` gets a liquidity tier given its id.
@@ -1397,6 +1451,19 @@ func (k Keeper) GetAllLiquidityTiers(ctx sdk.Context) (list []types.LiquidityTie
 	return list
 }
 
+// `GetPerpetualsByLiquidityTier` returns the ids of all perpetuals that use the liquidity tier with
+// the given id, sorted by perpetual id.
+func (k Keeper) GetPerpetualsByLiquidityTier(ctx sdk.Context, liquidityTierId uint32) (
+	perpetualIds []uint32,
+) {
+	for _, perpetual := range k.GetAllPerpetuals(ctx) {
+		if perpetual.Params.LiquidityTier == liquidityTierId {
+			perpetualIds = append(perpetualIds, perpetual.Params.Id)
+		}
+	}
+	return perpetualIds
+}
+
 // `setLiquidityTier` sets a liquidity tier in store.
 func (k Keeper) setLiquidityTier(
 	ctx sdk.Context,
@@ -1474,6 +1541,376 @@ func (k Keeper) IsPositionUpdatable(
 	return true, nil
 }
 
+// GetFundingRateCapPpm returns the cap on the absolute funding rate of a perpetual in parts-per-million,
+// and whether a cap is set.
+func (k Keeper) GetFundingRateCapPpm(
+	ctx sdk.Context,
+	perpetualId uint32,
+) (
+	capPpm uint32,
+	found bool,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FundingRateCapKeyPrefix))
+	b := store.Get(lib.Uint32ToKey(perpetualId))
+	if b == nil {
+		return 0, false
+	}
+
+	var result gogotypes.UInt32Value
+	k.cdc.MustUnmarshal(b, &result)
+	return result.Value, true
+}
+
+// SetFundingRateCapPpm sets the cap on the absolute funding rate of a perpetual in parts-per-million.
+// The funding rate applied to the funding index at each funding-tick epoch is clamped to the cap.
+// A cap of zero removes the cap.
+func (k Keeper) SetFundingRateCapPpm(
+	ctx sdk.Context,
+	perpetualId uint32,
+	capPpm uint32,
+) error {
+	if !k.HasPerpetual(ctx, perpetualId) {
+		return errorsmod.Wrap(types.ErrPerpetualDoesNotExist, lib.UintToString(perpetualId))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FundingRateCapKeyPrefix))
+	if capPpm == 0 {
+		store.Delete(lib.Uint32ToKey(perpetualId))
+		return nil
+	}
+	value := gogotypes.UInt32Value{Value: capPpm}
+	store.Set(lib.Uint32ToKey(perpetualId), k.cdc.MustMarshal(&value))
+	return nil
+}
+
+// GetMaxUnsettledFundingIndexDelta returns the cap on the absolute difference between the funding index
+// of a perpetual and the funding index of a position in the perpetual that is applied when the position
+// is settled. Zero means uncapped, and is also returned if the perpetual does not exist.
+func (k Keeper) GetMaxUnsettledFundingIndexDelta(
+	ctx sdk.Context,
+	perpetualId uint32,
+) uint64 {
+	perpetual, err := k.GetPerpetual(ctx, perpetualId)
+	if err != nil {
+		return 0
+	}
+	return perpetual.MaxUnsettledFundingIndexDelta
+}
+
+// SetMaxUnsettledFundingIndexDelta sets the cap on the unsettled funding index delta of positions in a
+// perpetual, which bounds the funding settled by, and so the net collateral of, a position whose funding
+// index is stale. The funding beyond the cap is not settled. A cap of zero removes the cap.
+func (k Keeper) SetMaxUnsettledFundingIndexDelta(
+	ctx sdk.Context,
+	perpetualId uint32,
+	maxDelta uint64,
+) error {
+	perpetual, err := k.GetPerpetual(ctx, perpetualId)
+	if err != nil {
+		return err
+	}
+
+	perpetual.MaxUnsettledFundingIndexDelta = maxDelta
+	k.setPerpetual(ctx, perpetual)
+	return nil
+}
+
+// GetConcentrationMargin returns the additional maintenance margin required of positions in a perpetual
+// that make up a large share of its open interest. The zero value, which adds no margin, is returned if
+// none is set or the perpetual does not exist.
+func (k Keeper) GetConcentrationMargin(
+	ctx sdk.Context,
+	perpetualId uint32,
+) types.ConcentrationMargin {
+	perpetual, err := k.GetPerpetual(ctx, perpetualId)
+	if err != nil {
+		return types.ConcentrationMargin{}
+	}
+	return perpetual.GetConcentrationMargin()
+}
+
+// SetConcentrationMargin sets the additional maintenance margin required of positions in a perpetual whose
+// size exceeds `ThresholdPpm` of the open interest of the perpetual. An add-on of zero removes the
+// concentration margin.
+func (k Keeper) SetConcentrationMargin(
+	ctx sdk.Context,
+	perpetualId uint32,
+	concentrationMargin types.ConcentrationMargin,
+) error {
+	perpetual, err := k.GetPerpetual(ctx, perpetualId)
+	if err != nil {
+		return err
+	}
+
+	if concentrationMargin.AddOnPpm == 0 {
+		concentrationMargin = types.ConcentrationMargin{}
+	}
+	perpetual.ConcentrationThresholdPpm = concentrationMargin.ThresholdPpm
+	perpetual.ConcentrationAddOnPpm = concentrationMargin.AddOnPpm
+	k.setPerpetual(ctx, perpetual)
+	return nil
+}
+
+// GetOpenInterestImbalancePool returns the open interest imbalance fees accrued to the pool of a perpetual
+// in quote quantums.
+func (k Keeper) GetOpenInterestImbalancePool(
+	ctx sdk.Context,
+	perpetualId uint32,
+) (
+	bigPoolQuoteQuantums *big.Int,
+	err error,
+) {
+	if !k.HasPerpetual(ctx, perpetualId) {
+		return nil, errorsmod.Wrap(types.ErrPerpetualDoesNotExist, lib.UintToString(perpetualId))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.OpenInterestImbalancePoolKeyPrefix))
+	b := store.Get(lib.Uint32ToKey(perpetualId))
+	if b == nil {
+		return new(big.Int), nil
+	}
+
+	var pool dtypes.SerializableInt
+	if err := pool.Unmarshal(b); err != nil {
+		return nil, err
+	}
+	return pool.BigInt(), nil
+}
+
+// AccrueOpenInterestImbalanceFee accrues the open interest imbalance fee of a perpetual to its pool, given
+// the imbalance in open interest in base quantums caused by positions being opened or closed. The fee is
+// `feePpm` of the notional of the absolute imbalance at the oracle price, rounded down so that accrual is
+// deterministic. Returns the fee accrued in quote quantums.
+//
+// `ModifyOpenInterest` accrues the fee on every change in open interest caused by a fill, with the
+// `OpenInterestImbalanceFeePpm` of the module params.
+func (k Keeper) AccrueOpenInterestImbalanceFee(
+	ctx sdk.Context,
+	perpetualId uint32,
+	bigImbalanceBaseQuantums *big.Int,
+	feePpm uint32,
+) (
+	bigFeeQuoteQuantums *big.Int,
+	err error,
+) {
+	bigPool, err := k.GetOpenInterestImbalancePool(ctx, perpetualId)
+	if err != nil {
+		return nil, err
+	}
+
+	bigImbalanceNotional, err := k.GetNetNotional(
+		ctx,
+		perpetualId,
+		new(big.Int).Abs(bigImbalanceBaseQuantums),
+	)
+	if err != nil {
+		return nil, err
+	}
+	bigFeeQuoteQuantums = lib.BigMulPpm(bigImbalanceNotional, lib.BigU(feePpm), false)
+	if bigFeeQuoteQuantums.Sign() == 0 {
+		return bigFeeQuoteQuantums, nil
+	}
+
+	pool := dtypes.NewIntFromBigInt(bigPool.Add(bigPool, bigFeeQuoteQuantums))
+	b, err := pool.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.OpenInterestImbalancePoolKeyPrefix))
+	store.Set(lib.Uint32ToKey(perpetualId), b)
+	return bigFeeQuoteQuantums, nil
+}
+
+// GetSettlementPrice returns the settlement price of a perpetual, in the exponent of its market price.
+// Zero means the perpetual is not in settlement mode, and is also returned if the perpetual does not exist.
+func (k Keeper) GetSettlementPrice(
+	ctx sdk.Context,
+	perpetualId uint32,
+) uint64 {
+	perpetual, err := k.GetPerpetual(ctx, perpetualId)
+	if err != nil {
+		return 0
+	}
+	return perpetual.SettlementPrice
+}
+
+// SetSettlementPrice puts a perpetual that is being wound down into settlement mode, in which positions in
+// the perpetual are valued at the fixed settlement price rather than the market price. The settlement
+// price is in the exponent of the market price of the perpetual. A settlement price of zero takes the
+// perpetual out of settlement mode.
+func (k Keeper) SetSettlementPrice(
+	ctx sdk.Context,
+	perpetualId uint32,
+	settlementPrice uint64,
+) error {
+	perpetual, err := k.GetPerpetual(ctx, perpetualId)
+	if err != nil {
+		return err
+	}
+
+	perpetual.SettlementPrice = settlementPrice
+	k.setPerpetual(ctx, perpetual)
+	return nil
+}
+
+// getFundingIndexSnapshotStore returns the prefix store of the funding index snapshots of a perpetual,
+// keyed by the ring buffer slot of the funding-tick epoch in which each was recorded.
+func (k Keeper) getFundingIndexSnapshotStore(ctx sdk.Context, perpetualId uint32) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append([]byte(types.FundingIndexSnapshotKeyPrefix), lib.Uint32ToKey(perpetualId)...),
+	)
+}
+
+// setFundingIndexSnapshot records the funding index of a perpetual at the current block height in the
+// ring buffer slot of the funding-tick epoch `epoch`, overwriting the snapshot recorded
+// `FundingIndexSnapshotRetentionEpochs` epochs earlier.
+func (k Keeper) setFundingIndexSnapshot(ctx sdk.Context, perpetual types.Perpetual, epoch uint32) {
+	snapshot := types.FundingIndexSnapshot{
+		BlockHeight:  lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+		FundingIndex: perpetual.FundingIndex,
+	}
+	store := k.getFundingIndexSnapshotStore(ctx, perpetual.Params.Id)
+	store.Set(
+		lib.Uint32ToKey(epoch%types.FundingIndexSnapshotRetentionEpochs),
+		k.cdc.MustMarshal(&snapshot),
+	)
+}
+
+// GetHistoricalFundingIndex returns the latest funding index snapshot of a perpetual recorded at or before
+// `height`. The funding index only changes at the start of a funding-tick epoch, so the funding index of
+// the snapshot is the funding index of the perpetual as of `height`.
+// Returns an error if the perpetual does not exist, or if `height` is before the first retained snapshot,
+// that is more than `FundingIndexSnapshotRetentionEpochs` funding-tick epochs ago or before the first
+// funding tick of the perpetual.
+func (k Keeper) GetHistoricalFundingIndex(
+	ctx sdk.Context,
+	perpetualId uint32,
+	height uint32,
+) (
+	snapshot types.FundingIndexSnapshot,
+	err error,
+) {
+	if !k.HasPerpetual(ctx, perpetualId) {
+		return snapshot, errorsmod.Wrap(types.ErrPerpetualDoesNotExist, lib.UintToString(perpetualId))
+	}
+
+	iterator := storetypes.KVStorePrefixIterator(k.getFundingIndexSnapshotStore(ctx, perpetualId), []byte{})
+	defer iterator.Close()
+
+	found := false
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FundingIndexSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		if val.BlockHeight <= height && (!found || val.BlockHeight > snapshot.BlockHeight) {
+			snapshot = val
+			found = true
+		}
+	}
+
+	if !found {
+		return snapshot, errorsmod.Wrapf(
+			types.ErrFundingIndexSnapshotNotFound,
+			"perpetual id = %d, height = %d",
+			perpetualId,
+			height,
+		)
+	}
+	return snapshot, nil
+}
+
+// getMarginFractionsStore returns the prefix store of the margin fractions of a perpetual, keyed by the
+// ring buffer slot of the funding-tick epoch in which each was recorded.
+func (k Keeper) getMarginFractionsStore(ctx sdk.Context, perpetualId uint32) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append([]byte(types.MarginFractionsKeyPrefix), lib.Uint32ToKey(perpetualId)...),
+	)
+}
+
+// setMarginFractions records the margin fractions of a perpetual in effect at the start of the
+// funding-tick epoch `epoch` in the ring buffer slot of the epoch, overwriting the margin fractions
+// recorded `MarginFractionsRetentionEpochs` epochs earlier. Initial margin fractions are scaled by the
+// open interest of the perpetual at the current market price.
+func (k Keeper) setMarginFractions(
+	ctx sdk.Context,
+	perpetual types.Perpetual,
+	liquidityTier types.LiquidityTier,
+	epoch uint32,
+) error {
+	marketPrice, err := k.pricesKeeper.GetMarketPrice(ctx, perpetual.Params.MarketId)
+	if err != nil {
+		return err
+	}
+	openInterestQuoteQuantums := lib.BaseToQuoteQuantums(
+		perpetual.OpenInterest.BigInt(),
+		perpetual.Params.AtomicResolution,
+		marketPrice.Price,
+		marketPrice.Exponent,
+	)
+
+	long := liquidityTier.ForPositionSide(true)
+	short := liquidityTier.ForPositionSide(false)
+	fractions := types.MarginFractions{
+		Epoch: epoch,
+		InitialMarginPpm: lib.MustConvertIntegerToUint32(
+			long.GetAdjustedInitialMarginPpm(openInterestQuoteQuantums).Uint64(),
+		),
+		MaintenanceMarginPpm: long.GetMaintenanceMarginPpm(),
+		ShortInitialMarginPpm: lib.MustConvertIntegerToUint32(
+			short.GetAdjustedInitialMarginPpm(openInterestQuoteQuantums).Uint64(),
+		),
+		ShortMaintenanceMarginPpm: short.GetMaintenanceMarginPpm(),
+	}
+	k.getMarginFractionsStore(ctx, perpetual.Params.Id).Set(
+		lib.Uint32ToKey(epoch%types.MarginFractionsRetentionEpochs),
+		k.cdc.MustMarshal(&fractions),
+	)
+	return nil
+}
+
+// GetHistoricalMarginFractions returns the margin fractions of a perpetual that were in effect during the
+// funding-tick epoch `epoch`, including the scaling of initial margin fractions by open interest.
+// Returns an error if the perpetual does not exist, if no margin fractions are retained for the epoch,
+// that is if it is more than `MarginFractionsRetentionEpochs` funding-tick epochs ago, or the perpetual
+// had no funding tick at its start, or if the stored margin fractions cannot be decoded.
+func (k Keeper) GetHistoricalMarginFractions(
+	ctx sdk.Context,
+	perpetualId uint32,
+	epoch uint32,
+) (
+	fractions types.MarginFractions,
+	err error,
+) {
+	if !k.HasPerpetual(ctx, perpetualId) {
+		return fractions, errorsmod.Wrap(types.ErrPerpetualDoesNotExist, lib.UintToString(perpetualId))
+	}
+
+	bz := k.getMarginFractionsStore(ctx, perpetualId).Get(
+		lib.Uint32ToKey(epoch % types.MarginFractionsRetentionEpochs),
+	)
+	if bz != nil {
+		if err := k.cdc.Unmarshal(bz, &fractions); err != nil {
+			return types.MarginFractions{}, errorsmod.Wrapf(
+				types.ErrInvalidMarginFractionsEncoding,
+				"perpetual id = %d, epoch = %d: %v",
+				perpetualId,
+				epoch,
+				err,
+			)
+		}
+	}
+	if bz == nil || fractions.Epoch != epoch {
+		return types.MarginFractions{}, errorsmod.Wrapf(
+			types.ErrMarginFractionsNotFound,
+			"perpetual id = %d, epoch = %d",
+			perpetualId,
+			epoch,
+		)
+	}
+	return fractions, nil
+}
+
 // GetNextPerpetualID returns the next perpetual id to be used from the module store
 func (k Keeper) GetNextPerpetualID(ctx sdk.Context) uint32 {
 	store := ctx.KVStore(k.storeKey)
@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"sort"
 	"testing"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
@@ -1319,10 +1320,94 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 		testFundingTickDuration          uint32
 		testPerpetuals                   []types.Perpetual
 		testFundingSamples               []int32
+		testFundingRateCapsPpm           map[uint32]uint32
 		expectedFundingIndexDeltas       []*big.Int
 		expectedFundingIndexDeltaStrings []string
 		fundingRatesAndIndices           []indexerevents.FundingUpdateV1
 	}{
+		"Success: 60 equivalent samples of 0.2 percent, clamped to 0.1 percent funding rate cap": {
+			testFundingSampleDuration: 60,
+			testFundingTickDuration:   3600,
+			testPerpetuals: []types.Perpetual{
+				constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+			},
+			// Premium sample = 0.2%, length = 60.
+			testFundingSamples: constants.GenerateConstantFundingPremiums(2000, 60),
+			testFundingRateCapsPpm: map[uint32]uint32{
+				constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(): 1_000,
+			},
+			// 8-hr funding rate of 2_000 is capped at 1_000, prorated funding rate thus is 125
+			expectedFundingIndexDeltaStrings: []string{"625"},
+			fundingRatesAndIndices: []indexerevents.FundingUpdateV1{
+				{
+					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
+					FundingValuePpm: 1_000,
+					FundingIndex:    dtypes.NewInt(625),
+				},
+			},
+		},
+		"Success: 60 equivalent samples of -0.2 percent, clamped to 0.1 percent funding rate cap": {
+			testFundingSampleDuration: 60,
+			testFundingTickDuration:   3600,
+			testPerpetuals: []types.Perpetual{
+				constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+			},
+			// Premium sample = -0.2%, length = 60.
+			testFundingSamples: constants.GenerateConstantFundingPremiums(-2000, 60),
+			testFundingRateCapsPpm: map[uint32]uint32{
+				constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(): 1_000,
+			},
+			// 8-hr funding rate of -2_000 is capped at -1_000, prorated funding rate thus is -125
+			expectedFundingIndexDeltaStrings: []string{"-625"},
+			fundingRatesAndIndices: []indexerevents.FundingUpdateV1{
+				{
+					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
+					FundingValuePpm: -1_000,
+					FundingIndex:    dtypes.NewInt(-625),
+				},
+			},
+		},
+		"Success: 60 equivalent samples of 0.2 percent, below 0.5 percent funding rate cap": {
+			testFundingSampleDuration: 60,
+			testFundingTickDuration:   3600,
+			testPerpetuals: []types.Perpetual{
+				constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+			},
+			// Premium sample = 0.2%, length = 60.
+			testFundingSamples: constants.GenerateConstantFundingPremiums(2000, 60),
+			testFundingRateCapsPpm: map[uint32]uint32{
+				constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(): 5_000,
+			},
+			expectedFundingIndexDeltaStrings: []string{"1250"},
+			fundingRatesAndIndices: []indexerevents.FundingUpdateV1{
+				{
+					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
+					FundingValuePpm: 2_000,
+					FundingIndex:    dtypes.NewInt(1250),
+				},
+			},
+		},
+		"Success: 60 equivalent samples of int32 max, funding rate cap above funding rate clamp": {
+			testFundingSampleDuration: 60,
+			testFundingTickDuration:   3600,
+			testPerpetuals: []types.Perpetual{
+				constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+			},
+			// Premium sample = MaxInt32, length = 60.
+			testFundingSamples: constants.GenerateConstantFundingPremiums(math.MaxInt32, 60),
+			testFundingRateCapsPpm: map[uint32]uint32{
+				constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(): 2_000_000,
+			},
+			// 8-hr funding rate clamped at 1_500_000 before the cap is applied.
+			expectedFundingIndexDeltaStrings: []string{"937500"},
+			fundingRatesAndIndices: []indexerevents.FundingUpdateV1{
+				{
+					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
+					FundingValuePpm: 1_500_000,
+					FundingIndex:    dtypes.NewInt(937500),
+				},
+			},
+		},
 		"Success: 60 equivalent samples of 0.001 percent, 60 samples expected": {
 			testFundingSampleDuration: 60,
 			testFundingTickDuration:   3600,
@@ -1685,6 +1770,9 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 				require.NoError(t, err)
 				oldPerps[i] = perp
 			}
+			for perpetualId, capPpm := range tc.testFundingRateCapsPpm {
+				require.NoError(t, pc.PerpetualsKeeper.SetFundingRateCapPpm(pc.Ctx, perpetualId, capPpm))
+			}
 
 			// Create funding-tick epoch.
 			err := pc.EpochsKeeper.CreateEpochInfo(
@@ -1785,6 +1873,157 @@ func getFundingBlockEventsFromIndexerBlock(
 	return fundingEvents
 }
 
+func TestSetFundingRateCapPpm(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	perps := keepertest.CreateLiquidityTiersAndNPerpetuals(t, pc.Ctx, pc.PerpetualsKeeper, pc.PricesKeeper, 1)
+	perpetualId := perps[0].Params.Id
+
+	_, found := pc.PerpetualsKeeper.GetFundingRateCapPpm(pc.Ctx, perpetualId)
+	require.False(t, found)
+
+	require.NoError(t, pc.PerpetualsKeeper.SetFundingRateCapPpm(pc.Ctx, perpetualId, 1_000))
+	capPpm, found := pc.PerpetualsKeeper.GetFundingRateCapPpm(pc.Ctx, perpetualId)
+	require.True(t, found)
+	require.Equal(t, uint32(1_000), capPpm)
+
+	// A cap of zero removes the cap.
+	require.NoError(t, pc.PerpetualsKeeper.SetFundingRateCapPpm(pc.Ctx, perpetualId, 0))
+	_, found = pc.PerpetualsKeeper.GetFundingRateCapPpm(pc.Ctx, perpetualId)
+	require.False(t, found)
+
+	err := pc.PerpetualsKeeper.SetFundingRateCapPpm(pc.Ctx, perpetualId+1, 1_000)
+	require.ErrorIs(t, err, types.ErrPerpetualDoesNotExist)
+}
+
+func TestSetMaxUnsettledFundingIndexDelta(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	perps := keepertest.CreateLiquidityTiersAndNPerpetuals(t, pc.Ctx, pc.PerpetualsKeeper, pc.PricesKeeper, 1)
+	perpetualId := perps[0].Params.Id
+
+	require.Zero(t, pc.PerpetualsKeeper.GetMaxUnsettledFundingIndexDelta(pc.Ctx, perpetualId))
+
+	require.NoError(t, pc.PerpetualsKeeper.SetMaxUnsettledFundingIndexDelta(pc.Ctx, perpetualId, 10_000))
+	require.Equal(t, uint64(10_000), pc.PerpetualsKeeper.GetMaxUnsettledFundingIndexDelta(pc.Ctx, perpetualId))
+
+	// A cap of zero removes the cap.
+	require.NoError(t, pc.PerpetualsKeeper.SetMaxUnsettledFundingIndexDelta(pc.Ctx, perpetualId, 0))
+	require.Zero(t, pc.PerpetualsKeeper.GetMaxUnsettledFundingIndexDelta(pc.Ctx, perpetualId))
+
+	err := pc.PerpetualsKeeper.SetMaxUnsettledFundingIndexDelta(pc.Ctx, perpetualId+1, 10_000)
+	require.ErrorIs(t, err, types.ErrPerpetualDoesNotExist)
+}
+
+func TestSetConcentrationMargin(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	perps := keepertest.CreateLiquidityTiersAndNPerpetuals(t, pc.Ctx, pc.PerpetualsKeeper, pc.PricesKeeper, 1)
+	perpetualId := perps[0].Params.Id
+
+	require.Zero(t, pc.PerpetualsKeeper.GetConcentrationMargin(pc.Ctx, perpetualId))
+
+	concentrationMargin := types.ConcentrationMargin{ThresholdPpm: 200_000, AddOnPpm: 50_000}
+	require.NoError(t, pc.PerpetualsKeeper.SetConcentrationMargin(pc.Ctx, perpetualId, concentrationMargin))
+	require.Equal(t, concentrationMargin, pc.PerpetualsKeeper.GetConcentrationMargin(pc.Ctx, perpetualId))
+
+	// An add-on of zero removes the concentration margin.
+	require.NoError(t, pc.PerpetualsKeeper.SetConcentrationMargin(
+		pc.Ctx,
+		perpetualId,
+		types.ConcentrationMargin{ThresholdPpm: 200_000},
+	))
+	require.Zero(t, pc.PerpetualsKeeper.GetConcentrationMargin(pc.Ctx, perpetualId))
+
+	err := pc.PerpetualsKeeper.SetConcentrationMargin(pc.Ctx, perpetualId+1, concentrationMargin)
+	require.ErrorIs(t, err, types.ErrPerpetualDoesNotExist)
+}
+
+func TestAccrueOpenInterestImbalanceFee(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	perps := keepertest.CreateLiquidityTiersAndNPerpetuals(t, pc.Ctx, pc.PerpetualsKeeper, pc.PricesKeeper, 1)
+	perpetualId := perps[0].Params.Id
+
+	pool, err := pc.PerpetualsKeeper.GetOpenInterestImbalancePool(pc.Ctx, perpetualId)
+	require.NoError(t, err)
+	require.Zero(t, pool.Sign())
+
+	// Open interest changes by 1_000_000 base quantums.
+	imbalanceNotional, err := pc.PerpetualsKeeper.GetNetNotional(pc.Ctx, perpetualId, big.NewInt(1_000_000))
+	require.NoError(t, err)
+	expectedFee := lib.BigMulPpm(imbalanceNotional, big.NewInt(1_000), false)
+	require.Positive(t, expectedFee.Sign())
+
+	fee, err := pc.PerpetualsKeeper.AccrueOpenInterestImbalanceFee(
+		pc.Ctx,
+		perpetualId,
+		big.NewInt(1_000_000),
+		1_000,
+	)
+	require.NoError(t, err)
+	require.Equal(t, expectedFee, fee)
+	pool, err = pc.PerpetualsKeeper.GetOpenInterestImbalancePool(pc.Ctx, perpetualId)
+	require.NoError(t, err)
+	require.Equal(t, expectedFee, pool)
+
+	// Fees accumulate in the pool.
+	_, err = pc.PerpetualsKeeper.AccrueOpenInterestImbalanceFee(
+		pc.Ctx,
+		perpetualId,
+		big.NewInt(1_000_000),
+		1_000,
+	)
+	require.NoError(t, err)
+	pool, err = pc.PerpetualsKeeper.GetOpenInterestImbalancePool(pc.Ctx, perpetualId)
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Mul(expectedFee, big.NewInt(2)), pool)
+
+	// Decreases in open interest accrue the same fee as increases.
+	fee, err = pc.PerpetualsKeeper.AccrueOpenInterestImbalanceFee(
+		pc.Ctx,
+		perpetualId,
+		big.NewInt(-1_000_000),
+		1_000,
+	)
+	require.NoError(t, err)
+	require.Equal(t, expectedFee, fee)
+
+	// No imbalance accrues no fee.
+	fee, err = pc.PerpetualsKeeper.AccrueOpenInterestImbalanceFee(
+		pc.Ctx,
+		perpetualId,
+		big.NewInt(0),
+		1_000,
+	)
+	require.NoError(t, err)
+	require.Zero(t, fee.Sign())
+
+	_, err = pc.PerpetualsKeeper.GetOpenInterestImbalancePool(pc.Ctx, perpetualId+1)
+	require.ErrorIs(t, err, types.ErrPerpetualDoesNotExist)
+	_, err = pc.PerpetualsKeeper.AccrueOpenInterestImbalanceFee(
+		pc.Ctx,
+		perpetualId+1,
+		big.NewInt(1_000_000),
+		1_000,
+	)
+	require.ErrorIs(t, err, types.ErrPerpetualDoesNotExist)
+}
+
+func TestSetSettlementPrice(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	perps := keepertest.CreateLiquidityTiersAndNPerpetuals(t, pc.Ctx, pc.PerpetualsKeeper, pc.PricesKeeper, 1)
+	perpetualId := perps[0].Params.Id
+
+	require.Zero(t, pc.PerpetualsKeeper.GetSettlementPrice(pc.Ctx, perpetualId))
+
+	require.NoError(t, pc.PerpetualsKeeper.SetSettlementPrice(pc.Ctx, perpetualId, 4_000_000_000))
+	require.Equal(t, uint64(4_000_000_000), pc.PerpetualsKeeper.GetSettlementPrice(pc.Ctx, perpetualId))
+
+	// A settlement price of zero takes the perpetual out of settlement mode.
+	require.NoError(t, pc.PerpetualsKeeper.SetSettlementPrice(pc.Ctx, perpetualId, 0))
+	require.Zero(t, pc.PerpetualsKeeper.GetSettlementPrice(pc.Ctx, perpetualId))
+
+	err := pc.PerpetualsKeeper.SetSettlementPrice(pc.Ctx, perpetualId+1, 4_000_000_000)
+	require.ErrorIs(t, err, types.ErrPerpetualDoesNotExist)
+}
+
 func TestMaybeProcessNewFundingTickEpoch_Failure(t *testing.T) {
 	tests := map[string]struct {
 		testEpochs         []epochstypes.EpochInfo
@@ -2615,6 +2854,46 @@ func TestGetAllLiquidityTiers_Sorted(t *testing.T) {
 	)
 }
 
+func TestGetPerpetualsByLiquidityTier(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+	lts := pc.PerpetualsKeeper.GetAllLiquidityTiers(pc.Ctx)
+	require.Greater(t, len(lts), 2)
+
+	// Perpetuals are assigned to liquidity tiers round-robin, so the first liquidity tier is shared by
+	// the first and the last perpetual while every other liquidity tier is used by a single perpetual.
+	numPerpetuals := len(lts) + 1
+	_, err := keepertest.CreateNPerpetuals(t, pc.Ctx, pc.PerpetualsKeeper, pc.PricesKeeper, numPerpetuals)
+	require.NoError(t, err)
+
+	require.Equal(
+		t,
+		[]uint32{0, uint32(numPerpetuals - 1)},
+		pc.PerpetualsKeeper.GetPerpetualsByLiquidityTier(pc.Ctx, lts[0].Id),
+	)
+	require.Equal(t, []uint32{1}, pc.PerpetualsKeeper.GetPerpetualsByLiquidityTier(pc.Ctx, lts[1].Id))
+	require.Empty(t, pc.PerpetualsKeeper.GetPerpetualsByLiquidityTier(pc.Ctx, lts[len(lts)-1].Id+1))
+
+	// Moving a perpetual to another liquidity tier removes it from its previous liquidity tier.
+	perpetual, err := pc.PerpetualsKeeper.GetPerpetual(pc.Ctx, 0)
+	require.NoError(t, err)
+	_, err = pc.PerpetualsKeeper.ModifyPerpetual(
+		pc.Ctx,
+		perpetual.Params.Id,
+		perpetual.Params.Ticker,
+		perpetual.Params.MarketId,
+		perpetual.Params.DefaultFundingPpm,
+		lts[1].Id,
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]uint32{uint32(numPerpetuals - 1)},
+		pc.PerpetualsKeeper.GetPerpetualsByLiquidityTier(pc.Ctx, lts[0].Id),
+	)
+	require.Equal(t, []uint32{0, 1}, pc.PerpetualsKeeper.GetPerpetualsByLiquidityTier(pc.Ctx, lts[1].Id))
+}
+
 func TestHasLiquidityTier(t *testing.T) {
 	// Setup context and keepers
 	pc := keepertest.PerpetualsKeepers(t)
@@ -3083,3 +3362,235 @@ func TestAcquireNextPerpetualID(t *testing.T) {
 	nextPerpetualId = pc.PerpetualsKeeper.AcquireNextPerpetualID(pc.Ctx)
 	require.Equal(t, nextPerpetualIdFromStore+1, nextPerpetualId)
 }
+
+// processFundingTickEpochs starts `numEpochs` hourly funding-tick epochs, ten blocks apart, and processes
+// each of them, starting with epoch 1 at block 10.
+func processFundingTickEpochs(t *testing.T, pc keepertest.PerpKeepersTestContext, numEpochs uint32) {
+	for _, epochInfo := range []epochstypes.EpochInfo{
+		{
+			Name:          string(epochstypes.FundingTickEpochInfoName),
+			Duration:      3600,
+			NextTick:      3600,
+			IsInitialized: true,
+		},
+		{
+			Name:     string(epochstypes.FundingSampleEpochInfoName),
+			Duration: 60,
+		},
+	} {
+		require.NoError(t, pc.EpochsKeeper.CreateEpochInfo(pc.Ctx, epochInfo))
+	}
+
+	for epoch := uint32(1); epoch <= numEpochs; epoch++ {
+		ctx := pc.Ctx.WithBlockHeight(int64(10 * epoch)).WithBlockTime(time.Unix(int64(3600*epoch), 0))
+		started, err := pc.EpochsKeeper.MaybeStartNextEpoch(ctx, epochstypes.FundingTickEpochInfoName)
+		require.NoError(t, err)
+		require.True(t, started)
+		pc.PerpetualsKeeper.MaybeProcessNewFundingTickEpoch(ctx)
+	}
+}
+
+func TestGetHistoricalFundingIndex(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+	// With no premium samples, the funding index decreases by 625 every funding-tick epoch.
+	p := constants.BtcUsd_NegativeDefaultFunding_10AtomicResolution
+	_, err := pc.PerpetualsKeeper.CreatePerpetual(
+		pc.Ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.MarketType,
+	)
+	require.NoError(t, err)
+
+	// The oldest 5 epochs are overwritten in the ring buffer.
+	processFundingTickEpochs(t, pc, types.FundingIndexSnapshotRetentionEpochs+5)
+
+	tests := map[string]struct {
+		height uint32
+
+		expectedSnapshot types.FundingIndexSnapshot
+		expectedErr      error
+	}{
+		"height of a funding tick": {
+			height: 300,
+			expectedSnapshot: types.FundingIndexSnapshot{
+				BlockHeight:  300,
+				FundingIndex: dtypes.NewInt(-625 * 30),
+			},
+		},
+		"height between funding ticks": {
+			height: 305,
+			expectedSnapshot: types.FundingIndexSnapshot{
+				BlockHeight:  300,
+				FundingIndex: dtypes.NewInt(-625 * 30),
+			},
+		},
+		"oldest retained height": {
+			height: 60,
+			expectedSnapshot: types.FundingIndexSnapshot{
+				BlockHeight:  60,
+				FundingIndex: dtypes.NewInt(-625 * 6),
+			},
+		},
+		"latest funding tick": {
+			height: 10 * (types.FundingIndexSnapshotRetentionEpochs + 5),
+			expectedSnapshot: types.FundingIndexSnapshot{
+				BlockHeight:  10 * (types.FundingIndexSnapshotRetentionEpochs + 5),
+				FundingIndex: dtypes.NewInt(-625 * int64(types.FundingIndexSnapshotRetentionEpochs+5)),
+			},
+		},
+		"height past the retention window": {
+			height:      59,
+			expectedErr: types.ErrFundingIndexSnapshotNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			snapshot, err := pc.PerpetualsKeeper.GetHistoricalFundingIndex(pc.Ctx, p.Params.Id, tc.height)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedSnapshot, snapshot)
+			}
+		})
+	}
+
+	_, err = pc.PerpetualsKeeper.GetHistoricalFundingIndex(pc.Ctx, p.Params.Id+1, 300)
+	require.ErrorIs(t, err, types.ErrPerpetualDoesNotExist)
+}
+
+func TestGetHistoricalMarginFractions(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+	p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+	_, err := pc.PerpetualsKeeper.CreatePerpetual(
+		pc.Ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.MarketType,
+	)
+	require.NoError(t, err)
+
+	for _, epochInfo := range []epochstypes.EpochInfo{
+		{
+			Name:          string(epochstypes.FundingTickEpochInfoName),
+			Duration:      3600,
+			NextTick:      3600,
+			IsInitialized: true,
+		},
+		{
+			Name:     string(epochstypes.FundingSampleEpochInfoName),
+			Duration: 60,
+		},
+	} {
+		require.NoError(t, pc.EpochsKeeper.CreateEpochInfo(pc.Ctx, epochInfo))
+	}
+
+	for epoch := uint32(1); epoch <= 3; epoch++ {
+		if epoch == 2 {
+			// Scale the initial margin fractions by open interest between $25,000 and $75,000, set short
+			// margin fractions, and open 1 BTC ($50,000) of open interest.
+			require.NoError(t, pc.PerpetualsKeeper.ValidateAndSetLiquidityTier(pc.Ctx, types.LiquidityTier{
+				Id:                          p.Params.LiquidityTier,
+				Name:                        "3",
+				InitialMarginPpm:            200_000,        // 20% initial margin
+				MaintenanceFractionPpm:      500_000,        // 50% maintenance fraction
+				ImpactNotional:              2_500_000_000,  // impact notional
+				OpenInterestLowerCap:        25_000_000_000, // $25,000 open interest lower cap
+				OpenInterestUpperCap:        75_000_000_000, // $75,000 open interest upper cap
+				ShortInitialMarginPpm:       300_000,        // 30% short initial margin
+				ShortMaintenanceFractionPpm: 500_000,        // 50% short maintenance fraction
+			}))
+			require.NoError(t, pc.PerpetualsKeeper.ModifyOpenInterest(pc.Ctx, p.Params.Id, big.NewInt(100_000_000)))
+		}
+
+		ctx := pc.Ctx.WithBlockHeight(int64(10 * epoch)).WithBlockTime(time.Unix(int64(3600*epoch), 0))
+		started, err := pc.EpochsKeeper.MaybeStartNextEpoch(ctx, epochstypes.FundingTickEpochInfoName)
+		require.NoError(t, err)
+		require.True(t, started)
+		pc.PerpetualsKeeper.MaybeProcessNewFundingTickEpoch(ctx)
+	}
+
+	tests := map[string]struct {
+		epoch uint32
+
+		expectedFractions types.MarginFractions
+		expectedErr       error
+	}{
+		"epoch without open interest scaling": {
+			epoch: 1,
+			expectedFractions: types.MarginFractions{
+				Epoch:                     1,
+				InitialMarginPpm:          200_000,
+				MaintenanceMarginPpm:      100_000,
+				ShortInitialMarginPpm:     200_000,
+				ShortMaintenanceMarginPpm: 100_000,
+			},
+		},
+		"epoch with open interest scaling": {
+			epoch: 2,
+			expectedFractions: types.MarginFractions{
+				Epoch: 2,
+				// Open interest is halfway between the caps, so the initial margin fractions are halfway to 100%.
+				InitialMarginPpm:          600_000,
+				MaintenanceMarginPpm:      100_000,
+				ShortInitialMarginPpm:     650_000,
+				ShortMaintenanceMarginPpm: 150_000,
+			},
+		},
+		"latest epoch": {
+			epoch: 3,
+			expectedFractions: types.MarginFractions{
+				Epoch:                     3,
+				InitialMarginPpm:          600_000,
+				MaintenanceMarginPpm:      100_000,
+				ShortInitialMarginPpm:     650_000,
+				ShortMaintenanceMarginPpm: 150_000,
+			},
+		},
+		"future epoch": {
+			epoch:       4,
+			expectedErr: types.ErrMarginFractionsNotFound,
+		},
+		"epoch sharing a ring buffer slot with a retained epoch": {
+			epoch:       2 + types.MarginFractionsRetentionEpochs,
+			expectedErr: types.ErrMarginFractionsNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			fractions, err := pc.PerpetualsKeeper.GetHistoricalMarginFractions(pc.Ctx, p.Params.Id, tc.epoch)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedFractions, fractions)
+			}
+		})
+	}
+
+	_, err = pc.PerpetualsKeeper.GetHistoricalMarginFractions(pc.Ctx, p.Params.Id+1, 1)
+	require.ErrorIs(t, err, types.ErrPerpetualDoesNotExist)
+
+	// Margin fractions that cannot be decoded return an error.
+	prefix.NewStore(
+		pc.Ctx.KVStore(pc.StoreKey),
+		append([]byte(types.MarginFractionsKeyPrefix), lib.Uint32ToKey(p.Params.Id)...),
+	).Set(lib.Uint32ToKey(3%types.MarginFractionsRetentionEpochs), []byte{0xff})
+	_, err = pc.PerpetualsKeeper.GetHistoricalMarginFractions(pc.Ctx, p.Params.Id, 3)
+	require.ErrorIs(t, err, types.ErrInvalidMarginFractionsEncoding)
+}
@@ -89,7 +89,8 @@ func GetNetCollateralAndMarginRequirements(
 // represented by the following equation:
 //
 // `quantums / 10^baseAtomicResolution * marketPrice * 10^marketExponent * 10^quoteAtomicResolution`.
-// Note that longs are positive, and shorts are negative.
+// Note that longs are positive, and shorts are negative, unless the market price is negative, in
+// which case the sign is inverted.
 func GetNetNotionalInQuoteQuantums(
 	perpetual types.Perpetual,
 	marketPrice pricestypes.MarketPrice,
@@ -103,12 +104,16 @@ func GetNetNotionalInQuoteQuantums(
 		marketPrice.Price,
 		marketPrice.Exponent,
 	)
+	if marketPrice.Negative {
+		bigQuoteQuantums.Neg(bigQuoteQuantums)
+	}
 
 	return bigQuoteQuantums
 }
 
 // GetMarginRequirementsInQuoteQuantums returns initial and maintenance margin requirements
-// in quote quantums, given the position size in base quantums.
+// in quote quantums, given the position size in base quantums. The margin fractions of the
+// liquidity tier for the side of the position are used.
 func GetMarginRequirementsInQuoteQuantums(
 	perpetual types.Perpetual,
 	marketPrice pricestypes.MarketPrice,
@@ -118,6 +123,9 @@ func GetMarginRequirementsInQuoteQuantums(
 	bigInitialMarginQuoteQuantums *big.Int,
 	bigMaintenanceMarginQuoteQuantums *big.Int,
 ) {
+	// Short positions may use different margin fractions than long positions.
+	liquidityTier = liquidityTier.ForPositionSide(bigQuantums.Sign() >= 0)
+
 	// Always consider the magnitude of the position regardless of whether it is long/short.
 	bigAbsQuantums := new(big.Int).Abs(bigQuantums)
 
@@ -135,7 +143,138 @@ func GetMarginRequirementsInQuoteQuantums(
 		marketPrice.Price,
 		marketPrice.Exponent,
 	)
+	return getMarginRequirementsForNotional(liquidityTier, bigQuoteQuantums, openInterestQuoteQuantums)
+}
+
+// GetInversePositionNetNotionalValueAndMarginRequirements returns the net collateral, initial margin
+// requirement, and maintenance margin requirement in quote quantums of a position in an inverse
+// perpetual, given the position size in quantums. The market price must be non-zero.
+func GetInversePositionNetNotionalValueAndMarginRequirements(
+	perpetual types.Perpetual,
+	marketPrice pricestypes.MarketPrice,
+	liquidityTier types.LiquidityTier,
+	quantums *big.Int,
+) (
+	risk margin.Risk,
+) {
+	nc := GetInverseNetNotionalInQuoteQuantums(
+		perpetual,
+		marketPrice,
+		quantums,
+	)
+
+	// Short positions may use different margin fractions than long positions.
+	liquidityTier = liquidityTier.ForPositionSide(quantums.Sign() >= 0)
+	openInterestQuoteQuantums := GetInverseNetNotionalInQuoteQuantums(
+		perpetual,
+		marketPrice,
+		perpetual.OpenInterest.BigInt(),
+	)
+	imr, mmr := getMarginRequirementsForNotional(
+		liquidityTier,
+		new(big.Int).Abs(nc),
+		openInterestQuoteQuantums.Abs(openInterestQuoteQuantums),
+	)
+	return margin.Risk{
+		NC:  nc,
+		IMR: imr,
+		MMR: mmr,
+	}
+}
+
+// GetInverseNetNotionalInQuoteQuantums returns the net notional in quote quantums of a position in an
+// inverse perpetual, which can be represented by the following equation:
+//
+// `quantums * 10^quoteAtomicResolution / 10^baseAtomicResolution / (marketPrice * 10^marketExponent)`.
+// Note that longs are positive, and shorts are negative, unless the market price is negative, in
+// which case the sign is inverted. The result is rounded towards zero and the market price must be
+// non-zero.
+func GetInverseNetNotionalInQuoteQuantums(
+	perpetual types.Perpetual,
+	marketPrice pricestypes.MarketPrice,
+	bigQuantums *big.Int,
+) (
+	bigNetNotionalQuoteQuantums *big.Int,
+) {
+	bigQuoteQuantums := lib.QuoteToBaseQuantums(
+		bigQuantums,
+		perpetual.Params.AtomicResolution,
+		marketPrice.Price,
+		marketPrice.Exponent,
+	)
+	if marketPrice.Negative {
+		bigQuoteQuantums.Neg(bigQuoteQuantums)
+	}
+
+	return bigQuoteQuantums
+}
+
+// ComputeLiquidationPenalty returns the liquidation penalty in quote quantums of an account with the given
+// risk, which is `liquidityTier.LiquidationPenaltyPpm` parts-per-million of the account's maintenance margin
+// deficit `MMR - NC`, rounded down. The penalty grows as the net collateral of the account falls below its
+// maintenance margin requirement, but never exceeds the remaining net collateral, so accounts at or below
+// bankruptcy are not penalized. Returns zero if the account is not liquidatable. The clob module charges
+// the penalty instead of its max liquidation fee when `liquidityTier.LiquidationPenaltyPpm` is non-zero.
+func ComputeLiquidationPenalty(
+	risk margin.Risk,
+	liquidityTier types.LiquidityTier,
+) (
+	bigPenaltyQuoteQuantums *big.Int,
+) {
+	if !risk.IsLiquidatable() || risk.NC.Sign() <= 0 {
+		return new(big.Int)
+	}
 
+	deficit := new(big.Int).Sub(risk.MMR, risk.NC)
+	penalty := lib.BigMulPpm(deficit, lib.BigU(liquidityTier.LiquidationPenaltyPpm), false)
+	return lib.BigMin(penalty, risk.NC)
+}
+
+// ComputeInsuranceFundDelta returns the signed contribution in quote quantums of a liquidation fill to
+// the insurance fund. Positive if the insurance fund gains and negative if it pays. `fillPrice` and
+// `bankruptcyPrice` are in quote quantums per base quantum, and `bigQuantums` is the signed size of the
+// liquidated position that is closed by the fill, i.e. positive when a long is sold.
+//
+// A fill better than the bankruptcy price leaves a surplus of `(fillPrice - bankruptcyPrice) * quantums`,
+// rounded down. The surplus is split between the insurance fund, which receives at most
+// `maxLiquidationFeePpm` parts-per-million of the absolute fill notional, rounded down, as the liquidation
+// fee, and the liquidated account, which keeps the rest. `maxLiquidationFeePpm` is the `MaxLiquidationFeePpm`
+// of the liquidations config of the clob module. A fill worse than the bankruptcy price leaves a deficit
+// that the insurance fund covers in full, rounded up.
+func ComputeInsuranceFundDelta(
+	fillPrice *big.Rat,
+	bankruptcyPrice *big.Rat,
+	bigQuantums *big.Int,
+	maxLiquidationFeePpm uint32,
+) (
+	bigDeltaQuoteQuantums *big.Int,
+) {
+	quantums := new(big.Rat).SetInt(bigQuantums)
+	surplus := new(big.Rat).Sub(fillPrice, bankruptcyPrice)
+	surplus.Mul(surplus, quantums)
+	if surplus.Sign() <= 0 {
+		return lib.BigRatRound(surplus, false)
+	}
+
+	notional := new(big.Rat).Mul(fillPrice, quantums)
+	maxFee := lib.BigRatRound(
+		lib.BigRatMulPpm(notional.Abs(notional), maxLiquidationFeePpm),
+		false,
+	)
+	return lib.BigMin(maxFee, lib.BigRatRound(surplus, false))
+}
+
+// getMarginRequirementsForNotional returns initial and maintenance margin requirements in quote
+// quantums, given the absolute notional of a position and the open interest of its perpetual in quote
+// quantums.
+func getMarginRequirementsForNotional(
+	liquidityTier types.LiquidityTier,
+	bigQuoteQuantums *big.Int,
+	openInterestQuoteQuantums *big.Int,
+) (
+	bigInitialMarginQuoteQuantums *big.Int,
+	bigMaintenanceMarginQuoteQuantums *big.Int,
+) {
 	// Initial margin requirement quote quantums = size in quote quantums * initial margin PPM.
 	bigBaseInitialMarginQuoteQuantums := liquidityTier.GetInitialMarginQuoteQuantums(
 		bigQuoteQuantums,
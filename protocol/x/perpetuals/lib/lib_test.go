@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
 	big_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/big"
 	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/lib"
@@ -279,6 +280,26 @@ func TestGetNetNotionalInQuoteQuantums(t *testing.T) {
 			quantums:            big.NewInt(-1_000_000_000_000),
 			expectedNetNotional: big.NewInt(-123_456_789),
 		},
+		"positive quantums with a negative market price": {
+			perpetual: testPerpetual,
+			marketPrice: pricestypes.MarketPrice{
+				Price:    123_456_789_123,
+				Exponent: -5,
+				Negative: true,
+			},
+			quantums:            big.NewInt(1_000_000_000_000),
+			expectedNetNotional: big.NewInt(-123_456_789),
+		},
+		"negative quantums with a negative market price": {
+			perpetual: testPerpetual,
+			marketPrice: pricestypes.MarketPrice{
+				Price:    123_456_789_123,
+				Exponent: -5,
+				Negative: true,
+			},
+			quantums:            big.NewInt(-1_000_000_000_000),
+			expectedNetNotional: big.NewInt(123_456_789),
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -391,6 +412,44 @@ func TestGetMarginRequirementsInQuoteQuantums(t *testing.T) {
 			expectedImr: big_testutil.MustFirst(new(big.Int).SetString("12345678912300000", 10)),
 			expectedMmr: big_testutil.MustFirst(new(big.Int).SetString("1234567891230000", 10)),
 		},
+		"positive quantums, asymmetric liquidity tier": {
+			perpetual:   testPerpetual,
+			marketPrice: testMarketPrice,
+			liquidityTier: types.LiquidityTier{
+				InitialMarginPpm:            200_000,
+				MaintenanceFractionPpm:      500_000,
+				ShortInitialMarginPpm:       300_000,
+				ShortMaintenanceFractionPpm: 600_000,
+			},
+			quantums:    big.NewInt(1),
+			expectedImr: big_testutil.MustFirst(new(big.Int).SetString("2469135782460000", 10)),
+			expectedMmr: big_testutil.MustFirst(new(big.Int).SetString("1234567891230000", 10)),
+		},
+		"negative quantums, asymmetric liquidity tier": {
+			perpetual:   testPerpetual,
+			marketPrice: testMarketPrice,
+			liquidityTier: types.LiquidityTier{
+				InitialMarginPpm:            200_000,
+				MaintenanceFractionPpm:      500_000,
+				ShortInitialMarginPpm:       300_000,
+				ShortMaintenanceFractionPpm: 600_000,
+			},
+			quantums:    big.NewInt(-1),
+			expectedImr: big_testutil.MustFirst(new(big.Int).SetString("3703703673690000", 10)),
+			expectedMmr: big_testutil.MustFirst(new(big.Int).SetString("2222222204214000", 10)),
+		},
+		"negative quantums, only short initial margin set": {
+			perpetual:   testPerpetual,
+			marketPrice: testMarketPrice,
+			liquidityTier: types.LiquidityTier{
+				InitialMarginPpm:       200_000,
+				MaintenanceFractionPpm: 500_000,
+				ShortInitialMarginPpm:  300_000,
+			},
+			quantums:    big.NewInt(-1),
+			expectedImr: big_testutil.MustFirst(new(big.Int).SetString("3703703673690000", 10)),
+			expectedMmr: big_testutil.MustFirst(new(big.Int).SetString("1851851836845000", 10)),
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -557,9 +616,9 @@ func TestGetMarginRequirementsInQuoteQuantums_2(t *testing.T) {
 			openInterestUpperCap:         20_000_000_000_000,
 			// openInterestNotional = 408_163_265 * 36_750 = 14_999_999_988_750
 			// percentageOfCap = (openInterestNotional - lowerCap) / (upperCap - lowerCap) = 0.499999998875
-			// adjustedIMF = (0.499999998875) * 0.8 + 0.2 = 0.5999999991 (rounded is 599_999 ppm)
-			// bigExpectedInitialMargin = bigBaseQuantums * price * adjustedIMF = 264_599_559
-			bigExpectedInitialMargin:     big.NewInt(264_599_559),
+			// adjustedIMF = (0.499999998875) * 0.8 + 0.2 = 0.5999999991 (rounded up to 600_000 ppm)
+			// bigExpectedInitialMargin = bigBaseQuantums * price * adjustedIMF = 264_600_000
+			bigExpectedInitialMargin:     big.NewInt(264_600_000),
 			bigExpectedMaintenanceMargin: big.NewInt(88_200_000 / 2),
 		},
 		"OIMF: IM 20%, scaled to 100%, MaintenanceMargin 10%, atomic resolution 6": {
@@ -590,9 +649,9 @@ func TestGetMarginRequirementsInQuoteQuantums_2(t *testing.T) {
 			openInterestUpperCap:         50_000_000_000_000,
 			// openInterestNotional = 1_123_456_789 * 36_750 = 41_287_036_995_750
 			// percentageOfCap = (openInterestNotional - lowerCap) / (upperCap - lowerCap) = 0.65148147983
-			// adjustedIMF = (0.65148147983) * 0.8 + 0.2 = 0.721185183864 (rounded is 721_185 ppm)
-			// bigExpectedInitialMargin = bigBaseQuantums * price * adjustedIMF = 318_042_585
-			bigExpectedInitialMargin:     big.NewInt(318_042_585),
+			// adjustedIMF = (0.65148147983) * 0.8 + 0.2 = 0.721185183864 (rounded up to 721_186 ppm)
+			// bigExpectedInitialMargin = bigBaseQuantums * price * adjustedIMF = 318_043_026
+			bigExpectedInitialMargin:     big.NewInt(318_043_026),
 			bigExpectedMaintenanceMargin: big.NewInt(88_200_000 / 2),
 		},
 	}
@@ -688,3 +747,189 @@ func TestGetMarginRequirementsInQuoteQuantums_2(t *testing.T) {
 		})
 	}
 }
+
+func TestComputeLiquidationPenalty(t *testing.T) {
+	tests := map[string]struct {
+		nc                    int64
+		mmr                   int64
+		liquidationPenaltyPpm uint32
+
+		expectedPenalty *big.Int
+	}{
+		"healthy": {
+			nc:                    1_500,
+			mmr:                   1_000,
+			liquidationPenaltyPpm: 500_000,
+			expectedPenalty:       big.NewInt(0),
+		},
+		"at maintenance margin": {
+			nc:                    1_000,
+			mmr:                   1_000,
+			liquidationPenaltyPpm: 500_000,
+			expectedPenalty:       big.NewInt(0),
+		},
+		"just below maintenance margin, rounds down": {
+			nc:                    999,
+			mmr:                   1_000,
+			liquidationPenaltyPpm: 500_000,
+			expectedPenalty:       big.NewInt(0),
+		},
+		"just below maintenance margin": {
+			nc:                    998,
+			mmr:                   1_000,
+			liquidationPenaltyPpm: 500_000,
+			expectedPenalty:       big.NewInt(1),
+		},
+		"halfway to bankruptcy": {
+			nc:                    500,
+			mmr:                   1_000,
+			liquidationPenaltyPpm: 500_000,
+			expectedPenalty:       big.NewInt(250),
+		},
+		"capped at net collateral": {
+			nc:                    300,
+			mmr:                   1_000,
+			liquidationPenaltyPpm: 500_000,
+			expectedPenalty:       big.NewInt(300),
+		},
+		"near bankruptcy": {
+			nc:                    10,
+			mmr:                   1_000,
+			liquidationPenaltyPpm: 500_000,
+			expectedPenalty:       big.NewInt(10),
+		},
+		"bankrupt": {
+			nc:                    0,
+			mmr:                   1_000,
+			liquidationPenaltyPpm: 500_000,
+			expectedPenalty:       big.NewInt(0),
+		},
+		"negative net collateral": {
+			nc:                    -100,
+			mmr:                   1_000,
+			liquidationPenaltyPpm: 500_000,
+			expectedPenalty:       big.NewInt(0),
+		},
+		"no maintenance margin requirement": {
+			nc:                    -100,
+			mmr:                   0,
+			liquidationPenaltyPpm: 500_000,
+			expectedPenalty:       big.NewInt(0),
+		},
+		"no penalty": {
+			nc:                    500,
+			mmr:                   1_000,
+			liquidationPenaltyPpm: 0,
+			expectedPenalty:       big.NewInt(0),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			risk := margin.Risk{
+				NC:  big.NewInt(tc.nc),
+				IMR: big.NewInt(2 * tc.mmr),
+				MMR: big.NewInt(tc.mmr),
+			}
+			penalty := lib.ComputeLiquidationPenalty(
+				risk,
+				types.LiquidityTier{LiquidationPenaltyPpm: tc.liquidationPenaltyPpm},
+			)
+			require.Equal(t, tc.expectedPenalty, penalty)
+		})
+	}
+}
+
+func TestComputeInsuranceFundDelta(t *testing.T) {
+	tests := map[string]struct {
+		fillPrice            *big.Rat
+		bankruptcyPrice      *big.Rat
+		quantums             int64
+		maxLiquidationFeePpm uint32
+
+		expectedDelta *big.Int
+	}{
+		"surplus of a long below the liquidation fee": {
+			// Surplus of (50 - 49) * 100 = 100, fee of 5% of 5,000 = 250.
+			fillPrice:            big.NewRat(50, 1),
+			bankruptcyPrice:      big.NewRat(49, 1),
+			quantums:             100,
+			maxLiquidationFeePpm: 50_000,
+			expectedDelta:        big.NewInt(100),
+		},
+		"surplus of a long capped at the liquidation fee": {
+			// Surplus of (50 - 40) * 100 = 1,000, fee of 5% of 5,000 = 250.
+			fillPrice:            big.NewRat(50, 1),
+			bankruptcyPrice:      big.NewRat(40, 1),
+			quantums:             100,
+			maxLiquidationFeePpm: 50_000,
+			expectedDelta:        big.NewInt(250),
+		},
+		"surplus of a short capped at the liquidation fee": {
+			// Surplus of (50 - 60) * -100 = 1,000, fee of 5% of 5,000 = 250.
+			fillPrice:            big.NewRat(50, 1),
+			bankruptcyPrice:      big.NewRat(60, 1),
+			quantums:             -100,
+			maxLiquidationFeePpm: 50_000,
+			expectedDelta:        big.NewInt(250),
+		},
+		"surplus rounds down": {
+			// Surplus of (50 - 49.995) * 100 = 0.5.
+			fillPrice:            big.NewRat(50, 1),
+			bankruptcyPrice:      big.NewRat(9_999, 200),
+			quantums:             100,
+			maxLiquidationFeePpm: 50_000,
+			expectedDelta:        big.NewInt(0),
+		},
+		"surplus without a liquidation fee": {
+			fillPrice:            big.NewRat(50, 1),
+			bankruptcyPrice:      big.NewRat(40, 1),
+			quantums:             100,
+			maxLiquidationFeePpm: 0,
+			expectedDelta:        big.NewInt(0),
+		},
+		"fill at the bankruptcy price": {
+			fillPrice:            big.NewRat(50, 1),
+			bankruptcyPrice:      big.NewRat(50, 1),
+			quantums:             100,
+			maxLiquidationFeePpm: 50_000,
+			expectedDelta:        big.NewInt(0),
+		},
+		"deficit of a long": {
+			// Deficit of (45 - 50) * 100 = -500, covered in full.
+			fillPrice:            big.NewRat(45, 1),
+			bankruptcyPrice:      big.NewRat(50, 1),
+			quantums:             100,
+			maxLiquidationFeePpm: 50_000,
+			expectedDelta:        big.NewInt(-500),
+		},
+		"deficit of a short": {
+			// Deficit of (55 - 50) * -100 = -500, covered in full.
+			fillPrice:            big.NewRat(55, 1),
+			bankruptcyPrice:      big.NewRat(50, 1),
+			quantums:             -100,
+			maxLiquidationFeePpm: 50_000,
+			expectedDelta:        big.NewInt(-500),
+		},
+		"deficit rounds up": {
+			// Deficit of (49.995 - 50) * 100 = -0.5.
+			fillPrice:            big.NewRat(9_999, 200),
+			bankruptcyPrice:      big.NewRat(50, 1),
+			quantums:             100,
+			maxLiquidationFeePpm: 50_000,
+			expectedDelta:        big.NewInt(-1),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			delta := lib.ComputeInsuranceFundDelta(
+				tc.fillPrice,
+				tc.bankruptcyPrice,
+				big.NewInt(tc.quantums),
+				tc.maxLiquidationFeePpm,
+			)
+			require.Zero(t, tc.expectedDelta.Cmp(delta), "expected %s, got %s", tc.expectedDelta, delta)
+		})
+	}
+}
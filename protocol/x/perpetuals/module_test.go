@@ -90,7 +90,7 @@ func TestAppModuleBasic_RegisterInterfaces(t *testing.T) {
 	// due to it using an unexported method on the interface thus we use reflection to access the field
 	// directly that contains the registrations.
 	fv := reflect.ValueOf(registry).Elem().FieldByName("implInterfaces")
-	require.Len(t, fv.MapKeys(), 10)
+	require.Len(t, fv.MapKeys(), 18)
 }
 
 func TestAppModuleBasic_DefaultGenesis(t *testing.T) {
@@ -104,7 +104,8 @@ func TestAppModuleBasic_DefaultGenesis(t *testing.T) {
 	require.Equal(
 		t,
 		`{"perpetuals":[],"liquidity_tiers":[],"params":{"funding_rate_clamp_factor_ppm":6000000,`+
-			`"premium_vote_clamp_factor_ppm":60000000,"min_num_votes_per_sample":15}}`,
+			`"premium_vote_clamp_factor_ppm":60000000,"min_num_votes_per_sample":15,`+
+			`"open_interest_imbalance_fee_ppm":0}}`,
 		string(json),
 	)
 }
@@ -211,13 +212,14 @@ func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
 
 	cmd := am.GetQueryCmd()
 	require.Equal(t, "perpetuals", cmd.Use)
-	require.Equal(t, 6, len(cmd.Commands()))
+	require.Equal(t, 7, len(cmd.Commands()))
 	require.Equal(t, "get-all-liquidity-tiers", cmd.Commands()[0].Name())
-	require.Equal(t, "get-params", cmd.Commands()[1].Name())
-	require.Equal(t, "get-premium-samples", cmd.Commands()[2].Name())
-	require.Equal(t, "get-premium-votes", cmd.Commands()[3].Name())
-	require.Equal(t, "list-perpetual", cmd.Commands()[4].Name())
-	require.Equal(t, "show-perpetual", cmd.Commands()[5].Name())
+	require.Equal(t, "get-historical-margin-fractions", cmd.Commands()[1].Name())
+	require.Equal(t, "get-params", cmd.Commands()[2].Name())
+	require.Equal(t, "get-premium-samples", cmd.Commands()[3].Name())
+	require.Equal(t, "get-premium-votes", cmd.Commands()[4].Name())
+	require.Equal(t, "list-perpetual", cmd.Commands()[5].Name())
+	require.Equal(t, "show-perpetual", cmd.Commands()[6].Name())
 }
 
 func TestAppModule_Name(t *testing.T) {
@@ -317,10 +319,15 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 				 "atomic_resolution":0,
 				 "default_funding_ppm":0,
 				 "liquidity_tier":0,
-				 "market_type":"PERPETUAL_MARKET_TYPE_CROSS"
+				 "market_type":"PERPETUAL_MARKET_TYPE_CROSS",
+				 "perpetual_type":0
 			  },
 			  "funding_index":"0",
-			  "open_interest":"0"
+			  "open_interest":"0",
+			  "max_unsettled_funding_index_delta":"0",
+			  "settlement_price":"0",
+			  "concentration_threshold_ppm":0,
+			  "concentration_add_on_ppm":0
 		   }
 		],
 		"liquidity_tiers":[
@@ -332,13 +339,18 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 			  "base_position_notional":"0",
 			  "impact_notional":"10000000000",
 			  "open_interest_lower_cap":"25000000000000",
-			  "open_interest_upper_cap":"50000000000000"
+			  "open_interest_upper_cap":"50000000000000",
+			  "short_initial_margin_ppm":0,
+			  "short_maintenance_fraction_ppm":0,
+			  "max_position_notional_quote_quantums":"0",
+			  "liquidation_penalty_ppm":0
 		   }
 		],
 		"params":{
 		   "funding_rate_clamp_factor_ppm":6000000,
 		   "premium_vote_clamp_factor_ppm":60000000,
-		   "min_num_votes_per_sample":15
+		   "min_num_votes_per_sample":15,
+		   "open_interest_imbalance_fee_ppm":0
 		}
 	 }`
 	require.Equal(t,
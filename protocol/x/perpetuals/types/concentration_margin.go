@@ -0,0 +1,35 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+// ConcentrationMargin is the additional maintenance margin required of positions in a perpetual that make
+// up a large share of its open interest. The zero value adds no margin.
+type ConcentrationMargin struct {
+	// The share of the open interest of the perpetual, in ppm, that the size of a position must exceed for
+	// the add-on to apply.
+	ThresholdPpm uint32
+	// The maintenance margin fraction added to concentrated positions, in ppm of their notional.
+	AddOnPpm uint32
+}
+
+// GetConcentrationMargin returns the concentration margin of the perpetual.
+func (p Perpetual) GetConcentrationMargin() ConcentrationMargin {
+	return ConcentrationMargin{
+		ThresholdPpm: p.ConcentrationThresholdPpm,
+		AddOnPpm:     p.ConcentrationAddOnPpm,
+	}
+}
+
+// Validate returns an error if the threshold or the add-on of the concentration margin exceeds 100%.
+func (cm ConcentrationMargin) Validate() error {
+	if cm.ThresholdPpm > lib.OneMillion {
+		return errorsmod.Wrapf(ErrConcentrationThresholdPpmExceedsMax, "%d", cm.ThresholdPpm)
+	}
+	if cm.AddOnPpm > lib.OneMillion {
+		return errorsmod.Wrapf(ErrConcentrationAddOnPpmExceedsMax, "%d", cm.AddOnPpm)
+	}
+	return nil
+}
@@ -8,4 +8,14 @@ const (
 	// taking the average.
 	// TODO(DEC-1105): Move this constant to state so that it can be changed via governance.
 	RemovedTailSampleRatioPpm uint32 = 0
+
+	// FundingIndexSnapshotRetentionEpochs is the number of funding-tick epochs for which the funding index
+	// snapshots of a perpetual are retained. Snapshots are stored in a ring buffer with one slot per epoch,
+	// so the snapshot of an epoch overwrites the one from `FundingIndexSnapshotRetentionEpochs` epochs
+	// earlier. With hourly funding ticks this retains 30 days of funding indices.
+	FundingIndexSnapshotRetentionEpochs uint32 = 720
+
+	// MarginFractionsRetentionEpochs is the number of funding-tick epochs for which the margin fractions
+	// of a perpetual are retained, in a ring buffer with one slot per epoch.
+	MarginFractionsRetentionEpochs uint32 = 720
 )
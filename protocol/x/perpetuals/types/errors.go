@@ -127,6 +127,56 @@ var (
 		26,
 		"PerpetualInfo does not exist",
 	)
+	ErrInvalidPerpInfosEncoding = errorsmod.Register(
+		ModuleName,
+		27,
+		"PerpInfos binary encoding is invalid",
+	)
+	ErrInvalidPerpetualType = errorsmod.Register(
+		ModuleName,
+		28,
+		"Perpetual type is invalid",
+	)
+	ErrInversePerpetualPriceIsZero = errorsmod.Register(
+		ModuleName,
+		29,
+		"Market price of an inverse perpetual is zero",
+	)
+	ErrFundingIndexSnapshotNotFound = errorsmod.Register(
+		ModuleName,
+		30,
+		"No funding index snapshot is retained at or before the block height",
+	)
+	ErrMarginFractionsNotFound = errorsmod.Register(
+		ModuleName,
+		31,
+		"No margin fractions are retained for the funding-tick epoch",
+	)
+	ErrInvalidMarginFractionsEncoding = errorsmod.Register(
+		ModuleName,
+		32,
+		"MarginFractions encoding is invalid",
+	)
+	ErrOpenInterestImbalanceFeePpmExceedsMax = errorsmod.Register(
+		ModuleName,
+		33,
+		"Open interest imbalance fee ppm exceeds maximum value of 1e6",
+	)
+	ErrFundingRateCapPpmExceedsMax = errorsmod.Register(
+		ModuleName,
+		34,
+		"Funding rate cap ppm exceeds maximum value of 1e6",
+	)
+	ErrConcentrationThresholdPpmExceedsMax = errorsmod.Register(
+		ModuleName,
+		35,
+		"Concentration threshold ppm exceeds maximum value of 1e6",
+	)
+	ErrConcentrationAddOnPpmExceedsMax = errorsmod.Register(
+		ModuleName,
+		36,
+		"Concentration add-on ppm exceeds maximum value of 1e6",
+	)
 
 	// Errors for Not Implemented
 	ErrNotImplementedFunding = errorsmod.Register(ModuleName, 1001, "Not Implemented: Perpetuals Funding")
@@ -47,4 +47,8 @@ type EpochsKeeper interface {
 	MustGetFundingSampleEpochInfo(
 		ctx sdk.Context,
 	) epochstypes.EpochInfo
+	GetEpochInfo(
+		ctx sdk.Context,
+		id epochstypes.EpochInfoName,
+	) (epochstypes.EpochInfo, bool)
 }
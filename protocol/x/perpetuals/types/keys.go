@@ -37,6 +37,20 @@ const (
 
 	// NextPerpetualIDKey is the key to retrieve the next perpetual id to be used
 	NextPerpetualIDKey = "NextPerpetualID"
+
+	// FundingRateCapKeyPrefix is the prefix to retrieve the funding rate cap of a perpetual.
+	FundingRateCapKeyPrefix = "FundingRateCap:"
+
+	// FundingIndexSnapshotKeyPrefix is the prefix to retrieve the funding index snapshots of a perpetual.
+	FundingIndexSnapshotKeyPrefix = "FundingIndexSnapshot:"
+
+	// MarginFractionsKeyPrefix is the prefix to retrieve the margin fractions of a perpetual in effect at
+	// past funding-tick epochs.
+	MarginFractionsKeyPrefix = "MarginFractions:"
+
+	// OpenInterestImbalancePoolKeyPrefix is the prefix to retrieve the open interest imbalance fee pool of
+	// a perpetual.
+	OpenInterestImbalancePoolKeyPrefix = "OIImbalancePool:"
 )
 
 // Module Accounts
@@ -18,6 +18,9 @@ func TestStateKeys(t *testing.T) {
 	require.Equal(t, "PremSamples", types.PremiumSamplesKey)
 	require.Equal(t, "LiqTier:", types.LiquidityTierKeyPrefix)
 	require.Equal(t, "Params", types.ParamsKey)
+	require.Equal(t, "FundingIndexSnapshot:", types.FundingIndexSnapshotKeyPrefix)
+	require.Equal(t, "MarginFractions:", types.MarginFractionsKeyPrefix)
+	require.Equal(t, "OIImbalancePool:", types.OpenInterestImbalancePoolKeyPrefix)
 }
 
 func TestModuleAccountKeys(t *testing.T) {
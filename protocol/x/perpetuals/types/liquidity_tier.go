@@ -9,8 +9,8 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 )
 
-// - Initial margin is less than or equal to 1.
-// - Maintenance fraction is less than or equal to 1.
+// - Initial margin is less than or equal to 1, for both long and short positions.
+// - Maintenance fraction is less than or equal to 1, for both long and short positions.
 func (liquidityTier LiquidityTier) Validate() error {
 	if liquidityTier.InitialMarginPpm > MaxInitialMarginPpm {
 		return errorsmod.Wrap(ErrInitialMarginPpmExceedsMax, lib.UintToString(liquidityTier.InitialMarginPpm))
@@ -21,6 +21,15 @@ func (liquidityTier LiquidityTier) Validate() error {
 			lib.UintToString(liquidityTier.MaintenanceFractionPpm))
 	}
 
+	if liquidityTier.ShortInitialMarginPpm > MaxInitialMarginPpm {
+		return errorsmod.Wrap(ErrInitialMarginPpmExceedsMax, lib.UintToString(liquidityTier.ShortInitialMarginPpm))
+	}
+
+	if liquidityTier.ShortMaintenanceFractionPpm > MaxMaintenanceFractionPpm {
+		return errorsmod.Wrap(ErrMaintenanceFractionPpmExceedsMax,
+			lib.UintToString(liquidityTier.ShortMaintenanceFractionPpm))
+	}
+
 	if liquidityTier.ImpactNotional == 0 {
 		return ErrImpactNotionalIsZero
 	}
@@ -37,6 +46,22 @@ func (liquidityTier LiquidityTier) Validate() error {
 	return nil
 }
 
+// `ForPositionSide` returns the liquidity tier with the margin fractions that apply to a long or a short
+// position. Short positions use `ShortInitialMarginPpm` and `ShortMaintenanceFractionPpm` if they are
+// set (non-zero), and otherwise fall back to the symmetric `InitialMarginPpm` and `MaintenanceFractionPpm`.
+func (liquidityTier LiquidityTier) ForPositionSide(isLong bool) LiquidityTier {
+	if isLong {
+		return liquidityTier
+	}
+	if liquidityTier.ShortInitialMarginPpm != 0 {
+		liquidityTier.InitialMarginPpm = liquidityTier.ShortInitialMarginPpm
+	}
+	if liquidityTier.ShortMaintenanceFractionPpm != 0 {
+		liquidityTier.MaintenanceFractionPpm = liquidityTier.ShortMaintenanceFractionPpm
+	}
+	return liquidityTier
+}
+
 // `GetMaintenanceMarginPpm` calculates maintenance margin ppm based on initial margin ppm
 // and maintenance fraction ppm.
 func (liquidityTier LiquidityTier) GetMaintenanceMarginPpm() uint32 {
@@ -99,6 +124,9 @@ func (liquidityTier LiquidityTier) GetInitialMarginQuoteQuantums(
 //
 // - I.e. the effective IMF is the base IMF while the OI < lower cap, and increases linearly until OI = Upper Cap,
 // at which point the IMF stays at 1.0 (requiring 1:1 collateral for trading).
+//
+// The IMF increase is rounded up to the nearest ppm, so that the effective IMF is never less than the exact
+// interpolated value. The computation uses integer arithmetic only, so the result is deterministic.
 func (liquidityTier LiquidityTier) GetAdjustedInitialMarginPpm(
 	oiQuoteQuantums *big.Int,
 ) *big.Int {
@@ -134,8 +162,7 @@ func (liquidityTier LiquidityTier) GetAdjustedInitialMarginPpm(
 
 	// Total IMF.
 	capScalePpm := lib.BigU(lib.OneMillion - liquidityTier.InitialMarginPpm)
-	result := new(big.Int).Mul(capScalePpm, capNum)
-	result.Div(result, capDen)
+	result := lib.BigDivCeil(new(big.Int).Mul(capScalePpm, capNum), capDen)
 	result.Add(result, lib.BigU(liquidityTier.InitialMarginPpm))
 	return result
 }
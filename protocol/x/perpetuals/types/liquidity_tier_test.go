@@ -16,6 +16,8 @@ func TestLiquidityTierValidate(t *testing.T) {
 		ImpactNotional         uint64
 		openInterestLowerCap   uint64
 		openInterestUpperCap   uint64
+		shortInitialMarginPpm  uint32
+		shortMaintenanceFrac   uint32
 		expectedError          error
 	}{
 		"Validates successfully": {
@@ -36,6 +38,28 @@ func TestLiquidityTierValidate(t *testing.T) {
 			ImpactNotional:         1_000_000_000, // 1_000 USDC
 			expectedError:          types.ErrMaintenanceFractionPpmExceedsMax,
 		},
+		"Validates successfully with short margin fractions": {
+			initialMarginPpm:       150_000,       // 15%
+			maintenanceFractionPpm: 800_000,       // 80% of IM
+			ImpactNotional:         3_333_000_000, // 3_333 USDC
+			shortInitialMarginPpm:  200_000,       // 20%
+			shortMaintenanceFrac:   700_000,       // 70% of IM
+			expectedError:          nil,
+		},
+		"Failure: short initial margin ppm exceeds max": {
+			initialMarginPpm:       150_000,       // 15%
+			maintenanceFractionPpm: 800_000,       // 80% of IM
+			ImpactNotional:         1_000_000_000, // 1_000 USDC
+			shortInitialMarginPpm:  1_000_001,     // above 100%
+			expectedError:          types.ErrInitialMarginPpmExceedsMax,
+		},
+		"Failure: short maintenance fraction ppm exceeds max": {
+			initialMarginPpm:       150_000,       // 15%
+			maintenanceFractionPpm: 800_000,       // 80% of IM
+			ImpactNotional:         1_000_000_000, // 1_000 USDC
+			shortMaintenanceFrac:   1_000_001,     // above 100%
+			expectedError:          types.ErrMaintenanceFractionPpmExceedsMax,
+		},
 		"Failure: impact notional is zero": {
 			initialMarginPpm:       1_000_000, // 100%
 			maintenanceFractionPpm: 1_000_000, // 100%
@@ -69,6 +93,9 @@ func TestLiquidityTierValidate(t *testing.T) {
 				ImpactNotional:         tc.ImpactNotional,
 				OpenInterestLowerCap:   tc.openInterestLowerCap,
 				OpenInterestUpperCap:   tc.openInterestUpperCap,
+
+				ShortInitialMarginPpm:       tc.shortInitialMarginPpm,
+				ShortMaintenanceFractionPpm: tc.shortMaintenanceFrac,
 			}
 
 			err := liquidityTier.Validate()
@@ -393,8 +420,8 @@ func TestGetAdjustedInitialMarginPpm(t *testing.T) {
 			openInterestNotional: big.NewInt(1_234_567),
 			// Base_IMF + OI_IMF_Adjustment =
 			// 0.2 + (0.234_567 * 0.8) =
-			// 0.387_653_6 (rounded down to 0.387_653)
-			expectedPpm: big.NewInt(387_653),
+			// 0.387_653_6 (rounded up to 0.387_654)
+			expectedPpm: big.NewInt(387_654),
 		},
 		"Open interest at lower bound": {
 			initialMarginPpm:     uint32(200_000),
@@ -437,3 +464,57 @@ func TestGetAdjustedInitialMarginPpm(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAdjustedInitialMarginPpm_NonTerminatingDivision(t *testing.T) {
+	tests := map[string]struct {
+		openInterestUpperCap uint64
+		openInterestNotional int64
+		expectedPpm          *big.Int
+	}{
+		"One third of the way between the caps": {
+			openInterestUpperCap: 3_000_000,
+			openInterestNotional: 1_000_000,
+			// 0.2 + (1/3 * 0.8) = 0.466_666_66... (rounded up to 0.466_667)
+			expectedPpm: big.NewInt(466_667),
+		},
+		"Two thirds of the way between the caps": {
+			openInterestUpperCap: 3_000_000,
+			openInterestNotional: 2_000_000,
+			// 0.2 + (2/3 * 0.8) = 0.733_333_33... (rounded up to 0.733_334)
+			expectedPpm: big.NewInt(733_334),
+		},
+		"One seventh of the way between the caps": {
+			openInterestUpperCap: 7_000_000,
+			openInterestNotional: 1_000_000,
+			// 0.2 + (1/7 * 0.8) = 0.314_285_71... (rounded up to 0.314_286)
+			expectedPpm: big.NewInt(314_286),
+		},
+		"One quote quantum above the lower cap": {
+			openInterestUpperCap: 3_000_000,
+			openInterestNotional: 1,
+			// 0.2 + (1/3_000_000 * 0.8) = 0.200_000_26... (rounded up to 0.200_001)
+			expectedPpm: big.NewInt(200_001),
+		},
+		"One quote quantum below the upper cap": {
+			openInterestUpperCap: 3_000_000,
+			openInterestNotional: 2_999_999,
+			// 0.2 + (2_999_999/3_000_000 * 0.8) = 0.999_999_73... (rounded up to 1)
+			expectedPpm: big.NewInt(1_000_000),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Scaling the caps and the open interest by the same factor does not change the ratio, and must
+			// produce the identical result.
+			for _, scale := range []int64{1, 7, 1_000_003} {
+				liquidityTier := &types.LiquidityTier{
+					InitialMarginPpm:     200_000,
+					OpenInterestLowerCap: 0,
+					OpenInterestUpperCap: tc.openInterestUpperCap * uint64(scale),
+				}
+				openInterestNotional := big.NewInt(tc.openInterestNotional * scale)
+				require.Equal(t, tc.expectedPpm, liquidityTier.GetAdjustedInitialMarginPpm(openInterestNotional))
+			}
+		})
+	}
+}
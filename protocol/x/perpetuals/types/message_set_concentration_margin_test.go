@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"testing"
+
+	types "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetConcentrationMargin_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgSetConcentrationMargin
+		expectedErr string
+	}{
+		"Success": {
+			msg: types.MsgSetConcentrationMargin{
+				Authority:    validAuthority,
+				PerpetualId:  1,
+				ThresholdPpm: 100_000,
+				AddOnPpm:     50_000,
+			},
+		},
+		"Success: zero add-on removes the concentration margin": {
+			msg: types.MsgSetConcentrationMargin{
+				Authority:   validAuthority,
+				PerpetualId: 1,
+			},
+		},
+		"Failure: Invalid authority": {
+			msg: types.MsgSetConcentrationMargin{
+				Authority: "",
+			},
+			expectedErr: "Authority is invalid",
+		},
+		"Failure: Threshold is greater than 100%": {
+			msg: types.MsgSetConcentrationMargin{
+				Authority:    validAuthority,
+				PerpetualId:  1,
+				ThresholdPpm: 1_000_001,
+				AddOnPpm:     50_000,
+			},
+			expectedErr: "Concentration threshold ppm exceeds maximum value of 1e6",
+		},
+		"Failure: Add-on is greater than 100%": {
+			msg: types.MsgSetConcentrationMargin{
+				Authority:    validAuthority,
+				PerpetualId:  1,
+				ThresholdPpm: 100_000,
+				AddOnPpm:     1_000_001,
+			},
+			expectedErr: "Concentration add-on ppm exceeds maximum value of 1e6",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
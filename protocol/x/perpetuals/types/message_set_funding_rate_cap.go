@@ -0,0 +1,27 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+var _ sdk.Msg = &MsgSetFundingRateCap{}
+
+func (msg *MsgSetFundingRateCap) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(
+			ErrInvalidAuthority,
+			fmt.Sprintf(
+				"authority '%s' must be a valid bech32 address, but got error '%v'",
+				msg.Authority,
+				err.Error(),
+			),
+		)
+	}
+	if msg.FundingRateCapPpm > lib.OneMillion {
+		return errorsmod.Wrapf(ErrFundingRateCapPpmExceedsMax, "%d", msg.FundingRateCapPpm)
+	}
+	return nil
+}
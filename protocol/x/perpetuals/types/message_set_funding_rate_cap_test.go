@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"testing"
+
+	types "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetFundingRateCap_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgSetFundingRateCap
+		expectedErr string
+	}{
+		"Success": {
+			msg: types.MsgSetFundingRateCap{
+				Authority:         validAuthority,
+				PerpetualId:       1,
+				FundingRateCapPpm: 10_000,
+			},
+		},
+		"Success: zero removes the cap": {
+			msg: types.MsgSetFundingRateCap{
+				Authority:   validAuthority,
+				PerpetualId: 1,
+			},
+		},
+		"Failure: Invalid authority": {
+			msg: types.MsgSetFundingRateCap{
+				Authority: "",
+			},
+			expectedErr: "Authority is invalid",
+		},
+		"Failure: Funding rate cap is greater than 100%": {
+			msg: types.MsgSetFundingRateCap{
+				Authority:         validAuthority,
+				PerpetualId:       1,
+				FundingRateCapPpm: 1_000_001,
+			},
+			expectedErr: "Funding rate cap ppm exceeds maximum value of 1e6",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
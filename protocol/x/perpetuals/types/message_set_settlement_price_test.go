@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"testing"
+
+	types "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetSettlementPrice_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgSetSettlementPrice
+		expectedErr string
+	}{
+		"Success": {
+			msg: types.MsgSetSettlementPrice{
+				Authority:       validAuthority,
+				PerpetualId:     1,
+				SettlementPrice: 5_000_000_000,
+			},
+		},
+		"Success: zero leaves settlement mode": {
+			msg: types.MsgSetSettlementPrice{
+				Authority:   validAuthority,
+				PerpetualId: 1,
+			},
+		},
+		"Failure: Invalid authority": {
+			msg: types.MsgSetSettlementPrice{
+				Authority: "",
+			},
+			expectedErr: "Authority is invalid",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
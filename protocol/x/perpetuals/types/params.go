@@ -1,5 +1,7 @@
 package types
 
+import "github.com/dydxprotocol/v4-chain/protocol/lib"
+
 // Validate validates perpetual module's parameters.
 func (params Params) Validate() error {
 	if params.FundingRateClampFactorPpm == 0 {
@@ -11,6 +13,9 @@ func (params Params) Validate() error {
 	if params.MinNumVotesPerSample == 0 {
 		return ErrMinNumVotesPerSampleIsZero
 	}
+	if params.OpenInterestImbalanceFeePpm > lib.OneMillion {
+		return ErrOpenInterestImbalanceFeePpmExceedsMax
+	}
 
 	return nil
 }
@@ -35,6 +35,10 @@ type Params struct {
 	// Minimum number of premium votes per premium sample. If number of premium
 	// votes is smaller than this number, pad with zeros up to this number.
 	MinNumVotesPerSample uint32 `protobuf:"varint,3,opt,name=min_num_votes_per_sample,json=minNumVotesPerSample,proto3" json:"min_num_votes_per_sample,omitempty"`
+	// Fee in parts-per-million of the notional of the change in open interest
+	// of a perpetual caused by a fill, accrued to the open interest imbalance
+	// pool of the perpetual. Zero disables the fee.
+	OpenInterestImbalanceFeePpm uint32 `protobuf:"varint,4,opt,name=open_interest_imbalance_fee_ppm,json=openInterestImbalanceFeePpm,proto3" json:"open_interest_imbalance_fee_ppm,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -91,6 +95,13 @@ func (m *Params) GetMinNumVotesPerSample() uint32 {
 	return 0
 }
 
+func (m *Params) GetOpenInterestImbalanceFeePpm() uint32 {
+	if m != nil {
+		return m.OpenInterestImbalanceFeePpm
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dydxprotocol.perpetuals.Params")
 }
@@ -100,24 +111,26 @@ func init() {
 }
 
 var fileDescriptor_8b16af88c7880f7e = []byte{
-	// 260 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x31, 0x4b, 0xc3, 0x40,
-	0x14, 0xc7, 0x73, 0x0a, 0x1d, 0x02, 0x2e, 0x45, 0x30, 0x0e, 0x1e, 0x22, 0x0e, 0x2e, 0x26, 0x83,
-	0xe2, 0xe4, 0x20, 0x0a, 0x8e, 0x12, 0x2a, 0x74, 0x70, 0x39, 0xae, 0x97, 0xd7, 0xf6, 0x20, 0xef,
-	0xee, 0x71, 0x77, 0x29, 0xed, 0xb7, 0xf0, 0x43, 0x39, 0x38, 0x76, 0x74, 0x94, 0xe4, 0x8b, 0x48,
-	0xaf, 0x41, 0x2b, 0xba, 0xbe, 0xdf, 0xef, 0xfd, 0x86, 0x7f, 0x7a, 0x5e, 0xad, 0xaa, 0x25, 0x39,
-	0x1b, 0xac, 0xb2, 0x75, 0x41, 0xe0, 0x08, 0x42, 0x23, 0x6b, 0x5f, 0x90, 0x74, 0x12, 0x7d, 0x1e,
-	0xd1, 0xf0, 0x68, 0xd7, 0xca, 0x7f, 0xac, 0xb3, 0x37, 0x96, 0x0e, 0xca, 0x68, 0x0e, 0xef, 0xd2,
-	0x93, 0x69, 0x63, 0x2a, 0x6d, 0x66, 0xc2, 0xc9, 0x00, 0x42, 0xd5, 0x12, 0x49, 0x4c, 0xa5, 0x0a,
-	0xd6, 0x09, 0x22, 0xcc, 0xd8, 0x29, 0xbb, 0x38, 0x18, 0x1d, 0xf7, 0xd2, 0x48, 0x06, 0x78, 0xd8,
-	0x28, 0x8f, 0xd1, 0x28, 0x09, 0x37, 0x05, 0x72, 0x80, 0xba, 0x41, 0xb1, 0xb0, 0xff, 0x15, 0xf6,
-	0xb6, 0x85, 0x5e, 0x1a, 0xdb, 0x3f, 0x85, 0x9b, 0x34, 0x43, 0x6d, 0x84, 0xe9, 0x0b, 0x5e, 0x10,
-	0x38, 0xe1, 0x25, 0x52, 0x0d, 0xd9, 0x7e, 0x7c, 0x3e, 0x44, 0x6d, 0x9e, 0xb6, 0xbf, 0xbe, 0x04,
-	0xf7, 0x1c, 0xd9, 0xfd, 0xf8, 0xbd, 0xe5, 0x6c, 0xdd, 0x72, 0xf6, 0xd9, 0x72, 0xf6, 0xda, 0xf1,
-	0x64, 0xdd, 0xf1, 0xe4, 0xa3, 0xe3, 0xc9, 0xcb, 0xed, 0x4c, 0x87, 0x79, 0x33, 0xc9, 0x95, 0xc5,
-	0xe2, 0xd7, 0x54, 0x8b, 0xeb, 0x4b, 0x35, 0x97, 0xda, 0x14, 0xdf, 0x97, 0xe5, 0xee, 0x7c, 0x61,
-	0x45, 0xe0, 0x27, 0x83, 0x08, 0xaf, 0xbe, 0x02, 0x00, 0x00, 0xff, 0xff, 0x45, 0x37, 0xf4, 0x5b,
-	0x66, 0x01, 0x00, 0x00,
+	// 297 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xd1, 0x31, 0x4b, 0x3b, 0x31,
+	0x18, 0x06, 0xf0, 0x5e, 0xff, 0x7f, 0x3a, 0x1c, 0xb8, 0x14, 0xc1, 0x8a, 0x18, 0x45, 0x1c, 0x5c,
+	0xec, 0x0d, 0x8a, 0x93, 0x83, 0xa8, 0x14, 0xba, 0xc8, 0x51, 0xa1, 0x83, 0x4b, 0x48, 0xd3, 0xf7,
+	0xda, 0xc0, 0xbd, 0xc9, 0x4b, 0x92, 0x2b, 0xed, 0x57, 0x70, 0xf2, 0x63, 0x39, 0x76, 0x74, 0x94,
+	0xbb, 0x2f, 0x22, 0x97, 0x9e, 0x5a, 0xd1, 0x35, 0xcf, 0xef, 0x79, 0x02, 0x49, 0x7c, 0x3a, 0x5d,
+	0x4d, 0x97, 0x64, 0x8d, 0x37, 0xd2, 0xe4, 0x09, 0x81, 0x25, 0xf0, 0x85, 0xc8, 0x5d, 0x42, 0xc2,
+	0x0a, 0x74, 0xfd, 0x10, 0x75, 0xf7, 0xb6, 0x55, 0xff, 0x5b, 0x9d, 0x3c, 0xb7, 0xe3, 0x4e, 0x1a,
+	0x64, 0xf7, 0x26, 0x3e, 0xcc, 0x0a, 0x3d, 0x55, 0x7a, 0xc6, 0xad, 0xf0, 0xc0, 0x65, 0x2e, 0x90,
+	0x78, 0x26, 0xa4, 0x37, 0x96, 0x13, 0x61, 0x2f, 0x3a, 0x8e, 0xce, 0x76, 0x46, 0xfb, 0x0d, 0x1a,
+	0x09, 0x0f, 0x77, 0x35, 0x19, 0x04, 0x91, 0x12, 0xd6, 0x0b, 0x64, 0x01, 0x55, 0x81, 0x7c, 0x61,
+	0xfe, 0x5a, 0x68, 0x6f, 0x16, 0x1a, 0x34, 0x36, 0xbf, 0x16, 0xae, 0xe2, 0x1e, 0x2a, 0xcd, 0x75,
+	0xb3, 0xe0, 0x38, 0x81, 0xe5, 0x4e, 0x20, 0xe5, 0xd0, 0xfb, 0x17, 0xca, 0xbb, 0xa8, 0xf4, 0xc3,
+	0xa6, 0xeb, 0x52, 0xb0, 0x8f, 0x21, 0xeb, 0xde, 0xc7, 0x47, 0x86, 0x40, 0x73, 0xa5, 0x3d, 0x58,
+	0x70, 0x9e, 0x2b, 0x9c, 0x88, 0x5c, 0x68, 0x09, 0x3c, 0x03, 0x08, 0x77, 0xff, 0x0f, 0xf5, 0x83,
+	0x9a, 0x0d, 0x1b, 0x35, 0xfc, 0x44, 0x03, 0x80, 0x94, 0xf0, 0x76, 0xfc, 0x5a, 0xb2, 0x68, 0x5d,
+	0xb2, 0xe8, 0xbd, 0x64, 0xd1, 0x4b, 0xc5, 0x5a, 0xeb, 0x8a, 0xb5, 0xde, 0x2a, 0xd6, 0x7a, 0xba,
+	0x9e, 0x29, 0x3f, 0x2f, 0x26, 0x7d, 0x69, 0x30, 0xf9, 0xf1, 0xe0, 0x8b, 0xcb, 0x73, 0x39, 0x17,
+	0x4a, 0x27, 0x5f, 0x27, 0xcb, 0xed, 0x4f, 0xf0, 0x2b, 0x02, 0x37, 0xe9, 0x84, 0xf0, 0xe2, 0x63,
+	0x00, 0x38, 0x69, 0x78, 0x1d, 0xac, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -140,6 +153,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OpenInterestImbalanceFeePpm != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OpenInterestImbalanceFeePpm))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.MinNumVotesPerSample != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MinNumVotesPerSample))
 		i--
@@ -184,6 +202,9 @@ func (m *Params) Size() (n int) {
 	if m.MinNumVotesPerSample != 0 {
 		n += 1 + sovParams(uint64(m.MinNumVotesPerSample))
 	}
+	if m.OpenInterestImbalanceFeePpm != 0 {
+		n += 1 + sovParams(uint64(m.OpenInterestImbalanceFeePpm))
+	}
 	return n
 }
 
@@ -279,6 +300,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenInterestImbalanceFeePpm", wireType)
+			}
+			m.OpenInterestImbalanceFeePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenInterestImbalanceFeePpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
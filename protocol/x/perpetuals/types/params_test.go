@@ -10,10 +10,11 @@ import (
 
 func TestParamsValidate(t *testing.T) {
 	tests := map[string]struct {
-		fundingRateClampFactorPpm uint32
-		premiumVoteClampFactorPpm uint32
-		minNumVotesPerSample      uint32
-		expectedError             error
+		fundingRateClampFactorPpm   uint32
+		premiumVoteClampFactorPpm   uint32
+		minNumVotesPerSample        uint32
+		openInterestImbalanceFeePpm uint32
+		expectedError               error
 	}{
 		"Validates successfully": {
 			fundingRateClampFactorPpm: 6_000_000,
@@ -45,15 +46,30 @@ func TestParamsValidate(t *testing.T) {
 			minNumVotesPerSample:      0,
 			expectedError:             types.ErrMinNumVotesPerSampleIsZero,
 		},
+		"Validates successfully: open interest imbalance fee ppm is one million": {
+			fundingRateClampFactorPpm:   6_000_000,
+			premiumVoteClampFactorPpm:   60_000_000,
+			minNumVotesPerSample:        15,
+			openInterestImbalanceFeePpm: 1_000_000,
+			expectedError:               nil,
+		},
+		"Failure: open interest imbalance fee ppm exceeds one million": {
+			fundingRateClampFactorPpm:   6_000_000,
+			premiumVoteClampFactorPpm:   60_000_000,
+			minNumVotesPerSample:        15,
+			openInterestImbalanceFeePpm: 1_000_001,
+			expectedError:               types.ErrOpenInterestImbalanceFeePpmExceedsMax,
+		},
 	}
 
 	// Run tests.
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			params := &types.Params{
-				FundingRateClampFactorPpm: tc.fundingRateClampFactorPpm,
-				PremiumVoteClampFactorPpm: tc.premiumVoteClampFactorPpm,
-				MinNumVotesPerSample:      tc.minNumVotesPerSample,
+				FundingRateClampFactorPpm:   tc.fundingRateClampFactorPpm,
+				PremiumVoteClampFactorPpm:   tc.premiumVoteClampFactorPpm,
+				MinNumVotesPerSample:        tc.minNumVotesPerSample,
+				OpenInterestImbalanceFeePpm: tc.openInterestImbalanceFeePpm,
 			}
 
 			err := params.Validate()
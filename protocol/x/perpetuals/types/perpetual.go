@@ -23,6 +23,14 @@ func (p *PerpetualParams) Validate() error {
 		)
 	}
 
+	// Validate `perpetualType`.
+	if !PerpetualType(p.PerpetualType).IsValid() {
+		return errorsmod.Wrap(
+			ErrInvalidPerpetualType,
+			fmt.Sprintf("perpetual type %d", p.PerpetualType),
+		)
+	}
+
 	// Validate `ticker`.
 	if len(p.Ticker) == 0 {
 		return errors.WithStack(ErrTickerEmptyString)
@@ -64,6 +64,19 @@ type Perpetual struct {
 	FundingIndex github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=funding_index,json=fundingIndex,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"funding_index"`
 	// Total size of open long contracts, measured in base_quantums.
 	OpenInterest github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=open_interest,json=openInterest,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"open_interest"`
+	// The cap on the absolute difference between the funding index of the
+	// perpetual and the funding index of a position that is applied when the
+	// position is settled. Zero means uncapped.
+	MaxUnsettledFundingIndexDelta uint64 `protobuf:"varint,4,opt,name=max_unsettled_funding_index_delta,json=maxUnsettledFundingIndexDelta,proto3" json:"max_unsettled_funding_index_delta,omitempty"`
+	// The settlement price of the perpetual, in the exponent of its market
+	// price. Zero means the perpetual is not in settlement mode.
+	SettlementPrice uint64 `protobuf:"varint,5,opt,name=settlement_price,json=settlementPrice,proto3" json:"settlement_price,omitempty"`
+	// The share of the open interest of the perpetual, in ppm, that the size of
+	// a position must exceed for the concentration margin add-on to apply.
+	ConcentrationThresholdPpm uint32 `protobuf:"varint,6,opt,name=concentration_threshold_ppm,json=concentrationThresholdPpm,proto3" json:"concentration_threshold_ppm,omitempty"`
+	// The maintenance margin fraction added to concentrated positions, in ppm
+	// of their notional. Zero means no concentration margin.
+	ConcentrationAddOnPpm uint32 `protobuf:"varint,7,opt,name=concentration_add_on_ppm,json=concentrationAddOnPpm,proto3" json:"concentration_add_on_ppm,omitempty"`
 }
 
 func (m *Perpetual) Reset()         { *m = Perpetual{} }
@@ -106,6 +119,34 @@ func (m *Perpetual) GetParams() PerpetualParams {
 	return PerpetualParams{}
 }
 
+func (m *Perpetual) GetMaxUnsettledFundingIndexDelta() uint64 {
+	if m != nil {
+		return m.MaxUnsettledFundingIndexDelta
+	}
+	return 0
+}
+
+func (m *Perpetual) GetSettlementPrice() uint64 {
+	if m != nil {
+		return m.SettlementPrice
+	}
+	return 0
+}
+
+func (m *Perpetual) GetConcentrationThresholdPpm() uint32 {
+	if m != nil {
+		return m.ConcentrationThresholdPpm
+	}
+	return 0
+}
+
+func (m *Perpetual) GetConcentrationAddOnPpm() uint32 {
+	if m != nil {
+		return m.ConcentrationAddOnPpm
+	}
+	return 0
+}
+
 // PerpetualParams represents the parameters of a perpetual on the dYdX
 // exchange.
 type PerpetualParams struct {
@@ -129,6 +170,9 @@ type PerpetualParams struct {
 	LiquidityTier uint32 `protobuf:"varint,6,opt,name=liquidity_tier,json=liquidityTier,proto3" json:"liquidity_tier,omitempty"`
 	// The market type specifying if this perpetual is cross or isolated
 	MarketType PerpetualMarketType `protobuf:"varint,7,opt,name=market_type,json=marketType,proto3,enum=dydxprotocol.perpetuals.PerpetualMarketType" json:"market_type,omitempty"`
+	// The contract type of the perpetual, `0` for linear and `1` for inverse.
+	// Defaults to linear.
+	PerpetualType uint32 `protobuf:"varint,8,opt,name=perpetual_type,json=perpetualType,proto3" json:"perpetual_type,omitempty"`
 }
 
 func (m *PerpetualParams) Reset()         { *m = PerpetualParams{} }
@@ -213,6 +257,147 @@ func (m *PerpetualParams) GetMarketType() PerpetualMarketType {
 	return PerpetualMarketType_PERPETUAL_MARKET_TYPE_UNSPECIFIED
 }
 
+func (m *PerpetualParams) GetPerpetualType() uint32 {
+	if m != nil {
+		return m.PerpetualType
+	}
+	return 0
+}
+
+// FundingIndexSnapshot is the funding index of a perpetual recorded at the start
+// of a funding-tick epoch.
+type FundingIndexSnapshot struct {
+	// The block height at which the funding index was recorded.
+	BlockHeight uint32 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The funding index of the perpetual as of the block height.
+	FundingIndex github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=funding_index,json=fundingIndex,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"funding_index"`
+}
+
+func (m *FundingIndexSnapshot) Reset()         { *m = FundingIndexSnapshot{} }
+func (m *FundingIndexSnapshot) String() string { return proto.CompactTextString(m) }
+func (*FundingIndexSnapshot) ProtoMessage()    {}
+func (*FundingIndexSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce7204eee10038be, []int{2}
+}
+func (m *FundingIndexSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingIndexSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingIndexSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingIndexSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingIndexSnapshot.Merge(m, src)
+}
+func (m *FundingIndexSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingIndexSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingIndexSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingIndexSnapshot proto.InternalMessageInfo
+
+func (m *FundingIndexSnapshot) GetBlockHeight() uint32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// MarginFractions are the margin fractions of a perpetual in effect at the
+// start of a funding-tick epoch. Initial margin fractions include the scaling
+// by the open interest of the perpetual.
+type MarginFractions struct {
+	// The funding-tick epoch at the start of which the margin fractions were
+	// recorded.
+	Epoch uint32 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// The initial margin fraction of long positions, in parts-per-million.
+	InitialMarginPpm uint32 `protobuf:"varint,2,opt,name=initial_margin_ppm,json=initialMarginPpm,proto3" json:"initial_margin_ppm,omitempty"`
+	// The maintenance margin fraction of long positions, in parts-per-million.
+	MaintenanceMarginPpm uint32 `protobuf:"varint,3,opt,name=maintenance_margin_ppm,json=maintenanceMarginPpm,proto3" json:"maintenance_margin_ppm,omitempty"`
+	// The initial margin fraction of short positions, in parts-per-million.
+	ShortInitialMarginPpm uint32 `protobuf:"varint,4,opt,name=short_initial_margin_ppm,json=shortInitialMarginPpm,proto3" json:"short_initial_margin_ppm,omitempty"`
+	// The maintenance margin fraction of short positions, in parts-per-million.
+	ShortMaintenanceMarginPpm uint32 `protobuf:"varint,5,opt,name=short_maintenance_margin_ppm,json=shortMaintenanceMarginPpm,proto3" json:"short_maintenance_margin_ppm,omitempty"`
+}
+
+func (m *MarginFractions) Reset()         { *m = MarginFractions{} }
+func (m *MarginFractions) String() string { return proto.CompactTextString(m) }
+func (*MarginFractions) ProtoMessage()    {}
+func (*MarginFractions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce7204eee10038be, []int{3}
+}
+func (m *MarginFractions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarginFractions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarginFractions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarginFractions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarginFractions.Merge(m, src)
+}
+func (m *MarginFractions) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarginFractions) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarginFractions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarginFractions proto.InternalMessageInfo
+
+func (m *MarginFractions) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *MarginFractions) GetInitialMarginPpm() uint32 {
+	if m != nil {
+		return m.InitialMarginPpm
+	}
+	return 0
+}
+
+func (m *MarginFractions) GetMaintenanceMarginPpm() uint32 {
+	if m != nil {
+		return m.MaintenanceMarginPpm
+	}
+	return 0
+}
+
+func (m *MarginFractions) GetShortInitialMarginPpm() uint32 {
+	if m != nil {
+		return m.ShortInitialMarginPpm
+	}
+	return 0
+}
+
+func (m *MarginFractions) GetShortMaintenanceMarginPpm() uint32 {
+	if m != nil {
+		return m.ShortMaintenanceMarginPpm
+	}
+	return 0
+}
+
 // MarketPremiums stores a list of premiums for a single perpetual market.
 type MarketPremiums struct {
 	// perpetual_id is the Id of the perpetual market.
@@ -227,7 +412,7 @@ func (m *MarketPremiums) Reset()         { *m = MarketPremiums{} }
 func (m *MarketPremiums) String() string { return proto.CompactTextString(m) }
 func (*MarketPremiums) ProtoMessage()    {}
 func (*MarketPremiums) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce7204eee10038be, []int{2}
+	return fileDescriptor_ce7204eee10038be, []int{4}
 }
 func (m *MarketPremiums) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -293,7 +478,7 @@ func (m *PremiumStore) Reset()         { *m = PremiumStore{} }
 func (m *PremiumStore) String() string { return proto.CompactTextString(m) }
 func (*PremiumStore) ProtoMessage()    {}
 func (*PremiumStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce7204eee10038be, []int{3}
+	return fileDescriptor_ce7204eee10038be, []int{5}
 }
 func (m *PremiumStore) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -369,13 +554,30 @@ type LiquidityTier struct {
 	// IMF scales linearly to 100% as OI approaches open_interest_upper_cap.
 	// If zero, then the IMF does not scale with OI.
 	OpenInterestUpperCap uint64 `protobuf:"varint,8,opt,name=open_interest_upper_cap,json=openInterestUpperCap,proto3" json:"open_interest_upper_cap,omitempty"`
+	// The margin fraction needed to open a short position, in parts-per-million.
+	// If zero, short positions use initial_margin_ppm.
+	ShortInitialMarginPpm uint32 `protobuf:"varint,9,opt,name=short_initial_margin_ppm,json=shortInitialMarginPpm,proto3" json:"short_initial_margin_ppm,omitempty"`
+	// The fraction of the initial-margin that the maintenance-margin is for short
+	// positions, in parts-per-million. If zero, short positions use
+	// maintenance_fraction_ppm.
+	ShortMaintenanceFractionPpm uint32 `protobuf:"varint,10,opt,name=short_maintenance_fraction_ppm,json=shortMaintenanceFractionPpm,proto3" json:"short_maintenance_fraction_ppm,omitempty"`
+	// The maximum notional of a subaccount's position in a perpetual of this
+	// tier, in quote quantums. Updates that increase a position beyond this
+	// notional are rejected. If zero, positions are not capped.
+	MaxPositionNotionalQuoteQuantums uint64 `protobuf:"varint,11,opt,name=max_position_notional_quote_quantums,json=maxPositionNotionalQuoteQuantums,proto3" json:"max_position_notional_quote_quantums,omitempty"`
+	// The liquidation penalty of a liquidatable subaccount per unit of its
+	// maintenance margin deficit (its maintenance margin requirement minus its
+	// net collateral), in parts-per-million. The penalty never exceeds the
+	// net collateral of the subaccount. If non-zero, the penalty replaces the
+	// max liquidation fee of the liquidations config of the clob module.
+	LiquidationPenaltyPpm uint32 `protobuf:"varint,12,opt,name=liquidation_penalty_ppm,json=liquidationPenaltyPpm,proto3" json:"liquidation_penalty_ppm,omitempty"`
 }
 
 func (m *LiquidityTier) Reset()         { *m = LiquidityTier{} }
 func (m *LiquidityTier) String() string { return proto.CompactTextString(m) }
 func (*LiquidityTier) ProtoMessage()    {}
 func (*LiquidityTier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce7204eee10038be, []int{4}
+	return fileDescriptor_ce7204eee10038be, []int{6}
 }
 func (m *LiquidityTier) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -461,10 +663,40 @@ func (m *LiquidityTier) GetOpenInterestUpperCap() uint64 {
 	return 0
 }
 
+func (m *LiquidityTier) GetShortInitialMarginPpm() uint32 {
+	if m != nil {
+		return m.ShortInitialMarginPpm
+	}
+	return 0
+}
+
+func (m *LiquidityTier) GetShortMaintenanceFractionPpm() uint32 {
+	if m != nil {
+		return m.ShortMaintenanceFractionPpm
+	}
+	return 0
+}
+
+func (m *LiquidityTier) GetMaxPositionNotionalQuoteQuantums() uint64 {
+	if m != nil {
+		return m.MaxPositionNotionalQuoteQuantums
+	}
+	return 0
+}
+
+func (m *LiquidityTier) GetLiquidationPenaltyPpm() uint32 {
+	if m != nil {
+		return m.LiquidationPenaltyPpm
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("dydxprotocol.perpetuals.PerpetualMarketType", PerpetualMarketType_name, PerpetualMarketType_value)
 	proto.RegisterType((*Perpetual)(nil), "dydxprotocol.perpetuals.Perpetual")
 	proto.RegisterType((*PerpetualParams)(nil), "dydxprotocol.perpetuals.PerpetualParams")
+	proto.RegisterType((*FundingIndexSnapshot)(nil), "dydxprotocol.perpetuals.FundingIndexSnapshot")
+	proto.RegisterType((*MarginFractions)(nil), "dydxprotocol.perpetuals.MarginFractions")
 	proto.RegisterType((*MarketPremiums)(nil), "dydxprotocol.perpetuals.MarketPremiums")
 	proto.RegisterType((*PremiumStore)(nil), "dydxprotocol.perpetuals.PremiumStore")
 	proto.RegisterType((*LiquidityTier)(nil), "dydxprotocol.perpetuals.LiquidityTier")
@@ -475,55 +707,74 @@ func init() {
 }
 
 var fileDescriptor_ce7204eee10038be = []byte{
-	// 758 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xf3, 0x44,
-	0x14, 0x8d, 0xdd, 0x10, 0xda, 0xc9, 0xcf, 0x97, 0x4c, 0xab, 0x7e, 0x56, 0x2b, 0xa5, 0x69, 0xa4,
-	0xaa, 0x11, 0x94, 0x44, 0x2a, 0x20, 0xb1, 0x60, 0x41, 0x93, 0x26, 0xc2, 0x22, 0x69, 0x2d, 0x27,
-	0x45, 0x02, 0x09, 0x8d, 0x26, 0xf6, 0x34, 0x1d, 0xd5, 0x1e, 0x0f, 0xf6, 0x18, 0x1a, 0x76, 0xbc,
-	0x41, 0x5f, 0x83, 0x25, 0x6f, 0xd1, 0x65, 0x97, 0x88, 0x45, 0x85, 0xda, 0x2d, 0x0f, 0x81, 0x3c,
-	0x9e, 0xba, 0x49, 0x7f, 0x04, 0x0b, 0x56, 0x1e, 0xdf, 0x73, 0xce, 0x9d, 0x33, 0xd7, 0xc7, 0x03,
-	0xf6, 0xdd, 0xb9, 0x7b, 0xc5, 0xc3, 0x40, 0x04, 0x4e, 0xe0, 0x75, 0x38, 0x09, 0x39, 0x11, 0x31,
-	0xf6, 0xa2, 0xa7, 0x65, 0x5b, 0xa2, 0xf0, 0xfd, 0x22, 0xb1, 0xfd, 0x44, 0xdc, 0xda, 0x98, 0x05,
-	0xb3, 0x40, 0x02, 0x9d, 0x64, 0x95, 0xd2, 0x9b, 0xbf, 0xeb, 0x60, 0xcd, 0x7a, 0x24, 0xc1, 0x01,
-	0x28, 0x70, 0x1c, 0x62, 0x3f, 0x32, 0xb4, 0x86, 0xd6, 0x2a, 0x1e, 0xb6, 0xda, 0x6f, 0x74, 0x6b,
-	0x67, 0x1a, 0x4b, 0xf2, 0xbb, 0xf9, 0x9b, 0xbb, 0x9d, 0x9c, 0xad, 0xd4, 0xd0, 0x07, 0xe5, 0xf3,
-	0x98, 0xb9, 0x94, 0xcd, 0x10, 0x65, 0x2e, 0xb9, 0x32, 0xf4, 0x86, 0xd6, 0x2a, 0x75, 0xbf, 0x4e,
-	0x48, 0x7f, 0xde, 0xed, 0x7c, 0x35, 0xa3, 0xe2, 0x22, 0x9e, 0xb6, 0x9d, 0xc0, 0xef, 0x2c, 0x9d,
-	0xeb, 0xa7, 0xcf, 0x3e, 0x71, 0x2e, 0x30, 0x65, 0x9d, 0xac, 0xe2, 0x8a, 0x39, 0x27, 0x51, 0x7b,
-	0x4c, 0x42, 0x8a, 0x3d, 0xfa, 0x0b, 0x9e, 0x7a, 0xc4, 0x64, 0xc2, 0x2e, 0xa9, 0xf6, 0x66, 0xd2,
-	0x3d, 0xd9, 0x2e, 0xe0, 0x84, 0x21, 0xca, 0x04, 0x09, 0x49, 0x24, 0x8c, 0x95, 0xff, 0x7b, 0xbb,
-	0xa4, 0xbd, 0xa9, 0xba, 0x37, 0x7f, 0xd3, 0xc1, 0xbb, 0x67, 0xe7, 0x87, 0x15, 0xa0, 0x53, 0x57,
-	0x4e, 0xad, 0x6c, 0xeb, 0xd4, 0x85, 0x9b, 0xa0, 0x20, 0xa8, 0x73, 0x49, 0x42, 0x79, 0xf4, 0x35,
-	0x5b, 0xbd, 0xc1, 0x6d, 0xb0, 0xe6, 0xe3, 0xf0, 0x92, 0x08, 0x44, 0x5d, 0x69, 0xb3, 0x6c, 0xaf,
-	0xa6, 0x05, 0xd3, 0x85, 0x1f, 0x83, 0x1a, 0x16, 0x81, 0x4f, 0x1d, 0x14, 0x92, 0x28, 0xf0, 0x62,
-	0x41, 0x03, 0x66, 0xe4, 0x1b, 0x5a, 0xab, 0x66, 0x57, 0x53, 0xc0, 0xce, 0xea, 0xb0, 0x0d, 0xd6,
-	0x5d, 0x72, 0x8e, 0x63, 0x4f, 0xa0, 0xc7, 0x59, 0x73, 0xee, 0x1b, 0x1f, 0x48, 0x7a, 0x4d, 0x41,
-	0x83, 0x14, 0xb1, 0xb8, 0x0f, 0xf7, 0x40, 0xc5, 0xa3, 0x3f, 0xc6, 0xd4, 0xa5, 0x62, 0x8e, 0x04,
-	0x25, 0xa1, 0x51, 0x90, 0xdb, 0x97, 0xb3, 0xea, 0x84, 0x92, 0x10, 0x8e, 0x40, 0x51, 0x19, 0x4c,
-	0x46, 0x61, 0x7c, 0xd8, 0xd0, 0x5a, 0x95, 0xc3, 0x83, 0x7f, 0xcf, 0xc1, 0x48, 0x8a, 0x26, 0x73,
-	0x4e, 0x6c, 0xe0, 0x67, 0xeb, 0xe6, 0x29, 0xa8, 0xa4, 0x88, 0x15, 0x12, 0x9f, 0xc6, 0x7e, 0x04,
-	0x77, 0x41, 0x29, 0xd3, 0xa3, 0x6c, 0x66, 0xc5, 0xac, 0x66, 0xba, 0x70, 0x0b, 0xac, 0x72, 0x45,
-	0x37, 0xf4, 0xc6, 0x4a, 0xab, 0x66, 0x67, 0xef, 0xcd, 0x6b, 0x0d, 0x94, 0x54, 0xaf, 0xb1, 0x08,
-	0x42, 0x02, 0x7f, 0x00, 0xeb, 0xd8, 0xf3, 0x90, 0x32, 0x9d, 0xe9, 0xb4, 0xc6, 0x4a, 0xab, 0x78,
-	0xb8, 0xff, 0xa6, 0xf1, 0x65, 0x57, 0x2a, 0xbf, 0x35, 0xec, 0x79, 0x2f, 0xed, 0xb2, 0xd8, 0x47,
-	0x0b, 0x7e, 0xa4, 0x5d, 0x16, 0xfb, 0x8f, 0x94, 0xe6, 0xdf, 0x3a, 0x28, 0x0f, 0x97, 0x86, 0xf8,
-	0x3c, 0x0d, 0x10, 0xe4, 0x19, 0xf6, 0x89, 0xca, 0x82, 0x5c, 0xc3, 0x03, 0x00, 0x29, 0xa3, 0x82,
-	0x62, 0xe9, 0x7d, 0x46, 0x99, 0xfc, 0x7c, 0x69, 0x24, 0xaa, 0x0a, 0x19, 0x49, 0x20, 0xf9, 0x7a,
-	0x5f, 0x00, 0xc3, 0xc7, 0x49, 0xbe, 0x19, 0x66, 0x0e, 0x41, 0xe7, 0x21, 0x76, 0x92, 0x14, 0x48,
-	0x4d, 0x5e, 0x6a, 0x36, 0x17, 0xf0, 0x81, 0x82, 0x53, 0xe5, 0xe6, 0x14, 0x47, 0x04, 0xf1, 0x20,
-	0xa2, 0x52, 0xc2, 0x82, 0xe4, 0x81, 0x3d, 0x19, 0x95, 0x7c, 0x57, 0x37, 0x34, 0x7b, 0x23, 0x61,
-	0x58, 0x8a, 0x70, 0xa2, 0x70, 0xb8, 0x0f, 0xde, 0x51, 0x9f, 0x63, 0x47, 0x3c, 0x49, 0x92, 0xc8,
-	0xe4, 0xed, 0x4a, 0x5a, 0xce, 0x88, 0x9f, 0x83, 0xf7, 0x4b, 0xff, 0x1f, 0xf2, 0x82, 0x9f, 0x49,
-	0x88, 0x1c, 0xcc, 0x65, 0x7e, 0xf2, 0xf6, 0xc6, 0xe2, 0xff, 0x33, 0x4c, 0xc0, 0x1e, 0xe6, 0x2f,
-	0x65, 0x31, 0xe7, 0x4a, 0xb6, 0xfa, 0x52, 0x76, 0x96, 0x80, 0x3d, 0xcc, 0x3f, 0xfa, 0x55, 0x03,
-	0xeb, 0xaf, 0xc4, 0x0e, 0xee, 0x81, 0x5d, 0xab, 0x6f, 0x5b, 0xfd, 0xc9, 0xd9, 0xd1, 0x10, 0x8d,
-	0x8e, 0xec, 0x6f, 0xfa, 0x13, 0x34, 0xf9, 0xce, 0xea, 0xa3, 0xb3, 0x93, 0xb1, 0xd5, 0xef, 0x99,
-	0x03, 0xb3, 0x7f, 0x5c, 0xcd, 0xc1, 0x1d, 0xb0, 0xfd, 0x3a, 0xad, 0x67, 0x9f, 0x8e, 0xc7, 0x55,
-	0x0d, 0x36, 0x41, 0xfd, 0x75, 0x82, 0x39, 0x3e, 0x1d, 0x1e, 0x4d, 0xfa, 0xc7, 0x55, 0xbd, 0xfb,
-	0xed, 0xcd, 0x7d, 0x5d, 0xbb, 0xbd, 0xaf, 0x6b, 0x7f, 0xdd, 0xd7, 0xb5, 0xeb, 0x87, 0x7a, 0xee,
-	0xf6, 0xa1, 0x9e, 0xfb, 0xe3, 0xa1, 0x9e, 0xfb, 0xfe, 0xcb, 0xff, 0x7e, 0xd9, 0x5c, 0x2d, 0xde,
-	0xe3, 0xf2, 0xe2, 0x99, 0x16, 0x24, 0xf8, 0xe9, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x28,
-	0x1e, 0x85, 0xef, 0x05, 0x00, 0x00,
+	// 1068 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0xce, 0x38, 0x4e, 0x48, 0xda, 0x71, 0xe2, 0x74, 0x4c, 0x62, 0x36, 0xe0, 0x38, 0x16, 0xab,
+	0x18, 0x58, 0x1c, 0x29, 0x2c, 0x2c, 0x07, 0x04, 0xe4, 0xc7, 0x51, 0x2c, 0xf2, 0x33, 0x3b, 0x76,
+	0x90, 0x40, 0x42, 0xad, 0xce, 0x4c, 0xc7, 0x6e, 0x65, 0xfa, 0x27, 0x33, 0x3d, 0xe0, 0x70, 0xe3,
+	0xc0, 0x7d, 0xdf, 0x80, 0x2b, 0x8f, 0xb2, 0xc7, 0x1c, 0x11, 0x87, 0x15, 0x4a, 0xde, 0x80, 0x27,
+	0x40, 0xdd, 0x33, 0x19, 0x8f, 0xf3, 0xb3, 0x70, 0x40, 0x7b, 0xf2, 0x4c, 0xd5, 0xf7, 0x55, 0x55,
+	0x57, 0x7d, 0x5d, 0x63, 0xb0, 0xe6, 0x5d, 0x78, 0x03, 0x19, 0x08, 0x25, 0x5c, 0xe1, 0xaf, 0x4b,
+	0x12, 0x48, 0xa2, 0x22, 0xec, 0x87, 0xc3, 0xc7, 0xa6, 0xf1, 0xc2, 0xa5, 0x2c, 0xb0, 0x39, 0x04,
+	0x3e, 0x2a, 0xf7, 0x44, 0x4f, 0x18, 0xc7, 0xba, 0x7e, 0x8a, 0xe1, 0xf5, 0xdf, 0xf2, 0x60, 0xda,
+	0xbe, 0x01, 0xc1, 0x5d, 0x30, 0x29, 0x71, 0x80, 0x59, 0x58, 0xb1, 0x6a, 0x56, 0xa3, 0xb0, 0xd1,
+	0x68, 0x3e, 0x10, 0xad, 0x99, 0x72, 0x6c, 0x83, 0xdf, 0xca, 0xbf, 0x7c, 0xb5, 0x32, 0xe6, 0x24,
+	0x6c, 0xc8, 0x40, 0xf1, 0x34, 0xe2, 0x1e, 0xe5, 0x3d, 0x44, 0xb9, 0x47, 0x06, 0x95, 0x5c, 0xcd,
+	0x6a, 0xcc, 0x6c, 0xed, 0x69, 0xd0, 0x9f, 0xaf, 0x56, 0xbe, 0xee, 0x51, 0xd5, 0x8f, 0x4e, 0x9a,
+	0xae, 0x60, 0xeb, 0x23, 0xe7, 0xfa, 0xf1, 0xe9, 0xc7, 0x6e, 0x1f, 0x53, 0xbe, 0x9e, 0x5a, 0x3c,
+	0x75, 0x21, 0x49, 0xd8, 0xec, 0x90, 0x80, 0x62, 0x9f, 0xfe, 0x8c, 0x4f, 0x7c, 0xd2, 0xe6, 0xca,
+	0x99, 0x49, 0xc2, 0xb7, 0x75, 0x74, 0x9d, 0x4e, 0x48, 0xc2, 0x11, 0xe5, 0x8a, 0x04, 0x24, 0x54,
+	0x95, 0xf1, 0xff, 0x3b, 0x9d, 0x0e, 0xdf, 0x4e, 0xa2, 0xc3, 0x3d, 0xb0, 0xca, 0xf0, 0x00, 0x45,
+	0x3c, 0x24, 0x4a, 0xf9, 0xc4, 0x43, 0x23, 0x67, 0x45, 0x1e, 0xf1, 0x15, 0xae, 0xe4, 0x6b, 0x56,
+	0x23, 0xef, 0xbc, 0xc7, 0xf0, 0xe0, 0xf8, 0x06, 0xb7, 0x9b, 0xa9, 0x79, 0x47, 0x83, 0xe0, 0x07,
+	0xa0, 0x14, 0xfb, 0x18, 0xe1, 0x0a, 0xc9, 0x80, 0xba, 0xa4, 0x32, 0x61, 0x88, 0x73, 0x43, 0xbb,
+	0xad, 0xcd, 0xf0, 0x4b, 0xb0, 0xec, 0x0a, 0xee, 0x12, 0xae, 0x02, 0xac, 0xa8, 0xe0, 0x48, 0xf5,
+	0x03, 0x12, 0xf6, 0x85, 0xef, 0x21, 0x29, 0x59, 0x65, 0xb2, 0x66, 0x35, 0x8a, 0xce, 0x3b, 0x23,
+	0x90, 0xee, 0x0d, 0xc2, 0x96, 0x0c, 0x3e, 0x03, 0x95, 0x51, 0x3e, 0xf6, 0x3c, 0x24, 0xb8, 0x21,
+	0xbf, 0x65, 0xc8, 0x6f, 0x8f, 0xf8, 0x37, 0x3d, 0xef, 0x88, 0xdb, 0x92, 0xd5, 0x2f, 0x73, 0x60,
+	0xee, 0xd6, 0xb4, 0xe1, 0x2c, 0xc8, 0x51, 0xcf, 0x68, 0xa4, 0xe8, 0xe4, 0xa8, 0x07, 0x17, 0xc1,
+	0xa4, 0xa2, 0xee, 0x19, 0x09, 0xcc, 0xa0, 0xa7, 0x9d, 0xe4, 0x0d, 0x2e, 0x83, 0x69, 0x86, 0x83,
+	0x33, 0xa2, 0x10, 0xf5, 0xcc, 0x50, 0x8a, 0xce, 0x54, 0x6c, 0x68, 0x7b, 0xf0, 0x23, 0x30, 0x8f,
+	0x95, 0x60, 0xd4, 0x45, 0x01, 0x09, 0x85, 0x1f, 0xe9, 0xac, 0xa6, 0x6d, 0xf3, 0x4e, 0x29, 0x76,
+	0x38, 0xa9, 0x1d, 0x36, 0xc1, 0x82, 0x47, 0x4e, 0x71, 0xe4, 0xab, 0xb4, 0xdb, 0xba, 0xf2, 0x09,
+	0x03, 0x9f, 0x4f, 0x5c, 0x49, 0x83, 0xf5, 0x71, 0x1f, 0x83, 0x59, 0x9f, 0x9e, 0x47, 0xd4, 0xa3,
+	0xea, 0x02, 0x29, 0x4a, 0x82, 0xa4, 0x43, 0xc5, 0xd4, 0xda, 0xa5, 0x24, 0x80, 0x07, 0xa0, 0x90,
+	0x14, 0xa8, 0x07, 0x6f, 0x1a, 0x31, 0xbb, 0xf1, 0xe4, 0xdf, 0x55, 0x7f, 0x60, 0x48, 0xdd, 0x0b,
+	0x49, 0x1c, 0xc0, 0xd2, 0x67, 0x9d, 0x35, 0x45, 0xc7, 0x11, 0xa7, 0xe2, 0xac, 0xa9, 0x55, 0xc3,
+	0xea, 0xbf, 0x5b, 0xa0, 0x9c, 0x15, 0x43, 0x87, 0x63, 0x19, 0xf6, 0x85, 0x82, 0xab, 0x60, 0xe6,
+	0xc4, 0x17, 0xee, 0x19, 0xea, 0x13, 0xda, 0xeb, 0xab, 0xa4, 0xc3, 0x05, 0x63, 0xdb, 0x33, 0xa6,
+	0x37, 0x7c, 0xb5, 0xea, 0xbf, 0xe6, 0xc0, 0xdc, 0x01, 0x0e, 0x7a, 0x94, 0xef, 0x06, 0xd8, 0xd5,
+	0xa3, 0x08, 0x61, 0x19, 0x4c, 0x10, 0x29, 0xdc, 0x7e, 0x52, 0x5e, 0xfc, 0x02, 0x9f, 0x00, 0x48,
+	0x39, 0x55, 0x14, 0xfb, 0x88, 0x19, 0x82, 0x19, 0x50, 0xce, 0x40, 0x4a, 0x89, 0x27, 0x8e, 0xa4,
+	0xe7, 0xf3, 0x14, 0x2c, 0x32, 0xac, 0xef, 0x2b, 0xc7, 0xdc, 0x25, 0x59, 0x46, 0x2c, 0x93, 0x72,
+	0xc6, 0x3b, 0x64, 0x3d, 0x03, 0x95, 0xb0, 0x2f, 0x02, 0x85, 0xee, 0xc9, 0x94, 0x8f, 0x45, 0x6c,
+	0xfc, 0xed, 0xdb, 0xe9, 0xbe, 0x02, 0xef, 0xc6, 0xc4, 0x07, 0x92, 0x4e, 0xc4, 0xd7, 0xc7, 0x60,
+	0x0e, 0xee, 0xc9, 0x5c, 0x3f, 0x02, 0xb3, 0xf1, 0xcc, 0xed, 0x80, 0x30, 0x1a, 0xb1, 0x50, 0xcf,
+	0x6a, 0x38, 0xeb, 0xf4, 0x36, 0x14, 0x52, 0x5b, 0xdb, 0x83, 0x8f, 0xc0, 0x94, 0x4c, 0xe0, 0x95,
+	0x5c, 0x6d, 0xbc, 0x31, 0xef, 0xa4, 0xef, 0xf5, 0x17, 0x16, 0x98, 0x49, 0x62, 0x75, 0x94, 0x08,
+	0x08, 0xfc, 0x01, 0x2c, 0x60, 0xdf, 0x47, 0x89, 0x1c, 0x53, 0x9e, 0x55, 0x1b, 0x6f, 0x14, 0x36,
+	0xd6, 0x1e, 0x94, 0xe4, 0x68, 0x55, 0xc9, 0x1e, 0x9e, 0xc7, 0xbe, 0x7f, 0xb7, 0x5c, 0x1e, 0x31,
+	0x94, 0xa9, 0xc7, 0x94, 0xcb, 0x23, 0x76, 0x03, 0xa9, 0xff, 0x9d, 0x07, 0xc5, 0xfd, 0x91, 0xeb,
+	0x71, 0xfb, 0x9e, 0x43, 0x90, 0xe7, 0x98, 0x91, 0xe4, 0x96, 0x9b, 0xe7, 0x07, 0xe6, 0x3e, 0xfe,
+	0xc0, 0xdc, 0x3f, 0x07, 0x95, 0xec, 0x08, 0x4e, 0x13, 0x51, 0x65, 0x26, 0x98, 0xd5, 0xc5, 0x8d,
+	0xe6, 0x62, 0xe6, 0xe2, 0x09, 0x0e, 0x09, 0x92, 0x22, 0xa4, 0x86, 0xc2, 0x85, 0xfe, 0xc1, 0x7e,
+	0xbc, 0x31, 0xb7, 0x72, 0x15, 0xcb, 0x29, 0x6b, 0x84, 0x9d, 0x00, 0x0e, 0x13, 0x3f, 0x5c, 0x03,
+	0x73, 0x94, 0x49, 0xec, 0xaa, 0x21, 0x65, 0xd2, 0x2c, 0xd9, 0xd9, 0xd8, 0x9c, 0x02, 0x3f, 0x05,
+	0x4b, 0x23, 0xdf, 0x11, 0xe4, 0x8b, 0x9f, 0x48, 0x80, 0x5c, 0x2c, 0xcd, 0x66, 0xc8, 0x3b, 0xe5,
+	0xec, 0x77, 0x60, 0x5f, 0x3b, 0xb7, 0xb1, 0xbc, 0x4b, 0x8b, 0xa4, 0x4c, 0x68, 0x53, 0x77, 0x69,
+	0xc7, 0x52, 0xc6, 0xb4, 0xd7, 0x89, 0x79, 0xfa, 0x75, 0x62, 0xde, 0x06, 0xd5, 0xbb, 0x62, 0x1e,
+	0xe9, 0x24, 0x30, 0xf4, 0xe5, 0xdb, 0x72, 0xce, 0xb6, 0xf3, 0x10, 0xbc, 0xaf, 0x3f, 0x62, 0x77,
+	0xba, 0x89, 0xce, 0x23, 0xa1, 0x08, 0x3a, 0x8f, 0x30, 0x57, 0x5a, 0x27, 0x05, 0x73, 0x82, 0x1a,
+	0xc3, 0x83, 0xdb, 0x7d, 0x7d, 0xae, 0x81, 0xcf, 0x13, 0x1c, 0xfc, 0x0c, 0x2c, 0xc5, 0xab, 0x35,
+	0xfe, 0xba, 0x48, 0xc2, 0xb1, 0xaf, 0x2e, 0x4c, 0x35, 0x33, 0xf1, 0x61, 0x32, 0x6e, 0x3b, 0xf6,
+	0xda, 0x92, 0x7d, 0xf8, 0x8b, 0x05, 0x16, 0xee, 0x59, 0xab, 0xf0, 0x31, 0x58, 0xb5, 0x5b, 0x8e,
+	0xdd, 0xea, 0x1e, 0x6f, 0xee, 0xa3, 0x83, 0x4d, 0xe7, 0x9b, 0x56, 0x17, 0x75, 0xbf, 0xb3, 0x5b,
+	0xe8, 0xf8, 0xb0, 0x63, 0xb7, 0xb6, 0xdb, 0xbb, 0xed, 0xd6, 0x4e, 0x69, 0x0c, 0xae, 0x80, 0xe5,
+	0xfb, 0x61, 0xdb, 0xce, 0x51, 0xa7, 0x53, 0xb2, 0x60, 0x1d, 0x54, 0xef, 0x07, 0xb4, 0x3b, 0x47,
+	0xfb, 0x9b, 0xdd, 0xd6, 0x4e, 0x29, 0xb7, 0xf5, 0xed, 0xcb, 0xab, 0xaa, 0x75, 0x79, 0x55, 0xb5,
+	0xfe, 0xba, 0xaa, 0x5a, 0x2f, 0xae, 0xab, 0x63, 0x97, 0xd7, 0xd5, 0xb1, 0x3f, 0xae, 0xab, 0x63,
+	0xdf, 0x7f, 0xf1, 0xdf, 0xd7, 0xe9, 0x20, 0xfb, 0xaf, 0xcc, 0xac, 0xd6, 0x93, 0x49, 0xe3, 0xfc,
+	0xe4, 0x9f, 0x01, 0x00, 0xa6, 0xf9, 0xf7, 0x03, 0xbd, 0x09, 0x00, 0x00,
 }
 
 func (m *Perpetual) Marshal() (dAtA []byte, err error) {
@@ -546,6 +797,26 @@ func (m *Perpetual) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ConcentrationAddOnPpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.ConcentrationAddOnPpm))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ConcentrationThresholdPpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.ConcentrationThresholdPpm))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SettlementPrice != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.SettlementPrice))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxUnsettledFundingIndexDelta != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.MaxUnsettledFundingIndexDelta))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.OpenInterest.Size()
 		i -= size
@@ -599,6 +870,11 @@ func (m *PerpetualParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PerpetualType != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.PerpetualType))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.MarketType != 0 {
 		i = encodeVarintPerpetual(dAtA, i, uint64(m.MarketType))
 		i--
@@ -639,6 +915,92 @@ func (m *PerpetualParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FundingIndexSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingIndexSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingIndexSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FundingIndex.Size()
+		i -= size
+		if _, err := m.FundingIndex.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPerpetual(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarginFractions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarginFractions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarginFractions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ShortMaintenanceMarginPpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.ShortMaintenanceMarginPpm))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ShortInitialMarginPpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.ShortInitialMarginPpm))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaintenanceMarginPpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.MaintenanceMarginPpm))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InitialMarginPpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.InitialMarginPpm))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MarketPremiums) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -748,6 +1110,26 @@ func (m *LiquidityTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LiquidationPenaltyPpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.LiquidationPenaltyPpm))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MaxPositionNotionalQuoteQuantums != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.MaxPositionNotionalQuoteQuantums))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ShortMaintenanceFractionPpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.ShortMaintenanceFractionPpm))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ShortInitialMarginPpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.ShortInitialMarginPpm))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.OpenInterestUpperCap != 0 {
 		i = encodeVarintPerpetual(dAtA, i, uint64(m.OpenInterestUpperCap))
 		i--
@@ -816,6 +1198,18 @@ func (m *Perpetual) Size() (n int) {
 	n += 1 + l + sovPerpetual(uint64(l))
 	l = m.OpenInterest.Size()
 	n += 1 + l + sovPerpetual(uint64(l))
+	if m.MaxUnsettledFundingIndexDelta != 0 {
+		n += 1 + sovPerpetual(uint64(m.MaxUnsettledFundingIndexDelta))
+	}
+	if m.SettlementPrice != 0 {
+		n += 1 + sovPerpetual(uint64(m.SettlementPrice))
+	}
+	if m.ConcentrationThresholdPpm != 0 {
+		n += 1 + sovPerpetual(uint64(m.ConcentrationThresholdPpm))
+	}
+	if m.ConcentrationAddOnPpm != 0 {
+		n += 1 + sovPerpetual(uint64(m.ConcentrationAddOnPpm))
+	}
 	return n
 }
 
@@ -847,6 +1241,47 @@ func (m *PerpetualParams) Size() (n int) {
 	if m.MarketType != 0 {
 		n += 1 + sovPerpetual(uint64(m.MarketType))
 	}
+	if m.PerpetualType != 0 {
+		n += 1 + sovPerpetual(uint64(m.PerpetualType))
+	}
+	return n
+}
+
+func (m *FundingIndexSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovPerpetual(uint64(m.BlockHeight))
+	}
+	l = m.FundingIndex.Size()
+	n += 1 + l + sovPerpetual(uint64(l))
+	return n
+}
+
+func (m *MarginFractions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovPerpetual(uint64(m.Epoch))
+	}
+	if m.InitialMarginPpm != 0 {
+		n += 1 + sovPerpetual(uint64(m.InitialMarginPpm))
+	}
+	if m.MaintenanceMarginPpm != 0 {
+		n += 1 + sovPerpetual(uint64(m.MaintenanceMarginPpm))
+	}
+	if m.ShortInitialMarginPpm != 0 {
+		n += 1 + sovPerpetual(uint64(m.ShortInitialMarginPpm))
+	}
+	if m.ShortMaintenanceMarginPpm != 0 {
+		n += 1 + sovPerpetual(uint64(m.ShortMaintenanceMarginPpm))
+	}
 	return n
 }
 
@@ -918,6 +1353,18 @@ func (m *LiquidityTier) Size() (n int) {
 	if m.OpenInterestUpperCap != 0 {
 		n += 1 + sovPerpetual(uint64(m.OpenInterestUpperCap))
 	}
+	if m.ShortInitialMarginPpm != 0 {
+		n += 1 + sovPerpetual(uint64(m.ShortInitialMarginPpm))
+	}
+	if m.ShortMaintenanceFractionPpm != 0 {
+		n += 1 + sovPerpetual(uint64(m.ShortMaintenanceFractionPpm))
+	}
+	if m.MaxPositionNotionalQuoteQuantums != 0 {
+		n += 1 + sovPerpetual(uint64(m.MaxPositionNotionalQuoteQuantums))
+	}
+	if m.LiquidationPenaltyPpm != 0 {
+		n += 1 + sovPerpetual(uint64(m.LiquidationPenaltyPpm))
+	}
 	return n
 }
 
@@ -1055,11 +1502,87 @@ func (m *Perpetual) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPerpetual(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnsettledFundingIndexDelta", wireType)
+			}
+			m.MaxUnsettledFundingIndexDelta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUnsettledFundingIndexDelta |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
+			}
+			m.SettlementPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcentrationThresholdPpm", wireType)
+			}
+			m.ConcentrationThresholdPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConcentrationThresholdPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcentrationAddOnPpm", wireType)
+			}
+			m.ConcentrationAddOnPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConcentrationAddOnPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerpetual(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPerpetual
@@ -1255,6 +1778,272 @@ func (m *PerpetualParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualType", wireType)
+			}
+			m.PerpetualType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerpetual(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingIndexSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerpetual
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingIndexSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingIndexSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingIndex", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerpetual(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarginFractions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerpetual
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarginFractions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarginFractions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginPpm", wireType)
+			}
+			m.InitialMarginPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialMarginPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginPpm", wireType)
+			}
+			m.MaintenanceMarginPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceMarginPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortInitialMarginPpm", wireType)
+			}
+			m.ShortInitialMarginPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShortInitialMarginPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortMaintenanceMarginPpm", wireType)
+			}
+			m.ShortMaintenanceMarginPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShortMaintenanceMarginPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPerpetual(dAtA[iNdEx:])
@@ -1720,6 +2509,82 @@ func (m *LiquidityTier) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortInitialMarginPpm", wireType)
+			}
+			m.ShortInitialMarginPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShortInitialMarginPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortMaintenanceFractionPpm", wireType)
+			}
+			m.ShortMaintenanceFractionPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShortMaintenanceFractionPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPositionNotionalQuoteQuantums", wireType)
+			}
+			m.MaxPositionNotionalQuoteQuantums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPositionNotionalQuoteQuantums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPenaltyPpm", wireType)
+			}
+			m.LiquidationPenaltyPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidationPenaltyPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPerpetual(dAtA[iNdEx:])
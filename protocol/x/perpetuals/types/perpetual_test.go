@@ -40,6 +40,26 @@ func TestPerpetualParams_Validate(t *testing.T) {
 			},
 			expectedErr: "DefaultFundingPpm magnitude exceeds maximum value",
 		},
+		{
+			desc: "Valid inverse perpetual",
+			params: types.PerpetualParams{
+				Ticker:            "test",
+				DefaultFundingPpm: 1_000_000,
+				MarketType:        types.PerpetualMarketType_PERPETUAL_MARKET_TYPE_CROSS,
+				PerpetualType:     uint32(types.InversePerpetual),
+			},
+			expectedErr: "",
+		},
+		{
+			desc: "Invalid PerpetualType",
+			params: types.PerpetualParams{
+				Ticker:            "test",
+				DefaultFundingPpm: 1_000_000,
+				MarketType:        types.PerpetualMarketType_PERPETUAL_MARKET_TYPE_CROSS,
+				PerpetualType:     2,
+			},
+			expectedErr: "Perpetual type is invalid",
+		},
 	}
 
 	for _, tc := range tests {
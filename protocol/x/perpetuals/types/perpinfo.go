@@ -5,11 +5,55 @@ import (
 	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 )
 
+// PerpetualType is the contract type of a perpetual, which determines how the notional value of a
+// position is computed from its size and the market price.
+type PerpetualType uint32
+
+const (
+	// LinearPerpetual has positions sized in base quantums, with a notional value of
+	// `quantums * price`. This is the default perpetual type.
+	LinearPerpetual PerpetualType = iota
+	// InversePerpetual has positions sized in quote quantums and settled in the base asset, with a
+	// notional value of `quantums / price`.
+	InversePerpetual
+)
+
+var perpetualTypeStringMap = map[PerpetualType]string{
+	LinearPerpetual:  "LinearPerpetual",
+	InversePerpetual: "InversePerpetual",
+}
+
+func (t PerpetualType) String() string {
+	result, exists := perpetualTypeStringMap[t]
+	if !exists {
+		return "UnknownPerpetualType"
+	}
+	return result
+}
+
+// IsValid returns true if the perpetual type is a known perpetual type.
+func (t PerpetualType) IsValid() bool {
+	_, exists := perpetualTypeStringMap[t]
+	return exists
+}
+
 // PerpInfo contains all information needed to calculate margin requirements for a perpetual.
 type PerpInfo struct {
 	Perpetual     Perpetual
 	Price         pricestypes.MarketPrice
 	LiquidityTier LiquidityTier
+	// The contract type of the perpetual, as set in its params. Defaults to `LinearPerpetual`.
+	PerpetualType PerpetualType
+	// The cap on the absolute unsettled funding index delta of a position in the perpetual applied when
+	// the position is settled. Zero means uncapped.
+	MaxUnsettledFundingIndexDelta uint64
+	// The settlement price of the perpetual, in the exponent of its market price, if the perpetual is in
+	// settlement mode. Positions in the perpetual are valued at the settlement price instead of the market
+	// price. Zero means the perpetual is not in settlement mode.
+	SettlementPrice uint64
+	// The additional maintenance margin required of positions that make up a large share of the open
+	// interest of the perpetual. The zero value adds no margin.
+	ConcentrationMargin ConcentrationMargin
 }
 
 // PerpInfos is a map of PerpInfo objects, keyed by perpetualId.
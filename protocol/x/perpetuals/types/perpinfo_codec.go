@@ -0,0 +1,373 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+)
+
+// PerpInfosBinaryVersion is the version byte that prefixes the binary encoding of `PerpInfos`.
+const PerpInfosBinaryVersion byte = 10
+
+// MarshalBinary returns a compact binary encoding of the `PerpInfos`, used for IPC between the
+// application and its daemons. External APIs should continue to use the proto encoding of the
+// individual fields.
+//
+// The encoding starts with the version byte, followed by a table of the distinct liquidity tiers in
+// increasing id order and then the perpetuals in increasing id order. Perpetual ids are encoded as
+// the uvarint delta from the previous perpetual's id, and the market price and liquidity tier of a
+// perpetual are referenced by the perpetual's market id and liquidity tier id rather than repeated.
+// The confidence of market prices and the concentration margin of perpetuals, which are usually
+// zero, are encoded as uvarints. All other integer fields are fixed-width big-endian. Strings are prefixed with their uint16 length
+// and signed big integers are encoded as a sign byte followed by their uint16-length-prefixed
+// absolute value. Booleans are encoded as a single `0` or `1` byte. The deprecated `BasePositionNotional`
+// of liquidity tiers is not encoded, and the perpetual type is encoded once for both the perpetual params
+// and the `PerpInfo`, which must match.
+func (pi PerpInfos) MarshalBinary() ([]byte, error) {
+	liquidityTiers := make(map[uint32]LiquidityTier)
+	for id, info := range pi {
+		params := info.Perpetual.Params
+		if params.Id != id {
+			return nil, errorsmod.Wrapf(
+				ErrInvalidPerpInfosEncoding,
+				"perpetual id %d does not match key %d",
+				params.Id,
+				id,
+			)
+		}
+		if info.Price.Id != params.MarketId {
+			return nil, errorsmod.Wrapf(
+				ErrInvalidPerpInfosEncoding,
+				"market price id %d does not match market id %d of perpetual %d",
+				info.Price.Id,
+				params.MarketId,
+				id,
+			)
+		}
+		if info.LiquidityTier.Id != params.LiquidityTier {
+			return nil, errorsmod.Wrapf(
+				ErrInvalidPerpInfosEncoding,
+				"liquidity tier id %d does not match liquidity tier %d of perpetual %d",
+				info.LiquidityTier.Id,
+				params.LiquidityTier,
+				id,
+			)
+		}
+		if params.MarketType > math.MaxUint8 || params.MarketType < 0 {
+			return nil, errorsmod.Wrapf(ErrInvalidPerpInfosEncoding, "invalid market type %d", params.MarketType)
+		}
+		if !info.PerpetualType.IsValid() {
+			return nil, errorsmod.Wrapf(ErrInvalidPerpInfosEncoding, "invalid perpetual type %d", info.PerpetualType)
+		}
+		if uint32(info.PerpetualType) != params.PerpetualType {
+			return nil, errorsmod.Wrapf(
+				ErrInvalidPerpInfosEncoding,
+				"perpetual type %d does not match perpetual type %d of perpetual %d",
+				info.PerpetualType,
+				params.PerpetualType,
+				id,
+			)
+		}
+		tier := info.LiquidityTier
+		tier.BasePositionNotional = 0 //nolint:staticcheck
+		if existing, ok := liquidityTiers[tier.Id]; ok && existing != tier {
+			return nil, errorsmod.Wrapf(
+				ErrInvalidPerpInfosEncoding,
+				"perpetuals have different liquidity tiers with the same id %d",
+				tier.Id,
+			)
+		}
+		liquidityTiers[tier.Id] = tier
+	}
+
+	buf := new(bytes.Buffer)
+	buf.WriteByte(PerpInfosBinaryVersion)
+
+	writeUint32(buf, uint32(len(liquidityTiers)))
+	for _, id := range lib.GetSortedKeys[lib.Sortable[uint32]](liquidityTiers) {
+		tier := liquidityTiers[id]
+		writeUint32(buf, tier.Id)
+		if err := writeString(buf, tier.Name); err != nil {
+			return nil, err
+		}
+		writeUint32(buf, tier.InitialMarginPpm)
+		writeUint32(buf, tier.MaintenanceFractionPpm)
+		writeUint64(buf, tier.ImpactNotional)
+		writeUint64(buf, tier.OpenInterestLowerCap)
+		writeUint64(buf, tier.OpenInterestUpperCap)
+		writeUint32(buf, tier.ShortInitialMarginPpm)
+		writeUint32(buf, tier.ShortMaintenanceFractionPpm)
+		writeUint64(buf, tier.MaxPositionNotionalQuoteQuantums)
+		writeUint32(buf, tier.LiquidationPenaltyPpm)
+	}
+
+	writeUint32(buf, uint32(len(pi)))
+	prevId := uint32(0)
+	for _, id := range lib.GetSortedKeys[lib.Sortable[uint32]](pi) {
+		info := pi[id]
+		buf.Write(binary.AppendUvarint(nil, uint64(id-prevId)))
+		prevId = id
+
+		params := info.Perpetual.Params
+		if err := writeString(buf, params.Ticker); err != nil {
+			return nil, err
+		}
+		writeUint32(buf, params.MarketId)
+		writeUint32(buf, uint32(params.AtomicResolution))
+		writeUint32(buf, uint32(params.DefaultFundingPpm))
+		writeUint32(buf, params.LiquidityTier)
+		buf.WriteByte(byte(params.MarketType))
+		if err := writeBigInt(buf, info.Perpetual.FundingIndex.BigInt()); err != nil {
+			return nil, err
+		}
+		if err := writeBigInt(buf, info.Perpetual.OpenInterest.BigInt()); err != nil {
+			return nil, err
+		}
+		writeUint32(buf, uint32(info.Price.Exponent))
+		writeUint64(buf, info.Price.Price)
+		writeUvarint(buf, info.Price.Confidence)
+		writeBool(buf, info.Price.Negative)
+		buf.WriteByte(byte(info.PerpetualType))
+		writeUint64(buf, info.MaxUnsettledFundingIndexDelta)
+		writeUint64(buf, info.SettlementPrice)
+		writeUvarint(buf, uint64(info.ConcentrationMargin.ThresholdPpm))
+		writeUvarint(buf, uint64(info.ConcentrationMargin.AddOnPpm))
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes `PerpInfos` from the binary encoding returned by `MarshalBinary`,
+// replacing the contents of `pi`.
+func (pi *PerpInfos) UnmarshalBinary(data []byte) error {
+	r := bytes.NewReader(data)
+	version, err := r.ReadByte()
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidPerpInfosEncoding, "missing version")
+	}
+	if version != PerpInfosBinaryVersion {
+		return errorsmod.Wrapf(ErrInvalidPerpInfosEncoding, "unsupported version %d", version)
+	}
+	d := &perpInfosDecoder{r: r}
+
+	numTiers := d.readCount()
+	liquidityTiers := make(map[uint32]LiquidityTier, numTiers)
+	for i := uint32(0); i < numTiers && d.err == nil; i++ {
+		tier := LiquidityTier{
+			Id:                               d.readUint32(),
+			Name:                             d.readString(),
+			InitialMarginPpm:                 d.readUint32(),
+			MaintenanceFractionPpm:           d.readUint32(),
+			ImpactNotional:                   d.readUint64(),
+			OpenInterestLowerCap:             d.readUint64(),
+			OpenInterestUpperCap:             d.readUint64(),
+			ShortInitialMarginPpm:            d.readUint32(),
+			ShortMaintenanceFractionPpm:      d.readUint32(),
+			MaxPositionNotionalQuoteQuantums: d.readUint64(),
+			LiquidationPenaltyPpm:            d.readUint32(),
+		}
+		if _, exists := liquidityTiers[tier.Id]; exists && d.err == nil {
+			d.err = fmt.Errorf("duplicate liquidity tier id %d", tier.Id)
+		}
+		liquidityTiers[tier.Id] = tier
+	}
+
+	numPerps := d.readCount()
+	infos := make(PerpInfos, numPerps)
+	id := uint32(0)
+	for i := uint32(0); i < numPerps && d.err == nil; i++ {
+		delta, err := binary.ReadUvarint(r)
+		if err != nil {
+			return errorsmod.Wrap(ErrInvalidPerpInfosEncoding, "invalid perpetual id delta")
+		}
+		if i > 0 && delta == 0 {
+			return errorsmod.Wrap(ErrInvalidPerpInfosEncoding, "perpetual ids are not increasing")
+		}
+		if delta > uint64(math.MaxUint32-id) {
+			return errorsmod.Wrap(ErrInvalidPerpInfosEncoding, "perpetual id overflows uint32")
+		}
+		id += uint32(delta)
+
+		params := PerpetualParams{
+			Id:                id,
+			Ticker:            d.readString(),
+			MarketId:          d.readUint32(),
+			AtomicResolution:  int32(d.readUint32()),
+			DefaultFundingPpm: int32(d.readUint32()),
+			LiquidityTier:     d.readUint32(),
+			MarketType:        PerpetualMarketType(d.readN(1)[0]),
+		}
+		info := PerpInfo{
+			Perpetual: Perpetual{
+				Params:       params,
+				FundingIndex: d.readBigInt(),
+				OpenInterest: d.readBigInt(),
+			},
+			Price: pricestypes.MarketPrice{
+				Id:         params.MarketId,
+				Exponent:   int32(d.readUint32()),
+				Price:      d.readUint64(),
+				Confidence: d.readUvarint(),
+				Negative:   d.readBool(),
+			},
+			PerpetualType:                 PerpetualType(d.readN(1)[0]),
+			MaxUnsettledFundingIndexDelta: d.readUint64(),
+			SettlementPrice:               d.readUint64(),
+			ConcentrationMargin: ConcentrationMargin{
+				ThresholdPpm: d.readUvarint32(),
+				AddOnPpm:     d.readUvarint32(),
+			},
+		}
+		if !info.PerpetualType.IsValid() && d.err == nil {
+			d.err = fmt.Errorf("invalid perpetual type %d of perpetual %d", info.PerpetualType, id)
+		}
+		info.Perpetual.Params.PerpetualType = uint32(info.PerpetualType)
+		tier, ok := liquidityTiers[params.LiquidityTier]
+		if !ok && d.err == nil {
+			d.err = fmt.Errorf("liquidity tier %d of perpetual %d does not exist", params.LiquidityTier, id)
+		}
+		info.LiquidityTier = tier
+		infos[id] = info
+	}
+	if d.err != nil {
+		return errorsmod.Wrap(ErrInvalidPerpInfosEncoding, d.err.Error())
+	}
+	if r.Len() != 0 {
+		return errorsmod.Wrapf(ErrInvalidPerpInfosEncoding, "%d trailing bytes", r.Len())
+	}
+
+	*pi = infos
+	return nil
+}
+
+func writeUint32(buf *bytes.Buffer, v uint32) {
+	buf.Write(binary.BigEndian.AppendUint32(nil, v))
+}
+
+func writeUint64(buf *bytes.Buffer, v uint64) {
+	buf.Write(binary.BigEndian.AppendUint64(nil, v))
+}
+
+func writeUvarint(buf *bytes.Buffer, v uint64) {
+	buf.Write(binary.AppendUvarint(nil, v))
+}
+
+func writeBool(buf *bytes.Buffer, b bool) {
+	if b {
+		buf.WriteByte(1)
+	} else {
+		buf.WriteByte(0)
+	}
+}
+
+func writeBytes(buf *bytes.Buffer, b []byte) error {
+	if len(b) > math.MaxUint16 {
+		return errorsmod.Wrapf(ErrInvalidPerpInfosEncoding, "field length %d exceeds uint16", len(b))
+	}
+	buf.Write(binary.BigEndian.AppendUint16(nil, uint16(len(b))))
+	buf.Write(b)
+	return nil
+}
+
+func writeString(buf *bytes.Buffer, s string) error {
+	return writeBytes(buf, []byte(s))
+}
+
+func writeBigInt(buf *bytes.Buffer, i *big.Int) error {
+	if i == nil {
+		i = new(big.Int)
+	}
+	buf.WriteByte(byte(i.Sign() + 1))
+	return writeBytes(buf, i.Bytes())
+}
+
+// perpInfosDecoder reads the fields of the binary encoding of `PerpInfos`. The first error
+// encountered is kept and all subsequent reads return zero values.
+type perpInfosDecoder struct {
+	r   *bytes.Reader
+	err error
+}
+
+func (d *perpInfosDecoder) readN(n int) []byte {
+	if d.err != nil {
+		return make([]byte, n)
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(d.r, b); err != nil {
+		d.err = io.ErrUnexpectedEOF
+	}
+	return b
+}
+
+// readCount reads the number of entries of a section. Each entry takes at least one byte, which
+// bounds the allocations made for malformed input.
+func (d *perpInfosDecoder) readCount() uint32 {
+	count := d.readUint32()
+	if d.err == nil && uint64(count) > uint64(d.r.Len()) {
+		d.err = fmt.Errorf("entry count %d exceeds input length", count)
+		return 0
+	}
+	return count
+}
+
+func (d *perpInfosDecoder) readUint32() uint32 {
+	return binary.BigEndian.Uint32(d.readN(4))
+}
+
+func (d *perpInfosDecoder) readUint64() uint64 {
+	return binary.BigEndian.Uint64(d.readN(8))
+}
+
+func (d *perpInfosDecoder) readUvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, err := binary.ReadUvarint(d.r)
+	if err != nil {
+		d.err = io.ErrUnexpectedEOF
+	}
+	return v
+}
+
+func (d *perpInfosDecoder) readUvarint32() uint32 {
+	v := d.readUvarint()
+	if v > math.MaxUint32 && d.err == nil {
+		d.err = fmt.Errorf("uvarint %d overflows uint32", v)
+	}
+	return uint32(v)
+}
+
+func (d *perpInfosDecoder) readBool() bool {
+	b := d.readN(1)[0]
+	if b > 1 && d.err == nil {
+		d.err = fmt.Errorf("invalid bool byte %d", b)
+	}
+	return b == 1
+}
+
+func (d *perpInfosDecoder) readBytes() []byte {
+	return d.readN(int(binary.BigEndian.Uint16(d.readN(2))))
+}
+
+func (d *perpInfosDecoder) readString() string {
+	return string(d.readBytes())
+}
+
+func (d *perpInfosDecoder) readBigInt() dtypes.SerializableInt {
+	sign := d.readN(1)[0]
+	i := new(big.Int).SetBytes(d.readBytes())
+	switch {
+	case sign == 0:
+		i.Neg(i)
+	case sign > 2 && d.err == nil:
+		d.err = fmt.Errorf("invalid sign byte %d", sign)
+	}
+	return dtypes.NewIntFromBigInt(i)
+}
@@ -0,0 +1,234 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createPerpInfos(numPerpetuals int) types.PerpInfos {
+	perpInfos := make(types.PerpInfos, numPerpetuals)
+	for i := 0; i < numPerpetuals; i++ {
+		// Use non-contiguous ids to exercise the delta encoding.
+		id := uint32(i * 3)
+		perpetual := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+		perpetual.Params.Id = id
+		perpetual.Params.MarketId = id
+		perpetual.FundingIndex = dtypes.NewInt(int64(i) * -1_234_567)
+		perpetual.OpenInterest = dtypes.NewIntFromBigInt(
+			new(big.Int).Lsh(big.NewInt(int64(i)+1), 70),
+		)
+		liquidityTier := constants.LiquidityTiers[i%len(constants.LiquidityTiers)]
+		perpetual.Params.LiquidityTier = liquidityTier.Id
+		perpetual.Params.PerpetualType = uint32(i % 2)
+		// The deprecated base position notional is not encoded.
+		liquidityTier.BasePositionNotional = 0 //nolint:staticcheck
+		liquidityTier.LiquidationPenaltyPpm = liquidityTier.InitialMarginPpm / 2
+
+		perpInfos[id] = types.PerpInfo{
+			Perpetual: perpetual,
+			Price: pricestypes.MarketPrice{
+				Id:         id,
+				Exponent:   -5,
+				Price:      uint64(i+1) * 5_000_000_000,
+				Confidence: uint64(i) * 1_000_000,
+				Negative:   i%3 == 2,
+			},
+			LiquidityTier:                 liquidityTier,
+			PerpetualType:                 types.PerpetualType(perpetual.Params.PerpetualType),
+			MaxUnsettledFundingIndexDelta: uint64(i) * 1_000,
+			SettlementPrice:               uint64(i%2) * 4_000_000_000,
+			ConcentrationMargin: types.ConcentrationMargin{
+				ThresholdPpm: uint32(i%4) * 100_000,
+				AddOnPpm:     uint32(i%4) * 10_000,
+			},
+		}
+	}
+	return perpInfos
+}
+
+// protoSize returns the number of bytes needed to encode the `PerpInfos` as proto messages.
+func protoSize(perpInfos types.PerpInfos) int {
+	size := 0
+	for _, info := range perpInfos {
+		size += info.Perpetual.Size() + info.Price.Size() + info.LiquidityTier.Size()
+		// Fields of `PerpInfo` without a proto message are counted as proto uint64 fields.
+		size += (&gogotypes.UInt64Value{Value: uint64(info.PerpetualType)}).Size()
+		size += (&gogotypes.UInt64Value{Value: info.MaxUnsettledFundingIndexDelta}).Size()
+		size += (&gogotypes.UInt64Value{Value: info.SettlementPrice}).Size()
+		size += (&gogotypes.UInt64Value{Value: uint64(info.ConcentrationMargin.ThresholdPpm)}).Size()
+		size += (&gogotypes.UInt64Value{Value: uint64(info.ConcentrationMargin.AddOnPpm)}).Size()
+	}
+	return size
+}
+
+func TestPerpInfos_BinaryRoundTrip(t *testing.T) {
+	tests := map[string]struct {
+		perpInfos types.PerpInfos
+	}{
+		"empty": {
+			perpInfos: types.PerpInfos{},
+		},
+		"single perpetual with id zero": {
+			perpInfos: createPerpInfos(1),
+		},
+		"many perpetuals": {
+			perpInfos: createPerpInfos(100),
+		},
+		"perpetual with max id": {
+			perpInfos: types.PerpInfos{
+				0: createPerpInfos(1)[0],
+				1<<32 - 1: func() types.PerpInfo {
+					info := createPerpInfos(1)[0]
+					info.Perpetual.Params.Id = 1<<32 - 1
+					info.Perpetual.FundingIndex = dtypes.NewInt(-1)
+					return info
+				}(),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			bz, err := tc.perpInfos.MarshalBinary()
+			require.NoError(t, err)
+			require.Equal(t, types.PerpInfosBinaryVersion, bz[0])
+
+			var decoded types.PerpInfos
+			require.NoError(t, decoded.UnmarshalBinary(bz))
+			require.Equal(t, tc.perpInfos, decoded)
+
+			// The encoding is deterministic.
+			reencoded, err := decoded.MarshalBinary()
+			require.NoError(t, err)
+			require.Equal(t, bz, reencoded)
+		})
+	}
+}
+
+func TestPerpInfos_BinarySmallerThanProto(t *testing.T) {
+	perpInfos := createPerpInfos(100)
+	bz, err := perpInfos.MarshalBinary()
+	require.NoError(t, err)
+	require.Less(t, len(bz), protoSize(perpInfos))
+}
+
+func TestPerpInfos_MarshalBinary_Invalid(t *testing.T) {
+	tests := map[string]func(perpInfos types.PerpInfos){
+		"perpetual id does not match key": func(perpInfos types.PerpInfos) {
+			perpInfos[1] = perpInfos[0]
+		},
+		"market price id does not match market id": func(perpInfos types.PerpInfos) {
+			info := perpInfos[0]
+			info.Price.Id++
+			perpInfos[0] = info
+		},
+		"liquidity tier id does not match liquidity tier": func(perpInfos types.PerpInfos) {
+			info := perpInfos[0]
+			info.LiquidityTier.Id++
+			perpInfos[0] = info
+		},
+		"perpetual type does not match perpetual type of params": func(perpInfos types.PerpInfos) {
+			info := perpInfos[0]
+			info.PerpetualType = types.InversePerpetual
+			perpInfos[0] = info
+		},
+		"different liquidity tiers with the same id": func(perpInfos types.PerpInfos) {
+			info := perpInfos[3]
+			info.Perpetual.Params.LiquidityTier = perpInfos[0].LiquidityTier.Id
+			info.LiquidityTier = perpInfos[0].LiquidityTier
+			info.LiquidityTier.InitialMarginPpm++
+			perpInfos[3] = info
+		},
+	}
+
+	for name, modify := range tests {
+		t.Run(name, func(t *testing.T) {
+			perpInfos := createPerpInfos(2)
+			modify(perpInfos)
+			_, err := perpInfos.MarshalBinary()
+			require.ErrorIs(t, err, types.ErrInvalidPerpInfosEncoding)
+		})
+	}
+}
+
+func TestPerpInfos_UnmarshalBinary_Invalid(t *testing.T) {
+	bz, err := createPerpInfos(2).MarshalBinary()
+	require.NoError(t, err)
+
+	// Two perpetuals with ids 0 and 1 that are otherwise identical and share a single liquidity tier.
+	perpetual := createPerpInfos(1)[0]
+	otherPerpetual := perpetual
+	otherPerpetual.Perpetual.Params.Id = 1
+	twoPerpetuals, err := types.PerpInfos{0: perpetual, 1: otherPerpetual}.MarshalBinary()
+	require.NoError(t, err)
+	// The version, the liquidity tier count, the liquidity tier and the perpetual count.
+	perpetualsOffset := 1 + 4 + (58 + len(perpetual.LiquidityTier.Name)) + 4
+	perpetualLength := (len(twoPerpetuals) - perpetualsOffset) / 2
+
+	tests := map[string][]byte{
+		"empty":               {},
+		"unsupported version": append([]byte{types.PerpInfosBinaryVersion + 1}, bz[1:]...),
+		"missing count":       {types.PerpInfosBinaryVersion, 0},
+		"count exceeds input": {types.PerpInfosBinaryVersion, 0, 0, 0, 2, 0},
+		"truncated":           bz[:len(bz)-1],
+		"trailing bytes":      append(append([]byte{}, bz...), 0),
+		"duplicate perpetual id": func() []byte {
+			duplicated := append([]byte{}, twoPerpetuals...)
+			// Set the id delta of the second perpetual to zero.
+			duplicated[perpetualsOffset+perpetualLength] = 0
+			return duplicated
+		}(),
+		"invalid negative price flag": func() []byte {
+			invalid := append([]byte{}, twoPerpetuals...)
+			// The flag is followed only by the perpetual type, the unsettled funding index delta cap, the
+			// settlement price and the zero concentration margin of the last perpetual.
+			invalid[len(invalid)-20] = 2
+			return invalid
+		}(),
+		"missing liquidity tier": func() []byte {
+			// Remove the liquidity tier table.
+			missing := []byte{types.PerpInfosBinaryVersion, 0, 0, 0, 0}
+			return append(missing, twoPerpetuals[perpetualsOffset-4:]...)
+		}(),
+	}
+
+	for name, bz := range tests {
+		t.Run(name, func(t *testing.T) {
+			var decoded types.PerpInfos
+			err := decoded.UnmarshalBinary(bz)
+			require.ErrorIs(t, err, types.ErrInvalidPerpInfosEncoding)
+			require.Nil(t, decoded)
+		})
+	}
+}
+
+func BenchmarkPerpInfos_MarshalBinary(b *testing.B) {
+	perpInfos := createPerpInfos(1_000)
+	bz, err := perpInfos.MarshalBinary()
+	require.NoError(b, err)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = perpInfos.MarshalBinary()
+	}
+	b.ReportMetric(float64(len(bz)), "binary-bytes")
+	b.ReportMetric(float64(protoSize(perpInfos)), "proto-bytes")
+}
+
+func BenchmarkPerpInfos_UnmarshalBinary(b *testing.B) {
+	bz, err := createPerpInfos(1_000).MarshalBinary()
+	require.NoError(b, err)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var decoded types.PerpInfos
+		_ = decoded.UnmarshalBinary(bz)
+	}
+}
@@ -648,6 +648,209 @@ func (m *QueryNextPerpetualIdResponse) GetNextPerpetualId() uint32 {
 	return 0
 }
 
+// QueryHistoricalFundingIndexRequest is the request type for the
+// HistoricalFundingIndex RPC method.
+type QueryHistoricalFundingIndexRequest struct {
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	Height      uint32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryHistoricalFundingIndexRequest) Reset()         { *m = QueryHistoricalFundingIndexRequest{} }
+func (m *QueryHistoricalFundingIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalFundingIndexRequest) ProtoMessage()    {}
+func (*QueryHistoricalFundingIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{14}
+}
+func (m *QueryHistoricalFundingIndexRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricalFundingIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricalFundingIndexRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHistoricalFundingIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalFundingIndexRequest.Merge(m, src)
+}
+func (m *QueryHistoricalFundingIndexRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricalFundingIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalFundingIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoricalFundingIndexRequest proto.InternalMessageInfo
+
+func (m *QueryHistoricalFundingIndexRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *QueryHistoricalFundingIndexRequest) GetHeight() uint32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryHistoricalFundingIndexResponse is the response type for the
+// HistoricalFundingIndex RPC method. The snapshot is the latest one recorded at
+// or before the requested height.
+type QueryHistoricalFundingIndexResponse struct {
+	Snapshot FundingIndexSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot"`
+}
+
+func (m *QueryHistoricalFundingIndexResponse) Reset()         { *m = QueryHistoricalFundingIndexResponse{} }
+func (m *QueryHistoricalFundingIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalFundingIndexResponse) ProtoMessage()    {}
+func (*QueryHistoricalFundingIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{15}
+}
+func (m *QueryHistoricalFundingIndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricalFundingIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricalFundingIndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHistoricalFundingIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalFundingIndexResponse.Merge(m, src)
+}
+func (m *QueryHistoricalFundingIndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricalFundingIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalFundingIndexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoricalFundingIndexResponse proto.InternalMessageInfo
+
+func (m *QueryHistoricalFundingIndexResponse) GetSnapshot() FundingIndexSnapshot {
+	if m != nil {
+		return m.Snapshot
+	}
+	return FundingIndexSnapshot{}
+}
+
+// QueryHistoricalMarginFractionsRequest is the request type for the
+// HistoricalMarginFractions RPC method.
+type QueryHistoricalMarginFractionsRequest struct {
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	Epoch       uint32 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (m *QueryHistoricalMarginFractionsRequest) Reset()         { *m = QueryHistoricalMarginFractionsRequest{} }
+func (m *QueryHistoricalMarginFractionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalMarginFractionsRequest) ProtoMessage()    {}
+func (*QueryHistoricalMarginFractionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{16}
+}
+func (m *QueryHistoricalMarginFractionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricalMarginFractionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricalMarginFractionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHistoricalMarginFractionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalMarginFractionsRequest.Merge(m, src)
+}
+func (m *QueryHistoricalMarginFractionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricalMarginFractionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalMarginFractionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoricalMarginFractionsRequest proto.InternalMessageInfo
+
+func (m *QueryHistoricalMarginFractionsRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *QueryHistoricalMarginFractionsRequest) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// QueryHistoricalMarginFractionsResponse is the response type for the
+// HistoricalMarginFractions RPC method.
+type QueryHistoricalMarginFractionsResponse struct {
+	MarginFractions MarginFractions `protobuf:"bytes,1,opt,name=margin_fractions,json=marginFractions,proto3" json:"margin_fractions"`
+}
+
+func (m *QueryHistoricalMarginFractionsResponse) Reset() {
+	*m = QueryHistoricalMarginFractionsResponse{}
+}
+func (m *QueryHistoricalMarginFractionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalMarginFractionsResponse) ProtoMessage()    {}
+func (*QueryHistoricalMarginFractionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{17}
+}
+func (m *QueryHistoricalMarginFractionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricalMarginFractionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricalMarginFractionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHistoricalMarginFractionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalMarginFractionsResponse.Merge(m, src)
+}
+func (m *QueryHistoricalMarginFractionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricalMarginFractionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalMarginFractionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoricalMarginFractionsResponse proto.InternalMessageInfo
+
+func (m *QueryHistoricalMarginFractionsResponse) GetMarginFractions() MarginFractions {
+	if m != nil {
+		return m.MarginFractions
+	}
+	return MarginFractions{}
+}
+
 func init() {
 	proto.RegisterType((*QueryPerpetualRequest)(nil), "dydxprotocol.perpetuals.QueryPerpetualRequest")
 	proto.RegisterType((*QueryPerpetualResponse)(nil), "dydxprotocol.perpetuals.QueryPerpetualResponse")
@@ -663,6 +866,10 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "dydxprotocol.perpetuals.QueryParamsResponse")
 	proto.RegisterType((*QueryNextPerpetualIdRequest)(nil), "dydxprotocol.perpetuals.QueryNextPerpetualIdRequest")
 	proto.RegisterType((*QueryNextPerpetualIdResponse)(nil), "dydxprotocol.perpetuals.QueryNextPerpetualIdResponse")
+	proto.RegisterType((*QueryHistoricalFundingIndexRequest)(nil), "dydxprotocol.perpetuals.QueryHistoricalFundingIndexRequest")
+	proto.RegisterType((*QueryHistoricalFundingIndexResponse)(nil), "dydxprotocol.perpetuals.QueryHistoricalFundingIndexResponse")
+	proto.RegisterType((*QueryHistoricalMarginFractionsRequest)(nil), "dydxprotocol.perpetuals.QueryHistoricalMarginFractionsRequest")
+	proto.RegisterType((*QueryHistoricalMarginFractionsResponse)(nil), "dydxprotocol.perpetuals.QueryHistoricalMarginFractionsResponse")
 }
 
 func init() {
@@ -670,56 +877,70 @@ func init() {
 }
 
 var fileDescriptor_13b6d29860ccef6b = []byte{
-	// 780 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xbf, 0x6f, 0xd3, 0x4c,
-	0x1c, 0xc6, 0xe3, 0xbc, 0x2f, 0x95, 0xfa, 0xa5, 0x4d, 0xd4, 0xa3, 0x40, 0x31, 0x6d, 0x0a, 0xa6,
-	0x34, 0x25, 0x14, 0x1f, 0x4d, 0x0b, 0x2c, 0x30, 0xd0, 0xa1, 0x08, 0x84, 0x50, 0x09, 0xa5, 0x03,
-	0x4b, 0x70, 0x92, 0x93, 0x6b, 0xc9, 0xf1, 0xb9, 0xf1, 0xa5, 0x4a, 0x84, 0x58, 0x98, 0x19, 0x90,
-	0x98, 0x99, 0x80, 0xb1, 0x0b, 0x03, 0x33, 0x63, 0xc7, 0x4a, 0x2c, 0x4c, 0x08, 0xb5, 0xfc, 0x21,
-	0x28, 0x77, 0x67, 0x27, 0x4e, 0xed, 0x3a, 0xa9, 0xba, 0x45, 0xfe, 0xfe, 0x78, 0x3e, 0xf7, 0xd8,
-	0xf7, 0x28, 0x70, 0xad, 0xd6, 0xae, 0xb5, 0xdc, 0x06, 0x65, 0xb4, 0x4a, 0x6d, 0xec, 0x92, 0x86,
-	0x4b, 0x58, 0xd3, 0xb0, 0x3d, 0xbc, 0xdd, 0x24, 0x8d, 0xb6, 0xce, 0x2b, 0xe8, 0x62, 0x6f, 0x93,
-	0xde, 0x6d, 0x52, 0x27, 0x4d, 0x6a, 0x52, 0x5e, 0xc0, 0x9d, 0x5f, 0xa2, 0x5d, 0x9d, 0x36, 0x29,
-	0x35, 0x6d, 0x82, 0x0d, 0xd7, 0xc2, 0x86, 0xe3, 0x50, 0x66, 0x30, 0x8b, 0x3a, 0x9e, 0xac, 0x16,
-	0xaa, 0xd4, 0xab, 0x53, 0x0f, 0x57, 0x0c, 0x8f, 0x08, 0x15, 0xbc, 0xb3, 0x54, 0x21, 0xcc, 0x58,
-	0xc2, 0xae, 0x61, 0x5a, 0x0e, 0x6f, 0x96, 0xbd, 0x73, 0x71, 0x74, 0xae, 0xd1, 0x30, 0xea, 0xfe,
-	0xc6, 0x7c, 0x6c, 0x97, 0xff, 0x53, 0x34, 0x6a, 0x79, 0x38, 0xff, 0xbc, 0x23, 0xb8, 0xee, 0x3f,
-	0x2f, 0x91, 0xed, 0x26, 0xf1, 0x18, 0xca, 0x40, 0xda, 0xaa, 0x4d, 0x29, 0x57, 0x94, 0x85, 0xf1,
-	0x52, 0xda, 0xaa, 0x69, 0xaf, 0xe1, 0x42, 0x7f, 0xa3, 0xe7, 0x52, 0xc7, 0x23, 0x68, 0x0d, 0x46,
-	0x83, 0xad, 0x7c, 0xe0, 0x6c, 0x51, 0xd3, 0x63, 0xec, 0xd1, 0x83, 0xf1, 0xd5, 0xff, 0xf7, 0x7e,
-	0xcf, 0xa6, 0x4a, 0xdd, 0x51, 0xad, 0x0a, 0x97, 0xb8, 0xc2, 0x43, 0xdb, 0x0e, 0xba, 0x3c, 0x1f,
-	0x67, 0x0d, 0xa0, 0x6b, 0x85, 0x54, 0x99, 0xd7, 0x85, 0x6f, 0x7a, 0xc7, 0x37, 0x5d, 0xbc, 0x1d,
-	0xe9, 0x9b, 0xbe, 0x6e, 0x98, 0x44, 0xce, 0x96, 0x7a, 0x26, 0xb5, 0x5d, 0x05, 0xd4, 0x28, 0x95,
-	0xe8, 0xb3, 0xfc, 0x77, 0xc2, 0xb3, 0xa0, 0x47, 0x21, 0xdc, 0x34, 0xc7, 0xcd, 0x27, 0xe2, 0x0a,
-	0x88, 0x10, 0xaf, 0x09, 0x33, 0x3e, 0xee, 0x53, 0x6b, 0xbb, 0x69, 0xd5, 0x2c, 0xd6, 0xde, 0xb0,
-	0x48, 0xe3, 0xd4, 0x8d, 0xf9, 0xa1, 0x40, 0x2e, 0x4e, 0x49, 0x9a, 0xf3, 0x12, 0xb2, 0xb6, 0x5f,
-	0x29, 0xb3, 0x4e, 0x49, 0x5a, 0x34, 0x1f, 0x6b, 0x51, 0x68, 0x93, 0xb4, 0x29, 0x63, 0x87, 0xd6,
-	0x9f, 0x9e, 0x57, 0x2a, 0x4c, 0x89, 0x4f, 0xb4, 0x41, 0xea, 0x56, 0xb3, 0xbe, 0x49, 0x19, 0xf1,
-	0x6d, 0xd2, 0xea, 0xf2, 0xe3, 0x0a, 0xd7, 0xe4, 0xc1, 0xd6, 0x61, 0xdc, 0x15, 0xcf, 0xcb, 0x3b,
-	0x9d, 0x82, 0xb4, 0xf1, 0x7a, 0xfc, 0x9b, 0x17, 0xdd, 0x2f, 0x18, 0x6d, 0x10, 0x79, 0xaa, 0x31,
-	0xb7, 0x67, 0xb3, 0x36, 0x2d, 0xbf, 0x32, 0xbf, 0xd1, 0xa8, 0xbb, 0x76, 0x17, 0xc6, 0x83, 0xcb,
-	0x91, 0x55, 0x89, 0xb3, 0x01, 0x59, 0x1f, 0xc7, 0x13, 0xa5, 0x93, 0x00, 0x65, 0xdc, 0xd0, 0x76,
-	0x6d, 0x12, 0x90, 0x10, 0xe5, 0x39, 0xe1, 0xa3, 0x6c, 0xc0, 0xb9, 0xd0, 0x53, 0x89, 0xf0, 0x00,
-	0x46, 0x44, 0x9e, 0x48, 0xe5, 0xd9, 0x78, 0x65, 0xde, 0x26, 0x35, 0xe5, 0x90, 0x36, 0x23, 0x0f,
-	0xf8, 0x8c, 0xb4, 0x58, 0x70, 0x4b, 0x1e, 0xd7, 0x7c, 0xd1, 0x27, 0x30, 0x1d, 0x5d, 0x96, 0xea,
-	0x05, 0x98, 0x70, 0x48, 0x8b, 0x95, 0x03, 0x99, 0x72, 0x10, 0x45, 0x59, 0x27, 0x3c, 0x53, 0xfc,
-	0x3c, 0x0a, 0x67, 0xf8, 0x32, 0xf4, 0x49, 0x81, 0xd1, 0xa0, 0x82, 0xf4, 0x58, 0xe2, 0xc8, 0xbc,
-	0x53, 0xf1, 0xc0, 0xfd, 0x02, 0x52, 0xc3, 0xef, 0x7e, 0xfe, 0xfd, 0x98, 0xbe, 0x81, 0xf2, 0x38,
-	0x31, 0x6b, 0xf1, 0x1b, 0xab, 0xf6, 0x16, 0x7d, 0x51, 0x60, 0x3c, 0x94, 0x3a, 0xa8, 0x78, 0xbc,
-	0x66, 0x54, 0x10, 0xaa, 0xcb, 0x43, 0xcd, 0x48, 0xd6, 0x02, 0x67, 0x9d, 0x43, 0x5a, 0x32, 0x2b,
-	0xfa, 0xae, 0xc0, 0xc4, 0x91, 0x0c, 0x40, 0x77, 0x13, 0x65, 0x23, 0xe3, 0x49, 0xbd, 0x37, 0xf4,
-	0x9c, 0x44, 0xbe, 0xcd, 0x91, 0x0b, 0x68, 0x21, 0x16, 0xb9, 0x2f, 0x8b, 0xd0, 0x57, 0x05, 0xc6,
-	0x7a, 0xaf, 0x37, 0x5a, 0x4a, 0x78, 0xa5, 0x47, 0x63, 0x42, 0x2d, 0x0e, 0x33, 0x22, 0x49, 0x75,
-	0x4e, 0xba, 0x80, 0xe6, 0xe3, 0xcd, 0xed, 0x0d, 0x17, 0xb4, 0xab, 0x40, 0x26, 0x7c, 0xf3, 0xd1,
-	0xf2, 0x40, 0xb2, 0xe1, 0x14, 0x51, 0x57, 0x86, 0x1b, 0x1a, 0xd8, 0xd7, 0xbe, 0xec, 0x41, 0xef,
-	0x15, 0x18, 0x11, 0xb7, 0x1c, 0xdd, 0x4c, 0x90, 0xec, 0x8d, 0x16, 0x75, 0x71, 0xb0, 0x66, 0xc9,
-	0x95, 0xe7, 0x5c, 0x57, 0xd1, 0x2c, 0x3e, 0xfe, 0x0f, 0x0e, 0xfa, 0xa6, 0x40, 0xb6, 0x2f, 0x38,
-	0x50, 0x82, 0x15, 0xd1, 0x31, 0xa4, 0xde, 0x19, 0x72, 0x4a, 0x92, 0x16, 0x39, 0xe9, 0x22, 0x2a,
-	0xc4, 0x92, 0x1e, 0x09, 0xaf, 0xd5, 0xcd, 0xbd, 0x83, 0x9c, 0xb2, 0x7f, 0x90, 0x53, 0xfe, 0x1c,
-	0xe4, 0x94, 0x0f, 0x87, 0xb9, 0xd4, 0xfe, 0x61, 0x2e, 0xf5, 0xeb, 0x30, 0x97, 0x7a, 0x75, 0xdf,
-	0xb4, 0xd8, 0x56, 0xb3, 0xa2, 0x57, 0x69, 0x3d, 0xbc, 0x6f, 0x67, 0xe5, 0x56, 0x75, 0xcb, 0xb0,
-	0x1c, 0x1c, 0x3c, 0x69, 0xf5, 0x6a, 0xb0, 0xb6, 0x4b, 0xbc, 0xca, 0x08, 0x2f, 0x2e, 0xff, 0x0b,
-	0x00, 0x00, 0xff, 0xff, 0x50, 0x66, 0xce, 0xd7, 0xb4, 0x0a, 0x00, 0x00,
+	// 1006 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0xdb, 0x54,
+	0x1c, 0xaf, 0x0b, 0xab, 0xd8, 0x77, 0x4d, 0xc3, 0x1e, 0xa5, 0xac, 0xa6, 0x4b, 0x99, 0xb7, 0x35,
+	0x25, 0x6c, 0x36, 0x4d, 0x07, 0x1c, 0x18, 0x20, 0x76, 0x28, 0x94, 0x31, 0xe8, 0xd2, 0x32, 0x09,
+	0x2e, 0x99, 0x1b, 0xbf, 0x39, 0x4f, 0xb2, 0xfd, 0x5c, 0xdb, 0xa9, 0x52, 0x55, 0x95, 0x10, 0x5c,
+	0x39, 0x20, 0x71, 0xe6, 0x06, 0xc7, 0x5d, 0x38, 0x70, 0xe6, 0xb8, 0xe3, 0x24, 0x2e, 0x9c, 0x10,
+	0x6a, 0x11, 0x77, 0xfe, 0x83, 0x29, 0xef, 0x7d, 0xed, 0xd8, 0xa9, 0x9d, 0x1f, 0xd5, 0x6e, 0xc9,
+	0xfb, 0xfe, 0xf8, 0xfc, 0xb0, 0xf3, 0x3e, 0x81, 0xab, 0xd6, 0x81, 0xd5, 0xf5, 0x03, 0x1e, 0xf1,
+	0x16, 0x77, 0x0c, 0x9f, 0x06, 0x3e, 0x8d, 0x3a, 0xa6, 0x13, 0x1a, 0x7b, 0x1d, 0x1a, 0x1c, 0xe8,
+	0xa2, 0x42, 0x5e, 0x4b, 0x37, 0xe9, 0xfd, 0x26, 0x75, 0xde, 0xe6, 0x36, 0x17, 0x05, 0xa3, 0xf7,
+	0x49, 0xb6, 0xab, 0x4b, 0x36, 0xe7, 0xb6, 0x43, 0x0d, 0xd3, 0x67, 0x86, 0xe9, 0x79, 0x3c, 0x32,
+	0x23, 0xc6, 0xbd, 0x10, 0xab, 0xb5, 0x16, 0x0f, 0x5d, 0x1e, 0x1a, 0xbb, 0x66, 0x48, 0x25, 0x8a,
+	0xb1, 0xbf, 0xb6, 0x4b, 0x23, 0x73, 0xcd, 0xf0, 0x4d, 0x9b, 0x79, 0xa2, 0x19, 0x7b, 0xaf, 0x15,
+	0xb1, 0xf3, 0xcd, 0xc0, 0x74, 0xe3, 0x8d, 0xd5, 0xc2, 0xae, 0xf8, 0xa3, 0x6c, 0xd4, 0xaa, 0xf0,
+	0xea, 0xfd, 0x1e, 0xe0, 0x56, 0x7c, 0xde, 0xa0, 0x7b, 0x1d, 0x1a, 0x46, 0x64, 0x0e, 0xa6, 0x99,
+	0x75, 0x49, 0x79, 0x43, 0x59, 0x2d, 0x35, 0xa6, 0x99, 0xa5, 0x3d, 0x84, 0x85, 0xc1, 0xc6, 0xd0,
+	0xe7, 0x5e, 0x48, 0xc9, 0x06, 0x9c, 0x4f, 0xb6, 0x8a, 0x81, 0x0b, 0x75, 0x4d, 0x2f, 0xb0, 0x47,
+	0x4f, 0xc6, 0xef, 0xbc, 0xf8, 0xe4, 0xef, 0xe5, 0xa9, 0x46, 0x7f, 0x54, 0x6b, 0xc1, 0xa2, 0x40,
+	0xf8, 0xd8, 0x71, 0x92, 0xae, 0x30, 0xa6, 0xb3, 0x01, 0xd0, 0xb7, 0x02, 0x51, 0x56, 0x74, 0xe9,
+	0x9b, 0xde, 0xf3, 0x4d, 0x97, 0x4f, 0x07, 0x7d, 0xd3, 0xb7, 0x4c, 0x9b, 0xe2, 0x6c, 0x23, 0x35,
+	0xa9, 0x3d, 0x56, 0x40, 0xcd, 0x43, 0xc9, 0xd7, 0xf2, 0xc2, 0x19, 0xb5, 0x90, 0x4f, 0x32, 0x74,
+	0xa7, 0x05, 0xdd, 0xea, 0x48, 0xba, 0x92, 0x44, 0x86, 0xaf, 0x0d, 0x97, 0x63, 0xba, 0x9f, 0xb3,
+	0xbd, 0x0e, 0xb3, 0x58, 0x74, 0xb0, 0xc3, 0x68, 0xf0, 0xdc, 0x8d, 0xf9, 0x43, 0x81, 0x4a, 0x11,
+	0x12, 0x9a, 0xf3, 0x15, 0x94, 0x9d, 0xb8, 0xd2, 0x8c, 0x7a, 0x25, 0xb4, 0x68, 0xa5, 0xd0, 0xa2,
+	0xcc, 0x26, 0xb4, 0x69, 0xce, 0xc9, 0xac, 0x7f, 0x7e, 0x5e, 0xa9, 0x70, 0x49, 0xbe, 0xa2, 0x01,
+	0x75, 0x59, 0xc7, 0x7d, 0xc0, 0x23, 0x1a, 0xdb, 0xa4, 0xb9, 0xb0, 0x98, 0x53, 0x43, 0x61, 0x5b,
+	0x50, 0xf2, 0xe5, 0x79, 0x73, 0xbf, 0x57, 0x40, 0x1b, 0xaf, 0x17, 0x3f, 0x79, 0xd9, 0xbd, 0x1d,
+	0xf1, 0x80, 0xa2, 0xaa, 0x59, 0x3f, 0xb5, 0x59, 0x5b, 0xc2, 0xb7, 0x2c, 0x6e, 0x34, 0x5d, 0xdf,
+	0xe9, 0x93, 0x09, 0xe1, 0xf5, 0xdc, 0x2a, 0xd2, 0xd9, 0x81, 0x72, 0x4c, 0x27, 0x94, 0xa5, 0xb3,
+	0x10, 0x9a, 0xf3, 0x33, 0xdb, 0xb5, 0x79, 0x20, 0x12, 0x54, 0xdc, 0x13, 0x31, 0x95, 0x1d, 0x78,
+	0x25, 0x73, 0x8a, 0x14, 0x3e, 0x80, 0x19, 0x79, 0x9f, 0x20, 0xf2, 0x72, 0x31, 0xb2, 0x68, 0x43,
+	0x4c, 0x1c, 0xd2, 0x2e, 0xa3, 0xc0, 0x2f, 0x68, 0x37, 0x4a, 0x7e, 0x25, 0x9b, 0x56, 0x0c, 0xfa,
+	0x19, 0x2c, 0xe5, 0x97, 0x11, 0xbd, 0x06, 0x17, 0x3d, 0xda, 0x8d, 0x9a, 0x09, 0x4c, 0x33, 0xb9,
+	0x8a, 0xca, 0x5e, 0x76, 0x46, 0x6b, 0x82, 0x26, 0x76, 0x7d, 0xca, 0xc2, 0x88, 0x07, 0xac, 0x65,
+	0x3a, 0x1b, 0x1d, 0xcf, 0x62, 0x9e, 0xbd, 0xe9, 0x59, 0xb4, 0x1b, 0xff, 0x4a, 0xae, 0xc0, 0x6c,
+	0xce, 0xb2, 0x0b, 0x7e, 0x7f, 0x11, 0x59, 0x80, 0x99, 0x36, 0x65, 0x76, 0x3b, 0x12, 0xaf, 0x60,
+	0xa9, 0x81, 0xdf, 0xb4, 0x7d, 0xb8, 0x3a, 0x14, 0x00, 0x39, 0x7f, 0x09, 0x2f, 0x85, 0x9e, 0xe9,
+	0x87, 0x6d, 0x1e, 0xa1, 0x67, 0x37, 0x0b, 0x3d, 0x4b, 0x2f, 0xd8, 0xc6, 0x21, 0x74, 0x30, 0x59,
+	0xa2, 0x3d, 0x84, 0xeb, 0x03, 0xb8, 0xf7, 0xcc, 0xc0, 0x66, 0xde, 0x46, 0x60, 0xb6, 0x44, 0x78,
+	0x4c, 0xa0, 0x6d, 0x1e, 0xce, 0x51, 0x9f, 0xb7, 0xda, 0x28, 0x4d, 0x7e, 0xd1, 0xbe, 0x57, 0x60,
+	0x65, 0x14, 0x04, 0xaa, 0xfb, 0x1a, 0x5e, 0x76, 0x45, 0xa9, 0xf9, 0x28, 0xae, 0xa1, 0xca, 0xd5,
+	0x42, 0x95, 0x03, 0xbb, 0x50, 0x60, 0xd9, 0xcd, 0x1e, 0xd7, 0xbf, 0x2d, 0xc1, 0x39, 0xc1, 0x82,
+	0xfc, 0xac, 0xc0, 0xf9, 0xe4, 0xd1, 0x12, 0xbd, 0x70, 0x71, 0x6e, 0x60, 0xa9, 0xc6, 0xd8, 0xfd,
+	0x52, 0x93, 0x66, 0x7c, 0xf7, 0xe7, 0xbf, 0x3f, 0x4d, 0xbf, 0x49, 0xaa, 0xc6, 0xc8, 0xb0, 0x34,
+	0x0e, 0x99, 0x75, 0x44, 0x7e, 0x51, 0xa0, 0x94, 0x89, 0x0d, 0x52, 0x1f, 0x8e, 0x99, 0x97, 0x64,
+	0xea, 0xfa, 0x44, 0x33, 0xc8, 0xb5, 0x26, 0xb8, 0x5e, 0x23, 0xda, 0x68, 0xae, 0xe4, 0x77, 0x05,
+	0x2e, 0x9e, 0xba, 0xc4, 0xc9, 0xbb, 0x23, 0x61, 0x73, 0xf3, 0x45, 0x7d, 0x6f, 0xe2, 0x39, 0xa4,
+	0xfc, 0xb6, 0xa0, 0x5c, 0x23, 0xab, 0x85, 0x94, 0x07, 0xc2, 0x84, 0xfc, 0xaa, 0xc0, 0x6c, 0xfa,
+	0x7e, 0x26, 0x6b, 0x23, 0x1e, 0xe9, 0xe9, 0x7b, 0x5e, 0xad, 0x4f, 0x32, 0x82, 0x4c, 0x75, 0xc1,
+	0x74, 0x95, 0xac, 0x14, 0x9b, 0x9b, 0x4e, 0x07, 0xf2, 0x58, 0x81, 0xb9, 0xec, 0xd5, 0x4d, 0xd6,
+	0xc7, 0x82, 0xcd, 0xc6, 0x80, 0x7a, 0x6b, 0xb2, 0xa1, 0xb1, 0x7d, 0x1d, 0x08, 0x0f, 0xf2, 0x83,
+	0x02, 0x33, 0xf2, 0x9a, 0x26, 0x6f, 0x8d, 0x80, 0x4c, 0x67, 0x83, 0x7a, 0x63, 0xbc, 0x66, 0xe4,
+	0x55, 0x15, 0xbc, 0xae, 0x90, 0x65, 0x63, 0xf8, 0x3f, 0x54, 0xf2, 0x9b, 0x02, 0xe5, 0x81, 0x9b,
+	0x9f, 0x8c, 0xb0, 0x22, 0x3f, 0x47, 0xd4, 0x77, 0x26, 0x9c, 0x42, 0xa6, 0x75, 0xc1, 0xf4, 0x06,
+	0xa9, 0x15, 0x32, 0x3d, 0x95, 0x3e, 0xe4, 0x3f, 0x05, 0x16, 0xf2, 0x13, 0x80, 0xbc, 0x3f, 0x9c,
+	0xc5, 0xd0, 0x60, 0x52, 0x6f, 0x9f, 0x6d, 0x18, 0x95, 0xdc, 0x17, 0x4a, 0xee, 0x92, 0xcd, 0x42,
+	0x25, 0xed, 0x64, 0x41, 0xf3, 0x91, 0xdc, 0xd0, 0x64, 0xbd, 0x15, 0xc6, 0x61, 0x5a, 0xde, 0x91,
+	0x71, 0x28, 0xd3, 0xee, 0x88, 0xfc, 0xaf, 0xc0, 0x62, 0x61, 0x1e, 0x90, 0x0f, 0xc7, 0xa5, 0x9b,
+	0x9f, 0x55, 0xea, 0x47, 0x67, 0x9e, 0x47, 0xc5, 0xdb, 0x42, 0xf1, 0x3d, 0x72, 0x77, 0x1c, 0xc5,
+	0x83, 0x91, 0x75, 0x4a, 0xb4, 0xc8, 0xc1, 0xa3, 0x3b, 0x0f, 0x9e, 0x1c, 0x57, 0x94, 0xa7, 0xc7,
+	0x15, 0xe5, 0x9f, 0xe3, 0x8a, 0xf2, 0xe3, 0x49, 0x65, 0xea, 0xe9, 0x49, 0x65, 0xea, 0xaf, 0x93,
+	0xca, 0xd4, 0x37, 0xb7, 0x6d, 0x16, 0xb5, 0x3b, 0xbb, 0x7a, 0x8b, 0xbb, 0x59, 0xc0, 0xfd, 0x5b,
+	0x37, 0x5b, 0x6d, 0x93, 0x79, 0x46, 0x72, 0xd2, 0x4d, 0x93, 0x88, 0x0e, 0x7c, 0x1a, 0xee, 0xce,
+	0x88, 0xe2, 0xfa, 0xb3, 0x01, 0x00, 0x27, 0xea, 0x23, 0x33, 0x52, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -748,6 +969,11 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Queries the next perpetual id.
 	NextPerpetualId(ctx context.Context, in *QueryNextPerpetualIdRequest, opts ...grpc.CallOption) (*QueryNextPerpetualIdResponse, error)
+	// Queries the funding index of a perpetual at a past block height.
+	HistoricalFundingIndex(ctx context.Context, in *QueryHistoricalFundingIndexRequest, opts ...grpc.CallOption) (*QueryHistoricalFundingIndexResponse, error)
+	// Queries the margin fractions of a perpetual during a past funding-tick
+	// epoch.
+	HistoricalMarginFractions(ctx context.Context, in *QueryHistoricalMarginFractionsRequest, opts ...grpc.CallOption) (*QueryHistoricalMarginFractionsResponse, error)
 }
 
 type queryClient struct {
@@ -821,6 +1047,24 @@ func (c *queryClient) NextPerpetualId(ctx context.Context, in *QueryNextPerpetua
 	return out, nil
 }
 
+func (c *queryClient) HistoricalFundingIndex(ctx context.Context, in *QueryHistoricalFundingIndexRequest, opts ...grpc.CallOption) (*QueryHistoricalFundingIndexResponse, error) {
+	out := new(QueryHistoricalFundingIndexResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/HistoricalFundingIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HistoricalMarginFractions(ctx context.Context, in *QueryHistoricalMarginFractionsRequest, opts ...grpc.CallOption) (*QueryHistoricalMarginFractionsResponse, error) {
+	out := new(QueryHistoricalMarginFractionsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/HistoricalMarginFractions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries a Perpetual by id.
@@ -837,6 +1081,11 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Queries the next perpetual id.
 	NextPerpetualId(context.Context, *QueryNextPerpetualIdRequest) (*QueryNextPerpetualIdResponse, error)
+	// Queries the funding index of a perpetual at a past block height.
+	HistoricalFundingIndex(context.Context, *QueryHistoricalFundingIndexRequest) (*QueryHistoricalFundingIndexResponse, error)
+	// Queries the margin fractions of a perpetual during a past funding-tick
+	// epoch.
+	HistoricalMarginFractions(context.Context, *QueryHistoricalMarginFractionsRequest) (*QueryHistoricalMarginFractionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -864,6 +1113,12 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) NextPerpetualId(ctx context.Context, req *QueryNextPerpetualIdRequest) (*QueryNextPerpetualIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NextPerpetualId not implemented")
 }
+func (*UnimplementedQueryServer) HistoricalFundingIndex(ctx context.Context, req *QueryHistoricalFundingIndexRequest) (*QueryHistoricalFundingIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalFundingIndex not implemented")
+}
+func (*UnimplementedQueryServer) HistoricalMarginFractions(ctx context.Context, req *QueryHistoricalMarginFractionsRequest) (*QueryHistoricalMarginFractionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalMarginFractions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -995,6 +1250,43 @@ func _Query_NextPerpetualId_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_HistoricalFundingIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricalFundingIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricalFundingIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Query/HistoricalFundingIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricalFundingIndex(ctx, req.(*QueryHistoricalFundingIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HistoricalMarginFractions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricalMarginFractionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricalMarginFractions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Query/HistoricalMarginFractions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricalMarginFractions(ctx, req.(*QueryHistoricalMarginFractionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.perpetuals.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1027,6 +1319,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NextPerpetualId",
 			Handler:    _Query_NextPerpetualId_Handler,
 		},
+		{
+			MethodName: "HistoricalFundingIndex",
+			Handler:    _Query_HistoricalFundingIndex_Handler,
+		},
+		{
+			MethodName: "HistoricalMarginFractions",
+			Handler:    _Query_HistoricalMarginFractions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/perpetuals/query.proto",
@@ -1480,68 +1780,200 @@ func (m *QueryNextPerpetualIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryHistoricalFundingIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryPerpetualRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryHistoricalFundingIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricalFundingIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPerpetualResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryHistoricalFundingIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Perpetual.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllPerpetualsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryHistoricalFundingIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricalFundingIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPerpetualsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryHistoricalMarginFractionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricalMarginFractionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricalMarginFractionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Perpetual) > 0 {
-		for _, e := range m.Perpetual {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricalMarginFractionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricalMarginFractionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricalMarginFractionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MarginFractions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPerpetualRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryPerpetualResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Perpetual.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPerpetualsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPerpetualsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Perpetual) > 0 {
+		for _, e := range m.Perpetual {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
@@ -1659,6 +2091,58 @@ func (m *QueryNextPerpetualIdResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryHistoricalFundingIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryHistoricalFundingIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Snapshot.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHistoricalMarginFractionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *QueryHistoricalMarginFractionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MarginFractions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2747,6 +3231,348 @@ func (m *QueryNextPerpetualIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryHistoricalFundingIndexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalFundingIndexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalFundingIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoricalFundingIndexResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalFundingIndexResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalFundingIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoricalMarginFractionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalMarginFractionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalMarginFractionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoricalMarginFractionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalMarginFractionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalMarginFractionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginFractions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarginFractions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -540,6 +540,438 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetFundingRateCap is a message used by x/gov to set the cap on the
+// absolute funding rate of a perpetual.
+type MsgSetFundingRateCap struct {
+	// The address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The id of the perpetual to set the funding rate cap of.
+	PerpetualId uint32 `protobuf:"varint,2,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The cap on the absolute funding rate, in parts-per-million. Zero removes
+	// the cap.
+	FundingRateCapPpm uint32 `protobuf:"varint,3,opt,name=funding_rate_cap_ppm,json=fundingRateCapPpm,proto3" json:"funding_rate_cap_ppm,omitempty"`
+}
+
+func (m *MsgSetFundingRateCap) Reset()         { *m = MsgSetFundingRateCap{} }
+func (m *MsgSetFundingRateCap) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFundingRateCap) ProtoMessage()    {}
+func (*MsgSetFundingRateCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daed24c15760c356, []int{11}
+}
+func (m *MsgSetFundingRateCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFundingRateCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFundingRateCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFundingRateCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFundingRateCap.Merge(m, src)
+}
+func (m *MsgSetFundingRateCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFundingRateCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFundingRateCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFundingRateCap proto.InternalMessageInfo
+
+func (m *MsgSetFundingRateCap) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetFundingRateCap) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *MsgSetFundingRateCap) GetFundingRateCapPpm() uint32 {
+	if m != nil {
+		return m.FundingRateCapPpm
+	}
+	return 0
+}
+
+// MsgSetFundingRateCapResponse defines the SetFundingRateCap response type.
+type MsgSetFundingRateCapResponse struct {
+}
+
+func (m *MsgSetFundingRateCapResponse) Reset()         { *m = MsgSetFundingRateCapResponse{} }
+func (m *MsgSetFundingRateCapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFundingRateCapResponse) ProtoMessage()    {}
+func (*MsgSetFundingRateCapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daed24c15760c356, []int{12}
+}
+func (m *MsgSetFundingRateCapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFundingRateCapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFundingRateCapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFundingRateCapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFundingRateCapResponse.Merge(m, src)
+}
+func (m *MsgSetFundingRateCapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFundingRateCapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFundingRateCapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFundingRateCapResponse proto.InternalMessageInfo
+
+// MsgSetMaxUnsettledFundingIndexDelta is a message used by x/gov to set the
+// cap on the unsettled funding index delta of positions in a perpetual.
+type MsgSetMaxUnsettledFundingIndexDelta struct {
+	// The address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The id of the perpetual to set the cap of.
+	PerpetualId uint32 `protobuf:"varint,2,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The cap on the absolute difference between the funding index of the
+	// perpetual and the funding index of a position that is settled. Zero
+	// removes the cap.
+	MaxUnsettledFundingIndexDelta uint64 `protobuf:"varint,3,opt,name=max_unsettled_funding_index_delta,json=maxUnsettledFundingIndexDelta,proto3" json:"max_unsettled_funding_index_delta,omitempty"`
+}
+
+func (m *MsgSetMaxUnsettledFundingIndexDelta) Reset()         { *m = MsgSetMaxUnsettledFundingIndexDelta{} }
+func (m *MsgSetMaxUnsettledFundingIndexDelta) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMaxUnsettledFundingIndexDelta) ProtoMessage()    {}
+func (*MsgSetMaxUnsettledFundingIndexDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daed24c15760c356, []int{13}
+}
+func (m *MsgSetMaxUnsettledFundingIndexDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMaxUnsettledFundingIndexDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMaxUnsettledFundingIndexDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMaxUnsettledFundingIndexDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMaxUnsettledFundingIndexDelta.Merge(m, src)
+}
+func (m *MsgSetMaxUnsettledFundingIndexDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMaxUnsettledFundingIndexDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMaxUnsettledFundingIndexDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMaxUnsettledFundingIndexDelta proto.InternalMessageInfo
+
+func (m *MsgSetMaxUnsettledFundingIndexDelta) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetMaxUnsettledFundingIndexDelta) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *MsgSetMaxUnsettledFundingIndexDelta) GetMaxUnsettledFundingIndexDelta() uint64 {
+	if m != nil {
+		return m.MaxUnsettledFundingIndexDelta
+	}
+	return 0
+}
+
+// MsgSetMaxUnsettledFundingIndexDeltaResponse defines the
+// SetMaxUnsettledFundingIndexDelta response type.
+type MsgSetMaxUnsettledFundingIndexDeltaResponse struct {
+}
+
+func (m *MsgSetMaxUnsettledFundingIndexDeltaResponse) Reset() {
+	*m = MsgSetMaxUnsettledFundingIndexDeltaResponse{}
+}
+func (m *MsgSetMaxUnsettledFundingIndexDeltaResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgSetMaxUnsettledFundingIndexDeltaResponse) ProtoMessage() {}
+func (*MsgSetMaxUnsettledFundingIndexDeltaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daed24c15760c356, []int{14}
+}
+func (m *MsgSetMaxUnsettledFundingIndexDeltaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMaxUnsettledFundingIndexDeltaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMaxUnsettledFundingIndexDeltaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMaxUnsettledFundingIndexDeltaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMaxUnsettledFundingIndexDeltaResponse.Merge(m, src)
+}
+func (m *MsgSetMaxUnsettledFundingIndexDeltaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMaxUnsettledFundingIndexDeltaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMaxUnsettledFundingIndexDeltaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMaxUnsettledFundingIndexDeltaResponse proto.InternalMessageInfo
+
+// MsgSetSettlementPrice is a message used by x/gov to put a perpetual that is
+// being wound down into, or take it out of, settlement mode.
+type MsgSetSettlementPrice struct {
+	// The address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The id of the perpetual to set the settlement price of.
+	PerpetualId uint32 `protobuf:"varint,2,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The price at which positions in the perpetual are valued, in the exponent
+	// of the market price of the perpetual. Zero takes the perpetual out of
+	// settlement mode.
+	SettlementPrice uint64 `protobuf:"varint,3,opt,name=settlement_price,json=settlementPrice,proto3" json:"settlement_price,omitempty"`
+}
+
+func (m *MsgSetSettlementPrice) Reset()         { *m = MsgSetSettlementPrice{} }
+func (m *MsgSetSettlementPrice) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSettlementPrice) ProtoMessage()    {}
+func (*MsgSetSettlementPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daed24c15760c356, []int{15}
+}
+func (m *MsgSetSettlementPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSettlementPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSettlementPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSettlementPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSettlementPrice.Merge(m, src)
+}
+func (m *MsgSetSettlementPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSettlementPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSettlementPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSettlementPrice proto.InternalMessageInfo
+
+func (m *MsgSetSettlementPrice) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetSettlementPrice) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *MsgSetSettlementPrice) GetSettlementPrice() uint64 {
+	if m != nil {
+		return m.SettlementPrice
+	}
+	return 0
+}
+
+// MsgSetSettlementPriceResponse defines the SetSettlementPrice response type.
+type MsgSetSettlementPriceResponse struct {
+}
+
+func (m *MsgSetSettlementPriceResponse) Reset()         { *m = MsgSetSettlementPriceResponse{} }
+func (m *MsgSetSettlementPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSettlementPriceResponse) ProtoMessage()    {}
+func (*MsgSetSettlementPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daed24c15760c356, []int{16}
+}
+func (m *MsgSetSettlementPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSettlementPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSettlementPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSettlementPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSettlementPriceResponse.Merge(m, src)
+}
+func (m *MsgSetSettlementPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSettlementPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSettlementPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSettlementPriceResponse proto.InternalMessageInfo
+
+// MsgSetConcentrationMargin is a message used by x/gov to set the additional
+// maintenance margin required of positions in a perpetual that make up a large
+// share of its open interest.
+type MsgSetConcentrationMargin struct {
+	// The address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The id of the perpetual to set the concentration margin of.
+	PerpetualId uint32 `protobuf:"varint,2,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The share of the open interest of the perpetual, in parts-per-million,
+	// that the size of a position must exceed for the add-on to apply.
+	ThresholdPpm uint32 `protobuf:"varint,3,opt,name=threshold_ppm,json=thresholdPpm,proto3" json:"threshold_ppm,omitempty"`
+	// The maintenance margin fraction added to concentrated positions, in
+	// parts-per-million of their notional. Zero removes the concentration
+	// margin.
+	AddOnPpm uint32 `protobuf:"varint,4,opt,name=add_on_ppm,json=addOnPpm,proto3" json:"add_on_ppm,omitempty"`
+}
+
+func (m *MsgSetConcentrationMargin) Reset()         { *m = MsgSetConcentrationMargin{} }
+func (m *MsgSetConcentrationMargin) String() string { return proto.CompactTextString(m) }
+func (*MsgSetConcentrationMargin) ProtoMessage()    {}
+func (*MsgSetConcentrationMargin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daed24c15760c356, []int{17}
+}
+func (m *MsgSetConcentrationMargin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetConcentrationMargin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetConcentrationMargin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetConcentrationMargin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetConcentrationMargin.Merge(m, src)
+}
+func (m *MsgSetConcentrationMargin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetConcentrationMargin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetConcentrationMargin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetConcentrationMargin proto.InternalMessageInfo
+
+func (m *MsgSetConcentrationMargin) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetConcentrationMargin) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *MsgSetConcentrationMargin) GetThresholdPpm() uint32 {
+	if m != nil {
+		return m.ThresholdPpm
+	}
+	return 0
+}
+
+func (m *MsgSetConcentrationMargin) GetAddOnPpm() uint32 {
+	if m != nil {
+		return m.AddOnPpm
+	}
+	return 0
+}
+
+// MsgSetConcentrationMarginResponse defines the SetConcentrationMargin
+// response type.
+type MsgSetConcentrationMarginResponse struct {
+}
+
+func (m *MsgSetConcentrationMarginResponse) Reset()         { *m = MsgSetConcentrationMarginResponse{} }
+func (m *MsgSetConcentrationMarginResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetConcentrationMarginResponse) ProtoMessage()    {}
+func (*MsgSetConcentrationMarginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daed24c15760c356, []int{18}
+}
+func (m *MsgSetConcentrationMarginResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetConcentrationMarginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetConcentrationMarginResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetConcentrationMarginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetConcentrationMarginResponse.Merge(m, src)
+}
+func (m *MsgSetConcentrationMarginResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetConcentrationMarginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetConcentrationMarginResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetConcentrationMarginResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreatePerpetual)(nil), "dydxprotocol.perpetuals.MsgCreatePerpetual")
 	proto.RegisterType((*MsgCreatePerpetualResponse)(nil), "dydxprotocol.perpetuals.MsgCreatePerpetualResponse")
@@ -552,51 +984,78 @@ func init() {
 	proto.RegisterType((*MsgAddPremiumVotesResponse)(nil), "dydxprotocol.perpetuals.MsgAddPremiumVotesResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "dydxprotocol.perpetuals.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dydxprotocol.perpetuals.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetFundingRateCap)(nil), "dydxprotocol.perpetuals.MsgSetFundingRateCap")
+	proto.RegisterType((*MsgSetFundingRateCapResponse)(nil), "dydxprotocol.perpetuals.MsgSetFundingRateCapResponse")
+	proto.RegisterType((*MsgSetMaxUnsettledFundingIndexDelta)(nil), "dydxprotocol.perpetuals.MsgSetMaxUnsettledFundingIndexDelta")
+	proto.RegisterType((*MsgSetMaxUnsettledFundingIndexDeltaResponse)(nil), "dydxprotocol.perpetuals.MsgSetMaxUnsettledFundingIndexDeltaResponse")
+	proto.RegisterType((*MsgSetSettlementPrice)(nil), "dydxprotocol.perpetuals.MsgSetSettlementPrice")
+	proto.RegisterType((*MsgSetSettlementPriceResponse)(nil), "dydxprotocol.perpetuals.MsgSetSettlementPriceResponse")
+	proto.RegisterType((*MsgSetConcentrationMargin)(nil), "dydxprotocol.perpetuals.MsgSetConcentrationMargin")
+	proto.RegisterType((*MsgSetConcentrationMarginResponse)(nil), "dydxprotocol.perpetuals.MsgSetConcentrationMarginResponse")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/perpetuals/tx.proto", fileDescriptor_daed24c15760c356) }
 
 var fileDescriptor_daed24c15760c356 = []byte{
-	// 620 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x6b, 0x13, 0x41,
-	0x18, 0xce, 0xd8, 0x0f, 0xe8, 0x9b, 0x36, 0x29, 0x6b, 0x25, 0xe9, 0xaa, 0x9b, 0x18, 0xc4, 0x06,
-	0xb5, 0x59, 0x9b, 0x16, 0x41, 0xd1, 0x43, 0x53, 0x28, 0x08, 0x06, 0x42, 0x52, 0x0b, 0xf5, 0x12,
-	0xb6, 0xd9, 0x61, 0x33, 0x92, 0xcd, 0x8e, 0x3b, 0xb3, 0x21, 0xb9, 0x0a, 0xde, 0x3d, 0xfa, 0x03,
-	0xfc, 0x01, 0x22, 0x5e, 0xbd, 0xf7, 0x58, 0x3c, 0x09, 0x82, 0x48, 0x72, 0xf0, 0x6f, 0x48, 0xf6,
-	0x2b, 0xd9, 0x4d, 0x36, 0x24, 0xed, 0x69, 0x67, 0xdf, 0x79, 0xde, 0xf7, 0x79, 0x9e, 0x77, 0xbe,
-	0x20, 0xab, 0xf6, 0xd4, 0x2e, 0x35, 0x0d, 0x6e, 0x34, 0x8c, 0x96, 0x4c, 0xb1, 0x49, 0x31, 0xb7,
-	0x94, 0x16, 0x93, 0x79, 0xb7, 0x60, 0x87, 0x85, 0xd4, 0x38, 0xa2, 0x30, 0x42, 0x88, 0xdb, 0x0d,
-	0x83, 0xe9, 0x06, 0xab, 0xdb, 0x73, 0xb2, 0xf3, 0xe3, 0xe4, 0x88, 0x29, 0xe7, 0x4f, 0xd6, 0x99,
-	0x26, 0x77, 0xf6, 0x86, 0x1f, 0x77, 0x62, 0x4b, 0x33, 0x34, 0xc3, 0x49, 0x18, 0x8e, 0xdc, 0xe8,
-	0xfd, 0x28, 0x11, 0x54, 0x31, 0x15, 0xdd, 0x2b, 0xba, 0x13, 0x89, 0xf2, 0x86, 0x0e, 0x30, 0xf7,
-	0x05, 0x81, 0x50, 0x66, 0xda, 0x91, 0x89, 0x15, 0x8e, 0x2b, 0xde, 0xa4, 0xf0, 0x14, 0xd6, 0x14,
-	0x8b, 0x37, 0x0d, 0x93, 0xf0, 0x5e, 0x1a, 0x65, 0x51, 0x7e, 0xad, 0x94, 0xfe, 0xf9, 0x7d, 0x77,
-	0xcb, 0x55, 0x7e, 0xa8, 0xaa, 0x26, 0x66, 0xac, 0xc6, 0x4d, 0xd2, 0xd6, 0xaa, 0x23, 0xa8, 0x70,
-	0x0c, 0xab, 0x8e, 0x8e, 0xf4, 0x8d, 0x2c, 0xca, 0xc7, 0x8b, 0xf9, 0x42, 0x44, 0x47, 0x0a, 0x3e,
-	0x57, 0xc5, 0xc6, 0x97, 0x96, 0x2f, 0xfe, 0x64, 0x62, 0x55, 0x37, 0xfb, 0x79, 0xe2, 0xc3, 0xbf,
-	0xaf, 0x0f, 0x47, 0x75, 0x73, 0x77, 0x40, 0x9c, 0x54, 0x59, 0xc5, 0x8c, 0x1a, 0x6d, 0x86, 0x73,
-	0xdf, 0x10, 0xdc, 0x2c, 0x33, 0xad, 0x86, 0xf9, 0x6b, 0xf2, 0xde, 0x22, 0x2a, 0xe1, 0xbd, 0x13,
-	0x82, 0xcd, 0x2b, 0xbb, 0xa8, 0x41, 0xa2, 0xe5, 0x15, 0xaa, 0x73, 0x82, 0x4d, 0xd7, 0xcd, 0x83,
-	0x48, 0x37, 0x01, 0x5e, 0xd7, 0xcb, 0x46, 0x6b, 0x3c, 0x38, 0x61, 0xe9, 0x2e, 0xdc, 0x9e, 0xa2,
-	0xd9, 0xf7, 0xf4, 0x03, 0x41, 0xba, 0xcc, 0xb4, 0x37, 0x54, 0x1d, 0xb7, 0xec, 0x34, 0xeb, 0xca,
-	0xc6, 0xce, 0x60, 0xd3, 0x17, 0x5d, 0xbf, 0xd6, 0x42, 0x25, 0x69, 0x30, 0x3c, 0x61, 0x2f, 0x07,
-	0xd9, 0x28, 0xf9, 0xbe, 0xc7, 0x13, 0x48, 0x1c, 0x5b, 0x6d, 0x95, 0xb4, 0xb5, 0x8a, 0x89, 0x75,
-	0x62, 0xe9, 0xc2, 0x3d, 0x58, 0x1f, 0x09, 0x24, 0xaa, 0xed, 0x6d, 0xa3, 0x1a, 0xf7, 0x63, 0xaf,
-	0x54, 0x21, 0x03, 0x71, 0xea, 0xa0, 0xeb, 0x94, 0xea, 0xb6, 0xfc, 0x95, 0x2a, 0xb8, 0xa1, 0x0a,
-	0xd5, 0x73, 0x67, 0xf6, 0x8e, 0x3e, 0x54, 0x55, 0xb7, 0xe8, 0xa9, 0xc1, 0x31, 0x13, 0x8e, 0x60,
-	0xa5, 0x33, 0x1c, 0xa4, 0x51, 0x76, 0x29, 0x1f, 0x2f, 0xee, 0x44, 0xfa, 0x0d, 0x2a, 0x72, 0xed,
-	0x3a, 0xb9, 0xee, 0x36, 0x0c, 0x95, 0xf6, 0xed, 0x7c, 0x46, 0x90, 0x1c, 0x79, 0xbe, 0xde, 0x4a,
-	0xbd, 0x0c, 0x1d, 0xa4, 0x4c, 0xf4, 0xfa, 0xcc, 0x73, 0x7e, 0xb6, 0x21, 0x15, 0x52, 0xe6, 0xa9,
-	0x2e, 0xfe, 0x5e, 0x86, 0xa5, 0x32, 0xd3, 0x04, 0x06, 0xc9, 0x70, 0xcf, 0x1e, 0x45, 0x92, 0x4e,
-	0x76, 0x41, 0xdc, 0x5f, 0x00, 0xec, 0x91, 0x0f, 0x49, 0xc3, 0x57, 0xcf, 0x4c, 0xd2, 0x10, 0x78,
-	0x36, 0x69, 0xc4, 0x75, 0x21, 0x74, 0x60, 0x73, 0xe2, 0xaa, 0x78, 0x3c, 0xab, 0x50, 0x18, 0x2d,
-	0x1e, 0x2c, 0x82, 0xf6, 0x79, 0x3f, 0x22, 0xb8, 0x35, 0xfd, 0x3c, 0xef, 0xcd, 0xaa, 0x37, 0x35,
-	0x45, 0x7c, 0xb6, 0x70, 0x8a, 0xaf, 0xe3, 0x1d, 0xac, 0x07, 0xf6, 0x68, 0x7e, 0x8e, 0x52, 0x0e,
-	0xe9, 0x93, 0x79, 0x91, 0x1e, 0x57, 0xe9, 0xf4, 0xa2, 0x2f, 0xa1, 0xcb, 0xbe, 0x84, 0xfe, 0xf6,
-	0x25, 0xf4, 0x69, 0x20, 0xc5, 0x2e, 0x07, 0x52, 0xec, 0xd7, 0x40, 0x8a, 0xbd, 0x7d, 0xa1, 0x11,
-	0xde, 0xb4, 0xce, 0x0b, 0x0d, 0x43, 0x97, 0x03, 0xaf, 0x55, 0xe7, 0x60, 0xb7, 0xd1, 0x54, 0x48,
-	0x5b, 0xf6, 0x23, 0xdd, 0xc0, 0x63, 0xdb, 0xa3, 0x98, 0x9d, 0xaf, 0xda, 0x93, 0xfb, 0xff, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0x2c, 0x4e, 0x17, 0x56, 0x94, 0x07, 0x00, 0x00,
+	// 917 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0xcd, 0x6f, 0x1b, 0x45,
+	0x18, 0xc6, 0x33, 0x4d, 0x5a, 0x91, 0x37, 0x9f, 0x5d, 0x52, 0xe2, 0x2c, 0x89, 0xe3, 0x38, 0x88,
+	0x1a, 0x4a, 0xbc, 0x34, 0x29, 0x95, 0xa8, 0xca, 0xa1, 0x49, 0x55, 0x51, 0x09, 0x0b, 0xcb, 0x69,
+	0x2b, 0x95, 0xcb, 0x6a, 0xea, 0x99, 0xae, 0x07, 0x79, 0x77, 0x87, 0x9d, 0x71, 0x64, 0x4b, 0x9c,
+	0x90, 0x90, 0x7a, 0xe4, 0xc8, 0x1f, 0x80, 0xb8, 0x21, 0x21, 0xc4, 0x95, 0x7b, 0xb9, 0x45, 0x9c,
+	0x10, 0x07, 0x84, 0x92, 0x03, 0xff, 0x46, 0xe5, 0xfd, 0x18, 0x7b, 0xbf, 0xec, 0x38, 0x51, 0x4e,
+	0x59, 0xcf, 0x3e, 0xef, 0xfb, 0x3c, 0xbf, 0x99, 0xd9, 0xd9, 0x2c, 0x94, 0x48, 0x8f, 0x74, 0xb9,
+	0xe7, 0x4a, 0xb7, 0xe9, 0xb6, 0x0d, 0x4e, 0x3d, 0x4e, 0x65, 0x07, 0xb7, 0x85, 0x21, 0xbb, 0x55,
+	0x7f, 0x58, 0x5b, 0x1d, 0x56, 0x54, 0x07, 0x0a, 0x7d, 0xad, 0xe9, 0x0a, 0xdb, 0x15, 0xa6, 0x7f,
+	0xcf, 0x08, 0x7e, 0x04, 0x35, 0xfa, 0x6a, 0xf0, 0xcb, 0xb0, 0x85, 0x65, 0x1c, 0xdd, 0xee, 0xff,
+	0x09, 0x6f, 0xac, 0x58, 0xae, 0xe5, 0x06, 0x05, 0xfd, 0xab, 0x70, 0xf4, 0xbd, 0xbc, 0x10, 0x1c,
+	0x7b, 0xd8, 0x8e, 0x9a, 0xde, 0xcc, 0x55, 0x45, 0x97, 0x81, 0xb0, 0xfc, 0x13, 0x02, 0xad, 0x26,
+	0xac, 0x03, 0x8f, 0x62, 0x49, 0xeb, 0xd1, 0x4d, 0xed, 0x2e, 0xcc, 0xe2, 0x8e, 0x6c, 0xb9, 0x1e,
+	0x93, 0xbd, 0x02, 0x2a, 0xa1, 0xca, 0xec, 0x7e, 0xe1, 0xaf, 0xdf, 0x77, 0x56, 0xc2, 0xe4, 0x0f,
+	0x08, 0xf1, 0xa8, 0x10, 0x87, 0xd2, 0x63, 0x8e, 0xd5, 0x18, 0x48, 0xb5, 0x47, 0x70, 0x2d, 0xc8,
+	0x51, 0xb8, 0x52, 0x42, 0x95, 0xb9, 0xdd, 0x4a, 0x35, 0x67, 0x46, 0xaa, 0xca, 0xab, 0xee, 0xeb,
+	0xf7, 0x67, 0x5e, 0xff, 0xbb, 0x39, 0xd5, 0x08, 0xab, 0xef, 0x2d, 0x7e, 0xf7, 0xff, 0xaf, 0x1f,
+	0x0e, 0xfa, 0x96, 0xd7, 0x41, 0x4f, 0xa7, 0x6c, 0x50, 0xc1, 0x5d, 0x47, 0xd0, 0xf2, 0x6f, 0x08,
+	0xde, 0xae, 0x09, 0xeb, 0x90, 0xca, 0x2f, 0xd8, 0x37, 0x1d, 0x46, 0x98, 0xec, 0x3d, 0x61, 0xd4,
+	0x3b, 0x37, 0xc5, 0x21, 0x2c, 0xb6, 0xa3, 0x46, 0xa6, 0x64, 0xd4, 0x0b, 0x69, 0xde, 0xcf, 0xa5,
+	0x89, 0xf9, 0x86, 0x2c, 0x0b, 0xed, 0xe1, 0xc1, 0x14, 0xd2, 0x06, 0xbc, 0x9b, 0x91, 0x59, 0x31,
+	0xfd, 0x81, 0xa0, 0x50, 0x13, 0xd6, 0x53, 0x4e, 0x86, 0x91, 0x83, 0xc9, 0x3a, 0x37, 0xd8, 0x73,
+	0x58, 0x56, 0xa1, 0xcd, 0x0b, 0x2d, 0xd4, 0x12, 0x8f, 0x0f, 0xa7, 0xf0, 0xca, 0x50, 0xca, 0x8b,
+	0xaf, 0x18, 0x9f, 0xc0, 0xe2, 0xa3, 0x8e, 0x43, 0x98, 0x63, 0xd5, 0x3d, 0x6a, 0xb3, 0x8e, 0xad,
+	0x6d, 0xc1, 0xfc, 0x20, 0x20, 0x23, 0x3e, 0xdb, 0x42, 0x63, 0x4e, 0x8d, 0x3d, 0x26, 0xda, 0x26,
+	0xcc, 0xf1, 0x40, 0x6d, 0x72, 0x6e, 0xfb, 0xf1, 0xaf, 0x36, 0x20, 0x1c, 0xaa, 0x73, 0xbb, 0xfc,
+	0xdc, 0xdf, 0xd1, 0x0f, 0x08, 0x09, 0x9b, 0x3e, 0x73, 0x25, 0x15, 0xda, 0x01, 0x5c, 0x3d, 0xea,
+	0x5f, 0x14, 0x50, 0x69, 0xba, 0x32, 0xb7, 0x7b, 0x33, 0x97, 0x37, 0x9e, 0x28, 0xc4, 0x0d, 0x6a,
+	0xc3, 0x6d, 0x98, 0x68, 0xad, 0x70, 0x7e, 0x44, 0xb0, 0x34, 0x60, 0xbe, 0xd8, 0x4a, 0x7d, 0x96,
+	0x78, 0x90, 0x36, 0xf3, 0xd7, 0xe7, 0x2c, 0xcf, 0xcf, 0x1a, 0xac, 0x26, 0x92, 0x0d, 0x3f, 0x3c,
+	0x2b, 0xc1, 0x46, 0x0c, 0xc9, 0x1b, 0x58, 0xd2, 0x03, 0xcc, 0xcf, 0x1d, 0x3d, 0xb9, 0x86, 0x57,
+	0xd2, 0x6b, 0x68, 0xc0, 0xca, 0xcb, 0xc0, 0xcc, 0xf4, 0xb0, 0xa4, 0x66, 0x13, 0x73, 0x7f, 0x31,
+	0xa7, 0x7d, 0xe9, 0xf5, 0x97, 0xb1, 0x20, 0x75, 0x6e, 0xa7, 0x78, 0x8a, 0xb0, 0x9e, 0x95, 0x59,
+	0x41, 0xfd, 0x83, 0x60, 0x3b, 0x10, 0xd4, 0x70, 0xf7, 0xa9, 0x23, 0xa8, 0x94, 0x6d, 0x4a, 0x42,
+	0xf1, 0x63, 0x87, 0xd0, 0xee, 0x43, 0xda, 0x96, 0xf8, 0x32, 0x19, 0x3f, 0x87, 0x2d, 0x1b, 0x77,
+	0xcd, 0x4e, 0x64, 0x6e, 0x46, 0xc4, 0xac, 0x6f, 0x6f, 0x92, 0xbe, 0xbf, 0x0f, 0x3c, 0xd3, 0xd8,
+	0xb0, 0x47, 0x85, 0x4c, 0xc1, 0xef, 0xc0, 0xad, 0x33, 0xb0, 0xa9, 0xb9, 0xf8, 0x05, 0xc1, 0x8d,
+	0x40, 0x7f, 0xe8, 0x4b, 0x6d, 0xea, 0xc8, 0xba, 0xc7, 0x9a, 0xf4, 0x32, 0xe9, 0x3f, 0x80, 0x65,
+	0xa1, 0xdc, 0x4c, 0xde, 0xb7, 0x0b, 0x61, 0x97, 0x44, 0x3c, 0x45, 0x0a, 0x6f, 0x13, 0x36, 0x32,
+	0xe3, 0x2a, 0xa0, 0x3f, 0x11, 0xac, 0x05, 0x8a, 0x03, 0xd7, 0x69, 0x52, 0x47, 0x7a, 0x58, 0x32,
+	0xd7, 0xa9, 0x61, 0xcf, 0x62, 0xce, 0x65, 0x42, 0x6d, 0xc3, 0x82, 0x6c, 0x79, 0x54, 0xb4, 0xdc,
+	0x36, 0x19, 0xda, 0xaf, 0xf3, 0x6a, 0xb0, 0xce, 0x6d, 0x6d, 0x1d, 0x00, 0x13, 0x62, 0xba, 0x8e,
+	0xaf, 0x98, 0xf1, 0x15, 0x6f, 0x61, 0x42, 0xbe, 0x74, 0xb2, 0x36, 0xf2, 0x36, 0x6c, 0xe5, 0xa2,
+	0x44, 0xc0, 0xbb, 0xaf, 0x66, 0x61, 0xba, 0x26, 0x2c, 0x4d, 0xc0, 0x52, 0xf2, 0x58, 0xbb, 0x95,
+	0x7b, 0x2e, 0xa4, 0x0f, 0x2a, 0x7d, 0x6f, 0x02, 0x71, 0x64, 0xde, 0x37, 0x4d, 0xfe, 0x77, 0x30,
+	0xd2, 0x34, 0x21, 0xd6, 0xf7, 0x26, 0x10, 0x2b, 0xd3, 0x23, 0x58, 0x4e, 0xbd, 0xcd, 0x3f, 0x1a,
+	0xd5, 0x28, 0xa9, 0xd6, 0xef, 0x4c, 0xa2, 0x56, 0xbe, 0xdf, 0x23, 0xb8, 0x91, 0xfd, 0xca, 0xbd,
+	0x3d, 0xaa, 0x5f, 0x66, 0x89, 0xfe, 0xe9, 0xc4, 0x25, 0x2a, 0xc7, 0xd7, 0x30, 0x1f, 0x7b, 0x8d,
+	0x54, 0xce, 0xd0, 0x2a, 0x30, 0xfd, 0xf8, 0xac, 0x4a, 0xe5, 0xd5, 0x83, 0xeb, 0xe9, 0xc3, 0x7f,
+	0x67, 0xcc, 0xf4, 0xc5, 0xe5, 0xfa, 0x27, 0x13, 0xc9, 0x95, 0xf5, 0xcf, 0x08, 0x4a, 0x63, 0xcf,
+	0xe8, 0xfb, 0x63, 0x7a, 0x8f, 0xac, 0xd6, 0x1f, 0x5e, 0xa4, 0x5a, 0x05, 0xfd, 0x16, 0xb4, 0x8c,
+	0xf3, 0xb3, 0x3a, 0xa6, 0x77, 0x42, 0xaf, 0xdf, 0x9d, 0x4c, 0xaf, 0xdc, 0x5f, 0x21, 0x78, 0x27,
+	0xe7, 0xb4, 0xdb, 0x1d, 0xd3, 0x32, 0xa3, 0x46, 0xbf, 0x37, 0x79, 0x4d, 0x14, 0x65, 0xff, 0xd9,
+	0xeb, 0x93, 0x22, 0x3a, 0x3e, 0x29, 0xa2, 0xff, 0x4e, 0x8a, 0xe8, 0x87, 0xd3, 0xe2, 0xd4, 0xf1,
+	0x69, 0x71, 0xea, 0xef, 0xd3, 0xe2, 0xd4, 0x57, 0xf7, 0x2d, 0x26, 0x5b, 0x9d, 0x17, 0xd5, 0xa6,
+	0x6b, 0x1b, 0xb1, 0xaf, 0x8f, 0xa3, 0x3b, 0x3b, 0xcd, 0x16, 0x66, 0x8e, 0xa1, 0x46, 0xba, 0xb1,
+	0x8f, 0xa7, 0x1e, 0xa7, 0xe2, 0xc5, 0x35, 0xff, 0xe6, 0xde, 0x9b, 0x01, 0x00, 0xfe, 0xac, 0x79,
+	0x9b, 0x64, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -623,6 +1082,17 @@ type MsgClient interface {
 	UpdatePerpetualParams(ctx context.Context, in *MsgUpdatePerpetualParams, opts ...grpc.CallOption) (*MsgUpdatePerpetualParamsResponse, error)
 	// UpdateParams updates the parameters of perpetuals module.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetFundingRateCap sets the cap on the funding rate of a perpetual.
+	SetFundingRateCap(ctx context.Context, in *MsgSetFundingRateCap, opts ...grpc.CallOption) (*MsgSetFundingRateCapResponse, error)
+	// SetMaxUnsettledFundingIndexDelta sets the cap on the unsettled funding
+	// index delta of positions in a perpetual.
+	SetMaxUnsettledFundingIndexDelta(ctx context.Context, in *MsgSetMaxUnsettledFundingIndexDelta, opts ...grpc.CallOption) (*MsgSetMaxUnsettledFundingIndexDeltaResponse, error)
+	// SetSettlementPrice sets the settlement price of a perpetual that is being
+	// wound down.
+	SetSettlementPrice(ctx context.Context, in *MsgSetSettlementPrice, opts ...grpc.CallOption) (*MsgSetSettlementPriceResponse, error)
+	// SetConcentrationMargin sets the additional maintenance margin required of
+	// concentrated positions in a perpetual.
+	SetConcentrationMargin(ctx context.Context, in *MsgSetConcentrationMargin, opts ...grpc.CallOption) (*MsgSetConcentrationMarginResponse, error)
 }
 
 type msgClient struct {
@@ -678,13 +1148,49 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// AddPremiumVotes add new samples of the funding premiums to the
-	// application.
-	AddPremiumVotes(context.Context, *MsgAddPremiumVotes) (*MsgAddPremiumVotesResponse, error)
-	// CreatePerpetual creates a new perpetual object.
-	CreatePerpetual(context.Context, *MsgCreatePerpetual) (*MsgCreatePerpetualResponse, error)
+func (c *msgClient) SetFundingRateCap(ctx context.Context, in *MsgSetFundingRateCap, opts ...grpc.CallOption) (*MsgSetFundingRateCapResponse, error) {
+	out := new(MsgSetFundingRateCapResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Msg/SetFundingRateCap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMaxUnsettledFundingIndexDelta(ctx context.Context, in *MsgSetMaxUnsettledFundingIndexDelta, opts ...grpc.CallOption) (*MsgSetMaxUnsettledFundingIndexDeltaResponse, error) {
+	out := new(MsgSetMaxUnsettledFundingIndexDeltaResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Msg/SetMaxUnsettledFundingIndexDelta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSettlementPrice(ctx context.Context, in *MsgSetSettlementPrice, opts ...grpc.CallOption) (*MsgSetSettlementPriceResponse, error) {
+	out := new(MsgSetSettlementPriceResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Msg/SetSettlementPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetConcentrationMargin(ctx context.Context, in *MsgSetConcentrationMargin, opts ...grpc.CallOption) (*MsgSetConcentrationMarginResponse, error) {
+	out := new(MsgSetConcentrationMarginResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Msg/SetConcentrationMargin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// AddPremiumVotes add new samples of the funding premiums to the
+	// application.
+	AddPremiumVotes(context.Context, *MsgAddPremiumVotes) (*MsgAddPremiumVotesResponse, error)
+	// CreatePerpetual creates a new perpetual object.
+	CreatePerpetual(context.Context, *MsgCreatePerpetual) (*MsgCreatePerpetualResponse, error)
 	// SetLiquidityTier creates an liquidity tier if the ID doesn't exist, and
 	// updates the existing liquidity tier otherwise.
 	SetLiquidityTier(context.Context, *MsgSetLiquidityTier) (*MsgSetLiquidityTierResponse, error)
@@ -692,6 +1198,17 @@ type MsgServer interface {
 	UpdatePerpetualParams(context.Context, *MsgUpdatePerpetualParams) (*MsgUpdatePerpetualParamsResponse, error)
 	// UpdateParams updates the parameters of perpetuals module.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetFundingRateCap sets the cap on the funding rate of a perpetual.
+	SetFundingRateCap(context.Context, *MsgSetFundingRateCap) (*MsgSetFundingRateCapResponse, error)
+	// SetMaxUnsettledFundingIndexDelta sets the cap on the unsettled funding
+	// index delta of positions in a perpetual.
+	SetMaxUnsettledFundingIndexDelta(context.Context, *MsgSetMaxUnsettledFundingIndexDelta) (*MsgSetMaxUnsettledFundingIndexDeltaResponse, error)
+	// SetSettlementPrice sets the settlement price of a perpetual that is being
+	// wound down.
+	SetSettlementPrice(context.Context, *MsgSetSettlementPrice) (*MsgSetSettlementPriceResponse, error)
+	// SetConcentrationMargin sets the additional maintenance margin required of
+	// concentrated positions in a perpetual.
+	SetConcentrationMargin(context.Context, *MsgSetConcentrationMargin) (*MsgSetConcentrationMarginResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -713,6 +1230,18 @@ func (*UnimplementedMsgServer) UpdatePerpetualParams(ctx context.Context, req *M
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetFundingRateCap(ctx context.Context, req *MsgSetFundingRateCap) (*MsgSetFundingRateCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFundingRateCap not implemented")
+}
+func (*UnimplementedMsgServer) SetMaxUnsettledFundingIndexDelta(ctx context.Context, req *MsgSetMaxUnsettledFundingIndexDelta) (*MsgSetMaxUnsettledFundingIndexDeltaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxUnsettledFundingIndexDelta not implemented")
+}
+func (*UnimplementedMsgServer) SetSettlementPrice(ctx context.Context, req *MsgSetSettlementPrice) (*MsgSetSettlementPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSettlementPrice not implemented")
+}
+func (*UnimplementedMsgServer) SetConcentrationMargin(ctx context.Context, req *MsgSetConcentrationMargin) (*MsgSetConcentrationMarginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConcentrationMargin not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -808,6 +1337,78 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetFundingRateCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFundingRateCap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFundingRateCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Msg/SetFundingRateCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFundingRateCap(ctx, req.(*MsgSetFundingRateCap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMaxUnsettledFundingIndexDelta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMaxUnsettledFundingIndexDelta)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMaxUnsettledFundingIndexDelta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Msg/SetMaxUnsettledFundingIndexDelta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMaxUnsettledFundingIndexDelta(ctx, req.(*MsgSetMaxUnsettledFundingIndexDelta))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSettlementPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSettlementPrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSettlementPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Msg/SetSettlementPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSettlementPrice(ctx, req.(*MsgSetSettlementPrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetConcentrationMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetConcentrationMargin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetConcentrationMargin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Msg/SetConcentrationMargin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetConcentrationMargin(ctx, req.(*MsgSetConcentrationMargin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.perpetuals.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -832,6 +1433,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetFundingRateCap",
+			Handler:    _Msg_SetFundingRateCap_Handler,
+		},
+		{
+			MethodName: "SetMaxUnsettledFundingIndexDelta",
+			Handler:    _Msg_SetMaxUnsettledFundingIndexDelta_Handler,
+		},
+		{
+			MethodName: "SetSettlementPrice",
+			Handler:    _Msg_SetSettlementPrice_Handler,
+		},
+		{
+			MethodName: "SetConcentrationMargin",
+			Handler:    _Msg_SetConcentrationMargin_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/perpetuals/tx.proto",
@@ -1182,129 +1799,386 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetFundingRateCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreatePerpetual) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetFundingRateCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFundingRateCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.FundingRateCapPpm != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FundingRateCapPpm))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if m.PerpetualId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreatePerpetualResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetFundingRateCapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSetLiquidityTier) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.LiquidityTier.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgSetFundingRateCapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetLiquidityTierResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetFundingRateCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdatePerpetualParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetMaxUnsettledFundingIndexDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.PerpetualParams.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdatePerpetualParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSetMaxUnsettledFundingIndexDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FundingPremium) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetMaxUnsettledFundingIndexDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.MaxUnsettledFundingIndexDelta != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxUnsettledFundingIndexDelta))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.PerpetualId != 0 {
-		n += 1 + sovTx(uint64(m.PerpetualId))
+		i = encodeVarintTx(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.PremiumPpm != 0 {
-		n += 1 + sovTx(uint64(m.PremiumPpm))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddPremiumVotes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Votes) > 0 {
-		for _, e := range m.Votes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgSetMaxUnsettledFundingIndexDeltaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddPremiumVotesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetMaxUnsettledFundingIndexDeltaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMaxUnsettledFundingIndexDeltaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
+func (m *MsgSetSettlementPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSettlementPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSettlementPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SettlementPrice != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettlementPrice))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSettlementPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSettlementPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSettlementPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetConcentrationMargin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetConcentrationMargin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetConcentrationMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AddOnPpm != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AddOnPpm))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ThresholdPpm != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ThresholdPpm))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetConcentrationMarginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetConcentrationMarginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetConcentrationMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreatePerpetual) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreatePerpetualResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetLiquidityTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.LiquidityTier.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetLiquidityTierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdatePerpetualParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PerpetualParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdatePerpetualParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *FundingPremium) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovTx(uint64(m.PerpetualId))
+	}
+	if m.PremiumPpm != 0 {
+		n += 1 + sovTx(uint64(m.PremiumPpm))
+	}
+	return n
+}
+
+func (m *MsgAddPremiumVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddPremiumVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1328,13 +2202,845 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgSetFundingRateCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PerpetualId != 0 {
+		n += 1 + sovTx(uint64(m.PerpetualId))
+	}
+	if m.FundingRateCapPpm != 0 {
+		n += 1 + sovTx(uint64(m.FundingRateCapPpm))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgSetFundingRateCapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMaxUnsettledFundingIndexDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PerpetualId != 0 {
+		n += 1 + sovTx(uint64(m.PerpetualId))
+	}
+	if m.MaxUnsettledFundingIndexDelta != 0 {
+		n += 1 + sovTx(uint64(m.MaxUnsettledFundingIndexDelta))
+	}
+	return n
+}
+
+func (m *MsgSetMaxUnsettledFundingIndexDeltaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSettlementPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PerpetualId != 0 {
+		n += 1 + sovTx(uint64(m.PerpetualId))
+	}
+	if m.SettlementPrice != 0 {
+		n += 1 + sovTx(uint64(m.SettlementPrice))
+	}
+	return n
+}
+
+func (m *MsgSetSettlementPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetConcentrationMargin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PerpetualId != 0 {
+		n += 1 + sovTx(uint64(m.PerpetualId))
+	}
+	if m.ThresholdPpm != 0 {
+		n += 1 + sovTx(uint64(m.ThresholdPpm))
+	}
+	if m.AddOnPpm != 0 {
+		n += 1 + sovTx(uint64(m.AddOnPpm))
+	}
+	return n
+}
+
+func (m *MsgSetConcentrationMarginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreatePerpetual) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePerpetual: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePerpetual: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePerpetualResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePerpetualResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePerpetualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetLiquidityTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetLiquidityTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetLiquidityTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityTier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityTier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetLiquidityTierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetLiquidityTierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetLiquidityTierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePerpetualParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePerpetualParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePerpetualParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerpetualParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePerpetualParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePerpetualParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePerpetualParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingPremium) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingPremium: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingPremium: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumPpm", wireType)
+			}
+			m.PremiumPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PremiumPpm |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddPremiumVotes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddPremiumVotes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddPremiumVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, FundingPremium{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddPremiumVotesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddPremiumVotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddPremiumVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreatePerpetual) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1357,10 +3063,10 @@ func (m *MsgCreatePerpetual) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePerpetual: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePerpetual: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1449,7 +3155,7 @@ func (m *MsgCreatePerpetual) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePerpetualResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1472,10 +3178,10 @@ func (m *MsgCreatePerpetualResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePerpetualResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePerpetualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1499,7 +3205,7 @@ func (m *MsgCreatePerpetualResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetLiquidityTier) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFundingRateCap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1522,10 +3228,10 @@ func (m *MsgSetLiquidityTier) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetLiquidityTier: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFundingRateCap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetLiquidityTier: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFundingRateCap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1561,10 +3267,10 @@ func (m *MsgSetLiquidityTier) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityTier", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
 			}
-			var msglen int
+			m.PerpetualId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1574,25 +3280,30 @@ func (m *MsgSetLiquidityTier) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PerpetualId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRateCapPpm", wireType)
 			}
-			if err := m.LiquidityTier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FundingRateCapPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FundingRateCapPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1614,7 +3325,7 @@ func (m *MsgSetLiquidityTier) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetLiquidityTierResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFundingRateCapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1637,10 +3348,10 @@ func (m *MsgSetLiquidityTierResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetLiquidityTierResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFundingRateCapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetLiquidityTierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFundingRateCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1664,7 +3375,7 @@ func (m *MsgSetLiquidityTierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePerpetualParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMaxUnsettledFundingIndexDelta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1687,10 +3398,10 @@ func (m *MsgUpdatePerpetualParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePerpetualParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMaxUnsettledFundingIndexDelta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePerpetualParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMaxUnsettledFundingIndexDelta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1726,10 +3437,10 @@ func (m *MsgUpdatePerpetualParams) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualParams", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
 			}
-			var msglen int
+			m.PerpetualId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1739,25 +3450,30 @@ func (m *MsgUpdatePerpetualParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PerpetualId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnsettledFundingIndexDelta", wireType)
 			}
-			if err := m.PerpetualParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MaxUnsettledFundingIndexDelta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUnsettledFundingIndexDelta |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1779,7 +3495,7 @@ func (m *MsgUpdatePerpetualParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePerpetualParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMaxUnsettledFundingIndexDeltaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1802,10 +3518,10 @@ func (m *MsgUpdatePerpetualParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePerpetualParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMaxUnsettledFundingIndexDeltaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePerpetualParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMaxUnsettledFundingIndexDeltaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1829,7 +3545,7 @@ func (m *MsgUpdatePerpetualParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FundingPremium) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSettlementPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1852,17 +3568,17 @@ func (m *FundingPremium) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FundingPremium: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSettlementPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FundingPremium: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSettlementPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			m.PerpetualId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1872,16 +3588,29 @@ func (m *FundingPremium) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PerpetualId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PremiumPpm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
 			}
-			m.PremiumPpm = 0
+			m.PerpetualId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1891,66 +3620,16 @@ func (m *FundingPremium) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PremiumPpm |= int32(b&0x7F) << shift
+				m.PerpetualId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAddPremiumVotes) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddPremiumVotes: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddPremiumVotes: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
 			}
-			var msglen int
+			m.SettlementPrice = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1960,26 +3639,11 @@ func (m *MsgAddPremiumVotes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SettlementPrice |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Votes = append(m.Votes, FundingPremium{})
-			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2001,7 +3665,7 @@ func (m *MsgAddPremiumVotes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddPremiumVotesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSettlementPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2024,10 +3688,10 @@ func (m *MsgAddPremiumVotesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddPremiumVotesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSettlementPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddPremiumVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSettlementPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2051,7 +3715,7 @@ func (m *MsgAddPremiumVotesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetConcentrationMargin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2074,10 +3738,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetConcentrationMargin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetConcentrationMargin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2113,10 +3777,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
 			}
-			var msglen int
+			m.PerpetualId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2126,25 +3790,49 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PerpetualId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdPpm", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			m.ThresholdPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThresholdPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddOnPpm", wireType)
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AddOnPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddOnPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2166,7 +3854,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetConcentrationMarginResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2189,10 +3877,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetConcentrationMarginResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetConcentrationMarginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -101,6 +101,26 @@ type PerpetualsKeeper interface {
 		ctx sdk.Context,
 		params Params,
 	) error
+	SetFundingRateCapPpm(
+		ctx sdk.Context,
+		perpetualId uint32,
+		capPpm uint32,
+	) error
+	SetMaxUnsettledFundingIndexDelta(
+		ctx sdk.Context,
+		perpetualId uint32,
+		maxDelta uint64,
+	) error
+	SetSettlementPrice(
+		ctx sdk.Context,
+		perpetualId uint32,
+		settlementPrice uint64,
+	) error
+	SetConcentrationMargin(
+		ctx sdk.Context,
+		perpetualId uint32,
+		concentrationMargin ConcentrationMargin,
+	) error
 	SetPerpetualMarketType(
 		ctx sdk.Context,
 		id uint32,
@@ -114,6 +134,11 @@ type PerpetualsKeeper interface {
 		ctx sdk.Context,
 	) []Perpetual
 	GetAllLiquidityTiers(ctx sdk.Context) (list []LiquidityTier)
+	GetPerpetualsByLiquidityTier(ctx sdk.Context, liquidityTierId uint32) (perpetualIds []uint32)
+	ValidateAndSetLiquidityTier(
+		ctx sdk.Context,
+		liquidityTier LiquidityTier,
+	) error
 	ValidateAndSetPerpetual(
 		ctx sdk.Context,
 		perpetual Perpetual,
@@ -60,7 +60,7 @@ func (k Keeper) UpdateMarketPrices(
 			)
 		}
 
-		// Update market price.
+		// Update market price. The sign of the market price is config-only and kept.
 		marketPrice.Price = update.Price
 		updatedMarketPrices = append(updatedMarketPrices, marketPrice)
 
@@ -88,6 +88,43 @@ func TestUpdateMarketPrices_NotFound(t *testing.T) {
 	}
 }
 
+func TestUpdateMarketPrices_KeepsSign(t *testing.T) {
+	ctx, keeper, _, _, mockTimeProvider, _, _ := keepertest.PricesKeepers(t)
+	mockTimeProvider.On("Now").Return(constants.TimeT)
+	ctx = ctx.WithTxBytes(constants.TestTxBytes)
+	_, err := keepertest.CreateTestMarket(
+		t,
+		ctx,
+		keeper,
+		types.MarketParam{
+			Id:                 0,
+			Pair:               "0-0",
+			Exponent:           -1,
+			MinExchanges:       1,
+			MinPriceChangePpm:  1,
+			ExchangeConfigJson: "{}",
+		},
+		types.MarketPrice{
+			Id:       0,
+			Exponent: -1,
+			Price:    1_000,
+			Negative: true,
+		},
+	)
+	require.NoError(t, err)
+
+	err = keeper.UpdateMarketPrices(
+		ctx,
+		[]*types.MsgUpdateMarketPrices_MarketPrice{{MarketId: 0, Price: 2_000}},
+	)
+	require.NoError(t, err)
+
+	price, err := keeper.GetMarketPrice(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2_000), price.Price)
+	require.True(t, price.Negative)
+}
+
 func TestGetMarketPrice(t *testing.T) {
 	ctx, keeper, _, _, mockTimeProvider, _, _ := keepertest.PricesKeepers(t)
 	mockTimeProvider.On("Now").Return(constants.TimeT)
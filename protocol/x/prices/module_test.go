@@ -36,7 +36,7 @@ const (
 	validGenesisState = `{` +
 		`"market_params":[{"id":0,"pair":"DENT-USD","exponent":-1,"min_exchanges":1,"min_price_change_ppm":1,` +
 		`"exchange_config_json":"{}"}],` +
-		`"market_prices":[{"id":0,"exponent":-1,"price":"1"}]` +
+		`"market_prices":[{"id":0,"exponent":-1,"price":"1","negative":false,"confidence":"0"}]` +
 		`}`
 )
 
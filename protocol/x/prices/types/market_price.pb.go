@@ -35,6 +35,17 @@ type MarketPrice struct {
 	// The variable value that is updated by oracle price updates. `0` if it has
 	// never been updated, `>0` otherwise.
 	Price uint64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	// Whether the market price is negative. If set, the market price is
+	// `-price * 10^exponent`. Defaults to `false`, a positive market price.
+	//
+	// This value is config-only and can only be set in the genesis state. Oracle
+	// price updates only update `price` and keep the sign. Markets with a
+	// negative price cannot be used as the market of an asset.
+	Negative bool `protobuf:"varint,4,opt,name=negative,proto3" json:"negative,omitempty"`
+	// The confidence interval of the market price, in the same units as
+	// `price`. The market price is expected to be within `confidence` of the
+	// reported price. Defaults to `0`, no uncertainty.
+	Confidence uint64 `protobuf:"varint,5,opt,name=confidence,proto3" json:"confidence,omitempty"`
 }
 
 func (m *MarketPrice) Reset()         { *m = MarketPrice{} }
@@ -91,6 +102,20 @@ func (m *MarketPrice) GetPrice() uint64 {
 	return 0
 }
 
+func (m *MarketPrice) GetNegative() bool {
+	if m != nil {
+		return m.Negative
+	}
+	return false
+}
+
+func (m *MarketPrice) GetConfidence() uint64 {
+	if m != nil {
+		return m.Confidence
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MarketPrice)(nil), "dydxprotocol.prices.MarketPrice")
 }
@@ -100,20 +125,22 @@ func init() {
 }
 
 var fileDescriptor_dfe320bc057cd5ae = []byte{
-	// 193 bytes of a gzipped FileDescriptorProto
+	// 230 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0xa9, 0x4c, 0xa9,
 	0x28, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x2f, 0x28, 0xca, 0x4c, 0x4e, 0x2d, 0xd6,
 	0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0x89, 0x07, 0xf3, 0xf4, 0xc0, 0x92, 0x42, 0xc2, 0xc8, 0xea,
-	0xf4, 0x20, 0xea, 0x94, 0xfc, 0xb9, 0xb8, 0x7d, 0xc1, 0x4a, 0x03, 0x40, 0x7c, 0x21, 0x3e, 0x2e,
-	0xa6, 0xcc, 0x14, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xde, 0x20, 0xa6, 0xcc, 0x14, 0x21, 0x29, 0x2e,
-	0x8e, 0xd4, 0x8a, 0x82, 0xfc, 0xbc, 0xd4, 0xbc, 0x12, 0x09, 0x26, 0x05, 0x46, 0x0d, 0xc1, 0x20,
-	0x38, 0x5f, 0x48, 0x84, 0x8b, 0x15, 0x6c, 0x88, 0x04, 0xb3, 0x02, 0xa3, 0x06, 0x4b, 0x10, 0x84,
-	0xe3, 0x14, 0x74, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x16, 0xe9, 0x99,
-	0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x28, 0x4e, 0x2e, 0x33, 0xd1, 0x4d, 0xce,
-	0x48, 0xcc, 0xcc, 0xd3, 0x87, 0x8b, 0x54, 0xc0, 0xbc, 0x51, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4,
-	0x06, 0x96, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xd6, 0xaf, 0x15, 0x28, 0xea, 0x00, 0x00,
-	0x00,
+	0xf4, 0x20, 0xea, 0x94, 0xba, 0x19, 0xb9, 0xb8, 0x7d, 0xc1, 0x6a, 0x03, 0x40, 0x02, 0x42, 0x7c,
+	0x5c, 0x4c, 0x99, 0x29, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xbc, 0x41, 0x4c, 0x99, 0x29, 0x42, 0x52,
+	0x5c, 0x1c, 0xa9, 0x15, 0x05, 0xf9, 0x79, 0xa9, 0x79, 0x25, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x82,
+	0x41, 0x70, 0xbe, 0x90, 0x08, 0x17, 0x2b, 0xd8, 0x14, 0x09, 0x66, 0x05, 0x46, 0x0d, 0x96, 0x20,
+	0x08, 0x07, 0xa4, 0x23, 0x2f, 0x35, 0x3d, 0xb1, 0x24, 0xb3, 0x2c, 0x55, 0x82, 0x45, 0x81, 0x51,
+	0x83, 0x23, 0x08, 0xce, 0x17, 0x92, 0xe3, 0xe2, 0x4a, 0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0x49, 0xcd,
+	0x4b, 0x4e, 0x95, 0x60, 0x05, 0x6b, 0x43, 0x12, 0x71, 0x0a, 0x3a, 0xf1, 0x48, 0x8e, 0xf1, 0xc2,
+	0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1,
+	0xc6, 0x63, 0x39, 0x86, 0x28, 0x8b, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c,
+	0x7d, 0x14, 0xff, 0x96, 0x99, 0xe8, 0x26, 0x67, 0x24, 0x66, 0xe6, 0xe9, 0xc3, 0x45, 0x2a, 0x60,
+	0x61, 0x50, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x96, 0x30, 0x06, 0x0c, 0x00, 0x02, 0x1b,
+	0x9f, 0xcb, 0x27, 0x01, 0x00, 0x00,
 }
 
 func (m *MarketPrice) Marshal() (dAtA []byte, err error) {
@@ -136,6 +163,21 @@ func (m *MarketPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Confidence != 0 {
+		i = encodeVarintMarketPrice(dAtA, i, uint64(m.Confidence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Negative {
+		i--
+		if m.Negative {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Price != 0 {
 		i = encodeVarintMarketPrice(dAtA, i, uint64(m.Price))
 		i--
@@ -180,6 +222,12 @@ func (m *MarketPrice) Size() (n int) {
 	if m.Price != 0 {
 		n += 1 + sovMarketPrice(uint64(m.Price))
 	}
+	if m.Negative {
+		n += 2
+	}
+	if m.Confidence != 0 {
+		n += 1 + sovMarketPrice(uint64(m.Confidence))
+	}
 	return n
 }
 
@@ -277,6 +325,45 @@ func (m *MarketPrice) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Negative", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Negative = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confidence", wireType)
+			}
+			m.Confidence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Confidence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarketPrice(dAtA[iNdEx:])
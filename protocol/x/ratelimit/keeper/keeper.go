@@ -23,11 +23,12 @@ import (
 
 type (
 	Keeper struct {
-		cdc             codec.BinaryCodec
-		storeKey        storetypes.StoreKey
-		bankKeeper      types.BankKeeper
-		blockTimeKeeper types.BlockTimeKeeper
-		ics4Wrapper     types.ICS4Wrapper
+		cdc               codec.BinaryCodec
+		storeKey          storetypes.StoreKey
+		bankKeeper        types.BankKeeper
+		blockTimeKeeper   types.BlockTimeKeeper
+		subaccountsKeeper types.SubaccountsKeeper
+		ics4Wrapper       types.ICS4Wrapper
 
 		// the addresses capable of executing MsgSetLimitParams message.
 		authorities map[string]struct{}
@@ -39,16 +40,18 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	bankKeeper types.BankKeeper,
 	blockTimeKeeper types.BlockTimeKeeper,
+	subaccountsKeeper types.SubaccountsKeeper,
 	ics4Wrapper types.ICS4Wrapper,
 	authorities []string,
 ) *Keeper {
 	return &Keeper{
-		cdc:             cdc,
-		storeKey:        storeKey,
-		bankKeeper:      bankKeeper,
-		blockTimeKeeper: blockTimeKeeper,
-		ics4Wrapper:     ics4Wrapper,
-		authorities:     lib.UniqueSliceToSet(authorities),
+		cdc:               cdc,
+		storeKey:          storeKey,
+		bankKeeper:        bankKeeper,
+		blockTimeKeeper:   blockTimeKeeper,
+		subaccountsKeeper: subaccountsKeeper,
+		ics4Wrapper:       ics4Wrapper,
+		authorities:       lib.UniqueSliceToSet(authorities),
 	}
 }
 
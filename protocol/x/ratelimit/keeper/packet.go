@@ -5,7 +5,7 @@ package keeper
 // See v4-chain/protocol/x/ratelimit/LICENSE and v4-chain/protocol/x/ratelimit/README.md for licensing information.
 
 import (
-	"math/big"
+	"math"
 
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,8 +15,10 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/ratelimit/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/ratelimit/util"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
 // Remove a pending packet sequence number from the store
@@ -62,14 +64,17 @@ func (k Keeper) AcknowledgeIBCTransferPacket(
 		return err
 	}
 
-	// If the ack was successful, remove the pending packet
+	// If the ack was successful, remove the pending packet and unlock its collateral
 	if ackResponse.Status == types.AckResponseStatus_SUCCESS {
-		k.RemovePendingSendPacket(ctx, packetInfo.ChannelID, packet.Sequence)
+		if k.HasPendingSendPacket(ctx, packetInfo.ChannelID, packet.Sequence) {
+			k.unlockPendingWithdrawalCollateral(ctx, packetInfo)
+			k.RemovePendingSendPacket(ctx, packetInfo.ChannelID, packet.Sequence)
+		}
 		return nil
 	}
 
 	// If the ack failed, undo the change to the capacity
-	k.UndoSendPacket(ctx, packetInfo.ChannelID, packet.Sequence, packetInfo.Denom, packetInfo.Amount)
+	k.UndoSendPacket(ctx, packetInfo, packet.Sequence)
 	return nil
 }
 
@@ -83,39 +88,94 @@ func (k Keeper) TimeoutIBCTransferPacket(ctx sdk.Context, packet channeltypes.Pa
 		return err
 	}
 
-	k.UndoSendPacket(ctx, packetInfo.ChannelID, packet.Sequence, packetInfo.Denom, packetInfo.Amount)
+	k.UndoSendPacket(ctx, packetInfo, packet.Sequence)
 	return nil
 }
 
 // If a SendPacket fails or times out, undo the capacity decrease that happened during the send
+// and unlock the collateral locked by the send.
 // Idempotent - has no effect on a previously removed pending packet.
 func (k Keeper) UndoSendPacket(
 	ctx sdk.Context,
-	channelId string,
+	packetInfo types.IBCTransferPacketInfo,
 	sequence uint64,
-	denom string,
-	amount *big.Int,
 ) {
-	if !k.HasPendingSendPacket(ctx, channelId, sequence) {
+	if !k.HasPendingSendPacket(ctx, packetInfo.ChannelID, sequence) {
 		return
 	}
 	// Undo'ing capacity change from the withdrawal.
-	k.UndoWithdrawal(ctx, denom, amount)
-	k.RemovePendingSendPacket(ctx, channelId, sequence)
+	k.UndoWithdrawal(ctx, packetInfo.Denom, packetInfo.Amount)
+	k.unlockPendingWithdrawalCollateral(ctx, packetInfo)
+	k.RemovePendingSendPacket(ctx, packetInfo.ChannelID, sequence)
 
 	k.Logger(ctx).Info(
 		"SendPacket timeout'ed or failed acknowledgement on the receiver chain. Reverted capacity change.",
 		"channel_id",
-		channelId,
+		packetInfo.ChannelID,
 		"sequence",
 		sequence,
 		"denom",
-		denom,
+		packetInfo.Denom,
 		"amount",
-		amount.String(),
+		packetInfo.Amount.String(),
 	)
 }
 
+// getPendingWithdrawalSubaccountId returns the subaccount whose collateral is locked while an
+// outbound transfer is pending. Only USDC transfers lock collateral, and they lock it on the main
+// subaccount of the sender. Returns false if the transfer does not lock collateral.
+func getPendingWithdrawalSubaccountId(packetInfo types.IBCTransferPacketInfo) (satypes.SubaccountId, bool) {
+	if packetInfo.Denom != assettypes.AssetUsdc.Denom {
+		return satypes.SubaccountId{}, false
+	}
+	if _, err := sdk.AccAddressFromBech32(packetInfo.Sender); err != nil {
+		return satypes.SubaccountId{}, false
+	}
+	return satypes.SubaccountId{Owner: packetInfo.Sender, Number: 0}, true
+}
+
+// getPendingWithdrawalQuoteQuantums returns the amount of an outbound USDC transfer in quote quantums.
+// Quote quantums and the base denom of USDC have the same resolution.
+func getPendingWithdrawalQuoteQuantums(packetInfo types.IBCTransferPacketInfo) uint64 {
+	if !packetInfo.Amount.IsUint64() {
+		return math.MaxUint64
+	}
+	return packetInfo.Amount.Uint64()
+}
+
+// lockPendingWithdrawalCollateral locks the collateral of the sender of an outbound transfer until the
+// transfer is acknowledged or times out.
+func (k Keeper) lockPendingWithdrawalCollateral(ctx sdk.Context, packetInfo types.IBCTransferPacketInfo) {
+	subaccountId, ok := getPendingWithdrawalSubaccountId(packetInfo)
+	if !ok {
+		return
+	}
+	locked := k.subaccountsKeeper.GetLockedCollateral(ctx, subaccountId)
+	amount := getPendingWithdrawalQuoteQuantums(packetInfo)
+	if locked > math.MaxUint64-amount {
+		locked = math.MaxUint64
+	} else {
+		locked += amount
+	}
+	k.subaccountsKeeper.SetLockedCollateral(ctx, subaccountId, locked)
+}
+
+// unlockPendingWithdrawalCollateral unlocks the collateral locked by `lockPendingWithdrawalCollateral`.
+func (k Keeper) unlockPendingWithdrawalCollateral(ctx sdk.Context, packetInfo types.IBCTransferPacketInfo) {
+	subaccountId, ok := getPendingWithdrawalSubaccountId(packetInfo)
+	if !ok {
+		return
+	}
+	locked := k.subaccountsKeeper.GetLockedCollateral(ctx, subaccountId)
+	amount := getPendingWithdrawalQuoteQuantums(packetInfo)
+	if locked < amount {
+		locked = 0
+	} else {
+		locked -= amount
+	}
+	k.subaccountsKeeper.SetLockedCollateral(ctx, subaccountId, locked)
+}
+
 // SendPacket wraps IBC ChannelKeeper's SendPacket function
 // If the packet does not get rate limited, it passes the packet to the IBC Channel keeper
 func (k Keeper) SendPacket(
@@ -195,6 +255,9 @@ func (k Keeper) TrySendRateLimitedPacket(ctx sdk.Context, packet channeltypes.Pa
 	// we can identify if it was sent during this quota and can revert the outflow
 	k.SetPendingSendPacket(ctx, packetInfo.ChannelID, packet.Sequence)
 
+	// Lock the collateral of the sender until the transfer is acknowledged or times out.
+	k.lockPendingWithdrawalCollateral(ctx, packetInfo)
+
 	return nil
 }
 
@@ -1,9 +1,14 @@
 package keeper_test
 
 import (
+	"encoding/json"
 	"testing"
 
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -49,4 +54,43 @@ func TestPendingPacket(t *testing.T) {
 	)
 }
 
+func TestPendingSendPacket_LocksCollateral(t *testing.T) {
+	testChannelId := "channel-0"
+	sender := constants.AliceAccAddress.String()
+	subaccountId := satypes.SubaccountId{Owner: sender, Number: 0}
+
+	packetData, err := json.Marshal(ibctransfertypes.FungibleTokenPacketData{
+		Denom:    "transfer/channel-0/uusdc",
+		Amount:   "1000000",
+		Sender:   sender,
+		Receiver: "receiver",
+	})
+	require.NoError(t, err)
+	packet := channeltypes.Packet{
+		Sequence:      1,
+		SourcePort:    "transfer",
+		SourceChannel: testChannelId,
+		Data:          packetData,
+	}
+
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.RatelimitKeeper
+
+	// Sending the packet locks the collateral of the main subaccount of the sender.
+	require.NoError(t, k.TrySendRateLimitedPacket(ctx, packet))
+	require.True(t, k.HasPendingSendPacket(ctx, testChannelId, 1))
+	require.Equal(t, uint64(1_000_000), tApp.App.SubaccountsKeeper.GetLockedCollateral(ctx, subaccountId))
+
+	// Timing out the packet unlocks the collateral.
+	require.NoError(t, k.TimeoutIBCTransferPacket(ctx, packet))
+	require.False(t, k.HasPendingSendPacket(ctx, testChannelId, 1))
+	require.Equal(t, uint64(0), tApp.App.SubaccountsKeeper.GetLockedCollateral(ctx, subaccountId))
+
+	// Timing out the packet again has no effect.
+	tApp.App.SubaccountsKeeper.SetLockedCollateral(ctx, subaccountId, 5)
+	require.NoError(t, k.TimeoutIBCTransferPacket(ctx, packet))
+	require.Equal(t, uint64(5), tApp.App.SubaccountsKeeper.GetLockedCollateral(ctx, subaccountId))
+}
+
 // TODO(CORE-856): Improve coverage for remaining functions in packet.go
@@ -8,6 +8,7 @@ import (
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types" //nolint:staticcheck
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
 // BankKeeper defines the expected bank keeper used for simulations.
@@ -15,6 +16,12 @@ type BankKeeper interface {
 	GetSupply(ctx context.Context, denom string) sdk.Coin
 }
 
+// SubaccountsKeeper defines the expected subaccounts keeper used to lock the collateral of pending withdrawals.
+type SubaccountsKeeper interface {
+	GetLockedCollateral(ctx sdk.Context, subaccountId satypes.SubaccountId) uint64
+	SetLockedCollateral(ctx sdk.Context, subaccountId satypes.SubaccountId, quoteQuantums uint64)
+}
+
 type BlockTimeKeeper interface {
 	GetTimeSinceLastBlock(ctx sdk.Context) time.Duration
 }
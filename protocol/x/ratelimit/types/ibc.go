@@ -15,6 +15,7 @@ type IBCTransferPacketInfo struct {
 	ChannelID string
 	Denom     string
 	Amount    *big.Int
+	Sender    string
 }
 
 // AckResponseStatus represents the status of an acknowledgement of IBC transfer packet.
@@ -21,9 +21,10 @@ import (
 // - channelID
 // - denom
 // - amount
+// - sender
 //
-// This function is similar to Stride's implementation below except it ignores the `Sender`
-// and `Receiver` information.
+// This function is similar to Stride's implementation below except it ignores the `Receiver`
+// information.
 // https://github.com/Stride-Labs/stride/blob/eb3564c7/x/ratelimit/keeper/packet.go#L127
 func ParsePacketInfo(
 	packet channeltypes.Packet,
@@ -61,6 +62,7 @@ func ParsePacketInfo(
 		ChannelID: channelID,
 		Denom:     denom,
 		Amount:    amount,
+		Sender:    packetData.Sender,
 	}
 
 	return packetInfo, nil
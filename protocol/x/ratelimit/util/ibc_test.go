@@ -63,6 +63,7 @@ func TestParsePacketInfo(t *testing.T) {
 		ChannelID: sourceChannel,
 		Denom:     denom,
 		Amount:    amountInt,
+		Sender:    sender,
 	}
 	actualSendPacketInfo, err := util.ParsePacketInfo(packet, types.PACKET_SEND)
 	require.NoError(t, err, "no error expected when parsing send packet")
@@ -73,6 +74,7 @@ func TestParsePacketInfo(t *testing.T) {
 		ChannelID: destinationChannel,
 		Denom:     hashDenomTrace(fmt.Sprintf("transfer/%s/%s", destinationChannel, denom)),
 		Amount:    amountInt,
+		Sender:    sender,
 	}
 	actualRecvPacketInfo, err := util.ParsePacketInfo(packet, types.PACKET_RECV)
 	require.NoError(t, err, "no error expected when parsing recv packet")
@@ -215,7 +215,7 @@ func TestProcessTransfer(t *testing.T) {
 				constants.Dave_Num0: big.NewInt(599_000_000),     // balance unchanged
 			},
 			expectedErr: fmt.Sprintf(
-				"Subaccount with id %v failed with UpdateResult: NewlyUndercollateralized: failed to apply subaccount updates",
+				"Subaccount with id %v failed with UpdateResult: NewlyBelowMaintenanceMargin: failed to apply subaccount updates",
 				constants.Carl_Num0,
 			),
 		},
@@ -23,6 +23,31 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdListSubaccount())
 	cmd.AddCommand(CmdShowSubaccount())
+	cmd.AddCommand(CmdQueryTotalValueLocked())
+	cmd.AddCommand(CmdQuerySubaccountLiquidationPrices())
+	cmd.AddCommand(CmdQueryBasketShockToLiquidate())
+	cmd.AddCommand(CmdQueryTotalMaintenanceMargin())
+	cmd.AddCommand(CmdQueryPositionNotional())
+	cmd.AddCommand(CmdQueryMarketExponentMigrationImpact())
+	cmd.AddCommand(CmdQueryRiskAtHeight())
+	cmd.AddCommand(CmdQueryHealthDistribution())
+	cmd.AddCommand(CmdQueryMarketNcSensitivity())
+	cmd.AddCommand(CmdQueryWithdrawableCheck())
+	cmd.AddCommand(CmdQueryRealizedPnlOnClose())
+	cmd.AddCommand(CmdQueryProtocolNetDelta())
+	cmd.AddCommand(CmdQueryFundingHistory())
+	cmd.AddCommand(CmdQueryActionToRestoreInitialMargin())
+	cmd.AddCommand(CmdQueryLossBufferToLiquidation())
+	cmd.AddCommand(CmdQueryMarketUnrealizedPnl())
+	cmd.AddCommand(CmdQueryAutoWithdrawableAccounts())
+	cmd.AddCommand(CmdQueryCloseAllCost())
+	cmd.AddCommand(CmdQueryLiquidatableAccounts())
+	cmd.AddCommand(CmdQueryBreakEvenPrice())
+	cmd.AddCommand(CmdQueryFlatteningSize())
+	cmd.AddCommand(CmdQueryLiquidationProceedsEstimate())
+	cmd.AddCommand(CmdQueryMarginDeltaForTierChange())
+	cmd.AddCommand(CmdQueryRiskUnderHistoricalShocks())
+	cmd.AddCommand(CmdQueryMinLiquidatingPriceMove())
 
 	return cmd
 }
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryAutoWithdrawableAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auto-withdrawable-accounts [threshold-quote-quantums]",
+		Short: "shows the subaccounts whose free collateral exceeds a threshold",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argThresholdQuoteQuantums, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryAutoWithdrawableAccountsRequest{
+				ThresholdQuoteQuantums: argThresholdQuoteQuantums,
+			}
+
+			res, err := queryClient.AutoWithdrawableAccounts(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
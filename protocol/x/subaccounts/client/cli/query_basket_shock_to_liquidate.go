@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryBasketShockToLiquidate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "basket-shock-to-liquidate [owner] [number] [perpetual-id:weight-ppm]...",
+		Short: "shows the smallest basket shock that liquidates a subaccount",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argOwner := args[0]
+			argNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+			argWeights := make([]types.BasketWeight, 0, len(args)-2)
+			for _, arg := range args[2:] {
+				perpetualId, weightPpm, found := strings.Cut(arg, ":")
+				if !found {
+					return fmt.Errorf("invalid weight %s, expected perpetual-id:weight-ppm", arg)
+				}
+				weight := types.BasketWeight{}
+				if weight.PerpetualId, err = cast.ToUint32E(perpetualId); err != nil {
+					return err
+				}
+				if weight.WeightPpm, err = cast.ToInt32E(weightPpm); err != nil {
+					return err
+				}
+				argWeights = append(argWeights, weight)
+			}
+
+			params := &types.QueryBasketShockToLiquidateRequest{
+				Owner:   argOwner,
+				Number:  argNumber,
+				Weights: argWeights,
+			}
+
+			res, err := queryClient.BasketShockToLiquidate(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
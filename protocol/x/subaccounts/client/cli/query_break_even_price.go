@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryBreakEvenPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "break-even-price [owner] [number] [perpetual-id]",
+		Short: "shows the price at which a subaccount would break even by closing its position in a perpetual",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argOwner := args[0]
+			argNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+			argPerpetualId, err := cast.ToUint32E(args[2])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryBreakEvenPriceRequest{
+				Owner:       argOwner,
+				Number:      argNumber,
+				PerpetualId: argPerpetualId,
+			}
+
+			res, err := queryClient.BreakEvenPrice(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
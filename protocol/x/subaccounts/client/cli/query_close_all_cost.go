@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryCloseAllCost() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close-all-cost [owner] [number]",
+		Short: "shows the taker fees a subaccount would pay to close all positions",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argOwner := args[0]
+			argNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryCloseAllCostRequest{
+				Owner:  argOwner,
+				Number: argNumber,
+			}
+
+			res, err := queryClient.CloseAllCost(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
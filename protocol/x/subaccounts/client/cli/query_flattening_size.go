@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryFlatteningSize() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "flattening-size [owner] [perpetual-id]",
+		Short: "shows the size of the trade that flattens the net position of an owner in a perpetual",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argOwner := args[0]
+			argPerpetualId, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryFlatteningSizeRequest{
+				Owner:       argOwner,
+				PerpetualId: argPerpetualId,
+			}
+
+			res, err := queryClient.FlatteningSize(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
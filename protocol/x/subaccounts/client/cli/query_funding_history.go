@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryFundingHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funding-history [owner] [number] [perpetual-id] [from-epoch] [to-epoch]",
+		Short: "shows the funding settled for the position of a subaccount in a perpetual",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argOwner := args[0]
+			argNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+			argPerpetualId, err := cast.ToUint32E(args[2])
+			if err != nil {
+				return err
+			}
+			argFromEpoch, err := cast.ToUint32E(args[3])
+			if err != nil {
+				return err
+			}
+			argToEpoch, err := cast.ToUint32E(args[4])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryFundingHistoryRequest{
+				Owner:       argOwner,
+				Number:      argNumber,
+				PerpetualId: argPerpetualId,
+				FromEpoch:   argFromEpoch,
+				ToEpoch:     argToEpoch,
+			}
+
+			res, err := queryClient.FundingHistory(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
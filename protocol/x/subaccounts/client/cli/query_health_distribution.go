@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryHealthDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "health-distribution [bucket-ppm]...",
+		Short: "shows the number of subaccounts in each health bucket",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argBuckets := make([]uint32, 0, len(args))
+			for _, arg := range args {
+				v, err := cast.ToUint32E(arg)
+				if err != nil {
+					return err
+				}
+				argBuckets = append(argBuckets, v)
+			}
+
+			params := &types.QueryHealthDistributionRequest{
+				Buckets: argBuckets,
+			}
+
+			res, err := queryClient.HealthDistribution(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
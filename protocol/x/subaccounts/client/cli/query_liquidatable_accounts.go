@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryLiquidatableAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidatable-accounts",
+		Short: "shows the liquidatable subaccounts in liquidation order",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryLiquidatableAccountsRequest{}
+
+			res, err := queryClient.LiquidatableAccounts(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
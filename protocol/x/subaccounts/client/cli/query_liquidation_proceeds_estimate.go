@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryLiquidationProceedsEstimate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "liquidation-proceeds-estimate [owner] [number] [perpetual-id] [max-liquidation-fee-ppm] " +
+			"[price:quantums]...",
+		Short: "estimates the proceeds of liquidating the position of a subaccount in a perpetual",
+		Args:  cobra.MinimumNArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argOwner := args[0]
+			argNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+			argPerpetualId, err := cast.ToUint32E(args[2])
+			if err != nil {
+				return err
+			}
+			argMaxLiquidationFeePpm, err := cast.ToUint32E(args[3])
+			if err != nil {
+				return err
+			}
+			argDepth := make([]types.LiquidationDepthLevel, 0, len(args)-4)
+			for _, arg := range args[4:] {
+				price, quantums, found := strings.Cut(arg, ":")
+				if !found {
+					return fmt.Errorf("invalid depth level %s, expected price:quantums", arg)
+				}
+				level := types.LiquidationDepthLevel{}
+				if level.Price, err = cast.ToUint64E(price); err != nil {
+					return err
+				}
+				if level.Quantums, err = cast.ToUint64E(quantums); err != nil {
+					return err
+				}
+				argDepth = append(argDepth, level)
+			}
+
+			params := &types.QueryLiquidationProceedsEstimateRequest{
+				Owner:                argOwner,
+				Number:               argNumber,
+				PerpetualId:          argPerpetualId,
+				Depth:                argDepth,
+				MaxLiquidationFeePpm: argMaxLiquidationFeePpm,
+			}
+
+			res, err := queryClient.LiquidationProceedsEstimate(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
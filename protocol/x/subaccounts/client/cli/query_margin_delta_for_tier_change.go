@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryMarginDeltaForTierChange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "margin-delta-for-tier-change [owner] [number] [perpetual-id] [proposed-tier-json]",
+		Short: "shows the change in the margin requirements of a subaccount for a liquidity tier change",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argOwner := args[0]
+			argNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+			argPerpetualId, err := cast.ToUint32E(args[2])
+			if err != nil {
+				return err
+			}
+			var argProposedTier perptypes.LiquidityTier
+			if err := clientCtx.Codec.UnmarshalJSON([]byte(args[3]), &argProposedTier); err != nil {
+				return err
+			}
+
+			params := &types.QueryMarginDeltaForTierChangeRequest{
+				Owner:        argOwner,
+				Number:       argNumber,
+				PerpetualId:  argPerpetualId,
+				ProposedTier: argProposedTier,
+			}
+
+			res, err := queryClient.MarginDeltaForTierChange(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
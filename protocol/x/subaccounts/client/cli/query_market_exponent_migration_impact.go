@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryMarketExponentMigrationImpact() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exponent-migration-impact [market-id] [proposed-exponent]",
+		Short: "shows the impact of migrating the price exponent of a market",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argMarketId, err := cast.ToUint32E(args[0])
+			if err != nil {
+				return err
+			}
+			argProposedExponent, err := cast.ToInt32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryMarketExponentMigrationImpactRequest{
+				MarketId:         argMarketId,
+				ProposedExponent: argProposedExponent,
+			}
+
+			res, err := queryClient.MarketExponentMigrationImpact(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryMarketUnrealizedPnl() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-unrealized-pnl [perpetual-id]",
+		Short: "shows the unrealized PnL of all positions in a perpetual",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argPerpetualId, err := cast.ToUint32E(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryMarketUnrealizedPnlRequest{
+				PerpetualId: argPerpetualId,
+			}
+
+			res, err := queryClient.MarketUnrealizedPnl(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
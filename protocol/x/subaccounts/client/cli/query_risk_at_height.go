@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryRiskAtHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "risk-at-height [owner] [number] [height]",
+		Short: "shows the risk of a subaccount at a past block height",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argOwner := args[0]
+			argNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+			argHeight, err := cast.ToInt64E(args[2])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryRiskAtHeightRequest{
+				Owner:  argOwner,
+				Number: argNumber,
+				Height: argHeight,
+			}
+
+			res, err := queryClient.RiskAtHeight(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryRiskUnderHistoricalShocks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "risk-under-historical-shocks [owner] [number] [perpetual-id:move-ppm]...",
+		Short: "shows the risk of a subaccount under the worst historical moves of its markets",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argOwner := args[0]
+			argNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+			argWorstMoves := make([]types.HistoricalMove, 0, len(args)-2)
+			for _, arg := range args[2:] {
+				perpetualId, movePpm, found := strings.Cut(arg, ":")
+				if !found {
+					return fmt.Errorf("invalid move %s, expected perpetual-id:move-ppm", arg)
+				}
+				move := types.HistoricalMove{}
+				if move.PerpetualId, err = cast.ToUint32E(perpetualId); err != nil {
+					return err
+				}
+				if move.MovePpm, err = cast.ToUint32E(movePpm); err != nil {
+					return err
+				}
+				argWorstMoves = append(argWorstMoves, move)
+			}
+
+			params := &types.QueryRiskUnderHistoricalShocksRequest{
+				Owner:      argOwner,
+				Number:     argNumber,
+				WorstMoves: argWorstMoves,
+			}
+
+			res, err := queryClient.RiskUnderHistoricalShocks(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
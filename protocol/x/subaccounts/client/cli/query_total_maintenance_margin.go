@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cobra"
+)
+
+const flagByPerpetual = "by-perpetual"
+
+func CmdQueryTotalMaintenanceMargin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-maintenance-margin",
+		Short: "shows the total maintenance margin requirement of all subaccounts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			byPerpetual, err := cmd.Flags().GetBool(flagByPerpetual)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryTotalMaintenanceMarginRequest{
+				ByPerpetual: byPerpetual,
+			}
+
+			res, err := queryClient.TotalMaintenanceMargin(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagByPerpetual, false, "Also show the total maintenance margin requirement of each perpetual")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryWithdrawableCheck() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdrawable-check [owner] [number] [quote-quantums]",
+		Short: "shows whether a withdrawal from a subaccount would be allowed",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argOwner := args[0]
+			argNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+			argQuoteQuantums, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryWithdrawableCheckRequest{
+				Owner:         argOwner,
+				Number:        argNumber,
+				QuoteQuantums: argQuoteQuantums,
+			}
+
+			res, err := queryClient.WithdrawableCheck(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
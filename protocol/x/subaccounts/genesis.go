@@ -30,6 +30,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			),
 		)
 	}
+
+	k.SetParams(ctx, genState.Params)
+
+	for _, elem := range genState.MaxLeverages {
+		k.SetMaxLeveragePpm(ctx, elem.SubaccountId, elem.MaxLeveragePpm)
+	}
 }
 
 // ExportGenesis returns the subaccounts module's exported genesis.
@@ -37,6 +43,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 
 	genesis.Subaccounts = k.GetAllSubaccount(ctx)
+	genesis.Params = k.GetParams(ctx)
+	genesis.MaxLeverages = k.GetAllMaxLeverages(ctx)
 
 	return genesis
 }
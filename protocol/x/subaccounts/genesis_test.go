@@ -33,6 +33,24 @@ func TestGenesis(t *testing.T) {
 				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(1_000)),
 			},
 		},
+		Params: types.Params{
+			TradingHalted:                true,
+			LiquidationGracePeriodBlocks: 10,
+			InitialMarginBufferPpm:       100_000,
+			MaxPerpetualPositions:        5,
+			ExternalCollateralEnabled:    true,
+			RiskSweepBudget:              500,
+			DustNcThreshold:              100,
+		},
+		MaxLeverages: []types.SubaccountMaxLeverage{
+			{
+				SubaccountId: types.SubaccountId{
+					Owner:  "foo",
+					Number: uint32(0),
+				},
+				MaxLeveragePpm: 5_000_000,
+			},
+		},
 	}
 
 	ctx, k, _, _, _, _, _, _, _, _, _ := keepertest.SubaccountsKeepers(t, true)
@@ -45,6 +63,8 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(got)           //nolint:staticcheck
 
 	require.ElementsMatch(t, genesisState.Subaccounts, got.Subaccounts)
+	require.Equal(t, genesisState.Params, got.Params)
+	require.ElementsMatch(t, genesisState.MaxLeverages, got.MaxLeverages)
 }
 
 // assertSubaccountUpdateEventsInIndexerBlock checks that the number of subaccount update events
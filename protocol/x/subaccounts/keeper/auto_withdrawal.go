@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetAutoWithdrawableAccounts returns the subaccounts whose free collateral exceeds `thresholdQuoteQuantums`,
+// in the order they are stored, with the amount that can be withdrawn from each as returned by
+// `MaxWithdrawableQuoteQuantums`. The risk of every subaccount is computed after settling funding and
+// subtracting its locked collateral, as when the subaccount is updated, using a single snapshot of the
+// perpetuals, prices and liquidity tiers of all open positions.
+func (k Keeper) GetAutoWithdrawableAccounts(
+	ctx sdk.Context,
+	thresholdQuoteQuantums *big.Int,
+) (
+	withdrawals []types.AutoWithdrawal,
+	err error,
+) {
+	subaccounts := k.GetAllSubaccount(ctx)
+	updates := make([]types.Update, len(subaccounts))
+	for i, subaccount := range subaccounts {
+		updates[i] = types.Update{SubaccountId: *subaccount.Id}
+	}
+	perpInfos, err := k.GetAllRelevantPerpetuals(ctx, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, subaccount := range subaccounts {
+		settledSubaccount, _ := salib.GetSettledSubaccountWithPerpetuals(subaccount, perpInfos)
+		risk, err := salib.GetRiskForSubaccount(settledSubaccount, perpInfos)
+		if err != nil {
+			return nil, err
+		}
+		risk.NC.Sub(risk.NC, new(big.Int).SetUint64(k.GetLockedCollateral(ctx, *subaccount.Id)))
+
+		maxWithdrawable := salib.MaxWithdrawableQuoteQuantums(risk)
+		if maxWithdrawable.Cmp(thresholdQuoteQuantums) > 0 {
+			withdrawals = append(withdrawals, types.AutoWithdrawal{
+				SubaccountId:  *subaccount.Id,
+				QuoteQuantums: maxWithdrawable,
+			})
+		}
+	}
+	return withdrawals, nil
+}
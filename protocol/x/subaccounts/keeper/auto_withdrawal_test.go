@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAutoWithdrawableAccounts(t *testing.T) {
+	btcLong := func(usdcQuantums int64) types.Subaccount {
+		return types.Subaccount{
+			AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(usdcQuantums)),
+			PerpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		thresholdQuoteQuantums *big.Int
+
+		expectedWithdrawals []types.AutoWithdrawal
+	}{
+		"zero threshold": {
+			thresholdQuoteQuantums: big.NewInt(0),
+			expectedWithdrawals: []types.AutoWithdrawal{
+				{SubaccountId: constants.Alice_Num0, QuoteQuantums: big.NewInt(100_000_000_000)},
+				{SubaccountId: constants.Alice_Num1, QuoteQuantums: big.NewInt(5_000_000_000)},
+				{SubaccountId: constants.Bob_Num0, QuoteQuantums: big.NewInt(10_000_000_000)},
+				{SubaccountId: constants.Carl_Num0, QuoteQuantums: big.NewInt(15_000_000_000)},
+			},
+		},
+		"free collateral must exceed the threshold": {
+			thresholdQuoteQuantums: big.NewInt(10_000_000_000),
+			expectedWithdrawals: []types.AutoWithdrawal{
+				{SubaccountId: constants.Alice_Num0, QuoteQuantums: big.NewInt(100_000_000_000)},
+				{SubaccountId: constants.Carl_Num0, QuoteQuantums: big.NewInt(15_000_000_000)},
+			},
+		},
+		"no free collateral exceeds the threshold": {
+			thresholdQuoteQuantums: big.NewInt(100_000_000_000),
+			expectedWithdrawals:    nil,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			subaccounts := map[types.SubaccountId]types.Subaccount{
+				// Free collateral of $100,000 with no positions.
+				constants.Alice_Num0: {
+					AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(100_000_000_000)),
+				},
+				// Free collateral of $50,000 less $45,000 locked by a pending withdrawal.
+				constants.Alice_Num1: {
+					AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(50_000_000_000)),
+				},
+				// NC of $20,000 and IMR of $10,000.
+				constants.Bob_Num0: btcLong(-30_000_000_000),
+				// NC of $25,000 and IMR of $10,000.
+				constants.Carl_Num0: btcLong(-25_000_000_000),
+				// NC of $4,000 is below the IMR of $10,000.
+				constants.Dave_Num0: btcLong(-46_000_000_000),
+			}
+			for id, subaccount := range subaccounts {
+				subaccount.Id = &id
+				keeper.SetSubaccount(ctx, subaccount)
+			}
+			keeper.SetLockedCollateral(ctx, constants.Alice_Num1, 45_000_000_000)
+
+			withdrawals, err := keeper.GetAutoWithdrawableAccounts(ctx, tc.thresholdQuoteQuantums)
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.expectedWithdrawals, withdrawals)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetBreakEvenPrice returns the price in quote quantums per base quantum at which the subaccount would
+// realize zero PnL by closing its position in the perpetual, net of the funding accrued by the position
+// since it was last settled. This is the average entry price of the position adjusted by the accrued
+// funding per base quantum. Fees are not included.
+//
+// Returns an error if the subaccount has no position in the perpetual or the cost basis of the position
+// is unknown.
+func (k Keeper) GetBreakEvenPrice(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	perpetualId uint32,
+) (
+	price *big.Rat,
+	err error,
+) {
+	subaccount := k.GetSubaccount(ctx, subaccountId)
+	position, exists := subaccount.GetPerpetualPositionForId(perpetualId)
+	if !exists {
+		return nil, errorsmod.Wrapf(
+			types.ErrPerpPositionNotFound,
+			"subaccount id: %v, perpetual id: %d",
+			subaccountId,
+			perpetualId,
+		)
+	}
+
+	perpInfos, err := k.GetAllRelevantPerpetuals(ctx, []types.Update{{SubaccountId: subaccountId}})
+	if err != nil {
+		return nil, err
+	}
+	_, fundingPayments := salib.GetSettledSubaccountWithPerpetuals(subaccount, perpInfos)
+	fundingPaid := new(big.Int)
+	if payment, ok := fundingPayments[perpetualId]; ok {
+		fundingPaid = payment.BigInt()
+	}
+
+	price, ok := salib.BreakEvenPrice(position, fundingPaid)
+	if !ok {
+		return nil, errorsmod.Wrapf(
+			types.ErrPerpPositionCostBasisUnknown,
+			"subaccount id: %v, perpetual id: %d",
+			subaccountId,
+			perpetualId,
+		)
+	}
+	return price, nil
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBreakEvenPrice(t *testing.T) {
+	// The funding index of BTC is zero, so a position last settled at a funding index of -1,000,000 has
+	// accrued 1 quote quantum of funding per base quantum since, paid by longs to shorts.
+	tests := map[string]struct {
+		perpetualPositions []*types.PerpetualPosition
+
+		expectedPrice *big.Rat
+		expectedErr   error
+	}{
+		"long without accrued funding breaks even at its entry price": {
+			// 1 BTC entered at $40,000.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPositionWithCostBasis(
+					0,
+					big.NewInt(100_000_000),
+					big.NewInt(0),
+					big.NewInt(0),
+					big.NewInt(40_000_000_000),
+				),
+			},
+			expectedPrice: big.NewRat(400, 1),
+		},
+		"long that paid funding breaks even above its entry price": {
+			// 1 BTC entered at $40,000 that paid $100 of funding breaks even at $40,100.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPositionWithCostBasis(
+					0,
+					big.NewInt(100_000_000),
+					big.NewInt(-1_000_000),
+					big.NewInt(0),
+					big.NewInt(40_000_000_000),
+				),
+			},
+			expectedPrice: big.NewRat(401, 1),
+		},
+		"short that received funding breaks even above its entry price": {
+			// 1 BTC shorted at $40,000 that received $100 of funding breaks even at $40,100.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPositionWithCostBasis(
+					0,
+					big.NewInt(-100_000_000),
+					big.NewInt(-1_000_000),
+					big.NewInt(0),
+					big.NewInt(-40_000_000_000),
+				),
+			},
+			expectedPrice: big.NewRat(401, 1),
+		},
+		"unknown cost basis": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+			expectedErr: types.ErrPerpPositionCostBasisUnknown,
+		},
+		"no position": {
+			expectedErr: types.ErrPerpPositionNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:                 &constants.Alice_Num0,
+				AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(100_000_000_000)),
+				PerpetualPositions: tc.perpetualPositions,
+			})
+
+			price, err := keeper.GetBreakEvenPrice(ctx, constants.Alice_Num0, p.Params.Id)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Zero(t, tc.expectedPrice.Cmp(price), "expected %s, got %s", tc.expectedPrice, price)
+		})
+	}
+}
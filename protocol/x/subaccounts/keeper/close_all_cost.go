@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetCloseAllCost returns the total taker fees in quote quantums the subaccount would pay to fully close
+// all of its perpetual positions at their market prices, and its net collateral after closing them and
+// paying the fees. The fee of each position is its notional at the market price multiplied by the taker
+// fee rate of the owner of the subaccount, rounded up as when a fill is processed.
+//
+// Closing positions at their market prices realizes their value without changing the net collateral, so
+// the net collateral after the close is the current net collateral, with unsettled funding settled, minus
+// the fees. Returns `ErrFeeTiersKeeperNotSet` if the fee tiers keeper was not set.
+func (k Keeper) GetCloseAllCost(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+) (
+	fees *big.Int,
+	postCloseNC *big.Int,
+	err error,
+) {
+	if k.feeTiersKeeper == nil {
+		return nil, nil, types.ErrFeeTiersKeeperNotSet
+	}
+
+	inputs, err := k.GetRiskInputs(ctx, subaccountId)
+	if err != nil {
+		return nil, nil, err
+	}
+	risk, err := salib.GetRiskForSubaccount(inputs.SettledSubaccount, inputs.PerpInfos)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	takerFeePpm := lib.BigI(k.feeTiersKeeper.GetPerpetualFeePpm(ctx, subaccountId.Owner, true))
+	fees = new(big.Int)
+	for _, pos := range inputs.SettledSubaccount.PerpetualPositions {
+		notional, err := salib.PositionNotional(pos.GetBigQuantums(), inputs.PerpInfos.MustGet(pos.PerpetualId))
+		if err != nil {
+			return nil, nil, err
+		}
+		fees.Add(fees, lib.BigMulPpm(notional, takerFeePpm, true))
+	}
+	return fees, risk.NC.Sub(risk.NC, fees), nil
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+// takerFees is a fee tiers keeper with a maker fee of zero and a fixed taker fee for every account.
+type takerFees int32
+
+func (f takerFees) GetPerpetualFeePpm(ctx sdk.Context, address string, isTaker bool) int32 {
+	if isTaker {
+		return int32(f)
+	}
+	return 0
+}
+
+func TestGetCloseAllCost(t *testing.T) {
+	tests := map[string]struct {
+		perpetualPositions []*types.PerpetualPosition
+		takerFeePpm        int32
+
+		expectedFees        *big.Int
+		expectedPostCloseNC *big.Int
+	}{
+		"no positions": {
+			takerFeePpm:         500,
+			expectedFees:        big.NewInt(0),
+			expectedPostCloseNC: big.NewInt(-10_000_000_000),
+		},
+		"long and short positions": {
+			// Closing 1 BTC worth $50,000 and 10 ETH worth $30,000 at 5 bps costs $40.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(-10_000_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+			takerFeePpm:         500,
+			expectedFees:        big.NewInt(40_000_000),
+			expectedPostCloseNC: big.NewInt(9_960_000_000),
+		},
+		"fees are rounded up": {
+			// Closing 1 BTC worth $50,000 at 3 bps costs $15, and the fee of closing 1 quantum of ETH worth 3
+			// quote quantums rounds up to 1 quote quantum.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(-1), big.NewInt(0), big.NewInt(0)),
+			},
+			takerFeePpm:         300,
+			expectedFees:        big.NewInt(15_000_001),
+			expectedPostCloseNC: big.NewInt(39_984_999_996),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			for _, p := range []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				constants.EthUsd_20PercentInitial_10PercentMaintenance,
+			} {
+				_, err := perpetualsKeeper.CreatePerpetual(
+					ctx,
+					p.Params.Id,
+					p.Params.Ticker,
+					p.Params.MarketId,
+					p.Params.AtomicResolution,
+					p.Params.DefaultFundingPpm,
+					p.Params.LiquidityTier,
+					p.Params.MarketType,
+				)
+				require.NoError(t, err)
+			}
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:                 &constants.Alice_Num0,
+				AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(-10_000_000_000)), // -$10,000
+				PerpetualPositions: tc.perpetualPositions,
+			})
+
+			_, _, err := keeper.GetCloseAllCost(ctx, constants.Alice_Num0)
+			require.ErrorIs(t, err, types.ErrFeeTiersKeeperNotSet)
+
+			keeper.SetFeeTiersKeeper(takerFees(tc.takerFeePpm))
+			fees, postCloseNC, err := keeper.GetCloseAllCost(ctx, constants.Alice_Num0)
+			require.NoError(t, err)
+			require.Equal(t, 0, tc.expectedFees.Cmp(fees), "expected %s, got %s", tc.expectedFees, fees)
+			require.Equal(
+				t,
+				0,
+				tc.expectedPostCloseNC.Cmp(postCloseNC),
+				"expected %s, got %s",
+				tc.expectedPostCloseNC,
+				postCloseNC,
+			)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetDustNCThreshold returns the threshold, in quote quantums, below which the absolute net collateral
+// of a subaccount with no perpetual positions is treated as dust. A threshold of zero, the default,
+// treats no subaccount as dust.
+func (k Keeper) GetDustNCThreshold(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get([]byte(types.DustNCThresholdKey))
+	if b == nil {
+		return 0
+	}
+
+	threshold := gogotypes.UInt64Value{}
+	k.cdc.MustUnmarshal(b, &threshold)
+	return threshold.Value
+}
+
+// SetDustNCThreshold sets the threshold, in quote quantums, below which the absolute net collateral of
+// a subaccount with no perpetual positions is treated as dust.
+func (k Keeper) SetDustNCThreshold(ctx sdk.Context, threshold uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if threshold == 0 {
+		store.Delete([]byte(types.DustNCThresholdKey))
+		return
+	}
+	store.Set(
+		[]byte(types.DustNCThresholdKey),
+		k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: threshold}),
+	)
+}
+
+// IsDustSubaccount returns true if the subaccount has no perpetual positions, holds only USDC, and the
+// absolute value of its net collateral is below the dust threshold. Dust subaccounts can be closed by
+// `SweepDustSubaccounts`. Subaccounts with open perpetual positions are never dust.
+func (k Keeper) IsDustSubaccount(ctx sdk.Context, subaccount types.Subaccount) bool {
+	return isDustSubaccount(subaccount, k.GetDustNCThreshold(ctx))
+}
+
+func isDustSubaccount(subaccount types.Subaccount, threshold uint64) bool {
+	if threshold == 0 || len(subaccount.PerpetualPositions) != 0 {
+		return false
+	}
+	if len(subaccount.AssetPositions) != 1 || subaccount.AssetPositions[0].AssetId != assettypes.AssetUsdc.Id {
+		return false
+	}
+
+	risk, err := salib.GetRiskForSubaccount(subaccount, perptypes.PerpInfos{})
+	if err != nil {
+		return false
+	}
+	return risk.NC.CmpAbs(new(big.Int).SetUint64(threshold)) < 0
+}
+
+// SweepDustSubaccounts closes every dust subaccount by moving its USDC balance to or from the cross
+// insurance fund, which removes the subaccount from state. Positive balances are withdrawn to the
+// insurance fund and negative balances are covered by a deposit from it. Returns the ids of the closed
+// subaccounts.
+//
+// Each subaccount is closed with a regular withdrawal or deposit, so a subaccount is skipped if the
+// transfer is not allowed, for example while withdrawals are blocked or if the insurance fund cannot
+// cover its negative balance.
+//
+// This is called once per block in `EndBlocker`. Each call only iterates over the subaccounts within the
+// risk sweep budget, and the next call resumes from the first subaccount that was not iterated over.
+func (k Keeper) SweepDustSubaccounts(ctx sdk.Context) (swept []types.SubaccountId) {
+	threshold := k.GetDustNCThreshold(ctx)
+	if threshold == 0 {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	var dust []types.Subaccount
+	nextKey := k.iterateSubaccountsWithinRiskSweepBudget(
+		ctx,
+		store.Get([]byte(types.DustSweepNextKeyKey)),
+		func(subaccount types.Subaccount) {
+			if isDustSubaccount(subaccount, threshold) {
+				dust = append(dust, subaccount)
+			}
+		},
+	)
+	if nextKey == nil {
+		store.Delete([]byte(types.DustSweepNextKeyKey))
+	} else {
+		store.Set([]byte(types.DustSweepNextKeyKey), nextKey)
+	}
+
+	for _, subaccount := range dust {
+		cacheCtx, writeCache := ctx.CacheContext()
+		quantums := subaccount.GetUsdcPosition()
+		var err error
+		if quantums.Sign() > 0 {
+			err = k.WithdrawFundsFromSubaccountToAccount(
+				cacheCtx,
+				*subaccount.Id,
+				perptypes.InsuranceFundModuleAddress,
+				assettypes.AssetUsdc.Id,
+				quantums,
+			)
+		} else {
+			err = k.DepositFundsFromAccountToSubaccount(
+				cacheCtx,
+				perptypes.InsuranceFundModuleAddress,
+				*subaccount.Id,
+				assettypes.AssetUsdc.Id,
+				new(big.Int).Neg(quantums),
+			)
+		}
+
+		// The subaccount is only closed if the transfer left it without positions, which is not the case
+		// if the quantums were rounded when converted to coins.
+		if err != nil || len(k.GetSubaccount(cacheCtx, *subaccount.Id).AssetPositions) != 0 {
+			continue
+		}
+		writeCache()
+		swept = append(swept, *subaccount.Id)
+	}
+	return swept
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/bank"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSweepDustSubaccounts(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, bankKeeper, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+		t,
+		true,
+	)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+	p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+	_, err := perpetualsKeeper.CreatePerpetual(
+		ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.MarketType,
+	)
+	require.NoError(t, err)
+
+	// Fund the collateral pool and the insurance fund.
+	require.NoError(t, bank_testutil.FundAccount(
+		ctx,
+		types.ModuleAddress,
+		sdk.Coins{sdk.NewCoin(constants.Usdc.Denom, sdkmath.NewInt(2_000))},
+		*bankKeeper,
+	))
+	require.NoError(t, bank_testutil.FundAccount(
+		ctx,
+		perptypes.InsuranceFundModuleAddress,
+		sdk.Coins{sdk.NewCoin(constants.Usdc.Denom, sdkmath.NewInt(1_000))},
+		*bankKeeper,
+	))
+
+	positiveDust := types.Subaccount{
+		Id:             &constants.Alice_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(50)),
+	}
+	negativeDust := types.Subaccount{
+		Id:             &constants.Alice_Num1,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-30)),
+	}
+	aboveThreshold := types.Subaccount{
+		Id:             &constants.Bob_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(1_000)),
+	}
+	// The quote balance offsets the value of the position, but subaccounts with open positions are never dust.
+	openPosition := types.Subaccount{
+		Id:             &constants.Carl_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-49_999_999_000)),
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(0, big.NewInt(1_000_000), big.NewInt(0), big.NewInt(0)),
+		},
+	}
+	for _, subaccount := range []types.Subaccount{positiveDust, negativeDust, aboveThreshold, openPosition} {
+		keeper.SetSubaccount(ctx, subaccount)
+	}
+
+	// No subaccount is dust while the threshold is zero.
+	require.Equal(t, uint64(0), keeper.GetDustNCThreshold(ctx))
+	require.False(t, keeper.IsDustSubaccount(ctx, positiveDust))
+	require.Empty(t, keeper.SweepDustSubaccounts(ctx))
+	require.Len(t, keeper.GetAllSubaccount(ctx), 4)
+
+	keeper.SetDustNCThreshold(ctx, 100)
+	require.Equal(t, uint64(100), keeper.GetDustNCThreshold(ctx))
+	require.True(t, keeper.IsDustSubaccount(ctx, positiveDust))
+	require.True(t, keeper.IsDustSubaccount(ctx, negativeDust))
+	require.False(t, keeper.IsDustSubaccount(ctx, aboveThreshold))
+	require.False(t, keeper.IsDustSubaccount(ctx, openPosition))
+
+	require.Equal(
+		t,
+		[]types.SubaccountId{constants.Alice_Num0, constants.Alice_Num1},
+		keeper.SweepDustSubaccounts(ctx),
+	)
+	require.Equal(t, []types.Subaccount{aboveThreshold, openPosition}, keeper.GetAllSubaccount(ctx))
+
+	// The positive dust is moved to the insurance fund, which covers the negative dust.
+	require.Equal(t, big.NewInt(1_000+50-30), keeper.GetCrossInsuranceFundBalance(ctx))
+	require.Equal(
+		t,
+		sdkmath.NewInt(2_000-50+30),
+		bankKeeper.GetBalance(ctx, types.ModuleAddress, constants.Usdc.Denom).Amount,
+	)
+
+	// Each sweep only iterates over the subaccounts within the risk sweep budget and resumes from where
+	// the previous sweep stopped.
+	moreDust := types.Subaccount{
+		Id:             &constants.Dave_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(20)),
+	}
+	keeper.SetSubaccount(ctx, moreDust)
+	keeper.SetRiskSweepBudget(ctx, 1)
+	require.Empty(t, keeper.SweepDustSubaccounts(ctx))
+	require.Empty(t, keeper.SweepDustSubaccounts(ctx))
+	require.Equal(t, []types.SubaccountId{constants.Dave_Num0}, keeper.SweepDustSubaccounts(ctx))
+	require.Equal(t, []types.Subaccount{aboveThreshold, openPosition}, keeper.GetAllSubaccount(ctx))
+
+	// Turning the threshold off again stops treating subaccounts as dust.
+	keeper.SetDustNCThreshold(ctx, 0)
+	require.Equal(t, uint64(0), keeper.GetDustNCThreshold(ctx))
+	require.False(t, keeper.IsDustSubaccount(ctx, positiveDust))
+}
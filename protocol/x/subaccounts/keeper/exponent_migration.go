@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetMarketExponentMigrationImpact returns the aggregate notional in quote quantums of all positions in
+// perpetuals of the market with id `marketId` under the current price exponent of the market, and under
+// `proposedExponent` with the same integer price. It also returns the subaccounts with positions in these
+// perpetuals that are not liquidatable under the current exponent but would be under `proposedExponent`.
+// This is read-only and is intended to be used before a price exponent migration is proposed.
+//
+// Subaccounts whose risk cannot be computed, e.g. subaccounts holding other assets, are skipped.
+func (k Keeper) GetMarketExponentMigrationImpact(
+	ctx sdk.Context,
+	marketId uint32,
+	proposedExponent int32,
+) (
+	currentNotional *big.Int,
+	proposedNotional *big.Int,
+	newlyLiquidatable []types.SubaccountId,
+	err error,
+) {
+	subaccounts := k.GetAllSubaccount(ctx)
+	updates := make([]types.Update, len(subaccounts))
+	for i, subaccount := range subaccounts {
+		updates[i] = types.Update{SubaccountId: *subaccount.Id}
+	}
+	perpInfos, err := k.GetAllRelevantPerpetuals(ctx, updates)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	proposedPerpInfos := make(perptypes.PerpInfos, len(perpInfos))
+	for id, info := range perpInfos {
+		if info.Price.Id == marketId {
+			info.Price.Exponent = proposedExponent
+		}
+		proposedPerpInfos[id] = info
+	}
+
+	currentNotional = new(big.Int)
+	proposedNotional = new(big.Int)
+	newlyLiquidatable = make([]types.SubaccountId, 0)
+	for _, subaccount := range subaccounts {
+		affected := false
+		settledSubaccount, _ := salib.GetSettledSubaccountWithPerpetuals(subaccount, perpInfos)
+		for _, position := range settledSubaccount.PerpetualPositions {
+			if perpInfos.MustGet(position.PerpetualId).Price.Id != marketId {
+				continue
+			}
+			affected = true
+
+			notional, err := salib.PositionNotional(position.GetBigQuantums(), perpInfos.MustGet(position.PerpetualId))
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			currentNotional.Add(currentNotional, notional)
+			notional, err = salib.PositionNotional(
+				position.GetBigQuantums(),
+				proposedPerpInfos.MustGet(position.PerpetualId),
+			)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			proposedNotional.Add(proposedNotional, notional)
+		}
+		if !affected {
+			continue
+		}
+
+		risk, err := salib.GetRiskForSubaccount(settledSubaccount, perpInfos)
+		if err != nil {
+			continue
+		}
+		proposedRisk, err := salib.GetRiskForSubaccount(settledSubaccount, proposedPerpInfos)
+		if err != nil {
+			continue
+		}
+		if !risk.IsLiquidatable() && proposedRisk.IsLiquidatable() {
+			newlyLiquidatable = append(newlyLiquidatable, *subaccount.Id)
+		}
+	}
+	return currentNotional, proposedNotional, newlyLiquidatable, nil
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMarketExponentMigrationImpact(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+		t,
+		true,
+	)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+	p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+	_, err := perpetualsKeeper.CreatePerpetual(
+		ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.MarketType,
+	)
+	require.NoError(t, err)
+
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:             &constants.Alice_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(60_000_000_000)), // $60,000
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(0, big.NewInt(-100_000_000), big.NewInt(0), big.NewInt(0)), // -1 BTC
+		},
+	})
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:             &constants.Bob_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-44_000_000_000)), // -$44,000
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)), // 1 BTC
+		},
+	})
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:             &constants.Carl_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(1_000_000_000)), // $1,000
+	})
+
+	// Keeping the current exponent of the BTC market changes nothing.
+	currentNotional, proposedNotional, newlyLiquidatable, err := keeper.GetMarketExponentMigrationImpact(
+		ctx,
+		p.Params.MarketId,
+		constants.BtcUsdExponent,
+	)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100_000_000_000), currentNotional) // 2 BTC at $50,000
+	require.Equal(t, big.NewInt(100_000_000_000), proposedNotional)
+	require.Empty(t, newlyLiquidatable)
+
+	// Raising the exponent by one multiplies the BTC price by 10 to $500,000, which makes only the short
+	// liquidatable: NC = $60,000 - $500,000 < MMR = $50,000.
+	currentNotional, proposedNotional, newlyLiquidatable, err = keeper.GetMarketExponentMigrationImpact(
+		ctx,
+		p.Params.MarketId,
+		constants.BtcUsdExponent+1,
+	)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100_000_000_000), currentNotional)
+	require.Equal(t, big.NewInt(1_000_000_000_000), proposedNotional)
+	require.Equal(t, []types.SubaccountId{constants.Alice_Num0}, newlyLiquidatable)
+
+	// Lowering the exponent by one divides the BTC price by 10 to $5,000, which makes only the long
+	// liquidatable: NC = $5,000 - $44,000 < MMR = $500.
+	_, proposedNotional, newlyLiquidatable, err = keeper.GetMarketExponentMigrationImpact(
+		ctx,
+		p.Params.MarketId,
+		constants.BtcUsdExponent-1,
+	)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(10_000_000_000), proposedNotional)
+	require.Equal(t, []types.SubaccountId{constants.Bob_Num0}, newlyLiquidatable)
+
+	// Positions in perpetuals of other markets are unaffected.
+	currentNotional, proposedNotional, newlyLiquidatable, err = keeper.GetMarketExponentMigrationImpact(
+		ctx,
+		constants.EthUsd_20PercentInitial_10PercentMaintenance.Params.MarketId,
+		constants.EthUsdExponent+1,
+	)
+	require.NoError(t, err)
+	require.Zero(t, currentNotional.Sign())
+	require.Zero(t, proposedNotional.Sign())
+	require.Empty(t, newlyLiquidatable)
+}
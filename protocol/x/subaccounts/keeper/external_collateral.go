@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetExternalCollateralEnabled returns whether collateral provided by the collateral sources of the keeper,
+// such as staked tokens, counts towards the net collateral returned by `GetRiskForSubaccount`. External
+// collateral is disabled by default.
+func (k Keeper) GetExternalCollateralEnabled(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get([]byte(types.ExternalCollateralEnabledKey))
+	if b == nil {
+		return false
+	}
+
+	enabled := gogotypes.BoolValue{}
+	k.cdc.MustUnmarshal(b, &enabled)
+	return enabled.Value
+}
+
+// SetExternalCollateralEnabled enables or disables external collateral.
+func (k Keeper) SetExternalCollateralEnabled(ctx sdk.Context, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !enabled {
+		store.Delete([]byte(types.ExternalCollateralEnabledKey))
+		return
+	}
+	store.Set(
+		[]byte(types.ExternalCollateralEnabledKey),
+		k.cdc.MustMarshal(&gogotypes.BoolValue{Value: enabled}),
+	)
+}
+
+// GetExternalCollateral returns the total value in quote quantums of the collateral of the subaccount
+// provided by the collateral sources of the keeper, or zero if external collateral is disabled. Collateral
+// shared by all subaccounts of an owner, such as the tokens staked by the owner, only counts towards the
+// owner's subaccount number 0.
+func (k Keeper) GetExternalCollateral(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+) (
+	collateral *big.Int,
+	err error,
+) {
+	if !k.GetExternalCollateralEnabled(ctx) {
+		return new(big.Int), nil
+	}
+	return k.getCollateralFromSources(ctx, subaccountId)
+}
+
+// getCollateralFromSources returns the total value in quote quantums of the collateral of the subaccount
+// provided by the collateral sources of the keeper, regardless of whether external collateral is enabled.
+func (k Keeper) getCollateralFromSources(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+) (
+	collateral *big.Int,
+	err error,
+) {
+	collateral = new(big.Int)
+	for _, source := range k.collateralSources {
+		value, err := source.GetCollateral(ctx, subaccountId)
+		if err != nil {
+			return nil, err
+		}
+		collateral.Add(collateral, value)
+	}
+	return collateral, nil
+}
@@ -0,0 +1,202 @@
+package keeper_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	rewardstypes "github.com/dydxprotocol/v4-chain/protocol/x/rewards/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+// bondedTokens is a staking keeper that returns a fixed amount of bonded tokens for every account.
+type bondedTokens int64
+
+func (b bondedTokens) GetDelegatorBonded(ctx context.Context, delegator sdk.AccAddress) (math.Int, error) {
+	return math.NewInt(int64(b)), nil
+}
+
+// rewardsParams is a rewards keeper that returns fixed params.
+type rewardsParams rewardstypes.Params
+
+func (p rewardsParams) GetParams(ctx sdk.Context) rewardstypes.Params {
+	return rewardstypes.Params(p)
+}
+
+func TestSetExternalCollateralEnabled(t *testing.T) {
+	ctx, keeper, _, _, _, _, _, _, _, _, _ := keepertest.SubaccountsKeepers(t, true)
+	require.False(t, keeper.GetExternalCollateralEnabled(ctx))
+
+	keeper.SetExternalCollateralEnabled(ctx, true)
+	require.True(t, keeper.GetExternalCollateralEnabled(ctx))
+
+	keeper.SetExternalCollateralEnabled(ctx, false)
+	require.False(t, keeper.GetExternalCollateralEnabled(ctx))
+}
+
+func TestGetRiskForSubaccount_StakedTokenCollateral(t *testing.T) {
+	tests := map[string]struct {
+		enabled      bool
+		subaccountId types.SubaccountId
+
+		expectedNC *big.Int
+	}{
+		"disabled": {
+			enabled:      false,
+			subaccountId: constants.Alice_Num0,
+			expectedNC:   big.NewInt(6_000_000_000),
+		},
+		"enabled": {
+			// 10 staked tokens at $3,000 with a 50% haircut add $15,000.
+			enabled:      true,
+			subaccountId: constants.Alice_Num0,
+			expectedNC:   big.NewInt(21_000_000_000),
+		},
+		"enabled for a subaccount other than number 0": {
+			enabled:      true,
+			subaccountId: constants.Alice_Num1,
+			expectedNC:   big.NewInt(6_000_000_000),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, k, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			// The staking token is priced by the ETH market at $3,000.
+			k.SetCollateralSources(
+				keeper.NewStakedTokenCollateralSource(
+					bondedTokens(10_000_000_000),
+					pricesKeeper,
+					rewardsParams{MarketId: 1, DenomExponent: -9},
+					500_000,
+				),
+			)
+			k.SetExternalCollateralEnabled(ctx, tc.enabled)
+
+			// 1 BTC at $50,000 with NC = $50,000 - $44,000.
+			k.SetSubaccount(ctx, types.Subaccount{
+				Id:             &tc.subaccountId,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-44_000_000_000)),
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+				},
+			})
+
+			for _, includeUnsettledFunding := range []bool{true, false} {
+				risk, err := k.GetRiskForSubaccount(ctx, tc.subaccountId, includeUnsettledFunding)
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					margin.Risk{
+						NC:  tc.expectedNC,
+						IMR: big.NewInt(10_000_000_000),
+						MMR: big.NewInt(5_000_000_000),
+					},
+					risk,
+				)
+			}
+		})
+	}
+}
+
+func TestCanUpdateSubaccounts_StakedTokenCollateral(t *testing.T) {
+	tests := map[string]struct {
+		enabled bool
+
+		expectedSuccess bool
+	}{
+		"disabled": {
+			enabled:         false,
+			expectedSuccess: false,
+		},
+		"enabled": {
+			enabled:         true,
+			expectedSuccess: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, k, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			// The staking token is priced by the ETH market at $3,000.
+			k.SetCollateralSources(
+				keeper.NewStakedTokenCollateralSource(
+					bondedTokens(10_000_000_000),
+					pricesKeeper,
+					rewardsParams{MarketId: 1, DenomExponent: -9},
+					500_000,
+				),
+			)
+			k.SetExternalCollateralEnabled(ctx, tc.enabled)
+
+			// 1 BTC at $50,000 with NC = $50,000 - $44,000 and an initial margin requirement of $10,000.
+			k.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-44_000_000_000)),
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+				},
+			})
+
+			// Withdrawing $1,000 is only collateralized with the $15,000 of staked token collateral.
+			success, _, err := k.CanUpdateSubaccounts(
+				ctx,
+				[]types.Update{
+					{
+						SubaccountId: constants.Alice_Num0,
+						AssetUpdates: testutil.CreateUsdcAssetUpdates(big.NewInt(-1_000_000_000)),
+					},
+				},
+				types.Withdrawal,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedSuccess, success)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetFlatteningSize returns the signed base quantums to trade in the perpetual for the net position of all
+// subaccounts of the owner in the perpetual to become zero, along with the absolute notional of the trade
+// at the market price in quote quantums. The result is negative if the owner is net long, and zero if the
+// owner is already delta-neutral in the perpetual.
+func (k Keeper) GetFlatteningSize(
+	ctx sdk.Context,
+	owner string,
+	perpetualId uint32,
+) (
+	quantums *big.Int,
+	absNotional *big.Int,
+	err error,
+) {
+	perpInfos, err := k.getPerpInfos(ctx, map[uint32]struct{}{perpetualId: {}})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	quantums = new(big.Int)
+	k.ForEachSubaccountOfOwner(ctx, owner, func(subaccount types.Subaccount) (finished bool) {
+		if position, exists := subaccount.GetPerpetualPositionForId(perpetualId); exists {
+			quantums.Sub(quantums, position.GetBigQuantums())
+		}
+		return false
+	})
+
+	absNotional, err = salib.PositionNotional(quantums, perpInfos.MustGet(perpetualId))
+	if err != nil {
+		return nil, nil, err
+	}
+	return quantums, absNotional, nil
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFlatteningSize(t *testing.T) {
+	tests := map[string]struct {
+		subaccounts []types.Subaccount
+		perpetualId uint32
+
+		expectedQuantums    *big.Int
+		expectedAbsNotional *big.Int
+	}{
+		"owner net long across two subaccounts": {
+			// Alice is long 1 BTC and short 0.25 BTC, so she sells 0.75 BTC worth $37,500 to flatten. The
+			// positions of Bob and Alice's ETH position are not included.
+			subaccounts: []types.Subaccount{
+				{
+					Id: &constants.Alice_Num0,
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+						testutil.CreateSinglePerpetualPosition(1, big.NewInt(1_000_000_000), big.NewInt(0), big.NewInt(0)),
+					},
+				},
+				{
+					Id: &constants.Alice_Num1,
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(0, big.NewInt(-25_000_000), big.NewInt(0), big.NewInt(0)),
+					},
+				},
+				{
+					Id: &constants.Bob_Num0,
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+					},
+				},
+			},
+			perpetualId:         0,
+			expectedQuantums:    big.NewInt(-75_000_000),
+			expectedAbsNotional: big.NewInt(37_500_000_000),
+		},
+		"owner net short": {
+			subaccounts: []types.Subaccount{
+				{
+					Id: &constants.Alice_Num0,
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(1, big.NewInt(-2_000_000_000), big.NewInt(0), big.NewInt(0)),
+					},
+				},
+			},
+			perpetualId:         1,
+			expectedQuantums:    big.NewInt(2_000_000_000),
+			expectedAbsNotional: big.NewInt(6_000_000_000),
+		},
+		"owner already flat": {
+			subaccounts: []types.Subaccount{
+				{
+					Id: &constants.Alice_Num0,
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(0, big.NewInt(50_000_000), big.NewInt(0), big.NewInt(0)),
+					},
+				},
+				{
+					Id: &constants.Alice_Num1,
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(0, big.NewInt(-50_000_000), big.NewInt(0), big.NewInt(0)),
+					},
+				},
+			},
+			perpetualId:         0,
+			expectedQuantums:    big.NewInt(0),
+			expectedAbsNotional: big.NewInt(0),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			for _, p := range []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				constants.EthUsd_20PercentInitial_10PercentMaintenance,
+			} {
+				_, err := perpetualsKeeper.CreatePerpetual(
+					ctx,
+					p.Params.Id,
+					p.Params.Ticker,
+					p.Params.MarketId,
+					p.Params.AtomicResolution,
+					p.Params.DefaultFundingPpm,
+					p.Params.LiquidityTier,
+					p.Params.MarketType,
+				)
+				require.NoError(t, err)
+			}
+			for _, subaccount := range tc.subaccounts {
+				keeper.SetSubaccount(ctx, subaccount)
+			}
+
+			quantums, absNotional, err := keeper.GetFlatteningSize(ctx, constants.AliceAccAddress.String(), tc.perpetualId)
+			require.NoError(t, err)
+			require.Equal(t, 0, tc.expectedQuantums.Cmp(quantums), "expected %v, got %v", tc.expectedQuantums, quantums)
+			require.Equal(
+				t,
+				0,
+				tc.expectedAbsNotional.Cmp(absNotional),
+				"expected %v, got %v",
+				tc.expectedAbsNotional,
+				absNotional,
+			)
+		})
+	}
+}
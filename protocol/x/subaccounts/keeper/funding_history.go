@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ante_types "github.com/dydxprotocol/v4-chain/protocol/app/ante/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// fundingHistoryKey returns the store key of the funding history entry of the position of the subaccount in
+// the perpetual for the ring buffer slot of `epoch`. The fixed-length fields precede the subaccount state
+// key so that keys of different subaccounts cannot collide.
+func fundingHistoryKey(subaccountId types.SubaccountId, perpetualId uint32, epoch uint32) []byte {
+	key := lib.Uint32ToKey(perpetualId)
+	key = append(key, lib.Uint32ToKey(epoch%types.FundingHistoryRetentionEpochs)...)
+	return append(key, subaccountId.ToStateKey()...)
+}
+
+// getFundingHistoryEntry returns the funding history entry of the position of the subaccount in the perpetual
+// for `epoch`. Returns false if no funding was settled during `epoch` or the entry is no longer retained.
+func (k Keeper) getFundingHistoryEntry(
+	store prefix.Store,
+	subaccountId types.SubaccountId,
+	perpetualId uint32,
+	epoch uint32,
+) (
+	entry types.FundingHistoryEntry,
+	found bool,
+) {
+	bz := store.Get(fundingHistoryKey(subaccountId, perpetualId, epoch))
+	if bz == nil {
+		return entry, false
+	}
+	if err := entry.UnmarshalBinary(bz); err != nil {
+		panic(err)
+	}
+	if entry.Epoch != epoch {
+		return types.FundingHistoryEntry{}, false
+	}
+	return entry, true
+}
+
+// recordSettledFunding adds `fundingPaid` to the funding settled for the position of the subaccount in the
+// perpetual during the current funding-tick epoch. Nothing is recorded if the funding-tick epoch does not
+// exist.
+//
+// Funding is settled on every subaccount update, so recording it does not consume gas.
+func (k Keeper) recordSettledFunding(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	perpetualId uint32,
+	fundingPaid *big.Int,
+) {
+	noGasCtx := ctx.WithGasMeter(ante_types.NewFreeInfiniteGasMeter())
+	epoch, found := k.perpetualsKeeper.GetFundingTickEpoch(noGasCtx)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(noGasCtx.KVStore(k.storeKey), []byte(types.FundingHistoryKeyPrefix))
+	entry, found := k.getFundingHistoryEntry(store, subaccountId, perpetualId, epoch)
+	if !found {
+		entry = types.FundingHistoryEntry{Epoch: epoch, FundingPaid: new(big.Int)}
+	}
+	entry.FundingPaid.Add(entry.FundingPaid, fundingPaid)
+
+	bz, err := entry.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(fundingHistoryKey(subaccountId, perpetualId, epoch), bz)
+}
+
+// GetFundingHistory returns the funding settled for the position of the subaccount in the perpetual during
+// each funding-tick epoch from `fromEpoch` to `toEpoch` inclusive, in ascending order of epoch. Epochs during
+// which no funding was settled are omitted, as are epochs whose entry was overwritten by the entry of an
+// epoch `FundingHistoryRetentionEpochs` epochs later.
+//
+// Returns an error if `fromEpoch` is after `toEpoch`, or if the range spans more than
+// `FundingHistoryRetentionEpochs` epochs.
+func (k Keeper) GetFundingHistory(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	perpetualId uint32,
+	fromEpoch uint32,
+	toEpoch uint32,
+) (
+	entries []types.FundingHistoryEntry,
+	err error,
+) {
+	if fromEpoch > toEpoch || toEpoch-fromEpoch >= types.FundingHistoryRetentionEpochs {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidFundingHistoryRange,
+			"from epoch = %d, to epoch = %d",
+			fromEpoch,
+			toEpoch,
+		)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FundingHistoryKeyPrefix))
+	entries = make([]types.FundingHistoryEntry, 0)
+	for epoch := fromEpoch; ; epoch++ {
+		if entry, found := k.getFundingHistoryEntry(store, subaccountId, perpetualId, epoch); found {
+			entries = append(entries, entry)
+		}
+		if epoch == toEpoch {
+			break
+		}
+	}
+	return entries, nil
+}
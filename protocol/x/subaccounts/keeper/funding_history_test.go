@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFundingHistory(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _, epochsKeeper :=
+		keepertest.SubaccountsKeepersWithEpochs(t, true)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+	p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+	_, err := perpetualsKeeper.CreatePerpetual(
+		ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.MarketType,
+	)
+	require.NoError(t, err)
+
+	// Long 1 BTC pays $100 of funding for each increase of 1,000,000 in the funding index.
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:             &constants.Alice_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)),
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+		},
+	})
+
+	// Funding settled before the funding-tick epoch exists is not recorded.
+	require.NoError(t, perpetualsKeeper.ModifyFundingIndex(ctx, 0, big.NewInt(1_000_000)))
+	settleFunding := func() {
+		success, _, err := keeper.UpdateSubaccounts(
+			ctx,
+			[]types.Update{{SubaccountId: constants.Alice_Num0}},
+			types.CollatCheck,
+		)
+		require.NoError(t, err)
+		require.True(t, success)
+	}
+	settleFunding()
+
+	startTime := constants.TimeT.Unix()
+	require.NoError(t, epochsKeeper.CreateEpochInfo(ctx, epochstypes.EpochInfo{
+		Name:          string(epochstypes.FundingTickEpochInfoName),
+		NextTick:      uint32(startTime),
+		Duration:      3_600,
+		IsInitialized: true,
+	}))
+	startNextEpoch := func(epoch uint32) {
+		ctx = ctx.WithBlockHeight(int64(epoch) + 1).WithBlockTime(constants.TimeT.Add(
+			time.Duration(epoch-1) * time.Hour,
+		))
+		started, err := epochsKeeper.MaybeStartNextEpoch(ctx, epochstypes.FundingTickEpochInfoName)
+		require.NoError(t, err)
+		require.True(t, started)
+	}
+
+	// Epoch 1: funding is settled twice and the payments are summed.
+	startNextEpoch(1)
+	require.NoError(t, perpetualsKeeper.ModifyFundingIndex(ctx, 0, big.NewInt(10_000_000)))
+	settleFunding()
+	require.NoError(t, perpetualsKeeper.ModifyFundingIndex(ctx, 0, big.NewInt(2_000_000)))
+	settleFunding()
+
+	// Epoch 2: no funding is settled.
+	startNextEpoch(2)
+	require.NoError(t, perpetualsKeeper.ModifyFundingIndex(ctx, 0, big.NewInt(-5_000_000)))
+
+	// Epoch 3: funding accrued over epochs 2 and 3 is received when settled by market.
+	startNextEpoch(3)
+	require.NoError(t, perpetualsKeeper.ModifyFundingIndex(ctx, 0, big.NewInt(-1_000_000)))
+	_, err = keeper.SettleFundingForMarket(ctx, 0, nil, 0)
+	require.NoError(t, err)
+
+	// Epoch 4: funding is paid again.
+	startNextEpoch(4)
+	require.NoError(t, perpetualsKeeper.ModifyFundingIndex(ctx, 0, big.NewInt(1_000_000)))
+	settleFunding()
+
+	entries, err := keeper.GetFundingHistory(ctx, constants.Alice_Num0, 0, 0, 4)
+	require.NoError(t, err)
+	require.Equal(t, []types.FundingHistoryEntry{
+		{Epoch: 1, FundingPaid: big.NewInt(1_200_000_000)},
+		{Epoch: 3, FundingPaid: big.NewInt(-600_000_000)},
+		{Epoch: 4, FundingPaid: big.NewInt(100_000_000)},
+	}, entries)
+
+	// The funding history reconstructs all funding settled since the funding-tick epoch was created.
+	total := new(big.Int)
+	for _, entry := range entries {
+		total.Add(total, entry.FundingPaid)
+	}
+	subaccount := keeper.GetSubaccount(ctx, constants.Alice_Num0)
+	require.Equal(
+		t,
+		new(big.Int).Sub(big.NewInt(10_000_000_000-100_000_000), total),
+		subaccount.GetUsdcPosition(),
+	)
+
+	entries, err = keeper.GetFundingHistory(ctx, constants.Alice_Num0, 0, 2, 3)
+	require.NoError(t, err)
+	require.Equal(t, []types.FundingHistoryEntry{
+		{Epoch: 3, FundingPaid: big.NewInt(-600_000_000)},
+	}, entries)
+
+	entries, err = keeper.GetFundingHistory(ctx, constants.Bob_Num0, 0, 0, 4)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	_, err = keeper.GetFundingHistory(ctx, constants.Alice_Num0, 0, 4, 3)
+	require.ErrorIs(t, err, types.ErrInvalidFundingHistoryRange)
+	_, err = keeper.GetFundingHistory(ctx, constants.Alice_Num0, 0, 0, types.FundingHistoryRetentionEpochs)
+	require.ErrorIs(t, err, types.ErrInvalidFundingHistoryRange)
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AutoWithdrawableAccounts returns the subaccounts whose free collateral exceeds a threshold, with the
+// amount that can be withdrawn from each, as returned by `GetAutoWithdrawableAccounts`.
+func (k Keeper) AutoWithdrawableAccounts(
+	c context.Context,
+	req *types.QueryAutoWithdrawableAccountsRequest,
+) (*types.QueryAutoWithdrawableAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	withdrawals, err := k.GetAutoWithdrawableAccounts(ctx, new(big.Int).SetUint64(req.ThresholdQuoteQuantums))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &types.QueryAutoWithdrawableAccountsResponse{
+		Accounts: make([]types.AutoWithdrawableAccount, len(withdrawals)),
+	}
+	for i, withdrawal := range withdrawals {
+		response.Accounts[i] = types.AutoWithdrawableAccount{
+			SubaccountId:  withdrawal.SubaccountId,
+			QuoteQuantums: dtypes.NewIntFromBigInt(withdrawal.QuoteQuantums),
+		}
+	}
+	return response, nil
+}
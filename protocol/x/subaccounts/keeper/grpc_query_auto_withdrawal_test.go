@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestQueryAutoWithdrawableAccounts(t *testing.T) {
+	for testName, tc := range map[string]struct {
+		// Parameters
+		request *types.QueryAutoWithdrawableAccountsRequest
+
+		// Expectations
+		response *types.QueryAutoWithdrawableAccountsResponse
+		err      error
+	}{
+		"Nil request results in error": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"Success": {
+			request: &types.QueryAutoWithdrawableAccountsRequest{
+				ThresholdQuoteQuantums: 10_000_000_000,
+			},
+			response: &types.QueryAutoWithdrawableAccountsResponse{
+				Accounts: []types.AutoWithdrawableAccount{
+					{SubaccountId: constants.Alice_Num0, QuoteQuantums: dtypes.NewInt(100_000_000_000)},
+				},
+			},
+		},
+		"No free collateral exceeds the threshold": {
+			request: &types.QueryAutoWithdrawableAccountsRequest{
+				ThresholdQuoteQuantums: 100_000_000_000,
+			},
+			response: &types.QueryAutoWithdrawableAccountsResponse{
+				Accounts: []types.AutoWithdrawableAccount{},
+			},
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ :=
+				keepertest.SubaccountsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			// Free collateral of $100,000 with no positions.
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(100_000_000_000)),
+			})
+			// Free collateral of $10,000 with 1 BTC and an IMR of $10,000.
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Bob_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-30_000_000_000)),
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+				},
+			})
+
+			response, err := keeper.AutoWithdrawableAccounts(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.response, response)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BasketShockToLiquidate returns the smallest shock to the prices of a correlated basket of perpetuals
+// that makes a subaccount liquidatable, as returned by `GetBasketShockToLiquidate`.
+func (k Keeper) BasketShockToLiquidate(
+	c context.Context,
+	req *types.QueryBasketShockToLiquidateRequest,
+) (*types.QueryBasketShockToLiquidateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	subaccountId := types.SubaccountId{
+		Owner:  req.Owner,
+		Number: req.Number,
+	}
+	if err := subaccountId.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	weightsPpm := make(map[uint32]int32, len(req.Weights))
+	for _, weight := range req.Weights {
+		if _, exists := weightsPpm[weight.PerpetualId]; exists {
+			return nil, status.Error(
+				codes.InvalidArgument,
+				fmt.Sprintf("duplicate weight for perpetual %d", weight.PerpetualId),
+			)
+		}
+		weightsPpm[weight.PerpetualId] = weight.WeightPpm
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	shockPpm, risk, found, err := k.GetBasketShockToLiquidate(ctx, subaccountId, weightsPpm)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return &types.QueryBasketShockToLiquidateResponse{}, nil
+	}
+
+	return &types.QueryBasketShockToLiquidateResponse{
+		Found:                        true,
+		ShockPpm:                     shockPpm,
+		NetCollateral:                dtypes.NewIntFromBigInt(risk.NC),
+		InitialMarginRequirement:     dtypes.NewIntFromBigInt(risk.IMR),
+		MaintenanceMarginRequirement: dtypes.NewIntFromBigInt(risk.MMR),
+	}, nil
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestQueryBasketShockToLiquidate(t *testing.T) {
+	for testName, tc := range map[string]struct {
+		// Parameters
+		request *types.QueryBasketShockToLiquidateRequest
+
+		// Expectations
+		weightsPpm map[uint32]int32
+		err        error
+	}{
+		"Nil request results in error": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"Invalid owner results in error": {
+			request: &types.QueryBasketShockToLiquidateRequest{
+				Owner: "invalid",
+			},
+			err: status.Error(
+				codes.InvalidArgument,
+				"invalid SubaccountId Owner address (invalid). Error: (decoding bech32 failed: invalid bech32 "+
+					"string length 7): subaccount id owner is an invalid address",
+			),
+		},
+		"Duplicate weight results in error": {
+			request: &types.QueryBasketShockToLiquidateRequest{
+				Owner:  constants.Alice_Num0.Owner,
+				Number: constants.Alice_Num0.Number,
+				Weights: []types.BasketWeight{
+					{PerpetualId: 0, WeightPpm: 1_000_000},
+					{PerpetualId: 0, WeightPpm: 500_000},
+				},
+			},
+			err: status.Error(codes.InvalidArgument, "duplicate weight for perpetual 0"),
+		},
+		"Success with perfect correlation": {
+			request: &types.QueryBasketShockToLiquidateRequest{
+				Owner:  constants.Alice_Num0.Owner,
+				Number: constants.Alice_Num0.Number,
+			},
+		},
+		"Success with weights": {
+			request: &types.QueryBasketShockToLiquidateRequest{
+				Owner:  constants.Alice_Num0.Owner,
+				Number: constants.Alice_Num0.Number,
+				Weights: []types.BasketWeight{
+					{PerpetualId: 0, WeightPpm: 1_000_000},
+					{PerpetualId: 1, WeightPpm: 500_000},
+				},
+			},
+			weightsPpm: map[uint32]int32{0: 1_000_000, 1: 500_000},
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ :=
+				keepertest.SubaccountsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			keepertest.CreateTestPerpetuals(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-50_000_000_000)),
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+					testutil.CreateSinglePerpetualPosition(1, big.NewInt(1_000_000_000), big.NewInt(0), big.NewInt(0)),
+				},
+			})
+
+			response, err := keeper.BasketShockToLiquidate(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			shockPpm, risk, found, err := keeper.GetBasketShockToLiquidate(ctx, constants.Alice_Num0, tc.weightsPpm)
+			require.NoError(t, err)
+			require.True(t, found)
+			require.Equal(t, &types.QueryBasketShockToLiquidateResponse{
+				Found:                        true,
+				ShockPpm:                     shockPpm,
+				NetCollateral:                dtypes.NewIntFromBigInt(risk.NC),
+				InitialMarginRequirement:     dtypes.NewIntFromBigInt(risk.IMR),
+				MaintenanceMarginRequirement: dtypes.NewIntFromBigInt(risk.MMR),
+			}, response)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BreakEvenPrice returns the price at which a subaccount would realize zero PnL by closing its position in
+// a perpetual, as returned by `GetBreakEvenPrice`.
+func (k Keeper) BreakEvenPrice(
+	c context.Context,
+	req *types.QueryBreakEvenPriceRequest,
+) (*types.QueryBreakEvenPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	subaccountId := types.SubaccountId{
+		Owner:  req.Owner,
+		Number: req.Number,
+	}
+	if err := subaccountId.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	price, err := k.GetBreakEvenPrice(ctx, subaccountId, req.PerpetualId)
+	if err != nil {
+		if errors.Is(err, types.ErrPerpPositionNotFound) || errors.Is(err, types.ErrPerpPositionCostBasisUnknown) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBreakEvenPriceResponse{
+		Price: price.RatString(),
+	}, nil
+}
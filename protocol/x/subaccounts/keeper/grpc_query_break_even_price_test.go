@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestQueryBreakEvenPrice(t *testing.T) {
+	for testName, tc := range map[string]struct {
+		// Parameters
+		request *types.QueryBreakEvenPriceRequest
+
+		// Expectations
+		response *types.QueryBreakEvenPriceResponse
+		errCode  codes.Code
+		err      error
+	}{
+		"Nil request results in error": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"Invalid owner results in error": {
+			request: &types.QueryBreakEvenPriceRequest{
+				Owner: "invalid",
+			},
+			err: status.Error(
+				codes.InvalidArgument,
+				"invalid SubaccountId Owner address (invalid). Error: (decoding bech32 failed: invalid bech32 "+
+					"string length 7): subaccount id owner is an invalid address",
+			),
+		},
+		"Unknown cost basis results in error": {
+			request: &types.QueryBreakEvenPriceRequest{
+				Owner:       constants.Alice_Num0.Owner,
+				Number:      constants.Alice_Num0.Number,
+				PerpetualId: 1,
+			},
+			errCode: codes.NotFound,
+		},
+		"Success": {
+			request: &types.QueryBreakEvenPriceRequest{
+				Owner:       constants.Alice_Num0.Owner,
+				Number:      constants.Alice_Num0.Number,
+				PerpetualId: 0,
+			},
+			// 1 BTC entered at $40,000 with no funding accrued.
+			response: &types.QueryBreakEvenPriceResponse{
+				Price: "400",
+			},
+		},
+		"No position results in error": {
+			request: &types.QueryBreakEvenPriceRequest{
+				Owner:       constants.Bob_Num0.Owner,
+				Number:      constants.Bob_Num0.Number,
+				PerpetualId: 0,
+			},
+			errCode: codes.NotFound,
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ :=
+				keepertest.SubaccountsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			for _, p := range []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				constants.EthUsd_20PercentInitial_10PercentMaintenance,
+			} {
+				_, err := perpetualsKeeper.CreatePerpetual(
+					ctx,
+					p.Params.Id,
+					p.Params.Ticker,
+					p.Params.MarketId,
+					p.Params.AtomicResolution,
+					p.Params.DefaultFundingPpm,
+					p.Params.LiquidityTier,
+					p.Params.MarketType,
+				)
+				require.NoError(t, err)
+			}
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(100_000_000_000)),
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPositionWithCostBasis(
+						0,
+						big.NewInt(100_000_000),
+						big.NewInt(0),
+						big.NewInt(0),
+						big.NewInt(40_000_000_000),
+					),
+					testutil.CreateSinglePerpetualPosition(1, big.NewInt(1_000_000_000), big.NewInt(0), big.NewInt(0)),
+				},
+			})
+
+			response, err := keeper.BreakEvenPrice(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			if tc.errCode != codes.OK {
+				require.Equal(t, tc.errCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.response, response)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CloseAllCost returns the taker fees a subaccount would pay to close all of its positions and its net
+// collateral afterwards, as returned by `GetCloseAllCost`.
+func (k Keeper) CloseAllCost(
+	c context.Context,
+	req *types.QueryCloseAllCostRequest,
+) (*types.QueryCloseAllCostResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	subaccountId := types.SubaccountId{
+		Owner:  req.Owner,
+		Number: req.Number,
+	}
+	if err := subaccountId.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	fees, postCloseNC, err := k.GetCloseAllCost(ctx, subaccountId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCloseAllCostResponse{
+		Fees:                   dtypes.NewIntFromBigInt(fees),
+		PostCloseNetCollateral: dtypes.NewIntFromBigInt(postCloseNC),
+	}, nil
+}
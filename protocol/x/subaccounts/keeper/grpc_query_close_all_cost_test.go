@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestQueryCloseAllCost(t *testing.T) {
+	for testName, tc := range map[string]struct {
+		// Parameters
+		request *types.QueryCloseAllCostRequest
+
+		// Expectations
+		response *types.QueryCloseAllCostResponse
+		err      error
+	}{
+		"Nil request results in error": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"Invalid owner results in error": {
+			request: &types.QueryCloseAllCostRequest{
+				Owner: "invalid",
+			},
+			err: status.Error(
+				codes.InvalidArgument,
+				"invalid SubaccountId Owner address (invalid). Error: (decoding bech32 failed: invalid bech32 "+
+					"string length 7): subaccount id owner is an invalid address",
+			),
+		},
+		"Success": {
+			request: &types.QueryCloseAllCostRequest{
+				Owner:  constants.Alice_Num0.Owner,
+				Number: constants.Alice_Num0.Number,
+			},
+			// Closing 1 BTC worth $50,000 and 10 ETH worth $30,000 at 5 bps costs $40.
+			response: &types.QueryCloseAllCostResponse{
+				Fees:                   dtypes.NewInt(40_000_000),
+				PostCloseNetCollateral: dtypes.NewInt(9_960_000_000),
+			},
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ :=
+				keepertest.SubaccountsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			for _, p := range []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				constants.EthUsd_20PercentInitial_10PercentMaintenance,
+			} {
+				_, err := perpetualsKeeper.CreatePerpetual(
+					ctx,
+					p.Params.Id,
+					p.Params.Ticker,
+					p.Params.MarketId,
+					p.Params.AtomicResolution,
+					p.Params.DefaultFundingPpm,
+					p.Params.LiquidityTier,
+					p.Params.MarketType,
+				)
+				require.NoError(t, err)
+			}
+			keeper.SetFeeTiersKeeper(takerFees(500))
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-10_000_000_000)),
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+					testutil.CreateSinglePerpetualPosition(1, big.NewInt(-10_000_000_000), big.NewInt(0), big.NewInt(0)),
+				},
+			})
+
+			response, err := keeper.CloseAllCost(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.response, response)
+		})
+	}
+}
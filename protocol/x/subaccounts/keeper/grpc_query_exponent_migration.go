@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MarketExponentMigrationImpact returns the impact of migrating the price exponent of a market on the
+// positions in its perpetuals, as returned by `GetMarketExponentMigrationImpact`.
+func (k Keeper) MarketExponentMigrationImpact(
+	c context.Context,
+	req *types.QueryMarketExponentMigrationImpactRequest,
+) (*types.QueryMarketExponentMigrationImpactResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	currentNotional, proposedNotional, newlyLiquidatable, err := k.GetMarketExponentMigrationImpact(
+		ctx,
+		req.MarketId,
+		req.ProposedExponent,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMarketExponentMigrationImpactResponse{
+		CurrentNotional:   dtypes.NewIntFromBigInt(currentNotional),
+		ProposedNotional:  dtypes.NewIntFromBigInt(proposedNotional),
+		NewlyLiquidatable: newlyLiquidatable,
+	}, nil
+}
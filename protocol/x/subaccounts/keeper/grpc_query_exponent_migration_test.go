@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestQueryMarketExponentMigrationImpact(t *testing.T) {
+	p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+	for testName, tc := range map[string]struct {
+		// Parameters
+		request *types.QueryMarketExponentMigrationImpactRequest
+
+		// Expectations
+		response *types.QueryMarketExponentMigrationImpactResponse
+		err      error
+	}{
+		"Nil request results in error": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"Success": {
+			// Raising the exponent by one multiplies the BTC price by 10 to $500,000, which makes the short
+			// liquidatable.
+			request: &types.QueryMarketExponentMigrationImpactRequest{
+				MarketId:         p.Params.MarketId,
+				ProposedExponent: constants.BtcUsdExponent + 1,
+			},
+			response: &types.QueryMarketExponentMigrationImpactResponse{
+				CurrentNotional:   dtypes.NewInt(50_000_000_000),
+				ProposedNotional:  dtypes.NewInt(500_000_000_000),
+				NewlyLiquidatable: []types.SubaccountId{constants.Alice_Num0},
+			},
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ :=
+				keepertest.SubaccountsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(60_000_000_000)),
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(0, big.NewInt(-100_000_000), big.NewInt(0), big.NewInt(0)),
+				},
+			})
+
+			response, err := keeper.MarketExponentMigrationImpact(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.response, response)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FlatteningSize returns the size and absolute notional of the trade that would make the net position of
+// all subaccounts of an owner in a perpetual zero, as returned by `GetFlatteningSize`.
+func (k Keeper) FlatteningSize(
+	c context.Context,
+	req *types.QueryFlatteningSizeRequest,
+) (*types.QueryFlatteningSizeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	quantums, absNotional, err := k.GetFlatteningSize(ctx, req.Owner, req.PerpetualId)
+	if err != nil {
+		if errors.Is(err, perptypes.ErrPerpetualDoesNotExist) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFlatteningSizeResponse{
+		Quantums:    dtypes.NewIntFromBigInt(quantums),
+		AbsNotional: dtypes.NewIntFromBigInt(absNotional),
+	}, nil
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestQueryFlatteningSize(t *testing.T) {
+	for testName, tc := range map[string]struct {
+		// Parameters
+		request *types.QueryFlatteningSizeRequest
+
+		// Expectations
+		response *types.QueryFlatteningSizeResponse
+		errCode  codes.Code
+		err      error
+	}{
+		"Nil request results in error": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"Invalid owner results in error": {
+			request: &types.QueryFlatteningSizeRequest{
+				Owner: "invalid",
+			},
+			err: status.Error(
+				codes.InvalidArgument,
+				"decoding bech32 failed: invalid bech32 string length 7",
+			),
+		},
+		"Unknown perpetual results in error": {
+			request: &types.QueryFlatteningSizeRequest{
+				Owner:       constants.AliceAccAddress.String(),
+				PerpetualId: 1000,
+			},
+			errCode: codes.NotFound,
+		},
+		"Success": {
+			request: &types.QueryFlatteningSizeRequest{
+				Owner:       constants.AliceAccAddress.String(),
+				PerpetualId: 0,
+			},
+			// Net long 0.5 BTC at $50,000.
+			response: &types.QueryFlatteningSizeResponse{
+				Quantums:    dtypes.NewInt(-50_000_000),
+				AbsNotional: dtypes.NewInt(25_000_000_000),
+			},
+		},
+		"No position": {
+			request: &types.QueryFlatteningSizeRequest{
+				Owner:       constants.BobAccAddress.String(),
+				PerpetualId: 0,
+			},
+			response: &types.QueryFlatteningSizeResponse{
+				Quantums:    dtypes.NewInt(0),
+				AbsNotional: dtypes.NewInt(0),
+			},
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ :=
+				keepertest.SubaccountsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(100_000_000_000)),
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+				},
+			})
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num1,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(100_000_000_000)),
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(0, big.NewInt(-50_000_000), big.NewInt(0), big.NewInt(0)),
+				},
+			})
+
+			response, err := keeper.FlatteningSize(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			if tc.errCode != codes.OK {
+				require.Equal(t, tc.errCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Zero(t, tc.response.Quantums.Cmp(response.Quantums))
+			require.Zero(t, tc.response.AbsNotional.Cmp(response.AbsNotional))
+		})
+	}
+}
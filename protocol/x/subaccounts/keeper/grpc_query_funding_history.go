@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FundingHistory returns the funding settled for the position of a subaccount in a perpetual during a range
+// of funding-tick epochs, as returned by `GetFundingHistory`.
+func (k Keeper) FundingHistory(
+	c context.Context,
+	req *types.QueryFundingHistoryRequest,
+) (*types.QueryFundingHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	subaccountId := types.SubaccountId{
+		Owner:  req.Owner,
+		Number: req.Number,
+	}
+	if err := subaccountId.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	entries, err := k.GetFundingHistory(ctx, subaccountId, req.PerpetualId, req.FromEpoch, req.ToEpoch)
+	if err != nil {
+		if errors.Is(err, types.ErrInvalidFundingHistoryRange) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &types.QueryFundingHistoryResponse{
+		Entries: make([]types.EpochFunding, len(entries)),
+	}
+	for i, entry := range entries {
+		response.Entries[i] = types.EpochFunding{
+			Epoch:       entry.Epoch,
+			FundingPaid: dtypes.NewIntFromBigInt(entry.FundingPaid),
+		}
+	}
+	return response, nil
+}
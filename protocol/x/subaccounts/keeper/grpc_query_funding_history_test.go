@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestQueryFundingHistory(t *testing.T) {
+	for testName, tc := range map[string]struct {
+		// Parameters
+		request *types.QueryFundingHistoryRequest
+
+		// Expectations
+		response *types.QueryFundingHistoryResponse
+		errCode  codes.Code
+		err      error
+	}{
+		"Nil request results in error": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"Invalid owner results in error": {
+			request: &types.QueryFundingHistoryRequest{
+				Owner: "invalid",
+			},
+			err: status.Error(
+				codes.InvalidArgument,
+				"invalid SubaccountId Owner address (invalid). Error: (decoding bech32 failed: invalid bech32 "+
+					"string length 7): subaccount id owner is an invalid address",
+			),
+		},
+		"Invalid range results in error": {
+			request: &types.QueryFundingHistoryRequest{
+				Owner:     constants.Alice_Num0.Owner,
+				Number:    constants.Alice_Num0.Number,
+				FromEpoch: 2,
+				ToEpoch:   1,
+			},
+			errCode: codes.InvalidArgument,
+		},
+		"Success": {
+			request: &types.QueryFundingHistoryRequest{
+				Owner:     constants.Alice_Num0.Owner,
+				Number:    constants.Alice_Num0.Number,
+				FromEpoch: 0,
+				ToEpoch:   1,
+			},
+			// Long 1 BTC pays $100 of funding for each increase of 1,000,000 in the funding index.
+			response: &types.QueryFundingHistoryResponse{
+				Entries: []types.EpochFunding{
+					{Epoch: 1, FundingPaid: dtypes.NewInt(200_000_000)},
+				},
+			},
+		},
+		"No funding settled": {
+			request: &types.QueryFundingHistoryRequest{
+				Owner:     constants.Bob_Num0.Owner,
+				Number:    constants.Bob_Num0.Number,
+				FromEpoch: 0,
+				ToEpoch:   1,
+			},
+			response: &types.QueryFundingHistoryResponse{
+				Entries: []types.EpochFunding{},
+			},
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _, epochsKeeper :=
+				keepertest.SubaccountsKeepersWithEpochs(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)),
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+				},
+			})
+			require.NoError(t, epochsKeeper.CreateEpochInfo(ctx, epochstypes.EpochInfo{
+				Name:          string(epochstypes.FundingTickEpochInfoName),
+				NextTick:      uint32(constants.TimeT.Unix()),
+				Duration:      3_600,
+				IsInitialized: true,
+			}))
+			ctx = ctx.WithBlockHeight(2).WithBlockTime(constants.TimeT)
+			started, err := epochsKeeper.MaybeStartNextEpoch(ctx, epochstypes.FundingTickEpochInfoName)
+			require.NoError(t, err)
+			require.True(t, started)
+			require.NoError(t, perpetualsKeeper.ModifyFundingIndex(ctx, 0, big.NewInt(2_000_000)))
+			success, _, err := keeper.UpdateSubaccounts(
+				ctx,
+				[]types.Update{{SubaccountId: constants.Alice_Num0}},
+				types.CollatCheck,
+			)
+			require.NoError(t, err)
+			require.True(t, success)
+
+			response, err := keeper.FundingHistory(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			if tc.errCode != codes.OK {
+				require.Equal(t, tc.errCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.response, response)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// HealthDistribution returns the number of subaccounts in each health bucket, as returned by
+// `GetHealthDistribution`.
+func (k Keeper) HealthDistribution(
+	c context.Context,
+	req *types.QueryHealthDistributionRequest,
+) (*types.QueryHealthDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	for i := 1; i < len(req.Buckets); i++ {
+		if req.Buckets[i] <= req.Buckets[i-1] {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"health buckets must be strictly ascending, got %v",
+				req.Buckets,
+			)
+		}
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	counts, err := k.GetHealthDistribution(ctx, req.Buckets)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHealthDistributionResponse{
+		Counts: counts,
+	}, nil
+}
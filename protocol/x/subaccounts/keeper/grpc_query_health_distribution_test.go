@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestQueryHealthDistribution(t *testing.T) {
+	for testName, tc := range map[string]struct {
+		// Parameters
+		request *types.QueryHealthDistributionRequest
+
+		// Expectations
+		response *types.QueryHealthDistributionResponse
+		err      error
+	}{
+		"Nil request results in error": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"Descending buckets results in error": {
+			request: &types.QueryHealthDistributionRequest{
+				Buckets: []uint32{1_500_000, 1_000_000},
+			},
+			err: status.Error(codes.InvalidArgument, "health buckets must be strictly ascending, got [1500000 1000000]"),
+		},
+		"Success": {
+			request: &types.QueryHealthDistributionRequest{
+				Buckets: []uint32{1_000_000, 1_500_000},
+			},
+			response: &types.QueryHealthDistributionResponse{
+				Counts: []uint64{1, 1, 1},
+			},
+		},
+		"No buckets": {
+			request: &types.QueryHealthDistributionRequest{},
+			response: &types.QueryHealthDistributionResponse{
+				Counts: []uint64{3},
+			},
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ :=
+				keepertest.SubaccountsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			// Each subaccount holds 1 BTC with a maintenance margin requirement of $5,000.
+			for _, subaccount := range []types.Subaccount{
+				{
+					Id:             &constants.Alice_Num0,
+					AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-46_000_000_000)), // Health of 80%.
+				},
+				{
+					Id:             &constants.Bob_Num0,
+					AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-44_000_000_000)), // Health of 120%.
+				},
+				{
+					Id:             &constants.Carl_Num0,
+					AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-40_000_000_000)), // Health of 200%.
+				},
+			} {
+				subaccount.PerpetualPositions = []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+				}
+				keeper.SetSubaccount(ctx, subaccount)
+			}
+
+			response, err := keeper.HealthDistribution(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.response, response)
+		})
+	}
+}
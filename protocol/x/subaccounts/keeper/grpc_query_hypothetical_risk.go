@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ComputeRiskForHypothetical returns the risk of a subaccount that is provided in the request rather
+// than read from state, after applying the updates in the request, at the current oracle prices. The
+// positions of the subaccount are treated as settled, so their funding indices are ignored.
+func (k Keeper) ComputeRiskForHypothetical(
+	c context.Context,
+	req *types.QueryComputeRiskForHypotheticalRequest,
+) (*types.QueryComputeRiskForHypotheticalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := req.Subaccount.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	settledUpdate := types.SettledUpdate{
+		SettledSubaccount: req.Subaccount,
+		AssetUpdates:      make([]types.AssetUpdate, 0, len(req.AssetUpdates)),
+		PerpetualUpdates:  make([]types.PerpetualUpdate, 0, len(req.PerpetualUpdates)),
+	}
+	for _, u := range req.AssetUpdates {
+		settledUpdate.AssetUpdates = append(settledUpdate.AssetUpdates, types.AssetUpdate{
+			AssetId:          u.AssetId,
+			BigQuantumsDelta: u.QuantumsDelta.BigInt(),
+		})
+	}
+	perpIdsSet := make(map[uint32]struct{})
+	for _, pos := range req.Subaccount.PerpetualPositions {
+		perpIdsSet[pos.PerpetualId] = struct{}{}
+	}
+	for _, u := range req.PerpetualUpdates {
+		settledUpdate.PerpetualUpdates = append(settledUpdate.PerpetualUpdates, types.PerpetualUpdate{
+			PerpetualId:          u.PerpetualId,
+			BigQuantumsDelta:     u.QuantumsDelta.BigInt(),
+			BigQuoteBalanceDelta: u.QuoteBalanceDelta.BigInt(),
+		})
+		perpIdsSet[u.PerpetualId] = struct{}{}
+	}
+
+	perpInfos, err := k.getPerpInfos(ctx, perpIdsSet)
+	if err != nil {
+		if errors.Is(err, perptypes.ErrPerpetualDoesNotExist) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	risk, err := salib.GetRiskForSubaccount(
+		salib.CalculateUpdatedSubaccount(settledUpdate, perpInfos),
+		perpInfos,
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryComputeRiskForHypotheticalResponse{
+		NetCollateral:                dtypes.NewIntFromBigInt(risk.NC),
+		InitialMarginRequirement:     dtypes.NewIntFromBigInt(risk.IMR),
+		MaintenanceMarginRequirement: dtypes.NewIntFromBigInt(risk.MMR),
+	}, nil
+}
@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestQueryComputeRiskForHypothetical(t *testing.T) {
+	// 1 BTC long and 1 ETH short, with -$20,000 of USDC.
+	twoPositionSubaccount := types.Subaccount{
+		Id:             &constants.Alice_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-20_000_000_000)),
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+			testutil.CreateSinglePerpetualPosition(1, big.NewInt(-1_000_000_000), big.NewInt(0), big.NewInt(0)),
+		},
+	}
+
+	for testName, tc := range map[string]struct {
+		// Parameters
+		request *types.QueryComputeRiskForHypotheticalRequest
+
+		// Expectations
+		response *types.QueryComputeRiskForHypotheticalResponse
+		err      error
+	}{
+		"Nil request results in error": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"Two positions": {
+			request: &types.QueryComputeRiskForHypotheticalRequest{
+				Subaccount: twoPositionSubaccount,
+			},
+			// NC = -$20,000 + $50,000 - $3,000, IMR = 20% * ($50,000 + $3,000), MMR = 10% * ($50,000 + $3,000).
+			response: &types.QueryComputeRiskForHypotheticalResponse{
+				NetCollateral:                dtypes.NewInt(27_000_000_000),
+				InitialMarginRequirement:     dtypes.NewInt(10_600_000_000),
+				MaintenanceMarginRequirement: dtypes.NewInt(5_300_000_000),
+			},
+		},
+		"Two positions with updates": {
+			request: &types.QueryComputeRiskForHypotheticalRequest{
+				Subaccount: twoPositionSubaccount,
+				// Sell 0.5 BTC for $25,000 and deposit $1,000.
+				AssetUpdates: []types.HypotheticalAssetUpdate{
+					{AssetId: 0, QuantumsDelta: dtypes.NewInt(1_000_000_000)},
+				},
+				PerpetualUpdates: []types.HypotheticalPerpetualUpdate{
+					{
+						PerpetualId:       0,
+						QuantumsDelta:     dtypes.NewInt(-50_000_000),
+						QuoteBalanceDelta: dtypes.NewInt(25_000_000_000),
+					},
+				},
+			},
+			// NC = -$19,000 + $25,000 - $3,000 + $25,000, IMR = 20% * ($25,000 + $3,000),
+			// MMR = 10% * ($25,000 + $3,000).
+			response: &types.QueryComputeRiskForHypotheticalResponse{
+				NetCollateral:                dtypes.NewInt(28_000_000_000),
+				InitialMarginRequirement:     dtypes.NewInt(5_600_000_000),
+				MaintenanceMarginRequirement: dtypes.NewInt(2_800_000_000),
+			},
+		},
+		"Nil subaccount id": {
+			request: &types.QueryComputeRiskForHypotheticalRequest{
+				Subaccount: types.Subaccount{
+					AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(1_000)),
+				},
+			},
+			err: status.Error(
+				codes.InvalidArgument,
+				"subaccount id is nil: subaccount id owner is an invalid address",
+			),
+		},
+		"Duplicate asset positions": {
+			request: &types.QueryComputeRiskForHypotheticalRequest{
+				Subaccount: types.Subaccount{
+					Id: &constants.Alice_Num0,
+					AssetPositions: append(
+						testutil.CreateUsdcAssetPositions(big.NewInt(1_000)),
+						testutil.CreateUsdcAssetPositions(big.NewInt(1_000))...,
+					),
+				},
+			},
+			err: status.Error(
+				codes.InvalidArgument,
+				types.ErrDuplicateAssetPositions.Wrapf("subaccount id: %v, asset id: 0", &constants.Alice_Num0).Error(),
+			),
+		},
+		"Perpetual not found": {
+			request: &types.QueryComputeRiskForHypotheticalRequest{
+				Subaccount: types.Subaccount{
+					Id: &constants.Alice_Num0,
+				},
+				PerpetualUpdates: []types.HypotheticalPerpetualUpdate{
+					{
+						PerpetualId:       1000,
+						QuantumsDelta:     dtypes.NewInt(1),
+						QuoteBalanceDelta: dtypes.NewInt(0),
+					},
+				},
+			},
+			err: status.Error(
+				codes.NotFound,
+				perptypes.ErrPerpetualDoesNotExist.Wrap("1000").Error(),
+			),
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ :=
+				keepertest.SubaccountsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			for _, p := range []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				constants.EthUsd_20PercentInitial_10PercentMaintenance,
+			} {
+				_, err := perpetualsKeeper.CreatePerpetual(
+					ctx,
+					p.Params.Id,
+					p.Params.Ticker,
+					p.Params.MarketId,
+					p.Params.AtomicResolution,
+					p.Params.DefaultFundingPpm,
+					p.Params.LiquidityTier,
+					p.Params.MarketType,
+				)
+				require.NoError(t, err)
+			}
+
+			response, err := keeper.ComputeRiskForHypothetical(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+
+			// The hypothetical subaccount is not written to state.
+			require.Empty(t, keeper.GetAllSubaccount(ctx))
+		})
+	}
+}
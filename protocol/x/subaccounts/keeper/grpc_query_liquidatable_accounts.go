@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LiquidatableAccounts returns the ids of all liquidatable subaccounts in the order in which they should be
+// liquidated, as returned by `GetLiquidatableAccountsOrdered`.
+func (k Keeper) LiquidatableAccounts(
+	c context.Context,
+	req *types.QueryLiquidatableAccountsRequest,
+) (*types.QueryLiquidatableAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	subaccountIds, err := k.GetLiquidatableAccountsOrdered(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLiquidatableAccountsResponse{
+		SubaccountIds: subaccountIds,
+	}, nil
+}
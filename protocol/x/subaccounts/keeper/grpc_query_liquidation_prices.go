@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SubaccountLiquidationPrices returns the liquidation and bankruptcy prices of every open perpetual
+// position of a subaccount, as returned by `GetSubaccountLiquidationPrices`.
+func (k Keeper) SubaccountLiquidationPrices(
+	c context.Context,
+	req *types.QuerySubaccountLiquidationPricesRequest,
+) (*types.QuerySubaccountLiquidationPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	subaccountId := types.SubaccountId{
+		Owner:  req.Owner,
+		Number: req.Number,
+	}
+	if err := subaccountId.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	prices, err := k.GetSubaccountLiquidationPrices(ctx, subaccountId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySubaccountLiquidationPricesResponse{Prices: prices}, nil
+}
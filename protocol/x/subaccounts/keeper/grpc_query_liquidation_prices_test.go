@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestQuerySubaccountLiquidationPrices(t *testing.T) {
+	for testName, tc := range map[string]struct {
+		// Parameters
+		request *types.QuerySubaccountLiquidationPricesRequest
+
+		// Expectations
+		err error
+	}{
+		"Nil request results in error": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"Invalid owner results in error": {
+			request: &types.QuerySubaccountLiquidationPricesRequest{
+				Owner: "invalid",
+			},
+			err: status.Error(
+				codes.InvalidArgument,
+				"invalid SubaccountId Owner address (invalid). Error: (decoding bech32 failed: invalid bech32 "+
+					"string length 7): subaccount id owner is an invalid address",
+			),
+		},
+		"Success": {
+			request: &types.QuerySubaccountLiquidationPricesRequest{
+				Owner:  constants.Alice_Num0.Owner,
+				Number: constants.Alice_Num0.Number,
+			},
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ :=
+				keepertest.SubaccountsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			keepertest.CreateTestPerpetuals(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)),
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+					testutil.CreateSinglePerpetualPosition(1, big.NewInt(-1_000_000_000), big.NewInt(0), big.NewInt(0)),
+				},
+			})
+
+			response, err := keeper.SubaccountLiquidationPrices(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			prices, err := keeper.GetSubaccountLiquidationPrices(ctx, constants.Alice_Num0)
+			require.NoError(t, err)
+			require.Len(t, response.Prices, 2)
+			require.Equal(t, prices, response.Prices)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LiquidationProceedsEstimate returns the estimated proceeds of liquidating the position of a subaccount in
+// a perpetual against a depth model of the order book, as returned by `GetLiquidationProceedsEstimate`.
+func (k Keeper) LiquidationProceedsEstimate(
+	c context.Context,
+	req *types.QueryLiquidationProceedsEstimateRequest,
+) (*types.QueryLiquidationProceedsEstimateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	subaccountId := types.SubaccountId{
+		Owner:  req.Owner,
+		Number: req.Number,
+	}
+	if err := subaccountId.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	depth := make([]types.DepthLevel, len(req.Depth))
+	for i, level := range req.Depth {
+		depth[i] = types.DepthLevel{
+			Price:    level.Price,
+			Quantums: level.Quantums,
+		}
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	estimate, err := k.GetLiquidationProceedsEstimate(
+		ctx,
+		subaccountId,
+		req.PerpetualId,
+		depth,
+		req.MaxLiquidationFeePpm,
+	)
+	if err != nil {
+		if errors.Is(err, types.ErrPerpPositionNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLiquidationProceedsEstimateResponse{
+		FilledQuantums:                  dtypes.NewIntFromBigInt(estimate.FilledQuantums),
+		ProceedsQuoteQuantums:           dtypes.NewIntFromBigInt(estimate.ProceedsQuoteQuantums),
+		InsuranceFundDeltaQuoteQuantums: dtypes.NewIntFromBigInt(estimate.InsuranceFundDeltaQuoteQuantums),
+	}, nil
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestQueryLiquidationProceedsEstimate(t *testing.T) {
+	for testName, tc := range map[string]struct {
+		// Parameters
+		request *types.QueryLiquidationProceedsEstimateRequest
+
+		// Expectations
+		response *types.QueryLiquidationProceedsEstimateResponse
+		errCode  codes.Code
+		err      error
+	}{
+		"Nil request results in error": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"Invalid owner results in error": {
+			request: &types.QueryLiquidationProceedsEstimateRequest{
+				Owner: "invalid",
+			},
+			err: status.Error(
+				codes.InvalidArgument,
+				"invalid SubaccountId Owner address (invalid). Error: (decoding bech32 failed: invalid bech32 "+
+					"string length 7): subaccount id owner is an invalid address",
+			),
+		},
+		"No position results in error": {
+			request: &types.QueryLiquidationProceedsEstimateRequest{
+				Owner:                constants.Bob_Num0.Owner,
+				Number:               constants.Bob_Num0.Number,
+				PerpetualId:          0,
+				Depth:                []types.LiquidationDepthLevel{{Price: 4_900_000_000, Quantums: 50_000_000}},
+				MaxLiquidationFeePpm: 5_000,
+			},
+			errCode: codes.NotFound,
+		},
+		"Success": {
+			// 0.5 BTC is bid at $49,000 and 1 BTC at $46,000, and liquidations pay a fee of at most 0.5%.
+			request: &types.QueryLiquidationProceedsEstimateRequest{
+				Owner:       constants.Alice_Num0.Owner,
+				Number:      constants.Alice_Num0.Number,
+				PerpetualId: 0,
+				Depth: []types.LiquidationDepthLevel{
+					{Price: 4_900_000_000, Quantums: 50_000_000},
+					{Price: 4_600_000_000, Quantums: 100_000_000},
+				},
+				MaxLiquidationFeePpm: 5_000,
+			},
+			response: &types.QueryLiquidationProceedsEstimateResponse{
+				FilledQuantums:                  dtypes.NewInt(100_000_000),
+				ProceedsQuoteQuantums:           dtypes.NewInt(47_500_000_000),
+				InsuranceFundDeltaQuoteQuantums: dtypes.NewInt(122_500_000 + 23_000_000_000 - 23_999_999_995),
+			},
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ :=
+				keepertest.SubaccountsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			// 1 BTC long with -$48,000 of USDC, which is bankrupt below a BTC price of $48,000.
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-48_000_000_000)),
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+				},
+			})
+
+			response, err := keeper.LiquidationProceedsEstimate(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			if tc.errCode != codes.OK {
+				require.Equal(t, tc.errCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.response, response)
+		})
+	}
+}
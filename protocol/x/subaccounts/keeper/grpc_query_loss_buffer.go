@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LossBufferToLiquidation returns how much a subaccount can lose on its position in a perpetual before it
+// becomes liquidatable, as returned by `GetLossBufferToLiquidation`.
+func (k Keeper) LossBufferToLiquidation(
+	c context.Context,
+	req *types.QueryLossBufferToLiquidationRequest,
+) (*types.QueryLossBufferToLiquidationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	subaccountId := types.SubaccountId{
+		Owner:  req.Owner,
+		Number: req.Number,
+	}
+	if err := subaccountId.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	lossBuffer, priceMove, found, err := k.GetLossBufferToLiquidation(ctx, subaccountId, req.PerpetualId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return &types.QueryLossBufferToLiquidationResponse{}, nil
+	}
+
+	return &types.QueryLossBufferToLiquidationResponse{
+		Found:      true,
+		LossBuffer: dtypes.NewIntFromBigInt(lossBuffer),
+		PriceMove:  priceMove,
+	}, nil
+}
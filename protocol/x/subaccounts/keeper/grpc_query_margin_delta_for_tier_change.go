@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MarginDeltaForTierChange returns the change in the margin requirements of a subaccount if a perpetual were
+// migrated to a proposed liquidity tier, as returned by `GetMarginDeltaForTierChange`.
+func (k Keeper) MarginDeltaForTierChange(
+	c context.Context,
+	req *types.QueryMarginDeltaForTierChangeRequest,
+) (*types.QueryMarginDeltaForTierChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	subaccountId := types.SubaccountId{
+		Owner:  req.Owner,
+		Number: req.Number,
+	}
+	if err := subaccountId.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := req.ProposedTier.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	imrDelta, mmrDelta, err := k.GetMarginDeltaForTierChange(ctx, subaccountId, req.PerpetualId, req.ProposedTier)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMarginDeltaForTierChangeResponse{
+		InitialMarginRequirementDelta:     dtypes.NewIntFromBigInt(imrDelta),
+		MaintenanceMarginRequirementDelta: dtypes.NewIntFromBigInt(mmrDelta),
+	}, nil
+}
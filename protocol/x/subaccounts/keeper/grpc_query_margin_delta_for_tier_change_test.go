@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestQueryMarginDeltaForTierChange(t *testing.T) {
+	for testName, tc := range map[string]struct {
+		// Parameters
+		request                *types.QueryMarginDeltaForTierChangeRequest
+		initialMarginPpm       uint32
+		maintenanceFractionPpm uint32
+
+		// Expectations
+		response *types.QueryMarginDeltaForTierChangeResponse
+		errCode  codes.Code
+		err      error
+	}{
+		"Nil request results in error": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"Invalid owner results in error": {
+			request: &types.QueryMarginDeltaForTierChangeRequest{
+				Owner: "invalid",
+			},
+			err: status.Error(
+				codes.InvalidArgument,
+				"invalid SubaccountId Owner address (invalid). Error: (decoding bech32 failed: invalid bech32 "+
+					"string length 7): subaccount id owner is an invalid address",
+			),
+		},
+		"Invalid proposed tier results in error": {
+			request: &types.QueryMarginDeltaForTierChangeRequest{
+				Owner:       constants.Alice_Num0.Owner,
+				Number:      constants.Alice_Num0.Number,
+				PerpetualId: 0,
+			},
+			initialMarginPpm:       1_000_001,
+			maintenanceFractionPpm: 500_000,
+			errCode:                codes.InvalidArgument,
+		},
+		"Success": {
+			request: &types.QueryMarginDeltaForTierChangeRequest{
+				Owner:       constants.Alice_Num0.Owner,
+				Number:      constants.Alice_Num0.Number,
+				PerpetualId: 0,
+			},
+			// The IMR of 1 BTC worth $50,000 increases from $10,000 to $15,000 and the MMR from $5,000 to
+			// $12,000.
+			initialMarginPpm:       300_000,
+			maintenanceFractionPpm: 800_000,
+			response: &types.QueryMarginDeltaForTierChangeResponse{
+				InitialMarginRequirementDelta:     dtypes.NewInt(5_000_000_000),
+				MaintenanceMarginRequirementDelta: dtypes.NewInt(7_000_000_000),
+			},
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ :=
+				keepertest.SubaccountsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-30_000_000_000)),
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+				},
+			})
+
+			if tc.request != nil {
+				tier, err := perpetualsKeeper.GetLiquidityTier(ctx, p.Params.LiquidityTier)
+				require.NoError(t, err)
+				tc.request.ProposedTier = tier
+				tc.request.ProposedTier.InitialMarginPpm = tc.initialMarginPpm
+				tc.request.ProposedTier.MaintenanceFractionPpm = tc.maintenanceFractionPpm
+			}
+
+			response, err := keeper.MarginDeltaForTierChange(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			if tc.errCode != codes.OK {
+				require.Equal(t, tc.errCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.response, response)
+		})
+	}
+}
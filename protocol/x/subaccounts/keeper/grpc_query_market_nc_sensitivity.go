@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MarketNcSensitivity returns the net quantums of all positions in a perpetual and the change in the total
+// net collateral per unit of its market price, as returned by `GetMarketNcSensitivity`.
+func (k Keeper) MarketNcSensitivity(
+	c context.Context,
+	req *types.QueryMarketNcSensitivityRequest,
+) (*types.QueryMarketNcSensitivityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	netQuantums, ncPerPriceUnit, err := k.GetMarketNcSensitivity(ctx, req.PerpetualId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMarketNcSensitivityResponse{
+		NetQuantums:    dtypes.NewIntFromBigInt(netQuantums),
+		NcPerPriceUnit: ncPerPriceUnit.RatString(),
+	}, nil
+}
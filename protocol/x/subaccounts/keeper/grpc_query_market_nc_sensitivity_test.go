@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestQueryMarketNcSensitivity(t *testing.T) {
+	for testName, tc := range map[string]struct {
+		// Parameters
+		request *types.QueryMarketNcSensitivityRequest
+
+		// Expectations
+		response *types.QueryMarketNcSensitivityResponse
+		err      error
+	}{
+		"Nil request results in error": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"Success": {
+			request: &types.QueryMarketNcSensitivityRequest{
+				PerpetualId: 0,
+			},
+			// Net short 0.75 BTC.
+			response: &types.QueryMarketNcSensitivityResponse{
+				NetQuantums:    dtypes.NewInt(-75_000_000),
+				NcPerPriceUnit: "-15/2",
+			},
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ :=
+				keepertest.SubaccountsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			ids := []types.SubaccountId{constants.Alice_Num0, constants.Bob_Num0}
+			for i, quantums := range []int64{-100_000_000, 25_000_000} {
+				keeper.SetSubaccount(ctx, types.Subaccount{
+					Id:             &ids[i],
+					AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(100_000_000_000)),
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(0, big.NewInt(quantums), big.NewInt(0), big.NewInt(0)),
+					},
+				})
+			}
+
+			response, err := keeper.MarketNcSensitivity(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.response, response)
+		})
+	}
+}
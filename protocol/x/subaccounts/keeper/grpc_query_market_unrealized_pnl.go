@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MarketUnrealizedPnl returns the sum of the unrealized PnL of the positions of all subaccounts in a
+// perpetual, as returned by `GetMarketUnrealizedPnl`.
+func (k Keeper) MarketUnrealizedPnl(
+	c context.Context,
+	req *types.QueryMarketUnrealizedPnlRequest,
+) (*types.QueryMarketUnrealizedPnlResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	pnl, err := k.GetMarketUnrealizedPnl(ctx, req.PerpetualId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMarketUnrealizedPnlResponse{
+		Pnl: dtypes.NewIntFromBigInt(pnl),
+	}, nil
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestQueryMarketUnrealizedPnl(t *testing.T) {
+	for testName, tc := range map[string]struct {
+		// Parameters
+		request *types.QueryMarketUnrealizedPnlRequest
+
+		// Expectations
+		response *types.QueryMarketUnrealizedPnlResponse
+		err      error
+	}{
+		"Nil request results in error": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"Success": {
+			request: &types.QueryMarketUnrealizedPnlRequest{
+				PerpetualId: 0,
+			},
+			// The long entered at $40,000 gains $10,000 and the short entered at $45,000 loses $5,000.
+			response: &types.QueryMarketUnrealizedPnlResponse{
+				Pnl: dtypes.NewInt(5_000_000_000),
+			},
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ :=
+				keepertest.SubaccountsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id: &constants.Alice_Num0,
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPositionWithCostBasis(
+						0,
+						big.NewInt(100_000_000),
+						big.NewInt(0),
+						big.NewInt(0),
+						big.NewInt(40_000_000_000),
+					),
+				},
+			})
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id: &constants.Bob_Num0,
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPositionWithCostBasis(
+						0,
+						big.NewInt(-100_000_000),
+						big.NewInt(0),
+						big.NewInt(0),
+						big.NewInt(-45_000_000_000),
+					),
+				},
+			})
+
+			response, err := keeper.MarketUnrealizedPnl(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.response, response)
+		})
+	}
+}
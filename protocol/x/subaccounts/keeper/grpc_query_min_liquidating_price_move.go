@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MinLiquidatingPriceMove returns the smallest adverse move in the oracle price of a perpetual that makes
+// a subaccount liquidatable, as returned by `GetMinLiquidatingPriceMove`.
+func (k Keeper) MinLiquidatingPriceMove(
+	c context.Context,
+	req *types.QueryMinLiquidatingPriceMoveRequest,
+) (*types.QueryMinLiquidatingPriceMoveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	movePpm, subaccountId, found, err := k.GetMinLiquidatingPriceMove(ctx, req.PerpetualId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return &types.QueryMinLiquidatingPriceMoveResponse{}, nil
+	}
+
+	return &types.QueryMinLiquidatingPriceMoveResponse{
+		Found:        true,
+		MovePpm:      movePpm,
+		SubaccountId: subaccountId,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PositionNotional returns the absolute notional of the position of a subaccount in a perpetual, as
+// returned by `GetPositionNotional`.
+func (k Keeper) PositionNotional(
+	c context.Context,
+	req *types.QueryPositionNotionalRequest,
+) (*types.QueryPositionNotionalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	subaccountId := types.SubaccountId{
+		Owner:  req.Owner,
+		Number: req.Number,
+	}
+	if err := subaccountId.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	notional, err := k.GetPositionNotional(ctx, subaccountId, req.PerpetualId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPositionNotionalResponse{
+		Notional: dtypes.NewIntFromBigInt(notional),
+	}, nil
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ProtocolNetDelta returns the sum of the signed quantums of the positions of all subaccounts in a
+// perpetual, as returned by `GetProtocolNetDelta`.
+func (k Keeper) ProtocolNetDelta(
+	c context.Context,
+	req *types.QueryProtocolNetDeltaRequest,
+) (*types.QueryProtocolNetDeltaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	return &types.QueryProtocolNetDeltaResponse{
+		NetDelta: dtypes.NewIntFromBigInt(k.GetProtocolNetDelta(ctx, req.PerpetualId)),
+	}, nil
+}
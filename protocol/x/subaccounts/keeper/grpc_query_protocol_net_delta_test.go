@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestQueryProtocolNetDelta(t *testing.T) {
+	for testName, tc := range map[string]struct {
+		// Parameters
+		request *types.QueryProtocolNetDeltaRequest
+
+		// Expectations
+		response *types.QueryProtocolNetDeltaResponse
+		err      error
+	}{
+		"Nil request results in error": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"Success": {
+			request: &types.QueryProtocolNetDeltaRequest{
+				PerpetualId: 0,
+			},
+			response: &types.QueryProtocolNetDeltaResponse{
+				NetDelta: dtypes.NewInt(25_000_000),
+			},
+		},
+		"No positions": {
+			request: &types.QueryProtocolNetDeltaRequest{
+				PerpetualId: 1,
+			},
+			response: &types.QueryProtocolNetDeltaResponse{
+				NetDelta: dtypes.NewInt(0),
+			},
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, keeper, _, _, _, _, _, _, _, _, _ := keepertest.SubaccountsKeepers(t, true)
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id: &constants.Alice_Num0,
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+				},
+			})
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id: &constants.Bob_Num0,
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(0, big.NewInt(-75_000_000), big.NewInt(0), big.NewInt(0)),
+				},
+			})
+
+			response, err := keeper.ProtocolNetDelta(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.response, response)
+		})
+	}
+}
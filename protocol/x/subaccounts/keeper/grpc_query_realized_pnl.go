@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RealizedPnlOnClose returns the PnL a subaccount would realize by closing its position in a perpetual at
+// the current market price, as returned by `GetRealizedPnlOnClose`.
+func (k Keeper) RealizedPnlOnClose(
+	c context.Context,
+	req *types.QueryRealizedPnlOnCloseRequest,
+) (*types.QueryRealizedPnlOnCloseResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	subaccountId := types.SubaccountId{
+		Owner:  req.Owner,
+		Number: req.Number,
+	}
+	if err := subaccountId.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	pnl, err := k.GetRealizedPnlOnClose(ctx, subaccountId, req.PerpetualId)
+	if err != nil {
+		if errors.Is(err, types.ErrPerpPositionCostBasisUnknown) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRealizedPnlOnCloseResponse{
+		Pnl: dtypes.NewIntFromBigInt(pnl),
+	}, nil
+}
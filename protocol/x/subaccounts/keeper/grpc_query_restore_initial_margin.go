@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ActionToRestoreInitialMargin returns the closes of the positions of a subaccount that each make it
+// initially collateralized, as returned by `GetActionToRestoreInitialMargin`.
+func (k Keeper) ActionToRestoreInitialMargin(
+	c context.Context,
+	req *types.QueryActionToRestoreInitialMarginRequest,
+) (*types.QueryActionToRestoreInitialMarginResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	subaccountId := types.SubaccountId{
+		Owner:  req.Owner,
+		Number: req.Number,
+	}
+	if err := subaccountId.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	action, err := k.GetActionToRestoreInitialMargin(ctx, subaccountId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &types.QueryActionToRestoreInitialMarginResponse{
+		InitiallyCollateralized: action.InitiallyCollateralized,
+		Closes:                  make([]types.InitialMarginRestoringClose, len(action.Closes)),
+	}
+	for i, positionClose := range action.Closes {
+		response.Closes[i] = types.InitialMarginRestoringClose{
+			PerpetualId: positionClose.PerpetualId,
+			Quantums:    dtypes.NewIntFromBigInt(positionClose.Quantums),
+			AbsNotional: dtypes.NewIntFromBigInt(positionClose.AbsNotional),
+		}
+		if action.Cheapest == &action.Closes[i] {
+			response.Cheapest = &response.Closes[i]
+		}
+	}
+	return response, nil
+}
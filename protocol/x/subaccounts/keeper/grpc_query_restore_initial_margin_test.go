@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestQueryActionToRestoreInitialMargin(t *testing.T) {
+	for testName, tc := range map[string]struct {
+		// Parameters
+		request *types.QueryActionToRestoreInitialMarginRequest
+
+		// Expectations
+		response *types.QueryActionToRestoreInitialMarginResponse
+		err      error
+	}{
+		"Nil request results in error": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"Invalid owner results in error": {
+			request: &types.QueryActionToRestoreInitialMarginRequest{
+				Owner: "invalid",
+			},
+			err: status.Error(
+				codes.InvalidArgument,
+				"invalid SubaccountId Owner address (invalid). Error: (decoding bech32 failed: invalid bech32 "+
+					"string length 7): subaccount id owner is an invalid address",
+			),
+		},
+		"Initially collateralized": {
+			request: &types.QueryActionToRestoreInitialMarginRequest{
+				Owner:  constants.Bob_Num0.Owner,
+				Number: constants.Bob_Num0.Number,
+			},
+			response: &types.QueryActionToRestoreInitialMarginResponse{
+				InitiallyCollateralized: true,
+				Closes:                  []types.InitialMarginRestoringClose{},
+			},
+		},
+		"Success": {
+			request: &types.QueryActionToRestoreInitialMarginRequest{
+				Owner:  constants.Alice_Num0.Owner,
+				Number: constants.Alice_Num0.Number,
+			},
+			// NC of $11,000 is between the MMR of $8,000 and the IMR of $13,000.
+			response: &types.QueryActionToRestoreInitialMarginResponse{
+				Closes: []types.InitialMarginRestoringClose{
+					{
+						PerpetualId: 0,
+						Quantums:    dtypes.NewInt(20_000_000), // 0.2 BTC
+						AbsNotional: dtypes.NewInt(10_000_000_000),
+					},
+					{
+						PerpetualId: 1,
+						Quantums:    dtypes.NewInt(666_666_667), // ~0.67 ETH
+						AbsNotional: dtypes.NewInt(2_000_000_001),
+					},
+				},
+				Cheapest: &types.InitialMarginRestoringClose{
+					PerpetualId: 1,
+					Quantums:    dtypes.NewInt(666_666_667),
+					AbsNotional: dtypes.NewInt(2_000_000_001),
+				},
+			},
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ :=
+				keepertest.SubaccountsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			for _, p := range []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				constants.EthUsd_100PercentMarginRequirement,
+			} {
+				_, err := perpetualsKeeper.CreatePerpetual(
+					ctx,
+					p.Params.Id,
+					p.Params.Ticker,
+					p.Params.MarketId,
+					p.Params.AtomicResolution,
+					p.Params.DefaultFundingPpm,
+					p.Params.LiquidityTier,
+					p.Params.MarketType,
+				)
+				require.NoError(t, err)
+			}
+
+			// 1 BTC at $50,000 and 1 ETH at $3,000.
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-42_000_000_000)),
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+					testutil.CreateSinglePerpetualPosition(1, big.NewInt(1_000_000_000), big.NewInt(0), big.NewInt(0)),
+				},
+			})
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Bob_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)),
+			})
+
+			response, err := keeper.ActionToRestoreInitialMargin(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.response, response)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RiskAtHeight returns the risk of a subaccount as of the end of a past block, as returned by
+// `GetRiskAtHeight`.
+func (k Keeper) RiskAtHeight(
+	c context.Context,
+	req *types.QueryRiskAtHeightRequest,
+) (*types.QueryRiskAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	subaccountId := types.SubaccountId{
+		Owner:  req.Owner,
+		Number: req.Number,
+	}
+	if err := subaccountId.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	risk, err := k.GetRiskAtHeight(ctx, subaccountId, req.Height)
+	if err != nil {
+		if errors.Is(err, types.ErrHistoricalStateUnavailable) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRiskAtHeightResponse{
+		NetCollateral:                dtypes.NewIntFromBigInt(risk.NC),
+		InitialMarginRequirement:     dtypes.NewIntFromBigInt(risk.IMR),
+		MaintenanceMarginRequirement: dtypes.NewIntFromBigInt(risk.MMR),
+	}, nil
+}
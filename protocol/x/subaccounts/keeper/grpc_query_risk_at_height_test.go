@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestQueryRiskAtHeight(t *testing.T) {
+	for testName, tc := range map[string]struct {
+		// Parameters
+		request *types.QueryRiskAtHeightRequest
+
+		// Expectations
+		response *types.QueryRiskAtHeightResponse
+		errCode  codes.Code
+		err      error
+	}{
+		"Nil request results in error": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"Invalid owner results in error": {
+			request: &types.QueryRiskAtHeightRequest{
+				Owner: "invalid",
+			},
+			err: status.Error(
+				codes.InvalidArgument,
+				"invalid SubaccountId Owner address (invalid). Error: (decoding bech32 failed: invalid bech32 "+
+					"string length 7): subaccount id owner is an invalid address",
+			),
+		},
+		"Unavailable height results in error": {
+			request: &types.QueryRiskAtHeightRequest{
+				Owner:  constants.Alice_Num0.Owner,
+				Number: constants.Alice_Num0.Number,
+				Height: 2,
+			},
+			errCode: codes.NotFound,
+		},
+		"Success": {
+			request: &types.QueryRiskAtHeightRequest{
+				Owner:  constants.Alice_Num0.Owner,
+				Number: constants.Alice_Num0.Number,
+				Height: 1,
+			},
+			// 1 BTC at $50,000 with NC = $50,000 - $44,000.
+			response: &types.QueryRiskAtHeightResponse{
+				NetCollateral:                dtypes.NewInt(6_000_000_000),
+				InitialMarginRequirement:     dtypes.NewInt(10_000_000_000),
+				MaintenanceMarginRequirement: dtypes.NewInt(5_000_000_000),
+			},
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ :=
+				keepertest.SubaccountsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-44_000_000_000)),
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+				},
+			})
+			height1Store := ctx.MultiStore()
+			keeper.SetHistoricalMultiStoreProvider(func(height int64) (storetypes.MultiStore, error) {
+				if height == 1 {
+					return height1Store, nil
+				}
+				return nil, fmt.Errorf("version %d does not exist", height)
+			})
+			ctx = ctx.WithBlockHeight(2)
+
+			response, err := keeper.RiskAtHeight(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			if tc.errCode != codes.OK {
+				require.Equal(t, tc.errCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.response, response)
+		})
+	}
+}
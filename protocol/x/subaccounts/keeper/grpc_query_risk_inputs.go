@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RiskInputs returns the inputs used to compute the current risk of a subaccount, as returned by
+// `GetRiskInputs`. The perpetuals are sorted by id.
+func (k Keeper) RiskInputs(
+	c context.Context,
+	req *types.QueryRiskInputsRequest,
+) (*types.QueryRiskInputsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	subaccountId := types.SubaccountId{
+		Owner:  req.Owner,
+		Number: req.Number,
+	}
+	if err := subaccountId.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	inputs, err := k.GetRiskInputs(ctx, subaccountId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	perpetuals := make([]types.PerpetualRiskInputs, 0, len(inputs.PerpInfos))
+	for _, perpId := range lib.GetSortedKeys[lib.Sortable[uint32]](inputs.PerpInfos) {
+		perpInfo := inputs.PerpInfos[perpId]
+		perpetuals = append(perpetuals, types.PerpetualRiskInputs{
+			Perpetual:     perpInfo.Perpetual,
+			MarketPrice:   perpInfo.Price,
+			LiquidityTier: perpInfo.LiquidityTier,
+		})
+	}
+
+	return &types.QueryRiskInputsResponse{
+		SettledSubaccount:  inputs.SettledSubaccount,
+		Perpetuals:         perpetuals,
+		ExternalCollateral: dtypes.NewIntFromBigInt(inputs.ExternalCollateral),
+		LockedCollateral:   inputs.LockedCollateral,
+	}, nil
+}
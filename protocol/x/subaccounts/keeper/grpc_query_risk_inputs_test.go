@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestQueryRiskInputs(t *testing.T) {
+	for testName, tc := range map[string]struct {
+		// Parameters
+		request *types.QueryRiskInputsRequest
+
+		// Expectations
+		err error
+	}{
+		"Nil request results in error": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"Invalid owner results in error": {
+			request: &types.QueryRiskInputsRequest{
+				Owner: "invalid",
+			},
+			err: status.Error(
+				codes.InvalidArgument,
+				"invalid SubaccountId Owner address (invalid). Error: (decoding bech32 failed: invalid bech32 "+
+					"string length 7): subaccount id owner is an invalid address",
+			),
+		},
+		"Success": {
+			request: &types.QueryRiskInputsRequest{
+				Owner:  constants.Alice_Num0.Owner,
+				Number: constants.Alice_Num0.Number,
+			},
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ :=
+				keepertest.SubaccountsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			keepertest.CreateTestPerpetuals(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)),
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(1, big.NewInt(-1_000_000_000), big.NewInt(0), big.NewInt(0)),
+					testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+				},
+			})
+			keeper.SetLockedCollateral(ctx, constants.Alice_Num0, 1_000_000_000)
+
+			response, err := keeper.RiskInputs(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			inputs, err := keeper.GetRiskInputs(ctx, constants.Alice_Num0)
+			require.NoError(t, err)
+			require.Equal(t, inputs.SettledSubaccount, response.SettledSubaccount)
+			require.Equal(t, dtypes.NewInt(0), response.ExternalCollateral)
+			require.Equal(t, uint64(1_000_000_000), response.LockedCollateral)
+
+			// The perpetuals are sorted by id.
+			require.Len(t, response.Perpetuals, 2)
+			for i, perpetual := range response.Perpetuals {
+				perpInfo := inputs.PerpInfos.MustGet(uint32(i))
+				require.Equal(t, perpInfo.Perpetual, perpetual.Perpetual)
+				require.Equal(t, perpInfo.Price, perpetual.MarketPrice)
+				require.Equal(t, perpInfo.LiquidityTier, perpetual.LiquidityTier)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RiskUnderHistoricalShocks returns the risk of a subaccount with each of its positions shocked by the worst
+// historical single-day move of its market, as returned by `GetRiskUnderHistoricalShocks`.
+func (k Keeper) RiskUnderHistoricalShocks(
+	c context.Context,
+	req *types.QueryRiskUnderHistoricalShocksRequest,
+) (*types.QueryRiskUnderHistoricalShocksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	subaccountId := types.SubaccountId{
+		Owner:  req.Owner,
+		Number: req.Number,
+	}
+	if err := subaccountId.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	worstMovesPpm := make(map[uint32]uint32, len(req.WorstMoves))
+	for _, move := range req.WorstMoves {
+		if _, exists := worstMovesPpm[move.PerpetualId]; exists {
+			return nil, status.Error(
+				codes.InvalidArgument,
+				fmt.Sprintf("duplicate move for perpetual %d", move.PerpetualId),
+			)
+		}
+		worstMovesPpm[move.PerpetualId] = move.MovePpm
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	risk, err := k.GetRiskUnderHistoricalShocks(ctx, subaccountId, worstMovesPpm)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRiskUnderHistoricalShocksResponse{
+		NetCollateral:                dtypes.NewIntFromBigInt(risk.NC),
+		InitialMarginRequirement:     dtypes.NewIntFromBigInt(risk.IMR),
+		MaintenanceMarginRequirement: dtypes.NewIntFromBigInt(risk.MMR),
+	}, nil
+}
@@ -26,8 +26,8 @@ func (k Keeper) SubaccountAll(
 	subaccountStore := prefix.NewStore(store, []byte(types.SubaccountKeyPrefix))
 
 	pageRes, err := query.Paginate(subaccountStore, req.Pagination, func(key []byte, value []byte) error {
-		var subaccount types.Subaccount
-		if err := k.cdc.Unmarshal(value, &subaccount); err != nil {
+		subaccount, _, err := types.DecodeSubaccountRecord(k.cdc, value)
+		if err != nil {
 			return err
 		}
 
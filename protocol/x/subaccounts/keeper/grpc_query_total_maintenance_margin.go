@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TotalMaintenanceMargin returns the sum of the maintenance margin requirements of all subaccounts, as
+// returned by `GetTotalMaintenanceMargin`. The per-perpetual totals are sorted by perpetual id.
+func (k Keeper) TotalMaintenanceMargin(
+	c context.Context,
+	req *types.QueryTotalMaintenanceMarginRequest,
+) (*types.QueryTotalMaintenanceMarginResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	total, perPerpetual, err := k.GetTotalMaintenanceMargin(ctx, req.ByPerpetual)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var perpetuals []types.PerpetualMaintenanceMargin
+	for _, perpetualId := range lib.GetSortedKeys[lib.Sortable[uint32]](perPerpetual) {
+		perpetuals = append(perpetuals, types.PerpetualMaintenanceMargin{
+			PerpetualId:       perpetualId,
+			MaintenanceMargin: dtypes.NewIntFromBigInt(perPerpetual[perpetualId]),
+		})
+	}
+
+	return &types.QueryTotalMaintenanceMarginResponse{
+		Total:      dtypes.NewIntFromBigInt(total),
+		Perpetuals: perpetuals,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TotalValueLocked returns the total value locked across all subaccounts and the total negative net
+// collateral, as returned by `GetTotalValueLocked`.
+func (k Keeper) TotalValueLocked(
+	c context.Context,
+	req *types.QueryTotalValueLockedRequest,
+) (*types.QueryTotalValueLockedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	totalValueLocked, totalNegativeNetCollateral, err := k.GetTotalValueLocked(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTotalValueLockedResponse{
+		TotalValueLocked:           dtypes.NewIntFromBigInt(totalValueLocked),
+		TotalNegativeNetCollateral: dtypes.NewIntFromBigInt(totalNegativeNetCollateral),
+	}, nil
+}
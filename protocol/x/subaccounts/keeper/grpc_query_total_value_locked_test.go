@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestQueryTotalValueLocked(t *testing.T) {
+	for testName, tc := range map[string]struct {
+		// Parameters
+		request *types.QueryTotalValueLockedRequest
+
+		// Expectations
+		response *types.QueryTotalValueLockedResponse
+		err      error
+	}{
+		"Nil request results in error": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"Success": {
+			request: &types.QueryTotalValueLockedRequest{},
+			response: &types.QueryTotalValueLockedResponse{
+				TotalValueLocked:           dtypes.NewInt(10_000_000_000),
+				TotalNegativeNetCollateral: dtypes.NewInt(-1_000_000_000),
+			},
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, keeper, _, _, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(t, true)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)),
+			})
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Bob_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-1_000_000_000)),
+			})
+
+			response, err := keeper.TotalValueLocked(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.response, response)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SubaccountUtilization returns the utilization of a subaccount, as returned by
+// `GetSubaccountUtilization`, in parts-per-million rounded up.
+func (k Keeper) SubaccountUtilization(
+	c context.Context,
+	req *types.QuerySubaccountUtilizationRequest,
+) (*types.QuerySubaccountUtilizationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	subaccountId := types.SubaccountId{
+		Owner:  req.Owner,
+		Number: req.Number,
+	}
+	if err := subaccountId.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	utilization, err := k.GetSubaccountUtilization(ctx, subaccountId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if utilization.Sign() < 0 {
+		return &types.QuerySubaccountUtilizationResponse{OverUtilized: true}, nil
+	}
+
+	utilizationPpm := lib.BigRatRound(new(big.Rat).Mul(utilization, lib.BigRatOneMillion()), true)
+	return &types.QuerySubaccountUtilizationResponse{
+		UtilizationPpm: utilizationPpm.Uint64(),
+	}, nil
+}
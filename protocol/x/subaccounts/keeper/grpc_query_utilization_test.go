@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestQuerySubaccountUtilization(t *testing.T) {
+	aliceRequest := &types.QuerySubaccountUtilizationRequest{
+		Owner:  constants.Alice_Num0.Owner,
+		Number: constants.Alice_Num0.Number,
+	}
+
+	for testName, tc := range map[string]struct {
+		// Parameters
+		request      *types.QuerySubaccountUtilizationRequest
+		usdcQuantums *big.Int
+
+		// Expectations
+		response *types.QuerySubaccountUtilizationResponse
+		err      error
+	}{
+		"Nil request results in error": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"Invalid owner results in error": {
+			request: &types.QuerySubaccountUtilizationRequest{
+				Owner: "invalid",
+			},
+			err: status.Error(
+				codes.InvalidArgument,
+				"invalid SubaccountId Owner address (invalid). Error: (decoding bech32 failed: invalid bech32 "+
+					"string length 7): subaccount id owner is an invalid address",
+			),
+		},
+		"Healthy subaccount": {
+			// NC = $50,000 - $30,000 = $20,000, IMR = $50,000 * 20% = $10,000.
+			request:      aliceRequest,
+			usdcQuantums: big.NewInt(-30_000_000_000),
+			response:     &types.QuerySubaccountUtilizationResponse{UtilizationPpm: 500_000},
+		},
+		"Subaccount below initial margin": {
+			// NC = $50,000 - $46,000 = $4,000, IMR = $10,000.
+			request:      aliceRequest,
+			usdcQuantums: big.NewInt(-46_000_000_000),
+			response:     &types.QuerySubaccountUtilizationResponse{UtilizationPpm: 2_500_000},
+		},
+		"Utilization is rounded up": {
+			// NC = $50,000 - $20,000 = $30,000, IMR = $10,000.
+			request:      aliceRequest,
+			usdcQuantums: big.NewInt(-20_000_000_000),
+			response:     &types.QuerySubaccountUtilizationResponse{UtilizationPpm: 333_334},
+		},
+		"Subaccount with negative net collateral is over-utilized": {
+			// NC = $50,000 - $51,000 = -$1,000.
+			request:      aliceRequest,
+			usdcQuantums: big.NewInt(-51_000_000_000),
+			response:     &types.QuerySubaccountUtilizationResponse{OverUtilized: true},
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ :=
+				keepertest.SubaccountsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			if tc.usdcQuantums != nil {
+				keeper.SetSubaccount(ctx, types.Subaccount{
+					Id:             &constants.Alice_Num0,
+					AssetPositions: testutil.CreateUsdcAssetPositions(tc.usdcQuantums),
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(
+							0,
+							big.NewInt(100_000_000), // 1 BTC
+							big.NewInt(0),
+							big.NewInt(0),
+						),
+					},
+				})
+			}
+
+			response, err := keeper.SubaccountUtilization(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
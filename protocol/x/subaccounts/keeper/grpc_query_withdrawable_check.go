@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WithdrawableCheck returns whether a withdrawal from a subaccount would be allowed and the maximum amount
+// that can be withdrawn, as returned by `GetWithdrawableCheck`.
+func (k Keeper) WithdrawableCheck(
+	c context.Context,
+	req *types.QueryWithdrawableCheckRequest,
+) (*types.QueryWithdrawableCheckResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	subaccountId := types.SubaccountId{
+		Owner:  req.Owner,
+		Number: req.Number,
+	}
+	if err := subaccountId.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.QuoteQuantums == 0 {
+		return nil, status.Error(codes.InvalidArgument, "quote quantums must be positive")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	allowed, maxWithdrawable, err := k.GetWithdrawableCheck(
+		ctx,
+		subaccountId,
+		new(big.Int).SetUint64(req.QuoteQuantums),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryWithdrawableCheckResponse{
+		Allowed:         allowed,
+		MaxWithdrawable: dtypes.NewIntFromBigInt(maxWithdrawable),
+	}, nil
+}
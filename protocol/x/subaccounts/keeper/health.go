@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetSubaccountHealthPpm returns the ratio of the subaccount's net collateral to its maintenance
+// margin requirement in parts-per-million. Subaccounts with no maintenance margin requirement
+// have a health of `margin.MaxHealthPpm` rather than dividing by zero.
+func (k Keeper) GetSubaccountHealthPpm(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+) (
+	healthPpm uint64,
+	err error,
+) {
+	risk, err := k.GetNetCollateralAndMarginRequirements(ctx, types.Update{SubaccountId: subaccountId})
+	if err != nil {
+		return 0, err
+	}
+	return risk.HealthPpm(), nil
+}
+
+// IsSubaccountAtWarningLevel returns true if the subaccount's net collateral is less than
+// `warningLevelPpm` parts-per-million of its maintenance margin requirement. Subaccounts with
+// no maintenance margin requirement are never at the warning level.
+func (k Keeper) IsSubaccountAtWarningLevel(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	warningLevelPpm uint32,
+) (
+	isAtWarningLevel bool,
+	err error,
+) {
+	risk, err := k.GetNetCollateralAndMarginRequirements(ctx, types.Update{SubaccountId: subaccountId})
+	if err != nil {
+		return false, err
+	}
+	return risk.IsAtWarningLevel(warningLevelPpm), nil
+}
+
+// GetSubaccountUtilization returns the ratio of the subaccount's initial margin requirement to its net
+// collateral, including unsettled funding and any external collateral. Subaccounts with non-positive net
+// collateral have a utilization of `margin.OverUtilized`.
+func (k Keeper) GetSubaccountUtilization(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+) (
+	utilization *big.Rat,
+	err error,
+) {
+	risk, err := k.GetRiskForSubaccount(ctx, subaccountId, true)
+	if err != nil {
+		return nil, err
+	}
+	return risk.Utilization(), nil
+}
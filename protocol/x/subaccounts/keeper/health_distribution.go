@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetHealthDistribution returns the number of subaccounts in each health bucket, where `buckets` are the
+// strictly ascending upper bounds of the buckets as health in parts-per-million. `counts[i]` is the
+// number of subaccounts with a health of at least `buckets[i-1]` (or zero if `i` is zero) and less than
+// `buckets[i]`, and the last of the `len(buckets) + 1` counts is the number of subaccounts with a health
+// of at least the last bound, including subaccounts with no maintenance margin requirement. The health of
+// every subaccount is computed after settling funding, using a single snapshot of the perpetuals, prices
+// and liquidity tiers of all open positions.
+func (k Keeper) GetHealthDistribution(
+	ctx sdk.Context,
+	buckets []uint32,
+) (
+	counts []uint64,
+	err error,
+) {
+	for i := 1; i < len(buckets); i++ {
+		if buckets[i] <= buckets[i-1] {
+			return nil, fmt.Errorf("health buckets must be strictly ascending, got %v", buckets)
+		}
+	}
+
+	subaccounts := k.GetAllSubaccount(ctx)
+	updates := make([]types.Update, len(subaccounts))
+	for i, subaccount := range subaccounts {
+		updates[i] = types.Update{SubaccountId: *subaccount.Id}
+	}
+	perpInfos, err := k.GetAllRelevantPerpetuals(ctx, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	counts = make([]uint64, len(buckets)+1)
+	for _, subaccount := range subaccounts {
+		settledSubaccount, _ := salib.GetSettledSubaccountWithPerpetuals(subaccount, perpInfos)
+		risk, err := salib.GetRiskForSubaccount(settledSubaccount, perpInfos)
+		if err != nil {
+			return nil, err
+		}
+
+		healthPpm := risk.HealthPpm()
+		bucket := len(buckets)
+		for i, bound := range buckets {
+			if healthPpm < uint64(bound) {
+				bucket = i
+				break
+			}
+		}
+		counts[bucket]++
+	}
+	return counts, nil
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetHealthDistribution(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+		t,
+		true,
+	)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+
+	p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+	_, err := perpetualsKeeper.CreatePerpetual(
+		ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.MarketType,
+	)
+	require.NoError(t, err)
+
+	// Each subaccount holds 1 BTC with a maintenance margin requirement of $5,000.
+	longBtc := func(id types.SubaccountId, usdc int64) types.Subaccount {
+		return types.Subaccount{
+			Id:             &id,
+			AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(usdc)),
+			PerpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+		}
+	}
+	for _, subaccount := range []types.Subaccount{
+		// No maintenance margin requirement.
+		{
+			Id:             &constants.Alice_Num0,
+			AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)),
+		},
+		longBtc(constants.Alice_Num1, -45_000_000_000), // Health of 100%.
+		longBtc(constants.Bob_Num0, -46_000_000_000),   // Health of 80%.
+		longBtc(constants.Carl_Num0, -44_000_000_000),  // Health of 120%.
+		longBtc(constants.Dave_Num0, -40_000_000_000),  // Health of 200%.
+	} {
+		keeper.SetSubaccount(ctx, subaccount)
+	}
+
+	counts, err := keeper.GetHealthDistribution(ctx, []uint32{1_000_000, 1_500_000})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 2}, counts)
+
+	counts, err = keeper.GetHealthDistribution(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5}, counts)
+
+	_, err = keeper.GetHealthDistribution(ctx, []uint32{1_500_000, 1_000_000})
+	require.ErrorContains(t, err, "strictly ascending")
+	_, err = keeper.GetHealthDistribution(ctx, []uint32{1_000_000, 1_000_000})
+	require.ErrorContains(t, err, "strictly ascending")
+}
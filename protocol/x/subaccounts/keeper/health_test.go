@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSubaccountHealthPpm(t *testing.T) {
+	tests := map[string]struct {
+		assetPositions     []*types.AssetPosition
+		perpetualPositions []*types.PerpetualPosition
+
+		expectedHealthPpm        uint64
+		expectedIsAtWarningLevel bool
+	}{
+		"empty subaccount has no maintenance margin requirement": {
+			expectedHealthPpm:        margin.MaxHealthPpm,
+			expectedIsAtWarningLevel: false,
+		},
+		"USDC only subaccount has no maintenance margin requirement": {
+			assetPositions:           testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)), // $10,000
+			expectedHealthPpm:        margin.MaxHealthPpm,
+			expectedIsAtWarningLevel: false,
+		},
+		"negative USDC subaccount has no maintenance margin requirement": {
+			assetPositions:           testutil.CreateUsdcAssetPositions(big.NewInt(-10_000_000_000)), // -$10,000
+			expectedHealthPpm:        margin.MaxHealthPpm,
+			expectedIsAtWarningLevel: false,
+		},
+		"subaccount with a perpetual position": {
+			assetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-45_000_000_000)), // -$45,000
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(
+					0,
+					big.NewInt(100_000_000), // 1 BTC
+					big.NewInt(0),
+					big.NewInt(0),
+				),
+			},
+			// NC = $50,000 - $45,000 = $5,000, MMR = $50,000 * 10% = $5,000.
+			expectedHealthPpm:        1_000_000,
+			expectedIsAtWarningLevel: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:                 &constants.Alice_Num0,
+				AssetPositions:     tc.assetPositions,
+				PerpetualPositions: tc.perpetualPositions,
+			})
+
+			healthPpm, err := keeper.GetSubaccountHealthPpm(ctx, constants.Alice_Num0)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedHealthPpm, healthPpm)
+
+			isAtWarningLevel, err := keeper.IsSubaccountAtWarningLevel(ctx, constants.Alice_Num0, 1_500_000)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedIsAtWarningLevel, isAtWarningLevel)
+		})
+	}
+}
+
+func TestGetSubaccountUtilization(t *testing.T) {
+	tests := map[string]struct {
+		usdcQuantums *big.Int
+
+		expectedUtilization *big.Rat
+	}{
+		"healthy subaccount": {
+			// NC = $50,000 - $30,000 = $20,000, IMR = $50,000 * 20% = $10,000.
+			usdcQuantums:        big.NewInt(-30_000_000_000),
+			expectedUtilization: big.NewRat(1, 2),
+		},
+		"subaccount at initial margin": {
+			// NC = $50,000 - $40,000 = $10,000, IMR = $10,000.
+			usdcQuantums:        big.NewInt(-40_000_000_000),
+			expectedUtilization: big.NewRat(1, 1),
+		},
+		"subaccount below initial margin": {
+			// NC = $50,000 - $46,000 = $4,000, IMR = $10,000.
+			usdcQuantums:        big.NewInt(-46_000_000_000),
+			expectedUtilization: big.NewRat(5, 2),
+		},
+		"subaccount with negative net collateral": {
+			// NC = $50,000 - $51,000 = -$1,000.
+			usdcQuantums:        big.NewInt(-51_000_000_000),
+			expectedUtilization: big.NewRat(margin.OverUtilized, 1),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(tc.usdcQuantums),
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(
+						0,
+						big.NewInt(100_000_000), // 1 BTC
+						big.NewInt(0),
+						big.NewInt(0),
+					),
+				},
+			})
+
+			utilization, err := keeper.GetSubaccountUtilization(ctx, constants.Alice_Num0)
+			require.NoError(t, err)
+			require.Zero(t, tc.expectedUtilization.Cmp(utilization), utilization.String())
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// OpenHedgedPair atomically applies two perpetual updates in different perpetuals to the subaccount.
+// The pair is collateralized as a whole: the subaccount must meet its initial margin requirement after
+// both legs are applied, but not after either leg alone. Either both legs are applied or neither is.
+func (k Keeper) OpenHedgedPair(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	legA types.PerpetualUpdate,
+	legB types.PerpetualUpdate,
+) error {
+	if legA.PerpetualId == legB.PerpetualId {
+		return errorsmod.Wrapf(types.ErrInvalidHedgedPair, "perpetual id: %d", legA.PerpetualId)
+	}
+
+	update := types.Update{
+		SubaccountId:     subaccountId,
+		PerpetualUpdates: []types.PerpetualUpdate{legA, legB},
+	}
+	updates := []types.Update{update}
+	perpInfos, err := k.GetAllRelevantPerpetuals(ctx, updates)
+	if err != nil {
+		return err
+	}
+	settledUpdates, _, err := k.getSettledUpdates(ctx, updates, perpInfos, false)
+	if err != nil {
+		return err
+	}
+
+	updatedSubaccount := salib.CalculateUpdatedSubaccount(settledUpdates[0], perpInfos)
+	risk, err := salib.GetRiskForSubaccount(updatedSubaccount, perpInfos)
+	if err != nil {
+		return err
+	}
+	// Collateral locked by pending withdrawals does not count towards the net collateral.
+	risk.NC.Sub(risk.NC, new(big.Int).SetUint64(k.GetLockedCollateral(ctx, subaccountId)))
+	if !risk.IsInitialCollateralized() {
+		return errorsmod.Wrapf(
+			types.ErrHedgedPairUndercollateralized,
+			"subaccount %v, net collateral %v, initial margin requirement %v",
+			subaccountId,
+			risk.NC,
+			risk.IMR,
+		)
+	}
+
+	success, successPerUpdate, err := k.UpdateSubaccounts(ctx, updates, types.CollatCheck)
+	if err != nil {
+		return err
+	}
+	return types.GetErrorFromUpdateResults(success, successPerUpdate, updates)
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenHedgedPair(t *testing.T) {
+	// The subaccount starts with -$1,000 of USDC. Buying 1 BTC worth $50,000 for $39,500 adds $10,500 of net
+	// collateral and $10,000 of initial margin requirement, and selling 1 ETH worth $3,000 for $4,500 adds
+	// $1,500 of net collateral and $600 of initial margin requirement. Neither leg alone leaves the
+	// subaccount initially collateralized, but both legs together do.
+	longBtc := types.PerpetualUpdate{
+		PerpetualId:          0,
+		BigQuantumsDelta:     big.NewInt(100_000_000),     // 1 BTC
+		BigQuoteBalanceDelta: big.NewInt(-39_500_000_000), // -$39,500
+	}
+	shortEth := types.PerpetualUpdate{
+		PerpetualId:          1,
+		BigQuantumsDelta:     big.NewInt(-1_000_000_000), // -1 ETH
+		BigQuoteBalanceDelta: big.NewInt(4_500_000_000),  // $4,500
+	}
+
+	tests := map[string]struct {
+		legA types.PerpetualUpdate
+		legB types.PerpetualUpdate
+
+		expectedErr       error
+		expectedPositions []*types.PerpetualPosition
+	}{
+		"pair is collateralized as a whole": {
+			legA: longBtc,
+			legB: shortEth,
+			expectedPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(
+					0,
+					big.NewInt(100_000_000),
+					big.NewInt(0),
+					big.NewInt(-39_500_000_000),
+				),
+				testutil.CreateSinglePerpetualPosition(
+					1,
+					big.NewInt(-1_000_000_000),
+					big.NewInt(0),
+					big.NewInt(4_500_000_000),
+				),
+			},
+		},
+		"undercollateralized pair is not applied": {
+			legA: longBtc,
+			legB: types.PerpetualUpdate{
+				PerpetualId:          1,
+				BigQuantumsDelta:     big.NewInt(-1_000_000_000), // -1 ETH
+				BigQuoteBalanceDelta: big.NewInt(3_000_000_000),  // $3,000
+			},
+			expectedErr: types.ErrHedgedPairUndercollateralized,
+		},
+		"legs in the same perpetual": {
+			legA:        longBtc,
+			legB:        types.PerpetualUpdate{PerpetualId: 0, BigQuantumsDelta: big.NewInt(-50_000_000)},
+			expectedErr: types.ErrInvalidHedgedPair,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			for _, p := range []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				constants.EthUsd_20PercentInitial_10PercentMaintenance,
+			} {
+				_, err := perpetualsKeeper.CreatePerpetual(
+					ctx,
+					p.Params.Id,
+					p.Params.Ticker,
+					p.Params.MarketId,
+					p.Params.AtomicResolution,
+					p.Params.DefaultFundingPpm,
+					p.Params.LiquidityTier,
+					p.Params.MarketType,
+				)
+				require.NoError(t, err)
+			}
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-1_000_000_000)), // -$1,000
+			})
+
+			// Neither leg can be applied alone.
+			for _, leg := range []types.PerpetualUpdate{tc.legA, tc.legB} {
+				success, _, err := keeper.CanUpdateSubaccounts(
+					ctx,
+					[]types.Update{{SubaccountId: constants.Alice_Num0, PerpetualUpdates: []types.PerpetualUpdate{leg}}},
+					types.CollatCheck,
+				)
+				require.NoError(t, err)
+				require.False(t, success)
+			}
+
+			err := keeper.OpenHedgedPair(ctx, constants.Alice_Num0, tc.legA, tc.legB)
+			subaccount := keeper.GetSubaccount(ctx, constants.Alice_Num0)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Empty(t, subaccount.PerpetualPositions)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, subaccount.PerpetualPositions, len(tc.expectedPositions))
+			for i, expected := range tc.expectedPositions {
+				require.Equal(t, expected.PerpetualId, subaccount.PerpetualPositions[i].PerpetualId)
+				require.Equal(t, expected.GetBigQuantums(), subaccount.PerpetualPositions[i].GetBigQuantums())
+				require.Equal(t, expected.GetQuoteBalance(), subaccount.PerpetualPositions[i].GetQuoteBalance())
+			}
+		})
+	}
+}
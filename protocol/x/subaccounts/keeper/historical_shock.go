@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetRiskUnderHistoricalShocks returns the risk of the subaccount with each of its positions shocked by
+// the worst single-day move observed historically in its market, given in parts-per-million in
+// `worstMovesPpm` and keyed by perpetual id. See `salib.GetRiskForSubaccountUnderHistoricalShocks` for
+// how the moves are applied.
+func (k Keeper) GetRiskUnderHistoricalShocks(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	worstMovesPpm map[uint32]uint32,
+) (
+	risk margin.Risk,
+	err error,
+) {
+	inputs, err := k.GetRiskInputs(ctx, subaccountId)
+	if err != nil {
+		return margin.Risk{}, err
+	}
+	return salib.GetRiskForSubaccountUnderHistoricalShocks(inputs.SettledSubaccount, inputs.PerpInfos, worstMovesPpm)
+}
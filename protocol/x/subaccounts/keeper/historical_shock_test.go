@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRiskUnderHistoricalShocks(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+		t,
+		true,
+	)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+	for _, p := range []perptypes.Perpetual{
+		constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+		constants.EthUsd_20PercentInitial_10PercentMaintenance,
+	} {
+		_, err := perpetualsKeeper.CreatePerpetual(
+			ctx,
+			p.Params.Id,
+			p.Params.Ticker,
+			p.Params.MarketId,
+			p.Params.AtomicResolution,
+			p.Params.DefaultFundingPpm,
+			p.Params.LiquidityTier,
+			p.Params.MarketType,
+		)
+		require.NoError(t, err)
+	}
+
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:             &constants.Alice_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)), // $10,000
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),     // 1 BTC
+			testutil.CreateSinglePerpetualPosition(1, big.NewInt(-10_000_000_000), big.NewInt(0), big.NewInt(0)), // -10 ETH
+		},
+	})
+
+	tests := map[string]struct {
+		worstMovesPpm map[uint32]uint32
+
+		expectedRisk margin.Risk
+	}{
+		"no historical moves": {
+			// NC = $10,000 + $50,000 - $30,000, IMR = $10,000 + $6,000 and MMR = $5,000 + $3,000.
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(30_000_000_000),
+				IMR: big.NewInt(16_000_000_000),
+				MMR: big.NewInt(8_000_000_000),
+			},
+		},
+		"worst moves in both markets": {
+			// BTC falls by 20% to $40,000 against the long and ETH rises by 25% to $3,750 against the short.
+			// NC = $10,000 + $40,000 - $37,500, IMR = $8,000 + $7,500 and MMR = $4,000 + $3,750.
+			worstMovesPpm: map[uint32]uint32{0: 200_000, 1: 250_000},
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(12_500_000_000),
+				IMR: big.NewInt(15_500_000_000),
+				MMR: big.NewInt(7_750_000_000),
+			},
+		},
+		"worst move in one market": {
+			// Only BTC falls by 20% to $40,000.
+			worstMovesPpm: map[uint32]uint32{0: 200_000},
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(20_000_000_000),
+				IMR: big.NewInt(14_000_000_000),
+				MMR: big.NewInt(7_000_000_000),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			risk, err := keeper.GetRiskUnderHistoricalShocks(ctx, constants.Alice_Num0, tc.worstMovesPpm)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedRisk, risk)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// UpdateSubaccountsWithIdempotencyKey applies `updates` like `UpdateSubaccounts`, but at most once per
+// block for a given non-empty `idempotencyKey`. Once updates with a key are successfully applied,
+// any further call with the same key in the same block is a no-op that reports success for every
+// update, so that resubmitted updates are not double-counted. Keys are recorded in the transient
+// store and are forgotten at the end of the block. Failed updates do not record their key and can
+// be retried. An empty key applies the updates unconditionally.
+func (k Keeper) UpdateSubaccountsWithIdempotencyKey(
+	ctx sdk.Context,
+	updates []types.Update,
+	updateType types.UpdateType,
+	idempotencyKey []byte,
+) (
+	success bool,
+	successPerUpdate []types.UpdateResult,
+	err error,
+) {
+	if len(idempotencyKey) == 0 {
+		return k.UpdateSubaccounts(ctx, updates, updateType)
+	}
+
+	store := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), []byte(types.IdempotencyKeyPrefix))
+	if store.Has(idempotencyKey) {
+		successPerUpdate = make([]types.UpdateResult, len(updates))
+		for i := range successPerUpdate {
+			successPerUpdate[i] = types.Success
+		}
+		return true, successPerUpdate, nil
+	}
+
+	success, successPerUpdate, err = k.UpdateSubaccounts(ctx, updates, updateType)
+	if err == nil && success {
+		store.Set(idempotencyKey, []byte{})
+	}
+	return success, successPerUpdate, err
+}
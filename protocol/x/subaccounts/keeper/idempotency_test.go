@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	asstypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateSubaccountsWithIdempotencyKey(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+		t,
+		true,
+	)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:             &constants.Alice_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(1_000_000_000)), // $1,000
+	})
+	getUsdcBalance := func() *big.Int {
+		subaccount := keeper.GetSubaccount(ctx, constants.Alice_Num0)
+		return subaccount.GetUsdcPosition()
+	}
+	usdcUpdate := func(quantums int64) []types.Update {
+		return []types.Update{
+			{
+				SubaccountId: constants.Alice_Num0,
+				AssetUpdates: []types.AssetUpdate{
+					{
+						AssetId:          asstypes.AssetUsdc.Id,
+						BigQuantumsDelta: big.NewInt(quantums),
+					},
+				},
+			},
+		}
+	}
+
+	// The first update with a key is applied.
+	success, successPerUpdate, err := keeper.UpdateSubaccountsWithIdempotencyKey(
+		ctx,
+		usdcUpdate(100_000_000),
+		types.Deposit,
+		[]byte("key-1"),
+	)
+	require.NoError(t, err)
+	require.True(t, success)
+	require.Equal(t, []types.UpdateResult{types.Success}, successPerUpdate)
+	require.Equal(t, big.NewInt(1_100_000_000), getUsdcBalance())
+
+	// Applying the same keyed update again is a no-op.
+	success, successPerUpdate, err = keeper.UpdateSubaccountsWithIdempotencyKey(
+		ctx,
+		usdcUpdate(100_000_000),
+		types.Deposit,
+		[]byte("key-1"),
+	)
+	require.NoError(t, err)
+	require.True(t, success)
+	require.Equal(t, []types.UpdateResult{types.Success}, successPerUpdate)
+	require.Equal(t, big.NewInt(1_100_000_000), getUsdcBalance())
+
+	// A failed update does not record its key, so it can be retried.
+	success, successPerUpdate, err = keeper.UpdateSubaccountsWithIdempotencyKey(
+		ctx,
+		usdcUpdate(-2_000_000_000),
+		types.Withdrawal,
+		[]byte("key-2"),
+	)
+	require.NoError(t, err)
+	require.False(t, success)
+	require.Equal(t, []types.UpdateResult{types.NewlyUndercollateralized}, successPerUpdate)
+	require.Equal(t, big.NewInt(1_100_000_000), getUsdcBalance())
+
+	success, _, err = keeper.UpdateSubaccountsWithIdempotencyKey(
+		ctx,
+		usdcUpdate(-1_000_000_000),
+		types.Withdrawal,
+		[]byte("key-2"),
+	)
+	require.NoError(t, err)
+	require.True(t, success)
+	require.Equal(t, big.NewInt(100_000_000), getUsdcBalance())
+
+	// Updates without a key are always applied.
+	for i := 0; i < 2; i++ {
+		success, _, err = keeper.UpdateSubaccountsWithIdempotencyKey(ctx, usdcUpdate(100_000_000), types.Deposit, nil)
+		require.NoError(t, err)
+		require.True(t, success)
+	}
+	require.Equal(t, big.NewInt(300_000_000), getUsdcBalance())
+}
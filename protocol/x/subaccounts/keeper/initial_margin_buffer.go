@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	ante_types "github.com/dydxprotocol/v4-chain/protocol/app/ante/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetInitialMarginBufferPpm returns the buffer, in parts-per-million of the initial margin requirement,
+// that the net collateral of a subaccount must meet after an update that opens or increases a perpetual
+// position, i.e. `NC >= IMR * (1 + bufferPpm / 1_000_000)`. Updates that only reduce or close positions
+// are not subject to the buffer. A buffer of zero, the default, disables it.
+//
+// The buffer is read for every subaccount update, so reading it does not consume gas.
+func (k Keeper) GetInitialMarginBufferPpm(ctx sdk.Context) uint32 {
+	noGasCtx := ctx.WithGasMeter(ante_types.NewFreeInfiniteGasMeter())
+	store := noGasCtx.KVStore(k.storeKey)
+	b := store.Get([]byte(types.InitialMarginBufferPpmKey))
+	if b == nil {
+		return 0
+	}
+
+	bufferPpm := gogotypes.UInt32Value{}
+	k.cdc.MustUnmarshal(b, &bufferPpm)
+	return bufferPpm.Value
+}
+
+// SetInitialMarginBufferPpm sets the buffer, in parts-per-million of the initial margin requirement, that
+// the net collateral of a subaccount must meet after an opening update.
+func (k Keeper) SetInitialMarginBufferPpm(ctx sdk.Context, bufferPpm uint32) {
+	store := ctx.KVStore(k.storeKey)
+	if bufferPpm == 0 {
+		store.Delete([]byte(types.InitialMarginBufferPpmKey))
+		return
+	}
+	store.Set(
+		[]byte(types.InitialMarginBufferPpmKey),
+		k.cdc.MustMarshal(&gogotypes.UInt32Value{Value: bufferPpm}),
+	)
+}
@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetInitialMarginBufferPpm(t *testing.T) {
+	ctx, keeper, _, _, _, _, _, _, _, _, _ := keepertest.SubaccountsKeepers(t, true)
+	require.Equal(t, uint32(0), keeper.GetInitialMarginBufferPpm(ctx))
+
+	keeper.SetInitialMarginBufferPpm(ctx, 200_000)
+	require.Equal(t, uint32(200_000), keeper.GetInitialMarginBufferPpm(ctx))
+
+	keeper.SetInitialMarginBufferPpm(ctx, 0)
+	require.Equal(t, uint32(0), keeper.GetInitialMarginBufferPpm(ctx))
+}
+
+func TestUpdateSubaccounts_InitialMarginBuffer(t *testing.T) {
+	tests := map[string]struct {
+		bufferPpm          uint32
+		usdcQuantums       *big.Int
+		perpetualPositions []*types.PerpetualPosition
+		assetUpdates       []types.AssetUpdate
+		perpetualUpdates   []types.PerpetualUpdate
+
+		expectedResult types.UpdateResult
+	}{
+		"open within initial margin is allowed without a buffer": {
+			bufferPpm:    0,
+			usdcQuantums: big.NewInt(11_000_000_000), // $11,000
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(-50_000_000_000)}, // -$50,000
+			},
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(100_000_000)}, // 1 BTC
+			},
+			expectedResult: types.Success,
+		},
+		"open within initial margin is rejected by the buffer": {
+			// NC of $11,000 is above the IMR of $10,000 but below the buffered IMR of $12,000.
+			bufferPpm:    200_000,
+			usdcQuantums: big.NewInt(11_000_000_000), // $11,000
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(-50_000_000_000)}, // -$50,000
+			},
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(100_000_000)}, // 1 BTC
+			},
+			expectedResult: types.ViolatesInitialMarginBuffer,
+		},
+		"open above the buffered initial margin is allowed": {
+			bufferPpm:    200_000,
+			usdcQuantums: big.NewInt(13_000_000_000), // $13,000
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(-50_000_000_000)}, // -$50,000
+			},
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(100_000_000)}, // 1 BTC
+			},
+			expectedResult: types.Success,
+		},
+		"increasing a position is rejected by the buffer": {
+			bufferPpm:    200_000,
+			usdcQuantums: big.NewInt(-14_000_000_000), // -$14,000
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(0, big.NewInt(50_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(-25_000_000_000)}, // -$25,000
+			},
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(50_000_000)}, // 0.5 BTC
+			},
+			expectedResult: types.ViolatesInitialMarginBuffer,
+		},
+		"reducing a position is not subject to the buffer": {
+			// NC of $11,000 is below the IMR of $15,000 after the update, but the update reduces risk.
+			bufferPpm:    200_000,
+			usdcQuantums: big.NewInt(-89_000_000_000), // -$89,000
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(0, big.NewInt(200_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(25_000_000_000)}, // $25,000
+			},
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(-50_000_000)}, // -0.5 BTC
+			},
+			expectedResult: types.Success,
+		},
+		"withdrawal is not subject to the buffer": {
+			bufferPpm:    200_000,
+			usdcQuantums: big.NewInt(-39_000_000_000), // -$39,000
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(-500_000_000)}, // -$500
+			},
+			expectedResult: types.Success,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			subaccount := types.Subaccount{
+				Id:                 &constants.Alice_Num0,
+				AssetPositions:     testutil.CreateUsdcAssetPositions(tc.usdcQuantums),
+				PerpetualPositions: tc.perpetualPositions,
+			}
+			keeper.SetSubaccount(ctx, subaccount)
+			keeper.SetInitialMarginBufferPpm(ctx, tc.bufferPpm)
+
+			success, successPerUpdate, err := keeper.CanUpdateSubaccounts(
+				ctx,
+				[]types.Update{
+					{
+						SubaccountId:     constants.Alice_Num0,
+						AssetUpdates:     tc.assetUpdates,
+						PerpetualUpdates: tc.perpetualUpdates,
+					},
+				},
+				types.CollatCheck,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedResult.IsSuccess(), success)
+			require.Equal(t, []types.UpdateResult{tc.expectedResult}, successPerUpdate)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"math"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetInsuranceFundNeedForLiquidityTierChange returns the projected insurance fund need in quote
+// quantums under the current liquidity tier with the id of `proposedTier`, and under `proposedTier`.
+// This is read-only and is intended to be used before a liquidity tier change is proposed.
+//
+// The insurance fund need is the aggregate bankruptcy exposure of all positions in perpetuals of the
+// liquidity tier, given the current positions, prices and open interest. The exposure of a position
+// is the negative net collateral of its subaccount, if any, when the position is liquidated at
+// `liquidationGapPpm` parts-per-million past its liquidation price, with all other prices held fixed.
+// Positions that are already liquidatable are liquidated from the current price. Tighter margins
+// liquidate positions earlier, which reduces the projected need.
+func (k Keeper) GetInsuranceFundNeedForLiquidityTierChange(
+	ctx sdk.Context,
+	proposedTier perptypes.LiquidityTier,
+	liquidationGapPpm uint32,
+) (
+	currentNeed *big.Int,
+	proposedNeed *big.Int,
+	err error,
+) {
+	if err := proposedTier.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	subaccounts := k.GetAllSubaccount(ctx)
+	updates := make([]types.Update, len(subaccounts))
+	for i, subaccount := range subaccounts {
+		updates[i] = types.Update{SubaccountId: *subaccount.Id}
+	}
+	perpInfos, err := k.GetAllRelevantPerpetuals(ctx, updates)
+	if err != nil {
+		return nil, nil, err
+	}
+	settledSubaccounts := make([]types.Subaccount, len(subaccounts))
+	for i, subaccount := range subaccounts {
+		settledSubaccounts[i], _ = salib.GetSettledSubaccountWithPerpetuals(subaccount, perpInfos)
+	}
+
+	proposedPerpInfos := make(perptypes.PerpInfos, len(perpInfos))
+	for id, info := range perpInfos {
+		if info.LiquidityTier.Id == proposedTier.Id {
+			info.LiquidityTier = proposedTier
+		}
+		proposedPerpInfos[id] = info
+	}
+
+	currentNeed, err = getInsuranceFundNeed(settledSubaccounts, perpInfos, proposedTier.Id, liquidationGapPpm)
+	if err != nil {
+		return nil, nil, err
+	}
+	proposedNeed, err = getInsuranceFundNeed(settledSubaccounts, proposedPerpInfos, proposedTier.Id, liquidationGapPpm)
+	if err != nil {
+		return nil, nil, err
+	}
+	return currentNeed, proposedNeed, nil
+}
+
+// getInsuranceFundNeed returns the aggregate bankruptcy exposure of all positions of the settled
+// subaccounts in perpetuals of the liquidity tier. See `GetInsuranceFundNeedForLiquidityTierChange`.
+func getInsuranceFundNeed(
+	settledSubaccounts []types.Subaccount,
+	perpInfos perptypes.PerpInfos,
+	liquidityTierId uint32,
+	liquidationGapPpm uint32,
+) (
+	need *big.Int,
+	err error,
+) {
+	need = new(big.Int)
+	for _, subaccount := range settledSubaccounts {
+		for _, position := range subaccount.PerpetualPositions {
+			perpInfo := perpInfos.MustGet(position.PerpetualId)
+			if perpInfo.LiquidityTier.Id != liquidityTierId || position.GetBigQuantums().Sign() == 0 {
+				continue
+			}
+
+			liquidationPrice, found, err := salib.GetLiquidationPrice(subaccount, perpInfos, position.PerpetualId)
+			if err != nil {
+				return nil, err
+			}
+			if !found {
+				continue
+			}
+
+			// Liquidate longs at a gap below the liquidation price and shorts at a gap above it, rounding the
+			// gap away from the liquidation price.
+			currentPrice := perpInfo.Price.Price
+			var gapPrice *big.Int
+			if position.GetIsLong() {
+				startPrice := lib.BigU(lib.Min(liquidationPrice, currentPrice))
+				gapPrice = new(big.Int).Sub(startPrice, lib.BigMulPpm(startPrice, lib.BigU(liquidationGapPpm), true))
+			} else {
+				startPrice := lib.BigU(lib.Max(liquidationPrice, currentPrice))
+				gapPrice = new(big.Int).Add(startPrice, lib.BigMulPpm(startPrice, lib.BigU(liquidationGapPpm), true))
+			}
+
+			risk, err := salib.GetRiskForSubaccountAtPrices(
+				subaccount,
+				perpInfos,
+				map[uint32]uint64{position.PerpetualId: lib.BigUint64Clamp(gapPrice, 0, math.MaxUint64)},
+			)
+			if err != nil {
+				return nil, err
+			}
+			if risk.NC.Sign() < 0 {
+				need.Sub(need, risk.NC)
+			}
+		}
+	}
+	return need, nil
+}
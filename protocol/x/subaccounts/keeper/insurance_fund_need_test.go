@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetInsuranceFundNeedForLiquidityTierChange(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+		t,
+		true,
+	)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+	for _, p := range []perptypes.Perpetual{
+		constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+		constants.EthUsd_20PercentInitial_10PercentMaintenance,
+	} {
+		_, err := perpetualsKeeper.CreatePerpetual(
+			ctx,
+			p.Params.Id,
+			p.Params.Ticker,
+			p.Params.MarketId,
+			p.Params.AtomicResolution,
+			p.Params.DefaultFundingPpm,
+			p.Params.LiquidityTier,
+			p.Params.MarketType,
+		)
+		require.NoError(t, err)
+	}
+
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:             &constants.Alice_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-40_000_000_000)), // -$40,000
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)), // 1 BTC
+		},
+	})
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:             &constants.Bob_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(58_000_000_000)), // $58,000
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(0, big.NewInt(-100_000_000), big.NewInt(0), big.NewInt(0)), // -1 BTC
+		},
+	})
+
+	// Tighten the 20% initial margin fraction of the liquidity tier of both perpetuals to 30%.
+	tier, err := perpetualsKeeper.GetLiquidityTier(ctx, 3)
+	require.NoError(t, err)
+	proposedTier := tier
+	proposedTier.InitialMarginPpm = 300_000
+
+	// With a 20% gap past the liquidation price, Alice is liquidated at $35,555.56 under the current tier
+	// and at $37,647.06 under the proposed tier, for a deficit of $4,444.44 and $2,352.94. Bob is
+	// liquidated at $63,272.73 and $60,521.74, for a deficit of $5,272.73 and $2,521.74.
+	currentNeed, proposedNeed, err := keeper.GetInsuranceFundNeedForLiquidityTierChange(ctx, proposedTier, 200_000)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(9_717_171_730), currentNeed)
+	require.Equal(t, big.NewInt(4_874_680_330), proposedNeed)
+	require.Negative(t, proposedNeed.Cmp(currentNeed))
+
+	// The proposed tier is validated.
+	proposedTier.InitialMarginPpm = 1_000_001
+	_, _, err = keeper.GetInsuranceFundNeedForLiquidityTierChange(ctx, proposedTier, 200_000)
+	require.ErrorIs(t, err, perptypes.ErrInitialMarginPpmExceedsMax)
+}
@@ -12,7 +12,7 @@ import (
 )
 
 // checkIsolatedSubaccountConstaints will validate all `updates` to the relevant subaccounts against
-// isolated subaccount constraints.
+// isolated subaccount constraints, including the constraints of subaccounts in isolated margin mode.
 // This function checks each update in isolation, so if multiple updates for the same subaccount id
 // are passed in, they are not evaluated separately.
 // The input subaccounts must be settled.
@@ -34,6 +34,12 @@ func (k Keeper) checkIsolatedSubaccountConstraints(
 
 	for i, u := range settledUpdates {
 		result := isValidIsolatedPerpetualUpdates(u, perpInfos)
+		// The margin mode is only read from state when the updates could violate it.
+		if result == types.Success &&
+			holdsMultiplePerpetualsAfterUpdates(u) &&
+			k.GetMarginMode(ctx, *u.SettledSubaccount.Id) == types.IsolatedMargin {
+			result = types.ViolatesIsolatedSubaccountConstraints
+		}
 		if result != types.Success {
 			success = false
 		}
@@ -123,6 +129,37 @@ func isValidIsolatedPerpetualUpdates(
 	return types.Success
 }
 
+// Checks whether the perpetual updates to a settled subaccount would result in the subaccount
+// holding positions in more than one perpetual, which is not allowed in isolated margin mode.
+func holdsMultiplePerpetualsAfterUpdates(
+	settledUpdate types.SettledUpdate,
+) bool {
+	if len(settledUpdate.PerpetualUpdates) == 0 {
+		return false
+	}
+
+	quantums := make(map[uint32]*big.Int)
+	for _, position := range settledUpdate.SettledSubaccount.PerpetualPositions {
+		quantums[position.PerpetualId] = position.GetBigQuantums()
+	}
+	for _, update := range settledUpdate.PerpetualUpdates {
+		q, exists := quantums[update.PerpetualId]
+		if !exists {
+			q = new(big.Int)
+			quantums[update.PerpetualId] = q
+		}
+		q.Add(q, update.GetBigQuantums())
+	}
+
+	numPositions := 0
+	for _, q := range quantums {
+		if q.Sign() != 0 {
+			numPositions++
+		}
+	}
+	return numPositions > 1
+}
+
 // GetIsolatedPerpetualStateTransition computes whether an isolated perpetual position will be
 // opened or closed for a subaccount.
 // This function assumes that the subaccount is valid under isolated perpetual constraints.
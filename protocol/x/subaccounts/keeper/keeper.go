@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
@@ -23,7 +24,17 @@ type (
 		blocktimeKeeper     types.BlocktimeKeeper
 		indexerEventManager indexer_manager.IndexerEventManager
 		streamingManager    streamingtypes.FullNodeStreamingManager
+		transientStoreKey   storetypes.StoreKey
+		authorities         map[string]struct{}
+
+		historicalMultiStore HistoricalMultiStoreProvider
+		collateralSources    []types.CollateralSource
+		feeTiersKeeper       types.FeeTiersKeeper
 	}
+
+	// HistoricalMultiStoreProvider returns a read-only view of the committed state as of the end of the
+	// block at `height`.
+	HistoricalMultiStoreProvider func(height int64) (storetypes.MultiStore, error)
 )
 
 func NewKeeper(
@@ -35,6 +46,8 @@ func NewKeeper(
 	blocktimeKeeper types.BlocktimeKeeper,
 	indexerEventManager indexer_manager.IndexerEventManager,
 	streamingManager streamingtypes.FullNodeStreamingManager,
+	transientStoreKey storetypes.StoreKey,
+	authorities []string,
 ) *Keeper {
 	return &Keeper{
 		cdc:                 cdc,
@@ -45,9 +58,40 @@ func NewKeeper(
 		blocktimeKeeper:     blocktimeKeeper,
 		indexerEventManager: indexerEventManager,
 		streamingManager:    streamingManager,
+		transientStoreKey:   transientStoreKey,
+		authorities:         lib.UniqueSliceToSet(authorities),
 	}
 }
 
+// SetHistoricalMultiStoreProvider sets the provider of the committed state at past block heights, which
+// is used to replay the risk of subaccounts at a past height.
+// This reference is set with an explicit method call rather than during `NewKeeper` since the committed
+// multistore is owned by the app and is only available after the keeper is initialized.
+func (k *Keeper) SetHistoricalMultiStoreProvider(provider HistoricalMultiStoreProvider) {
+	k.historicalMultiStore = provider
+}
+
+// SetCollateralSources sets the sources of collateral held outside of the asset positions of subaccounts
+// that count towards their net collateral while external collateral is enabled.
+// This reference is set with an explicit method call rather than during `NewKeeper` since the sources may
+// depend on keepers that are initialized after this keeper.
+func (k *Keeper) SetCollateralSources(sources ...types.CollateralSource) {
+	k.collateralSources = sources
+}
+
+// SetFeeTiersKeeper sets the keeper providing the trading fee rates of accounts, which is used to estimate
+// the fees of closing the positions of subaccounts.
+// This reference is set with an explicit method call rather than during `NewKeeper` since it is only used
+// by queries, which most users of the keeper do not need.
+func (k *Keeper) SetFeeTiersKeeper(feeTiersKeeper types.FeeTiersKeeper) {
+	k.feeTiersKeeper = feeTiersKeeper
+}
+
+func (k Keeper) HasAuthority(authority string) bool {
+	_, ok := k.authorities[authority]
+	return ok
+}
+
 func (k Keeper) GetIndexerEventManager() indexer_manager.IndexerEventManager {
 	return k.indexerEventManager
 }
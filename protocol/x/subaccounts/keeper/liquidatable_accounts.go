@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetLiquidatableAccountsOrdered returns the ids of all subaccounts that are currently liquidatable, in the
+// order in which they should be liquidated. The risk of every subaccount is computed after settling
+// funding, using a single snapshot of the perpetuals, prices and liquidity tiers of all open positions.
+//
+// Subaccounts are ordered from most to least underwater, i.e. by ascending ratio of net collateral to
+// maintenance margin requirement, with equal ratios ordered as by `margin.Risk.Cmp`. Subaccounts with equal
+// risks are ordered as by `SortedSubaccounts`, i.e. by id and then by a hash of the full subaccount state,
+// so the order is total and deterministic.
+func (k Keeper) GetLiquidatableAccountsOrdered(
+	ctx sdk.Context,
+) (
+	subaccountIds []types.SubaccountId,
+	err error,
+) {
+	subaccounts := k.GetAllSubaccount(ctx)
+	updates := make([]types.Update, len(subaccounts))
+	for i, subaccount := range subaccounts {
+		updates[i] = types.Update{SubaccountId: *subaccount.Id}
+	}
+	perpInfos, err := k.GetAllRelevantPerpetuals(ctx, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	type liquidatable struct {
+		subaccount types.Subaccount
+		risk       margin.Risk
+	}
+	var accounts []liquidatable
+	for _, subaccount := range subaccounts {
+		settledSubaccount, _ := salib.GetSettledSubaccountWithPerpetuals(subaccount, perpInfos)
+		risk, err := salib.GetRiskForSubaccount(settledSubaccount, perpInfos)
+		if err != nil {
+			return nil, err
+		}
+		if risk.IsLiquidatable() {
+			accounts = append(accounts, liquidatable{subaccount: subaccount, risk: risk})
+		}
+	}
+
+	sort.Slice(accounts, func(i, j int) bool {
+		if cmp := accounts[i].risk.Cmp(accounts[j].risk); cmp != 0 {
+			return cmp > 0
+		}
+		return types.SortedSubaccounts{accounts[i].subaccount, accounts[j].subaccount}.Less(0, 1)
+	})
+
+	subaccountIds = make([]types.SubaccountId, len(accounts))
+	for i, account := range accounts {
+		subaccountIds[i] = *account.subaccount.Id
+	}
+	return subaccountIds, nil
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLiquidatableAccountsOrdered(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+		t,
+		true,
+	)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+	p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+	_, err := perpetualsKeeper.CreatePerpetual(
+		ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.MarketType,
+	)
+	require.NoError(t, err)
+
+	ids, err := keeper.GetLiquidatableAccountsOrdered(ctx)
+	require.NoError(t, err)
+	require.Empty(t, ids)
+
+	btcLong := func(id types.SubaccountId, btcQuantums int64, usdcQuantums int64) types.Subaccount {
+		return types.Subaccount{
+			Id:             &id,
+			AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(usdcQuantums)),
+			PerpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(0, big.NewInt(btcQuantums), big.NewInt(0), big.NewInt(0)),
+			},
+		}
+	}
+	for _, subaccount := range []types.Subaccount{
+		// NC of $4,000 and MMR of $5,000.
+		btcLong(constants.Alice_Num1, 100_000_000, -46_000_000_000),
+		// NC of $4,000 and MMR of $5,000.
+		btcLong(constants.Alice_Num0, 100_000_000, -46_000_000_000),
+		// NC of $2,000 and MMR of $5,000.
+		btcLong(constants.Bob_Num0, 100_000_000, -48_000_000_000),
+		// NC of $8,000 and MMR of $10,000 has the same ratio as Alice but a larger MMR.
+		btcLong(constants.Carl_Num0, 200_000_000, -92_000_000_000),
+		// NC of $10,000 and MMR of $5,000 is not liquidatable.
+		btcLong(constants.Dave_Num0, 100_000_000, -40_000_000_000),
+		// Negative NC without an MMR is not liquidatable.
+		{
+			Id:             &constants.Dave_Num1,
+			AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-1_000_000)),
+		},
+	} {
+		keeper.SetSubaccount(ctx, subaccount)
+	}
+
+	ids, err = keeper.GetLiquidatableAccountsOrdered(ctx)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]types.SubaccountId{
+			constants.Bob_Num0,
+			constants.Carl_Num0,
+			constants.Alice_Num0,
+			constants.Alice_Num1,
+		},
+		ids,
+	)
+}
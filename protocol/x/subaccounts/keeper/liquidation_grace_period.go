@@ -0,0 +1,219 @@
+package keeper
+
+import (
+	"sort"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetLiquidationGracePeriodBlocks returns the number of blocks a subaccount must remain below its
+// maintenance margin requirement before it can be liquidated. A grace period of zero blocks, the
+// default, allows subaccounts to be liquidated as soon as they fall below maintenance margin.
+func (k Keeper) GetLiquidationGracePeriodBlocks(ctx sdk.Context) uint32 {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get([]byte(types.LiquidationGracePeriodBlocksKey))
+	if b == nil {
+		return 0
+	}
+
+	blocks := gogotypes.UInt32Value{}
+	k.cdc.MustUnmarshal(b, &blocks)
+	return blocks.Value
+}
+
+// SetLiquidationGracePeriodBlocks sets the number of blocks a subaccount must remain below its
+// maintenance margin requirement before it can be liquidated. Disabling the grace period clears the
+// tracked block of every undercollateralized subaccount, so that re-enabling it starts a new grace
+// period for subaccounts that are still undercollateralized.
+func (k Keeper) SetLiquidationGracePeriodBlocks(ctx sdk.Context, blocks uint32) {
+	store := ctx.KVStore(k.storeKey)
+	if blocks != 0 {
+		store.Set(
+			[]byte(types.LiquidationGracePeriodBlocksKey),
+			k.cdc.MustMarshal(&gogotypes.UInt32Value{Value: blocks}),
+		)
+		return
+	}
+
+	store.Delete([]byte(types.LiquidationGracePeriodBlocksKey))
+	undercollateralizedStore := prefix.NewStore(store, []byte(types.UndercollateralizedSinceBlockKeyPrefix))
+	iterator := undercollateralizedStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		undercollateralizedStore.Delete(key)
+	}
+}
+
+// GetUndercollateralizedSinceBlock returns the block at which the subaccount was first seen below its
+// maintenance margin requirement, and whether the subaccount is currently tracked as such.
+func (k Keeper) GetUndercollateralizedSinceBlock(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+) (
+	blockHeight uint32,
+	exists bool,
+) {
+	return k.getUndercollateralizedSinceBlock(k.getUndercollateralizedSinceBlockStore(ctx), subaccountId)
+}
+
+// IsLiquidationGracePeriodElapsed returns true if the subaccount has been below its maintenance margin
+// requirement for at least the liquidation grace period. The grace period starts when an update leaves
+// the subaccount below maintenance margin, or when `TrackUndercollateralizedSubaccounts` first sees it
+// below maintenance margin. Always returns true if the grace period is zero blocks.
+func (k Keeper) IsLiquidationGracePeriodElapsed(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+) bool {
+	gracePeriodBlocks := k.GetLiquidationGracePeriodBlocks(ctx)
+	if gracePeriodBlocks == 0 {
+		return true
+	}
+
+	sinceBlock, exists := k.GetUndercollateralizedSinceBlock(ctx, subaccountId)
+	if !exists {
+		return false
+	}
+	blockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight())
+	return uint64(blockHeight) >= uint64(sinceBlock)+uint64(gracePeriodBlocks)
+}
+
+// TrackUndercollateralizedSubaccounts records the current block for every subaccount that is below
+// its maintenance margin requirement and not already tracked, and clears the tracked block of every
+// subaccount that has recovered. The risk of each subaccount is that returned by `GetRiskForSubaccount`,
+// including its external and locked collateral. It is a no-op while the liquidation grace period is disabled.
+//
+// This iterates over all subaccounts, and must be called once per block in `EndBlocker` so that the
+// grace period of every subaccount is measured from the first block it was seen undercollateralized.
+// Each call only iterates over the subaccounts within the risk sweep budget, and the next call resumes
+// from the first subaccount that was not iterated over.
+func (k Keeper) TrackUndercollateralizedSubaccounts(ctx sdk.Context) error {
+	if k.GetLiquidationGracePeriodBlocks(ctx) == 0 {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	startKey := store.Get([]byte(types.UndercollateralizedSweepNextKeyKey))
+	var subaccounts []types.Subaccount
+	nextKey := k.iterateSubaccountsWithinRiskSweepBudget(ctx, startKey, func(subaccount types.Subaccount) {
+		subaccounts = append(subaccounts, subaccount)
+	})
+	if nextKey == nil {
+		store.Delete([]byte(types.UndercollateralizedSweepNextKeyKey))
+	} else {
+		store.Set([]byte(types.UndercollateralizedSweepNextKeyKey), nextKey)
+	}
+
+	updates := make([]types.Update, len(subaccounts))
+	for i, subaccount := range subaccounts {
+		updates[i] = types.Update{SubaccountId: *subaccount.Id}
+	}
+	perpInfos, err := k.GetAllRelevantPerpetuals(ctx, updates)
+	if err != nil {
+		return err
+	}
+
+	// Subaccounts in the iterated range that are tracked but are no longer undercollateralized, or no
+	// longer exist, are cleared after iterating over the range.
+	undercollateralizedStore := k.getUndercollateralizedSinceBlockStore(ctx)
+	recovered := make(map[string]bool)
+	iterator := undercollateralizedStore.Iterator(startKey, nextKey)
+	for ; iterator.Valid(); iterator.Next() {
+		recovered[string(iterator.Key())] = true
+	}
+	iterator.Close()
+
+	blockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight())
+	for _, subaccount := range subaccounts {
+		settledSubaccount, _ := salib.GetSettledSubaccountWithPerpetuals(subaccount, perpInfos)
+		risk, err := salib.GetRiskForSubaccount(settledSubaccount, perpInfos)
+		if err != nil {
+			return err
+		}
+		if err := k.adjustRiskForCollateral(ctx, *subaccount.Id, &risk); err != nil {
+			return err
+		}
+		if !risk.IsLiquidatable() {
+			continue
+		}
+
+		key := subaccount.Id.ToStateKey()
+		if !recovered[string(key)] {
+			undercollateralizedStore.Set(key, k.cdc.MustMarshal(&gogotypes.UInt32Value{Value: blockHeight}))
+		}
+		delete(recovered, string(key))
+	}
+
+	for _, key := range lib.GetSortedKeys[sort.StringSlice](recovered) {
+		undercollateralizedStore.Delete([]byte(key))
+	}
+	return nil
+}
+
+// trackUndercollateralizedUpdatedSubaccounts records the current block for every updated subaccount that
+// is below its maintenance margin requirement, including its external and locked collateral, and not
+// already tracked, and clears the tracked block of every updated subaccount that is not. It is a no-op
+// while the liquidation grace period is disabled. The subaccounts of `settledUpdates` must have the
+// updates applied.
+func (k Keeper) trackUndercollateralizedUpdatedSubaccounts(
+	ctx sdk.Context,
+	settledUpdates []types.SettledUpdate,
+	perpInfos perptypes.PerpInfos,
+) error {
+	if k.GetLiquidationGracePeriodBlocks(ctx) == 0 {
+		return nil
+	}
+
+	undercollateralizedStore := k.getUndercollateralizedSinceBlockStore(ctx)
+	blockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight())
+	for _, u := range settledUpdates {
+		risk, err := salib.GetRiskForSubaccount(u.SettledSubaccount, perpInfos)
+		if err != nil {
+			return err
+		}
+		if err := k.adjustRiskForCollateral(ctx, *u.SettledSubaccount.Id, &risk); err != nil {
+			return err
+		}
+
+		key := u.SettledSubaccount.Id.ToStateKey()
+		if !risk.IsLiquidatable() {
+			undercollateralizedStore.Delete(key)
+			continue
+		}
+		if !undercollateralizedStore.Has(key) {
+			undercollateralizedStore.Set(key, k.cdc.MustMarshal(&gogotypes.UInt32Value{Value: blockHeight}))
+		}
+	}
+	return nil
+}
+
+func (k Keeper) getUndercollateralizedSinceBlockStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.UndercollateralizedSinceBlockKeyPrefix))
+}
+
+func (k Keeper) getUndercollateralizedSinceBlock(
+	store storetypes.KVStore,
+	subaccountId types.SubaccountId,
+) (
+	blockHeight uint32,
+	exists bool,
+) {
+	b := store.Get(subaccountId.ToStateKey())
+	if b == nil {
+		return 0, false
+	}
+
+	sinceBlock := gogotypes.UInt32Value{}
+	k.cdc.MustUnmarshal(b, &sinceBlock)
+	return sinceBlock.Value, true
+}
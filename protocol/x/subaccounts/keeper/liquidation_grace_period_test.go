@@ -0,0 +1,242 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrackUndercollateralizedSubaccounts(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+		t,
+		true,
+	)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+	p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+	_, err := perpetualsKeeper.CreatePerpetual(
+		ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.MarketType,
+	)
+	require.NoError(t, err)
+
+	// 1 BTC worth $50,000 with a maintenance margin requirement of $5,000.
+	setQuoteBalance := func(quoteBalance int64) {
+		keeper.SetSubaccount(ctx, types.Subaccount{
+			Id:             &constants.Alice_Num0,
+			AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(quoteBalance)),
+			PerpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+		})
+	}
+	setQuoteBalance(-46_000_000_000) // NC = $4,000
+
+	// Subaccounts are not tracked while the grace period is disabled.
+	require.Equal(t, uint32(0), keeper.GetLiquidationGracePeriodBlocks(ctx))
+	require.True(t, keeper.IsLiquidationGracePeriodElapsed(ctx, constants.Alice_Num0))
+	require.NoError(t, keeper.TrackUndercollateralizedSubaccounts(ctx.WithBlockHeight(10)))
+	_, exists := keeper.GetUndercollateralizedSinceBlock(ctx, constants.Alice_Num0)
+	require.False(t, exists)
+
+	// The first block the subaccount is seen undercollateralized is tracked.
+	keeper.SetLiquidationGracePeriodBlocks(ctx, 2)
+	require.Equal(t, uint32(2), keeper.GetLiquidationGracePeriodBlocks(ctx))
+	require.False(t, keeper.IsLiquidationGracePeriodElapsed(ctx.WithBlockHeight(10), constants.Alice_Num0))
+	require.NoError(t, keeper.TrackUndercollateralizedSubaccounts(ctx.WithBlockHeight(10)))
+	require.NoError(t, keeper.TrackUndercollateralizedSubaccounts(ctx.WithBlockHeight(11)))
+	sinceBlock, exists := keeper.GetUndercollateralizedSinceBlock(ctx, constants.Alice_Num0)
+	require.True(t, exists)
+	require.Equal(t, uint32(10), sinceBlock)
+	require.False(t, keeper.IsLiquidationGracePeriodElapsed(ctx.WithBlockHeight(11), constants.Alice_Num0))
+	require.True(t, keeper.IsLiquidationGracePeriodElapsed(ctx.WithBlockHeight(12), constants.Alice_Num0))
+
+	// The tracked block is cleared once the subaccount recovers.
+	setQuoteBalance(-40_000_000_000) // NC = $10,000
+	require.NoError(t, keeper.TrackUndercollateralizedSubaccounts(ctx.WithBlockHeight(12)))
+	_, exists = keeper.GetUndercollateralizedSinceBlock(ctx, constants.Alice_Num0)
+	require.False(t, exists)
+
+	// Collateral locked by pending withdrawals does not count towards the net collateral.
+	keeper.SetLockedCollateral(ctx, constants.Alice_Num0, 6_000_000_000) // NC = $4,000
+	require.NoError(t, keeper.TrackUndercollateralizedSubaccounts(ctx.WithBlockHeight(12)))
+	sinceBlock, exists = keeper.GetUndercollateralizedSinceBlock(ctx, constants.Alice_Num0)
+	require.True(t, exists)
+	require.Equal(t, uint32(12), sinceBlock)
+	keeper.SetLockedCollateral(ctx, constants.Alice_Num0, 0)
+
+	// Disabling the grace period clears all tracked subaccounts.
+	setQuoteBalance(-46_000_000_000) // NC = $4,000
+	require.NoError(t, keeper.TrackUndercollateralizedSubaccounts(ctx.WithBlockHeight(13)))
+	_, exists = keeper.GetUndercollateralizedSinceBlock(ctx, constants.Alice_Num0)
+	require.True(t, exists)
+	keeper.SetLiquidationGracePeriodBlocks(ctx, 0)
+	_, exists = keeper.GetUndercollateralizedSinceBlock(ctx, constants.Alice_Num0)
+	require.False(t, exists)
+}
+
+func TestTrackUndercollateralizedSubaccounts_RiskSweepBudget(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+		t,
+		true,
+	)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+	p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+	_, err := perpetualsKeeper.CreatePerpetual(
+		ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.MarketType,
+	)
+	require.NoError(t, err)
+
+	// 50 subaccounts, of which every fifth has no positions and the others hold 1 BTC worth $50,000 with
+	// NC = $4,000 below the maintenance margin requirement of $5,000.
+	const numSubaccounts = 50
+	setSubaccount := func(number uint32, healthy bool) {
+		subaccount := types.Subaccount{
+			Id:             &types.SubaccountId{Owner: constants.AliceAccAddress.String(), Number: number},
+			AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-46_000_000_000)),
+			PerpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+		}
+		if healthy {
+			subaccount.AssetPositions = testutil.CreateUsdcAssetPositions(big.NewInt(1_000_000_000))
+			subaccount.PerpetualPositions = nil
+		}
+		keeper.SetSubaccount(ctx, subaccount)
+	}
+	for i := uint32(0); i < numSubaccounts; i++ {
+		setSubaccount(i, i%5 == 0)
+	}
+	subaccounts := keeper.GetAllSubaccount(ctx)
+	require.Len(t, subaccounts, numSubaccounts)
+
+	keeper.SetLiquidationGracePeriodBlocks(ctx, 100)
+	require.Equal(t, types.DefaultRiskSweepBudget, keeper.GetRiskSweepBudget(ctx))
+	keeper.SetRiskSweepBudget(ctx, 8)
+	require.Equal(t, uint64(8), keeper.GetRiskSweepBudget(ctx))
+
+	// Every block sweeps over the next 8 subaccounts, so the sweep takes 7 blocks and every
+	// undercollateralized subaccount is tracked from the block that its chunk was swept.
+	for block := int64(10); block < 17; block++ {
+		require.NoError(t, keeper.TrackUndercollateralizedSubaccounts(ctx.WithBlockHeight(block)))
+		for i, subaccount := range subaccounts {
+			sinceBlock, exists := keeper.GetUndercollateralizedSinceBlock(ctx, *subaccount.Id)
+			swept := int64(i/8)+10 <= block
+			if !swept || len(subaccount.PerpetualPositions) == 0 {
+				require.False(t, exists, "block %d, subaccount %d", block, i)
+				continue
+			}
+			require.True(t, exists, "block %d, subaccount %d", block, i)
+			require.Equal(t, uint32(i/8+10), sinceBlock, "block %d, subaccount %d", block, i)
+		}
+	}
+
+	// The next sweep starts over from the first subaccount, clearing those that recovered.
+	setSubaccount(subaccounts[1].Id.Number, true)
+	require.NoError(t, keeper.TrackUndercollateralizedSubaccounts(ctx.WithBlockHeight(17)))
+	_, exists := keeper.GetUndercollateralizedSinceBlock(ctx, *subaccounts[1].Id)
+	require.False(t, exists)
+	sinceBlock, exists := keeper.GetUndercollateralizedSinceBlock(ctx, *subaccounts[2].Id)
+	require.True(t, exists)
+	require.Equal(t, uint32(10), sinceBlock)
+
+	// With the default budget, the sweep in progress finishes in the next block, after which every block
+	// sweeps over all subaccounts.
+	keeper.SetRiskSweepBudget(ctx, 0)
+	require.Equal(t, types.DefaultRiskSweepBudget, keeper.GetRiskSweepBudget(ctx))
+	setSubaccount(subaccounts[1].Id.Number, false)
+	setSubaccount(subaccounts[numSubaccounts-1].Id.Number, true)
+	require.NoError(t, keeper.TrackUndercollateralizedSubaccounts(ctx.WithBlockHeight(18)))
+	require.NoError(t, keeper.TrackUndercollateralizedSubaccounts(ctx.WithBlockHeight(19)))
+	sinceBlock, exists = keeper.GetUndercollateralizedSinceBlock(ctx, *subaccounts[1].Id)
+	require.True(t, exists)
+	require.Equal(t, uint32(19), sinceBlock)
+	_, exists = keeper.GetUndercollateralizedSinceBlock(ctx, *subaccounts[numSubaccounts-1].Id)
+	require.False(t, exists)
+}
+
+func TestUpdateSubaccounts_TracksUndercollateralizedSubaccounts(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+		t,
+		true,
+	)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+	p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+	_, err := perpetualsKeeper.CreatePerpetual(
+		ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.MarketType,
+	)
+	require.NoError(t, err)
+	keeper.SetLiquidationGracePeriodBlocks(ctx, 2)
+
+	// 1 BTC worth $50,000 with a maintenance margin requirement of $5,000, and NC = $4,000.
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:             &constants.Alice_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-46_000_000_000)),
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+		},
+	})
+	deposit := func(ctx sdk.Context, quoteQuantums int64) {
+		success, _, err := keeper.UpdateSubaccounts(
+			ctx,
+			[]types.Update{
+				{
+					SubaccountId: constants.Alice_Num0,
+					AssetUpdates: testutil.CreateUsdcAssetUpdates(big.NewInt(quoteQuantums)),
+				},
+			},
+			types.Deposit,
+		)
+		require.NoError(t, err)
+		require.True(t, success)
+	}
+
+	// An update that leaves the subaccount below maintenance margin starts the grace period.
+	deposit(ctx.WithBlockHeight(20), 500_000_000) // NC = $4,500
+	sinceBlock, exists := keeper.GetUndercollateralizedSinceBlock(ctx, constants.Alice_Num0)
+	require.True(t, exists)
+	require.Equal(t, uint32(20), sinceBlock)
+
+	// Later updates do not restart the grace period.
+	deposit(ctx.WithBlockHeight(21), 100_000_000) // NC = $4,600
+	sinceBlock, exists = keeper.GetUndercollateralizedSinceBlock(ctx, constants.Alice_Num0)
+	require.True(t, exists)
+	require.Equal(t, uint32(20), sinceBlock)
+	require.True(t, keeper.IsLiquidationGracePeriodElapsed(ctx.WithBlockHeight(22), constants.Alice_Num0))
+
+	// An update that brings the subaccount above maintenance margin clears the tracked block.
+	deposit(ctx.WithBlockHeight(22), 2_000_000_000) // NC = $6,600
+	_, exists = keeper.GetUndercollateralizedSinceBlock(ctx, constants.Alice_Num0)
+	require.False(t, exists)
+}
@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"math"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetMinLiquidatingPriceMove returns the smallest adverse move in the oracle price of the perpetual,
+// in parts-per-million of the current price, that makes at least one subaccount with a position in
+// the perpetual liquidatable, along with the subaccount that becomes liquidatable first. The move
+// is rounded up so that moving the price by `movePpm` liquidates the subaccount. Subaccounts that are
+// already liquidatable have a move of zero.
+//
+// `found` is false if no subaccount can become liquidatable through a move in the perpetual's price.
+func (k Keeper) GetMinLiquidatingPriceMove(
+	ctx sdk.Context,
+	perpetualId uint32,
+) (
+	movePpm uint64,
+	subaccountId types.SubaccountId,
+	found bool,
+	err error,
+) {
+	var minMove *big.Int
+	for _, subaccount := range k.GetAllSubaccount(ctx) {
+		var position *types.PerpetualPosition
+		for _, pos := range subaccount.PerpetualPositions {
+			if pos.PerpetualId == perpetualId && pos.GetBigQuantums().Sign() != 0 {
+				position = pos
+				break
+			}
+		}
+		if position == nil {
+			continue
+		}
+
+		inputs, err := k.GetRiskInputs(ctx, *subaccount.Id)
+		if err != nil {
+			return 0, types.SubaccountId{}, false, err
+		}
+		liquidationPrice, liquidatable, err := salib.GetLiquidationPrice(
+			inputs.SettledSubaccount,
+			inputs.PerpInfos,
+			perpetualId,
+		)
+		if err != nil {
+			return 0, types.SubaccountId{}, false, err
+		}
+		currentPrice := inputs.PerpInfos.MustGet(perpetualId).Price.Price
+		if !liquidatable || currentPrice == 0 {
+			continue
+		}
+
+		// Longs are liquidated by a drop in price and shorts by a rise. A subaccount that is already
+		// liquidatable has a move of zero.
+		distance := new(big.Int).Sub(lib.BigU(currentPrice), lib.BigU(liquidationPrice))
+		if position.GetIsShort() {
+			distance.Neg(distance)
+		}
+		move := new(big.Int)
+		if distance.Sign() > 0 {
+			move = lib.BigDivCeil(new(big.Int).Mul(distance, lib.BigU(lib.OneMillion)), lib.BigU(currentPrice))
+		}
+		if minMove == nil || move.Cmp(minMove) < 0 {
+			minMove = move
+			subaccountId = *subaccount.Id
+		}
+	}
+
+	if minMove == nil {
+		return 0, types.SubaccountId{}, false, nil
+	}
+	return lib.BigUint64Clamp(minMove, 0, math.MaxUint64), subaccountId, true, nil
+}
+
+// GetSubaccountLiquidationPrices returns the liquidation and bankruptcy prices of every open perpetual
+// position of the subaccount, ordered by perpetual id. The prices are computed on the settled
+// subaccount with `GetLiquidationPrice` and `GetBankruptcyPrice`.
+//
+// The prices are marginal (single-market-move) prices: each is the price of one perpetual at which
+// the subaccount is liquidated or bankrupt while the prices of all other markets are held fixed. A
+// combined move across several markets can liquidate the subaccount well before any one price is
+// reached.
+func (k Keeper) GetSubaccountLiquidationPrices(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+) (
+	prices []types.PositionLiquidationPrices,
+	err error,
+) {
+	inputs, err := k.GetRiskInputs(ctx, subaccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	prices = make([]types.PositionLiquidationPrices, 0, len(inputs.SettledSubaccount.PerpetualPositions))
+	for _, position := range inputs.SettledSubaccount.PerpetualPositions {
+		if position.GetBigQuantums().Sign() == 0 {
+			continue
+		}
+		positionPrices := types.PositionLiquidationPrices{PerpetualId: position.PerpetualId}
+		positionPrices.LiquidationPrice, positionPrices.HasLiquidationPrice, err = salib.GetLiquidationPrice(
+			inputs.SettledSubaccount,
+			inputs.PerpInfos,
+			position.PerpetualId,
+		)
+		if err != nil {
+			return nil, err
+		}
+		positionPrices.BankruptcyPrice, positionPrices.HasBankruptcyPrice, err = salib.GetBankruptcyPrice(
+			inputs.SettledSubaccount,
+			inputs.PerpInfos,
+			position.PerpetualId,
+		)
+		if err != nil {
+			return nil, err
+		}
+		prices = append(prices, positionPrices)
+	}
+	return prices, nil
+}
+
+// GetLossBufferToLiquidation returns how much the subaccount can lose on its position in the perpetual
+// before it becomes liquidatable, i.e. the buffer of its net collateral over its maintenance margin
+// requirement expressed as an adverse move in the oracle price of the perpetual. `priceMove` is the
+// distance from the current price to the liquidation price returned by `GetLiquidationPrice`, and
+// `lossBuffer` is the decrease in the net collateral of the subaccount, in quote quantums, when the price
+// moves to the liquidation price. Both are zero if the subaccount is already liquidatable.
+//
+// `found` is false if the subaccount has no position in the perpetual or if no price of the perpetual
+// makes the subaccount liquidatable.
+func (k Keeper) GetLossBufferToLiquidation(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	perpetualId uint32,
+) (
+	lossBuffer *big.Int,
+	priceMove uint64,
+	found bool,
+	err error,
+) {
+	inputs, err := k.GetRiskInputs(ctx, subaccountId)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	liquidationPrice, found, err := salib.GetLiquidationPrice(inputs.SettledSubaccount, inputs.PerpInfos, perpetualId)
+	if err != nil || !found {
+		return nil, 0, false, err
+	}
+
+	var position *types.PerpetualPosition
+	for _, pos := range inputs.SettledSubaccount.PerpetualPositions {
+		if pos.PerpetualId == perpetualId {
+			position = pos
+		}
+	}
+
+	// Longs are liquidated by a drop in price and shorts by a rise.
+	currentPrice := inputs.PerpInfos.MustGet(perpetualId).Price.Price
+	distance := new(big.Int).Sub(lib.BigU(currentPrice), lib.BigU(liquidationPrice))
+	if position.GetIsShort() {
+		distance.Neg(distance)
+	}
+	if distance.Sign() <= 0 {
+		return new(big.Int), 0, true, nil
+	}
+
+	risk, err := salib.GetRiskForSubaccount(inputs.SettledSubaccount, inputs.PerpInfos)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	riskAtLiquidation, err := salib.GetRiskForSubaccountAtPrices(
+		inputs.SettledSubaccount,
+		inputs.PerpInfos,
+		map[uint32]uint64{perpetualId: liquidationPrice},
+	)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	return risk.NC.Sub(risk.NC, riskAtLiquidation.NC), distance.Uint64(), true, nil
+}
+
+// GetBasketShockToLiquidate returns the smallest shock, in parts-per-million, to the oracle prices of a
+// correlated basket of perpetuals that makes the subaccount liquidatable, along with the risk of the
+// subaccount under the shock. See `salib.GetBasketShockToLiquidate` for how `weightsPpm` scales the
+// shock to each price of the basket.
+//
+// If `weightsPpm` is empty the basket is every perpetual the subaccount has a position in, perfectly
+// correlated.
+func (k Keeper) GetBasketShockToLiquidate(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	weightsPpm map[uint32]int32,
+) (
+	shockPpm int64,
+	risk margin.Risk,
+	found bool,
+	err error,
+) {
+	inputs, err := k.GetRiskInputs(ctx, subaccountId)
+	if err != nil {
+		return 0, margin.Risk{}, false, err
+	}
+
+	if len(weightsPpm) == 0 {
+		weightsPpm = make(map[uint32]int32, len(inputs.SettledSubaccount.PerpetualPositions))
+		for _, position := range inputs.SettledSubaccount.PerpetualPositions {
+			weightsPpm[position.PerpetualId] = int32(lib.OneMillion)
+		}
+	}
+	return salib.GetBasketShockToLiquidate(inputs.SettledSubaccount, inputs.PerpInfos, weightsPpm)
+}
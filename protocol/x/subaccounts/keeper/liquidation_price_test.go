@@ -0,0 +1,384 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMinLiquidatingPriceMove(t *testing.T) {
+	btcPosition := func(quantums int64) []*types.PerpetualPosition {
+		return []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(0, big.NewInt(quantums), big.NewInt(0), big.NewInt(0)),
+		}
+	}
+	ethPosition := []*types.PerpetualPosition{
+		testutil.CreateSinglePerpetualPosition(1, big.NewInt(1_000_000_000), big.NewInt(0), big.NewInt(0)),
+	}
+
+	tests := map[string]struct {
+		subaccounts []types.Subaccount
+
+		expectedMovePpm      uint64
+		expectedSubaccountId types.SubaccountId
+		expectedFound        bool
+	}{
+		"no subaccounts": {
+			expectedFound: false,
+		},
+		"no subaccounts with a position in the perpetual": {
+			subaccounts: []types.Subaccount{
+				{
+					Id:                 &constants.Alice_Num0,
+					AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(-1_000_000_000)), // -$1,000
+					PerpetualPositions: ethPosition,
+				},
+			},
+			expectedFound: false,
+		},
+		"subaccount that cannot be liquidated by a move in the price": {
+			subaccounts: []types.Subaccount{
+				{
+					Id:                 &constants.Alice_Num0,
+					AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(0)),
+					PerpetualPositions: btcPosition(100_000_000), // 1 BTC
+				},
+			},
+			expectedFound: false,
+		},
+		"two longs with different leverage": {
+			subaccounts: []types.Subaccount{
+				{
+					Id:                 &constants.Alice_Num0,
+					AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(-30_000_000_000)), // -$30,000
+					PerpetualPositions: btcPosition(100_000_000),                                       // 1 BTC
+				},
+				{
+					Id:                 &constants.Bob_Num0,
+					AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(-40_000_000_000)), // -$40,000
+					PerpetualPositions: btcPosition(100_000_000),                                       // 1 BTC
+				},
+			},
+			// Bob is liquidated once 90% of the position's notional is below $40,000, which happens at
+			// a price of $44,444.44, or a drop of 11.11% from $50,000.
+			expectedMovePpm:      111_112,
+			expectedSubaccountId: constants.Bob_Num0,
+			expectedFound:        true,
+		},
+		"short trips before a long": {
+			subaccounts: []types.Subaccount{
+				{
+					Id:                 &constants.Alice_Num0,
+					AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(-40_000_000_000)), // -$40,000
+					PerpetualPositions: btcPosition(100_000_000),                                       // 1 BTC
+				},
+				{
+					Id:                 &constants.Bob_Num0,
+					AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(60_000_000_000)), // $60,000
+					PerpetualPositions: btcPosition(-100_000_000),                                     // -1 BTC
+				},
+			},
+			// Bob is liquidated once 110% of the position's notional is above $60,000, which happens
+			// at a price of $54,545.45, or a rise of 9.09% from $50,000.
+			expectedMovePpm:      90_910,
+			expectedSubaccountId: constants.Bob_Num0,
+			expectedFound:        true,
+		},
+		"subaccount that is already liquidatable": {
+			subaccounts: []types.Subaccount{
+				{
+					Id:                 &constants.Alice_Num0,
+					AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(-30_000_000_000)), // -$30,000
+					PerpetualPositions: btcPosition(100_000_000),                                       // 1 BTC
+				},
+				{
+					Id:                 &constants.Bob_Num0,
+					AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(-46_000_000_000)), // -$46,000
+					PerpetualPositions: btcPosition(100_000_000),                                       // 1 BTC
+				},
+			},
+			expectedMovePpm:      0,
+			expectedSubaccountId: constants.Bob_Num0,
+			expectedFound:        true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+
+			for _, p := range []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				constants.EthUsd_20PercentInitial_10PercentMaintenance,
+			} {
+				_, err := perpetualsKeeper.CreatePerpetual(
+					ctx,
+					p.Params.Id,
+					p.Params.Ticker,
+					p.Params.MarketId,
+					p.Params.AtomicResolution,
+					p.Params.DefaultFundingPpm,
+					p.Params.LiquidityTier,
+					p.Params.MarketType,
+				)
+				require.NoError(t, err)
+			}
+
+			for _, subaccount := range tc.subaccounts {
+				keeper.SetSubaccount(ctx, subaccount)
+			}
+
+			movePpm, subaccountId, found, err := keeper.GetMinLiquidatingPriceMove(ctx, 0)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedFound, found)
+			require.Equal(t, tc.expectedMovePpm, movePpm)
+			require.Equal(t, tc.expectedSubaccountId, subaccountId)
+		})
+	}
+}
+
+func TestGetSubaccountLiquidationPrices(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+		t,
+		true,
+	)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+
+	for _, p := range []perptypes.Perpetual{
+		constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+		constants.EthUsd_20PercentInitial_10PercentMaintenance,
+	} {
+		_, err := perpetualsKeeper.CreatePerpetual(
+			ctx,
+			p.Params.Id,
+			p.Params.Ticker,
+			p.Params.MarketId,
+			p.Params.AtomicResolution,
+			p.Params.DefaultFundingPpm,
+			p.Params.LiquidityTier,
+			p.Params.MarketType,
+		)
+		require.NoError(t, err)
+	}
+
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:             &constants.Alice_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)), // $10,000
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),     // 1 BTC
+			testutil.CreateSinglePerpetualPosition(1, big.NewInt(-10_000_000_000), big.NewInt(0), big.NewInt(0)), // -10 ETH
+		},
+	})
+
+	prices, err := keeper.GetSubaccountLiquidationPrices(ctx, constants.Alice_Num0)
+	require.NoError(t, err)
+	require.Equal(t, []types.PositionLiquidationPrices{
+		{
+			// With ETH fixed at $3,000, NC = BTC - $20,000 and MMR = 10% * BTC + $3,000. The subaccount is
+			// liquidatable below $25,555.56 and bankrupt below $20,000.
+			PerpetualId:         0,
+			LiquidationPrice:    2_555_555_555,
+			HasLiquidationPrice: true,
+			BankruptcyPrice:     1_999_999_999,
+			HasBankruptcyPrice:  true,
+		},
+		{
+			// With BTC fixed at $50,000, NC = $60,000 - 10 * ETH and MMR = $5,000 + ETH. The subaccount is
+			// liquidatable above $5,000 and bankrupt above $6,000.
+			PerpetualId:         1,
+			LiquidationPrice:    5_000_000_001,
+			HasLiquidationPrice: true,
+			BankruptcyPrice:     6_000_000_001,
+			HasBankruptcyPrice:  true,
+		},
+	}, prices)
+
+	// A subaccount with no positions has no prices.
+	prices, err = keeper.GetSubaccountLiquidationPrices(ctx, constants.Bob_Num0)
+	require.NoError(t, err)
+	require.Empty(t, prices)
+}
+
+func TestGetBasketShockToLiquidate(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+		t,
+		true,
+	)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+
+	for _, p := range []perptypes.Perpetual{
+		constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+		constants.EthUsd_20PercentInitial_10PercentMaintenance,
+	} {
+		_, err := perpetualsKeeper.CreatePerpetual(
+			ctx,
+			p.Params.Id,
+			p.Params.Ticker,
+			p.Params.MarketId,
+			p.Params.AtomicResolution,
+			p.Params.DefaultFundingPpm,
+			p.Params.LiquidityTier,
+			p.Params.MarketType,
+		)
+		require.NoError(t, err)
+	}
+
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:             &constants.Alice_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-60_000_000_000)), // -$60,000
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),    // 1 BTC
+			testutil.CreateSinglePerpetualPosition(1, big.NewInt(10_000_000_000), big.NewInt(0), big.NewInt(0)), // 10 ETH
+		},
+	})
+
+	tests := map[string]struct {
+		weightsPpm map[uint32]int32
+
+		expectedShockPpm int64
+		expectedRisk     margin.Risk
+	}{
+		"Defaults to perfect correlation": {
+			// With both prices scaled by (1 + s), NC = $80,000 * (1 + s) - $60,000 and
+			// MMR = $8,000 * (1 + s). The subaccount is liquidatable once both prices fall by 16.67%.
+			expectedShockPpm: -166_667,
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(6_666_640_000),
+				IMR: big.NewInt(13_333_328_000),
+				MMR: big.NewInt(6_666_664_000),
+			},
+		},
+		"Shocks only the markets in the basket": {
+			// With only BTC moving, NC = $50,000 * (1 + s) - $30,000 and MMR = $5,000 * (1 + s) + $3,000.
+			// The subaccount is liquidatable once BTC falls by 26.67%.
+			weightsPpm:       map[uint32]int32{0: 1_000_000},
+			expectedShockPpm: -266_667,
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(6_666_650_000),
+				IMR: big.NewInt(13_333_330_000),
+				MMR: big.NewInt(6_666_665_000),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			shockPpm, risk, found, err := keeper.GetBasketShockToLiquidate(ctx, constants.Alice_Num0, tc.weightsPpm)
+			require.NoError(t, err)
+			require.True(t, found)
+			require.Equal(t, tc.expectedShockPpm, shockPpm)
+			require.Equal(t, tc.expectedRisk, risk)
+		})
+	}
+}
+
+func TestGetLossBufferToLiquidation(t *testing.T) {
+	tests := map[string]struct {
+		usdcQuantums *big.Int
+		perpetualId  uint32
+
+		expectedLossBuffer *big.Int
+		expectedPriceMove  uint64
+		expectedFound      bool
+	}{
+		"high leverage": {
+			// NC = BTC - $44,000 and MMR = 10% * BTC, so the subaccount is liquidatable below $48,888.89.
+			usdcQuantums:       big.NewInt(-44_000_000_000),
+			perpetualId:        0,
+			expectedLossBuffer: big.NewInt(1_111_111_120),
+			expectedPriceMove:  111_111_112,
+			expectedFound:      true,
+		},
+		"low leverage": {
+			// NC = BTC - $20,000 and MMR = 10% * BTC, so the subaccount is liquidatable below $22,222.22.
+			usdcQuantums:       big.NewInt(-20_000_000_000),
+			perpetualId:        0,
+			expectedLossBuffer: big.NewInt(27_777_777_780),
+			expectedPriceMove:  2_777_777_778,
+			expectedFound:      true,
+		},
+		"already liquidatable": {
+			usdcQuantums:       big.NewInt(-46_000_000_000),
+			perpetualId:        0,
+			expectedLossBuffer: big.NewInt(0),
+			expectedPriceMove:  0,
+			expectedFound:      true,
+		},
+		"never liquidatable": {
+			usdcQuantums:  big.NewInt(1_000_000_000),
+			perpetualId:   0,
+			expectedFound: false,
+		},
+		"no position in the perpetual": {
+			usdcQuantums:  big.NewInt(-44_000_000_000),
+			perpetualId:   1,
+			expectedFound: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			for _, p := range []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				constants.EthUsd_20PercentInitial_10PercentMaintenance,
+			} {
+				_, err := perpetualsKeeper.CreatePerpetual(
+					ctx,
+					p.Params.Id,
+					p.Params.Ticker,
+					p.Params.MarketId,
+					p.Params.AtomicResolution,
+					p.Params.DefaultFundingPpm,
+					p.Params.LiquidityTier,
+					p.Params.MarketType,
+				)
+				require.NoError(t, err)
+			}
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(tc.usdcQuantums),
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)), // 1 BTC
+				},
+			})
+
+			lossBuffer, priceMove, found, err := keeper.GetLossBufferToLiquidation(
+				ctx,
+				constants.Alice_Num0,
+				tc.perpetualId,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedFound, found)
+			if !tc.expectedFound {
+				return
+			}
+			require.Equal(t, 0, tc.expectedLossBuffer.Cmp(lossBuffer))
+			require.Equal(t, tc.expectedPriceMove, priceMove)
+		})
+	}
+}
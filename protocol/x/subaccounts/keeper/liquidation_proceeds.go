@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetLiquidationProceedsEstimate estimates the proceeds of liquidating the subaccount's position in the
+// perpetual against `depth`, a depth model or snapshot of the side of the order book the liquidation
+// would trade against, ordered from the best price to the worst, and the resulting impact on the
+// insurance fund, given the `MaxLiquidationFeePpm` of the liquidations config of the clob module. See
+// `EstimateLiquidationProceeds`. The subaccount does not need to be liquidatable.
+func (k Keeper) GetLiquidationProceedsEstimate(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	perpetualId uint32,
+	depth []types.DepthLevel,
+	maxLiquidationFeePpm uint32,
+) (
+	estimate types.LiquidationProceedsEstimate,
+	err error,
+) {
+	inputs, err := k.GetRiskInputs(ctx, subaccountId)
+	if err != nil {
+		return estimate, err
+	}
+	return salib.EstimateLiquidationProceeds(
+		inputs.SettledSubaccount,
+		inputs.PerpInfos,
+		perpetualId,
+		depth,
+		maxLiquidationFeePpm,
+	)
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLiquidationProceedsEstimate(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+		t,
+		true,
+	)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+	p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+	_, err := perpetualsKeeper.CreatePerpetual(
+		ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.MarketType,
+	)
+	require.NoError(t, err)
+
+	// 1 BTC long with -$48,000 of USDC, which is bankrupt below a BTC price of $48,000.
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:             &constants.Alice_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-48_000_000_000)),
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+		},
+	})
+
+	// 0.5 BTC is bid at $49,000 and 1 BTC at $46,000, and liquidations pay a fee of at most 0.5%.
+	estimate, err := keeper.GetLiquidationProceedsEstimate(
+		ctx,
+		constants.Alice_Num0,
+		0,
+		[]types.DepthLevel{
+			{Price: 4_900_000_000, Quantums: 50_000_000},
+			{Price: 4_600_000_000, Quantums: 100_000_000},
+		},
+		5_000,
+	)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100_000_000), estimate.FilledQuantums)
+	// $24,500 + $23,000.
+	require.Equal(t, big.NewInt(47_500_000_000), estimate.ProceedsQuoteQuantums)
+	// The insurance fund receives the liquidation fee of 0.5% of $24,500 out of the surplus of the first
+	// level over the bankruptcy price, and covers the deficit of the second level against the bankruptcy
+	// price of $47,999.99999 for 0.5 BTC.
+	require.Equal(
+		t,
+		big.NewInt(122_500_000+23_000_000_000-23_999_999_995),
+		estimate.InsuranceFundDeltaQuoteQuantums,
+	)
+
+	_, err = keeper.GetLiquidationProceedsEstimate(
+		ctx,
+		constants.Bob_Num0,
+		0,
+		[]types.DepthLevel{{Price: 4_900_000_000, Quantums: 50_000_000}},
+		5_000,
+	)
+	require.ErrorIs(t, err, types.ErrPerpPositionNotFound)
+}
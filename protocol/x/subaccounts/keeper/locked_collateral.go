@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetLockedCollateral returns the collateral of the subaccount, in quote quantums, that is locked by pending
+// withdrawals, such as outbound transfers held by the rate limit flow until they are acknowledged. Locked
+// collateral is subtracted from the net collateral of the subaccount before its margin requirements are
+// checked. No collateral is locked by default.
+func (k Keeper) GetLockedCollateral(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.LockedCollateralKeyPrefix))
+	b := store.Get(subaccountId.ToStateKey())
+	if b == nil {
+		return 0
+	}
+
+	lockedCollateral := gogotypes.UInt64Value{}
+	k.cdc.MustUnmarshal(b, &lockedCollateral)
+	return lockedCollateral.Value
+}
+
+// SetLockedCollateral sets the collateral of the subaccount, in quote quantums, that is locked by pending
+// withdrawals. Setting it to zero unlocks all collateral of the subaccount.
+func (k Keeper) SetLockedCollateral(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	quoteQuantums uint64,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.LockedCollateralKeyPrefix))
+	if quoteQuantums == 0 {
+		store.Delete(subaccountId.ToStateKey())
+		return
+	}
+	store.Set(
+		subaccountId.ToStateKey(),
+		k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: quoteQuantums}),
+	)
+}
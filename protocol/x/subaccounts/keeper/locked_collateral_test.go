@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetLockedCollateral(t *testing.T) {
+	ctx, keeper, _, _, _, _, _, _, _, _, _ := keepertest.SubaccountsKeepers(t, true)
+	require.Equal(t, uint64(0), keeper.GetLockedCollateral(ctx, constants.Alice_Num0))
+
+	keeper.SetLockedCollateral(ctx, constants.Alice_Num0, 2_000_000_000)
+	require.Equal(t, uint64(2_000_000_000), keeper.GetLockedCollateral(ctx, constants.Alice_Num0))
+	require.Equal(t, uint64(0), keeper.GetLockedCollateral(ctx, constants.Alice_Num1))
+
+	keeper.SetLockedCollateral(ctx, constants.Alice_Num0, 0)
+	require.Equal(t, uint64(0), keeper.GetLockedCollateral(ctx, constants.Alice_Num0))
+}
+
+func TestUpdateSubaccounts_LockedCollateral(t *testing.T) {
+	tests := map[string]struct {
+		lockedCollateral uint64
+
+		expectedNC     *big.Int
+		expectedResult types.UpdateResult
+	}{
+		"open within initial margin is allowed without locked collateral": {
+			lockedCollateral: 0,
+			expectedNC:       big.NewInt(11_000_000_000),
+			expectedResult:   types.Success,
+		},
+		"open within initial margin is allowed with some collateral locked": {
+			// NC of $11,000 - $1,000 = $10,000 meets the IMR of $10,000.
+			lockedCollateral: 1_000_000_000,
+			expectedNC:       big.NewInt(10_000_000_000),
+			expectedResult:   types.Success,
+		},
+		"pending withdrawal rejects an open": {
+			// NC of $11,000 - $2,000 = $9,000 is below the IMR of $10,000.
+			lockedCollateral: 2_000_000_000,
+			expectedNC:       big.NewInt(9_000_000_000),
+			expectedResult:   types.NewlyUndercollateralized,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(11_000_000_000)), // $11,000
+			})
+			keeper.SetLockedCollateral(ctx, constants.Alice_Num0, tc.lockedCollateral)
+
+			risk, err := keeper.GetRiskForSubaccount(ctx, constants.Alice_Num0, true)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedNC, risk.NC)
+
+			success, successPerUpdate, err := keeper.CanUpdateSubaccounts(
+				ctx,
+				[]types.Update{
+					{
+						SubaccountId: constants.Alice_Num0,
+						AssetUpdates: []types.AssetUpdate{
+							{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(-50_000_000_000)}, // -$50,000
+						},
+						PerpetualUpdates: []types.PerpetualUpdate{
+							{PerpetualId: 0, BigQuantumsDelta: big.NewInt(100_000_000)}, // 1 BTC
+						},
+					},
+				},
+				types.CollatCheck,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedResult.IsSuccess(), success)
+			require.Equal(t, []types.UpdateResult{tc.expectedResult}, successPerUpdate)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetMarginMode returns the margin mode of the subaccount. Subaccounts default to cross margin.
+func (k Keeper) GetMarginMode(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+) types.MarginMode {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.MarginModeKeyPrefix))
+	b := store.Get(subaccountId.ToStateKey())
+	if b == nil {
+		return types.CrossMargin
+	}
+
+	mode := gogotypes.UInt32Value{}
+	k.cdc.MustUnmarshal(b, &mode)
+	return types.MarginMode(mode.Value)
+}
+
+// SetMarginMode switches the subaccount to the given margin mode. The switch is only permitted if
+// the subaccount satisfies the rules of the new margin mode:
+//   - A subaccount in isolated margin mode can hold a position in at most one perpetual.
+//   - The subaccount must be initially collateralized under the new margin mode. An isolated subaccount
+//     holds at most one perpetual, so the risk of the subaccount under either mode is the risk
+//     returned by `GetRiskForSubaccount`.
+func (k Keeper) SetMarginMode(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	mode types.MarginMode,
+) error {
+	if !mode.IsValid() {
+		return errorsmod.Wrapf(types.ErrInvalidMarginMode, "%d", mode)
+	}
+	if k.GetMarginMode(ctx, subaccountId) == mode {
+		return nil
+	}
+
+	inputs, err := k.GetRiskInputs(ctx, subaccountId)
+	if err != nil {
+		return err
+	}
+	if mode == types.IsolatedMargin && len(inputs.SettledSubaccount.PerpetualPositions) > 1 {
+		return errorsmod.Wrapf(
+			types.ErrIsolatedMarginModeMultiplePerpetuals,
+			"subaccount %v has positions in %d perpetuals",
+			subaccountId,
+			len(inputs.SettledSubaccount.PerpetualPositions),
+		)
+	}
+
+	risk, err := salib.GetRiskForSubaccount(inputs.SettledSubaccount, inputs.PerpInfos)
+	if err != nil {
+		return err
+	}
+	if !risk.IsInitialCollateralized() {
+		return errorsmod.Wrapf(
+			types.ErrMarginModeChangeUndercollateralized,
+			"subaccount %v, mode %v, net collateral %v, initial margin requirement %v",
+			subaccountId,
+			mode,
+			risk.NC,
+			risk.IMR,
+		)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.MarginModeKeyPrefix))
+	if mode == types.CrossMargin {
+		store.Delete(subaccountId.ToStateKey())
+		return nil
+	}
+	store.Set(
+		subaccountId.ToStateKey(),
+		k.cdc.MustMarshal(&gogotypes.UInt32Value{Value: uint32(mode)}),
+	)
+	return nil
+}
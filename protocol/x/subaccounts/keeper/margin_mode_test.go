@@ -0,0 +1,221 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetMarginMode(t *testing.T) {
+	btcLong := testutil.CreateSinglePerpetualPosition(
+		0,
+		big.NewInt(100_000_000), // 1 BTC
+		big.NewInt(0),
+		big.NewInt(0),
+	)
+	ethShort := testutil.CreateSinglePerpetualPosition(
+		1,
+		big.NewInt(-1_000_000_000), // -1 ETH
+		big.NewInt(0),
+		big.NewInt(0),
+	)
+
+	tests := map[string]struct {
+		assetPositions     []*types.AssetPosition
+		perpetualPositions []*types.PerpetualPosition
+		initialMode        types.MarginMode
+		mode               types.MarginMode
+
+		expectedErr  error
+		expectedMode types.MarginMode
+	}{
+		"switch empty subaccount to isolated": {
+			initialMode:  types.CrossMargin,
+			mode:         types.IsolatedMargin,
+			expectedMode: types.IsolatedMargin,
+		},
+		"switch subaccount with a single perpetual to isolated": {
+			assetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(-40_000_000_000)), // -$40,000
+			perpetualPositions: []*types.PerpetualPosition{btcLong},
+			initialMode:        types.CrossMargin,
+			mode:               types.IsolatedMargin,
+			expectedMode:       types.IsolatedMargin,
+		},
+		"switch isolated subaccount back to cross": {
+			assetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(-40_000_000_000)), // -$40,000
+			perpetualPositions: []*types.PerpetualPosition{btcLong},
+			initialMode:        types.IsolatedMargin,
+			mode:               types.CrossMargin,
+			expectedMode:       types.CrossMargin,
+		},
+		"switching to the current mode is a no-op": {
+			initialMode:  types.CrossMargin,
+			mode:         types.CrossMargin,
+			expectedMode: types.CrossMargin,
+		},
+		"cannot switch subaccount with multiple perpetuals to isolated": {
+			assetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)), // $10,000
+			perpetualPositions: []*types.PerpetualPosition{btcLong, ethShort},
+			initialMode:        types.CrossMargin,
+			mode:               types.IsolatedMargin,
+			expectedErr:        types.ErrIsolatedMarginModeMultiplePerpetuals,
+			expectedMode:       types.CrossMargin,
+		},
+		"cannot switch undercollateralized subaccount to isolated": {
+			// NC = $50,000 - $45,000 = $5,000, IMR = $50,000 * 20% = $10,000.
+			assetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(-45_000_000_000)), // -$45,000
+			perpetualPositions: []*types.PerpetualPosition{btcLong},
+			initialMode:        types.CrossMargin,
+			mode:               types.IsolatedMargin,
+			expectedErr:        types.ErrMarginModeChangeUndercollateralized,
+			expectedMode:       types.CrossMargin,
+		},
+		"cannot switch undercollateralized subaccount to cross": {
+			assetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(-45_000_000_000)), // -$45,000
+			perpetualPositions: []*types.PerpetualPosition{btcLong},
+			initialMode:        types.IsolatedMargin,
+			mode:               types.CrossMargin,
+			expectedErr:        types.ErrMarginModeChangeUndercollateralized,
+			expectedMode:       types.IsolatedMargin,
+		},
+		"invalid margin mode": {
+			initialMode:  types.CrossMargin,
+			mode:         types.MarginMode(2),
+			expectedErr:  types.ErrInvalidMarginMode,
+			expectedMode: types.CrossMargin,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			for _, p := range []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				constants.EthUsd_20PercentInitial_10PercentMaintenance,
+			} {
+				_, err := perpetualsKeeper.CreatePerpetual(
+					ctx,
+					p.Params.Id,
+					p.Params.Ticker,
+					p.Params.MarketId,
+					p.Params.AtomicResolution,
+					p.Params.DefaultFundingPpm,
+					p.Params.LiquidityTier,
+					p.Params.MarketType,
+				)
+				require.NoError(t, err)
+			}
+
+			// Set the initial margin mode before the positions so that it is always permitted.
+			require.NoError(t, keeper.SetMarginMode(ctx, constants.Alice_Num0, tc.initialMode))
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:                 &constants.Alice_Num0,
+				AssetPositions:     tc.assetPositions,
+				PerpetualPositions: tc.perpetualPositions,
+			})
+
+			err := keeper.SetMarginMode(ctx, constants.Alice_Num0, tc.mode)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expectedMode, keeper.GetMarginMode(ctx, constants.Alice_Num0))
+		})
+	}
+}
+
+func TestUpdateSubaccounts_IsolatedMarginMode(t *testing.T) {
+	tests := map[string]struct {
+		perpetualUpdates []types.PerpetualUpdate
+
+		expectedResult types.UpdateResult
+	}{
+		"can increase the existing position": {
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(10_000_000)},
+			},
+			expectedResult: types.Success,
+		},
+		"cannot open a position in another perpetual": {
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 1, BigQuantumsDelta: big.NewInt(1_000_000_000)},
+			},
+			expectedResult: types.ViolatesIsolatedSubaccountConstraints,
+		},
+		"can close the existing position and open a position in another perpetual": {
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(-100_000_000)},
+				{PerpetualId: 1, BigQuantumsDelta: big.NewInt(1_000_000_000)},
+			},
+			expectedResult: types.Success,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			for _, p := range []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				constants.EthUsd_20PercentInitial_10PercentMaintenance,
+			} {
+				_, err := perpetualsKeeper.CreatePerpetual(
+					ctx,
+					p.Params.Id,
+					p.Params.Ticker,
+					p.Params.MarketId,
+					p.Params.AtomicResolution,
+					p.Params.DefaultFundingPpm,
+					p.Params.LiquidityTier,
+					p.Params.MarketType,
+				)
+				require.NoError(t, err)
+			}
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(100_000_000_000)), // $100,000
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(
+						0,
+						big.NewInt(100_000_000), // 1 BTC
+						big.NewInt(0),
+						big.NewInt(0),
+					),
+				},
+			})
+			require.NoError(t, keeper.SetMarginMode(ctx, constants.Alice_Num0, types.IsolatedMargin))
+
+			_, successPerUpdate, err := keeper.CanUpdateSubaccounts(
+				ctx,
+				[]types.Update{
+					{
+						SubaccountId:     constants.Alice_Num0,
+						PerpetualUpdates: tc.perpetualUpdates,
+					},
+				},
+				types.CollatCheck,
+			)
+			require.NoError(t, err)
+			require.Equal(t, []types.UpdateResult{tc.expectedResult}, successPerUpdate)
+		})
+	}
+}
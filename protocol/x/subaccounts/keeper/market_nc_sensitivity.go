@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+// GetMarketNcSensitivity returns the net signed quantums of all positions in the perpetual across all
+// subaccounts, and the change in the total net collateral of all subaccounts in quote quantums when the
+// market price of the perpetual moves up by one unit, i.e. by `10^exponent` of the market price. The change
+// is `netQuantums * 10^(priceExponent + atomicResolution - quoteAtomicResolution)` and is returned exactly,
+// without rounding. A net-long population gains net collateral when the price moves up, and a net-short
+// population loses it.
+func (k Keeper) GetMarketNcSensitivity(
+	ctx sdk.Context,
+	perpetualId uint32,
+) (
+	netQuantums *big.Int,
+	ncPerPriceUnit *big.Rat,
+	err error,
+) {
+	perpInfos, err := k.getPerpInfos(ctx, map[uint32]struct{}{perpetualId: {}})
+	if err != nil {
+		return nil, nil, err
+	}
+	perpInfo := perpInfos.MustGet(perpetualId)
+
+	netQuantums = new(big.Int)
+	for _, subaccount := range k.GetAllSubaccount(ctx) {
+		for _, pos := range subaccount.PerpetualPositions {
+			if pos.PerpetualId == perpetualId {
+				netQuantums.Add(netQuantums, pos.GetBigQuantums())
+			}
+		}
+	}
+
+	pow10, inverse := lib.BigPow10(
+		perpInfo.Price.Exponent + perpInfo.Perpetual.Params.AtomicResolution - lib.QuoteCurrencyAtomicResolution,
+	)
+	if inverse {
+		ncPerPriceUnit = new(big.Rat).SetFrac(netQuantums, pow10)
+	} else {
+		ncPerPriceUnit = new(big.Rat).SetInt(new(big.Int).Mul(netQuantums, pow10))
+	}
+	return netQuantums, ncPerPriceUnit, nil
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMarketNcSensitivity(t *testing.T) {
+	tests := map[string]struct {
+		btcQuantums []int64
+
+		expectedNetQuantums    *big.Int
+		expectedNcPerPriceUnit *big.Rat
+	}{
+		"no positions": {
+			expectedNetQuantums:    big.NewInt(0),
+			expectedNcPerPriceUnit: new(big.Rat),
+		},
+		"net-long population": {
+			// Net long 1.5 BTC. A price unit is $0.00001, so the net collateral changes by $0.000015.
+			btcQuantums:            []int64{200_000_000, -50_000_000},
+			expectedNetQuantums:    big.NewInt(150_000_000),
+			expectedNcPerPriceUnit: big.NewRat(15, 1),
+		},
+		"net-short population": {
+			// Net short 0.75 BTC.
+			btcQuantums:            []int64{-100_000_000, 25_000_000},
+			expectedNetQuantums:    big.NewInt(-75_000_000),
+			expectedNcPerPriceUnit: big.NewRat(-15, 2),
+		},
+		"balanced population": {
+			btcQuantums:            []int64{100_000_000, -100_000_000},
+			expectedNetQuantums:    big.NewInt(0),
+			expectedNcPerPriceUnit: new(big.Rat),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			ids := []types.SubaccountId{constants.Alice_Num0, constants.Bob_Num0}
+			for i, quantums := range tc.btcQuantums {
+				keeper.SetSubaccount(ctx, types.Subaccount{
+					Id:             &ids[i],
+					AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(100_000_000_000)),
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(0, big.NewInt(quantums), big.NewInt(0), big.NewInt(0)),
+					},
+				})
+			}
+			// A subaccount without a position does not contribute.
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Carl_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(100_000_000_000)),
+			})
+
+			netQuantums, ncPerPriceUnit, err := keeper.GetMarketNcSensitivity(ctx, 0)
+			require.NoError(t, err)
+			require.Equal(t, 0, tc.expectedNetQuantums.Cmp(netQuantums), "expected %s, got %s",
+				tc.expectedNetQuantums, netQuantums)
+			require.Equal(t, 0, tc.expectedNcPerPriceUnit.Cmp(ncPerPriceUnit), "expected %s, got %s",
+				tc.expectedNcPerPriceUnit, ncPerPriceUnit)
+
+			_, _, err = keeper.GetMarketNcSensitivity(ctx, 1)
+			require.ErrorIs(t, err, perptypes.ErrPerpetualDoesNotExist)
+		})
+	}
+}
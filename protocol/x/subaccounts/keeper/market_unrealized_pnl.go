@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetMarketUnrealizedPnl returns the sum of the unrealized PnL in quote quantums of the positions of all
+// subaccounts in the perpetual, the gains of the winners minus the losses of the losers. The unrealized PnL
+// of a position is its signed notional at the market price minus its cost basis, as returned by
+// `GetRealizedPnlOnClose`. Funding and fees are not included. Positions whose cost basis is unknown are
+// skipped.
+//
+// Since every fill creates equal and opposite positions at the same price, the sum is close to zero unless
+// positions were created or removed outside of matching. A large result reveals skew in the market.
+func (k Keeper) GetMarketUnrealizedPnl(
+	ctx sdk.Context,
+	perpetualId uint32,
+) (
+	pnl *big.Int,
+	err error,
+) {
+	perpInfos, err := k.getPerpInfos(ctx, map[uint32]struct{}{perpetualId: {}})
+	if err != nil {
+		return nil, err
+	}
+	perpInfo := perpInfos.MustGet(perpetualId)
+
+	pnl = new(big.Int)
+	k.ForEachSubaccount(ctx, func(subaccount types.Subaccount) (finished bool) {
+		position, exists := subaccount.GetPerpetualPositionForId(perpetualId)
+		if !exists {
+			return false
+		}
+		costBasis := position.GetBigCostBasis()
+		if costBasis.Sign() == 0 {
+			return false
+		}
+
+		var notional *big.Int
+		notional, err = salib.PositionNotional(position.GetBigQuantums(), perpInfo)
+		if err != nil {
+			return true
+		}
+		if !position.GetIsLong() {
+			notional.Neg(notional)
+		}
+		pnl.Add(pnl, notional.Sub(notional, costBasis))
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pnl, nil
+}
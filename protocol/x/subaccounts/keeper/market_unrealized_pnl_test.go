@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMarketUnrealizedPnl(t *testing.T) {
+	tests := map[string]struct {
+		subaccounts []types.Subaccount
+
+		expectedPnl *big.Int
+	}{
+		"no subaccounts": {
+			expectedPnl: big.NewInt(0),
+		},
+		"offsetting positions at the same entry net to zero": {
+			// Long and short 1 BTC entered at $45,000 and marked at $50,000.
+			subaccounts: []types.Subaccount{
+				{
+					Id: &constants.Alice_Num0,
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
+							0,
+							big.NewInt(100_000_000),
+							big.NewInt(0),
+							big.NewInt(0),
+							big.NewInt(45_000_000_000),
+						),
+					},
+				},
+				{
+					Id: &constants.Bob_Num0,
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
+							0,
+							big.NewInt(-100_000_000),
+							big.NewInt(0),
+							big.NewInt(0),
+							big.NewInt(-45_000_000_000),
+						),
+					},
+				},
+			},
+			expectedPnl: big.NewInt(0),
+		},
+		"offsetting positions at different entries": {
+			// The long entered at $40,000 gains $10,000 and the short entered at $45,000 loses $5,000.
+			subaccounts: []types.Subaccount{
+				{
+					Id: &constants.Alice_Num0,
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
+							0,
+							big.NewInt(100_000_000),
+							big.NewInt(0),
+							big.NewInt(0),
+							big.NewInt(40_000_000_000),
+						),
+					},
+				},
+				{
+					Id: &constants.Bob_Num0,
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
+							0,
+							big.NewInt(-100_000_000),
+							big.NewInt(0),
+							big.NewInt(0),
+							big.NewInt(-45_000_000_000),
+						),
+					},
+				},
+			},
+			expectedPnl: big.NewInt(5_000_000_000),
+		},
+		"positions with unknown cost basis and in other perpetuals are not included": {
+			subaccounts: []types.Subaccount{
+				{
+					Id: &constants.Alice_Num0,
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
+							0,
+							big.NewInt(-50_000_000),
+							big.NewInt(0),
+							big.NewInt(0),
+							big.NewInt(-30_000_000_000),
+						),
+						testutil.CreateSinglePerpetualPositionWithCostBasis(
+							1,
+							big.NewInt(1_000_000_000),
+							big.NewInt(0),
+							big.NewInt(0),
+							big.NewInt(1_000_000_000),
+						),
+					},
+				},
+				{
+					Id: &constants.Bob_Num0,
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(0, big.NewInt(50_000_000), big.NewInt(0), big.NewInt(0)),
+					},
+				},
+			},
+			// 0.5 BTC shorted at $60,000 gains $5,000.
+			expectedPnl: big.NewInt(5_000_000_000),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			for _, p := range []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				constants.EthUsd_20PercentInitial_10PercentMaintenance,
+			} {
+				_, err := perpetualsKeeper.CreatePerpetual(
+					ctx,
+					p.Params.Id,
+					p.Params.Ticker,
+					p.Params.MarketId,
+					p.Params.AtomicResolution,
+					p.Params.DefaultFundingPpm,
+					p.Params.LiquidityTier,
+					p.Params.MarketType,
+				)
+				require.NoError(t, err)
+			}
+
+			for _, subaccount := range tc.subaccounts {
+				keeper.SetSubaccount(ctx, subaccount)
+			}
+
+			pnl, err := keeper.GetMarketUnrealizedPnl(ctx, 0)
+			require.NoError(t, err)
+			require.Equal(t, 0, tc.expectedPnl.Cmp(pnl), "expected %s, got %s", tc.expectedPnl, pnl)
+		})
+	}
+}
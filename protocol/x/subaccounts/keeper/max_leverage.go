@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	ante_types "github.com/dydxprotocol/v4-chain/protocol/app/ante/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetMaxLeveragePpm returns the self-imposed leverage cap of the subaccount, in parts-per-million. Updates
+// that open or increase a perpetual position must leave the leverage of the subaccount, the total notional
+// of its perpetual positions divided by its net collateral, at or below the cap. This applies in addition
+// to the initial margin requirements of the perpetuals. A cap of zero, the default, disables it.
+//
+// The cap is read for every opening update, so reading it does not consume gas.
+func (k Keeper) GetMaxLeveragePpm(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+) uint32 {
+	noGasCtx := ctx.WithGasMeter(ante_types.NewFreeInfiniteGasMeter())
+	store := prefix.NewStore(noGasCtx.KVStore(k.storeKey), []byte(types.MaxLeveragePpmKeyPrefix))
+	b := store.Get(subaccountId.ToStateKey())
+	if b == nil {
+		return 0
+	}
+
+	maxLeveragePpm := gogotypes.UInt32Value{}
+	k.cdc.MustUnmarshal(b, &maxLeveragePpm)
+	return maxLeveragePpm.Value
+}
+
+// SetMaxLeveragePpm sets the self-imposed leverage cap of the subaccount, in parts-per-million. A cap of
+// zero removes it. Existing positions are not affected until the subaccount next opens a position.
+func (k Keeper) SetMaxLeveragePpm(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	maxLeveragePpm uint32,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.MaxLeveragePpmKeyPrefix))
+	if maxLeveragePpm == 0 {
+		store.Delete(subaccountId.ToStateKey())
+		return
+	}
+	store.Set(
+		subaccountId.ToStateKey(),
+		k.cdc.MustMarshal(&gogotypes.UInt32Value{Value: maxLeveragePpm}),
+	)
+}
+
+// GetAllMaxLeverages returns the self-imposed leverage caps of all subaccounts that have one, ordered by
+// the state key of the subaccount.
+func (k Keeper) GetAllMaxLeverages(ctx sdk.Context) []types.SubaccountMaxLeverage {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.MaxLeveragePpmKeyPrefix))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	maxLeverages := make([]types.SubaccountMaxLeverage, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var subaccountId types.SubaccountId
+		k.cdc.MustUnmarshal(iterator.Key(), &subaccountId)
+		maxLeveragePpm := gogotypes.UInt32Value{}
+		k.cdc.MustUnmarshal(iterator.Value(), &maxLeveragePpm)
+		maxLeverages = append(maxLeverages, types.SubaccountMaxLeverage{
+			SubaccountId:   subaccountId,
+			MaxLeveragePpm: maxLeveragePpm.Value,
+		})
+	}
+	return maxLeverages
+}
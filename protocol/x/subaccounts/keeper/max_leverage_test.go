@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetMaxLeveragePpm(t *testing.T) {
+	ctx, keeper, _, _, _, _, _, _, _, _, _ := keepertest.SubaccountsKeepers(t, true)
+	require.Equal(t, uint32(0), keeper.GetMaxLeveragePpm(ctx, constants.Alice_Num0))
+
+	keeper.SetMaxLeveragePpm(ctx, constants.Alice_Num0, 5_000_000)
+	require.Equal(t, uint32(5_000_000), keeper.GetMaxLeveragePpm(ctx, constants.Alice_Num0))
+	require.Equal(t, uint32(0), keeper.GetMaxLeveragePpm(ctx, constants.Alice_Num1))
+
+	keeper.SetMaxLeveragePpm(ctx, constants.Alice_Num0, 0)
+	require.Equal(t, uint32(0), keeper.GetMaxLeveragePpm(ctx, constants.Alice_Num0))
+}
+
+func TestUpdateSubaccounts_MaxLeverage(t *testing.T) {
+	tests := map[string]struct {
+		maxLeveragePpm     uint32
+		usdcQuantums       *big.Int
+		perpetualPositions []*types.PerpetualPosition
+		assetUpdates       []types.AssetUpdate
+		perpetualUpdates   []types.PerpetualUpdate
+
+		expectedResult types.UpdateResult
+	}{
+		"open is allowed without a cap": {
+			maxLeveragePpm: 0,
+			usdcQuantums:   big.NewInt(11_000_000_000), // $11,000
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(-50_000_000_000)}, // -$50,000
+			},
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(100_000_000)}, // 1 BTC
+			},
+			expectedResult: types.Success,
+		},
+		"open within the cap is allowed": {
+			// Leverage of $50,000 / $11,000 is below 5x.
+			maxLeveragePpm: 5_000_000,
+			usdcQuantums:   big.NewInt(11_000_000_000), // $11,000
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(-50_000_000_000)}, // -$50,000
+			},
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(100_000_000)}, // 1 BTC
+			},
+			expectedResult: types.Success,
+		},
+		"open at the cap is allowed": {
+			// Leverage of $50,000 / $12,500 is 4x.
+			maxLeveragePpm: 4_000_000,
+			usdcQuantums:   big.NewInt(12_500_000_000), // $12,500
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(-50_000_000_000)}, // -$50,000
+			},
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(100_000_000)}, // 1 BTC
+			},
+			expectedResult: types.Success,
+		},
+		"open within initial margin is rejected above the cap": {
+			// NC of $11,000 is above the IMR of $10,000, but leverage of $50,000 / $11,000 is above 4x.
+			maxLeveragePpm: 4_000_000,
+			usdcQuantums:   big.NewInt(11_000_000_000), // $11,000
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(-50_000_000_000)}, // -$50,000
+			},
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(100_000_000)}, // 1 BTC
+			},
+			expectedResult: types.ViolatesMaxLeverage,
+		},
+		"reducing a position is not subject to the cap": {
+			// Leverage of $75,000 / $11,000 is above 5x after the update, but the update reduces risk.
+			maxLeveragePpm: 5_000_000,
+			usdcQuantums:   big.NewInt(-89_000_000_000), // -$89,000
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(0, big.NewInt(200_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(25_000_000_000)}, // $25,000
+			},
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(-50_000_000)}, // -0.5 BTC
+			},
+			expectedResult: types.Success,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			subaccount := types.Subaccount{
+				Id:                 &constants.Alice_Num0,
+				AssetPositions:     testutil.CreateUsdcAssetPositions(tc.usdcQuantums),
+				PerpetualPositions: tc.perpetualPositions,
+			}
+			keeper.SetSubaccount(ctx, subaccount)
+			keeper.SetMaxLeveragePpm(ctx, constants.Alice_Num0, tc.maxLeveragePpm)
+
+			success, successPerUpdate, err := keeper.CanUpdateSubaccounts(
+				ctx,
+				[]types.Update{
+					{
+						SubaccountId:     constants.Alice_Num0,
+						AssetUpdates:     tc.assetUpdates,
+						PerpetualUpdates: tc.perpetualUpdates,
+					},
+				},
+				types.CollatCheck,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedResult.IsSuccess(), success)
+			require.Equal(t, []types.UpdateResult{tc.expectedResult}, successPerUpdate)
+		})
+	}
+}
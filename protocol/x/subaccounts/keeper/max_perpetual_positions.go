@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	ante_types "github.com/dydxprotocol/v4-chain/protocol/app/ante/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetMaxPerpetualPositions returns the maximum number of perpetual positions a subaccount may hold. Updates
+// that open a new position beyond the limit are rejected, while updates to existing positions are not. A
+// limit of zero, the default, disables it.
+//
+// The limit is read for every subaccount update, so reading it does not consume gas.
+func (k Keeper) GetMaxPerpetualPositions(ctx sdk.Context) uint32 {
+	noGasCtx := ctx.WithGasMeter(ante_types.NewFreeInfiniteGasMeter())
+	store := noGasCtx.KVStore(k.storeKey)
+	b := store.Get([]byte(types.MaxPerpetualPositionsKey))
+	if b == nil {
+		return 0
+	}
+
+	maxPerpetualPositions := gogotypes.UInt32Value{}
+	k.cdc.MustUnmarshal(b, &maxPerpetualPositions)
+	return maxPerpetualPositions.Value
+}
+
+// SetMaxPerpetualPositions sets the maximum number of perpetual positions a subaccount may hold.
+func (k Keeper) SetMaxPerpetualPositions(ctx sdk.Context, maxPerpetualPositions uint32) {
+	store := ctx.KVStore(k.storeKey)
+	if maxPerpetualPositions == 0 {
+		store.Delete([]byte(types.MaxPerpetualPositionsKey))
+		return
+	}
+	store.Set(
+		[]byte(types.MaxPerpetualPositionsKey),
+		k.cdc.MustMarshal(&gogotypes.UInt32Value{Value: maxPerpetualPositions}),
+	)
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetMaxPerpetualPositions(t *testing.T) {
+	ctx, keeper, _, _, _, _, _, _, _, _, _ := keepertest.SubaccountsKeepers(t, true)
+	require.Equal(t, uint32(0), keeper.GetMaxPerpetualPositions(ctx))
+
+	keeper.SetMaxPerpetualPositions(ctx, 10)
+	require.Equal(t, uint32(10), keeper.GetMaxPerpetualPositions(ctx))
+
+	keeper.SetMaxPerpetualPositions(ctx, 0)
+	require.Equal(t, uint32(0), keeper.GetMaxPerpetualPositions(ctx))
+}
+
+func TestUpdateSubaccounts_MaxPerpetualPositions(t *testing.T) {
+	tests := map[string]struct {
+		maxPerpetualPositions uint32
+		perpetualUpdates      []types.PerpetualUpdate
+
+		expectedResult types.UpdateResult
+	}{
+		"opening a position is allowed without a limit": {
+			maxPerpetualPositions: 0,
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 1, BigQuantumsDelta: big.NewInt(1_000_000_000)}, // 1 ETH
+			},
+			expectedResult: types.Success,
+		},
+		"opening a position within the limit is allowed": {
+			maxPerpetualPositions: 2,
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 1, BigQuantumsDelta: big.NewInt(1_000_000_000)}, // 1 ETH
+			},
+			expectedResult: types.Success,
+		},
+		"opening a position beyond the limit is rejected": {
+			maxPerpetualPositions: 1,
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 1, BigQuantumsDelta: big.NewInt(1_000_000_000)}, // 1 ETH
+			},
+			expectedResult: types.ViolatesMaxPerpetualPositions,
+		},
+		"increasing an existing position at the limit is allowed": {
+			maxPerpetualPositions: 1,
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(10_000_000)}, // 0.1 BTC
+			},
+			expectedResult: types.Success,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			for _, p := range []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				constants.EthUsd_20PercentInitial_10PercentMaintenance,
+			} {
+				_, err := perpetualsKeeper.CreatePerpetual(
+					ctx,
+					p.Params.Id,
+					p.Params.Ticker,
+					p.Params.MarketId,
+					p.Params.AtomicResolution,
+					p.Params.DefaultFundingPpm,
+					p.Params.LiquidityTier,
+					p.Params.MarketType,
+				)
+				require.NoError(t, err)
+			}
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(100_000_000_000)), // $100,000
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+				},
+			})
+			keeper.SetMaxPerpetualPositions(ctx, tc.maxPerpetualPositions)
+
+			success, successPerUpdate, err := keeper.CanUpdateSubaccounts(
+				ctx,
+				[]types.Update{
+					{
+						SubaccountId:     constants.Alice_Num0,
+						PerpetualUpdates: tc.perpetualUpdates,
+					},
+				},
+				types.CollatCheck,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedResult.IsSuccess(), success)
+			require.Equal(t, []types.UpdateResult{tc.expectedResult}, successPerUpdate)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateSubaccounts_MaxPositionNotional(t *testing.T) {
+	tests := map[string]struct {
+		quantums         int64
+		assetUpdates     []types.AssetUpdate
+		perpetualUpdates []types.PerpetualUpdate
+		updateType       types.UpdateType
+
+		expectedResult types.UpdateResult
+	}{
+		"increasing a long to the cap is allowed": {
+			quantums: 100_000_000, // 1 BTC
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(-50_000_000_000)}, // -$50,000
+			},
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(100_000_000)}, // 1 BTC
+			},
+			updateType:     types.CollatCheck,
+			expectedResult: types.Success,
+		},
+		"increasing a long to one quote quantum over the cap is rejected": {
+			quantums: 100_000_000, // 1 BTC
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(-50_000_000_001)},
+			},
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(100_000_200)},
+			},
+			updateType:     types.CollatCheck,
+			expectedResult: types.ViolatesMaxPositionNotional,
+		},
+		"flipping to a short at the cap is allowed": {
+			quantums: 100_000_000, // 1 BTC
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(150_000_000_000)}, // $150,000
+			},
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(-300_000_000)}, // -3 BTC
+			},
+			updateType:     types.CollatCheck,
+			expectedResult: types.Success,
+		},
+		"flipping to a short one quote quantum over the cap is rejected": {
+			quantums: 100_000_000, // 1 BTC
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(150_000_000_001)},
+			},
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(-300_000_200)},
+			},
+			updateType:     types.CollatCheck,
+			expectedResult: types.ViolatesMaxPositionNotional,
+		},
+		"reducing a position over the cap is allowed": {
+			quantums: 300_000_000, // 3 BTC
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(25_000_000_000)}, // $25,000
+			},
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(-50_000_000)}, // -0.5 BTC
+			},
+			updateType:     types.CollatCheck,
+			expectedResult: types.Success,
+		},
+		"deposit to a subaccount with a position over the cap is allowed": {
+			quantums: 300_000_000, // 3 BTC
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(1_000_000_000)}, // $1,000
+			},
+			updateType:     types.Deposit,
+			expectedResult: types.Success,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			// Cap positions in the perpetual at $100,000, or 2 BTC.
+			lt, err := perpetualsKeeper.GetLiquidityTier(ctx, p.Params.LiquidityTier)
+			require.NoError(t, err)
+			lt.MaxPositionNotionalQuoteQuantums = 100_000_000_000
+			require.NoError(t, perpetualsKeeper.ValidateAndSetLiquidityTier(ctx, lt))
+
+			subaccount := types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(100_000_000_000)), // $100,000
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(
+						0,
+						big.NewInt(tc.quantums),
+						big.NewInt(0),
+						big.NewInt(0),
+					),
+				},
+			}
+			keeper.SetSubaccount(ctx, subaccount)
+
+			success, successPerUpdate, err := keeper.UpdateSubaccounts(
+				ctx,
+				[]types.Update{
+					{
+						SubaccountId:     constants.Alice_Num0,
+						AssetUpdates:     tc.assetUpdates,
+						PerpetualUpdates: tc.perpetualUpdates,
+					},
+				},
+				tc.updateType,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedResult.IsSuccess(), success)
+			require.Equal(t, []types.UpdateResult{tc.expectedResult}, successPerUpdate)
+			if !success {
+				require.Equal(t, subaccount, keeper.GetSubaccount(ctx, constants.Alice_Num0))
+			}
+		})
+	}
+}
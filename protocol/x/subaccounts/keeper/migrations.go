@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	perplib "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// MigratePerpetualPositions rewrites every subaccount record encoded with an older version of
+// perpetual positions in `types.CurrentPerpetualPositionVersion`. Records are upgraded lazily in
+// memory whenever they are read, so this only persists the upgrades, and can be run in an upgrade
+// handler to remove older records from state. Returns the number of records rewritten.
+func (k Keeper) MigratePerpetualPositions(ctx sdk.Context) (migrated int, err error) {
+	upgraded, err := k.getSubaccountsWithOutdatedRecords(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, subaccount := range upgraded {
+		k.SetSubaccount(ctx, subaccount)
+	}
+	return len(upgraded), nil
+}
+
+// getSubaccountsWithOutdatedRecords returns the upgraded subaccounts whose records in state were
+// encoded with an older version of perpetual positions. The records are collected before any are
+// rewritten since the store must not be written to while iterating.
+func (k Keeper) getSubaccountsWithOutdatedRecords(ctx sdk.Context) (upgraded []types.Subaccount, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.SubaccountKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		subaccount, version, err := types.DecodeSubaccountRecord(k.cdc, iterator.Value())
+		if err != nil {
+			return nil, err
+		}
+		if version != types.CurrentPerpetualPositionVersion {
+			upgraded = append(upgraded, subaccount)
+		}
+	}
+	return upgraded, nil
+}
+
+// InitializeCostBasisForPerpetualPositions sets the cost basis of every open perpetual position
+// with an unknown (zero) cost basis to the net notional of the position at the current oracle price.
+// This is a one-time approximation used when cost basis tracking is introduced, since the fill
+// history of existing positions is not available in state.
+func (k Keeper) InitializeCostBasisForPerpetualPositions(ctx sdk.Context) error {
+	for _, subaccount := range k.GetAllSubaccount(ctx) {
+		updated := false
+		for _, position := range subaccount.PerpetualPositions {
+			if position.GetBigQuantums().Sign() == 0 || position.GetBigCostBasis().Sign() != 0 {
+				continue
+			}
+
+			perpetual, price, err := k.perpetualsKeeper.GetPerpetualAndMarketPrice(ctx, position.PerpetualId)
+			if err != nil {
+				return err
+			}
+			position.CostBasis = dtypes.NewIntFromBigInt(
+				perplib.GetNetNotionalInQuoteQuantums(perpetual, price, position.GetBigQuantums()),
+			)
+			updated = true
+		}
+
+		if updated {
+			k.SetSubaccount(ctx, subaccount)
+		}
+	}
+	return nil
+}
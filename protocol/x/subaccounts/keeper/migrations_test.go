@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitializeCostBasisForPerpetualPositions(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+		t,
+		true,
+	)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+
+	for _, p := range []perptypes.Perpetual{
+		constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+		constants.EthUsd_20PercentInitial_10PercentMaintenance,
+	} {
+		_, err := perpetualsKeeper.CreatePerpetual(
+			ctx,
+			p.Params.Id,
+			p.Params.Ticker,
+			p.Params.MarketId,
+			p.Params.AtomicResolution,
+			p.Params.DefaultFundingPpm,
+			p.Params.LiquidityTier,
+			p.Params.MarketType,
+		)
+		require.NoError(t, err)
+	}
+
+	btcPosition := testutil.CreateSinglePerpetualPosition(
+		0,
+		big.NewInt(100_000_000), // 1 BTC
+		big.NewInt(0),
+		big.NewInt(0),
+	)
+	ethPosition := testutil.CreateSinglePerpetualPosition(
+		1,
+		big.NewInt(-1_000_000_000), // -1 ETH
+		big.NewInt(0),
+		big.NewInt(0),
+	)
+	// The cost basis of the ETH position is already known and must not be overwritten.
+	ethPosition.CostBasis = dtypes.NewInt(-2_500_000_000)
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:                 &constants.Alice_Num0,
+		AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)), // $10,000
+		PerpetualPositions: []*types.PerpetualPosition{btcPosition, ethPosition},
+	})
+
+	require.NoError(t, keeper.InitializeCostBasisForPerpetualPositions(ctx))
+
+	subaccount := keeper.GetSubaccount(ctx, constants.Alice_Num0)
+	require.Len(t, subaccount.PerpetualPositions, 2)
+	// 1 BTC at $50,000.
+	require.Equal(t, big.NewInt(50_000_000_000), subaccount.PerpetualPositions[0].GetBigCostBasis())
+	require.Equal(t, big.NewInt(-2_500_000_000), subaccount.PerpetualPositions[1].GetBigCostBasis())
+}
+
+func TestMigratePerpetualPositions(t *testing.T) {
+	ctx, keeper, _, _, _, _, _, _, _, _, storeKey := keepertest.SubaccountsKeepers(t, true)
+	cdc := constants.TestEncodingCfg.Codec
+	store := prefix.NewStore(ctx.KVStore(storeKey), []byte(types.SubaccountKeyPrefix))
+
+	// Write a version 1 record, which is the unversioned protobuf encoding of the subaccount.
+	legacy := types.Subaccount{
+		Id:             &constants.Alice_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)), // $10,000
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(5), big.NewInt(0)),
+		},
+	}
+	store.Set(constants.Alice_Num0.ToStateKey(), cdc.MustMarshal(&legacy))
+	current := types.Subaccount{
+		Id:             &constants.Bob_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(1_000_000_000)), // $1,000
+	}
+	keeper.SetSubaccount(ctx, current)
+
+	// The record is upgraded lazily on read without being rewritten.
+	subaccount := keeper.GetSubaccount(ctx, constants.Alice_Num0)
+	require.Equal(t, legacy, subaccount)
+	require.Equal(t, big.NewInt(0), subaccount.PerpetualPositions[0].GetBigCostBasis())
+	_, version, err := types.DecodeSubaccountRecord(cdc, store.Get(constants.Alice_Num0.ToStateKey()))
+	require.NoError(t, err)
+	require.Equal(t, types.PerpetualPositionVersion1, version)
+
+	// Only the outdated record is rewritten, in the current version.
+	migrated, err := keeper.MigratePerpetualPositions(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, migrated)
+	for _, id := range []types.SubaccountId{constants.Alice_Num0, constants.Bob_Num0} {
+		_, version, err := types.DecodeSubaccountRecord(cdc, store.Get(id.ToStateKey()))
+		require.NoError(t, err)
+		require.Equal(t, types.CurrentPerpetualPositionVersion, version)
+	}
+	require.Equal(t, legacy, keeper.GetSubaccount(ctx, constants.Alice_Num0))
+	require.Equal(t, current, keeper.GetSubaccount(ctx, constants.Bob_Num0))
+
+	// Migrating again is a no-op.
+	migrated, err = keeper.MigratePerpetualPositions(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, migrated)
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// OpenHedgedPair atomically opens positions in two perpetuals of a subaccount.
+func (k msgServer) OpenHedgedPair(
+	goCtx context.Context,
+	msg *types.MsgOpenHedgedPair,
+) (*types.MsgOpenHedgedPairResponse, error) {
+	if !k.HasAuthority(msg.Authority) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority %s",
+			msg.Authority,
+		)
+	}
+
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+	if err := k.Keeper.OpenHedgedPair(
+		ctx,
+		msg.SubaccountId,
+		msg.LegA.ToPerpetualUpdate(),
+		msg.LegB.ToPerpetualUpdate(),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgOpenHedgedPairResponse{}, nil
+}
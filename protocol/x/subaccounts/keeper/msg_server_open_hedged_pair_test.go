@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServerOpenHedgedPair(t *testing.T) {
+	// See `TestOpenHedgedPair`: neither leg alone leaves the subaccount initially collateralized, but both
+	// legs together do.
+	longBtc := types.HedgedPairLeg{
+		PerpetualId:       0,
+		QuantumsDelta:     dtypes.NewInt(100_000_000),     // 1 BTC
+		QuoteBalanceDelta: dtypes.NewInt(-39_500_000_000), // -$39,500
+	}
+	shortEth := types.HedgedPairLeg{
+		PerpetualId:       1,
+		QuantumsDelta:     dtypes.NewInt(-1_000_000_000), // -1 ETH
+		QuoteBalanceDelta: dtypes.NewInt(4_500_000_000),  // $4,500
+	}
+
+	tests := map[string]struct {
+		msg *types.MsgOpenHedgedPair
+
+		expectedErr string
+	}{
+		"Success": {
+			msg: &types.MsgOpenHedgedPair{
+				Authority:    lib.GovModuleAddress.String(),
+				SubaccountId: constants.Alice_Num0,
+				LegA:         longBtc,
+				LegB:         shortEth,
+			},
+		},
+		"Failure: undercollateralized pair": {
+			msg: &types.MsgOpenHedgedPair{
+				Authority:    lib.GovModuleAddress.String(),
+				SubaccountId: constants.Alice_Num0,
+				LegA:         longBtc,
+				LegB: types.HedgedPairLeg{
+					PerpetualId:       1,
+					QuantumsDelta:     dtypes.NewInt(-1_000_000_000), // -1 ETH
+					QuoteBalanceDelta: dtypes.NewInt(3_000_000_000),  // $3,000
+				},
+			},
+			expectedErr: types.ErrHedgedPairUndercollateralized.Error(),
+		},
+		"Failure: authority is not gov module": {
+			msg: &types.MsgOpenHedgedPair{
+				Authority:    constants.AliceAccAddress.String(),
+				SubaccountId: constants.Alice_Num0,
+				LegA:         longBtc,
+				LegB:         shortEth,
+			},
+			expectedErr: "invalid authority",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, k, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			for _, p := range []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				constants.EthUsd_20PercentInitial_10PercentMaintenance,
+			} {
+				_, err := perpetualsKeeper.CreatePerpetual(
+					ctx,
+					p.Params.Id,
+					p.Params.Ticker,
+					p.Params.MarketId,
+					p.Params.AtomicResolution,
+					p.Params.DefaultFundingPpm,
+					p.Params.LiquidityTier,
+					p.Params.MarketType,
+				)
+				require.NoError(t, err)
+			}
+			k.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-1_000_000_000)), // -$1,000
+			})
+			msgServer := keeper.NewMsgServerImpl(*k)
+
+			_, err := msgServer.OpenHedgedPair(ctx, tc.msg)
+			subaccount := k.GetSubaccount(ctx, constants.Alice_Num0)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				require.Empty(t, subaccount.PerpetualPositions)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, subaccount.PerpetualPositions, 2)
+				require.Equal(t, big.NewInt(100_000_000), subaccount.PerpetualPositions[0].GetBigQuantums())
+				require.Equal(t, big.NewInt(-1_000_000_000), subaccount.PerpetualPositions[1].GetBigQuantums())
+			}
+		})
+	}
+}
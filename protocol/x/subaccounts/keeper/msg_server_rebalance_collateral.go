@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// RebalanceCollateral moves USDC between a cross margin subaccount and an isolated position of the same
+// owner. The message is signed by the owner of the subaccounts.
+func (k msgServer) RebalanceCollateral(
+	goCtx context.Context,
+	msg *types.MsgRebalanceCollateral,
+) (*types.MsgRebalanceCollateralResponse, error) {
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+	quoteQuantums := new(big.Int).SetUint64(msg.QuoteQuantums)
+	if msg.ToCross {
+		quoteQuantums.Neg(quoteQuantums)
+	}
+	if err := k.Keeper.RebalanceCollateral(
+		ctx,
+		msg.CrossSubaccountId,
+		msg.IsolatedSubaccountId,
+		msg.PerpetualId,
+		quoteQuantums,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRebalanceCollateralResponse{}, nil
+}
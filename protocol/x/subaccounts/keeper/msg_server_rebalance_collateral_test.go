@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	auth_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/auth"
+	bank_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/bank"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServerRebalanceCollateral(t *testing.T) {
+	tests := map[string]struct {
+		msg *types.MsgRebalanceCollateral
+
+		expectedCrossUsdcQuantums    *big.Int
+		expectedIsolatedUsdcQuantums *big.Int
+		expectedErr                  error
+	}{
+		"Success: move to the isolated position": {
+			msg: &types.MsgRebalanceCollateral{
+				CrossSubaccountId:    constants.Alice_Num0,
+				IsolatedSubaccountId: constants.Alice_Num1,
+				PerpetualId:          3,
+				QuoteQuantums:        1_000_000_000, // $1,000
+			},
+			expectedCrossUsdcQuantums:    big.NewInt(4_000_000_000),
+			expectedIsolatedUsdcQuantums: big.NewInt(1_200_000_000),
+		},
+		"Success: move to the cross subaccount": {
+			msg: &types.MsgRebalanceCollateral{
+				CrossSubaccountId:    constants.Alice_Num0,
+				IsolatedSubaccountId: constants.Alice_Num1,
+				PerpetualId:          3,
+				QuoteQuantums:        200_000_000, // $200
+				ToCross:              true,
+			},
+			expectedCrossUsdcQuantums:    big.NewInt(5_200_000_000),
+			expectedIsolatedUsdcQuantums: big.NewInt(0),
+		},
+		"Failure: over-move from the isolated position": {
+			msg: &types.MsgRebalanceCollateral{
+				CrossSubaccountId:    constants.Alice_Num0,
+				IsolatedSubaccountId: constants.Alice_Num1,
+				PerpetualId:          3,
+				QuoteQuantums:        250_000_000, // $250
+				ToCross:              true,
+			},
+			expectedCrossUsdcQuantums:    big.NewInt(5_000_000_000),
+			expectedIsolatedUsdcQuantums: big.NewInt(200_000_000),
+			expectedErr:                  types.ErrFailedToUpdateSubaccounts,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, k, pricesKeeper, perpetualsKeeper, accountKeeper, bankKeeper, assetsKeeper, _, _, _, _ :=
+				keepertest.SubaccountsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			keepertest.CreateTestPerpetuals(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			auth_testutil.CreateTestModuleAccount(ctx, accountKeeper, types.ModuleName, []string{})
+
+			// A cross subaccount with $5,000 and an isolated subaccount with $200 and 10 ISO at $50 bought at
+			// $40, for a net collateral of $300 and an IMR of $100.
+			k.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(5_000_000_000)),
+			})
+			k.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num1,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(200_000_000)),
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(
+						3,
+						big.NewInt(10_000_000_000),
+						big.NewInt(0),
+						big.NewInt(-400_000_000),
+					),
+				},
+			})
+			require.NoError(t, bank_testutil.FundAccount(
+				ctx,
+				types.ModuleAddress,
+				sdk.Coins{sdk.NewCoin(assettypes.AssetUsdc.Denom, sdkmath.NewInt(5_000_000_000))},
+				*bankKeeper,
+			))
+			require.NoError(t, bank_testutil.FundAccount(
+				ctx,
+				authtypes.NewModuleAddress(
+					types.ModuleName+":"+lib.UintToString(constants.IsoUsd_IsolatedMarket.Params.Id),
+				),
+				sdk.Coins{sdk.NewCoin(assettypes.AssetUsdc.Denom, sdkmath.NewInt(200_000_000))},
+				*bankKeeper,
+			))
+			msgServer := keeper.NewMsgServerImpl(*k)
+
+			_, err := msgServer.RebalanceCollateral(ctx, tc.msg)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			crossSubaccount := k.GetSubaccount(ctx, constants.Alice_Num0)
+			require.Equal(t, 0, tc.expectedCrossUsdcQuantums.Cmp(crossSubaccount.GetUsdcPosition()))
+			isolatedSubaccount := k.GetSubaccount(ctx, constants.Alice_Num1)
+			require.Equal(t, 0, tc.expectedIsolatedUsdcQuantums.Cmp(isolatedSubaccount.GetUsdcPosition()))
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// SetMarginMode switches a subaccount between cross and isolated margin. The message is signed by the
+// owner of the subaccount.
+func (k msgServer) SetMarginMode(
+	goCtx context.Context,
+	msg *types.MsgSetMarginMode,
+) (*types.MsgSetMarginModeResponse, error) {
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+	if err := k.Keeper.SetMarginMode(ctx, msg.SubaccountId, types.MarginMode(msg.MarginMode)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetMarginModeResponse{}, nil
+}
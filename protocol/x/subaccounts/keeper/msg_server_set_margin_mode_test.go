@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServerSetMarginMode(t *testing.T) {
+	tests := map[string]struct {
+		perpetualPositions []*types.PerpetualPosition
+
+		expectedErr  error
+		expectedMode types.MarginMode
+	}{
+		"Success: switch subaccount with a single perpetual to isolated": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+			expectedMode: types.IsolatedMargin,
+		},
+		"Failure: cannot switch subaccount with multiple perpetuals to isolated": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(-1_000_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+			expectedErr:  types.ErrIsolatedMarginModeMultiplePerpetuals,
+			expectedMode: types.CrossMargin,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, k, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			for _, p := range []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				constants.EthUsd_20PercentInitial_10PercentMaintenance,
+			} {
+				_, err := perpetualsKeeper.CreatePerpetual(
+					ctx,
+					p.Params.Id,
+					p.Params.Ticker,
+					p.Params.MarketId,
+					p.Params.AtomicResolution,
+					p.Params.DefaultFundingPpm,
+					p.Params.LiquidityTier,
+					p.Params.MarketType,
+				)
+				require.NoError(t, err)
+			}
+			k.SetSubaccount(ctx, types.Subaccount{
+				Id:                 &constants.Alice_Num0,
+				AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)), // $10,000
+				PerpetualPositions: tc.perpetualPositions,
+			})
+			msgServer := keeper.NewMsgServerImpl(*k)
+
+			_, err := msgServer.SetMarginMode(ctx, &types.MsgSetMarginMode{
+				SubaccountId: constants.Alice_Num0,
+				MarginMode:   uint32(types.IsolatedMargin),
+			})
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expectedMode, k.GetMarginMode(ctx, constants.Alice_Num0))
+		})
+	}
+}
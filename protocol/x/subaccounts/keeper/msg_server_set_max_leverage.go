@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// SetMaxLeverage sets the self-imposed leverage cap of a subaccount. The message is signed by the owner
+// of the subaccount.
+func (k msgServer) SetMaxLeverage(
+	goCtx context.Context,
+	msg *types.MsgSetMaxLeverage,
+) (*types.MsgSetMaxLeverageResponse, error) {
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+	k.SetMaxLeveragePpm(ctx, msg.SubaccountId, msg.MaxLeveragePpm)
+
+	return &types.MsgSetMaxLeverageResponse{}, nil
+}
@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetMaxLeverage(t *testing.T) {
+	tests := map[string]struct {
+		msg *types.MsgSetMaxLeverage
+	}{
+		"Success: set the cap": {
+			msg: &types.MsgSetMaxLeverage{
+				SubaccountId:   constants.Alice_Num0,
+				MaxLeveragePpm: 5_000_000,
+			},
+		},
+		"Success: remove the cap": {
+			msg: &types.MsgSetMaxLeverage{
+				SubaccountId:   constants.Alice_Num0,
+				MaxLeveragePpm: 0,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, k, _, _, _, _, _, _, _, _, _ := keepertest.SubaccountsKeepers(t, true)
+			k.SetMaxLeveragePpm(ctx, constants.Alice_Num0, 2_000_000)
+			k.SetMaxLeveragePpm(ctx, constants.Alice_Num1, 3_000_000)
+			msgServer := keeper.NewMsgServerImpl(*k)
+
+			_, err := msgServer.SetMaxLeverage(ctx, tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.msg.MaxLeveragePpm, k.GetMaxLeveragePpm(ctx, constants.Alice_Num0))
+			// The caps of other subaccounts of the owner are not affected.
+			require.Equal(t, uint32(3_000_000), k.GetMaxLeveragePpm(ctx, constants.Alice_Num1))
+		})
+	}
+}
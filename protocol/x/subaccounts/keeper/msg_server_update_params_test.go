@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateParams(t *testing.T) {
+	initialParams := types.Params{
+		TradingHalted:                false,
+		LiquidationGracePeriodBlocks: 5,
+		InitialMarginBufferPpm:       50_000,
+		MaxPerpetualPositions:        10,
+		ExternalCollateralEnabled:    false,
+		RiskSweepBudget:              1_000,
+		DustNcThreshold:              50,
+	}
+
+	tests := map[string]struct {
+		msg         *types.MsgUpdateParams
+		expectedErr string
+	}{
+		"Success: set all params": {
+			msg: &types.MsgUpdateParams{
+				Authority: lib.GovModuleAddress.String(),
+				Params: types.Params{
+					TradingHalted:                true,
+					LiquidationGracePeriodBlocks: 20,
+					InitialMarginBufferPpm:       100_000,
+					MaxPerpetualPositions:        3,
+					ExternalCollateralEnabled:    true,
+					RiskSweepBudget:              500,
+					DustNcThreshold:              100,
+				},
+			},
+		},
+		"Success: disable all params": {
+			msg: &types.MsgUpdateParams{
+				Authority: lib.GovModuleAddress.String(),
+				Params: types.Params{
+					RiskSweepBudget: types.DefaultRiskSweepBudget,
+				},
+			},
+		},
+		"Failure: empty authority": {
+			msg: &types.MsgUpdateParams{
+				Authority: "",
+				Params: types.Params{
+					TradingHalted: true,
+				},
+			},
+			expectedErr: "invalid authority",
+		},
+		"Failure: authority is not gov module": {
+			msg: &types.MsgUpdateParams{
+				Authority: constants.BobAccAddress.String(),
+				Params: types.Params{
+					TradingHalted: true,
+				},
+			},
+			expectedErr: "invalid authority",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, k, _, _, _, _, _, _, _, _, _ := keepertest.SubaccountsKeepers(t, true)
+			k.SetParams(ctx, initialParams)
+			msgServer := keeper.NewMsgServerImpl(*k)
+
+			_, err := msgServer.UpdateParams(ctx, tc.msg)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				require.Equal(t, initialParams, k.GetParams(ctx))
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.msg.Params, k.GetParams(ctx))
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetParams returns the parameters of the subaccounts module. Each parameter is stored under its own key,
+// so that the parameters read for every subaccount update are read individually.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.Params{
+		TradingHalted:                k.GetTradingHalted(ctx),
+		LiquidationGracePeriodBlocks: k.GetLiquidationGracePeriodBlocks(ctx),
+		InitialMarginBufferPpm:       k.GetInitialMarginBufferPpm(ctx),
+		MaxPerpetualPositions:        k.GetMaxPerpetualPositions(ctx),
+		ExternalCollateralEnabled:    k.GetExternalCollateralEnabled(ctx),
+		RiskSweepBudget:              k.GetRiskSweepBudget(ctx),
+		DustNcThreshold:              k.GetDustNCThreshold(ctx),
+	}
+}
+
+// SetParams sets the parameters of the subaccounts module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.SetTradingHalted(ctx, params.TradingHalted)
+	k.SetLiquidationGracePeriodBlocks(ctx, params.LiquidationGracePeriodBlocks)
+	k.SetInitialMarginBufferPpm(ctx, params.InitialMarginBufferPpm)
+	k.SetMaxPerpetualPositions(ctx, params.MaxPerpetualPositions)
+	k.SetExternalCollateralEnabled(ctx, params.ExternalCollateralEnabled)
+	k.SetRiskSweepBudget(ctx, params.RiskSweepBudget)
+	k.SetDustNCThreshold(ctx, params.DustNcThreshold)
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetPositionNotional returns the absolute notional in quote quantums of the subaccount's position in the
+// perpetual at its current market price, as used when computing the risk of the subaccount. Returns zero
+// if the subaccount has no position in the perpetual.
+func (k Keeper) GetPositionNotional(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	perpetualId uint32,
+) (
+	notional *big.Int,
+	err error,
+) {
+	subaccount := k.GetSubaccount(ctx, subaccountId)
+	position, exists := subaccount.GetPerpetualPositionForId(perpetualId)
+	if !exists {
+		return new(big.Int), nil
+	}
+
+	perpInfos, err := k.GetAllRelevantPerpetuals(ctx, []types.Update{{SubaccountId: subaccountId}})
+	if err != nil {
+		return nil, err
+	}
+	return salib.PositionNotional(position.GetBigQuantums(), perpInfos.MustGet(perpetualId))
+}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPositionNotional(t *testing.T) {
+	tests := map[string]struct {
+		perpetualPositions []*types.PerpetualPosition
+
+		expectedNotional *big.Int
+	}{
+		"long": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+			expectedNotional: big.NewInt(50_000_000_000),
+		},
+		"short": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(0, big.NewInt(-50_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+			expectedNotional: big.NewInt(25_000_000_000),
+		},
+		"quote balance is not included": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(
+					0,
+					big.NewInt(100_000_000),
+					big.NewInt(0),
+					big.NewInt(-40_000_000_000),
+				),
+			},
+			expectedNotional: big.NewInt(50_000_000_000),
+		},
+		"no position": {
+			expectedNotional: big.NewInt(0),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:                 &constants.Alice_Num0,
+				AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(100_000_000_000)),
+				PerpetualPositions: tc.perpetualPositions,
+			})
+
+			notional, err := keeper.GetPositionNotional(ctx, constants.Alice_Num0, p.Params.Id)
+			require.NoError(t, err)
+			require.Equal(t, 0, tc.expectedNotional.Cmp(notional), "expected %s, got %s", tc.expectedNotional, notional)
+		})
+	}
+}
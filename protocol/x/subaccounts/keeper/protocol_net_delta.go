@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetProtocolNetDelta returns the sum of the signed base quantums of the positions of all subaccounts in
+// the perpetual. Every fill creates equal and opposite positions, so the sum is zero unless positions were
+// created or removed outside of matching, for example by rounding or by positions held against the
+// insurance fund. A nonzero result is the residual exposure of the protocol in the perpetual.
+func (k Keeper) GetProtocolNetDelta(
+	ctx sdk.Context,
+	perpetualId uint32,
+) (
+	netDelta *big.Int,
+) {
+	netDelta = new(big.Int)
+	k.ForEachSubaccount(ctx, func(subaccount types.Subaccount) (finished bool) {
+		if position, exists := subaccount.GetPerpetualPositionForId(perpetualId); exists {
+			netDelta.Add(netDelta, position.GetBigQuantums())
+		}
+		return false
+	})
+	return netDelta
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetProtocolNetDelta(t *testing.T) {
+	tests := map[string]struct {
+		subaccounts []types.Subaccount
+		perpetualId uint32
+
+		expectedNetDelta *big.Int
+	}{
+		"no subaccounts": {
+			perpetualId:      0,
+			expectedNetDelta: big.NewInt(0),
+		},
+		"matched positions net to zero": {
+			subaccounts: []types.Subaccount{
+				{
+					Id: &constants.Alice_Num0,
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+					},
+				},
+				{
+					Id: &constants.Bob_Num0,
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(0, big.NewInt(-100_000_000), big.NewInt(0), big.NewInt(0)),
+					},
+				},
+			},
+			perpetualId:      0,
+			expectedNetDelta: big.NewInt(0),
+		},
+		"nonzero residual": {
+			subaccounts: []types.Subaccount{
+				{
+					Id: &constants.Alice_Num0,
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+						testutil.CreateSinglePerpetualPosition(1, big.NewInt(-1_000_000_000), big.NewInt(0), big.NewInt(0)),
+					},
+				},
+				{
+					Id: &constants.Bob_Num0,
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(0, big.NewInt(-70_000_000), big.NewInt(0), big.NewInt(0)),
+					},
+				},
+				{
+					Id: &constants.Carl_Num0,
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(0, big.NewInt(-25_000_000), big.NewInt(0), big.NewInt(0)),
+					},
+				},
+				{
+					Id:             &constants.Dave_Num0,
+					AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(1_000_000_000)),
+				},
+			},
+			perpetualId:      0,
+			expectedNetDelta: big.NewInt(5_000_000),
+		},
+		"positions in other perpetuals are not included": {
+			subaccounts: []types.Subaccount{
+				{
+					Id: &constants.Alice_Num0,
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+						testutil.CreateSinglePerpetualPosition(1, big.NewInt(-1_000_000_000), big.NewInt(0), big.NewInt(0)),
+					},
+				},
+			},
+			perpetualId:      1,
+			expectedNetDelta: big.NewInt(-1_000_000_000),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, _, _, _, _, _, _, _, _, _ := keepertest.SubaccountsKeepers(t, true)
+
+			for _, subaccount := range tc.subaccounts {
+				keeper.SetSubaccount(ctx, subaccount)
+			}
+
+			netDelta := keeper.GetProtocolNetDelta(ctx, tc.perpetualId)
+			require.Equal(t, 0, tc.expectedNetDelta.Cmp(netDelta), "expected %s, got %s", tc.expectedNetDelta, netDelta)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetRealizedPnlOnClose returns the PnL in quote quantums that the subaccount would realize by closing its
+// entire position in the perpetual at its current market price. This is the signed notional of the
+// position at the market price minus its cost basis, and is negative for a loss. Funding and fees are not
+// included. Returns zero if the subaccount has no position in the perpetual, and an error if the cost
+// basis of the position is unknown.
+func (k Keeper) GetRealizedPnlOnClose(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	perpetualId uint32,
+) (
+	pnl *big.Int,
+	err error,
+) {
+	subaccount := k.GetSubaccount(ctx, subaccountId)
+	position, exists := subaccount.GetPerpetualPositionForId(perpetualId)
+	if !exists {
+		return new(big.Int), nil
+	}
+
+	costBasis := position.GetBigCostBasis()
+	if costBasis.Sign() == 0 {
+		return nil, errorsmod.Wrapf(
+			types.ErrPerpPositionCostBasisUnknown,
+			"subaccount id: %v, perpetual id: %d",
+			subaccountId,
+			perpetualId,
+		)
+	}
+
+	perpInfos, err := k.GetAllRelevantPerpetuals(ctx, []types.Update{{SubaccountId: subaccountId}})
+	if err != nil {
+		return nil, err
+	}
+	notional, err := salib.PositionNotional(position.GetBigQuantums(), perpInfos.MustGet(perpetualId))
+	if err != nil {
+		return nil, err
+	}
+	if !position.GetIsLong() {
+		notional.Neg(notional)
+	}
+	return notional.Sub(notional, costBasis), nil
+}
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRealizedPnlOnClose(t *testing.T) {
+	tests := map[string]struct {
+		perpetualPositions []*types.PerpetualPosition
+
+		expectedPnl *big.Int
+		expectedErr error
+	}{
+		"profitable long": {
+			// 1 BTC entered at $40,000 and closed at $50,000.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPositionWithCostBasis(
+					0,
+					big.NewInt(100_000_000),
+					big.NewInt(0),
+					big.NewInt(0),
+					big.NewInt(40_000_000_000),
+				),
+			},
+			expectedPnl: big.NewInt(10_000_000_000),
+		},
+		"losing long": {
+			// 0.5 BTC entered at $60,000 and closed at $50,000.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPositionWithCostBasis(
+					0,
+					big.NewInt(50_000_000),
+					big.NewInt(0),
+					big.NewInt(0),
+					big.NewInt(30_000_000_000),
+				),
+			},
+			expectedPnl: big.NewInt(-5_000_000_000),
+		},
+		"losing short": {
+			// 1 BTC shorted at $40,000 and closed at $50,000.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPositionWithCostBasis(
+					0,
+					big.NewInt(-100_000_000),
+					big.NewInt(0),
+					big.NewInt(0),
+					big.NewInt(-40_000_000_000),
+				),
+			},
+			expectedPnl: big.NewInt(-10_000_000_000),
+		},
+		"profitable short": {
+			// 0.5 BTC shorted at $60,000 and closed at $50,000.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPositionWithCostBasis(
+					0,
+					big.NewInt(-50_000_000),
+					big.NewInt(0),
+					big.NewInt(0),
+					big.NewInt(-30_000_000_000),
+				),
+			},
+			expectedPnl: big.NewInt(5_000_000_000),
+		},
+		"quote balance is not included": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPositionWithCostBasis(
+					0,
+					big.NewInt(100_000_000),
+					big.NewInt(0),
+					big.NewInt(-40_000_000_000),
+					big.NewInt(50_000_000_000),
+				),
+			},
+			expectedPnl: big.NewInt(0),
+		},
+		"unknown cost basis": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+			expectedErr: types.ErrPerpPositionCostBasisUnknown,
+		},
+		"no position": {
+			expectedPnl: big.NewInt(0),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:                 &constants.Alice_Num0,
+				AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(100_000_000_000)),
+				PerpetualPositions: tc.perpetualPositions,
+			})
+
+			pnl, err := keeper.GetRealizedPnlOnClose(ctx, constants.Alice_Num0, p.Params.Id)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 0, tc.expectedPnl.Cmp(pnl), "expected %s, got %s", tc.expectedPnl, pnl)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// RebalanceCollateral moves `quoteQuantums` of USDC between a cross margin subaccount and a subaccount of the
+// same owner holding a position in the isolated perpetual `perpetualId`. A positive amount moves collateral
+// from the cross subaccount to the isolated position and a negative amount moves it back.
+//
+// Collateral of an isolated position is held in the collateral pool of its perpetual, so the move is done with
+// `TransferFundsFromSubaccountToSubaccount`, which moves the funds between the two collateral pools and requires
+// the sending subaccount to remain collateralized.
+func (k Keeper) RebalanceCollateral(
+	ctx sdk.Context,
+	crossSubaccountId types.SubaccountId,
+	isolatedSubaccountId types.SubaccountId,
+	perpetualId uint32,
+	quoteQuantums *big.Int,
+) error {
+	if quoteQuantums.Sign() == 0 {
+		return nil
+	}
+
+	if crossSubaccountId.Owner != isolatedSubaccountId.Owner {
+		return errorsmod.Wrapf(
+			types.ErrInvalidCollateralRebalance,
+			"subaccounts %v and %v have different owners",
+			crossSubaccountId,
+			isolatedSubaccountId,
+		)
+	}
+
+	isolatedPoolAddr, err := k.GetCollateralPoolFromPerpetualId(ctx, perpetualId)
+	if err != nil {
+		return err
+	}
+	if isolatedPoolAddr.Equals(types.ModuleAddress) {
+		return errorsmod.Wrapf(
+			types.ErrInvalidCollateralRebalance,
+			"perpetual %d is not an isolated perpetual",
+			perpetualId,
+		)
+	}
+
+	isolatedSubaccount := k.GetSubaccount(ctx, isolatedSubaccountId)
+	if _, exists := isolatedSubaccount.GetPerpetualPositionForId(perpetualId); !exists {
+		return errorsmod.Wrapf(
+			types.ErrPerpPositionNotFound,
+			"subaccount %v, perpetual id %d",
+			isolatedSubaccountId,
+			perpetualId,
+		)
+	}
+
+	crossPoolAddr, err := k.GetCollateralPoolForSubaccount(ctx, crossSubaccountId)
+	if err != nil {
+		return err
+	}
+	if !crossPoolAddr.Equals(types.ModuleAddress) {
+		return errorsmod.Wrapf(
+			types.ErrInvalidCollateralRebalance,
+			"subaccount %v is not a cross margin subaccount",
+			crossSubaccountId,
+		)
+	}
+
+	if quoteQuantums.Sign() > 0 {
+		return k.TransferFundsFromSubaccountToSubaccount(
+			ctx,
+			crossSubaccountId,
+			isolatedSubaccountId,
+			assettypes.AssetUsdc.Id,
+			quoteQuantums,
+		)
+	}
+	return k.TransferFundsFromSubaccountToSubaccount(
+		ctx,
+		isolatedSubaccountId,
+		crossSubaccountId,
+		assettypes.AssetUsdc.Id,
+		new(big.Int).Neg(quoteQuantums),
+	)
+}
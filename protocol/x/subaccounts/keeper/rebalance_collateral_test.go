@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	auth_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/auth"
+	bank_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/bank"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRebalanceCollateral(t *testing.T) {
+	isolatedPoolAddr := authtypes.NewModuleAddress(
+		types.ModuleName + ":" + lib.UintToString(constants.IsoUsd_IsolatedMarket.Params.Id),
+	)
+
+	tests := map[string]struct {
+		isolatedSubaccountId types.SubaccountId
+		perpetualId          uint32
+		quoteQuantums        *big.Int
+
+		expectedCrossUsdcQuantums    *big.Int
+		expectedIsolatedUsdcQuantums *big.Int
+		expectedErr                  error
+	}{
+		"move from the cross subaccount to the isolated position": {
+			isolatedSubaccountId:         constants.Alice_Num1,
+			perpetualId:                  3,
+			quoteQuantums:                big.NewInt(1_000_000_000), // $1,000
+			expectedCrossUsdcQuantums:    big.NewInt(4_000_000_000),
+			expectedIsolatedUsdcQuantums: big.NewInt(1_200_000_000),
+		},
+		"move all of the cross subaccount to the isolated position": {
+			isolatedSubaccountId:         constants.Alice_Num1,
+			perpetualId:                  3,
+			quoteQuantums:                big.NewInt(5_000_000_000), // $5,000
+			expectedCrossUsdcQuantums:    big.NewInt(0),
+			expectedIsolatedUsdcQuantums: big.NewInt(5_200_000_000),
+		},
+		"move from the isolated position to the cross subaccount": {
+			// Isolated NC of $100 remains at the IMR of $100.
+			isolatedSubaccountId:         constants.Alice_Num1,
+			perpetualId:                  3,
+			quoteQuantums:                big.NewInt(-200_000_000), // -$200
+			expectedCrossUsdcQuantums:    big.NewInt(5_200_000_000),
+			expectedIsolatedUsdcQuantums: big.NewInt(0),
+		},
+		"over-move from the cross subaccount is rejected": {
+			isolatedSubaccountId:         constants.Alice_Num1,
+			perpetualId:                  3,
+			quoteQuantums:                big.NewInt(6_000_000_000), // $6,000
+			expectedCrossUsdcQuantums:    big.NewInt(5_000_000_000),
+			expectedIsolatedUsdcQuantums: big.NewInt(200_000_000),
+			expectedErr:                  types.ErrFailedToUpdateSubaccounts,
+		},
+		"over-move from the isolated position is rejected": {
+			// Isolated NC of $50 would be below the IMR of $100.
+			isolatedSubaccountId:         constants.Alice_Num1,
+			perpetualId:                  3,
+			quoteQuantums:                big.NewInt(-250_000_000), // -$250
+			expectedCrossUsdcQuantums:    big.NewInt(5_000_000_000),
+			expectedIsolatedUsdcQuantums: big.NewInt(200_000_000),
+			expectedErr:                  types.ErrFailedToUpdateSubaccounts,
+		},
+		"perpetual is not isolated": {
+			isolatedSubaccountId:         constants.Alice_Num1,
+			perpetualId:                  0,
+			quoteQuantums:                big.NewInt(1_000_000_000),
+			expectedCrossUsdcQuantums:    big.NewInt(5_000_000_000),
+			expectedIsolatedUsdcQuantums: big.NewInt(200_000_000),
+			expectedErr:                  types.ErrInvalidCollateralRebalance,
+		},
+		"no position in the isolated perpetual": {
+			isolatedSubaccountId:         constants.Alice_Num1,
+			perpetualId:                  4,
+			quoteQuantums:                big.NewInt(1_000_000_000),
+			expectedCrossUsdcQuantums:    big.NewInt(5_000_000_000),
+			expectedIsolatedUsdcQuantums: big.NewInt(200_000_000),
+			expectedErr:                  types.ErrPerpPositionNotFound,
+		},
+		"subaccounts of different owners": {
+			isolatedSubaccountId:         constants.Bob_Num0,
+			perpetualId:                  3,
+			quoteQuantums:                big.NewInt(1_000_000_000),
+			expectedCrossUsdcQuantums:    big.NewInt(5_000_000_000),
+			expectedIsolatedUsdcQuantums: big.NewInt(200_000_000),
+			expectedErr:                  types.ErrInvalidCollateralRebalance,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, accountKeeper, bankKeeper, assetsKeeper, _, _, _, _ :=
+				keepertest.SubaccountsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			keepertest.CreateTestPerpetuals(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			auth_testutil.CreateTestModuleAccount(ctx, accountKeeper, types.ModuleName, []string{})
+
+			// A cross subaccount with $5,000 and an isolated subaccount with $200 and 10 ISO at $50 bought at
+			// $40, for a net collateral of $300 and an IMR of $100.
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(5_000_000_000)),
+			})
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &tc.isolatedSubaccountId,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(200_000_000)),
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(
+						3,
+						big.NewInt(10_000_000_000),
+						big.NewInt(0),
+						big.NewInt(-400_000_000),
+					),
+				},
+			})
+			require.NoError(t, bank_testutil.FundAccount(
+				ctx,
+				types.ModuleAddress,
+				sdk.Coins{sdk.NewCoin(assettypes.AssetUsdc.Denom, sdkmath.NewInt(5_000_000_000))},
+				*bankKeeper,
+			))
+			require.NoError(t, bank_testutil.FundAccount(
+				ctx,
+				isolatedPoolAddr,
+				sdk.Coins{sdk.NewCoin(assettypes.AssetUsdc.Denom, sdkmath.NewInt(200_000_000))},
+				*bankKeeper,
+			))
+
+			err := keeper.RebalanceCollateral(
+				ctx,
+				constants.Alice_Num0,
+				tc.isolatedSubaccountId,
+				tc.perpetualId,
+				tc.quoteQuantums,
+			)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			crossSubaccount := keeper.GetSubaccount(ctx, constants.Alice_Num0)
+			require.Equal(t, 0, tc.expectedCrossUsdcQuantums.Cmp(crossSubaccount.GetUsdcPosition()))
+			isolatedSubaccount := keeper.GetSubaccount(ctx, tc.isolatedSubaccountId)
+			require.Equal(t, 0, tc.expectedIsolatedUsdcQuantums.Cmp(isolatedSubaccount.GetUsdcPosition()))
+			require.Len(t, isolatedSubaccount.PerpetualPositions, 1)
+			require.Equal(t, big.NewInt(-400_000_000), isolatedSubaccount.PerpetualPositions[0].GetQuoteBalance())
+
+			// The collateral pools hold the USDC of their subaccounts.
+			require.Equal(
+				t,
+				sdkmath.NewIntFromBigInt(tc.expectedCrossUsdcQuantums),
+				bankKeeper.GetBalance(ctx, types.ModuleAddress, assettypes.AssetUsdc.Denom).Amount,
+			)
+			require.Equal(
+				t,
+				sdkmath.NewIntFromBigInt(tc.expectedIsolatedUsdcQuantums),
+				bankKeeper.GetBalance(ctx, isolatedPoolAddr, assettypes.AssetUsdc.Denom).Amount,
+			)
+		})
+	}
+}
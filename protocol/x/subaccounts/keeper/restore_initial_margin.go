@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetActionToRestoreInitialMargin returns, for each perpetual position of the subaccount, the smallest
+// number of base quantums to close at the market price for the subaccount to be initially collateralized,
+// along with the close with the smallest notional. This is used by subaccounts between their maintenance
+// and initial margin requirements, which cannot open new positions but are not liquidatable.
+func (k Keeper) GetActionToRestoreInitialMargin(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+) (
+	action types.RestoreInitialMarginAction,
+	err error,
+) {
+	inputs, err := k.GetRiskInputs(ctx, subaccountId)
+	if err != nil {
+		return action, err
+	}
+	risk, err := salib.GetRiskForSubaccount(inputs.SettledSubaccount, inputs.PerpInfos)
+	if err != nil {
+		return action, err
+	}
+	if risk.IsInitialCollateralized() {
+		action.InitiallyCollateralized = true
+		return action, nil
+	}
+
+	settledUpdate := types.SettledUpdate{SettledSubaccount: inputs.SettledSubaccount}
+	for _, pos := range inputs.SettledSubaccount.PerpetualPositions {
+		quantums, restored, err := salib.QuantumsToCloseToRestoreInitialMargin(
+			settledUpdate,
+			inputs.PerpInfos,
+			pos.PerpetualId,
+		)
+		if err != nil {
+			return action, err
+		}
+		if !restored {
+			continue
+		}
+		absNotional, err := salib.PositionNotional(quantums, inputs.PerpInfos.MustGet(pos.PerpetualId))
+		if err != nil {
+			return action, err
+		}
+		action.Closes = append(action.Closes, types.PositionClose{
+			PerpetualId: pos.PerpetualId,
+			Quantums:    quantums,
+			AbsNotional: absNotional,
+		})
+	}
+
+	for i := range action.Closes {
+		if action.Cheapest == nil || action.Closes[i].AbsNotional.Cmp(action.Cheapest.AbsNotional) < 0 {
+			action.Cheapest = &action.Closes[i]
+		}
+	}
+	return action, nil
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetActionToRestoreInitialMargin(t *testing.T) {
+	tests := map[string]struct {
+		usdcQuantums *big.Int
+
+		expectedAction types.RestoreInitialMarginAction
+	}{
+		"initially collateralized": {
+			// NC of $13,000 equals the IMR of $13,000.
+			usdcQuantums: big.NewInt(-40_000_000_000),
+			expectedAction: types.RestoreInitialMarginAction{
+				InitiallyCollateralized: true,
+			},
+		},
+		"closing the smaller position suffices": {
+			// NC of $11,000 is between the MMR of $8,000 and the IMR of $13,000. Closing $2,000 of ETH with a
+			// 100% IMF releases the $2,000 of IMR needed, while $10,000 of BTC with a 20% IMF is needed.
+			usdcQuantums: big.NewInt(-42_000_000_000),
+			expectedAction: types.RestoreInitialMarginAction{
+				Closes: []types.PositionClose{
+					{
+						PerpetualId: 0,
+						Quantums:    big.NewInt(20_000_000), // 0.2 BTC
+						AbsNotional: big.NewInt(10_000_000_000),
+					},
+					{
+						PerpetualId: 1,
+						Quantums:    big.NewInt(666_666_667), // ~0.67 ETH
+						AbsNotional: big.NewInt(2_000_000_001),
+					},
+				},
+				Cheapest: &types.PositionClose{
+					PerpetualId: 1,
+					Quantums:    big.NewInt(666_666_667),
+					AbsNotional: big.NewInt(2_000_000_001),
+				},
+			},
+		},
+		"only closing the larger position suffices": {
+			// NC of $9,000 needs $4,000 of IMR released, which is more than the IMR of the ETH position.
+			usdcQuantums: big.NewInt(-44_000_000_000),
+			expectedAction: types.RestoreInitialMarginAction{
+				Closes: []types.PositionClose{
+					{
+						PerpetualId: 0,
+						Quantums:    big.NewInt(40_000_000), // 0.4 BTC
+						AbsNotional: big.NewInt(20_000_000_000),
+					},
+				},
+				Cheapest: &types.PositionClose{
+					PerpetualId: 0,
+					Quantums:    big.NewInt(40_000_000),
+					AbsNotional: big.NewInt(20_000_000_000),
+				},
+			},
+		},
+		"no close suffices": {
+			// NC of $2,000 is below the IMR of $3,000 of the ETH position.
+			usdcQuantums:   big.NewInt(-51_000_000_000),
+			expectedAction: types.RestoreInitialMarginAction{},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			for _, p := range []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				constants.EthUsd_100PercentMarginRequirement,
+			} {
+				_, err := perpetualsKeeper.CreatePerpetual(
+					ctx,
+					p.Params.Id,
+					p.Params.Ticker,
+					p.Params.MarketId,
+					p.Params.AtomicResolution,
+					p.Params.DefaultFundingPpm,
+					p.Params.LiquidityTier,
+					p.Params.MarketType,
+				)
+				require.NoError(t, err)
+			}
+
+			// 1 BTC at $50,000 and 1 ETH at $3,000.
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(tc.usdcQuantums),
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+					testutil.CreateSinglePerpetualPosition(1, big.NewInt(1_000_000_000), big.NewInt(0), big.NewInt(0)),
+				},
+			})
+
+			action, err := keeper.GetActionToRestoreInitialMargin(ctx, constants.Alice_Num0)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedAction, action)
+		})
+	}
+}
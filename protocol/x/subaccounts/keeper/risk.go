@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetRiskForSubaccount returns the risk of the subaccount at current prices. If `includeUnsettledFunding`
+// is true, the unsettled funding of its perpetual positions is settled into its USDC position first, as when
+// the subaccount is updated, and without external or locked collateral the risk equals that returned by
+// `GetNetCollateralAndMarginRequirements` for an empty update. Otherwise, the net collateral is based only on
+// the value of its positions and its USDC balance as stored.
+//
+// If external collateral is enabled, the collateral of the subaccount provided by the collateral sources
+// of the keeper, such as staked tokens, is added to the net collateral, as when the subaccount is updated.
+//
+// The collateral of the subaccount locked by pending withdrawals, as returned by `GetLockedCollateral`, is
+// subtracted from the net collateral, as when the subaccount is updated.
+//
+// The net collateral of subaccounts is not stored or cached in state, so there is no cached value for the
+// risk returned here to drift from, and the module registers no invariant checking it.
+func (k Keeper) GetRiskForSubaccount(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	includeUnsettledFunding bool,
+) (
+	risk margin.Risk,
+	err error,
+) {
+	if includeUnsettledFunding {
+		risk, err = k.GetNetCollateralAndMarginRequirements(ctx, types.Update{SubaccountId: subaccountId})
+	} else {
+		var perpInfos perptypes.PerpInfos
+		perpInfos, err = k.GetAllRelevantPerpetuals(ctx, []types.Update{{SubaccountId: subaccountId}})
+		if err != nil {
+			return risk, err
+		}
+		risk, err = salib.GetRiskForSubaccount(k.GetSubaccount(ctx, subaccountId), perpInfos)
+	}
+	if err != nil {
+		return risk, err
+	}
+
+	if err := k.adjustRiskForCollateral(ctx, subaccountId, &risk); err != nil {
+		return risk, err
+	}
+	return risk, nil
+}
+
+// adjustRiskForCollateral adds the external collateral of the subaccount to the net collateral of `risk`,
+// and subtracts the collateral of the subaccount locked by pending withdrawals.
+func (k Keeper) adjustRiskForCollateral(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	risk *margin.Risk,
+) error {
+	externalCollateral, err := k.GetExternalCollateral(ctx, subaccountId)
+	if err != nil {
+		return err
+	}
+	risk.NC.Add(risk.NC, externalCollateral)
+	risk.NC.Sub(risk.NC, new(big.Int).SetUint64(k.GetLockedCollateral(ctx, subaccountId)))
+	return nil
+}
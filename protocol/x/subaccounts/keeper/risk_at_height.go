@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetRiskAtHeight returns the risk of a subaccount as of the end of the block at `height`, replayed from
+// the positions, funding indices, prices and liquidity tiers committed at that height.
+//
+// Data availability is bounded by the node rather than by this module: only heights whose state has not
+// been pruned by the node's pruning strategy can be replayed, so archive nodes can answer for any height
+// while pruning nodes can only answer for recent heights. Heights after the current block height, and
+// heights before the first block, are unavailable.
+func (k Keeper) GetRiskAtHeight(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	height int64,
+) (
+	risk margin.Risk,
+	err error,
+) {
+	if k.historicalMultiStore == nil {
+		return risk, errorsmod.Wrap(types.ErrHistoricalStateUnavailable, "no historical state provider")
+	}
+	if height <= 0 || height > ctx.BlockHeight() {
+		return risk, errorsmod.Wrapf(
+			types.ErrHistoricalStateUnavailable,
+			"height %d is not within (0, %d]",
+			height,
+			ctx.BlockHeight(),
+		)
+	}
+
+	multiStore, err := k.historicalMultiStore(height)
+	if err != nil {
+		return risk, errorsmod.Wrapf(types.ErrHistoricalStateUnavailable, "height %d: %v", height, err)
+	}
+
+	historicalCtx := ctx.WithMultiStore(multiStore).WithBlockHeight(height)
+	return k.GetNetCollateralAndMarginRequirements(historicalCtx, types.Update{SubaccountId: subaccountId})
+}
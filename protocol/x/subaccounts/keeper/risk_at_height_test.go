@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRiskAtHeight(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+		t,
+		true,
+	)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+	p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+	_, err := perpetualsKeeper.CreatePerpetual(
+		ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.MarketType,
+	)
+	require.NoError(t, err)
+
+	// Without a historical state provider, no height is available.
+	_, err = keeper.GetRiskAtHeight(ctx.WithBlockHeight(1), constants.Alice_Num0, 1)
+	require.ErrorIs(t, err, types.ErrHistoricalStateUnavailable)
+
+	// At height 1, 1 BTC at $50,000 with NC = $50,000 - $44,000 and MMR = $5,000.
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:             &constants.Alice_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-44_000_000_000)),
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+		},
+	})
+	height1Store := ctx.MultiStore()
+
+	// At height 2, the price of BTC drops to $48,000 and the subaccount is liquidatable.
+	height2Store := height1Store.CacheMultiStore()
+	ctx = ctx.WithMultiStore(height2Store).WithBlockHeight(2)
+	require.NoError(t, pricesKeeper.UpdateMarketPrices(
+		ctx,
+		[]*pricestypes.MsgUpdateMarketPrices_MarketPrice{{MarketId: p.Params.MarketId, Price: 4_800_000_000}},
+	))
+
+	keeper.SetHistoricalMultiStoreProvider(func(height int64) (storetypes.MultiStore, error) {
+		switch height {
+		case 1:
+			return height1Store, nil
+		case 2:
+			return height2Store, nil
+		default:
+			return nil, fmt.Errorf("version %d does not exist", height)
+		}
+	})
+
+	risk, err := keeper.GetRiskAtHeight(ctx, constants.Alice_Num0, 1)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		margin.Risk{
+			NC:  big.NewInt(6_000_000_000),
+			IMR: big.NewInt(10_000_000_000),
+			MMR: big.NewInt(5_000_000_000),
+		},
+		risk,
+	)
+	require.False(t, risk.IsLiquidatable())
+
+	risk, err = keeper.GetRiskAtHeight(ctx, constants.Alice_Num0, 2)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(4_000_000_000), risk.NC)
+	require.True(t, risk.IsLiquidatable())
+
+	// Heights after the current block height are unavailable.
+	_, err = keeper.GetRiskAtHeight(ctx, constants.Alice_Num0, 3)
+	require.ErrorIs(t, err, types.ErrHistoricalStateUnavailable)
+	_, err = keeper.GetRiskAtHeight(ctx, constants.Alice_Num0, 0)
+	require.ErrorIs(t, err, types.ErrHistoricalStateUnavailable)
+}
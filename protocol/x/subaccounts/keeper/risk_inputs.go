@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetRiskInputs returns the settled subaccount, the perpetual information and the external and
+// locked collateral that are used to compute the current risk of the subaccount. This allows
+// off-chain tools to reproduce the risk returned by `GetRiskForSubaccount` exactly.
+func (k Keeper) GetRiskInputs(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+) (
+	inputs types.RiskInputs,
+	err error,
+) {
+	perpInfos, err := k.GetAllRelevantPerpetuals(ctx, []types.Update{{SubaccountId: subaccountId}})
+	if err != nil {
+		return inputs, err
+	}
+
+	subaccount := k.GetSubaccount(ctx, subaccountId)
+	settledSubaccount, _ := salib.GetSettledSubaccountWithPerpetuals(subaccount, perpInfos)
+
+	externalCollateral, err := k.GetExternalCollateral(ctx, subaccountId)
+	if err != nil {
+		return inputs, err
+	}
+
+	return types.RiskInputs{
+		SettledSubaccount:  settledSubaccount,
+		PerpInfos:          perpInfos,
+		ExternalCollateral: externalCollateral,
+		LockedCollateral:   k.GetLockedCollateral(ctx, subaccountId),
+	}, nil
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	sakeeper "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/keeper"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRiskInputs(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+		t,
+		true,
+	)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+
+	for _, p := range []perptypes.Perpetual{
+		constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+		constants.EthUsd_20PercentInitial_10PercentMaintenance,
+	} {
+		_, err := perpetualsKeeper.CreatePerpetual(
+			ctx,
+			p.Params.Id,
+			p.Params.Ticker,
+			p.Params.MarketId,
+			p.Params.AtomicResolution,
+			p.Params.DefaultFundingPpm,
+			p.Params.LiquidityTier,
+			p.Params.MarketType,
+		)
+		require.NoError(t, err)
+	}
+	// ETH is an inverse perpetual.
+	eth, err := perpetualsKeeper.GetPerpetual(ctx, 1)
+	require.NoError(t, err)
+	eth.Params.PerpetualType = uint32(perptypes.InversePerpetual)
+	require.NoError(t, perpetualsKeeper.ValidateAndSetPerpetual(ctx, eth))
+
+	// Advance the BTC funding index so that the subaccount has unsettled funding.
+	require.NoError(t, perpetualsKeeper.ModifyFundingIndex(ctx, 0, big.NewInt(10)))
+
+	subaccount := types.Subaccount{
+		Id:             &constants.Alice_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)), // $10,000
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(
+				0,
+				big.NewInt(100_000_000), // 1 BTC
+				big.NewInt(0),
+				big.NewInt(0),
+			),
+			testutil.CreateSinglePerpetualPosition(
+				1,
+				big.NewInt(-1_000_000_000), // -1 ETH
+				big.NewInt(0),
+				big.NewInt(0),
+			),
+		},
+	}
+	keeper.SetSubaccount(ctx, subaccount)
+
+	// 10 staked tokens at $3,000 with a 50% haircut add $15,000 of external collateral.
+	keeper.SetCollateralSources(
+		sakeeper.NewStakedTokenCollateralSource(
+			bondedTokens(10_000_000_000),
+			pricesKeeper,
+			rewardsParams{MarketId: 1, DenomExponent: -9},
+			500_000,
+		),
+	)
+	keeper.SetExternalCollateralEnabled(ctx, true)
+	keeper.SetLockedCollateral(ctx, constants.Alice_Num0, 1_000_000_000) // $1,000
+
+	inputs, err := keeper.GetRiskInputs(ctx, constants.Alice_Num0)
+	require.NoError(t, err)
+
+	// The funding of the BTC position (10 * 1 BTC / 1e6 = 1,000 quote quantums) is settled into the
+	// USDC position.
+	require.Equal(t, constants.Alice_Num0, *inputs.SettledSubaccount.Id)
+	require.Equal(t, big.NewInt(9_999_999_000), inputs.SettledSubaccount.GetUsdcPosition())
+	require.Len(t, inputs.SettledSubaccount.PerpetualPositions, 2)
+	require.Equal(t, dtypes.NewInt(10), inputs.SettledSubaccount.PerpetualPositions[0].FundingIndex)
+
+	// The perpetual information of all held perpetuals is included.
+	require.Len(t, inputs.PerpInfos, 2)
+	require.Equal(t, dtypes.NewInt(10), inputs.PerpInfos.MustGet(0).Perpetual.FundingIndex)
+	require.Equal(t, constants.LiquidityTiers[3], inputs.PerpInfos.MustGet(0).LiquidityTier)
+	require.Equal(t, constants.TestMarketPrices[1].Price, inputs.PerpInfos.MustGet(1).Price.Price)
+	require.Equal(t, perptypes.LinearPerpetual, inputs.PerpInfos.MustGet(0).PerpetualType)
+	require.Equal(t, perptypes.InversePerpetual, inputs.PerpInfos.MustGet(1).PerpetualType)
+
+	// The external and locked collateral are included.
+	require.Equal(t, big.NewInt(15_000_000_000), inputs.ExternalCollateral)
+	require.Equal(t, uint64(1_000_000_000), inputs.LockedCollateral)
+
+	// Feeding the inputs back into the risk computation reproduces the on-chain risk.
+	expectedRisk, err := keeper.GetRiskForSubaccount(ctx, constants.Alice_Num0, true)
+	require.NoError(t, err)
+	risk, err := salib.GetRiskForSubaccount(inputs.SettledSubaccount, inputs.PerpInfos)
+	require.NoError(t, err)
+	risk.NC.Add(risk.NC, inputs.ExternalCollateral)
+	risk.NC.Sub(risk.NC, new(big.Int).SetUint64(inputs.LockedCollateral))
+	require.Equal(t, expectedRisk, risk)
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"bytes"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetRiskSweepBudget returns the maximum number of perpetual positions whose risk is computed by a sweep
+// over all subaccounts in a single block. Sweeps that exceed the budget resume from where they stopped
+// in the next block. Defaults to `DefaultRiskSweepBudget` if no budget is set.
+func (k Keeper) GetRiskSweepBudget(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get([]byte(types.RiskSweepBudgetKey))
+	if b == nil {
+		return types.DefaultRiskSweepBudget
+	}
+
+	budget := gogotypes.UInt64Value{}
+	k.cdc.MustUnmarshal(b, &budget)
+	return budget.Value
+}
+
+// SetRiskSweepBudget sets the maximum number of perpetual positions whose risk is computed by a sweep
+// over all subaccounts in a single block. A budget of zero resets the budget to `DefaultRiskSweepBudget`.
+func (k Keeper) SetRiskSweepBudget(ctx sdk.Context, budget uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if budget == 0 {
+		store.Delete([]byte(types.RiskSweepBudgetKey))
+		return
+	}
+
+	store.Set([]byte(types.RiskSweepBudgetKey), k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: budget}))
+}
+
+// iterateSubaccountsWithinRiskSweepBudget performs a callback across subaccounts in state key order,
+// starting from the subaccount with state key `startKey` inclusive, until the total number of perpetual
+// positions of the subaccounts would exceed the risk sweep budget. Subaccounts without perpetual positions
+// count as a single position, and at least one subaccount is always iterated over so that the sweep makes
+// progress. Returns the state key to resume the iteration from, or nil if there are no more subaccounts.
+func (k Keeper) iterateSubaccountsWithinRiskSweepBudget(
+	ctx sdk.Context,
+	startKey []byte,
+	callback func(types.Subaccount),
+) (nextKey []byte) {
+	budget := k.GetRiskSweepBudget(ctx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.SubaccountKeyPrefix))
+	iterator := store.Iterator(startKey, nil)
+
+	defer iterator.Close()
+
+	for cost, iterated := uint64(0), false; iterator.Valid(); iterator.Next() {
+		subaccount := types.MustDecodeSubaccountRecord(k.cdc, iterator.Value())
+		cost += max(uint64(len(subaccount.PerpetualPositions)), 1)
+		if cost > budget && iterated {
+			return bytes.Clone(iterator.Key())
+		}
+		callback(subaccount)
+		iterated = true
+	}
+	return nil
+}
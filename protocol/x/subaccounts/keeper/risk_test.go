@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRiskForSubaccount(t *testing.T) {
+	tests := map[string]struct {
+		fundingIndex int64
+
+		expectedRiskWithFunding    margin.Risk
+		expectedRiskWithoutFunding margin.Risk
+	}{
+		"no unsettled funding": {
+			fundingIndex: 0,
+			expectedRiskWithFunding: margin.Risk{
+				NC:  big.NewInt(6_000_000_000),
+				IMR: big.NewInt(10_000_000_000),
+				MMR: big.NewInt(5_000_000_000),
+			},
+			expectedRiskWithoutFunding: margin.Risk{
+				NC:  big.NewInt(6_000_000_000),
+				IMR: big.NewInt(10_000_000_000),
+				MMR: big.NewInt(5_000_000_000),
+			},
+		},
+		"unsettled funding owed": {
+			// Long 1 BTC owes $1,000 of funding.
+			fundingIndex: 10_000_000,
+			expectedRiskWithFunding: margin.Risk{
+				NC:  big.NewInt(5_000_000_000),
+				IMR: big.NewInt(10_000_000_000),
+				MMR: big.NewInt(5_000_000_000),
+			},
+			expectedRiskWithoutFunding: margin.Risk{
+				NC:  big.NewInt(6_000_000_000),
+				IMR: big.NewInt(10_000_000_000),
+				MMR: big.NewInt(5_000_000_000),
+			},
+		},
+		"unsettled funding received": {
+			// Long 1 BTC receives $500 of funding.
+			fundingIndex: -5_000_000,
+			expectedRiskWithFunding: margin.Risk{
+				NC:  big.NewInt(6_500_000_000),
+				IMR: big.NewInt(10_000_000_000),
+				MMR: big.NewInt(5_000_000_000),
+			},
+			expectedRiskWithoutFunding: margin.Risk{
+				NC:  big.NewInt(6_000_000_000),
+				IMR: big.NewInt(10_000_000_000),
+				MMR: big.NewInt(5_000_000_000),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+			require.NoError(t, perpetualsKeeper.ModifyFundingIndex(ctx, p.Params.Id, big.NewInt(tc.fundingIndex)))
+
+			// 1 BTC at $50,000 with NC = $50,000 - $44,000 before funding.
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-44_000_000_000)),
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+				},
+			})
+
+			riskWithFunding, err := keeper.GetRiskForSubaccount(ctx, constants.Alice_Num0, true)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedRiskWithFunding, riskWithFunding)
+
+			riskWithoutFunding, err := keeper.GetRiskForSubaccount(ctx, constants.Alice_Num0, false)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedRiskWithoutFunding, riskWithoutFunding)
+
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// SettleFundingForMarket settles the funding of the positions in the perpetual of the subaccounts
+// iterated in state key order from the subaccount with state key `startKey` inclusive, up to `limit`
+// subaccounts, or all remaining subaccounts if `limit` is zero. The funding of each position is moved
+// into the USDC position of its subaccount and the funding index of the position is updated to the
+// funding index of the perpetual. Positions in other perpetuals are not settled.
+//
+// Returns the state key of the next subaccount to settle, which should be passed as `startKey` to
+// resume settling in a later call, or nil if all subaccounts were iterated.
+func (k Keeper) SettleFundingForMarket(
+	ctx sdk.Context,
+	perpetualId uint32,
+	startKey []byte,
+	limit uint32,
+) (
+	nextKey []byte,
+	err error,
+) {
+	perpInfos, err := k.getPerpInfos(ctx, map[uint32]struct{}{perpetualId: {}})
+	if err != nil {
+		return nil, err
+	}
+	fundingIndex := perpInfos.MustGet(perpetualId).Perpetual.FundingIndex
+
+	// Collect the subaccounts to settle before writing to the store being iterated.
+	var subaccounts []types.Subaccount
+	nextKey = k.IterateSubaccounts(ctx, startKey, limit, func(subaccount types.Subaccount) {
+		subaccounts = append(subaccounts, subaccount)
+	})
+
+	for _, subaccount := range subaccounts {
+		for i, pos := range subaccount.PerpetualPositions {
+			if pos.PerpetualId != perpetualId || pos.FundingIndex.Cmp(fundingIndex) == 0 {
+				continue
+			}
+
+			// Settle only the position in the perpetual.
+			settledSubaccount, fundingPayments := salib.GetSettledSubaccountWithPerpetuals(
+				types.Subaccount{
+					Id:                 subaccount.Id,
+					AssetPositions:     subaccount.AssetPositions,
+					PerpetualPositions: []*types.PerpetualPosition{pos},
+					MarginEnabled:      subaccount.MarginEnabled,
+				},
+				perpInfos,
+			)
+			subaccount.AssetPositions = settledSubaccount.AssetPositions
+			subaccount.PerpetualPositions[i] = settledSubaccount.PerpetualPositions[0]
+			k.SetSubaccount(ctx, subaccount)
+
+			settledUpdate := types.SettledUpdate{SettledSubaccount: subaccount}
+			k.GetIndexerEventManager().AddTxnEvent(
+				ctx,
+				indexerevents.SubtypeSubaccountUpdate,
+				indexerevents.SubaccountUpdateEventVersion,
+				indexer_manager.GetBytes(
+					indexerevents.NewSubaccountUpdateEvent(
+						subaccount.Id,
+						salib.GetUpdatedPerpetualPositions(settledUpdate, fundingPayments),
+						salib.GetUpdatedAssetPositions(settledUpdate),
+						fundingPayments,
+					),
+				),
+			)
+			if lib.IsDeliverTxMode(ctx) && k.GetFullNodeStreamingManager().Enabled() {
+				if k.GetFullNodeStreamingManager().TracksSubaccountId(*subaccount.Id) {
+					k.GetFullNodeStreamingManager().SendSubaccountUpdate(
+						ctx,
+						GenerateStreamSubaccountUpdate(settledUpdate, fundingPayments),
+					)
+				}
+			}
+			if fundingPaid, ok := fundingPayments[perpetualId]; ok {
+				ctx.EventManager().EmitEvent(
+					types.NewCreateSettledFundingEvent(*subaccount.Id, perpetualId, fundingPaid.BigInt()),
+				)
+				k.recordSettledFunding(ctx, *subaccount.Id, perpetualId, fundingPaid.BigInt())
+			}
+			break
+		}
+	}
+	return nextKey, nil
+}
+
+// SettleFundingAfterFundingTick settles the funding of all perpetual positions after each funding tick,
+// one perpetual at a time in perpetual id order, using `SettleFundingForMarket`. Positions are otherwise
+// only settled when their subaccount is updated, so this keeps the funding of idle positions from
+// accumulating.
+//
+// This is called once per block in `EndBlocker`. Each call settles the positions of at most as many
+// subaccounts as the risk sweep budget, and the next call resumes from the first subaccount or perpetual
+// that was not settled. A settlement still in progress when the next funding tick starts is restarted
+// from the first perpetual, since the funding of every position has changed again.
+func (k Keeper) SettleFundingAfterFundingTick(ctx sdk.Context) error {
+	epoch, found := k.perpetualsKeeper.GetFundingTickEpoch(ctx)
+	if !found {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	var perpetualId uint32
+	var startKey []byte
+	settledEpoch := gogotypes.UInt32Value{}
+	b := store.Get([]byte(types.FundingSettlementEpochKey))
+	if b != nil {
+		k.cdc.MustUnmarshal(b, &settledEpoch)
+	}
+	if b == nil || settledEpoch.Value != epoch {
+		// A new funding tick has started, so settle from the first perpetual.
+		perpetuals := k.perpetualsKeeper.GetAllPerpetuals(ctx)
+		if len(perpetuals) == 0 {
+			return nil
+		}
+		store.Set(
+			[]byte(types.FundingSettlementEpochKey),
+			k.cdc.MustMarshal(&gogotypes.UInt32Value{Value: epoch}),
+		)
+		store.Delete([]byte(types.FundingSettlementNextKeyKey))
+		perpetualId = perpetuals[0].Params.Id
+	} else if b := store.Get([]byte(types.FundingSettlementPerpetualKey)); b != nil {
+		perpetual := gogotypes.UInt32Value{}
+		k.cdc.MustUnmarshal(b, &perpetual)
+		perpetualId = perpetual.Value
+		startKey = store.Get([]byte(types.FundingSettlementNextKeyKey))
+	} else {
+		// The funding of the current funding tick is already settled.
+		return nil
+	}
+
+	limit := uint32(min(k.GetRiskSweepBudget(ctx), math.MaxUint32))
+	nextKey, err := k.SettleFundingForMarket(ctx, perpetualId, startKey, limit)
+	if err != nil {
+		return err
+	}
+	if nextKey != nil {
+		store.Set(
+			[]byte(types.FundingSettlementPerpetualKey),
+			k.cdc.MustMarshal(&gogotypes.UInt32Value{Value: perpetualId}),
+		)
+		store.Set([]byte(types.FundingSettlementNextKeyKey), nextKey)
+		return nil
+	}
+
+	// All positions in the perpetual are settled, so move on to the next perpetual, if any.
+	store.Delete([]byte(types.FundingSettlementNextKeyKey))
+	for _, perpetual := range k.perpetualsKeeper.GetAllPerpetuals(ctx) {
+		if perpetual.Params.Id > perpetualId {
+			store.Set(
+				[]byte(types.FundingSettlementPerpetualKey),
+				k.cdc.MustMarshal(&gogotypes.UInt32Value{Value: perpetual.Params.Id}),
+			)
+			return nil
+		}
+	}
+	store.Delete([]byte(types.FundingSettlementPerpetualKey))
+	return nil
+}
@@ -0,0 +1,214 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettleFundingForMarket(t *testing.T) {
+	for name, limit := range map[string]uint32{"no limit": 0, "one subaccount per call": 1} {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			for _, p := range []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				constants.EthUsd_20PercentInitial_10PercentMaintenance,
+			} {
+				_, err := perpetualsKeeper.CreatePerpetual(
+					ctx,
+					p.Params.Id,
+					p.Params.Ticker,
+					p.Params.MarketId,
+					p.Params.AtomicResolution,
+					p.Params.DefaultFundingPpm,
+					p.Params.LiquidityTier,
+					p.Params.MarketType,
+				)
+				require.NoError(t, err)
+				require.NoError(t, perpetualsKeeper.ModifyFundingIndex(ctx, p.Params.Id, big.NewInt(10_000_000)))
+			}
+
+			subaccounts := []types.Subaccount{
+				{
+					// Long 1 BTC with a stale funding index pays $1,000 of funding. The ETH position is not
+					// settled.
+					Id:             &constants.Alice_Num0,
+					AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)),
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+						testutil.CreateSinglePerpetualPosition(1, big.NewInt(1_000_000_000), big.NewInt(0), big.NewInt(0)),
+					},
+				},
+				{
+					// Short 1 BTC with a less stale funding index receives $500 of funding.
+					Id:             &constants.Bob_Num0,
+					AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)),
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(
+							0,
+							big.NewInt(-100_000_000),
+							big.NewInt(5_000_000),
+							big.NewInt(0),
+						),
+					},
+				},
+				{
+					// Long 1 BTC with the current funding index pays no funding.
+					Id:             &constants.Carl_Num0,
+					AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)),
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(
+							0,
+							big.NewInt(100_000_000),
+							big.NewInt(10_000_000),
+							big.NewInt(0),
+						),
+					},
+				},
+				{
+					// No BTC position.
+					Id:             &constants.Dave_Num0,
+					AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)),
+				},
+			}
+			for _, subaccount := range subaccounts {
+				keeper.SetSubaccount(ctx, subaccount)
+			}
+
+			var startKey []byte
+			for calls := 0; calls == 0 || startKey != nil; calls++ {
+				require.Less(t, calls, len(subaccounts)+1)
+				var err error
+				startKey, err = keeper.SettleFundingForMarket(ctx, 0, startKey, limit)
+				require.NoError(t, err)
+			}
+
+			expectedUsdc := map[types.SubaccountId]int64{
+				constants.Alice_Num0: 9_000_000_000,
+				constants.Bob_Num0:   10_500_000_000,
+				constants.Carl_Num0:  10_000_000_000,
+				constants.Dave_Num0:  10_000_000_000,
+			}
+			for id, usdc := range expectedUsdc {
+				subaccount := keeper.GetSubaccount(ctx, id)
+				require.Equal(t, big.NewInt(usdc), subaccount.GetUsdcPosition(), id.String())
+				for _, pos := range subaccount.PerpetualPositions {
+					if pos.PerpetualId == 0 {
+						require.Equal(t, dtypes.NewInt(10_000_000), pos.FundingIndex)
+					} else {
+						require.Equal(t, dtypes.NewInt(0), pos.FundingIndex)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSettleFundingAfterFundingTick(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _, epochsKeeper :=
+		keepertest.SubaccountsKeepersWithEpochs(t, true)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+	for _, p := range []perptypes.Perpetual{
+		constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+		constants.EthUsd_20PercentInitial_10PercentMaintenance,
+	} {
+		_, err := perpetualsKeeper.CreatePerpetual(
+			ctx,
+			p.Params.Id,
+			p.Params.Ticker,
+			p.Params.MarketId,
+			p.Params.AtomicResolution,
+			p.Params.DefaultFundingPpm,
+			p.Params.LiquidityTier,
+			p.Params.MarketType,
+		)
+		require.NoError(t, err)
+	}
+
+	// Settle the positions of a single subaccount per call.
+	keeper.SetRiskSweepBudget(ctx, 1)
+	for _, id := range []types.SubaccountId{constants.Alice_Num0, constants.Bob_Num0} {
+		keeper.SetSubaccount(ctx, types.Subaccount{
+			Id:             &id,
+			AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)),
+			PerpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(1_000_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+		})
+	}
+	requireFundingIndices := func(btc int64, eth int64) {
+		for _, id := range []types.SubaccountId{constants.Alice_Num0, constants.Bob_Num0} {
+			subaccount := keeper.GetSubaccount(ctx, id)
+			require.Equal(t, dtypes.NewInt(btc), subaccount.PerpetualPositions[0].FundingIndex, id.String())
+			require.Equal(t, dtypes.NewInt(eth), subaccount.PerpetualPositions[1].FundingIndex, id.String())
+		}
+	}
+
+	// Nothing is settled before the funding-tick epoch exists.
+	require.NoError(t, perpetualsKeeper.ModifyFundingIndex(ctx, 0, big.NewInt(10_000_000)))
+	require.NoError(t, perpetualsKeeper.ModifyFundingIndex(ctx, 1, big.NewInt(1_000_000)))
+	require.NoError(t, keeper.SettleFundingAfterFundingTick(ctx))
+	requireFundingIndices(0, 0)
+
+	require.NoError(t, epochsKeeper.CreateEpochInfo(ctx, epochstypes.EpochInfo{
+		Name:          string(epochstypes.FundingTickEpochInfoName),
+		NextTick:      uint32(constants.TimeT.Unix()),
+		Duration:      3_600,
+		IsInitialized: true,
+	}))
+	startNextEpoch := func(epoch uint32) {
+		ctx = ctx.WithBlockHeight(int64(epoch) + 1).WithBlockTime(constants.TimeT.Add(
+			time.Duration(epoch-1) * time.Hour,
+		))
+		started, err := epochsKeeper.MaybeStartNextEpoch(ctx, epochstypes.FundingTickEpochInfoName)
+		require.NoError(t, err)
+		require.True(t, started)
+	}
+
+	// Epoch 1: each perpetual is settled for one subaccount per call.
+	startNextEpoch(1)
+	require.NoError(t, keeper.SettleFundingAfterFundingTick(ctx))
+	settled := 0
+	for _, id := range []types.SubaccountId{constants.Alice_Num0, constants.Bob_Num0} {
+		subaccount := keeper.GetSubaccount(ctx, id)
+		if subaccount.PerpetualPositions[0].FundingIndex.Cmp(dtypes.NewInt(10_000_000)) == 0 {
+			settled++
+		}
+		require.Equal(t, dtypes.NewInt(0), subaccount.PerpetualPositions[1].FundingIndex, id.String())
+	}
+	require.Equal(t, 1, settled)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, keeper.SettleFundingAfterFundingTick(ctx))
+	}
+	requireFundingIndices(10_000_000, 1_000_000)
+
+	// Funding is not settled again until the next funding tick.
+	require.NoError(t, perpetualsKeeper.ModifyFundingIndex(ctx, 0, big.NewInt(1_000_000)))
+	require.NoError(t, keeper.SettleFundingAfterFundingTick(ctx))
+	requireFundingIndices(10_000_000, 1_000_000)
+
+	// Epoch 2: the funding accrued since the last settlement is settled.
+	startNextEpoch(2)
+	for i := 0; i < 4; i++ {
+		require.NoError(t, keeper.SettleFundingAfterFundingTick(ctx))
+	}
+	requireFundingIndices(11_000_000, 1_000_000)
+}
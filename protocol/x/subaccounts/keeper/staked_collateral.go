@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+var _ types.CollateralSource = StakedTokenCollateralSource{}
+
+// StakedTokenCollateralSource is a collateral source that values the tokens bonded by the owner of a
+// subaccount at the market price of the staking token, reduced by a haircut. The staking token is the
+// reward token, so its market and atomic resolution are those in the params of the rewards module. The
+// staked tokens of an owner are shared by all of its subaccounts, so they only count as collateral of the
+// owner's subaccount number 0.
+type StakedTokenCollateralSource struct {
+	stakingKeeper types.StakingKeeper
+	pricesKeeper  types.PricesKeeper
+	rewardsKeeper types.RewardsKeeper
+	// The haircut applied to the value of the staked tokens, in parts-per-million.
+	haircutPpm uint32
+}
+
+func NewStakedTokenCollateralSource(
+	stakingKeeper types.StakingKeeper,
+	pricesKeeper types.PricesKeeper,
+	rewardsKeeper types.RewardsKeeper,
+	haircutPpm uint32,
+) StakedTokenCollateralSource {
+	return StakedTokenCollateralSource{
+		stakingKeeper: stakingKeeper,
+		pricesKeeper:  pricesKeeper,
+		rewardsKeeper: rewardsKeeper,
+		haircutPpm:    haircutPpm,
+	}
+}
+
+// GetCollateral returns the value in quote quantums of the tokens bonded by the owner of the subaccount,
+// after the haircut.
+func (s StakedTokenCollateralSource) GetCollateral(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+) (*big.Int, error) {
+	if subaccountId.Number != 0 {
+		return new(big.Int), nil
+	}
+	owner, err := sdk.AccAddressFromBech32(subaccountId.Owner)
+	if err != nil {
+		return nil, err
+	}
+	bonded, err := s.stakingKeeper.GetDelegatorBonded(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	rewardsParams := s.rewardsKeeper.GetParams(ctx)
+	price, err := s.pricesKeeper.GetMarketPrice(ctx, rewardsParams.MarketId)
+	if err != nil {
+		return nil, err
+	}
+	return salib.StakedTokenCollateral(bonded.BigInt(), rewardsParams.DenomExponent, price, s.haircutPpm), nil
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"math/big"
@@ -52,7 +53,7 @@ func (k Keeper) SetSubaccount(ctx sdk.Context, subaccount types.Subaccount) {
 				),
 			)
 		}
-		b := k.cdc.MustMarshal(&subaccount)
+		b := types.EncodeSubaccountRecord(k.cdc, subaccount)
 		store.Set(key, b)
 	}
 }
@@ -129,9 +130,8 @@ func (k Keeper) GetSubaccount(
 		}
 	}
 
-	// If subaccount does exist in state, unmarshall and return the value.
-	k.cdc.MustUnmarshal(b, &val)
-	return val
+	// If subaccount does exist in state, decode it with its positions upgraded to the current version.
+	return types.MustDecodeSubaccountRecord(k.cdc, b)
 }
 
 func (k Keeper) GetStreamSubaccountUpdate(
@@ -151,7 +151,7 @@ func (k Keeper) GetStreamSubaccountUpdate(
 	for i, pp := range subaccount.PerpetualPositions {
 		perpetualPositions[i] = &types.SubaccountPerpetualPosition{
 			PerpetualId: pp.PerpetualId,
-			Quantums:    pp.Quantums.BigInt().Int64(),
+			Quantums:    pp.GetBigQuantums().Int64(),
 		}
 	}
 
@@ -172,9 +172,7 @@ func (k Keeper) GetAllSubaccount(ctx sdk.Context) (list []types.Subaccount) {
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		var val types.Subaccount
-		k.cdc.MustUnmarshal(iterator.Value(), &val)
-		list = append(list, val)
+		list = append(list, types.MustDecodeSubaccountRecord(k.cdc, iterator.Value()))
 	}
 
 	return
@@ -191,8 +189,7 @@ func (k Keeper) ForEachSubaccount(ctx sdk.Context, callback func(types.Subaccoun
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		var subaccount types.Subaccount
-		k.cdc.MustUnmarshal(iterator.Value(), &subaccount)
+		subaccount := types.MustDecodeSubaccountRecord(k.cdc, iterator.Value())
 		done := callback(subaccount)
 		if done {
 			break
@@ -200,6 +197,56 @@ func (k Keeper) ForEachSubaccount(ctx sdk.Context, callback func(types.Subaccoun
 	}
 }
 
+// ForEachSubaccountOfOwner performs a callback across all subaccounts of the owner, in increasing order of
+// subaccount number. The callback function should return a boolean if we should end iteration or not.
+func (k Keeper) ForEachSubaccountOfOwner(
+	ctx sdk.Context,
+	owner string,
+	callback func(types.Subaccount) (finished bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.SubaccountKeyPrefix))
+	// The state key of a subaccount is its marshaled id, which starts with the marshaled owner.
+	ownerPrefix := (&types.SubaccountId{Owner: owner}).ToStateKey()
+	iterator := storetypes.KVStorePrefixIterator(store, ownerPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		subaccount := types.MustDecodeSubaccountRecord(k.cdc, iterator.Value())
+		if callback(subaccount) {
+			break
+		}
+	}
+}
+
+// IterateSubaccounts performs a callback across at most `limit` subaccounts in state key order,
+// starting from the subaccount with state key `startKey` inclusive. A nil `startKey` starts from the
+// first subaccount and a `limit` of zero iterates over all remaining subaccounts.
+// Returns the state key to pass as `startKey` to resume the iteration, or nil if there are no more
+// subaccounts. Sweeps over large state can use this to iterate over all subaccounts exactly once,
+// in bounded chunks across multiple blocks.
+func (k Keeper) IterateSubaccounts(
+	ctx sdk.Context,
+	startKey []byte,
+	limit uint32,
+	callback func(types.Subaccount),
+) (nextKey []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.SubaccountKeyPrefix))
+	iterator := store.Iterator(startKey, nil)
+
+	defer iterator.Close()
+
+	for count := uint32(0); iterator.Valid(); iterator.Next() {
+		if limit != 0 && count == limit {
+			return bytes.Clone(iterator.Key())
+		}
+		subaccount := types.MustDecodeSubaccountRecord(k.cdc, iterator.Value())
+		callback(subaccount)
+		count++
+	}
+	return nil
+}
+
 // GetRandomSubaccount returns a random subaccount. Will return an error if there are no subaccounts.
 func (k Keeper) GetRandomSubaccount(ctx sdk.Context, rand *rand.Rand) (types.Subaccount, error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.SubaccountKeyPrefix))
@@ -211,9 +258,7 @@ func (k Keeper) GetRandomSubaccount(ctx sdk.Context, rand *rand.Rand) (types.Sub
 	prefixItr := store.Iterator(prefix, nil)
 	defer prefixItr.Close()
 
-	var val types.Subaccount
-	k.cdc.MustUnmarshal(prefixItr.Value(), &val)
-	return val, nil
+	return types.MustDecodeSubaccountRecord(k.cdc, prefixItr.Value()), nil
 }
 
 func (k Keeper) getRandomBytes(ctx sdk.Context, rand *rand.Rand) ([]byte, error) {
@@ -267,6 +312,9 @@ func (k Keeper) getSettledUpdates(
 		// idToSettledSubaccount map.
 		if !exists {
 			subaccount := k.GetSubaccount(ctx, u.SubaccountId)
+			if err := subaccount.ValidateAssetPositions(); err != nil {
+				return nil, nil, err
+			}
 			settledSubaccount, fundingPayments = salib.GetSettledSubaccountWithPerpetuals(subaccount, perpInfos)
 
 			idToSettledSubaccount[u.SubaccountId] = settledSubaccount
@@ -299,7 +347,7 @@ func GenerateStreamSubaccountUpdate(
 	for i, pp := range updatedPerpetualPositions {
 		perpetualPositions[i] = &types.SubaccountPerpetualPosition{
 			PerpetualId: pp.PerpetualId,
-			Quantums:    pp.Quantums.BigInt().Int64(),
+			Quantums:    pp.GetBigQuantums().Int64(),
 		}
 	}
 
@@ -337,7 +385,10 @@ func GenerateStreamSubaccountUpdate(
 // perpetual collateral pools if any of the updates led to an isolated perpetual posititon to be opened
 // or closed. This is done using the `x/bank` keeper and updates `x/bank` state.
 //
-// Each `SubaccountId` in the `updates` must be unique or an error is returned.
+// Each `SubaccountId` in the `updates` must be unique or an error is returned. Subaccounts are read from
+// state on every call, so updates to the same subaccount made by successive calls within a block, such as
+// by different messages, are applied in call order and each is checked against the state left by the
+// previous calls rather than the state at the start of the block.
 func (k Keeper) UpdateSubaccounts(
 	ctx sdk.Context,
 	updates []types.Update,
@@ -467,9 +518,15 @@ func (k Keeper) UpdateSubaccounts(
 					fundingPaid.BigInt(),
 				),
 			)
+			k.recordSettledFunding(ctx, *u.SettledSubaccount.Id, perpetualId, fundingPaid.BigInt())
 		}
 	}
 
+	// Start the liquidation grace period of updated subaccounts that fell below maintenance margin.
+	if err := k.trackUndercollateralizedUpdatedSubaccounts(ctx, settledUpdates, perpInfos); err != nil {
+		return false, nil, err
+	}
+
 	return success, successPerUpdate, err
 }
 
@@ -549,6 +606,15 @@ func (k Keeper) internalCanUpdateSubaccounts(
 		return success, successPerUpdate, nil
 	}
 
+	// Block all withdrawals and transfers while trading is halted.
+	tradingHalted := k.GetTradingHalted(ctx)
+	if tradingHalted && (updateType == types.Withdrawal || updateType == types.Transfer) {
+		for i := range settledUpdates {
+			successPerUpdate[i] = types.WithdrawalsAndTransfersBlocked
+		}
+		return false, successPerUpdate, nil
+	}
+
 	// Block all withdrawals and transfers if either of the following is true within the last
 	// `WITHDRAWAL_AND_TRANSFERS_BLOCKED_AFTER_NEGATIVE_TNC_SUBACCOUNT_SEEN_BLOCKS`:
 	// - There was a negative TNC subaccount seen for any of the collateral pools of subaccounts being updated
@@ -618,8 +684,14 @@ func (k Keeper) internalCanUpdateSubaccounts(
 	// do not result in OI changes.
 	perpOpenInterestDelta := salib.GetDeltaOpenInterestFromUpdates(settledUpdates, updateType)
 
+	// Orders are collateralized against the open interest after they are filled. Since the counterparty is
+	// not known yet, assume the fill increases open interest as much as possible.
+	if perpOpenInterestDelta == nil {
+		perpOpenInterestDelta = salib.GetMaxDeltaOpenInterestFromOrderUpdates(settledUpdates, updateType)
+	}
+
 	// Temporily apply open interest delta to perpetuals, so IMF is calculated based on open interest after the update.
-	// `perpOpenInterestDeltas` is only present for `Match` update type.
+	// `perpOpenInterestDeltas` is only present for `Match` and `CollatCheck` update types.
 	if perpOpenInterestDelta != nil {
 		perpInfo := perpInfos.MustGet(perpOpenInterestDelta.PerpetualId)
 		existingValue := big.NewInt(0)
@@ -638,6 +710,11 @@ func (k Keeper) internalCanUpdateSubaccounts(
 		}()
 	}
 
+	initialMarginBufferPpm := k.GetInitialMarginBufferPpm(ctx)
+	externalCollateralEnabled := k.GetExternalCollateralEnabled(ctx)
+	positionLimits := types.PositionLimits{
+		MaxPerpetualPositions: k.GetMaxPerpetualPositions(ctx),
+	}
 	riskCurMap := make(map[string]margin.Risk)
 
 	// Iterate over all updates.
@@ -681,12 +758,25 @@ func (k Keeper) internalCanUpdateSubaccounts(
 		if err != nil {
 			return false, nil, err
 		}
+		// Collateral locked by pending withdrawals does not count towards the net collateral, and collateral
+		// held outside of the asset positions of the subaccount does if external collateral is enabled.
+		collateralAdjustment := new(big.Int).Neg(
+			new(big.Int).SetUint64(k.GetLockedCollateral(ctx, *u.SettledSubaccount.Id)),
+		)
+		if externalCollateralEnabled {
+			externalCollateral, err := k.getCollateralFromSources(ctx, *u.SettledSubaccount.Id)
+			if err != nil {
+				return false, nil, err
+			}
+			collateralAdjustment.Add(collateralAdjustment, externalCollateral)
+		}
+		riskNew.NC.Add(riskNew.NC, collateralAdjustment)
 
 		var result = types.Success
 
-		// The subaccount is not well-collateralized after the update.
+		// The subaccount is not well-collateralized after the update, or trading is halted.
 		// We must now check if the state transition is valid.
-		if !riskNew.IsInitialCollateralized() {
+		if !riskNew.IsInitialCollateralized() || tradingHalted {
 			// Get the current collateralization and margin requirements without the update applied.
 			bytes, err := proto.Marshal(u.SettledSubaccount.Id)
 			if err != nil {
@@ -703,13 +793,56 @@ func (k Keeper) internalCanUpdateSubaccounts(
 				if err != nil {
 					return false, nil, err
 				}
+				riskCurMap[saKey].NC.Add(riskCurMap[saKey].NC, collateralAdjustment)
 			}
 
 			// Determine whether the state transition is valid.
-			result = salib.IsValidStateTransitionForUndercollateralizedSubaccount(
-				riskCurMap[saKey],
-				riskNew,
-			)
+			if !riskNew.IsInitialCollateralized() {
+				result = salib.IsValidStateTransitionForUndercollateralizedSubaccount(
+					riskCurMap[saKey],
+					riskNew,
+				)
+			}
+
+			// While trading is halted, only state transitions that reduce the risk of the subaccount are valid.
+			if result.IsSuccess() && tradingHalted && !salib.IsRiskReducing(riskCurMap[saKey], riskNew) {
+				result = types.ViolatesTradingHalt
+			}
+		}
+
+		// Updates must not increase a position beyond the max position notional of its liquidity tier or
+		// open a position beyond the max number of perpetual positions.
+		if result.IsSuccess() {
+			result, err = salib.CheckPositionLimits(u, perpInfos, positionLimits)
+			if err != nil {
+				return false, nil, err
+			}
+		}
+
+		// Opening updates must leave the net collateral above the buffered initial margin requirement.
+		if result.IsSuccess() &&
+			initialMarginBufferPpm != 0 &&
+			!riskNew.IsInitialCollateralizedWithBuffer(initialMarginBufferPpm) &&
+			salib.IsOpeningUpdate(u, updatedSubaccount) {
+			result = types.ViolatesInitialMarginBuffer
+		}
+
+		// Opening updates must not take the subaccount above its self-imposed leverage cap.
+		if result.IsSuccess() && salib.IsOpeningUpdate(u, updatedSubaccount) {
+			if maxLeveragePpm := k.GetMaxLeveragePpm(ctx, *u.SettledSubaccount.Id); maxLeveragePpm != 0 {
+				exceedsMaxLeverage, err := salib.ExceedsMaxLeverage(
+					updatedSubaccount,
+					perpInfos,
+					riskNew.NC,
+					maxLeveragePpm,
+				)
+				if err != nil {
+					return false, nil, err
+				}
+				if exceedsMaxLeverage {
+					result = types.ViolatesMaxLeverage
+				}
+			}
 		}
 
 		// If this state transition is not valid, the overall success is now false.
@@ -791,6 +924,17 @@ func (k Keeper) GetAllRelevantPerpetuals(
 		}
 	}
 
+	return k.getPerpInfos(ctx, perpIdsSet)
+}
+
+// getPerpInfos returns the perpetual information for a set of perpetual ids from state.
+func (k Keeper) getPerpInfos(
+	ctx sdk.Context,
+	perpIdsSet map[uint32]struct{},
+) (
+	perptypes.PerpInfos,
+	error,
+) {
 	// Important: Sort the perpIds to ensure determinism!
 	sortedPerpIds := lib.GetSortedKeys[lib.Sortable[uint32]](perpIdsSet)
 
@@ -814,9 +958,13 @@ func (k Keeper) GetAllRelevantPerpetuals(
 		liquidityTier := ltCache[ltId]
 
 		perpInfos[perpId] = perptypes.PerpInfo{
-			Perpetual:     perpetual,
-			Price:         price,
-			LiquidityTier: liquidityTier,
+			Perpetual:                     perpetual,
+			Price:                         price,
+			LiquidityTier:                 liquidityTier,
+			PerpetualType:                 perptypes.PerpetualType(perpetual.Params.PerpetualType),
+			MaxUnsettledFundingIndexDelta: perpetual.MaxUnsettledFundingIndexDelta,
+			SettlementPrice:               perpetual.SettlementPrice,
+			ConcentrationMargin:           perpetual.GetConcentrationMargin(),
 		}
 	}
 
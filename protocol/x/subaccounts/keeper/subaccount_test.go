@@ -238,6 +238,36 @@ func TestSubaccountGetNonExistent(t *testing.T) {
 	require.False(t, acct.MarginEnabled)
 }
 
+func TestUpdateSubaccounts_DuplicateAssetPositions(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ :=
+		keepertest.SubaccountsKeepers(t, true)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	keepertest.CreateTestPerpetuals(t, ctx, perpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+
+	// `SetSubaccount` does not validate the subaccount, so a corrupted subaccount can be written to state.
+	usdcPosition := testutil.CreateUsdcAssetPositions(big.NewInt(1_000))[0]
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:             &constants.Alice_Num0,
+		AssetPositions: []*types.AssetPosition{usdcPosition, usdcPosition},
+	})
+
+	updates := []types.Update{
+		{
+			SubaccountId: constants.Alice_Num0,
+			AssetUpdates: testutil.CreateUsdcAssetUpdates(big.NewInt(1)),
+		},
+	}
+	success, _, err := keeper.CanUpdateSubaccounts(ctx, updates, types.Deposit)
+	require.ErrorIs(t, err, types.ErrDuplicateAssetPositions)
+	require.False(t, success)
+
+	success, _, err = keeper.UpdateSubaccounts(ctx, updates, types.Deposit)
+	require.ErrorIs(t, err, types.ErrDuplicateAssetPositions)
+	require.False(t, success)
+}
+
 func TestGetAllSubaccount(t *testing.T) {
 	ctx, keeper, _, _, _, _, _, _, _, _, _ := keepertest.SubaccountsKeepers(t, true)
 	items := createNSubaccount(keeper, ctx, 10, big.NewInt(1_000))
@@ -298,6 +328,68 @@ func TestForEachSubaccount(t *testing.T) {
 	}
 }
 
+func TestIterateSubaccounts(t *testing.T) {
+	tests := map[string]struct {
+		numSubaccountsInState int
+		limit                 uint32
+		expectedNumChunks     int
+	}{
+		"No subaccounts in state": {
+			numSubaccountsInState: 0,
+			limit:                 3,
+			expectedNumChunks:     1,
+		},
+		"ten subaccounts in state, chunks of three": {
+			numSubaccountsInState: 10,
+			limit:                 3,
+			expectedNumChunks:     4,
+		},
+		"ten subaccounts in state, chunks of five": {
+			numSubaccountsInState: 10,
+			limit:                 5,
+			expectedNumChunks:     2,
+		},
+		"ten subaccounts in state, chunk larger than state": {
+			numSubaccountsInState: 10,
+			limit:                 20,
+			expectedNumChunks:     1,
+		},
+		"ten subaccounts in state, no limit": {
+			numSubaccountsInState: 10,
+			limit:                 0,
+			expectedNumChunks:     1,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, _, _, _, _, _, _, _, _, _ := keepertest.SubaccountsKeepers(t, true)
+			items := createNSubaccount(keeper, ctx, tc.numSubaccountsInState, big.NewInt(1_000))
+
+			collectedSubaccounts := make([]types.Subaccount, 0)
+			numChunks := 0
+			var startKey []byte
+			for {
+				chunkSize := uint32(0)
+				startKey = keeper.IterateSubaccounts(ctx, startKey, tc.limit, func(subaccount types.Subaccount) {
+					chunkSize++
+					collectedSubaccounts = append(collectedSubaccounts, subaccount)
+				})
+				numChunks++
+				if tc.limit != 0 {
+					require.LessOrEqual(t, chunkSize, tc.limit)
+				}
+				if startKey == nil {
+					break
+				}
+			}
+
+			// Every subaccount is iterated over exactly once, in state key order.
+			require.Equal(t, tc.expectedNumChunks, numChunks)
+			require.Equal(t, items, collectedSubaccounts)
+		})
+	}
+}
+
 func TestUpdateSubaccounts(t *testing.T) {
 	// default subaccount id, the first subaccount id generated when calling createNSubaccount
 	defaultSubaccountId := types.SubaccountId{
@@ -1584,7 +1676,7 @@ func TestUpdateSubaccounts(t *testing.T) {
 		"update would make account undercollateralized": {
 			expectedQuoteBalance:     big.NewInt(0),
 			expectedSuccess:          false,
-			expectedSuccessPerUpdate: []types.UpdateResult{types.NewlyUndercollateralized},
+			expectedSuccessPerUpdate: []types.UpdateResult{types.NewlyBelowMaintenanceMargin},
 			perpetuals: []perptypes.Perpetual{
 				constants.BtcUsd_SmallMarginRequirement,
 			},
@@ -3840,8 +3932,8 @@ func TestUpdateSubaccounts_WithdrawalsBlocked(t *testing.T) {
 			expectedQuoteBalance: big.NewInt(0),
 			expectedSuccess:      false,
 			expectedSuccessPerUpdate: []types.UpdateResult{
-				types.NewlyUndercollateralized,
-				types.NewlyUndercollateralized,
+				types.NewlyBelowMaintenanceMargin,
+				types.NewlyBelowMaintenanceMargin,
 			},
 			perpetuals: []perptypes.Perpetual{
 				constants.BtcUsd_SmallMarginRequirement,
@@ -3886,8 +3978,8 @@ func TestUpdateSubaccounts_WithdrawalsBlocked(t *testing.T) {
 			expectedQuoteBalance: big.NewInt(0),
 			expectedSuccess:      false,
 			expectedSuccessPerUpdate: []types.UpdateResult{
-				types.NewlyUndercollateralized,
-				types.NewlyUndercollateralized,
+				types.NewlyBelowMaintenanceMargin,
+				types.NewlyBelowMaintenanceMargin,
 			},
 			perpetuals: []perptypes.Perpetual{
 				constants.BtcUsd_SmallMarginRequirement,
@@ -3933,8 +4025,8 @@ func TestUpdateSubaccounts_WithdrawalsBlocked(t *testing.T) {
 			expectedQuoteBalance: big.NewInt(0),
 			expectedSuccess:      false,
 			expectedSuccessPerUpdate: []types.UpdateResult{
-				types.NewlyUndercollateralized,
-				types.NewlyUndercollateralized,
+				types.NewlyBelowMaintenanceMargin,
+				types.NewlyBelowMaintenanceMargin,
 			},
 			perpetuals: []perptypes.Perpetual{
 				constants.BtcUsd_SmallMarginRequirement,
@@ -4506,6 +4598,195 @@ func TestUpdateSubaccounts_WithdrawalsBlocked(t *testing.T) {
 	}
 }
 
+func TestUpdateSubaccounts_SequentialUpdates(t *testing.T) {
+	type step struct {
+		assetUpdates     []types.AssetUpdate
+		perpetualUpdates []types.PerpetualUpdate
+		updateType       types.UpdateType
+
+		expectedResult types.UpdateResult
+	}
+	// Buys 1 BTC for $50,000, with an IMR of $10,000.
+	openBtcLong := func(expectedResult types.UpdateResult) step {
+		return step{
+			assetUpdates: testutil.CreateUsdcAssetUpdates(big.NewInt(-50_000_000_000)),
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(100_000_000)},
+			},
+			updateType:     types.CollatCheck,
+			expectedResult: expectedResult,
+		}
+	}
+
+	tests := map[string]struct {
+		usdcQuantums *big.Int
+		steps        []step
+
+		expectedUsdcQuantums *big.Int
+		expectedBtcQuantums  *big.Int
+	}{
+		"open is allowed by a deposit earlier in the block": {
+			usdcQuantums: big.NewInt(5_000_000_000), // $5,000
+			steps: []step{
+				openBtcLong(types.NewlyUndercollateralized),
+				{
+					assetUpdates:   testutil.CreateUsdcAssetUpdates(big.NewInt(5_000_000_000)),
+					updateType:     types.Deposit,
+					expectedResult: types.Success,
+				},
+				openBtcLong(types.Success),
+			},
+			expectedUsdcQuantums: big.NewInt(-40_000_000_000),
+			expectedBtcQuantums:  big.NewInt(100_000_000),
+		},
+		"withdrawal is rejected by an open earlier in the block": {
+			// The withdrawal is collateralized against the state at the start of the block.
+			usdcQuantums: big.NewInt(10_000_000_000), // $10,000
+			steps: []step{
+				openBtcLong(types.Success),
+				{
+					assetUpdates:   testutil.CreateUsdcAssetUpdates(big.NewInt(-1_000_000_000)),
+					updateType:     types.Withdrawal,
+					expectedResult: types.NewlyUndercollateralized,
+				},
+			},
+			expectedUsdcQuantums: big.NewInt(-40_000_000_000),
+			expectedBtcQuantums:  big.NewInt(100_000_000),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(tc.usdcQuantums),
+			})
+
+			// Each step is applied as a separate message would be, in order.
+			for i, s := range tc.steps {
+				success, successPerUpdate, err := keeper.UpdateSubaccounts(
+					ctx,
+					[]types.Update{
+						{
+							SubaccountId:     constants.Alice_Num0,
+							AssetUpdates:     s.assetUpdates,
+							PerpetualUpdates: s.perpetualUpdates,
+						},
+					},
+					s.updateType,
+				)
+				require.NoError(t, err, "step %d", i)
+				require.Equal(t, s.expectedResult.IsSuccess(), success, "step %d", i)
+				require.Equal(t, []types.UpdateResult{s.expectedResult}, successPerUpdate, "step %d", i)
+			}
+
+			subaccount := keeper.GetSubaccount(ctx, constants.Alice_Num0)
+			require.Equal(t, tc.expectedUsdcQuantums, subaccount.GetUsdcPosition())
+			require.Len(t, subaccount.PerpetualPositions, 1)
+			require.Equal(t, tc.expectedBtcQuantums, subaccount.PerpetualPositions[0].GetBigQuantums())
+		})
+	}
+}
+
+func TestUpdateSubaccounts_MatchAccruesOpenInterestImbalanceFee(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+		t,
+		true,
+	)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+	p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+	_, err := perpetualsKeeper.CreatePerpetual(
+		ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.MarketType,
+	)
+	require.NoError(t, err)
+
+	params := constants.PerpetualsGenesisParams
+	params.OpenInterestImbalanceFeePpm = 1_000 // 0.1%
+	require.NoError(t, perpetualsKeeper.SetParams(ctx, params))
+
+	for _, id := range []types.SubaccountId{constants.Alice_Num0, constants.Bob_Num0} {
+		keeper.SetSubaccount(ctx, types.Subaccount{
+			Id:             &id,
+			AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(100_000_000_000)), // $100,000
+		})
+	}
+
+	// Alice buys 1 BTC worth $50,000 from Bob.
+	fill := func(buyer types.SubaccountId, seller types.SubaccountId) {
+		success, _, err := keeper.UpdateSubaccounts(
+			ctx,
+			[]types.Update{
+				{
+					SubaccountId: buyer,
+					AssetUpdates: testutil.CreateUsdcAssetUpdates(big.NewInt(-50_000_000_000)),
+					PerpetualUpdates: []types.PerpetualUpdate{
+						{
+							PerpetualId:          0,
+							BigQuantumsDelta:     big.NewInt(100_000_000),
+							BigFillQuoteQuantums: big.NewInt(50_000_000_000),
+						},
+					},
+				},
+				{
+					SubaccountId: seller,
+					AssetUpdates: testutil.CreateUsdcAssetUpdates(big.NewInt(50_000_000_000)),
+					PerpetualUpdates: []types.PerpetualUpdate{
+						{
+							PerpetualId:          0,
+							BigQuantumsDelta:     big.NewInt(-100_000_000),
+							BigFillQuoteQuantums: big.NewInt(50_000_000_000),
+						},
+					},
+				},
+			},
+			types.Match,
+		)
+		require.NoError(t, err)
+		require.True(t, success)
+	}
+
+	// Opening a position on both sides increases the open interest by 1 BTC, and accrues 0.1% of $50,000.
+	fill(constants.Alice_Num0, constants.Bob_Num0)
+	pool, err := perpetualsKeeper.GetOpenInterestImbalancePool(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(50_000_000), pool)
+
+	// Closing both positions decreases the open interest by 1 BTC, and accrues the same fee.
+	fill(constants.Bob_Num0, constants.Alice_Num0)
+	pool, err = perpetualsKeeper.GetOpenInterestImbalancePool(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100_000_000), pool)
+}
+
 func TestCanUpdateSubaccounts(t *testing.T) {
 	tests := map[string]struct {
 		// State.
@@ -4795,6 +5076,88 @@ func TestCanUpdateSubaccounts(t *testing.T) {
 			},
 			updateType: types.Match,
 		},
+		"(OIMF) order collateralized at OIMF of current OI but not OIMF after the order is filled": {
+			expectedSuccess: false,
+			expectedSuccessPerUpdate: []types.UpdateResult{
+				types.NewlyUndercollateralized,
+			},
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance_25mmLowerCap_50mmUpperCap,
+			},
+			assetPositions: []*types.AssetPosition{
+				{
+					AssetId: uint32(0),
+					// 4,000,000 USDC (enough to collateralize 90 BTC at $50,000 and 84% OIMF, but not 98.4% OIMF)
+					Quantums: dtypes.NewInt(4_000_000_000_000),
+				},
+			},
+			openInterests: []perptypes.OpenInterestDelta{
+				{
+					PerpetualId: uint32(0),
+					// 900 BTC. At $50,000, this is $45,000,000 of OI, so OIMF is 84%.
+					// OI would be at most $49,500,000 after the order is filled, so OIMF would be 98.4%.
+					BaseQuantums: big.NewInt(90_000_000_000),
+				},
+			},
+			updates: []types.Update{
+				{
+					PerpetualUpdates: []types.PerpetualUpdate{
+						{
+							PerpetualId:      uint32(0),
+							BigQuantumsDelta: big.NewInt(9_000_000_000), // 90 BTC
+						},
+					},
+					AssetUpdates: []types.AssetUpdate{
+						{
+							AssetId:          uint32(0),
+							BigQuantumsDelta: big.NewInt(-4_500_000_000_000), // -4,500,000 USDC
+						},
+					},
+				},
+			},
+			updateType: types.CollatCheck,
+		},
+		"(OIMF) order collateralized at OIMF after the order is filled": {
+			expectedSuccess: true,
+			expectedSuccessPerUpdate: []types.UpdateResult{
+				types.Success,
+			},
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance_25mmLowerCap_50mmUpperCap,
+			},
+			assetPositions: []*types.AssetPosition{
+				{
+					AssetId: uint32(0),
+					// 4,500,000 USDC (enough to collateralize 90 BTC at $50,000 and 98.4% OIMF)
+					Quantums: dtypes.NewInt(4_500_000_000_000),
+				},
+			},
+			openInterests: []perptypes.OpenInterestDelta{
+				{
+					PerpetualId: uint32(0),
+					// 900 BTC. At $50,000, this is $45,000,000 of OI, so OIMF is 84%.
+					// OI would be at most $49,500,000 after the order is filled, so OIMF would be 98.4%.
+					BaseQuantums: big.NewInt(90_000_000_000),
+				},
+			},
+			updates: []types.Update{
+				{
+					PerpetualUpdates: []types.PerpetualUpdate{
+						{
+							PerpetualId:      uint32(0),
+							BigQuantumsDelta: big.NewInt(9_000_000_000), // 90 BTC
+						},
+					},
+					AssetUpdates: []types.AssetUpdate{
+						{
+							AssetId:          uint32(0),
+							BigQuantumsDelta: big.NewInt(-4_500_000_000_000), // -4,500,000 USDC
+						},
+					},
+				},
+			},
+			updateType: types.CollatCheck,
+		},
 		"(OIMF) OIMF caps at 100%, un-leveraged trade always succeeds": {
 			expectedSuccess: true,
 			expectedSuccessPerUpdate: []types.UpdateResult{
@@ -4954,7 +5317,7 @@ func TestCanUpdateSubaccounts(t *testing.T) {
 		},
 		"multiple updates are considered independently for same account": {
 			expectedSuccess:          false,
-			expectedSuccessPerUpdate: []types.UpdateResult{types.NewlyUndercollateralized, types.Success, types.Success},
+			expectedSuccessPerUpdate: []types.UpdateResult{types.NewlyBelowMaintenanceMargin, types.Success, types.Success},
 			perpetuals: []perptypes.Perpetual{
 				constants.BtcUsd_SmallMarginRequirement,
 			},
@@ -5097,7 +5460,7 @@ func TestCanUpdateSubaccounts(t *testing.T) {
 		"two updates on different accounts, second account is new account": {
 			assetPositions:           testutil.CreateUsdcAssetPositions(big.NewInt(50_000_000_000)), // $50,000
 			expectedSuccess:          false,
-			expectedSuccessPerUpdate: []types.UpdateResult{types.Success, types.NewlyUndercollateralized},
+			expectedSuccessPerUpdate: []types.UpdateResult{types.Success, types.NewlyBelowMaintenanceMargin},
 			perpetuals: []perptypes.Perpetual{
 				constants.BtcUsd_100PercentMarginRequirement,
 			},
@@ -5172,7 +5535,7 @@ func TestCanUpdateSubaccounts(t *testing.T) {
 			},
 			expectedSuccess: false,
 			expectedSuccessPerUpdate: []types.UpdateResult{
-				types.NewlyUndercollateralized,
+				types.NewlyBelowMaintenanceMargin,
 			},
 		},
 		"unsettled funding makes position undercollateralized": {
@@ -5195,7 +5558,7 @@ func TestCanUpdateSubaccounts(t *testing.T) {
 			},
 			expectedSuccess: false,
 			expectedSuccessPerUpdate: []types.UpdateResult{
-				types.NewlyUndercollateralized,
+				types.NewlyBelowMaintenanceMargin,
 			},
 		},
 		"position was undercollateralized before update due to funding and still undercollateralized" +
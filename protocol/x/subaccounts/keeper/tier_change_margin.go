@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetMarginDeltaForTierChange returns the change in quote quantums of the initial and maintenance margin
+// requirements of the subaccount if the perpetual were migrated to `proposedTier`, with all other
+// perpetuals, prices and positions held fixed. Positive deltas mean the subaccount would need more margin.
+// This is read-only. Returns zero deltas if the subaccount has no position in the perpetual.
+func (k Keeper) GetMarginDeltaForTierChange(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	perpetualId uint32,
+	proposedTier perptypes.LiquidityTier,
+) (
+	imrDelta *big.Int,
+	mmrDelta *big.Int,
+	err error,
+) {
+	if err := proposedTier.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	inputs, err := k.GetRiskInputs(ctx, subaccountId)
+	if err != nil {
+		return nil, nil, err
+	}
+	info, exists := inputs.PerpInfos[perpetualId]
+	if !exists {
+		return new(big.Int), new(big.Int), nil
+	}
+
+	proposedPerpInfos := make(perptypes.PerpInfos, len(inputs.PerpInfos))
+	for id, perpInfo := range inputs.PerpInfos {
+		proposedPerpInfos[id] = perpInfo
+	}
+	info.Perpetual.Params.LiquidityTier = proposedTier.Id
+	info.LiquidityTier = proposedTier
+	proposedPerpInfos[perpetualId] = info
+
+	currentRisk, err := salib.GetRiskForSubaccount(inputs.SettledSubaccount, inputs.PerpInfos)
+	if err != nil {
+		return nil, nil, err
+	}
+	proposedRisk, err := salib.GetRiskForSubaccount(inputs.SettledSubaccount, proposedPerpInfos)
+	if err != nil {
+		return nil, nil, err
+	}
+	return new(big.Int).Sub(proposedRisk.IMR, currentRisk.IMR),
+		new(big.Int).Sub(proposedRisk.MMR, currentRisk.MMR),
+		nil
+}
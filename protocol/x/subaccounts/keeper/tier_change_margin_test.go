@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMarginDeltaForTierChange(t *testing.T) {
+	// Alice holds 1 BTC worth $50,000 and -1 ETH worth $3,000, both in a liquidity tier with a 20% initial
+	// margin fraction and a maintenance fraction of 50%. Only the BTC position is migrated.
+	tests := map[string]struct {
+		subaccountId           types.SubaccountId
+		initialMarginPpm       uint32
+		maintenanceFractionPpm uint32
+
+		expectedImrDelta *big.Int
+		expectedMmrDelta *big.Int
+		expectedErr      error
+	}{
+		"tightening increases the margin requirements": {
+			// The IMR of BTC increases from $10,000 to $15,000 and the MMR from $5,000 to $12,000.
+			subaccountId:           constants.Alice_Num0,
+			initialMarginPpm:       300_000,
+			maintenanceFractionPpm: 800_000,
+			expectedImrDelta:       big.NewInt(5_000_000_000),
+			expectedMmrDelta:       big.NewInt(7_000_000_000),
+		},
+		"loosening decreases the margin requirements": {
+			// The IMR of BTC decreases from $10,000 to $5,000 and the MMR from $5,000 to $2,500.
+			subaccountId:           constants.Alice_Num0,
+			initialMarginPpm:       100_000,
+			maintenanceFractionPpm: 500_000,
+			expectedImrDelta:       big.NewInt(-5_000_000_000),
+			expectedMmrDelta:       big.NewInt(-2_500_000_000),
+		},
+		"no position": {
+			subaccountId:           constants.Bob_Num0,
+			initialMarginPpm:       300_000,
+			maintenanceFractionPpm: 800_000,
+			expectedImrDelta:       big.NewInt(0),
+			expectedMmrDelta:       big.NewInt(0),
+		},
+		"invalid proposed tier": {
+			subaccountId:           constants.Alice_Num0,
+			initialMarginPpm:       1_000_001,
+			maintenanceFractionPpm: 500_000,
+			expectedErr:            perptypes.ErrInitialMarginPpmExceedsMax,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			for _, p := range []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				constants.EthUsd_20PercentInitial_10PercentMaintenance,
+			} {
+				_, err := perpetualsKeeper.CreatePerpetual(
+					ctx,
+					p.Params.Id,
+					p.Params.Ticker,
+					p.Params.MarketId,
+					p.Params.AtomicResolution,
+					p.Params.DefaultFundingPpm,
+					p.Params.LiquidityTier,
+					p.Params.MarketType,
+				)
+				require.NoError(t, err)
+			}
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-30_000_000_000)), // -$30,000
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+					testutil.CreateSinglePerpetualPosition(1, big.NewInt(-1_000_000_000), big.NewInt(0), big.NewInt(0)),
+				},
+			})
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:             &constants.Bob_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)), // $10,000
+			})
+
+			tier, err := perpetualsKeeper.GetLiquidityTier(ctx, 3)
+			require.NoError(t, err)
+			proposedTier := tier
+			proposedTier.InitialMarginPpm = tc.initialMarginPpm
+			proposedTier.MaintenanceFractionPpm = tc.maintenanceFractionPpm
+
+			imrDelta, mmrDelta, err := keeper.GetMarginDeltaForTierChange(ctx, tc.subaccountId, 0, proposedTier)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedImrDelta, imrDelta)
+			require.Equal(t, tc.expectedMmrDelta, mmrDelta)
+
+			// The liquidity tier itself is unchanged.
+			current, err := perpetualsKeeper.GetLiquidityTier(ctx, 3)
+			require.NoError(t, err)
+			require.Equal(t, tier, current)
+		})
+	}
+}
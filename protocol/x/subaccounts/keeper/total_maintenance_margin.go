@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetTotalMaintenanceMargin returns the sum of the maintenance margin requirements of all subaccounts in
+// quote quantums. The requirement of every subaccount is computed after settling funding, using a single
+// snapshot of the perpetuals, prices and liquidity tiers of all open positions.
+//
+// If `byPerpetual` is true, the total maintenance margin requirement of the positions in each perpetual
+// is also returned, keyed by perpetual id. Otherwise `perPerpetual` is nil.
+func (k Keeper) GetTotalMaintenanceMargin(
+	ctx sdk.Context,
+	byPerpetual bool,
+) (
+	total *big.Int,
+	perPerpetual map[uint32]*big.Int,
+	err error,
+) {
+	subaccounts := k.GetAllSubaccount(ctx)
+	updates := make([]types.Update, len(subaccounts))
+	for i, subaccount := range subaccounts {
+		updates[i] = types.Update{SubaccountId: *subaccount.Id}
+	}
+	perpInfos, err := k.GetAllRelevantPerpetuals(ctx, updates)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	total = new(big.Int)
+	if byPerpetual {
+		perPerpetual = make(map[uint32]*big.Int)
+	}
+	for _, subaccount := range subaccounts {
+		settledSubaccount, _ := salib.GetSettledSubaccountWithPerpetuals(subaccount, perpInfos)
+		if !byPerpetual {
+			risk, err := salib.GetRiskForSubaccount(settledSubaccount, perpInfos)
+			if err != nil {
+				return nil, nil, err
+			}
+			total.Add(total, risk.MMR)
+			continue
+		}
+
+		breakdown, err := salib.GetRiskBreakdownForSubaccount(settledSubaccount, perpInfos)
+		if err != nil {
+			return nil, nil, err
+		}
+		total.Add(total, breakdown.Total.MMR)
+		for perpetualId, positionRisk := range breakdown.PerpetualPositions {
+			if _, ok := perPerpetual[perpetualId]; !ok {
+				perPerpetual[perpetualId] = new(big.Int)
+			}
+			perPerpetual[perpetualId].Add(perPerpetual[perpetualId], positionRisk.Risk.MMR)
+		}
+	}
+	return total, perPerpetual, nil
+}
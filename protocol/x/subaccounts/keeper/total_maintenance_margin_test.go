@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTotalMaintenanceMargin(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+		t,
+		true,
+	)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+
+	for _, p := range []perptypes.Perpetual{
+		constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+		constants.EthUsd_20PercentInitial_10PercentMaintenance,
+	} {
+		_, err := perpetualsKeeper.CreatePerpetual(
+			ctx,
+			p.Params.Id,
+			p.Params.Ticker,
+			p.Params.MarketId,
+			p.Params.AtomicResolution,
+			p.Params.DefaultFundingPpm,
+			p.Params.LiquidityTier,
+			p.Params.MarketType,
+		)
+		require.NoError(t, err)
+	}
+
+	// No subaccounts have no maintenance margin requirement.
+	total, perPerpetual, err := keeper.GetTotalMaintenanceMargin(ctx, true)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(0), total)
+	require.Empty(t, perPerpetual)
+
+	subaccounts := []types.Subaccount{
+		{
+			Id:             &constants.Alice_Num0,
+			AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)), // $10,000
+		},
+		{
+			// MMR = 10% * $50,000 + 10% * $3,000 = $5,300.
+			Id:             &constants.Bob_Num0,
+			AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-20_000_000_000)),
+			PerpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)), // 1 BTC
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(-1_000_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+		},
+		{
+			// MMR = 10% * $25,000 = $2,500, with unsettled funding owed.
+			Id:             &constants.Carl_Num0,
+			AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(30_000_000_000)),
+			PerpetualPositions: []*types.PerpetualPosition{
+				{
+					PerpetualId:  0,
+					Quantums:     dtypes.NewInt(-50_000_000), // -0.5 BTC
+					FundingIndex: dtypes.NewInt(20_000_000),
+				},
+			},
+		},
+	}
+	for _, subaccount := range subaccounts {
+		keeper.SetSubaccount(ctx, subaccount)
+	}
+
+	total, perPerpetual, err = keeper.GetTotalMaintenanceMargin(ctx, true)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(7_800_000_000), total)
+	require.Equal(t, map[uint32]*big.Int{
+		0: big.NewInt(7_500_000_000),
+		1: big.NewInt(300_000_000),
+	}, perPerpetual)
+
+	// The total equals the sum of the maintenance margin requirements of the individual subaccounts.
+	sum := new(big.Int)
+	for _, subaccount := range subaccounts {
+		risk, err := keeper.GetNetCollateralAndMarginRequirements(ctx, types.Update{SubaccountId: *subaccount.Id})
+		require.NoError(t, err)
+		sum.Add(sum, risk.MMR)
+	}
+	require.Equal(t, sum, total)
+
+	// The breakdown is optional.
+	total, perPerpetual, err = keeper.GetTotalMaintenanceMargin(ctx, false)
+	require.NoError(t, err)
+	require.Equal(t, sum, total)
+	require.Nil(t, perPerpetual)
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetTotalValueLocked returns the total value locked across all subaccounts in quote quantums,
+// which is the sum of the net collateral of all subaccounts with positive net collateral. The sum
+// of the net collateral of all subaccounts with negative net collateral is returned separately.
+// The net collateral of every subaccount is computed after settling funding, using a single
+// snapshot of the perpetuals, prices and liquidity tiers of all open positions.
+func (k Keeper) GetTotalValueLocked(
+	ctx sdk.Context,
+) (
+	totalValueLocked *big.Int,
+	totalNegativeNetCollateral *big.Int,
+	err error,
+) {
+	subaccounts := k.GetAllSubaccount(ctx)
+	updates := make([]types.Update, len(subaccounts))
+	for i, subaccount := range subaccounts {
+		updates[i] = types.Update{SubaccountId: *subaccount.Id}
+	}
+	perpInfos, err := k.GetAllRelevantPerpetuals(ctx, updates)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	totalValueLocked = new(big.Int)
+	totalNegativeNetCollateral = new(big.Int)
+	for _, subaccount := range subaccounts {
+		settledSubaccount, _ := salib.GetSettledSubaccountWithPerpetuals(subaccount, perpInfos)
+		risk, err := salib.GetRiskForSubaccount(settledSubaccount, perpInfos)
+		if err != nil {
+			return nil, nil, err
+		}
+		if risk.NC.Sign() > 0 {
+			totalValueLocked.Add(totalValueLocked, risk.NC)
+		} else {
+			totalNegativeNetCollateral.Add(totalNegativeNetCollateral, risk.NC)
+		}
+	}
+	return totalValueLocked, totalNegativeNetCollateral, nil
+}
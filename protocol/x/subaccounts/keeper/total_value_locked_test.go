@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTotalValueLocked(t *testing.T) {
+	tests := map[string]struct {
+		subaccounts []types.Subaccount
+
+		expectedTotalValueLocked           *big.Int
+		expectedTotalNegativeNetCollateral *big.Int
+	}{
+		"no subaccounts": {
+			expectedTotalValueLocked:           big.NewInt(0),
+			expectedTotalNegativeNetCollateral: big.NewInt(0),
+		},
+		"mix of positive and negative net collateral": {
+			subaccounts: []types.Subaccount{
+				{
+					Id:             &constants.Alice_Num0,
+					AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)), // $10,000
+				},
+				{
+					// NC = $50,000 - $45,000 = $5,000.
+					Id:             &constants.Bob_Num0,
+					AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-45_000_000_000)),
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(
+							0,
+							big.NewInt(100_000_000), // 1 BTC
+							big.NewInt(0),
+							big.NewInt(0),
+						),
+					},
+				},
+				{
+					// NC = $50,000 - $52,000 = -$2,000.
+					Id:             &constants.Carl_Num0,
+					AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-52_000_000_000)),
+					PerpetualPositions: []*types.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(
+							0,
+							big.NewInt(100_000_000), // 1 BTC
+							big.NewInt(0),
+							big.NewInt(0),
+						),
+					},
+				},
+				{
+					Id:             &constants.Dave_Num0,
+					AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-1_000_000_000)), // -$1,000
+				},
+			},
+			expectedTotalValueLocked:           big.NewInt(15_000_000_000),
+			expectedTotalNegativeNetCollateral: big.NewInt(-3_000_000_000),
+		},
+		"unsettled funding is included in net collateral": {
+			subaccounts: []types.Subaccount{
+				{
+					// NC = $50,000 - $49,000 - $2,000 of funding owed = -$1,000.
+					Id:             &constants.Alice_Num0,
+					AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-49_000_000_000)),
+					PerpetualPositions: []*types.PerpetualPosition{
+						{
+							PerpetualId:  0,
+							Quantums:     dtypes.NewInt(100_000_000), // 1 BTC
+							FundingIndex: dtypes.NewInt(-20_000_000),
+						},
+					},
+				},
+			},
+			expectedTotalValueLocked:           big.NewInt(0),
+			expectedTotalNegativeNetCollateral: big.NewInt(-1_000_000_000),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			for _, subaccount := range tc.subaccounts {
+				keeper.SetSubaccount(ctx, subaccount)
+			}
+
+			totalValueLocked, totalNegativeNetCollateral, err := keeper.GetTotalValueLocked(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTotalValueLocked, totalValueLocked)
+			require.Equal(t, tc.expectedTotalNegativeNetCollateral, totalNegativeNetCollateral)
+		})
+	}
+}
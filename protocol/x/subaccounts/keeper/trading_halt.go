@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	ante_types "github.com/dydxprotocol/v4-chain/protocol/app/ante/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetTradingHalted returns whether trading is halted across all subaccounts. While trading is halted,
+// only updates that reduce the risk of a subaccount are allowed and all withdrawals and transfers are
+// blocked.
+//
+// The flag is read for every subaccount update, so reading it does not consume gas.
+func (k Keeper) GetTradingHalted(ctx sdk.Context) bool {
+	noGasCtx := ctx.WithGasMeter(ante_types.NewFreeInfiniteGasMeter())
+	store := noGasCtx.KVStore(k.storeKey)
+	b := store.Get([]byte(types.TradingHaltedKey))
+	if b == nil {
+		return false
+	}
+
+	halted := gogotypes.BoolValue{}
+	k.cdc.MustUnmarshal(b, &halted)
+	return halted.Value
+}
+
+// SetTradingHalted halts or resumes trading across all subaccounts.
+func (k Keeper) SetTradingHalted(ctx sdk.Context, halted bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !halted {
+		store.Delete([]byte(types.TradingHaltedKey))
+		return
+	}
+	store.Set(
+		[]byte(types.TradingHaltedKey),
+		k.cdc.MustMarshal(&gogotypes.BoolValue{Value: halted}),
+	)
+}
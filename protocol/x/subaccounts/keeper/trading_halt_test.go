@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetTradingHalted(t *testing.T) {
+	ctx, keeper, _, _, _, _, _, _, _, _, _ := keepertest.SubaccountsKeepers(t, true)
+	require.False(t, keeper.GetTradingHalted(ctx))
+
+	keeper.SetTradingHalted(ctx, true)
+	require.True(t, keeper.GetTradingHalted(ctx))
+
+	keeper.SetTradingHalted(ctx, false)
+	require.False(t, keeper.GetTradingHalted(ctx))
+}
+
+func TestUpdateSubaccounts_TradingHalted(t *testing.T) {
+	tests := map[string]struct {
+		tradingHalted    bool
+		assetUpdates     []types.AssetUpdate
+		perpetualUpdates []types.PerpetualUpdate
+		updateType       types.UpdateType
+
+		expectedResult types.UpdateResult
+	}{
+		"opening update is allowed while trading is not halted": {
+			tradingHalted: false,
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(-5_000_000_000)}, // -$5,000
+			},
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(10_000_000)}, // 0.1 BTC
+			},
+			updateType:     types.CollatCheck,
+			expectedResult: types.Success,
+		},
+		"opening update is rejected while trading is halted": {
+			tradingHalted: true,
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(-5_000_000_000)}, // -$5,000
+			},
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(10_000_000)}, // 0.1 BTC
+			},
+			updateType:     types.CollatCheck,
+			expectedResult: types.ViolatesTradingHalt,
+		},
+		"partially closing update is allowed while trading is halted": {
+			tradingHalted: true,
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(25_000_000_000)}, // $25,000
+			},
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(-50_000_000)}, // -0.5 BTC
+			},
+			updateType:     types.CollatCheck,
+			expectedResult: types.Success,
+		},
+		"closing update is allowed while trading is halted": {
+			tradingHalted: true,
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(50_000_000_000)}, // $50,000
+			},
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(-100_000_000)}, // -1 BTC
+			},
+			updateType:     types.CollatCheck,
+			expectedResult: types.Success,
+		},
+		"flipping to a larger position is rejected while trading is halted": {
+			tradingHalted: true,
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(150_000_000_000)}, // $150,000
+			},
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 0, BigQuantumsDelta: big.NewInt(-300_000_000)}, // -3 BTC
+			},
+			updateType:     types.CollatCheck,
+			expectedResult: types.ViolatesTradingHalt,
+		},
+		"deposit is allowed while trading is halted": {
+			tradingHalted: true,
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(1_000_000_000)}, // $1,000
+			},
+			updateType:     types.Deposit,
+			expectedResult: types.Success,
+		},
+		"withdrawal is blocked while trading is halted": {
+			tradingHalted: true,
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: constants.Usdc.Id, BigQuantumsDelta: big.NewInt(-1_000_000_000)}, // -$1,000
+			},
+			updateType:     types.Withdrawal,
+			expectedResult: types.WithdrawalsAndTransfersBlocked,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			subaccount := types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(100_000_000_000)), // $100,000
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(
+						0,
+						big.NewInt(100_000_000), // 1 BTC
+						big.NewInt(0),
+						big.NewInt(0),
+					),
+				},
+			}
+			keeper.SetSubaccount(ctx, subaccount)
+			keeper.SetTradingHalted(ctx, tc.tradingHalted)
+
+			success, successPerUpdate, err := keeper.UpdateSubaccounts(
+				ctx,
+				[]types.Update{
+					{
+						SubaccountId:     constants.Alice_Num0,
+						AssetUpdates:     tc.assetUpdates,
+						PerpetualUpdates: tc.perpetualUpdates,
+					},
+				},
+				tc.updateType,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedResult.IsSuccess(), success)
+			require.Equal(t, []types.UpdateResult{tc.expectedResult}, successPerUpdate)
+			if !success {
+				require.Equal(t, subaccount, keeper.GetSubaccount(ctx, constants.Alice_Num0))
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	salib "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetWithdrawableCheck returns whether a withdrawal of `quoteQuantums` USDC quote quantums from the
+// subaccount would leave it collateralized, and the maximum quote quantums that can be withdrawn. A
+// withdrawal is allowed if the subaccount remains at or above its initial margin requirement after the
+// withdrawal, or if the state transition is otherwise valid for an undercollateralized subaccount as
+// determined by `IsValidStateTransitionForUndercollateralizedSubaccount`.
+//
+// The maximum withdrawable amount is the free collateral of the subaccount, as returned by
+// `MaxWithdrawableQuoteQuantums`. Withdrawals blocked for other reasons, e.g. after a negative TNC
+// subaccount was seen, are not taken into account.
+func (k Keeper) GetWithdrawableCheck(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	quoteQuantums *big.Int,
+) (
+	allowed bool,
+	maxWithdrawable *big.Int,
+	err error,
+) {
+	if quoteQuantums.Sign() <= 0 {
+		return false, nil, errorsmod.Wrapf(
+			types.ErrAssetTransferQuantumsNotPositive,
+			"quote quantums: %v",
+			quoteQuantums,
+		)
+	}
+
+	riskCur, err := k.GetNetCollateralAndMarginRequirements(ctx, types.Update{SubaccountId: subaccountId})
+	if err != nil {
+		return false, nil, err
+	}
+	riskNew, err := k.GetNetCollateralAndMarginRequirements(
+		ctx,
+		types.Update{
+			SubaccountId: subaccountId,
+			AssetUpdates: []types.AssetUpdate{
+				{
+					AssetId:          assettypes.AssetUsdc.Id,
+					BigQuantumsDelta: new(big.Int).Neg(quoteQuantums),
+				},
+			},
+		},
+	)
+	if err != nil {
+		return false, nil, err
+	}
+
+	allowed = riskNew.IsInitialCollateralized() ||
+		salib.IsValidStateTransitionForUndercollateralizedSubaccount(riskCur, riskNew).IsSuccess()
+
+	return allowed, salib.MaxWithdrawableQuoteQuantums(riskCur), nil
+}
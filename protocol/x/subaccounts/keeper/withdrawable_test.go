@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetWithdrawableCheck(t *testing.T) {
+	tests := map[string]struct {
+		usdcQuantums       *big.Int
+		perpetualPositions []*types.PerpetualPosition
+		quoteQuantums      *big.Int
+
+		expectedAllowed         bool
+		expectedMaxWithdrawable *big.Int
+		expectedErr             error
+	}{
+		"withdrawal within the max withdrawable": {
+			// NC of $11,000 and IMR of $10,000.
+			usdcQuantums: big.NewInt(-39_000_000_000), // -$39,000
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+			quoteQuantums:           big.NewInt(500_000_000), // $500
+			expectedAllowed:         true,
+			expectedMaxWithdrawable: big.NewInt(1_000_000_000), // $1,000
+		},
+		"withdrawal of exactly the max withdrawable": {
+			usdcQuantums: big.NewInt(-39_000_000_000), // -$39,000
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+			quoteQuantums:           big.NewInt(1_000_000_000), // $1,000
+			expectedAllowed:         true,
+			expectedMaxWithdrawable: big.NewInt(1_000_000_000), // $1,000
+		},
+		"withdrawal beyond the max withdrawable": {
+			usdcQuantums: big.NewInt(-39_000_000_000), // -$39,000
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+			quoteQuantums:           big.NewInt(1_500_000_000), // $1,500
+			expectedAllowed:         false,
+			expectedMaxWithdrawable: big.NewInt(1_000_000_000), // $1,000
+		},
+		"undercollateralized subaccount cannot withdraw": {
+			// NC of $9,000 and IMR of $10,000.
+			usdcQuantums: big.NewInt(-41_000_000_000), // -$41,000
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+			quoteQuantums:           big.NewInt(1), // $0.000001
+			expectedAllowed:         false,
+			expectedMaxWithdrawable: big.NewInt(0),
+		},
+		"subaccount without positions can withdraw its balance": {
+			usdcQuantums:            big.NewInt(1_000_000_000), // $1,000
+			quoteQuantums:           big.NewInt(1_000_000_000), // $1,000
+			expectedAllowed:         true,
+			expectedMaxWithdrawable: big.NewInt(1_000_000_000), // $1,000
+		},
+		"non-positive withdrawal": {
+			usdcQuantums:  big.NewInt(1_000_000_000), // $1,000
+			quoteQuantums: big.NewInt(0),
+			expectedErr:   types.ErrAssetTransferQuantumsNotPositive,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			keeper.SetSubaccount(ctx, types.Subaccount{
+				Id:                 &constants.Alice_Num0,
+				AssetPositions:     testutil.CreateUsdcAssetPositions(tc.usdcQuantums),
+				PerpetualPositions: tc.perpetualPositions,
+			})
+
+			allowed, maxWithdrawable, err := keeper.GetWithdrawableCheck(ctx, constants.Alice_Num0, tc.quoteQuantums)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedAllowed, allowed)
+			require.Zero(t, tc.expectedMaxWithdrawable.Cmp(maxWithdrawable))
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package lib
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// maxBasketShockPpm bounds the magnitude of the shocks searched by `GetBasketShockToLiquidate` in
+// directions where no price of the basket falls to zero. It is also the denominator of the price
+// factor applied for a shock, since a shock is in ppm and a weight is in ppm of the shock.
+const maxBasketShockPpm = int64(lib.OneMillion) * int64(lib.OneMillion)
+
+// GetBasketShockToLiquidate returns the smallest shock, in parts-per-million, to the prices of a basket
+// of correlated perpetuals that makes the subaccount liquidatable, along with the risk of the
+// subaccount under the shock. The input subaccount must be settled.
+//
+// A shock of `shockPpm` moves the oracle price of each perpetual in `weightsPpm` by
+// `shockPpm * weightPpm / 1,000,000` parts-per-million, holding the prices of all other perpetuals
+// fixed. A weight of 1,000,000 for every perpetual models perfect correlation within the basket, and a
+// negative weight models a perpetual that moves against the basket. Negative shocks move the prices of
+// perpetuals with positive weights down. Of the smallest liquidating shocks in each direction, the one
+// with the smaller magnitude is returned, preferring negative shocks.
+//
+// `found` is false if no shock in either direction makes the subaccount liquidatable. The solver relies
+// on the subaccount's net collateral minus its maintenance margin requirement being monotonic in the
+// shock, which holds for linear perpetuals since both are linear in the prices.
+func GetBasketShockToLiquidate(
+	subaccount types.Subaccount,
+	perpInfos perptypes.PerpInfos,
+	weightsPpm map[uint32]int32,
+) (
+	shockPpm int64,
+	risk margin.Risk,
+	found bool,
+	err error,
+) {
+	riskAt := func(shock int64) (margin.Risk, error) {
+		prices := make(map[uint32]uint64, len(weightsPpm))
+		for id, weightPpm := range weightsPpm {
+			perpInfo, ok := perpInfos[id]
+			if !ok {
+				continue
+			}
+			// price * (10^12 + shock * weight) / 10^12, clamped to the range of prices.
+			factor := new(big.Int).Mul(big.NewInt(shock), big.NewInt(int64(weightPpm)))
+			factor.Add(factor, big.NewInt(maxBasketShockPpm))
+			price := new(big.Int).Mul(lib.BigU(perpInfo.Price.Price), factor)
+			price.Quo(price, big.NewInt(maxBasketShockPpm))
+			prices[id] = lib.BigUint64Clamp(price, 0, math.MaxUint64)
+		}
+		return GetRiskForSubaccountAtPrices(subaccount, perpInfos, prices)
+	}
+	// Compare net collateral against the maintenance margin requirement directly, as in
+	// `GetLiquidationPrice`.
+	isLiquidatable := func(r margin.Risk) bool {
+		return r.MMR.Cmp(r.NC) > 0
+	}
+
+	risk, err = riskAt(0)
+	if err != nil {
+		return 0, margin.Risk{}, false, err
+	}
+	if isLiquidatable(risk) {
+		return 0, risk, true, nil
+	}
+
+	var minMagnitude uint64
+	for _, direction := range []int64{-1, 1} {
+		// Shocks beyond the point at which a price of the basket falls to zero are not searched.
+		maxMagnitude := uint64(maxBasketShockPpm)
+		for id, weightPpm := range weightsPpm {
+			if _, ok := perpInfos[id]; ok && direction*int64(weightPpm) < 0 {
+				maxMagnitude = lib.Min(maxMagnitude, uint64(maxBasketShockPpm)/lib.AbsInt64(int64(weightPpm)))
+			}
+		}
+
+		directionRisk, err := riskAt(direction * int64(maxMagnitude))
+		if err != nil {
+			return 0, margin.Risk{}, false, err
+		}
+		if !isLiquidatable(directionRisk) {
+			continue
+		}
+
+		// Binary search for the smallest liquidating magnitude, maintaining that the subaccount is not
+		// liquidatable at `safeMagnitude` and is liquidatable at `liquidatingMagnitude`.
+		safeMagnitude, liquidatingMagnitude := uint64(0), maxMagnitude
+		for liquidatingMagnitude-safeMagnitude > 1 {
+			mid := safeMagnitude + (liquidatingMagnitude-safeMagnitude)/2
+			midRisk, err := riskAt(direction * int64(mid))
+			if err != nil {
+				return 0, margin.Risk{}, false, err
+			}
+			if isLiquidatable(midRisk) {
+				liquidatingMagnitude, directionRisk = mid, midRisk
+			} else {
+				safeMagnitude = mid
+			}
+		}
+
+		if !found || liquidatingMagnitude < minMagnitude {
+			minMagnitude = liquidatingMagnitude
+			shockPpm, risk, found = direction*int64(liquidatingMagnitude), directionRisk, true
+		}
+	}
+	if !found {
+		return 0, margin.Risk{}, false, nil
+	}
+	return shockPpm, risk, true, nil
+}
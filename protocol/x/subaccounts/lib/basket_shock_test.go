@@ -0,0 +1,108 @@
+package lib_test
+
+import (
+	"math/big"
+	"testing"
+
+	perp_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBasketShockToLiquidate(t *testing.T) {
+	subaccountId := types.SubaccountId{Owner: "test", Number: 1}
+	// One quantum has a notional of `price` quote quantums, with an initial margin fraction of 10% and
+	// a maintenance margin fraction of 5%.
+	perpInfos := perptypes.PerpInfos{
+		1: perp_testutil.CreatePerpInfo(1, -6, 1_000_000, 0),
+		2: perp_testutil.CreatePerpInfo(2, -6, 2_000_000, 0),
+	}
+	correlated := map[uint32]int32{1: 1_000_000, 2: 1_000_000}
+	tests := map[string]struct {
+		perpetualPositions []*types.PerpetualPosition
+		quoteBalance       *big.Int
+		weightsPpm         map[uint32]int32
+
+		expectedShockPpm int64
+		expectedFound    bool
+	}{
+		"two longs": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(10), big.NewInt(0), big.NewInt(0)),
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(5), big.NewInt(0), big.NewInt(0)),
+			},
+			// NC = 20,000,000 * (1 + s) - 15,000,000 and MMR = 1,000,000 * (1 + s), liquidatable while
+			// s < -21.05%.
+			quoteBalance:     big.NewInt(-15_000_000),
+			weightsPpm:       correlated,
+			expectedShockPpm: -210_527,
+			expectedFound:    true,
+		},
+		"hedged long and short are liquidated by a rise in both prices": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(10), big.NewInt(0), big.NewInt(0)),
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(-5), big.NewInt(0), big.NewInt(0)),
+			},
+			// NC = 2,000,000 and MMR = 1,000,000 * (1 + s), liquidatable while s > 100%.
+			quoteBalance:     big.NewInt(2_000_000),
+			weightsPpm:       correlated,
+			expectedShockPpm: 1_000_001,
+			expectedFound:    true,
+		},
+		"anti-correlated long and short": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(10), big.NewInt(0), big.NewInt(0)),
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(-5), big.NewInt(0), big.NewInt(0)),
+			},
+			// NC = 20,000,000 * s + 2,000,000 and MMR = 1,000,000, liquidatable while s < -5%.
+			quoteBalance:     big.NewInt(2_000_000),
+			weightsPpm:       map[uint32]int32{1: 1_000_000, 2: -1_000_000},
+			expectedShockPpm: -50_001,
+			expectedFound:    true,
+		},
+		"already liquidatable": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(10), big.NewInt(0), big.NewInt(0)),
+			},
+			quoteBalance:     big.NewInt(-9_600_000),
+			weightsPpm:       correlated,
+			expectedShockPpm: 0,
+			expectedFound:    true,
+		},
+		"long with non-negative collateral is never liquidatable": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(10), big.NewInt(0), big.NewInt(0)),
+			},
+			quoteBalance:  big.NewInt(0),
+			weightsPpm:    correlated,
+			expectedFound: false,
+		},
+		"markets outside the basket are not moved": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(10), big.NewInt(0), big.NewInt(0)),
+			},
+			quoteBalance:  big.NewInt(-9_000_000),
+			weightsPpm:    map[uint32]int32{2: 1_000_000},
+			expectedFound: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			subaccount := types.Subaccount{
+				Id:                 &subaccountId,
+				PerpetualPositions: tc.perpetualPositions,
+				AssetPositions:     testutil.CreateUsdcAssetPositions(tc.quoteBalance),
+			}
+			shockPpm, risk, found, err := lib.GetBasketShockToLiquidate(subaccount, perpInfos, tc.weightsPpm)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedFound, found)
+			require.Equal(t, tc.expectedShockPpm, shockPpm)
+			if found {
+				require.True(t, risk.MMR.Cmp(risk.NC) > 0)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package lib
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// BuyingPower returns the largest additional notional in quote quantums that the subaccount can open in
+// the perpetual, after the updates in `settledUpdate` are applied. This is the free collateral of the
+// subaccount (its net collateral minus its initial margin requirement) divided by the initial margin
+// fraction of the perpetual, scaled by the current open interest of the perpetual, and rounded down.
+//
+// Changes in the net collateral and open interest from opening the position, and the reduction of an
+// existing position in the perpetual, are not taken into account. Returns zero if the subaccount has no
+// free collateral, and `math.MaxUint64` if the initial margin fraction of the perpetual is zero.
+func BuyingPower(
+	settledUpdate types.SettledUpdate,
+	perpInfos perptypes.PerpInfos,
+	perpetualId uint32,
+) (
+	buyingPower *big.Int,
+	err error,
+) {
+	perpInfo := perpInfos.MustGet(perpetualId)
+	risk, err := GetRiskForSubaccount(CalculateUpdatedSubaccount(settledUpdate, perpInfos), perpInfos)
+	if err != nil {
+		return nil, err
+	}
+	freeCollateral := new(big.Int).Sub(risk.NC, risk.IMR)
+	if freeCollateral.Sign() <= 0 {
+		return new(big.Int), nil
+	}
+
+	openInterest, err := PositionNotional(perpInfo.Perpetual.OpenInterest.BigInt(), perpInfo)
+	if err != nil {
+		return nil, err
+	}
+	initialMarginPpm := perpInfo.LiquidityTier.GetAdjustedInitialMarginPpm(openInterest)
+	if initialMarginPpm.Sign() == 0 {
+		return new(big.Int).SetUint64(math.MaxUint64), nil
+	}
+
+	buyingPower = freeCollateral.Mul(freeCollateral, lib.BigIntOneMillion())
+	return buyingPower.Quo(buyingPower, initialMarginPpm), nil
+}
@@ -0,0 +1,109 @@
+package lib_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	perp_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuyingPower(t *testing.T) {
+	// One quantum has a notional of 100 quote quantums in perpetual 1 and 200 quote quantums in
+	// perpetual 2, both with an initial margin fraction of 10%.
+	perpInfo := perp_testutil.CreatePerpInfo(1, -6, 100, 0)
+	otherPerpInfo := perp_testutil.CreatePerpInfo(2, -6, 200, 0)
+	// Scale the initial margin fraction linearly from 10% to 100% as open interest grows from 0 to
+	// 200,000 quote quantums, with an open interest of 100,000 quote quantums.
+	oiScaledPerpInfo := perp_testutil.CreatePerpInfo(1, -6, 100, 0)
+	oiScaledPerpInfo.LiquidityTier.OpenInterestUpperCap = 200_000
+	oiScaledPerpInfo.Perpetual.OpenInterest = dtypes.NewInt(1_000)
+	noMarginPerpInfo := perp_testutil.CreatePerpInfo(1, -6, 100, 0)
+	noMarginPerpInfo.LiquidityTier.InitialMarginPpm = 0
+
+	tests := map[string]struct {
+		perpInfo           perptypes.PerpInfo
+		perpetualPositions []*types.PerpetualPosition
+		usdcBalance        int64
+		assetUpdates       []types.AssetUpdate
+
+		expectedBuyingPower *big.Int
+	}{
+		"flat account": {
+			perpInfo:            perpInfo,
+			usdcBalance:         10_000,
+			expectedBuyingPower: big.NewInt(100_000),
+		},
+		"flat account with open interest scaled initial margin": {
+			// The initial margin fraction is 55%.
+			perpInfo:            oiScaledPerpInfo,
+			usdcBalance:         10_000,
+			expectedBuyingPower: big.NewInt(18_181),
+		},
+		"flat account with no initial margin": {
+			perpInfo:            noMarginPerpInfo,
+			usdcBalance:         10_000,
+			expectedBuyingPower: new(big.Int).SetUint64(math.MaxUint64),
+		},
+		"flat account after a pending withdrawal": {
+			perpInfo:    perpInfo,
+			usdcBalance: 10_000,
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: assettypes.AssetUsdc.Id, BigQuantumsDelta: big.NewInt(-5_000)},
+			},
+			expectedBuyingPower: big.NewInt(50_000),
+		},
+		"existing position in the market": {
+			perpInfo: perpInfo,
+			// NC = 15,000, IMR = 500.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(50), big.NewInt(0), big.NewInt(0)),
+			},
+			usdcBalance:         10_000,
+			expectedBuyingPower: big.NewInt(145_000),
+		},
+		"existing position in another market reduces free collateral": {
+			perpInfo: perpInfo,
+			// NC = 10,000, IMR = 4,000.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(200), big.NewInt(0), big.NewInt(-40_000)),
+			},
+			usdcBalance:         10_000,
+			expectedBuyingPower: big.NewInt(60_000),
+		},
+		"no free collateral": {
+			perpInfo: perpInfo,
+			// NC = 3,000, IMR = 4,000.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(200), big.NewInt(0), big.NewInt(-47_000)),
+			},
+			usdcBalance:         10_000,
+			expectedBuyingPower: big.NewInt(0),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			perpInfos := perptypes.PerpInfos{1: tc.perpInfo, 2: otherPerpInfo}
+			settledUpdate := types.SettledUpdate{
+				SettledSubaccount: types.Subaccount{
+					Id:                 &types.SubaccountId{Owner: "test", Number: 1},
+					PerpetualPositions: tc.perpetualPositions,
+					AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(tc.usdcBalance)),
+				},
+				AssetUpdates: tc.assetUpdates,
+			}
+			buyingPower, err := lib.BuyingPower(settledUpdate, perpInfos, 1)
+			require.NoError(t, err)
+			require.Equal(t, 0, tc.expectedBuyingPower.Cmp(buyingPower), "expected %s, got %s",
+				tc.expectedBuyingPower, buyingPower)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package lib
+
+import (
+	"math/big"
+
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// WouldCloseRealizeUncoveredLoss returns whether fully closing the subaccount's position in the
+// perpetual, after the updates in `settledUpdate` are applied, would leave the subaccount with negative
+// net collateral, and if so the loss that is not covered by the subaccount's collateral. The position
+// is closed at the market price of the perpetual, which is used as its mark price, and the notional
+// and quote balance of the position are realized in USDC.
+//
+// Returns false and an uncovered loss of zero if the subaccount has no position in the perpetual.
+func WouldCloseRealizeUncoveredLoss(
+	settledUpdate types.SettledUpdate,
+	perpInfos perptypes.PerpInfos,
+	perpetualId uint32,
+) (
+	uncovered bool,
+	uncoveredLoss *big.Int,
+	err error,
+) {
+	subaccount := CalculateUpdatedSubaccount(settledUpdate, perpInfos)
+
+	var position *types.PerpetualPosition
+	for _, pos := range subaccount.PerpetualPositions {
+		if pos.PerpetualId == perpetualId {
+			position = pos
+		}
+	}
+	if position == nil {
+		return false, new(big.Int), nil
+	}
+
+	// The net collateral of the position in isolation is its value when closed at the market price.
+	positionRisk, err := PositionRisk(position, perpInfos.MustGet(perpetualId))
+	if err != nil {
+		return false, nil, err
+	}
+	quantums := position.GetBigQuantums()
+	quoteBalance := position.GetQuoteBalance()
+	notional := new(big.Int).Sub(positionRisk.NC, quoteBalance)
+
+	closeUpdate := types.SettledUpdate{
+		SettledSubaccount: subaccount,
+		PerpetualUpdates: []types.PerpetualUpdate{
+			{
+				PerpetualId:          perpetualId,
+				BigQuantumsDelta:     quantums.Neg(quantums),
+				BigQuoteBalanceDelta: new(big.Int).Neg(quoteBalance),
+				BigFillQuoteQuantums: notional.Abs(notional),
+			},
+		},
+		AssetUpdates: []types.AssetUpdate{
+			{
+				AssetId:          assettypes.AssetUsdc.Id,
+				BigQuantumsDelta: positionRisk.NC,
+			},
+		},
+	}
+	risk, err := GetRiskForSubaccount(CalculateUpdatedSubaccount(closeUpdate, perpInfos), perpInfos)
+	if err != nil {
+		return false, nil, err
+	}
+
+	if risk.NC.Sign() >= 0 {
+		return false, new(big.Int), nil
+	}
+	return true, risk.NC.Neg(risk.NC), nil
+}
@@ -0,0 +1,97 @@
+package lib_test
+
+import (
+	"math/big"
+	"testing"
+
+	perp_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWouldCloseRealizeUncoveredLoss(t *testing.T) {
+	// One quantum has a notional of 100 quote quantums in perpetual 1 and 200 quote quantums in
+	// perpetual 2.
+	perpInfos := perptypes.PerpInfos{
+		1: perp_testutil.CreatePerpInfo(1, -6, 100, 0),
+		2: perp_testutil.CreatePerpInfo(2, -6, 200, 0),
+	}
+	tests := map[string]struct {
+		perpetualPositions []*types.PerpetualPosition
+		usdcBalance        int64
+		assetUpdates       []types.AssetUpdate
+
+		expectedUncovered     bool
+		expectedUncoveredLoss *big.Int
+	}{
+		"no position": {
+			usdcBalance:           1_000,
+			expectedUncovered:     false,
+			expectedUncoveredLoss: big.NewInt(0),
+		},
+		"profitable long": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			},
+			usdcBalance:           -5_000,
+			expectedUncovered:     false,
+			expectedUncoveredLoss: big.NewInt(0),
+		},
+		"deeply underwater long": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			},
+			usdcBalance:           -15_000,
+			expectedUncovered:     true,
+			expectedUncoveredLoss: big.NewInt(5_000),
+		},
+		"underwater short with a quote balance": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(-100), big.NewInt(0), big.NewInt(8_000)),
+			},
+			expectedUncovered:     true,
+			expectedUncoveredLoss: big.NewInt(2_000),
+		},
+		"underwater long covered by another position": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(50), big.NewInt(0), big.NewInt(0)),
+			},
+			usdcBalance:           -15_000,
+			expectedUncovered:     false,
+			expectedUncoveredLoss: big.NewInt(0),
+		},
+		"underwater long after a pending withdrawal": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			},
+			usdcBalance: -9_000,
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: assettypes.AssetUsdc.Id, BigQuantumsDelta: big.NewInt(-1_500)},
+			},
+			expectedUncovered:     true,
+			expectedUncoveredLoss: big.NewInt(500),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			settledUpdate := types.SettledUpdate{
+				SettledSubaccount: types.Subaccount{
+					Id:                 &types.SubaccountId{Owner: "test", Number: 1},
+					PerpetualPositions: tc.perpetualPositions,
+					AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(tc.usdcBalance)),
+				},
+				AssetUpdates: tc.assetUpdates,
+			}
+			uncovered, uncoveredLoss, err := lib.WouldCloseRealizeUncoveredLoss(settledUpdate, perpInfos, 1)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedUncovered, uncovered)
+			require.Equal(t, 0, tc.expectedUncoveredLoss.Cmp(uncoveredLoss))
+		})
+	}
+}
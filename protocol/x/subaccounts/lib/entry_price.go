@@ -0,0 +1,38 @@
+package lib
+
+import (
+	"math/big"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// AverageEntryPrice returns the average entry price of the position in quote quantums per base
+// quantum, which is the average of the prices of the fills that opened the position weighted by
+// their notional. The price is always positive, for both longs and shorts.
+//
+// The average entry price is derived from the cost basis of the position, which is updated on each
+// fill by `GetUpdatedCostBasis`. Returns false if the position is closed or its cost basis is unknown.
+func AverageEntryPrice(position *types.PerpetualPosition) (price *big.Rat, ok bool) {
+	quantums := position.GetBigQuantums()
+	costBasis := position.GetBigCostBasis()
+	if quantums.Sign() == 0 || costBasis.Sign() == 0 {
+		return nil, false
+	}
+	return new(big.Rat).SetFrac(costBasis, quantums), true
+}
+
+// BreakEvenPrice returns the price in quote quantums per base quantum at which closing the position
+// realizes zero PnL net of `fundingPaid`, the funding in quote quantums paid by the position that is not
+// reflected in its cost basis. Funding received is negative. The price is above the average entry price
+// of a long that paid funding and of a short that received funding, and may be non-positive if a short
+// received more funding than its cost basis.
+//
+// Returns false if the position is closed or its cost basis is unknown.
+func BreakEvenPrice(position *types.PerpetualPosition, fundingPaid *big.Int) (price *big.Rat, ok bool) {
+	quantums := position.GetBigQuantums()
+	costBasis := position.GetBigCostBasis()
+	if quantums.Sign() == 0 || costBasis.Sign() == 0 {
+		return nil, false
+	}
+	return new(big.Rat).SetFrac(new(big.Int).Add(costBasis, fundingPaid), quantums), true
+}
@@ -0,0 +1,107 @@
+package lib_test
+
+import (
+	"math/big"
+	"testing"
+
+	perp_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAverageEntryPrice(t *testing.T) {
+	perpInfos := perptypes.PerpInfos{1: perp_testutil.CreatePerpInfo(1, -6, 100, 0)}
+	tests := map[string]struct {
+		position *types.PerpetualPosition
+		fills    []types.PerpetualUpdate
+
+		expectedPrice *big.Rat
+		expectedOk    bool
+	}{
+		"long from two fills at different prices": {
+			fills: []types.PerpetualUpdate{
+				{
+					PerpetualId:          1,
+					BigQuantumsDelta:     big.NewInt(100),
+					BigFillQuoteQuantums: big.NewInt(5_000),
+				},
+				{
+					PerpetualId:          1,
+					BigQuantumsDelta:     big.NewInt(300),
+					BigFillQuoteQuantums: big.NewInt(21_000),
+				},
+			},
+			// (100 * 50 + 300 * 70) / 400.
+			expectedPrice: big.NewRat(65, 1),
+			expectedOk:    true,
+		},
+		"short from two fills at different prices": {
+			fills: []types.PerpetualUpdate{
+				{
+					PerpetualId:          1,
+					BigQuantumsDelta:     big.NewInt(-200),
+					BigFillQuoteQuantums: big.NewInt(20_000),
+				},
+				{
+					PerpetualId:          1,
+					BigQuantumsDelta:     big.NewInt(-100),
+					BigFillQuoteQuantums: big.NewInt(11_000),
+				},
+			},
+			// (200 * 100 + 100 * 110) / 300.
+			expectedPrice: big.NewRat(310, 3),
+			expectedOk:    true,
+		},
+		"partially closed long keeps its average entry price": {
+			fills: []types.PerpetualUpdate{
+				{
+					PerpetualId:          1,
+					BigQuantumsDelta:     big.NewInt(100),
+					BigFillQuoteQuantums: big.NewInt(5_000),
+				},
+				{
+					PerpetualId:          1,
+					BigQuantumsDelta:     big.NewInt(100),
+					BigFillQuoteQuantums: big.NewInt(7_000),
+				},
+				{
+					PerpetualId:          1,
+					BigQuantumsDelta:     big.NewInt(-50),
+					BigFillQuoteQuantums: big.NewInt(10_000),
+				},
+			},
+			expectedPrice: big.NewRat(60, 1),
+			expectedOk:    true,
+		},
+		"unknown cost basis": {
+			position: testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+		},
+		"no position": {},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			position := tc.position
+			if len(tc.fills) > 0 {
+				positions := []*types.PerpetualPosition{}
+				for _, fill := range tc.fills {
+					positions = lib.CalculateUpdatedPerpetualPositions(
+						positions,
+						[]types.PerpetualUpdate{fill},
+						perpInfos,
+					)
+				}
+				require.Len(t, positions, 1)
+				position = positions[0]
+			}
+
+			price, ok := lib.AverageEntryPrice(position)
+			require.Equal(t, tc.expectedOk, ok)
+			if tc.expectedOk {
+				require.Zero(t, tc.expectedPrice.Cmp(price), "expected %s, got %s", tc.expectedPrice, price)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package lib
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// ProjectedFunding returns the net funding in quote quantums that the subaccount would receive over the
+// next funding period at `projectedFundingRates`, after the updates in `settledUpdate` are applied. A
+// negative value is funding paid by the subaccount.
+//
+// Each rate is in parts-per-million of the position's notional for the whole funding period, with
+// longs paying shorts when the rate is positive. Positions in perpetuals without a projected rate
+// accrue no funding. Like settlement, the funding of all positions is summed in parts-per-million
+// before rounding down to whole quote quantums, and is valued at the current oracle prices.
+func ProjectedFunding(
+	settledUpdate types.SettledUpdate,
+	perpInfos perptypes.PerpInfos,
+	projectedFundingRates map[uint32]*big.Int,
+) (
+	bigNetFundingQuoteQuantums *big.Int,
+	err error,
+) {
+	subaccount := CalculateUpdatedSubaccount(settledUpdate, perpInfos)
+
+	totalNetFundingPpm := big.NewInt(0)
+	for _, position := range subaccount.PerpetualPositions {
+		fundingRatePpm, ok := projectedFundingRates[position.PerpetualId]
+		if !ok || fundingRatePpm.Sign() == 0 {
+			continue
+		}
+		perpInfo, ok := perpInfos[position.PerpetualId]
+		if !ok {
+			return nil, errorsmod.Wrapf(
+				perptypes.ErrPerpetualInfoDoesNotExist,
+				"perpetualId: %d",
+				position.PerpetualId,
+			)
+		}
+
+		// The funding index would increase by the rate times the quote quantums per base quantum, and a
+		// rise in the index is paid by longs.
+		indexDelta := lib.BaseToQuoteQuantums(
+			fundingRatePpm,
+			perpInfo.Perpetual.Params.AtomicResolution,
+			perpInfo.Price.Price,
+			perpInfo.Price.Exponent,
+		)
+		fundingPpm := indexDelta.Mul(indexDelta, position.GetBigQuantums())
+		totalNetFundingPpm.Sub(totalNetFundingPpm, fundingPpm)
+	}
+
+	return totalNetFundingPpm.Div(totalNetFundingPpm, lib.BigIntOneMillion()), nil
+}
+
+// GetProjectedNcAfterFunding returns the net collateral in quote quantums of the subaccount after the
+// updates in `settledUpdate` are applied and the funding of the next funding period at
+// `projectedFundingRates` is settled. See `ProjectedFunding` for how the funding is projected.
+func GetProjectedNcAfterFunding(
+	settledUpdate types.SettledUpdate,
+	perpInfos perptypes.PerpInfos,
+	projectedFundingRates map[uint32]*big.Int,
+) (
+	bigNetCollateral *big.Int,
+	err error,
+) {
+	funding, err := ProjectedFunding(settledUpdate, perpInfos, projectedFundingRates)
+	if err != nil {
+		return nil, err
+	}
+	risk, err := GetRiskForSubaccount(CalculateUpdatedSubaccount(settledUpdate, perpInfos), perpInfos)
+	if err != nil {
+		return nil, err
+	}
+	return risk.NC.Add(risk.NC, funding), nil
+}
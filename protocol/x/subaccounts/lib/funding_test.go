@@ -0,0 +1,171 @@
+package lib_test
+
+import (
+	"math/big"
+	"testing"
+
+	perp_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectedFunding(t *testing.T) {
+	subaccountId := types.SubaccountId{Owner: "test", Number: 1}
+	// One quantum of perpetual 1 has a notional of 100 quote quantums, and one of perpetual 2 has a
+	// notional of 200 quote quantums.
+	perpInfos := perptypes.PerpInfos{
+		1: perp_testutil.CreatePerpInfo(1, -6, 100, 0),
+		2: perp_testutil.CreatePerpInfo(2, -6, 200, 0),
+	}
+	tests := map[string]struct {
+		perpetualPositions    []*types.PerpetualPosition
+		perpetualUpdates      []types.PerpetualUpdate
+		projectedFundingRates map[uint32]*big.Int
+
+		expectedFunding *big.Int
+		expectedErr     error
+	}{
+		"long pays a positive rate": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(1_000), big.NewInt(0), big.NewInt(0)),
+			},
+			// 0.01% of a notional of 100,000.
+			projectedFundingRates: map[uint32]*big.Int{1: big.NewInt(100)},
+			expectedFunding:       big.NewInt(-10),
+		},
+		"short receives a positive rate": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(-1_000), big.NewInt(0), big.NewInt(0)),
+			},
+			projectedFundingRates: map[uint32]*big.Int{1: big.NewInt(100)},
+			expectedFunding:       big.NewInt(10),
+		},
+		"long receives a negative rate": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(1_000), big.NewInt(0), big.NewInt(0)),
+			},
+			projectedFundingRates: map[uint32]*big.Int{1: big.NewInt(-100)},
+			expectedFunding:       big.NewInt(10),
+		},
+		"hedged account nets funding across positions": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(1_000), big.NewInt(0), big.NewInt(0)),
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(-500), big.NewInt(0), big.NewInt(0)),
+			},
+			// The long pays 10 and the short receives 5 on equal notionals of 100,000.
+			projectedFundingRates: map[uint32]*big.Int{1: big.NewInt(100), 2: big.NewInt(50)},
+			expectedFunding:       big.NewInt(-5),
+		},
+		"updates are applied before projecting": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(500), big.NewInt(0), big.NewInt(0)),
+			},
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 1, BigQuantumsDelta: big.NewInt(500)},
+			},
+			projectedFundingRates: map[uint32]*big.Int{1: big.NewInt(100)},
+			expectedFunding:       big.NewInt(-10),
+		},
+		"funding is rounded down": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(1), big.NewInt(0), big.NewInt(0)),
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(-1), big.NewInt(0), big.NewInt(0)),
+			},
+			// -100 ppm + 200 ppm of a quote quantum.
+			projectedFundingRates: map[uint32]*big.Int{1: big.NewInt(1), 2: big.NewInt(-1)},
+			expectedFunding:       big.NewInt(-1),
+		},
+		"positions without a projected rate accrue no funding": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(1_000), big.NewInt(0), big.NewInt(0)),
+			},
+			projectedFundingRates: map[uint32]*big.Int{2: big.NewInt(100)},
+			expectedFunding:       big.NewInt(0),
+		},
+		"missing perpetual info": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(3, big.NewInt(1_000), big.NewInt(0), big.NewInt(0)),
+			},
+			projectedFundingRates: map[uint32]*big.Int{3: big.NewInt(100)},
+			expectedErr:           perptypes.ErrPerpetualInfoDoesNotExist,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			settledUpdate := types.SettledUpdate{
+				SettledSubaccount: types.Subaccount{
+					Id:                 &subaccountId,
+					PerpetualPositions: tc.perpetualPositions,
+				},
+				PerpetualUpdates: tc.perpetualUpdates,
+			}
+
+			funding, err := lib.ProjectedFunding(settledUpdate, perpInfos, tc.projectedFundingRates)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 0, tc.expectedFunding.Cmp(funding), "expected %v, got %v", tc.expectedFunding, funding)
+		})
+	}
+}
+
+func TestGetProjectedNcAfterFunding(t *testing.T) {
+	subaccountId := types.SubaccountId{Owner: "test", Number: 1}
+	// One quantum of perpetual 1 has a notional of 100 quote quantums.
+	perpInfos := perptypes.PerpInfos{1: perp_testutil.CreatePerpInfo(1, -6, 100, 0)}
+	tests := map[string]struct {
+		usdcQuantums          *big.Int
+		perpetualPositions    []*types.PerpetualPosition
+		projectedFundingRates map[uint32]*big.Int
+
+		expectedNc *big.Int
+	}{
+		"long paying funding loses net collateral": {
+			// A long with a net collateral of 100,000 - 60,000 pays 0.01% of a notional of 100,000.
+			usdcQuantums: big.NewInt(-60_000),
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(1_000), big.NewInt(0), big.NewInt(0)),
+			},
+			projectedFundingRates: map[uint32]*big.Int{1: big.NewInt(100)},
+			expectedNc:            big.NewInt(40_000 - 10),
+		},
+		"short receiving funding gains net collateral": {
+			// A short with a net collateral of -100,000 + 140,000 receives 0.01% of a notional of 100,000.
+			usdcQuantums: big.NewInt(140_000),
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(-1_000), big.NewInt(0), big.NewInt(0)),
+			},
+			projectedFundingRates: map[uint32]*big.Int{1: big.NewInt(100)},
+			expectedNc:            big.NewInt(40_000 + 10),
+		},
+		"no projected rates": {
+			usdcQuantums: big.NewInt(-60_000),
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(1_000), big.NewInt(0), big.NewInt(0)),
+			},
+			expectedNc: big.NewInt(40_000),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			settledUpdate := types.SettledUpdate{
+				SettledSubaccount: types.Subaccount{
+					Id:                 &subaccountId,
+					AssetPositions:     testutil.CreateUsdcAssetPositions(tc.usdcQuantums),
+					PerpetualPositions: tc.perpetualPositions,
+				},
+			}
+
+			nc, err := lib.GetProjectedNcAfterFunding(settledUpdate, perpInfos, tc.projectedFundingRates)
+			require.NoError(t, err)
+			require.Equal(t, 0, tc.expectedNc.Cmp(nc), "expected %v, got %v", tc.expectedNc, nc)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package lib
+
+import (
+	"math"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetRiskForSubaccountUnderHistoricalShocks returns the risk of the subaccount with the oracle price of
+// each perpetual in `worstMovesPpm` moved against the subaccount's position by the magnitude, in
+// parts-per-million, of the worst single-day move observed historically in the perpetual's market. The
+// prices of longs are moved down, flooring at zero, and the prices of shorts are moved up, with the move
+// rounded away from the current price. The prices of all other perpetuals, and of perpetuals the
+// subaccount has no position in, are unchanged. The input subaccount must be settled.
+func GetRiskForSubaccountUnderHistoricalShocks(
+	subaccount types.Subaccount,
+	perpInfos perptypes.PerpInfos,
+	worstMovesPpm map[uint32]uint32,
+) (
+	risk margin.Risk,
+	err error,
+) {
+	prices := make(map[uint32]uint64, len(worstMovesPpm))
+	for _, position := range subaccount.PerpetualPositions {
+		movePpm, ok := worstMovesPpm[position.PerpetualId]
+		if !ok || position.GetBigQuantums().Sign() == 0 {
+			continue
+		}
+		perpInfo, ok := perpInfos[position.PerpetualId]
+		if !ok {
+			continue
+		}
+
+		price := lib.BigU(perpInfo.Price.Price)
+		move := lib.BigMulPpm(price, lib.BigU(movePpm), true)
+		if position.GetIsLong() {
+			price.Sub(price, move)
+		} else {
+			price.Add(price, move)
+		}
+		prices[position.PerpetualId] = lib.BigUint64Clamp(price, 0, math.MaxUint64)
+	}
+	return GetRiskForSubaccountAtPrices(subaccount, perpInfos, prices)
+}
@@ -0,0 +1,83 @@
+package lib_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+	perp_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRiskForSubaccountUnderHistoricalShocks(t *testing.T) {
+	// One quantum has a notional of `price` quote quantums, with an initial margin fraction of 10% and
+	// a maintenance margin fraction of 5%.
+	perpInfos := perptypes.PerpInfos{
+		1: perp_testutil.CreatePerpInfo(1, -6, 1_000_000, 0),
+		2: perp_testutil.CreatePerpInfo(2, -6, 2_000_000, 0),
+		3: perp_testutil.CreatePerpInfo(3, -6, 3_000_000, 0),
+	}
+	subaccountId := types.SubaccountId{Owner: "test", Number: 1}
+	subaccount := types.Subaccount{
+		Id:             &subaccountId,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(5_000_000)),
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(1, big.NewInt(10), big.NewInt(0), big.NewInt(0)),
+			testutil.CreateSinglePerpetualPosition(2, big.NewInt(-5), big.NewInt(0), big.NewInt(0)),
+		},
+	}
+
+	tests := map[string]struct {
+		worstMovesPpm map[uint32]uint32
+
+		expectedRisk margin.Risk
+	}{
+		"no moves": {
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(5_000_000),
+				IMR: big.NewInt(2_000_000),
+				MMR: big.NewInt(1_000_000),
+			},
+		},
+		"moves against the long and the short": {
+			// The long's price falls by 10% to 900,000 and the short's price rises by 50% to 3,000,000.
+			worstMovesPpm: map[uint32]uint32{1: 100_000, 2: 500_000},
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(-1_000_000),
+				IMR: big.NewInt(2_400_000),
+				MMR: big.NewInt(1_200_000),
+			},
+		},
+		"moves beyond 100% floor the long's price at zero": {
+			worstMovesPpm: map[uint32]uint32{1: 1_500_000},
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(-5_000_000),
+				IMR: big.NewInt(1_000_000),
+				MMR: big.NewInt(500_000),
+			},
+		},
+		"moves in perpetuals without a position are ignored": {
+			worstMovesPpm: map[uint32]uint32{3: 500_000, 4: 500_000},
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(5_000_000),
+				IMR: big.NewInt(2_000_000),
+				MMR: big.NewInt(1_000_000),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			risk, err := lib.GetRiskForSubaccountUnderHistoricalShocks(subaccount, perpInfos, tc.worstMovesPpm)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedRisk, risk)
+			// The prices are not modified.
+			require.Equal(t, uint64(1_000_000), perpInfos[1].Price.Price)
+			require.Equal(t, uint64(2_000_000), perpInfos[2].Price.Price)
+		})
+	}
+}
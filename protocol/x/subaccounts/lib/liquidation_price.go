@@ -0,0 +1,127 @@
+package lib
+
+import (
+	"math"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetLiquidationPrice returns the oracle price of the perpetual at which the subaccount becomes
+// liquidatable, assuming all other prices stay constant. The input subaccount must be settled.
+//
+// For a long position this is the highest price at which the subaccount is liquidatable, and for a
+// short position it is the lowest. `found` is false if the subaccount has no position in the
+// perpetual or if no price of the perpetual makes the subaccount liquidatable.
+//
+// The solver binary searches over all prices for the price at which the subaccount's net collateral
+// falls below its maintenance margin requirement. This relies on the subaccount's net collateral
+// minus its maintenance margin requirement being monotonic in the price of the perpetual, which holds
+// since maintenance margin fractions are less than one.
+func GetLiquidationPrice(
+	subaccount types.Subaccount,
+	perpInfos perptypes.PerpInfos,
+	perpetualId uint32,
+) (
+	price uint64,
+	found bool,
+	err error,
+) {
+	// Compare net collateral against the maintenance margin requirement directly rather than using
+	// `IsLiquidatable`, which also requires a non-zero maintenance margin requirement and so is not
+	// monotonic at a price of zero.
+	return getThresholdPrice(subaccount, perpInfos, perpetualId, func(risk margin.Risk) bool {
+		return risk.MMR.Cmp(risk.NC) > 0
+	})
+}
+
+// GetBankruptcyPrice returns the oracle price of the perpetual at which the subaccount's net collateral
+// becomes negative, assuming all other prices stay constant. The input subaccount must be settled.
+//
+// For a long position this is the highest price at which the subaccount is bankrupt, and for a short
+// position it is the lowest. `found` is false if the subaccount has no position in the perpetual or if
+// no price of the perpetual makes the subaccount bankrupt.
+func GetBankruptcyPrice(
+	subaccount types.Subaccount,
+	perpInfos perptypes.PerpInfos,
+	perpetualId uint32,
+) (
+	price uint64,
+	found bool,
+	err error,
+) {
+	return getThresholdPrice(subaccount, perpInfos, perpetualId, func(risk margin.Risk) bool {
+		return risk.NC.Sign() < 0
+	})
+}
+
+// getThresholdPrice binary searches over all prices of the perpetual for the price at which `isBreached`
+// starts to hold for the risk of the subaccount. For a long position this is the highest price at which
+// the subaccount is breached, and for a short position it is the lowest. This relies on `isBreached`
+// holding at all prices below the threshold for longs, and at all prices above it for shorts.
+func getThresholdPrice(
+	subaccount types.Subaccount,
+	perpInfos perptypes.PerpInfos,
+	perpetualId uint32,
+	isBreached func(risk margin.Risk) bool,
+) (
+	price uint64,
+	found bool,
+	err error,
+) {
+	var position *types.PerpetualPosition
+	for _, pos := range subaccount.PerpetualPositions {
+		if pos.PerpetualId == perpetualId {
+			position = pos
+			break
+		}
+	}
+	if position == nil || position.GetBigQuantums().Sign() == 0 {
+		return 0, false, nil
+	}
+	isLong := position.GetIsLong()
+
+	isBreachedAt := func(p uint64) (bool, error) {
+		risk, err := GetRiskForSubaccountAtPrices(subaccount, perpInfos, map[uint32]uint64{perpetualId: p})
+		if err != nil {
+			return false, err
+		}
+		return isBreached(risk), nil
+	}
+
+	// Longs are breached at all prices below the threshold price and shorts at all prices above it.
+	// Binary search between the lowest and highest price, maintaining that the subaccount is breached
+	// at `breachedPrice` and not breached at `safePrice`.
+	breachedPrice, safePrice := uint64(0), uint64(math.MaxUint64)
+	if !isLong {
+		breachedPrice, safePrice = safePrice, breachedPrice
+	}
+	for _, p := range []uint64{breachedPrice, safePrice} {
+		breached, err := isBreachedAt(p)
+		if err != nil {
+			return 0, false, err
+		}
+		if p == breachedPrice && !breached {
+			return 0, false, nil
+		}
+		if p == safePrice && breached {
+			return p, true, nil
+		}
+	}
+
+	for lib.AbsDiffUint64(breachedPrice, safePrice) > 1 {
+		mid := breachedPrice/2 + safePrice/2 + (breachedPrice%2+safePrice%2)/2
+		breached, err := isBreachedAt(mid)
+		if err != nil {
+			return 0, false, err
+		}
+		if breached {
+			breachedPrice = mid
+		} else {
+			safePrice = mid
+		}
+	}
+	return breachedPrice, true, nil
+}
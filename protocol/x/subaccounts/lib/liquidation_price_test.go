@@ -0,0 +1,193 @@
+package lib_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	perp_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLiquidationPrice(t *testing.T) {
+	subaccountId := types.SubaccountId{Owner: "test", Number: 1}
+	// One quantum has a notional of `price` quote quantums, with an initial margin fraction of 10% and
+	// a maintenance margin fraction of 5%.
+	perpInfos := perptypes.PerpInfos{
+		1: perp_testutil.CreatePerpInfo(1, -6, 100, 0),
+		2: perp_testutil.CreatePerpInfo(2, -6, 200, 0),
+	}
+	tests := map[string]struct {
+		perpetualPositions []*types.PerpetualPosition
+		quoteBalance       *big.Int
+
+		expectedPrice uint64
+		expectedFound bool
+	}{
+		"no position": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			},
+			quoteBalance:  big.NewInt(0),
+			expectedFound: false,
+		},
+		"long": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			},
+			// NC = 100 * p - 9,000 and MMR = 5 * p, liquidatable while p < 94.74.
+			quoteBalance:  big.NewInt(-9_000),
+			expectedPrice: 94,
+			expectedFound: true,
+		},
+		"long that is already liquidatable": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			},
+			// NC = 100 * p - 9,900 and MMR = 5 * p, liquidatable while p < 104.21.
+			quoteBalance:  big.NewInt(-9_900),
+			expectedPrice: 104,
+			expectedFound: true,
+		},
+		"long with non-negative collateral at a price of zero is never liquidatable": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			},
+			quoteBalance:  big.NewInt(0),
+			expectedFound: false,
+		},
+		"short": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(-100), big.NewInt(0), big.NewInt(0)),
+			},
+			// NC = 11,000 - 100 * p and MMR = 5 * p, liquidatable while p > 104.76.
+			quoteBalance:  big.NewInt(11_000),
+			expectedPrice: 105,
+			expectedFound: true,
+		},
+		"short with another position": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(-100), big.NewInt(0), big.NewInt(0)),
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(10), big.NewInt(0), big.NewInt(0)),
+			},
+			// NC = 9,000 + 2,000 - 100 * p and MMR = 5 * p + 100, liquidatable while p > 103.81.
+			quoteBalance:  big.NewInt(9_000),
+			expectedPrice: 104,
+			expectedFound: true,
+		},
+		"long with another position": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(-100), big.NewInt(0), big.NewInt(0)),
+			},
+			// NC = 100 * p - 20,000 and MMR = 5 * p + 1,000, liquidatable while p < 221.05.
+			quoteBalance:  big.NewInt(0),
+			expectedPrice: 221,
+			expectedFound: true,
+		},
+		"short with negative collateral at a price of zero is liquidatable at all prices": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(-100), big.NewInt(0), big.NewInt(0)),
+			},
+			quoteBalance:  big.NewInt(-1),
+			expectedPrice: 0,
+			expectedFound: true,
+		},
+		"long with negative collateral at all prices is liquidatable at all prices": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(1), big.NewInt(0), big.NewInt(0)),
+			},
+			quoteBalance:  new(big.Int).Neg(new(big.Int).SetUint64(math.MaxUint64)),
+			expectedPrice: math.MaxUint64,
+			expectedFound: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			subaccount := types.Subaccount{
+				Id:                 &subaccountId,
+				PerpetualPositions: tc.perpetualPositions,
+				AssetPositions:     testutil.CreateUsdcAssetPositions(tc.quoteBalance),
+			}
+			price, found, err := lib.GetLiquidationPrice(subaccount, perpInfos, 1)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedFound, found)
+			require.Equal(t, tc.expectedPrice, price)
+		})
+	}
+}
+
+func TestGetBankruptcyPrice(t *testing.T) {
+	subaccountId := types.SubaccountId{Owner: "test", Number: 1}
+	perpInfos := perptypes.PerpInfos{
+		1: perp_testutil.CreatePerpInfo(1, -6, 100, 0),
+		2: perp_testutil.CreatePerpInfo(2, -6, 200, 0),
+	}
+	tests := map[string]struct {
+		perpetualPositions []*types.PerpetualPosition
+		quoteBalance       *big.Int
+
+		expectedPrice uint64
+		expectedFound bool
+	}{
+		"no position": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			},
+			quoteBalance:  big.NewInt(-1_000),
+			expectedFound: false,
+		},
+		"long": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			},
+			// NC = 100 * p - 9,000, bankrupt while p < 90.
+			quoteBalance:  big.NewInt(-9_000),
+			expectedPrice: 89,
+			expectedFound: true,
+		},
+		"long with non-negative collateral at a price of zero is never bankrupt": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			},
+			quoteBalance:  big.NewInt(0),
+			expectedFound: false,
+		},
+		"short": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(-100), big.NewInt(0), big.NewInt(0)),
+			},
+			// NC = 11,000 - 100 * p, bankrupt while p > 110.
+			quoteBalance:  big.NewInt(11_000),
+			expectedPrice: 111,
+			expectedFound: true,
+		},
+		"long with another position": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(-100), big.NewInt(0), big.NewInt(0)),
+			},
+			// NC = 100 * p - 20,000, bankrupt while p < 200.
+			quoteBalance:  big.NewInt(0),
+			expectedPrice: 199,
+			expectedFound: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			subaccount := types.Subaccount{
+				Id:                 &subaccountId,
+				PerpetualPositions: tc.perpetualPositions,
+				AssetPositions:     testutil.CreateUsdcAssetPositions(tc.quoteBalance),
+			}
+			price, found, err := lib.GetBankruptcyPrice(subaccount, perpInfos, 1)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedFound, found)
+			require.Equal(t, tc.expectedPrice, price)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package lib
+
+import (
+	"math"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	perplib "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/lib"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// EstimateLiquidationProceeds estimates the outcome of liquidating the subaccount's position in the
+// perpetual against `depth`, the price levels available to the liquidation in the order they would be
+// filled. The input subaccount must be settled.
+//
+// The position is closed by sweeping the levels in order until it is fully closed or the levels are
+// exhausted, so the proceeds include the slippage of the liquidation through the book. Each level fill
+// is valued at the price of the level, and its contribution to the insurance fund is computed with
+// `ComputeInsuranceFundDelta` against the bankruptcy price of the position returned by
+// `GetBankruptcyPrice`, with a liquidation fee of at most `maxLiquidationFeePpm` of the fill notional.
+// If no price of the perpetual makes the subaccount bankrupt, the bankruptcy price is taken to be zero
+// for longs and the maximum price for shorts.
+//
+// Returns `ErrPerpPositionNotFound` if the subaccount has no position in the perpetual.
+func EstimateLiquidationProceeds(
+	subaccount types.Subaccount,
+	perpInfos perptypes.PerpInfos,
+	perpetualId uint32,
+	depth []types.DepthLevel,
+	maxLiquidationFeePpm uint32,
+) (
+	estimate types.LiquidationProceedsEstimate,
+	err error,
+) {
+	position, exists := subaccount.GetPerpetualPositionForId(perpetualId)
+	if !exists || position.GetBigQuantums().Sign() == 0 {
+		return estimate, errorsmod.Wrapf(
+			types.ErrPerpPositionNotFound,
+			"subaccount id: %v, perpetual id: %d",
+			subaccount.Id,
+			perpetualId,
+		)
+	}
+	isLong := position.GetIsLong()
+
+	bankruptcyPrice, found, err := GetBankruptcyPrice(subaccount, perpInfos, perpetualId)
+	if err != nil {
+		return estimate, err
+	}
+	if !found && !isLong {
+		bankruptcyPrice = math.MaxUint64
+	}
+
+	perpInfo := perpInfos.MustGet(perpetualId)
+	notionalAt := func(bigQuantums *big.Int, price uint64) (*big.Int, error) {
+		info := perpInfo
+		info.Price.Price = price
+		info.Price.Negative = false
+		return PositionNotional(bigQuantums, info)
+	}
+
+	remaining := new(big.Int).Abs(position.GetBigQuantums())
+	estimate = types.LiquidationProceedsEstimate{
+		FilledQuantums:                  new(big.Int),
+		ProceedsQuoteQuantums:           new(big.Int),
+		InsuranceFundDeltaQuoteQuantums: new(big.Int),
+	}
+	for _, level := range depth {
+		if remaining.Sign() == 0 {
+			break
+		}
+		fill := lib.BigMin(remaining, lib.BigU(level.Quantums))
+		if fill.Sign() == 0 {
+			continue
+		}
+		remaining.Sub(remaining, fill)
+
+		proceeds, err := notionalAt(fill, level.Price)
+		if err != nil {
+			return estimate, err
+		}
+		bankruptcyNotional, err := notionalAt(fill, bankruptcyPrice)
+		if err != nil {
+			return estimate, err
+		}
+		// Prices in quote quantums per base quantum.
+		fillPrice := new(big.Rat).SetFrac(proceeds, fill)
+		fillBankruptcyPrice := new(big.Rat).SetFrac(bankruptcyNotional, fill)
+		signedFill := new(big.Int).Set(fill)
+		if !isLong {
+			signedFill.Neg(signedFill)
+			proceeds.Neg(proceeds)
+		}
+
+		estimate.FilledQuantums.Add(estimate.FilledQuantums, fill)
+		estimate.ProceedsQuoteQuantums.Add(estimate.ProceedsQuoteQuantums, proceeds)
+		estimate.InsuranceFundDeltaQuoteQuantums.Add(
+			estimate.InsuranceFundDeltaQuoteQuantums,
+			perplib.ComputeInsuranceFundDelta(fillPrice, fillBankruptcyPrice, signedFill, maxLiquidationFeePpm),
+		)
+	}
+	return estimate, nil
+}
@@ -0,0 +1,131 @@
+package lib_test
+
+import (
+	"math/big"
+	"testing"
+
+	perp_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateLiquidationProceeds(t *testing.T) {
+	// One quantum has a notional of `price` quote quantums. Liquidations pay a fee of at most 1% of the
+	// fill notional to the insurance fund.
+	perpInfo := perp_testutil.CreatePerpInfo(1, -6, 100, 0)
+	perpInfos := perptypes.PerpInfos{1: perpInfo}
+
+	// A long of 100 quantums with -9,000 quote quantums has NC = 100 * p - 9,000, which is negative while
+	// p < 90, for a bankruptcy price of 89. A short of 100 quantums with 11,000 quote quantums has NC =
+	// 11,000 - 100 * p, which is negative while p > 110, for a bankruptcy price of 111.
+	long := testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0))
+	short := testutil.CreateSinglePerpetualPosition(1, big.NewInt(-100), big.NewInt(0), big.NewInt(0))
+
+	tests := map[string]struct {
+		position     *types.PerpetualPosition
+		quoteBalance *big.Int
+		depth        []types.DepthLevel
+
+		expectedEstimate types.LiquidationProceedsEstimate
+		expectedErr      error
+	}{
+		"long swept through two levels": {
+			position:     long,
+			quoteBalance: big.NewInt(-9_000),
+			depth: []types.DepthLevel{
+				{Price: 95, Quantums: 60},
+				{Price: 85, Quantums: 100},
+			},
+			// The first level fills 60 quantums for 5,700 with a surplus of 60 * (95 - 89) = 360 over the
+			// bankruptcy price, of which the fee of 1% of 5,700 = 57 goes to the insurance fund. The second
+			// level fills 40 quantums for 3,400 with a deficit of 40 * (89 - 85) = 160 that the insurance fund
+			// covers.
+			expectedEstimate: types.LiquidationProceedsEstimate{
+				FilledQuantums:                  big.NewInt(100),
+				ProceedsQuoteQuantums:           big.NewInt(5_700 + 3_400),
+				InsuranceFundDeltaQuoteQuantums: big.NewInt(57 - 160),
+			},
+		},
+		"short swept through two levels": {
+			position:     short,
+			quoteBalance: big.NewInt(11_000),
+			depth: []types.DepthLevel{
+				{Price: 105, Quantums: 60},
+				{Price: 115, Quantums: 100},
+			},
+			// The first level fills 60 quantums for 6,300 with a surplus of 60 * (111 - 105) = 360, of which
+			// the fee of 1% of 6,300 = 63 goes to the insurance fund. The second level fills 40 quantums for
+			// 4,600 with a deficit of 40 * (115 - 111) = 160.
+			expectedEstimate: types.LiquidationProceedsEstimate{
+				FilledQuantums:                  big.NewInt(100),
+				ProceedsQuoteQuantums:           big.NewInt(-6_300 - 4_600),
+				InsuranceFundDeltaQuoteQuantums: big.NewInt(63 - 160),
+			},
+		},
+		"depth does not close the position": {
+			position:     long,
+			quoteBalance: big.NewInt(-9_000),
+			depth: []types.DepthLevel{
+				{Price: 95, Quantums: 60},
+			},
+			expectedEstimate: types.LiquidationProceedsEstimate{
+				FilledQuantums:                  big.NewInt(60),
+				ProceedsQuoteQuantums:           big.NewInt(5_700),
+				InsuranceFundDeltaQuoteQuantums: big.NewInt(57),
+			},
+		},
+		"long that cannot become bankrupt": {
+			position:     long,
+			quoteBalance: big.NewInt(0),
+			depth: []types.DepthLevel{
+				{Price: 1, Quantums: 100},
+			},
+			// The bankruptcy price is taken to be zero, so the whole fill is surplus and the fee is 1% of 100.
+			expectedEstimate: types.LiquidationProceedsEstimate{
+				FilledQuantums:                  big.NewInt(100),
+				ProceedsQuoteQuantums:           big.NewInt(100),
+				InsuranceFundDeltaQuoteQuantums: big.NewInt(1),
+			},
+		},
+		"no position": {
+			quoteBalance: big.NewInt(0),
+			depth: []types.DepthLevel{
+				{Price: 95, Quantums: 60},
+			},
+			expectedErr: types.ErrPerpPositionNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			subaccount := types.Subaccount{
+				Id:             &types.SubaccountId{Owner: "test", Number: 1},
+				AssetPositions: testutil.CreateUsdcAssetPositions(tc.quoteBalance),
+			}
+			if tc.position != nil {
+				subaccount.PerpetualPositions = []*types.PerpetualPosition{tc.position}
+			}
+
+			estimate, err := lib.EstimateLiquidationProceeds(subaccount, perpInfos, 1, tc.depth, 10_000)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedEstimate.FilledQuantums.String(), estimate.FilledQuantums.String())
+			require.Equal(
+				t,
+				tc.expectedEstimate.ProceedsQuoteQuantums.String(),
+				estimate.ProceedsQuoteQuantums.String(),
+			)
+			require.Equal(
+				t,
+				tc.expectedEstimate.InsuranceFundDeltaQuoteQuantums.String(),
+				estimate.InsuranceFundDeltaQuoteQuantums.String(),
+			)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package lib
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// MaxOpenableQuantums returns the largest number of base quantums of the perpetual that the subaccount
+// can buy at `price` while remaining initially collateralized (i.e. NC >= IMR), after the updates in
+// `settledUpdate` are applied. `price` is in the same units as the market price of the perpetual.
+// The bought quantums are paid for in USDC at `price` and valued at the market price.
+//
+// The initial margin requirement is computed with the open interest of the perpetual after the buy,
+// assuming the counterparty does not change the open interest. Buying first reduces any existing
+// short position, which decreases the initial margin requirement of the subaccount.
+//
+// Returns zero if the subaccount is not initially collateralized either with no buy or with its
+// existing position in the perpetual closed, and returns `math.MaxUint64` quantums if the buy is
+// unbounded.
+func MaxOpenableQuantums(
+	settledUpdate types.SettledUpdate,
+	perpInfos perptypes.PerpInfos,
+	perpetualId uint32,
+	price uint64,
+) (
+	quantums *big.Int,
+	err error,
+) {
+	perpInfo := perpInfos.MustGet(perpetualId)
+	subaccount := CalculateUpdatedSubaccount(settledUpdate, perpInfos)
+
+	existingQuantums := new(big.Int)
+	for _, pos := range subaccount.PerpetualPositions {
+		if pos.PerpetualId == perpetualId {
+			existingQuantums = pos.GetBigQuantums()
+		}
+	}
+	existingLong := lib.BigMax(existingQuantums, new(big.Int))
+
+	isCollateralizedAfterBuying := func(q *big.Int) (bool, error) {
+		cost := lib.BaseToQuoteQuantums(q, perpInfo.Perpetual.Params.AtomicResolution, price, perpInfo.Price.Exponent)
+		buy := types.SettledUpdate{
+			SettledSubaccount: subaccount,
+			PerpetualUpdates: []types.PerpetualUpdate{
+				{
+					PerpetualId:          perpetualId,
+					BigQuantumsDelta:     q,
+					BigFillQuoteQuantums: cost,
+				},
+			},
+			AssetUpdates: []types.AssetUpdate{
+				{
+					AssetId:          assettypes.AssetUsdc.Id,
+					BigQuantumsDelta: new(big.Int).Neg(cost),
+				},
+			},
+		}
+
+		// Apply the change in open interest from the buy so the open-interest-scaled initial margin
+		// fraction is used.
+		newLong := lib.BigMax(new(big.Int).Add(existingQuantums, q), new(big.Int))
+		deltaLong := newLong.Sub(newLong, existingLong)
+		infos := make(perptypes.PerpInfos, len(perpInfos))
+		for id, info := range perpInfos {
+			infos[id] = info
+		}
+		info := infos[perpetualId]
+		info.Perpetual.OpenInterest = dtypes.NewIntFromBigInt(
+			new(big.Int).Add(info.Perpetual.OpenInterest.BigInt(), deltaLong),
+		)
+		infos[perpetualId] = info
+
+		risk, err := GetRiskForSubaccount(CalculateUpdatedSubaccount(buy, infos), infos)
+		if err != nil {
+			return false, err
+		}
+		return risk.IsInitialCollateralized(), nil
+	}
+
+	// The initial margin requirement is convex in the size of the position while the net collateral is
+	// linear, so the quantums that keep the subaccount collateralized form an interval. Find a size in
+	// the interval, trying no buy and then buying enough to close an existing short position.
+	collateralizedQuantums := new(big.Int)
+	collateralized, err := isCollateralizedAfterBuying(collateralizedQuantums)
+	if err != nil {
+		return nil, err
+	}
+	if !collateralized && existingQuantums.Sign() < 0 {
+		collateralizedQuantums.Neg(existingQuantums)
+		if collateralized, err = isCollateralizedAfterBuying(collateralizedQuantums); err != nil {
+			return nil, err
+		}
+	}
+	if !collateralized {
+		return new(big.Int), nil
+	}
+
+	// Find an uncollateralized size by doubling, then binary search for the end of the interval.
+	maxQuantums := new(big.Int).SetUint64(math.MaxUint64)
+	uncollateralizedQuantums := new(big.Int).Add(collateralizedQuantums, big.NewInt(1))
+	for {
+		collateralized, err := isCollateralizedAfterBuying(uncollateralizedQuantums)
+		if err != nil {
+			return nil, err
+		}
+		if !collateralized {
+			break
+		}
+		if uncollateralizedQuantums.Cmp(maxQuantums) >= 0 {
+			return maxQuantums, nil
+		}
+		collateralizedQuantums.Set(uncollateralizedQuantums)
+		uncollateralizedQuantums = lib.BigMin(new(big.Int).Lsh(uncollateralizedQuantums, 1), maxQuantums)
+	}
+
+	one := big.NewInt(1)
+	for new(big.Int).Sub(uncollateralizedQuantums, collateralizedQuantums).Cmp(one) > 0 {
+		mid := new(big.Int).Add(collateralizedQuantums, uncollateralizedQuantums)
+		mid.Rsh(mid, 1)
+		collateralized, err := isCollateralizedAfterBuying(mid)
+		if err != nil {
+			return nil, err
+		}
+		if collateralized {
+			collateralizedQuantums = mid
+		} else {
+			uncollateralizedQuantums = mid
+		}
+	}
+	return collateralizedQuantums, nil
+}
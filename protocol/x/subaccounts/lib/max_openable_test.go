@@ -0,0 +1,136 @@
+package lib_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	perp_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxOpenableQuantums(t *testing.T) {
+	subaccountId := types.SubaccountId{Owner: "test", Number: 1}
+	// One quantum has a notional of 100 quote quantums, with an initial margin fraction of 10%.
+	perpInfo := perp_testutil.CreatePerpInfo(1, -6, 100, 0)
+	// Scale the initial margin fraction linearly from 10% to 100% as open interest grows from 0 to
+	// 200,000 quote quantums.
+	oiScaledPerpInfo := perp_testutil.CreatePerpInfo(1, -6, 100, 0)
+	oiScaledPerpInfo.LiquidityTier.OpenInterestUpperCap = 200_000
+	tests := map[string]struct {
+		perpInfo           perptypes.PerpInfo
+		perpetualPositions []*types.PerpetualPosition
+		quoteBalance       *big.Int
+		perpetualUpdates   []types.PerpetualUpdate
+		price              uint64
+
+		expectedQuantums *big.Int
+	}{
+		"flat account": {
+			perpInfo: perpInfo,
+			// NC = 10,000, IMR = 10 * q.
+			quoteBalance:     big.NewInt(10_000),
+			price:            100,
+			expectedQuantums: big.NewInt(1_000),
+		},
+		"flat account with limit price above the market price": {
+			perpInfo: perpInfo,
+			// NC = 10,000 - 10 * q, IMR = 10 * q.
+			quoteBalance:     big.NewInt(10_000),
+			price:            110,
+			expectedQuantums: big.NewInt(500),
+		},
+		"flat account with limit price below the market price": {
+			perpInfo: perpInfo,
+			// NC = 10,000 + 10 * q, IMR = 10 * q.
+			quoteBalance:     big.NewInt(10_000),
+			price:            90,
+			expectedQuantums: new(big.Int).SetUint64(math.MaxUint64),
+		},
+		"flat account with open interest scaled initial margin": {
+			perpInfo: oiScaledPerpInfo,
+			// NC = 10,000, IMR = 10 * q + 0.045 * q^2.
+			quoteBalance:     big.NewInt(10_000),
+			price:            100,
+			expectedQuantums: big.NewInt(373),
+		},
+		"existing short increases buying power": {
+			perpInfo: perpInfo,
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(-500), big.NewInt(0), big.NewInt(0)),
+			},
+			// NC = 10,000, IMR = 10 * |q - 500|.
+			quoteBalance:     big.NewInt(60_000),
+			price:            100,
+			expectedQuantums: big.NewInt(1_500),
+		},
+		"undercollateralized short can be closed": {
+			perpInfo: perpInfo,
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(-500), big.NewInt(0), big.NewInt(0)),
+			},
+			// NC = 3,000, IMR = 10 * |q - 500|.
+			quoteBalance:     big.NewInt(53_000),
+			price:            100,
+			expectedQuantums: big.NewInt(800),
+		},
+		"existing long decreases buying power": {
+			perpInfo: perpInfo,
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(500), big.NewInt(0), big.NewInt(0)),
+			},
+			// NC = 10,000, IMR = 10 * (q + 500).
+			quoteBalance:     big.NewInt(-40_000),
+			price:            100,
+			expectedQuantums: big.NewInt(500),
+		},
+		"pending updates are applied first": {
+			perpInfo:     perpInfo,
+			quoteBalance: big.NewInt(10_000),
+			perpetualUpdates: []types.PerpetualUpdate{
+				{
+					PerpetualId:          1,
+					BigQuantumsDelta:     big.NewInt(-500),
+					BigQuoteBalanceDelta: big.NewInt(50_000),
+				},
+			},
+			price:            100,
+			expectedQuantums: big.NewInt(1_500),
+		},
+		"undercollateralized account": {
+			perpInfo: perpInfo,
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(500), big.NewInt(0), big.NewInt(0)),
+			},
+			// NC = 4,000, IMR = 10 * (q + 500).
+			quoteBalance:     big.NewInt(-46_000),
+			price:            100,
+			expectedQuantums: big.NewInt(0),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			settledUpdate := types.SettledUpdate{
+				SettledSubaccount: types.Subaccount{
+					Id:                 &subaccountId,
+					PerpetualPositions: tc.perpetualPositions,
+					AssetPositions:     testutil.CreateUsdcAssetPositions(tc.quoteBalance),
+				},
+				PerpetualUpdates: tc.perpetualUpdates,
+			}
+			perpInfos := perptypes.PerpInfos{1: tc.perpInfo}
+
+			quantums, err := lib.MaxOpenableQuantums(settledUpdate, perpInfos, 1, tc.price)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedQuantums, quantums)
+			// The input open interest is not modified.
+			require.Equal(t, dtypes.NewInt(0), perpInfos[1].Perpetual.OpenInterest)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package lib
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// MaxSafeLeverage returns the highest leverage, as the notional of a new position in the perpetual
+// divided by the net collateral of the subaccount, that the subaccount can open after the updates in
+// `settledUpdate` are applied without being immediately at risk of liquidation. The new position must
+// both fit within the free collateral of the subaccount at the initial margin fraction of the perpetual,
+// scaled by its current open interest, and keep the net collateral of the subaccount at least
+// `safetyBufferPpm` parts-per-million above its maintenance margin requirement after the open.
+//
+// Like `BuyingPower`, changes in the net collateral and open interest from opening the position, and the
+// reduction of an existing position in the perpetual, are not taken into account. Returns zero if the
+// subaccount has no free collateral or no room above the buffered maintenance margin requirement, and
+// `math.MaxUint64` if neither the initial nor the maintenance margin fraction of the perpetual bound the
+// new position.
+func MaxSafeLeverage(
+	settledUpdate types.SettledUpdate,
+	perpInfos perptypes.PerpInfos,
+	perpetualId uint32,
+	safetyBufferPpm uint32,
+) (
+	leverage *big.Rat,
+	err error,
+) {
+	perpInfo := perpInfos.MustGet(perpetualId)
+	risk, err := GetRiskForSubaccount(CalculateUpdatedSubaccount(settledUpdate, perpInfos), perpInfos)
+	if err != nil {
+		return nil, err
+	}
+	freeCollateral := new(big.Int).Sub(risk.NC, risk.IMR)
+	if risk.NC.Sign() <= 0 || freeCollateral.Sign() <= 0 {
+		return new(big.Rat), nil
+	}
+
+	openInterest, err := PositionNotional(perpInfo.Perpetual.OpenInterest.BigInt(), perpInfo)
+	if err != nil {
+		return nil, err
+	}
+	initialMarginPpm := perpInfo.LiquidityTier.GetAdjustedInitialMarginPpm(openInterest)
+	maintenanceMarginPpm := lib.BigU(perpInfo.LiquidityTier.GetMaintenanceMarginPpm())
+	oneMillion := new(big.Rat).SetInt(lib.BigIntOneMillion())
+
+	// The notional that can be opened with the free collateral of the subaccount.
+	var maxNotional *big.Rat
+	if initialMarginPpm.Sign() != 0 {
+		maxNotional = new(big.Rat).SetFrac(freeCollateral, initialMarginPpm)
+		maxNotional.Mul(maxNotional, oneMillion)
+	}
+
+	// The notional that keeps NC >= (MMR + notional * maintenance margin fraction) * (1 + buffer).
+	if maintenanceMarginPpm.Sign() != 0 {
+		maxMaintenanceMargin := new(big.Rat).SetFrac(
+			new(big.Int).Mul(risk.NC, lib.BigIntOneMillion()),
+			new(big.Int).Add(lib.BigIntOneMillion(), lib.BigU(safetyBufferPpm)),
+		)
+		maxMaintenanceMargin.Sub(maxMaintenanceMargin, new(big.Rat).SetInt(risk.MMR))
+		if maxMaintenanceMargin.Sign() <= 0 {
+			return new(big.Rat), nil
+		}
+		maxBufferedNotional := maxMaintenanceMargin.Mul(maxMaintenanceMargin, oneMillion)
+		maxBufferedNotional.Quo(maxBufferedNotional, new(big.Rat).SetInt(maintenanceMarginPpm))
+		if maxNotional == nil || maxBufferedNotional.Cmp(maxNotional) < 0 {
+			maxNotional = maxBufferedNotional
+		}
+	}
+
+	if maxNotional == nil {
+		return new(big.Rat).SetUint64(math.MaxUint64), nil
+	}
+	return maxNotional.Quo(maxNotional, new(big.Rat).SetInt(risk.NC)), nil
+}
@@ -0,0 +1,101 @@
+package lib_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	perp_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxSafeLeverage(t *testing.T) {
+	// One quantum has a notional of 100 quote quantums, with an initial margin fraction of 10% and a
+	// maintenance margin fraction of 5%.
+	perpInfo := perp_testutil.CreatePerpInfo(1, -6, 100, 0)
+	noMarginPerpInfo := perp_testutil.CreatePerpInfo(1, -6, 100, 0)
+	noMarginPerpInfo.LiquidityTier.InitialMarginPpm = 0
+
+	tests := map[string]struct {
+		perpInfo           perptypes.PerpInfo
+		perpetualPositions []*types.PerpetualPosition
+		usdcBalance        int64
+		safetyBufferPpm    uint32
+
+		expectedLeverage *big.Rat
+	}{
+		"flat account": {
+			perpInfo:         perpInfo,
+			usdcBalance:      10_000,
+			expectedLeverage: big.NewRat(10, 1),
+		},
+		"flat account with a buffer that does not bind": {
+			// The buffered maintenance margin bounds the notional to 100,000.
+			perpInfo:         perpInfo,
+			usdcBalance:      10_000,
+			safetyBufferPpm:  1_000_000,
+			expectedLeverage: big.NewRat(10, 1),
+		},
+		"flat account with a buffer that binds": {
+			// The buffered maintenance margin bounds the notional to 80,000.
+			perpInfo:         perpInfo,
+			usdcBalance:      10_000,
+			safetyBufferPpm:  1_500_000,
+			expectedLeverage: big.NewRat(8, 1),
+		},
+		"flat account with no margin requirement": {
+			perpInfo:         noMarginPerpInfo,
+			usdcBalance:      10_000,
+			expectedLeverage: new(big.Rat).SetUint64(math.MaxUint64),
+		},
+		"account near its initial margin requirement": {
+			perpInfo: perpInfo,
+			// NC = 1,000, IMR = 900, MMR = 450.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(90), big.NewInt(0), big.NewInt(0)),
+			},
+			usdcBalance:      -8_000,
+			expectedLeverage: big.NewRat(1, 1),
+		},
+		"account near its initial margin requirement with a buffer above its net collateral": {
+			perpInfo: perpInfo,
+			// NC = 1,000, MMR = 450, and NC / (1 + 130%) < MMR.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(90), big.NewInt(0), big.NewInt(0)),
+			},
+			usdcBalance:      -8_000,
+			safetyBufferPpm:  1_300_000,
+			expectedLeverage: new(big.Rat),
+		},
+		"account below its initial margin requirement": {
+			perpInfo: perpInfo,
+			// NC = 800, IMR = 900.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(90), big.NewInt(0), big.NewInt(0)),
+			},
+			usdcBalance:      -8_200,
+			expectedLeverage: new(big.Rat),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			perpInfos := perptypes.PerpInfos{1: tc.perpInfo}
+			settledUpdate := types.SettledUpdate{
+				SettledSubaccount: types.Subaccount{
+					Id:                 &types.SubaccountId{Owner: "test", Number: 1},
+					PerpetualPositions: tc.perpetualPositions,
+					AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(tc.usdcBalance)),
+				},
+			}
+			leverage, err := lib.MaxSafeLeverage(settledUpdate, perpInfos, 1, tc.safetyBufferPpm)
+			require.NoError(t, err)
+			require.Equal(t, 0, tc.expectedLeverage.Cmp(leverage), "expected %s, got %s",
+				tc.expectedLeverage, leverage)
+		})
+	}
+}
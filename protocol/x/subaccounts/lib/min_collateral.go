@@ -0,0 +1,42 @@
+package lib
+
+import (
+	"math/big"
+
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// MinMaintenanceCollateral returns the minimum USDC balance in quote quantums that keeps the net
+// collateral of the subaccount at or above its maintenance margin requirement at current prices, after
+// the updates in `settledUpdate` are applied. This is the maintenance margin requirement of the
+// subaccount minus the net collateral contributed by its positions other than USDC.
+//
+// The result is negative if the other positions alone contribute more net collateral than the
+// maintenance margin requirement. Unlike `BuyingPower`, which is based on the initial margin requirement,
+// the result only ensures that the subaccount is not liquidatable.
+func MinMaintenanceCollateral(
+	settledUpdate types.SettledUpdate,
+	perpInfos perptypes.PerpInfos,
+) (
+	minCollateral *big.Int,
+	err error,
+) {
+	subaccount := CalculateUpdatedSubaccount(settledUpdate, perpInfos)
+
+	// Exclude the USDC position so that the risk only includes the net collateral of other positions.
+	assetPositions := make([]*types.AssetPosition, 0, len(subaccount.AssetPositions))
+	for _, pos := range subaccount.AssetPositions {
+		if pos.AssetId != assettypes.AssetUsdc.Id {
+			assetPositions = append(assetPositions, pos)
+		}
+	}
+	subaccount.AssetPositions = assetPositions
+
+	risk, err := GetRiskForSubaccount(subaccount, perpInfos)
+	if err != nil {
+		return nil, err
+	}
+	return risk.MMR.Sub(risk.MMR, risk.NC), nil
+}
@@ -0,0 +1,91 @@
+package lib_test
+
+import (
+	"math/big"
+	"testing"
+
+	perp_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMinMaintenanceCollateral(t *testing.T) {
+	// One quantum has a notional of 100 quote quantums in perpetual 1 and 200 quote quantums in
+	// perpetual 2, both with a maintenance margin fraction of 5%.
+	perpInfos := perptypes.PerpInfos{
+		1: perp_testutil.CreatePerpInfo(1, -6, 100, 0),
+		2: perp_testutil.CreatePerpInfo(2, -6, 200, 0),
+	}
+
+	tests := map[string]struct {
+		perpetualPositions []*types.PerpetualPosition
+		usdcBalance        int64
+		perpetualUpdates   []types.PerpetualUpdate
+
+		expectedMinCollateral *big.Int
+	}{
+		"no positions": {
+			usdcBalance:           10_000,
+			expectedMinCollateral: big.NewInt(0),
+		},
+		"long position with positive net collateral": {
+			// Position NC = 10,000, MMR = 500.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			},
+			expectedMinCollateral: big.NewInt(-9_500),
+		},
+		"long and short positions with no net collateral": {
+			// Position NCs = 0 and 0, MMRs = 500 and 500.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(-10_000)),
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(-50), big.NewInt(0), big.NewInt(10_000)),
+			},
+			usdcBalance:           5_000,
+			expectedMinCollateral: big.NewInt(1_000),
+		},
+		"positions with unrealized losses": {
+			// Position NCs = -1,000 and -2,000, MMRs = 500 and 1,000.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(-11_000)),
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(-100), big.NewInt(0), big.NewInt(18_000)),
+			},
+			usdcBalance:           20_000,
+			expectedMinCollateral: big.NewInt(4_500),
+		},
+		"pending update opening a position": {
+			// Position NCs = 0 and 0, MMRs = 500 and 500.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(-10_000)),
+			},
+			perpetualUpdates: []types.PerpetualUpdate{
+				{
+					PerpetualId:          2,
+					BigQuantumsDelta:     big.NewInt(-50),
+					BigQuoteBalanceDelta: big.NewInt(10_000),
+				},
+			},
+			expectedMinCollateral: big.NewInt(1_000),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			settledUpdate := types.SettledUpdate{
+				SettledSubaccount: types.Subaccount{
+					Id:                 &types.SubaccountId{Owner: "test", Number: 1},
+					PerpetualPositions: tc.perpetualPositions,
+					AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(tc.usdcBalance)),
+				},
+				PerpetualUpdates: tc.perpetualUpdates,
+			}
+			minCollateral, err := lib.MinMaintenanceCollateral(settledUpdate, perpInfos)
+			require.NoError(t, err)
+			require.Equal(t, 0, tc.expectedMinCollateral.Cmp(minCollateral), "expected %s, got %s",
+				tc.expectedMinCollateral, minCollateral)
+		})
+	}
+}
@@ -118,3 +118,44 @@ func GetDeltaOpenInterestFromUpdates(
 		BaseQuantums: baseQuantumsDelta,
 	}
 }
+
+// GetMaxDeltaOpenInterestFromOrderUpdates returns the largest change in open interest that filling the order
+// of a `CollatCheck` update could cause. Open interest only increases if both sides of a fill open positions,
+// so this is the amount by which the update opens a position, assuming the unknown counterparty also opens
+// one. Collateralizing the order against the open interest after this change ensures the order is still
+// collateralized once it is filled, even as the higher open interest raises the initial margin fraction.
+//
+// Returns nil if the update type is not `CollatCheck`, the updates are not a single update to a single
+// perpetual, or the update does not open a position.
+func GetMaxDeltaOpenInterestFromOrderUpdates(
+	settledUpdates []types.SettledUpdate,
+	updateType types.UpdateType,
+) (ret *perptypes.OpenInterestDelta) {
+	if updateType != types.CollatCheck ||
+		len(settledUpdates) != 1 ||
+		len(settledUpdates[0].PerpetualUpdates) != 1 {
+		return nil
+	}
+
+	u := settledUpdates[0]
+	perpUpdate := u.PerpetualUpdates[0]
+	prevQuantums := new(big.Int)
+	if position, exists := u.SettledSubaccount.GetPerpetualPositionForId(perpUpdate.PerpetualId); exists {
+		prevQuantums = position.GetBigQuantums()
+	}
+	afterQuantums := new(big.Int).Add(prevQuantums, perpUpdate.GetBigQuantums())
+
+	// Flipping a position opens all of the position on the new side.
+	openedQuantums := new(big.Int).Abs(afterQuantums)
+	if prevQuantums.Sign()*afterQuantums.Sign() >= 0 {
+		openedQuantums.Sub(openedQuantums, new(big.Int).Abs(prevQuantums))
+	}
+	if openedQuantums.Sign() <= 0 {
+		return nil
+	}
+
+	return &perptypes.OpenInterestDelta{
+		PerpetualId:  perpUpdate.PerpetualId,
+		BaseQuantums: openedQuantums,
+	}
+}
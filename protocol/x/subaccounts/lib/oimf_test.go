@@ -397,3 +397,102 @@ func TestGetDeltaOpenInterestFromUpdates(t *testing.T) {
 		})
 	}
 }
+
+func TestGetMaxDeltaOpenInterestFromOrderUpdates(t *testing.T) {
+	tests := map[string]struct {
+		updateType    types.UpdateType
+		curQuantums   int64
+		quantumsDelta int64
+		expectedVal   *perptypes.OpenInterestDelta
+	}{
+		"Opening a long": {
+			updateType:    types.CollatCheck,
+			quantumsDelta: 500,
+			expectedVal: &perptypes.OpenInterestDelta{
+				PerpetualId:  1000,
+				BaseQuantums: big.NewInt(500),
+			},
+		},
+		"Opening a short": {
+			updateType:    types.CollatCheck,
+			quantumsDelta: -500,
+			expectedVal: &perptypes.OpenInterestDelta{
+				PerpetualId:  1000,
+				BaseQuantums: big.NewInt(500),
+			},
+		},
+		"Increasing a short": {
+			updateType:    types.CollatCheck,
+			curQuantums:   -200,
+			quantumsDelta: -500,
+			expectedVal: &perptypes.OpenInterestDelta{
+				PerpetualId:  1000,
+				BaseQuantums: big.NewInt(500),
+			},
+		},
+		"Flipping a long to a short": {
+			updateType:    types.CollatCheck,
+			curQuantums:   200,
+			quantumsDelta: -500,
+			expectedVal: &perptypes.OpenInterestDelta{
+				PerpetualId:  1000,
+				BaseQuantums: big.NewInt(300),
+			},
+		},
+		"Reducing a long, return nil": {
+			updateType:    types.CollatCheck,
+			curQuantums:   500,
+			quantumsDelta: -200,
+			expectedVal:   nil,
+		},
+		"Closing a short, return nil": {
+			updateType:    types.CollatCheck,
+			curQuantums:   -500,
+			quantumsDelta: 500,
+			expectedVal:   nil,
+		},
+		"Not CollatCheck update, return nil": {
+			updateType:    types.Withdrawal,
+			quantumsDelta: 500,
+			expectedVal:   nil,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			subaccount := types.Subaccount{
+				Id: aliceSubaccountId,
+			}
+			if tc.curQuantums != 0 {
+				subaccount.PerpetualPositions = []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(
+						1000,
+						big.NewInt(tc.curQuantums),
+						big.NewInt(0),
+						big.NewInt(0),
+					),
+				}
+			}
+
+			perpOpenInterestDelta := salib.GetMaxDeltaOpenInterestFromOrderUpdates(
+				[]types.SettledUpdate{
+					{
+						SettledSubaccount: subaccount,
+						PerpetualUpdates: []types.PerpetualUpdate{
+							{
+								PerpetualId:      1000,
+								BigQuantumsDelta: big.NewInt(tc.quantumsDelta),
+							},
+						},
+					},
+				},
+				tc.updateType,
+			)
+			require.Equal(
+				t,
+				tc.expectedVal,
+				perpOpenInterestDelta,
+			)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package lib
+
+import (
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// CheckPositionLimits returns the first position limit violated by the update, in the order:
+//   - `ViolatesMaxPositionNotional` if the update increases a position above the max position notional of
+//     its liquidity tier, see `ExceedsMaxPositionNotional`.
+//   - `ViolatesMaxPerpetualPositions` if the update opens a new position while the subaccount holds
+//     `limits.MaxPerpetualPositions` or more, see `ExceedsMaxPerpetualPositions`.
+//
+// Returns `Success` if no limit is violated. Updates that only reduce or close positions never violate a
+// position limit.
+func CheckPositionLimits(
+	settledUpdate types.SettledUpdate,
+	perpInfos perptypes.PerpInfos,
+	limits types.PositionLimits,
+) (
+	result types.UpdateResult,
+	err error,
+) {
+	updatedSubaccount := CalculateUpdatedSubaccount(settledUpdate, perpInfos)
+
+	exceedsMaxPositionNotional, err := ExceedsMaxPositionNotional(settledUpdate, updatedSubaccount, perpInfos)
+	if err != nil {
+		return types.UpdateCausedError, err
+	}
+	if exceedsMaxPositionNotional {
+		return types.ViolatesMaxPositionNotional, nil
+	}
+
+	if ExceedsMaxPerpetualPositions(settledUpdate, updatedSubaccount, limits.MaxPerpetualPositions) {
+		return types.ViolatesMaxPerpetualPositions, nil
+	}
+
+	return types.Success, nil
+}
+
+// ExceedsMaxPerpetualPositions returns true if the update increases the number of perpetual positions of
+// the subaccount above `maxPerpetualPositions`. `updatedSubaccount` is the settled subaccount of
+// `settledUpdate` with the update applied. A limit of zero is never exceeded.
+//
+// Updates that do not open a new position are always allowed, even if the subaccount remains above the
+// limit, e.g. after the limit was lowered.
+func ExceedsMaxPerpetualPositions(
+	settledUpdate types.SettledUpdate,
+	updatedSubaccount types.Subaccount,
+	maxPerpetualPositions uint32,
+) bool {
+	if maxPerpetualPositions == 0 {
+		return false
+	}
+	numPositions := len(updatedSubaccount.PerpetualPositions)
+	return numPositions > int(maxPerpetualPositions) &&
+		numPositions > len(settledUpdate.SettledSubaccount.PerpetualPositions)
+}
@@ -0,0 +1,110 @@
+package lib_test
+
+import (
+	"math/big"
+	"testing"
+
+	perp_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPositionLimits(t *testing.T) {
+	// Each base quantum is worth 100 quote quantums, so the cap of perpetual 1 is a position of 100 base
+	// quantums. Perpetuals 2 and 3 have no cap.
+	cappedPerpInfo := perp_testutil.CreatePerpInfo(1, -6, 100, 0)
+	cappedPerpInfo.LiquidityTier.MaxPositionNotionalQuoteQuantums = 10_000
+	perpInfos := perptypes.PerpInfos{
+		1: cappedPerpInfo,
+		2: perp_testutil.CreatePerpInfo(2, -6, 100, 0),
+		3: perp_testutil.CreatePerpInfo(3, -6, 100, 0),
+	}
+
+	tests := map[string]struct {
+		curQuantums      map[uint32]int64
+		perpetualUpdates map[uint32]int64
+		limits           types.PositionLimits
+
+		expectedResult types.UpdateResult
+	}{
+		"no limit is violated": {
+			curQuantums:      map[uint32]int64{2: 10},
+			perpetualUpdates: map[uint32]int64{1: 100, 3: 10},
+			limits:           types.PositionLimits{MaxPerpetualPositions: 3},
+			expectedResult:   types.Success,
+		},
+		"max position notional": {
+			perpetualUpdates: map[uint32]int64{1: 101},
+			expectedResult:   types.ViolatesMaxPositionNotional,
+		},
+		"max perpetual positions": {
+			curQuantums:      map[uint32]int64{1: 10, 2: 10},
+			perpetualUpdates: map[uint32]int64{3: 10},
+			limits:           types.PositionLimits{MaxPerpetualPositions: 2},
+			expectedResult:   types.ViolatesMaxPerpetualPositions,
+		},
+		"max position notional is checked before max perpetual positions": {
+			curQuantums:      map[uint32]int64{2: 10},
+			perpetualUpdates: map[uint32]int64{1: 101},
+			limits:           types.PositionLimits{MaxPerpetualPositions: 1},
+			expectedResult:   types.ViolatesMaxPositionNotional,
+		},
+		"increasing an existing position at max perpetual positions": {
+			curQuantums:      map[uint32]int64{1: 10, 2: 10},
+			perpetualUpdates: map[uint32]int64{2: 10},
+			limits:           types.PositionLimits{MaxPerpetualPositions: 2},
+			expectedResult:   types.Success,
+		},
+		"replacing a position at max perpetual positions": {
+			curQuantums:      map[uint32]int64{1: 10, 2: 10},
+			perpetualUpdates: map[uint32]int64{2: -10, 3: 10},
+			limits:           types.PositionLimits{MaxPerpetualPositions: 2},
+			expectedResult:   types.Success,
+		},
+		"closing a position above max perpetual positions": {
+			curQuantums:      map[uint32]int64{1: 10, 2: 10, 3: 10},
+			perpetualUpdates: map[uint32]int64{3: -10},
+			limits:           types.PositionLimits{MaxPerpetualPositions: 1},
+			expectedResult:   types.Success,
+		},
+		"max perpetual positions of zero is disabled": {
+			curQuantums:      map[uint32]int64{1: 10, 2: 10},
+			perpetualUpdates: map[uint32]int64{3: 10},
+			expectedResult:   types.Success,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			subaccount := types.Subaccount{
+				Id:             &types.SubaccountId{Owner: "test", Number: 0},
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(1_000_000)),
+			}
+			for _, perpetualId := range []uint32{1, 2, 3} {
+				if quantums, ok := tc.curQuantums[perpetualId]; ok {
+					subaccount.PerpetualPositions = append(
+						subaccount.PerpetualPositions,
+						testutil.CreateSinglePerpetualPosition(perpetualId, big.NewInt(quantums), big.NewInt(0), big.NewInt(0)),
+					)
+				}
+			}
+			settledUpdate := types.SettledUpdate{SettledSubaccount: subaccount}
+			for _, perpetualId := range []uint32{1, 2, 3} {
+				if delta, ok := tc.perpetualUpdates[perpetualId]; ok {
+					settledUpdate.PerpetualUpdates = append(settledUpdate.PerpetualUpdates, types.PerpetualUpdate{
+						PerpetualId:          perpetualId,
+						BigQuantumsDelta:     big.NewInt(delta),
+						BigQuoteBalanceDelta: big.NewInt(0),
+					})
+				}
+			}
+
+			result, err := lib.CheckPositionLimits(settledUpdate, perpInfos, tc.limits)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedResult, result)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package lib
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// ProtectiveStop is a resting stop-loss order that reduces a perpetual position of a subaccount when the
+// market price of the perpetual crosses its trigger price.
+type ProtectiveStop struct {
+	PerpetualId uint32
+	// The absolute number of base quantums of the position the stop closes.
+	Quantums *big.Int
+	// The trigger price of the stop, in the same units as the market price of the perpetual.
+	TriggerPrice uint64
+}
+
+// GetRiskForSubaccountWithProtectiveStops returns the risk of the `Subaccount` as returned by
+// `GetRiskForSubaccount`, and its risk if all of the protective stops in `stops` are triggered. The input
+// subaccount must be settled. With no stops, the protected risk equals the raw risk.
+//
+// A triggered stop closes up to its quantums of the position at its trigger price, which bounds the loss
+// on the closed quantums to the move from the market price to the trigger price. The closed quantums no
+// longer contribute to the margin requirements, and their value at the trigger price is realized into the
+// net collateral. A stop whose trigger price has already been crossed by the market price closes at the
+// market price instead. Stops are applied in order, and stops on a perpetual in which the subaccount has
+// no remaining position or which is in settlement mode are ignored.
+func GetRiskForSubaccountWithProtectiveStops(
+	subaccount types.Subaccount,
+	perpInfos perptypes.PerpInfos,
+	stops []ProtectiveStop,
+) (
+	raw margin.Risk,
+	protected margin.Risk,
+	err error,
+) {
+	raw, err = GetRiskForSubaccount(subaccount, perpInfos)
+	if err != nil {
+		return raw, protected, err
+	}
+	protected = margin.ZeroRisk()
+	protected.AddInPlace(raw)
+
+	positions := make(map[uint32]*types.PerpetualPosition, len(subaccount.PerpetualPositions))
+	for _, pos := range subaccount.PerpetualPositions {
+		positions[pos.PerpetualId] = pos
+	}
+	for _, stop := range stops {
+		if stop.Quantums == nil || stop.Quantums.Sign() <= 0 {
+			return raw, protected, errorsmod.Wrapf(
+				types.ErrInvalidProtectiveStop,
+				"perpetual id: %d, quantums: %v",
+				stop.PerpetualId,
+				stop.Quantums,
+			)
+		}
+		pos, exists := positions[stop.PerpetualId]
+		if !exists {
+			continue
+		}
+		perpInfo := perpInfos.MustGet(stop.PerpetualId)
+		if perpInfo.SettlementPrice != 0 {
+			continue
+		}
+
+		quantums := pos.GetBigQuantums()
+		closed := new(big.Int).Set(stop.Quantums)
+		if closed.CmpAbs(quantums) > 0 {
+			closed.Abs(quantums)
+		}
+		if pos.GetIsShort() {
+			closed.Neg(closed)
+		}
+		remaining := &types.PerpetualPosition{
+			PerpetualId:  pos.PerpetualId,
+			Quantums:     dtypes.NewIntFromBigInt(new(big.Int).Sub(quantums, closed)),
+			FundingIndex: pos.FundingIndex,
+			QuoteBalance: pos.QuoteBalance,
+		}
+
+		// Long positions are closed at the lower and short positions at the higher of the trigger price and
+		// the market price.
+		closeInfo := perpInfo
+		triggerPrice := new(big.Int).SetUint64(stop.TriggerPrice)
+		marketPrice := new(big.Int).SetUint64(perpInfo.Price.Price)
+		if perpInfo.Price.Negative {
+			marketPrice.Neg(marketPrice)
+		}
+		if cmp := triggerPrice.Cmp(marketPrice); (pos.GetIsLong() && cmp < 0) || (pos.GetIsShort() && cmp > 0) {
+			closeInfo.Price.Price = stop.TriggerPrice
+			closeInfo.Price.Negative = false
+		}
+
+		before, err := PositionRisk(pos, perpInfo)
+		if err != nil {
+			return raw, protected, err
+		}
+		after, err := PositionRisk(remaining, perpInfo)
+		if err != nil {
+			return raw, protected, err
+		}
+		closedValue, err := PositionRisk(
+			&types.PerpetualPosition{PerpetualId: pos.PerpetualId, Quantums: dtypes.NewIntFromBigInt(closed)},
+			closeInfo,
+		)
+		if err != nil {
+			return raw, protected, err
+		}
+		protected.NC.Add(protected.NC, closedValue.NC)
+		protected.AddInPlace(after)
+		protected.NC.Sub(protected.NC, before.NC)
+		protected.IMR.Sub(protected.IMR, before.IMR)
+		protected.MMR.Sub(protected.MMR, before.MMR)
+
+		positions[stop.PerpetualId] = remaining
+		if remaining.GetBigQuantums().Sign() == 0 {
+			delete(positions, stop.PerpetualId)
+		}
+	}
+	return raw, protected, nil
+}
@@ -0,0 +1,144 @@
+package lib_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+	perp_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRiskForSubaccountWithProtectiveStops(t *testing.T) {
+	// One quantum has a notional of 100 quote quantums at the market price of perpetual 1 and 200 quote
+	// quantums at the market price of perpetual 2, both with an initial margin fraction of 10% and a
+	// maintenance margin fraction of 5%.
+	perpInfos := perptypes.PerpInfos{
+		1: perp_testutil.CreatePerpInfo(1, -6, 100, 0),
+		2: perp_testutil.CreatePerpInfo(2, -6, 200, 0),
+	}
+	longRisk := margin.Risk{NC: big.NewInt(5_000), IMR: big.NewInt(1_000), MMR: big.NewInt(500)}
+	closedRisk := margin.Risk{NC: big.NewInt(3_000), IMR: big.NewInt(0), MMR: big.NewInt(0)}
+
+	tests := map[string]struct {
+		perpetualPositions []*types.PerpetualPosition
+		usdcBalance        int64
+		stops              []lib.ProtectiveStop
+
+		expectedRaw       margin.Risk
+		expectedProtected margin.Risk
+		expectedErr       error
+	}{
+		"long without a protective stop": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			},
+			usdcBalance:       -5_000,
+			expectedRaw:       longRisk,
+			expectedProtected: longRisk,
+		},
+		"long with a protective stop below the market price": {
+			// The long is closed at 80 for 8,000.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			},
+			usdcBalance: -5_000,
+			stops: []lib.ProtectiveStop{
+				{PerpetualId: 1, Quantums: big.NewInt(100), TriggerPrice: 80},
+			},
+			expectedRaw:       longRisk,
+			expectedProtected: closedRisk,
+		},
+		"long with a protective stop for part of the position": {
+			// 40 quantums are closed at 80 for 3,200, and the remaining 60 quantums are valued at 6,000.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			},
+			usdcBalance: -5_000,
+			stops: []lib.ProtectiveStop{
+				{PerpetualId: 1, Quantums: big.NewInt(40), TriggerPrice: 80},
+			},
+			expectedRaw:       longRisk,
+			expectedProtected: margin.Risk{NC: big.NewInt(4_200), IMR: big.NewInt(600), MMR: big.NewInt(300)},
+		},
+		"protective stops larger than the position close it once": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			},
+			usdcBalance: -5_000,
+			stops: []lib.ProtectiveStop{
+				{PerpetualId: 1, Quantums: big.NewInt(150), TriggerPrice: 80},
+				{PerpetualId: 1, Quantums: big.NewInt(100), TriggerPrice: 50},
+			},
+			expectedRaw:       longRisk,
+			expectedProtected: closedRisk,
+		},
+		"short with a protective stop above the market price": {
+			// The short is closed at 120 for 12,000 from its quote balance.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(-100), big.NewInt(0), big.NewInt(15_000)),
+			},
+			stops: []lib.ProtectiveStop{
+				{PerpetualId: 1, Quantums: big.NewInt(100), TriggerPrice: 120},
+			},
+			expectedRaw:       longRisk,
+			expectedProtected: closedRisk,
+		},
+		"protective stop crossed by the market price closes at the market price": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			},
+			usdcBalance: -5_000,
+			stops: []lib.ProtectiveStop{
+				{PerpetualId: 1, Quantums: big.NewInt(100), TriggerPrice: 120},
+			},
+			expectedRaw:       longRisk,
+			expectedProtected: margin.Risk{NC: big.NewInt(5_000), IMR: big.NewInt(0), MMR: big.NewInt(0)},
+		},
+		"protective stop in a perpetual without a position is ignored": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			},
+			usdcBalance: -5_000,
+			stops: []lib.ProtectiveStop{
+				{PerpetualId: 2, Quantums: big.NewInt(100), TriggerPrice: 150},
+			},
+			expectedRaw:       longRisk,
+			expectedProtected: longRisk,
+		},
+		"protective stop with non-positive quantums": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			},
+			usdcBalance: -5_000,
+			stops: []lib.ProtectiveStop{
+				{PerpetualId: 1, Quantums: big.NewInt(0), TriggerPrice: 80},
+			},
+			expectedErr: types.ErrInvalidProtectiveStop,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			subaccount := types.Subaccount{
+				PerpetualPositions: tc.perpetualPositions,
+			}
+			if tc.usdcBalance != 0 {
+				subaccount.AssetPositions = testutil.CreateUsdcAssetPositions(big.NewInt(tc.usdcBalance))
+			}
+
+			raw, protected, err := lib.GetRiskForSubaccountWithProtectiveStops(subaccount, perpInfos, tc.stops)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedRaw.String(), raw.String())
+			require.Equal(t, tc.expectedProtected.String(), protected.String())
+		})
+	}
+}
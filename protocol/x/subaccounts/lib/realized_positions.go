@@ -0,0 +1,59 @@
+package lib
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetRiskWithRealizedPositions returns the risk of the subaccount after the updates in `settledUpdate`
+// are applied and the positions in the perpetuals in `realized` are realized at the given PnL in quote
+// quantums. Each realized position is removed along with its quote balance, and its PnL is credited to
+// the USDC asset position of the subaccount. The remaining positions are margined at their market
+// prices as usual.
+//
+// Returns `ErrPerpPositionNotFound` if the subaccount has no position in a perpetual in `realized`.
+func GetRiskWithRealizedPositions(
+	settledUpdate types.SettledUpdate,
+	perpInfos perptypes.PerpInfos,
+	realized map[uint32]*big.Int,
+) (
+	risk margin.Risk,
+	err error,
+) {
+	subaccount := CalculateUpdatedSubaccount(settledUpdate, perpInfos)
+
+	realizeUpdate := types.SettledUpdate{SettledSubaccount: subaccount}
+	bigRealizedPnl := new(big.Int)
+	for _, perpetualId := range lib.GetSortedKeys[lib.Sortable[uint32]](realized) {
+		position, exists := subaccount.GetPerpetualPositionForId(perpetualId)
+		if !exists {
+			return risk, errorsmod.Wrapf(
+				types.ErrPerpPositionNotFound,
+				"subaccount id: %v, perpetual id: %d",
+				subaccount.Id,
+				perpetualId,
+			)
+		}
+		quantums := position.GetBigQuantums()
+		realizeUpdate.PerpetualUpdates = append(realizeUpdate.PerpetualUpdates, types.PerpetualUpdate{
+			PerpetualId:          perpetualId,
+			BigQuantumsDelta:     quantums.Neg(quantums),
+			BigQuoteBalanceDelta: new(big.Int).Neg(position.GetQuoteBalance()),
+		})
+		bigRealizedPnl.Add(bigRealizedPnl, realized[perpetualId])
+	}
+	realizeUpdate.AssetUpdates = []types.AssetUpdate{
+		{
+			AssetId:          assettypes.AssetUsdc.Id,
+			BigQuantumsDelta: bigRealizedPnl,
+		},
+	}
+
+	return GetRiskForSubaccount(CalculateUpdatedSubaccount(realizeUpdate, perpInfos), perpInfos)
+}
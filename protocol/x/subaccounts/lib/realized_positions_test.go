@@ -0,0 +1,93 @@
+package lib_test
+
+import (
+	"math/big"
+	"testing"
+
+	perp_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRiskWithRealizedPositions(t *testing.T) {
+	// One quantum of perpetual 1 has a notional of 100 and one quantum of perpetual 2 a notional of 200,
+	// both with an initial margin fraction of 10% and a maintenance margin fraction of 5%.
+	perpInfos := perptypes.PerpInfos{
+		1: perp_testutil.CreatePerpInfo(1, -6, 100, 0),
+		2: perp_testutil.CreatePerpInfo(2, -6, 200, 0),
+	}
+	// A long in perpetual 1 and a short in perpetual 2, each with a net collateral of 1,000 and margin
+	// requirements of 1,000 (IMR) and 500 (MMR), and 1,000 of USDC.
+	subaccount := types.Subaccount{
+		Id:             &types.SubaccountId{Owner: "test", Number: 0},
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(1_000)),
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(-9_000)),
+			testutil.CreateSinglePerpetualPosition(2, big.NewInt(-50), big.NewInt(0), big.NewInt(11_000)),
+		},
+	}
+
+	tests := map[string]struct {
+		assetUpdates []types.AssetUpdate
+		realized     map[uint32]*big.Int
+
+		expectedNC  *big.Int
+		expectedIMR *big.Int
+		expectedMMR *big.Int
+		expectedErr error
+	}{
+		"no realized positions": {
+			realized:    map[uint32]*big.Int{},
+			expectedNC:  big.NewInt(3_000),
+			expectedIMR: big.NewInt(2_000),
+			expectedMMR: big.NewInt(1_000),
+		},
+		"one of two positions is realized": {
+			// The long is realized at a PnL of 500 instead of its current value of 1,000 and no longer
+			// requires margin.
+			realized:    map[uint32]*big.Int{1: big.NewInt(500)},
+			expectedNC:  big.NewInt(1_000 + 500 + 1_000),
+			expectedIMR: big.NewInt(1_000),
+			expectedMMR: big.NewInt(500),
+		},
+		"realized at a loss after an update": {
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: assettypes.AssetUsdc.Id, BigQuantumsDelta: big.NewInt(-400)},
+			},
+			realized:    map[uint32]*big.Int{2: big.NewInt(-200)},
+			expectedNC:  big.NewInt(600 + 1_000 - 200),
+			expectedIMR: big.NewInt(1_000),
+			expectedMMR: big.NewInt(500),
+		},
+		"no position in a realized perpetual": {
+			realized:    map[uint32]*big.Int{3: big.NewInt(500)},
+			expectedErr: types.ErrPerpPositionNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			settledUpdate := types.SettledUpdate{
+				SettledSubaccount: subaccount,
+				AssetUpdates:      tc.assetUpdates,
+			}
+			risk, err := lib.GetRiskWithRealizedPositions(settledUpdate, perpInfos, tc.realized)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedNC.String(), risk.NC.String())
+			require.Equal(t, tc.expectedIMR.String(), risk.IMR.String())
+			require.Equal(t, tc.expectedMMR.String(), risk.MMR.String())
+
+			// The subaccount is not modified.
+			require.Equal(t, big.NewInt(100), subaccount.PerpetualPositions[0].GetBigQuantums())
+			require.Equal(t, big.NewInt(-50), subaccount.PerpetualPositions[1].GetBigQuantums())
+		})
+	}
+}
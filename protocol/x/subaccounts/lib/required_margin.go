@@ -0,0 +1,42 @@
+package lib
+
+import (
+	"math/big"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// RequiredMarginForOrders returns the increase in the initial margin requirement of the subaccount
+// from filling all of `legs` together, after the updates in `settledUpdate` are applied. The legs are
+// applied one after another, so legs in the same perpetual net against each other and against the
+// existing position. Legs that reduce the initial margin requirement overall require no margin, so
+// the result is never negative.
+//
+// All perpetuals referenced by the subaccount and the legs must be in `perpInfos`.
+func RequiredMarginForOrders(
+	settledUpdate types.SettledUpdate,
+	perpInfos perptypes.PerpInfos,
+	legs []types.PerpetualUpdate,
+) (
+	requiredMargin *big.Int,
+	err error,
+) {
+	subaccount := CalculateUpdatedSubaccount(settledUpdate, perpInfos)
+	currentRisk, err := GetRiskForSubaccount(subaccount, perpInfos)
+	if err != nil {
+		return nil, err
+	}
+
+	withLegs := types.SettledUpdate{
+		SettledSubaccount: subaccount,
+		PerpetualUpdates:  legs,
+	}
+	newRisk, err := GetRiskForSubaccount(CalculateUpdatedSubaccount(withLegs, perpInfos), perpInfos)
+	if err != nil {
+		return nil, err
+	}
+
+	return lib.BigMax(new(big.Int).Sub(newRisk.IMR, currentRisk.IMR), new(big.Int)), nil
+}
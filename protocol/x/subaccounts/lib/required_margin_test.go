@@ -0,0 +1,94 @@
+package lib_test
+
+import (
+	"math/big"
+	"testing"
+
+	perp_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequiredMarginForOrders(t *testing.T) {
+	subaccountId := types.SubaccountId{Owner: "test", Number: 1}
+	// One quantum has a notional of 100 quote quantums in perpetual 1 and 200 quote quantums in
+	// perpetual 2, both with an initial margin fraction of 10%.
+	perpInfos := perptypes.PerpInfos{
+		1: perp_testutil.CreatePerpInfo(1, -6, 100, 0),
+		2: perp_testutil.CreatePerpInfo(2, -6, 200, 0),
+	}
+	tests := map[string]struct {
+		perpetualPositions []*types.PerpetualPosition
+		perpetualUpdates   []types.PerpetualUpdate
+		legs               []types.PerpetualUpdate
+
+		expectedRequiredMargin *big.Int
+	}{
+		"no legs": {
+			expectedRequiredMargin: big.NewInt(0),
+		},
+		"netting pair in the same perpetual": {
+			legs: []types.PerpetualUpdate{
+				{PerpetualId: 1, BigQuantumsDelta: big.NewInt(100)},
+				{PerpetualId: 1, BigQuantumsDelta: big.NewInt(-60)},
+			},
+			// IMR = 10% * 40 * 100, rather than 10% * (100 + 60) * 100 for the legs separately.
+			expectedRequiredMargin: big.NewInt(400),
+		},
+		"fully netting pair in the same perpetual": {
+			legs: []types.PerpetualUpdate{
+				{PerpetualId: 1, BigQuantumsDelta: big.NewInt(100)},
+				{PerpetualId: 1, BigQuantumsDelta: big.NewInt(-100)},
+			},
+			expectedRequiredMargin: big.NewInt(0),
+		},
+		"non-netting pair in different perpetuals": {
+			legs: []types.PerpetualUpdate{
+				{PerpetualId: 1, BigQuantumsDelta: big.NewInt(100)},
+				{PerpetualId: 2, BigQuantumsDelta: big.NewInt(-50)},
+			},
+			// IMR = 10% * 100 * 100 + 10% * 50 * 200.
+			expectedRequiredMargin: big.NewInt(2_000),
+		},
+		"legs net against the existing position": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(-100), big.NewInt(0), big.NewInt(0)),
+			},
+			legs: []types.PerpetualUpdate{
+				{PerpetualId: 1, BigQuantumsDelta: big.NewInt(150)},
+			},
+			// IMR goes from 10% * 100 * 100 to 10% * 50 * 100.
+			expectedRequiredMargin: big.NewInt(0),
+		},
+		"legs net against pending updates": {
+			perpetualUpdates: []types.PerpetualUpdate{
+				{PerpetualId: 1, BigQuantumsDelta: big.NewInt(100)},
+			},
+			legs: []types.PerpetualUpdate{
+				{PerpetualId: 1, BigQuantumsDelta: big.NewInt(-300)},
+			},
+			// IMR goes from 10% * 100 * 100 to 10% * 200 * 100.
+			expectedRequiredMargin: big.NewInt(1_000),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			settledUpdate := types.SettledUpdate{
+				SettledSubaccount: types.Subaccount{
+					Id:                 &subaccountId,
+					PerpetualPositions: tc.perpetualPositions,
+					AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(10_000)),
+				},
+				PerpetualUpdates: tc.perpetualUpdates,
+			}
+
+			requiredMargin, err := lib.RequiredMarginForOrders(settledUpdate, perpInfos, tc.legs)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedRequiredMargin, requiredMargin)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package lib
+
+import (
+	"math/big"
+
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// QuantumsToCloseToRestoreInitialMargin returns the smallest number of base quantums of the subaccount's
+// position in the perpetual that must be closed for the subaccount to be initially collateralized (i.e.
+// NC >= IMR), after the updates in `settledUpdate` are applied. The position is closed at the market price
+// of the perpetual, so closing does not change the net collateral of the subaccount and only reduces its
+// initial margin requirement. The open interest of the perpetual is assumed to be unchanged.
+//
+// Returns zero if the subaccount is already initially collateralized, and false if it is not initially
+// collateralized even with the position fully closed or if it has no position in the perpetual.
+func QuantumsToCloseToRestoreInitialMargin(
+	settledUpdate types.SettledUpdate,
+	perpInfos perptypes.PerpInfos,
+	perpetualId uint32,
+) (
+	quantums *big.Int,
+	restored bool,
+	err error,
+) {
+	perpInfo := perpInfos.MustGet(perpetualId)
+	subaccount := CalculateUpdatedSubaccount(settledUpdate, perpInfos)
+
+	positionQuantums := new(big.Int)
+	for _, pos := range subaccount.PerpetualPositions {
+		if pos.PerpetualId == perpetualId {
+			positionQuantums = pos.GetBigQuantums()
+		}
+	}
+
+	isCollateralizedAfterClosing := func(q *big.Int) (bool, error) {
+		closed := new(big.Int).Set(q)
+		notional, err := PositionNotional(closed, perpInfo)
+		if err != nil {
+			return false, err
+		}
+		// Closing a short position buys it back.
+		if positionQuantums.Sign() < 0 {
+			closed.Neg(closed)
+			notional.Neg(notional)
+		}
+		closeUpdate := types.SettledUpdate{
+			SettledSubaccount: subaccount,
+			PerpetualUpdates: []types.PerpetualUpdate{
+				{
+					PerpetualId:          perpetualId,
+					BigQuantumsDelta:     new(big.Int).Neg(closed),
+					BigFillQuoteQuantums: new(big.Int).Abs(notional),
+				},
+			},
+			AssetUpdates: []types.AssetUpdate{
+				{
+					AssetId:          assettypes.AssetUsdc.Id,
+					BigQuantumsDelta: notional,
+				},
+			},
+		}
+		risk, err := GetRiskForSubaccount(CalculateUpdatedSubaccount(closeUpdate, perpInfos), perpInfos)
+		if err != nil {
+			return false, err
+		}
+		return risk.IsInitialCollateralized(), nil
+	}
+
+	collateralized, err := isCollateralizedAfterClosing(new(big.Int))
+	if err != nil {
+		return nil, false, err
+	}
+	if collateralized {
+		return new(big.Int), true, nil
+	}
+	if positionQuantums.Sign() == 0 {
+		return nil, false, nil
+	}
+
+	collateralizedQuantums := new(big.Int).Abs(positionQuantums)
+	if collateralized, err = isCollateralizedAfterClosing(collateralizedQuantums); err != nil {
+		return nil, false, err
+	}
+	if !collateralized {
+		return nil, false, nil
+	}
+
+	// The initial margin requirement decreases as the position is closed while the net collateral is
+	// unchanged, so binary search for the smallest close that makes the subaccount collateralized.
+	uncollateralizedQuantums := new(big.Int)
+	one := big.NewInt(1)
+	for new(big.Int).Sub(collateralizedQuantums, uncollateralizedQuantums).Cmp(one) > 0 {
+		mid := new(big.Int).Add(uncollateralizedQuantums, collateralizedQuantums)
+		mid.Rsh(mid, 1)
+		collateralized, err := isCollateralizedAfterClosing(mid)
+		if err != nil {
+			return nil, false, err
+		}
+		if collateralized {
+			collateralizedQuantums = mid
+		} else {
+			uncollateralizedQuantums = mid
+		}
+	}
+	return collateralizedQuantums, true, nil
+}
@@ -0,0 +1,123 @@
+package lib_test
+
+import (
+	"math/big"
+	"testing"
+
+	perp_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuantumsToCloseToRestoreInitialMargin(t *testing.T) {
+	// One quantum has a notional of 100 quote quantums in perpetual 1 and 200 quote quantums in
+	// perpetual 2. Both have an initial margin requirement of 10%.
+	perpInfos := perptypes.PerpInfos{
+		1: perp_testutil.CreatePerpInfo(1, -6, 100, 0),
+		2: perp_testutil.CreatePerpInfo(2, -6, 200, 0),
+	}
+	tests := map[string]struct {
+		perpetualPositions []*types.PerpetualPosition
+		usdcBalance        int64
+		assetUpdates       []types.AssetUpdate
+
+		expectedQuantums *big.Int
+		expectedRestored bool
+	}{
+		"already initially collateralized": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			},
+			usdcBalance:      -9_000,
+			expectedQuantums: big.NewInt(0),
+			expectedRestored: true,
+		},
+		"partially close a long": {
+			// NC of $500 covers the IMR of 50 quantums.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			},
+			usdcBalance:      -9_500,
+			expectedQuantums: big.NewInt(50),
+			expectedRestored: true,
+		},
+		"partially close a short": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(-100), big.NewInt(0), big.NewInt(0)),
+			},
+			usdcBalance:      10_700,
+			expectedQuantums: big.NewInt(30),
+			expectedRestored: true,
+		},
+		"close rounds up to a whole quantum": {
+			// NC of $550 covers the IMR of 55 quantums.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			},
+			usdcBalance:      -9_451,
+			expectedQuantums: big.NewInt(46),
+			expectedRestored: true,
+		},
+		"fully closing is required": {
+			// The IMR of $400 of the position in perpetual 2 remains.
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(20), big.NewInt(0), big.NewInt(0)),
+			},
+			usdcBalance:      -13_600,
+			expectedQuantums: big.NewInt(100),
+			expectedRestored: true,
+		},
+		"fully closing is not enough": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(20), big.NewInt(0), big.NewInt(0)),
+			},
+			usdcBalance:      -13_700,
+			expectedRestored: false,
+		},
+		"no position": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(20), big.NewInt(0), big.NewInt(0)),
+			},
+			usdcBalance:      -3_800,
+			expectedRestored: false,
+		},
+		"after a pending withdrawal": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			},
+			usdcBalance: -9_000,
+			assetUpdates: []types.AssetUpdate{
+				{AssetId: assettypes.AssetUsdc.Id, BigQuantumsDelta: big.NewInt(-800)},
+			},
+			expectedQuantums: big.NewInt(80),
+			expectedRestored: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			settledUpdate := types.SettledUpdate{
+				SettledSubaccount: types.Subaccount{
+					Id:                 &types.SubaccountId{Owner: "test", Number: 1},
+					PerpetualPositions: tc.perpetualPositions,
+					AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(tc.usdcBalance)),
+				},
+				AssetUpdates: tc.assetUpdates,
+			}
+			quantums, restored, err := lib.QuantumsToCloseToRestoreInitialMargin(settledUpdate, perpInfos, 1)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedRestored, restored)
+			if tc.expectedRestored {
+				require.Equal(t, 0, tc.expectedQuantums.Cmp(quantums))
+			} else {
+				require.Nil(t, quantums)
+			}
+		})
+	}
+}
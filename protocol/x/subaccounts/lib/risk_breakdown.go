@@ -0,0 +1,87 @@
+package lib
+
+import (
+	"math/big"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+	assetslib "github.com/dydxprotocol/v4-chain/protocol/x/assets/lib"
+	perplib "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/lib"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetRiskBreakdownForSubaccount returns the risk of each position of the `Subaccount` along with the
+// total risk of the subaccount. The input subaccount must be settled.
+func GetRiskBreakdownForSubaccount(
+	subaccount types.Subaccount,
+	perpInfos perptypes.PerpInfos,
+) (
+	breakdown types.RiskBreakdown,
+	err error,
+) {
+	breakdown = types.RiskBreakdown{
+		Total:              margin.ZeroRisk(),
+		AssetPositions:     make(map[uint32]margin.Risk, len(subaccount.AssetPositions)),
+		PerpetualPositions: make(map[uint32]types.PerpetualPositionRisk, len(subaccount.PerpetualPositions)),
+	}
+
+	for _, pos := range subaccount.AssetPositions {
+		r, err := assetslib.GetNetCollateralAndMarginRequirements(
+			pos.AssetId,
+			pos.GetBigQuantums(),
+		)
+		if err != nil {
+			return breakdown, err
+		}
+		breakdown.AssetPositions[pos.AssetId] = r
+		breakdown.Total.AddInPlace(r)
+	}
+
+	for _, pos := range subaccount.PerpetualPositions {
+		perpInfo := perpInfos.MustGet(pos.PerpetualId)
+		r, err := PositionRisk(pos, perpInfo)
+		if err != nil {
+			return breakdown, err
+		}
+		notional := perplib.GetNetNotionalInQuoteQuantums(
+			perpInfo.Perpetual,
+			perpInfo.Price,
+			pos.GetBigQuantums(),
+		)
+		breakdown.PerpetualPositions[pos.PerpetualId] = types.PerpetualPositionRisk{
+			Risk:        r,
+			AbsNotional: notional.Abs(notional),
+		}
+		breakdown.Total.AddInPlace(r)
+	}
+
+	return breakdown, nil
+}
+
+// GetBlendedMarginFraction returns the notional-weighted average maintenance margin fraction of the
+// perpetual positions of the `Subaccount`, i.e. the sum of the maintenance margin requirements of
+// the positions divided by the sum of their absolute notionals. A subaccount with no perpetual
+// notional has a blended margin fraction of zero. The input subaccount must be settled.
+func GetBlendedMarginFraction(
+	subaccount types.Subaccount,
+	perpInfos perptypes.PerpInfos,
+) (
+	fraction *big.Rat,
+	err error,
+) {
+	breakdown, err := GetRiskBreakdownForSubaccount(subaccount, perpInfos)
+	if err != nil {
+		return nil, err
+	}
+
+	totalMMR := new(big.Int)
+	totalNotional := new(big.Int)
+	for _, positionRisk := range breakdown.PerpetualPositions {
+		totalMMR.Add(totalMMR, positionRisk.Risk.MMR)
+		totalNotional.Add(totalNotional, positionRisk.AbsNotional)
+	}
+	if totalNotional.Sign() == 0 {
+		return new(big.Rat), nil
+	}
+	return new(big.Rat).SetFrac(totalMMR, totalNotional), nil
+}
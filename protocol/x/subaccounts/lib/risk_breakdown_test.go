@@ -0,0 +1,125 @@
+package lib_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+	perp_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+// getTwoTierPerpInfos returns perpetual 1 with a maintenance margin fraction of 5% and perpetual 2 with
+// a maintenance margin fraction of 10%.
+func getTwoTierPerpInfos() perptypes.PerpInfos {
+	perpInfo2 := perp_testutil.CreatePerpInfo(2, -6, 200, 0)
+	perpInfo2.LiquidityTier.InitialMarginPpm = 200_000
+	return perptypes.PerpInfos{
+		1: perp_testutil.CreatePerpInfo(1, -6, 100, 0),
+		2: perpInfo2,
+	}
+}
+
+func TestGetRiskBreakdownForSubaccount(t *testing.T) {
+	subaccount := types.Subaccount{
+		Id: &types.SubaccountId{Owner: "test", Number: 1},
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			testutil.CreateSinglePerpetualPosition(2, big.NewInt(-25), big.NewInt(0), big.NewInt(0)),
+		},
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(110)),
+	}
+	perpInfos := getTwoTierPerpInfos()
+
+	breakdown, err := lib.GetRiskBreakdownForSubaccount(subaccount, perpInfos)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		types.RiskBreakdown{
+			Total: margin.Risk{
+				NC:  big.NewInt(110 + 100*100 - 25*200),
+				IMR: big.NewInt(100*100*0.1 + 25*200*0.2),
+				MMR: big.NewInt(100*100*0.05 + 25*200*0.1),
+			},
+			AssetPositions: map[uint32]margin.Risk{
+				assettypes.AssetUsdc.Id: {
+					NC:  big.NewInt(110),
+					IMR: big.NewInt(0),
+					MMR: big.NewInt(0),
+				},
+			},
+			PerpetualPositions: map[uint32]types.PerpetualPositionRisk{
+				1: {
+					Risk: margin.Risk{
+						NC:  big.NewInt(100 * 100),
+						IMR: big.NewInt(100 * 100 * 0.1),
+						MMR: big.NewInt(100 * 100 * 0.05),
+					},
+					AbsNotional: big.NewInt(100 * 100),
+				},
+				2: {
+					Risk: margin.Risk{
+						NC:  big.NewInt(-25 * 200),
+						IMR: big.NewInt(25 * 200 * 0.2),
+						MMR: big.NewInt(25 * 200 * 0.1),
+					},
+					AbsNotional: big.NewInt(25 * 200),
+				},
+			},
+		},
+		breakdown,
+	)
+
+	// The total risk is the risk of the subaccount.
+	risk, err := lib.GetRiskForSubaccount(subaccount, perpInfos)
+	require.NoError(t, err)
+	require.Equal(t, risk, breakdown.Total)
+}
+
+func TestGetBlendedMarginFraction(t *testing.T) {
+	tests := map[string]struct {
+		perpetualPositions []*types.PerpetualPosition
+		expectedFraction   *big.Rat
+	}{
+		"no perpetual positions": {
+			expectedFraction: new(big.Rat),
+		},
+		"one perpetual position": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(-25), big.NewInt(0), big.NewInt(0)),
+			},
+			expectedFraction: big.NewRat(1, 10),
+		},
+		"two perpetual positions at different tiers": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(-25), big.NewInt(0), big.NewInt(0)),
+			},
+			// (500 + 500) / (10,000 + 5,000)
+			expectedFraction: big.NewRat(1, 15),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			subaccount := types.Subaccount{
+				Id:                 &types.SubaccountId{Owner: "test", Number: 1},
+				PerpetualPositions: tc.perpetualPositions,
+				AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(1_000)),
+			}
+			fraction, err := lib.GetBlendedMarginFraction(subaccount, getTwoTierPerpInfos())
+			require.NoError(t, err)
+			require.Equal(t, 0, tc.expectedFraction.Cmp(fraction), "expected %v, got %v", tc.expectedFraction, fraction)
+
+			if len(tc.perpetualPositions) == 2 {
+				// The blended fraction is between the maintenance margin fractions of the two tiers.
+				require.Equal(t, 1, fraction.Cmp(big.NewRat(5, 100)))
+				require.Equal(t, -1, fraction.Cmp(big.NewRat(10, 100)))
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package lib
+
+import (
+	"math/big"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+)
+
+// StakedTokenCollateral returns the value in quote quantums of `stakedAmount` staked tokens when used as
+// collateral. The tokens are valued at `price`, the market price of the staking token, and the value is
+// reduced by `haircutPpm` parts-per-million, rounding down. `atomicResolution` is the exponent converting
+// the staked amount to whole tokens. Negative prices value the tokens at zero.
+func StakedTokenCollateral(
+	stakedAmount *big.Int,
+	atomicResolution int32,
+	price pricestypes.MarketPrice,
+	haircutPpm uint32,
+) *big.Int {
+	if price.Negative || stakedAmount.Sign() <= 0 || haircutPpm >= lib.OneMillion {
+		return new(big.Int)
+	}
+	value := lib.BaseToQuoteQuantums(stakedAmount, atomicResolution, price.Price, price.Exponent)
+	return lib.BigIntMulPpm(value, lib.OneMillion-haircutPpm)
+}
@@ -0,0 +1,71 @@
+package lib_test
+
+import (
+	"math/big"
+	"testing"
+
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStakedTokenCollateral(t *testing.T) {
+	// $3,000 per whole token.
+	price := pricestypes.MarketPrice{Id: 1, Exponent: -6, Price: 3_000_000_000}
+	tests := map[string]struct {
+		stakedAmount *big.Int
+		price        pricestypes.MarketPrice
+		haircutPpm   uint32
+
+		expectedCollateral *big.Int
+	}{
+		"no haircut": {
+			stakedAmount:       big.NewInt(10_000_000_000), // 10 tokens
+			price:              price,
+			haircutPpm:         0,
+			expectedCollateral: big.NewInt(30_000_000_000), // $30,000
+		},
+		"50% haircut": {
+			stakedAmount:       big.NewInt(10_000_000_000),
+			price:              price,
+			haircutPpm:         500_000,
+			expectedCollateral: big.NewInt(15_000_000_000),
+		},
+		"haircut rounds down": {
+			stakedAmount:       big.NewInt(1), // 3 quote quantums
+			price:              price,
+			haircutPpm:         500_000,
+			expectedCollateral: big.NewInt(1),
+		},
+		"full haircut": {
+			stakedAmount:       big.NewInt(10_000_000_000),
+			price:              price,
+			haircutPpm:         1_000_000,
+			expectedCollateral: big.NewInt(0),
+		},
+		"nothing staked": {
+			stakedAmount:       big.NewInt(0),
+			price:              price,
+			haircutPpm:         500_000,
+			expectedCollateral: big.NewInt(0),
+		},
+		"negative price": {
+			stakedAmount: big.NewInt(10_000_000_000),
+			price: pricestypes.MarketPrice{
+				Id:       1,
+				Exponent: -6,
+				Price:    3_000_000_000,
+				Negative: true,
+			},
+			haircutPpm:         500_000,
+			expectedCollateral: big.NewInt(0),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			collateral := lib.StakedTokenCollateral(tc.stakedAmount, -9, tc.price, tc.haircutPpm)
+			require.Equal(t, 0, tc.expectedCollateral.Cmp(collateral))
+		})
+	}
+}
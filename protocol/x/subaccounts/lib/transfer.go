@@ -0,0 +1,85 @@
+package lib
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// CanTransfer returns whether `amount` quote quantums of USDC can be transferred from the subaccount of
+// `from` to the subaccount of `to`, after the updates in `from` and `to` are applied, along with the result
+// of the transfer for each subaccount. The transfer is valid for a subaccount if it is initially
+// collateralized after the transfer, or if the transfer is a valid state transition for an
+// undercollateralized subaccount as determined by `IsValidStateTransitionForUndercollateralizedSubaccount`.
+//
+// Returns `ErrAssetTransferQuantumsNotPositive` if `amount` is not positive.
+func CanTransfer(
+	from types.SettledUpdate,
+	to types.SettledUpdate,
+	amount *big.Int,
+	perpInfos perptypes.PerpInfos,
+) (
+	success bool,
+	fromResult types.UpdateResult,
+	toResult types.UpdateResult,
+	err error,
+) {
+	if amount.Sign() <= 0 {
+		return false, types.UpdateCausedError, types.UpdateCausedError, errorsmod.Wrapf(
+			types.ErrAssetTransferQuantumsNotPositive,
+			"amount: %v",
+			amount,
+		)
+	}
+
+	fromResult, err = getTransferResult(from, new(big.Int).Neg(amount), perpInfos)
+	if err != nil {
+		return false, types.UpdateCausedError, types.UpdateCausedError, err
+	}
+	toResult, err = getTransferResult(to, amount, perpInfos)
+	if err != nil {
+		return false, types.UpdateCausedError, types.UpdateCausedError, err
+	}
+	return fromResult.IsSuccess() && toResult.IsSuccess(), fromResult, toResult, nil
+}
+
+// getTransferResult returns the result of changing the USDC position of the subaccount by `delta` quote
+// quantums after the updates in `settledUpdate` are applied.
+func getTransferResult(
+	settledUpdate types.SettledUpdate,
+	delta *big.Int,
+	perpInfos perptypes.PerpInfos,
+) (
+	result types.UpdateResult,
+	err error,
+) {
+	subaccount := CalculateUpdatedSubaccount(settledUpdate, perpInfos)
+	transferred := CalculateUpdatedSubaccount(
+		types.SettledUpdate{
+			SettledSubaccount: subaccount,
+			AssetUpdates: []types.AssetUpdate{
+				{
+					AssetId:          assettypes.AssetUsdc.Id,
+					BigQuantumsDelta: delta,
+				},
+			},
+		},
+		perpInfos,
+	)
+
+	riskNew, err := GetRiskForSubaccount(transferred, perpInfos)
+	if err != nil {
+		return types.UpdateCausedError, err
+	}
+	if riskNew.IsInitialCollateralized() {
+		return types.Success, nil
+	}
+	riskCur, err := GetRiskForSubaccount(subaccount, perpInfos)
+	if err != nil {
+		return types.UpdateCausedError, err
+	}
+	return IsValidStateTransitionForUndercollateralizedSubaccount(riskCur, riskNew), nil
+}
@@ -0,0 +1,118 @@
+package lib_test
+
+import (
+	"math/big"
+	"testing"
+
+	perp_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanTransfer(t *testing.T) {
+	// One quantum has a notional of 100 quote quantums, with an IMR of 10% and an MMR of 5%.
+	perpInfos := perptypes.PerpInfos{
+		1: perp_testutil.CreatePerpInfo(1, -6, 100, 0),
+	}
+	// A long of 100 quantums with an NC of 1,500 and an IMR of 1,000.
+	sender := types.Subaccount{
+		Id:             &types.SubaccountId{Owner: "sender", Number: 0},
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-8_500)),
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+		},
+	}
+	tests := map[string]struct {
+		amount          *big.Int
+		senderUpdates   []types.AssetUpdate
+		receiverBalance int64
+		receiverPerps   []*types.PerpetualPosition
+
+		expectedSuccess    bool
+		expectedFromResult types.UpdateResult
+		expectedToResult   types.UpdateResult
+	}{
+		"both stay healthy": {
+			amount:             big.NewInt(500),
+			receiverBalance:    1_000,
+			expectedSuccess:    true,
+			expectedFromResult: types.Success,
+			expectedToResult:   types.Success,
+		},
+		"sender becomes undercollateralized": {
+			amount:             big.NewInt(600),
+			receiverBalance:    1_000,
+			expectedSuccess:    false,
+			expectedFromResult: types.NewlyUndercollateralized,
+			expectedToResult:   types.Success,
+		},
+		"sender falls below maintenance margin": {
+			amount:             big.NewInt(1_100),
+			receiverBalance:    1_000,
+			expectedSuccess:    false,
+			expectedFromResult: types.NewlyBelowMaintenanceMargin,
+			expectedToResult:   types.Success,
+		},
+		"sender becomes undercollateralized after a pending withdrawal": {
+			amount: big.NewInt(500),
+			senderUpdates: []types.AssetUpdate{
+				{AssetId: assettypes.AssetUsdc.Id, BigQuantumsDelta: big.NewInt(-100)},
+			},
+			receiverBalance:    1_000,
+			expectedSuccess:    false,
+			expectedFromResult: types.NewlyUndercollateralized,
+			expectedToResult:   types.Success,
+		},
+		"undercollateralized receiver is improved": {
+			// A short of 100 quantums with an NC of 300, below its MMR of 500.
+			amount:          big.NewInt(100),
+			receiverBalance: 10_300,
+			receiverPerps: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(-100), big.NewInt(0), big.NewInt(0)),
+			},
+			expectedSuccess:    true,
+			expectedFromResult: types.Success,
+			expectedToResult:   types.Success,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			from := types.SettledUpdate{
+				SettledSubaccount: sender,
+				AssetUpdates:      tc.senderUpdates,
+			}
+			to := types.SettledUpdate{
+				SettledSubaccount: types.Subaccount{
+					Id:                 &types.SubaccountId{Owner: "receiver", Number: 0},
+					AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(tc.receiverBalance)),
+					PerpetualPositions: tc.receiverPerps,
+				},
+			}
+			success, fromResult, toResult, err := lib.CanTransfer(from, to, tc.amount, perpInfos)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedSuccess, success)
+			require.Equal(t, tc.expectedFromResult, fromResult)
+			require.Equal(t, tc.expectedToResult, toResult)
+		})
+	}
+}
+
+func TestCanTransfer_NonPositiveAmount(t *testing.T) {
+	perpInfos := perptypes.PerpInfos{}
+	update := types.SettledUpdate{
+		SettledSubaccount: types.Subaccount{
+			Id:             &types.SubaccountId{Owner: "sender", Number: 0},
+			AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(1_000)),
+		},
+	}
+	for _, amount := range []*big.Int{big.NewInt(0), big.NewInt(-1)} {
+		success, _, _, err := lib.CanTransfer(update, update, amount, perpInfos)
+		require.ErrorIs(t, err, types.ErrAssetTransferQuantumsNotPositive)
+		require.False(t, success)
+	}
+}
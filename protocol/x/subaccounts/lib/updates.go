@@ -1,9 +1,11 @@
 package lib
 
 import (
+	"math"
 	"math/big"
 	"sort"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
@@ -33,11 +35,24 @@ func GetSettledSubaccountWithPerpetuals(
 	for _, p := range subaccount.PerpetualPositions {
 		perpInfo := perpInfos.MustGet(p.PerpetualId)
 
+		// Cap the funding index delta of the position, if the perpetual has a cap, so that the funding of a
+		// stale position cannot dominate its collateral. The funding beyond the cap is not settled.
+		positionFundingIndex := p.FundingIndex.BigInt()
+		if perpInfo.MaxUnsettledFundingIndexDelta != 0 {
+			fundingIndex := perpInfo.Perpetual.FundingIndex.BigInt()
+			maxDelta := new(big.Int).SetUint64(perpInfo.MaxUnsettledFundingIndexDelta)
+			positionFundingIndex = lib.BigIntClamp(
+				positionFundingIndex,
+				new(big.Int).Sub(fundingIndex, maxDelta),
+				new(big.Int).Add(fundingIndex, maxDelta),
+			)
+		}
+
 		// Call the stateless utility function to get the net settlement and new funding index.
 		bigNetSettlementPpm, newFundingIndex := perplib.GetSettlementPpmWithPerpetual(
 			perpInfo.Perpetual,
 			p.GetBigQuantums(),
-			p.FundingIndex.BigInt(),
+			positionFundingIndex,
 		)
 		// Record non-zero funding payment (to be later emitted in SubaccountUpdateEvent to indexer).
 		// Note: Funding payment is the negative of settlement, i.e. positive settlement is equivalent
@@ -60,6 +75,7 @@ func GetSettledSubaccountWithPerpetuals(
 				Quantums:     p.Quantums,
 				QuoteBalance: p.QuoteBalance,
 				FundingIndex: dtypes.NewIntFromBigInt(newFundingIndex),
+				CostBasis:    p.CostBasis,
 			},
 		)
 	}
@@ -92,10 +108,11 @@ func GetSettledSubaccountWithPerpetuals(
 // "less-or-equally-risky" state after an update.
 // i.e.`newNetCollateral / newMaintenanceMargin >= curNetCollateral / curMaintenanceMargin`.
 //
-// Otherwise, the state transition is invalid. If the account was previously undercollateralized,
-// `types.StillUndercollateralized` is returned. If the account was previously
-// collateralized and is now undercollateralized, `types.NewlyUndercollateralized` is
-// returned.
+// Otherwise, the state transition is invalid. If the update causes the account's net collateral to
+// fall below its maintenance margin requirement, `types.NewlyBelowMaintenanceMargin` is returned.
+// Otherwise, if the account was previously undercollateralized, `types.StillUndercollateralized` is
+// returned. If the account was previously collateralized and is now undercollateralized,
+// `types.NewlyUndercollateralized` is returned.
 //
 // Note that the inequality `newNetCollateral / newMaintenanceMargin >= curNetCollateral / curMaintenanceMargin`
 // has divide-by-zero issue when margin requirements are zero. To make sure the state
@@ -109,6 +126,11 @@ func IsValidStateTransitionForUndercollateralizedSubaccount(
 	if riskCur.IMR.Cmp(riskCur.NC) <= 0 {
 		underCollateralizationResult = types.NewlyUndercollateralized
 	}
+	// Determine whether the update causes the subaccount to fall below a non-zero maintenance margin
+	// requirement, i.e. become liquidatable.
+	if riskCur.NC.Cmp(riskCur.MMR) >= 0 && riskNew.IsLiquidatable() {
+		underCollateralizationResult = types.NewlyBelowMaintenanceMargin
+	}
 
 	// If the maintenance margin is increasing, then the subaccount is undercollateralized.
 	if riskNew.MMR.Cmp(riskCur.MMR) > 0 {
@@ -139,6 +161,156 @@ func IsValidStateTransitionForUndercollateralizedSubaccount(
 	return types.Success
 }
 
+// IsRiskReducing returns true if the state transition from `riskCur` to `riskNew` does not increase the
+// risk of the subaccount. This is the case if the maintenance margin requirement does not increase and
+// either:
+//   - the net collateral does not decrease, or
+//   - the ratio of net collateral to maintenance margin requirement does not decrease.
+//
+// The second condition allows reducing or closing positions at a loss, as long as the subaccount is no
+// less collateralized relative to its remaining positions.
+func IsRiskReducing(
+	riskCur margin.Risk,
+	riskNew margin.Risk,
+) bool {
+	if riskNew.MMR.Cmp(riskCur.MMR) > 0 {
+		return false
+	}
+	return riskNew.NC.Cmp(riskCur.NC) >= 0 || riskNew.Cmp(riskCur) <= 0
+}
+
+// ExceedsMaxPositionNotional returns true if the update increases a perpetual position of the subaccount
+// to an absolute notional above the `MaxPositionNotionalQuoteQuantums` of the perpetual's liquidity tier.
+// `updatedSubaccount` is the settled subaccount of `settledUpdate` with the update applied.
+//
+// Updates that reduce or close a position are always allowed, even if the position remains above the
+// cap, e.g. after the price of the perpetual has risen. Flipping a position to the other side is not a
+// reduction.
+func ExceedsMaxPositionNotional(
+	settledUpdate types.SettledUpdate,
+	updatedSubaccount types.Subaccount,
+	perpInfos perptypes.PerpInfos,
+) (
+	exceeds bool,
+	err error,
+) {
+	for _, u := range settledUpdate.PerpetualUpdates {
+		perpInfo := perpInfos.MustGet(u.PerpetualId)
+		maxNotional := perpInfo.LiquidityTier.MaxPositionNotionalQuoteQuantums
+		if maxNotional == 0 {
+			continue
+		}
+
+		newPosition, exists := updatedSubaccount.GetPerpetualPositionForId(u.PerpetualId)
+		if !exists {
+			continue
+		}
+		newQuantums := newPosition.GetBigQuantums()
+		if curPosition, exists := settledUpdate.SettledSubaccount.GetPerpetualPositionForId(u.PerpetualId); exists {
+			curQuantums := curPosition.GetBigQuantums()
+			if curQuantums.Sign() == newQuantums.Sign() && newQuantums.CmpAbs(curQuantums) <= 0 {
+				continue
+			}
+		}
+
+		notional, err := PositionNotional(newQuantums, perpInfo)
+		if err != nil {
+			return false, err
+		}
+		if notional.Cmp(lib.BigU(maxNotional)) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ExceedsMaxLeverage returns true if the leverage of the subaccount, the total notional of its perpetual
+// positions divided by its net collateral `nc`, is above `maxLeveragePpm / 1_000_000`. Equivalently, the
+// net collateral is below the initial margin requirement implied by the leverage cap,
+// `notional * 1_000_000 / maxLeveragePpm`. A subaccount with positions and no positive net collateral
+// always exceeds the cap. The input subaccount must be settled.
+func ExceedsMaxLeverage(
+	subaccount types.Subaccount,
+	perpInfos perptypes.PerpInfos,
+	nc *big.Int,
+	maxLeveragePpm uint32,
+) (
+	exceeds bool,
+	err error,
+) {
+	totalNotional := new(big.Int)
+	for _, pos := range subaccount.PerpetualPositions {
+		notional, err := PositionNotional(pos.GetBigQuantums(), perpInfos.MustGet(pos.PerpetualId))
+		if err != nil {
+			return false, err
+		}
+		totalNotional.Add(totalNotional, notional)
+	}
+
+	// notional / nc > maxLeveragePpm / 1_000_000
+	totalNotional.Mul(totalNotional, lib.BigIntOneMillion())
+	return totalNotional.Cmp(new(big.Int).Mul(nc, lib.BigU(maxLeveragePpm))) > 0, nil
+}
+
+// IsOpeningUpdate returns true if the update opens or increases a perpetual position of the subaccount,
+// including flipping a position to the other side. `updatedSubaccount` is the settled subaccount of
+// `settledUpdate` with the update applied.
+func IsOpeningUpdate(
+	settledUpdate types.SettledUpdate,
+	updatedSubaccount types.Subaccount,
+) bool {
+	for _, u := range settledUpdate.PerpetualUpdates {
+		newPosition, exists := updatedSubaccount.GetPerpetualPositionForId(u.PerpetualId)
+		if !exists {
+			continue
+		}
+		newQuantums := newPosition.GetBigQuantums()
+		if newQuantums.Sign() == 0 {
+			continue
+		}
+		if curPosition, exists := settledUpdate.SettledSubaccount.GetPerpetualPositionForId(u.PerpetualId); exists {
+			curQuantums := curPosition.GetBigQuantums()
+			if curQuantums.Sign() == newQuantums.Sign() && newQuantums.CmpAbs(curQuantums) <= 0 {
+				continue
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// PositionNotional returns the absolute notional in quote quantums of a position of `bigQuantums` in the
+// perpetual, valued at its market price in the same way as in the risk of the position.
+func PositionNotional(
+	bigQuantums *big.Int,
+	perpInfo perptypes.PerpInfo,
+) (
+	notional *big.Int,
+	err error,
+) {
+	switch perpInfo.PerpetualType {
+	case perptypes.LinearPerpetual:
+		notional = perplib.GetNetNotionalInQuoteQuantums(perpInfo.Perpetual, perpInfo.Price, bigQuantums)
+	case perptypes.InversePerpetual:
+		if perpInfo.Price.Price == 0 {
+			return nil, errorsmod.Wrapf(
+				perptypes.ErrInversePerpetualPriceIsZero,
+				"perpetual id: %d",
+				perpInfo.Perpetual.Params.Id,
+			)
+		}
+		notional = perplib.GetInverseNetNotionalInQuoteQuantums(perpInfo.Perpetual, perpInfo.Price, bigQuantums)
+	default:
+		return nil, errorsmod.Wrapf(
+			perptypes.ErrInvalidPerpetualType,
+			"perpetual id: %d, perpetual type: %d",
+			perpInfo.Perpetual.Params.Id,
+			perpInfo.PerpetualType,
+		)
+	}
+	return notional.Abs(notional), nil
+}
+
 // GetUpdatedAssetPositions filters out all the asset positions on a subaccount that have
 // been updated. This will include any asset postions that were closed due to an update.
 // TODO(DEC-1295): look into reducing code duplication here using Generics+Reflect.
@@ -286,6 +458,12 @@ func CalculateUpdatedPerpetualPositions(
 		pos, exists := positionsMap[update.PerpetualId]
 		if exists {
 			// Update existing position.
+			costBasis := GetUpdatedCostBasis(
+				pos.GetBigQuantums(),
+				pos.GetBigCostBasis(),
+				update.GetBigQuantums(),
+				update.BigFillQuoteQuantums,
+			)
 			quantums := pos.GetBigQuantums()
 			quantums.Add(quantums, update.GetBigQuantums())
 
@@ -298,6 +476,7 @@ func CalculateUpdatedPerpetualPositions(
 			} else {
 				pos.Quantums = dtypes.NewIntFromBigInt(quantums)
 				pos.QuoteBalance = dtypes.NewIntFromBigInt(quoteBalance)
+				pos.CostBasis = dtypes.NewIntFromBigInt(costBasis)
 			}
 		} else {
 			// Create a new position.
@@ -307,6 +486,14 @@ func CalculateUpdatedPerpetualPositions(
 				Quantums:     dtypes.NewIntFromBigInt(update.GetBigQuantums()),
 				QuoteBalance: dtypes.NewIntFromBigInt(update.GetBigQuoteBalance()),
 				FundingIndex: perpInfo.Perpetual.FundingIndex,
+				CostBasis: dtypes.NewIntFromBigInt(
+					GetUpdatedCostBasis(
+						new(big.Int),
+						new(big.Int),
+						update.GetBigQuantums(),
+						update.BigFillQuoteQuantums,
+					),
+				),
 			}
 		}
 	}
@@ -314,6 +501,63 @@ func CalculateUpdatedPerpetualPositions(
 	return lib.MapToSortedSlice[lib.Sortable[uint32]](positionsMap)
 }
 
+// GetUpdatedCostBasis returns the cost basis of a perpetual position of `quantums` base quantums
+// and cost basis `costBasis` after a fill of `quantumsDelta` base quantums in exchange for
+// `fillQuoteQuantums` quote quantums. A zero cost basis on an open position denotes that the
+// cost basis of the position is unknown.
+//
+// - Increasing the position adds the signed fill notional to the cost basis, so that the average
+// entry price of the position is the weighted average of the fill prices.
+// - Reducing the position scales the cost basis proportionally, leaving the average entry price
+// unchanged.
+// - Flipping the position resets the cost basis to the portion of the fill that opened the
+// position on the new side.
+//
+// If `fillQuoteQuantums` is nil, the cost basis is only preserved when the position is reduced and
+// becomes unknown otherwise.
+func GetUpdatedCostBasis(
+	quantums *big.Int,
+	costBasis *big.Int,
+	quantumsDelta *big.Int,
+	fillQuoteQuantums *big.Int,
+) *big.Int {
+	newQuantums := new(big.Int).Add(quantums, quantumsDelta)
+	if quantumsDelta.Sign() == 0 {
+		return new(big.Int).Set(costBasis)
+	}
+	if newQuantums.Sign() == 0 {
+		return new(big.Int)
+	}
+
+	// Reducing the position without flipping it does not depend on the fill price.
+	isReduce := quantums.Sign()*quantumsDelta.Sign() < 0
+	if isReduce && quantums.Sign() == newQuantums.Sign() {
+		result := new(big.Int).Mul(costBasis, newQuantums)
+		return result.Quo(result, quantums)
+	}
+
+	if fillQuoteQuantums == nil {
+		return new(big.Int)
+	}
+	signedFillQuoteQuantums := new(big.Int).Abs(fillQuoteQuantums)
+	if quantumsDelta.Sign() < 0 {
+		signedFillQuoteQuantums.Neg(signedFillQuoteQuantums)
+	}
+
+	// The position flipped, only the part of the fill beyond closing the position opens the new side.
+	if isReduce {
+		result := new(big.Int).Mul(signedFillQuoteQuantums, newQuantums)
+		return result.Quo(result, quantumsDelta)
+	}
+
+	// The position is opened or increased. If the cost basis of an existing position is unknown,
+	// it remains unknown.
+	if quantums.Sign() != 0 && costBasis.Sign() == 0 {
+		return new(big.Int)
+	}
+	return signedFillQuoteQuantums.Add(signedFillQuoteQuantums, costBasis)
+}
+
 // CalculateUpdatedSubaccount returns a copy of the settled subaccount with the updates applied.
 func CalculateUpdatedSubaccount(
 	settledUpdate types.SettledUpdate,
@@ -339,7 +583,11 @@ func CalculateUpdatedSubaccount(
 // The provided update can also be "zeroed" in order to get information about
 // the current state of the subaccount (i.e. with no changes).
 //
-// If two position updates reference the same position, an error is returned.
+// If two positions reference the same asset or perpetual, `ErrDuplicatePosition` is returned. If the
+// perpetual info of a position is not in `perpInfos`, `ErrPerpetualInfoNotFound` is returned. If the
+// exponent converting the base quantums of a position to quote quantums does not fit in an int32,
+// `ErrOverflow` is returned. If the price of an inverse perpetual not in settlement mode is zero,
+// `ErrPriceMissing` is returned.
 func GetRiskForSubaccount(
 	subaccount types.Subaccount,
 	perpInfos perptypes.PerpInfos,
@@ -351,7 +599,12 @@ func GetRiskForSubaccount(
 	risk = margin.ZeroRisk()
 
 	// Iterate over all assets and updates and calculate change to net collateral and margin requirements.
+	assetIds := make(map[uint32]struct{}, len(subaccount.AssetPositions))
 	for _, pos := range subaccount.AssetPositions {
+		if _, exists := assetIds[pos.AssetId]; exists {
+			return risk, errorsmod.Wrapf(types.ErrDuplicatePosition, "asset id: %d", pos.AssetId)
+		}
+		assetIds[pos.AssetId] = struct{}{}
 		r, err := assetslib.GetNetCollateralAndMarginRequirements(
 			pos.AssetId,
 			pos.GetBigQuantums(),
@@ -363,17 +616,388 @@ func GetRiskForSubaccount(
 	}
 
 	// Iterate over all perpetuals and updates and calculate change to net collateral and margin requirements.
+	perpetualIds := make(map[uint32]struct{}, len(subaccount.PerpetualPositions))
 	for _, pos := range subaccount.PerpetualPositions {
-		perpInfo := perpInfos.MustGet(pos.PerpetualId)
-		r := perplib.GetNetCollateralAndMarginRequirements(
+		if _, exists := perpetualIds[pos.PerpetualId]; exists {
+			return risk, errorsmod.Wrapf(types.ErrDuplicatePosition, "perpetual id: %d", pos.PerpetualId)
+		}
+		perpetualIds[pos.PerpetualId] = struct{}{}
+		perpInfo, exists := perpInfos[pos.PerpetualId]
+		if !exists {
+			return risk, errorsmod.Wrapf(types.ErrPerpetualInfoNotFound, "perpetual id: %d", pos.PerpetualId)
+		}
+		// The exponent converting base quantums to quote quantums is computed as an int32.
+		exponent := int64(perpInfo.Price.Exponent) + int64(perpInfo.Perpetual.Params.AtomicResolution) -
+			int64(lib.QuoteCurrencyAtomicResolution)
+		if exponent < math.MinInt32 || exponent > math.MaxInt32 {
+			return risk, errorsmod.Wrapf(types.ErrOverflow, "perpetual id: %d", pos.PerpetualId)
+		}
+		if perpInfo.PerpetualType == perptypes.InversePerpetual &&
+			perpInfo.Price.Price == 0 &&
+			perpInfo.SettlementPrice == 0 {
+			return risk, errorsmod.Wrapf(types.ErrPriceMissing, "perpetual id: %d", pos.PerpetualId)
+		}
+		r, err := PositionRisk(pos, perpInfo)
+		if err != nil {
+			return risk, err
+		}
+		risk.AddInPlace(r)
+	}
+
+	return risk, nil
+}
+
+// PerpetualCorrelation is the correlation between the prices of two perpetuals, in parts-per-million
+// in [-1,000,000, 1,000,000], used to recognize hedged positions across markets.
+type PerpetualCorrelation struct {
+	PerpetualIdA   uint32
+	PerpetualIdB   uint32
+	CorrelationPpm int32
+}
+
+// GetRiskForSubaccountWithCorrelations returns the risk value of the `Subaccount` with its maintenance
+// margin requirement reduced by the hedge benefit of pairs of positions in correlated perpetuals. A pair
+// of positions is hedged if the positions are on opposite sides and the correlation of their perpetuals
+// is positive, or on the same side and the correlation is negative.
+//
+// The hedge benefit of each hedged pair is `|correlation| * min(MMR_A, MMR_B)`, rounded down, where
+// `MMR_A` and `MMR_B` are the maintenance margin requirements of the two positions not yet used to hedge
+// an earlier pair in `correlations`. The benefit is subtracted from both so the maintenance margin
+// requirement of a position hedges at most once, and the total benefit is subtracted from the
+// maintenance margin requirement of the subaccount. Net collateral and initial margin requirement are
+// not changed. With no correlations there is no hedge benefit, and the risk equals that returned by
+// `GetRiskForSubaccount`. The input subaccount must be settled.
+func GetRiskForSubaccountWithCorrelations(
+	subaccount types.Subaccount,
+	perpInfos perptypes.PerpInfos,
+	correlations []PerpetualCorrelation,
+) (
+	risk margin.Risk,
+	err error,
+) {
+	risk, err = GetRiskForSubaccount(subaccount, perpInfos)
+	if err != nil || len(correlations) == 0 {
+		return risk, err
+	}
+
+	positionMMRs := make(map[uint32]*big.Int, len(subaccount.PerpetualPositions))
+	positionSigns := make(map[uint32]int, len(subaccount.PerpetualPositions))
+	for _, pos := range subaccount.PerpetualPositions {
+		// The positions and their perpetuals were validated by `GetRiskForSubaccount`.
+		r, err := PositionRisk(pos, perpInfos[pos.PerpetualId])
+		if err != nil {
+			return risk, err
+		}
+		positionMMRs[pos.PerpetualId] = r.MMR
+		positionSigns[pos.PerpetualId] = pos.GetBigQuantums().Sign()
+	}
+
+	for _, c := range correlations {
+		mmrA, okA := positionMMRs[c.PerpetualIdA]
+		mmrB, okB := positionMMRs[c.PerpetualIdB]
+		if !okA || !okB || c.PerpetualIdA == c.PerpetualIdB {
+			continue
+		}
+		// The pair is hedged if the positions are on the same side if and only if the correlation is
+		// negative.
+		sameSide := positionSigns[c.PerpetualIdA] == positionSigns[c.PerpetualIdB]
+		if c.CorrelationPpm == 0 || sameSide != (c.CorrelationPpm < 0) {
+			continue
+		}
+		benefit := lib.BigMulPpm(lib.BigMin(mmrA, mmrB), lib.BigU(lib.AbsInt32(c.CorrelationPpm)), false)
+		mmrA.Sub(mmrA, benefit)
+		mmrB.Sub(mmrB, benefit)
+		risk.MMR.Sub(risk.MMR, benefit)
+	}
+	return risk, nil
+}
+
+// PositionRisk returns the net collateral, including the quote balance, and the margin requirements of
+// a single perpetual position in isolation, according to the contract type of the perpetual. The risk
+// of a subaccount is the sum of the risks of its positions, so this is the contribution of the position
+// to the risk of its subaccount. The position must be settled.
+//
+// Positions in a perpetual in settlement mode are valued at the settlement price of the perpetual
+// instead of its market price. Otherwise, if the market price has a non-zero confidence, the
+// maintenance margin requirement is increased by the loss in net collateral of the position at the
+// edge of the confidence interval, `price - confidence` or `price + confidence`, that is adverse to the
+// position. Edges that are not positive are skipped for inverse perpetuals.
+//
+// If the size of the position exceeds the `ThresholdPpm` of the open interest of the perpetual set by
+// its concentration margin, the maintenance margin requirement is also increased by `AddOnPpm` of the
+// notional of the position. Perpetuals have no concentration margin by default.
+func PositionRisk(
+	pos *types.PerpetualPosition,
+	perpInfo perptypes.PerpInfo,
+) (
+	risk margin.Risk,
+	err error,
+) {
+	risk, err = positionRiskAtPrice(pos, perpInfo)
+	if err != nil {
+		return risk, err
+	}
+	concentrationAddOn, err := concentrationMarginAddOn(pos, perpInfo)
+	if err != nil {
+		return risk, err
+	}
+	risk.MMR.Add(risk.MMR, concentrationAddOn)
+	if perpInfo.SettlementPrice != 0 || perpInfo.Price.Confidence == 0 {
+		return risk, nil
+	}
+
+	price := new(big.Int).SetUint64(perpInfo.Price.Price)
+	if perpInfo.Price.Negative {
+		price.Neg(price)
+	}
+	confidence := new(big.Int).SetUint64(perpInfo.Price.Confidence)
+	adverseLoss := new(big.Int)
+	for _, edge := range []*big.Int{
+		new(big.Int).Sub(price, confidence),
+		new(big.Int).Add(price, confidence),
+	} {
+		if perpInfo.PerpetualType == perptypes.InversePerpetual && edge.Sign() <= 0 {
+			continue
+		}
+		edgeInfo := perpInfo
+		absEdge := new(big.Int).Abs(edge)
+		if absEdge.IsUint64() {
+			edgeInfo.Price.Price = absEdge.Uint64()
+		} else {
+			edgeInfo.Price.Price = math.MaxUint64
+		}
+		edgeInfo.Price.Negative = edge.Sign() < 0
+		edgeRisk, err := positionRiskAtPrice(pos, edgeInfo)
+		if err != nil {
+			return risk, err
+		}
+		if loss := new(big.Int).Sub(risk.NC, edgeRisk.NC); loss.Cmp(adverseLoss) > 0 {
+			adverseLoss = loss
+		}
+	}
+	risk.MMR.Add(risk.MMR, adverseLoss)
+	return risk, nil
+}
+
+// concentrationMarginAddOn returns the additional maintenance margin requirement of a position whose size
+// exceeds the concentration threshold of the open interest of its perpetual, rounded up, or zero if the
+// position is not concentrated.
+func concentrationMarginAddOn(
+	pos *types.PerpetualPosition,
+	perpInfo perptypes.PerpInfo,
+) (
+	addOn *big.Int,
+	err error,
+) {
+	concentrationMargin := perpInfo.ConcentrationMargin
+	openInterest := perpInfo.Perpetual.OpenInterest.BigInt()
+	if concentrationMargin.AddOnPpm == 0 || openInterest.Sign() <= 0 {
+		return new(big.Int), nil
+	}
+
+	bigQuantums := pos.GetBigQuantums()
+	// The position is concentrated if `|quantums| / openInterest > thresholdPpm / 1,000,000`.
+	scaledSize := new(big.Int).Mul(new(big.Int).Abs(bigQuantums), lib.BigIntOneMillion())
+	threshold := new(big.Int).Mul(openInterest, lib.BigU(concentrationMargin.ThresholdPpm))
+	if scaledSize.Cmp(threshold) <= 0 {
+		return new(big.Int), nil
+	}
+
+	if perpInfo.SettlementPrice != 0 {
+		perpInfo.Price.Price = perpInfo.SettlementPrice
+		perpInfo.Price.Negative = false
+	}
+	notional, err := PositionNotional(bigQuantums, perpInfo)
+	if err != nil {
+		return nil, err
+	}
+	return lib.BigMulPpm(notional, lib.BigU(concentrationMargin.AddOnPpm), true), nil
+}
+
+// positionRiskAtPrice returns the risk of a single perpetual position at the market price, or the
+// settlement price if the perpetual is in settlement mode, ignoring the confidence of the market price.
+func positionRiskAtPrice(
+	pos *types.PerpetualPosition,
+	perpInfo perptypes.PerpInfo,
+) (
+	risk margin.Risk,
+	err error,
+) {
+	if perpInfo.SettlementPrice != 0 {
+		perpInfo.Price.Price = perpInfo.SettlementPrice
+		perpInfo.Price.Negative = false
+	}
+
+	switch perpInfo.PerpetualType {
+	case perptypes.LinearPerpetual:
+		return perplib.GetNetCollateralAndMarginRequirements(
 			perpInfo.Perpetual,
 			perpInfo.Price,
 			perpInfo.LiquidityTier,
 			pos.GetBigQuantums(),
 			pos.GetQuoteBalance(),
+		), nil
+	case perptypes.InversePerpetual:
+		// The notional of an inverse position is `quantums / price`, which is undefined at a price of zero.
+		if perpInfo.Price.Price == 0 {
+			return risk, errorsmod.Wrapf(
+				perptypes.ErrInversePerpetualPriceIsZero,
+				"perpetual id: %d",
+				pos.PerpetualId,
+			)
+		}
+		risk = perplib.GetInversePositionNetNotionalValueAndMarginRequirements(
+			perpInfo.Perpetual,
+			perpInfo.Price,
+			perpInfo.LiquidityTier,
+			pos.GetBigQuantums(),
+		)
+		risk.NC.Add(risk.NC, pos.GetQuoteBalance())
+		return risk, nil
+	default:
+		return risk, errorsmod.Wrapf(
+			perptypes.ErrInvalidPerpetualType,
+			"perpetual id: %d, perpetual type: %d",
+			pos.PerpetualId,
+			perpInfo.PerpetualType,
+		)
+	}
+}
+
+// GetRiskForSubaccountAtPrices returns the risk value of the `Subaccount` with the market prices of
+// the perpetuals in `prices`, keyed by perpetual id, replaced by the given non-negative prices. The
+// market prices of all other perpetuals are unchanged, and `perpInfos` is not modified. Perpetuals in
+// settlement mode are always valued at their settlement price, so their prices are not replaced. The
+// input subaccount must be settled.
+func GetRiskForSubaccountAtPrices(
+	subaccount types.Subaccount,
+	perpInfos perptypes.PerpInfos,
+	prices map[uint32]uint64,
+) (
+	risk margin.Risk,
+	err error,
+) {
+	infos := make(perptypes.PerpInfos, len(perpInfos))
+	for id, info := range perpInfos {
+		if price, ok := prices[id]; ok {
+			info = withMarketPrice(info, price)
+		}
+		infos[id] = info
+	}
+	return GetRiskForSubaccount(subaccount, infos)
+}
+
+// withMarketPrice returns `perpInfo` with its market price replaced by the non-negative `price`. The
+// market price of a perpetual in settlement mode is not replaced.
+func withMarketPrice(perpInfo perptypes.PerpInfo, price uint64) perptypes.PerpInfo {
+	if perpInfo.SettlementPrice != 0 {
+		return perpInfo
+	}
+	perpInfo.Price.Price = price
+	perpInfo.Price.Negative = false
+	return perpInfo
+}
+
+// GetRiskForSubaccountSubset returns the risk value of the subaccount after the updates in
+// `settledUpdate` are applied, considering only its asset positions and its perpetual positions in the
+// perpetuals in `includePerpetualIds`. All other perpetual positions, including their quote balances,
+// are ignored. This is used to isolate the risk of a subset of the positions of a subaccount.
+func GetRiskForSubaccountSubset(
+	settledUpdate types.SettledUpdate,
+	perpInfos perptypes.PerpInfos,
+	includePerpetualIds []uint32,
+) (
+	risk margin.Risk,
+	err error,
+) {
+	subaccount := CalculateUpdatedSubaccount(settledUpdate, perpInfos)
+	included := make(map[uint32]bool, len(includePerpetualIds))
+	for _, id := range includePerpetualIds {
+		included[id] = true
+	}
+
+	positions := make([]*types.PerpetualPosition, 0, len(subaccount.PerpetualPositions))
+	for _, pos := range subaccount.PerpetualPositions {
+		if included[pos.PerpetualId] {
+			positions = append(positions, pos)
+		}
+	}
+	subaccount.PerpetualPositions = positions
+	return GetRiskForSubaccount(subaccount, perpInfos)
+}
+
+// GetConservativeRiskForSubaccount returns the risk value of the `Subaccount` where the risk of each
+// perpetual position is evaluated at whichever of its market price and the alternate price in
+// `alternatePrices`, keyed by perpetual id, is worse for the subaccount, i.e. results in the lower
+// initial margin collateralization `NC - IMR` of the position. Positions in perpetuals without an
+// alternate price, and positions in perpetuals in settlement mode, use the market price and the
+// settlement price respectively. Alternate prices are non-negative. This is used to check the
+// collateralization of opening orders against a recent mark price so that a single manipulated price
+// source cannot be used to open positions. The input subaccount must be settled.
+func GetConservativeRiskForSubaccount(
+	subaccount types.Subaccount,
+	perpInfos perptypes.PerpInfos,
+	alternatePrices map[uint32]uint64,
+) (
+	risk margin.Risk,
+	err error,
+) {
+	risk = margin.ZeroRisk()
+
+	for _, pos := range subaccount.AssetPositions {
+		r, err := assetslib.GetNetCollateralAndMarginRequirements(
+			pos.AssetId,
+			pos.GetBigQuantums(),
 		)
+		if err != nil {
+			return risk, err
+		}
+		risk.AddInPlace(r)
+	}
+
+	for _, pos := range subaccount.PerpetualPositions {
+		perpInfo := perpInfos.MustGet(pos.PerpetualId)
+		r, err := PositionRisk(pos, perpInfo)
+		if err != nil {
+			return risk, err
+		}
+		alternateInfo := perpInfo
+		if price, ok := alternatePrices[pos.PerpetualId]; ok {
+			alternateInfo = withMarketPrice(perpInfo, price)
+		}
+		if alternateInfo.Price != perpInfo.Price {
+			alternate, err := PositionRisk(pos, alternateInfo)
+			if err != nil {
+				return risk, err
+			}
+			freeCollateral := new(big.Int).Sub(r.NC, r.IMR)
+			if new(big.Int).Sub(alternate.NC, alternate.IMR).Cmp(freeCollateral) < 0 {
+				r = alternate
+			}
+		}
 		risk.AddInPlace(r)
 	}
 
 	return risk, nil
 }
+
+// GetStressedRiskForSubaccount returns the risk value of the `Subaccount` with its maintenance margin
+// requirement scaled by `mmrMultiplierPpm` parts-per-million, rounded up. Net collateral and initial
+// margin requirement are not changed. This is used to find subaccounts that would be liquidatable
+// under stressed margin requirements without changing liquidity tiers. The input subaccount must be
+// settled.
+func GetStressedRiskForSubaccount(
+	subaccount types.Subaccount,
+	perpInfos perptypes.PerpInfos,
+	mmrMultiplierPpm uint32,
+) (
+	risk margin.Risk,
+	err error,
+) {
+	risk, err = GetRiskForSubaccount(subaccount, perpInfos)
+	if err != nil {
+		return risk, err
+	}
+	risk.MMR = lib.BigMulPpm(risk.MMR, lib.BigU(mmrMultiplierPpm), true)
+	return risk, nil
+}
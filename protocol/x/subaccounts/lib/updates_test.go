@@ -1,12 +1,15 @@
 package lib_test
 
 import (
+	"math"
 	"math/big"
 	"testing"
 
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
 	perp_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
 	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
@@ -105,6 +108,247 @@ func TestIsValidStateTransitionForUndercollateralizedSubaccount_ZeroMarginRequir
 	}
 }
 
+func TestIsValidStateTransitionForUndercollateralizedSubaccount_NewlyBelowMaintenanceMargin(t *testing.T) {
+	tests := map[string]struct {
+		oldNC  *big.Int
+		oldIMR *big.Int
+		oldMMR *big.Int
+		newNC  *big.Int
+		newMMR *big.Int
+
+		expectedResult types.UpdateResult
+	}{
+		"initially collateralized account falls below maintenance margin": {
+			oldNC:          big.NewInt(100),
+			oldIMR:         big.NewInt(50),
+			oldMMR:         big.NewInt(25),
+			newNC:          big.NewInt(10),
+			newMMR:         big.NewInt(25),
+			expectedResult: types.NewlyBelowMaintenanceMargin,
+		},
+		"initially undercollateralized account falls below maintenance margin": {
+			oldNC:          big.NewInt(40),
+			oldIMR:         big.NewInt(50),
+			oldMMR:         big.NewInt(25),
+			newNC:          big.NewInt(10),
+			newMMR:         big.NewInt(25),
+			expectedResult: types.NewlyBelowMaintenanceMargin,
+		},
+		"account at maintenance margin falls below maintenance margin": {
+			oldNC:          big.NewInt(25),
+			oldIMR:         big.NewInt(50),
+			oldMMR:         big.NewInt(25),
+			newNC:          big.NewInt(10),
+			newMMR:         big.NewInt(25),
+			expectedResult: types.NewlyBelowMaintenanceMargin,
+		},
+		"account already below maintenance margin falls further below maintenance margin": {
+			oldNC:          big.NewInt(20),
+			oldIMR:         big.NewInt(50),
+			oldMMR:         big.NewInt(25),
+			newNC:          big.NewInt(10),
+			newMMR:         big.NewInt(25),
+			expectedResult: types.StillUndercollateralized,
+		},
+		"initially collateralized account becomes undercollateralized but stays above maintenance margin": {
+			oldNC:          big.NewInt(100),
+			oldIMR:         big.NewInt(50),
+			oldMMR:         big.NewInt(25),
+			newNC:          big.NewInt(30),
+			newMMR:         big.NewInt(30),
+			expectedResult: types.NewlyUndercollateralized,
+		},
+		"account with no maintenance margin requirement after the update is not below maintenance margin": {
+			oldNC:          big.NewInt(100),
+			oldIMR:         big.NewInt(50),
+			oldMMR:         big.NewInt(25),
+			newNC:          big.NewInt(-1),
+			newMMR:         big.NewInt(0),
+			expectedResult: types.NewlyUndercollateralized,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(
+				t,
+				tc.expectedResult,
+				lib.IsValidStateTransitionForUndercollateralizedSubaccount(
+					margin.Risk{
+						NC:  tc.oldNC,
+						IMR: tc.oldIMR,
+						MMR: tc.oldMMR,
+					},
+					margin.Risk{
+						NC:  tc.newNC,
+						MMR: tc.newMMR,
+					},
+				),
+			)
+		})
+	}
+}
+
+func TestIsRiskReducing(t *testing.T) {
+	tests := map[string]struct {
+		oldNC  *big.Int
+		oldMMR *big.Int
+		newNC  *big.Int
+		newMMR *big.Int
+
+		expected bool
+	}{
+		"unchanged risk": {
+			oldNC:    big.NewInt(100),
+			oldMMR:   big.NewInt(25),
+			newNC:    big.NewInt(100),
+			newMMR:   big.NewInt(25),
+			expected: true,
+		},
+		"reducing a position": {
+			oldNC:    big.NewInt(100),
+			oldMMR:   big.NewInt(25),
+			newNC:    big.NewInt(100),
+			newMMR:   big.NewInt(10),
+			expected: true,
+		},
+		"closing all positions at a loss": {
+			oldNC:    big.NewInt(100),
+			oldMMR:   big.NewInt(25),
+			newNC:    big.NewInt(90),
+			newMMR:   big.NewInt(0),
+			expected: true,
+		},
+		"closing all positions with negative net collateral": {
+			oldNC:    big.NewInt(10),
+			oldMMR:   big.NewInt(25),
+			newNC:    big.NewInt(-10),
+			newMMR:   big.NewInt(0),
+			expected: false,
+		},
+		"reducing a position with negative net collateral": {
+			oldNC:    big.NewInt(-10),
+			oldMMR:   big.NewInt(25),
+			newNC:    big.NewInt(-10),
+			newMMR:   big.NewInt(10),
+			expected: true,
+		},
+		"net collateral increases but maintenance margin increases": {
+			oldNC:    big.NewInt(100),
+			oldMMR:   big.NewInt(25),
+			newNC:    big.NewInt(200),
+			newMMR:   big.NewInt(30),
+			expected: false,
+		},
+		"maintenance margin decreases but net collateral and ratio decrease": {
+			oldNC:    big.NewInt(100),
+			oldMMR:   big.NewInt(25),
+			newNC:    big.NewInt(20),
+			newMMR:   big.NewInt(10),
+			expected: false,
+		},
+		"maintenance margin decreases and net collateral decreases with an unchanged ratio": {
+			oldNC:    big.NewInt(100),
+			oldMMR:   big.NewInt(25),
+			newNC:    big.NewInt(40),
+			newMMR:   big.NewInt(10),
+			expected: true,
+		},
+		"depositing without positions": {
+			oldNC:    big.NewInt(100),
+			oldMMR:   big.NewInt(0),
+			newNC:    big.NewInt(110),
+			newMMR:   big.NewInt(0),
+			expected: true,
+		},
+		"withdrawing without positions": {
+			oldNC:    big.NewInt(100),
+			oldMMR:   big.NewInt(0),
+			newNC:    big.NewInt(90),
+			newMMR:   big.NewInt(0),
+			expected: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(
+				t,
+				tc.expected,
+				lib.IsRiskReducing(
+					margin.Risk{NC: tc.oldNC, MMR: tc.oldMMR},
+					margin.Risk{NC: tc.newNC, MMR: tc.newMMR},
+				),
+			)
+		})
+	}
+}
+
+func TestGetSettledSubaccountWithPerpetuals_MaxUnsettledFundingIndexDelta(t *testing.T) {
+	subaccountId := types.SubaccountId{Owner: "test", Number: 1}
+	tests := map[string]struct {
+		quantums             int64
+		positionFundingIndex int64
+		maxDelta             uint64
+
+		expectedSettlement int64
+	}{
+		"uncapped long": {
+			quantums:             100,
+			positionFundingIndex: 0,
+			maxDelta:             0,
+			expectedSettlement:   -100,
+		},
+		"capped long": {
+			quantums:             100,
+			positionFundingIndex: 0,
+			maxDelta:             10_000,
+			expectedSettlement:   -1,
+		},
+		"capped short with a funding index above the perpetual's": {
+			quantums:             -100,
+			positionFundingIndex: 2_000_000,
+			maxDelta:             10_000,
+			expectedSettlement:   -1,
+		},
+		"cap above the funding index delta": {
+			quantums:             100,
+			positionFundingIndex: 0,
+			maxDelta:             2_000_000,
+			expectedSettlement:   -100,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// The funding index of the perpetual is 1_000_000, i.e. 1 quote quantum per base quantum.
+			perpInfo := perp_testutil.CreatePerpInfo(1, -6, 100, 0)
+			perpInfo.Perpetual.FundingIndex = dtypes.NewInt(1_000_000)
+			perpInfo.MaxUnsettledFundingIndexDelta = tc.maxDelta
+			perpInfos := perptypes.PerpInfos{1: perpInfo}
+			subaccount := types.Subaccount{
+				Id: &subaccountId,
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(
+						1,
+						big.NewInt(tc.quantums),
+						big.NewInt(tc.positionFundingIndex),
+						big.NewInt(0),
+					),
+				},
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(20_000)),
+			}
+
+			settledSubaccount, _ := lib.GetSettledSubaccountWithPerpetuals(subaccount, perpInfos)
+			require.Equal(t, big.NewInt(20_000+tc.expectedSettlement), settledSubaccount.GetUsdcPosition())
+			require.Equal(t, dtypes.NewInt(1_000_000), settledSubaccount.PerpetualPositions[0].FundingIndex)
+
+			risk, err := lib.GetRiskForSubaccount(settledSubaccount, perpInfos)
+			require.NoError(t, err)
+			require.Equal(t, big.NewInt(tc.quantums*100+20_000+tc.expectedSettlement), risk.NC)
+		})
+	}
+}
+
 func TestGetRiskForSubaccount(t *testing.T) {
 	subaccountId := types.SubaccountId{Owner: "test", Number: 1}
 	tests := map[string]struct {
@@ -171,18 +415,1244 @@ func TestGetRiskForSubaccount(t *testing.T) {
 	}
 }
 
-func TestGetRiskForSubaccount_Panic(t *testing.T) {
-	subaccount := types.Subaccount{
-		Id: &types.SubaccountId{Owner: "test", Number: 1},
+func TestGetRiskForSubaccount_AsymmetricLiquidityTier(t *testing.T) {
+	// Shorts in the perpetual require a 20% initial margin and a 75% maintenance fraction, while longs
+	// use the symmetric 10% initial margin and 50% maintenance fraction.
+	perpInfo := perp_testutil.CreatePerpInfo(1, -6, 100, 0)
+	perpInfo.LiquidityTier.ShortInitialMarginPpm = 200_000
+	perpInfo.LiquidityTier.ShortMaintenanceFractionPpm = 750_000
+	perpInfos := perptypes.PerpInfos{1: perpInfo}
+
+	long := types.Subaccount{
+		Id: &types.SubaccountId{Owner: "long", Number: 0},
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+		},
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-5_000)),
+	}
+	short := types.Subaccount{
+		Id: &types.SubaccountId{Owner: "short", Number: 0},
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(1, big.NewInt(-100), big.NewInt(0), big.NewInt(0)),
+		},
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(15_000)),
+	}
+
+	longRisk, err := lib.GetRiskForSubaccount(long, perpInfos)
+	require.NoError(t, err)
+	require.Equal(t, margin.Risk{
+		NC:  big.NewInt(100*100 - 5_000),
+		IMR: big.NewInt(100 * 100 * 0.1),
+		MMR: big.NewInt(100 * 100 * 0.1 * 0.5),
+	}, longRisk)
+
+	shortRisk, err := lib.GetRiskForSubaccount(short, perpInfos)
+	require.NoError(t, err)
+	require.Equal(t, margin.Risk{
+		NC:  big.NewInt(-100*100 + 15_000),
+		IMR: big.NewInt(100 * 100 * 0.2),
+		MMR: big.NewInt(100 * 100 * 0.2 * 0.75),
+	}, shortRisk)
+}
+
+func TestGetRiskForSubaccount_NegativeMarketPrice(t *testing.T) {
+	// The market price of the perpetual is -100, so longs have a negative notional and shorts a positive
+	// notional. Margin requirements are based on the magnitude of the notional.
+	perpInfo := perp_testutil.CreatePerpInfo(1, -6, 100, 0)
+	perpInfo.Price.Negative = true
+	perpInfos := perptypes.PerpInfos{1: perpInfo}
+
+	long := types.Subaccount{
+		Id: &types.SubaccountId{Owner: "long", Number: 0},
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+		},
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(15_000)),
+	}
+	short := types.Subaccount{
+		Id: &types.SubaccountId{Owner: "short", Number: 0},
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(1, big.NewInt(-100), big.NewInt(0), big.NewInt(0)),
+		},
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-5_000)),
+	}
+
+	longRisk, err := lib.GetRiskForSubaccount(long, perpInfos)
+	require.NoError(t, err)
+	require.Equal(t, margin.Risk{
+		NC:  big.NewInt(-100*100 + 15_000),
+		IMR: big.NewInt(100 * 100 * 0.1),
+		MMR: big.NewInt(100 * 100 * 0.1 * 0.5),
+	}, longRisk)
+
+	shortRisk, err := lib.GetRiskForSubaccount(short, perpInfos)
+	require.NoError(t, err)
+	require.Equal(t, margin.Risk{
+		NC:  big.NewInt(100*100 - 5_000),
+		IMR: big.NewInt(100 * 100 * 0.1),
+		MMR: big.NewInt(100 * 100 * 0.1 * 0.5),
+	}, shortRisk)
+
+	// The price falling further below zero is a loss for the long and a gain for the short.
+	perpInfo.Price.Price = 110
+	perpInfos[1] = perpInfo
+	longRisk, err = lib.GetRiskForSubaccount(long, perpInfos)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(-100*110+15_000), longRisk.NC)
+	shortRisk, err = lib.GetRiskForSubaccount(short, perpInfos)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100*110-5_000), shortRisk.NC)
+}
+
+func TestGetRiskForSubaccount_PriceConfidence(t *testing.T) {
+	// The market price of the perpetual is 100 with a confidence of 10. A 100 quantum position loses
+	// 100 * 10 in net collateral at the adverse edge of the confidence interval, which is added to the
+	// maintenance margin requirement of 100 * 100 * 0.1 * 0.5.
+	long := types.Subaccount{
+		Id: &types.SubaccountId{Owner: "long", Number: 0},
 		PerpetualPositions: []*types.PerpetualPosition{
 			testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
 		},
-		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(100)),
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-5_000)),
+	}
+	short := types.Subaccount{
+		Id: &types.SubaccountId{Owner: "short", Number: 0},
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(1, big.NewInt(-100), big.NewInt(0), big.NewInt(0)),
+		},
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(15_000)),
+	}
+
+	tests := map[string]struct {
+		subaccount      types.Subaccount
+		confidence      uint64
+		settlementPrice uint64
+
+		expectedRisk margin.Risk
+	}{
+		"long without confidence": {
+			subaccount: long,
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(100*100 - 5_000),
+				IMR: big.NewInt(100 * 100 * 0.1),
+				MMR: big.NewInt(100 * 100 * 0.1 * 0.5),
+			},
+		},
+		"long is margined at the lower edge": {
+			subaccount: long,
+			confidence: 10,
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(100*100 - 5_000),
+				IMR: big.NewInt(100 * 100 * 0.1),
+				MMR: big.NewInt(100*100*0.1*0.5 + 100*10),
+			},
+		},
+		"short without confidence": {
+			subaccount: short,
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(-100*100 + 15_000),
+				IMR: big.NewInt(100 * 100 * 0.1),
+				MMR: big.NewInt(100 * 100 * 0.1 * 0.5),
+			},
+		},
+		"short is margined at the upper edge": {
+			subaccount: short,
+			confidence: 10,
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(-100*100 + 15_000),
+				IMR: big.NewInt(100 * 100 * 0.1),
+				MMR: big.NewInt(100*100*0.1*0.5 + 100*10),
+			},
+		},
+		"confidence is ignored in settlement mode": {
+			subaccount:      long,
+			confidence:      10,
+			settlementPrice: 100,
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(100*100 - 5_000),
+				IMR: big.NewInt(100 * 100 * 0.1),
+				MMR: big.NewInt(100 * 100 * 0.1 * 0.5),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			perpInfo := perp_testutil.CreatePerpInfo(1, -6, 100, 0)
+			perpInfo.Price.Confidence = tc.confidence
+			perpInfo.SettlementPrice = tc.settlementPrice
+
+			risk, err := lib.GetRiskForSubaccount(tc.subaccount, perptypes.PerpInfos{1: perpInfo})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedRisk.NC.String(), risk.NC.String())
+			require.Equal(t, tc.expectedRisk.IMR.String(), risk.IMR.String())
+			require.Equal(t, tc.expectedRisk.MMR.String(), risk.MMR.String())
+		})
+	}
+}
+
+func TestGetRiskForSubaccount_ConcentrationMargin(t *testing.T) {
+	// The perpetual has a price of 100 and an open interest of 1,000 quantums. Positions larger than 20% of
+	// the open interest are concentrated, and their maintenance margin requirement of 100 * quantums * 0.1 *
+	// 0.5 is increased by 5% of their notional.
+	concentrationMargin := perptypes.ConcentrationMargin{ThresholdPpm: 200_000, AddOnPpm: 50_000}
+
+	tests := map[string]struct {
+		quantums            int64
+		concentrationMargin perptypes.ConcentrationMargin
+
+		expectedMMR *big.Int
+	}{
+		"concentrated long": {
+			quantums:            400,
+			concentrationMargin: concentrationMargin,
+			expectedMMR:         big.NewInt(400*100*0.1*0.5 + 400*100*0.05),
+		},
+		"concentrated short": {
+			quantums:            -400,
+			concentrationMargin: concentrationMargin,
+			expectedMMR:         big.NewInt(400*100*0.1*0.5 + 400*100*0.05),
+		},
+		"small position": {
+			quantums:            100,
+			concentrationMargin: concentrationMargin,
+			expectedMMR:         big.NewInt(100 * 100 * 0.1 * 0.5),
+		},
+		"position at the threshold": {
+			quantums:            200,
+			concentrationMargin: concentrationMargin,
+			expectedMMR:         big.NewInt(200 * 100 * 0.1 * 0.5),
+		},
+		"no concentration margin by default": {
+			quantums:    400,
+			expectedMMR: big.NewInt(400 * 100 * 0.1 * 0.5),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			perpInfo := perp_testutil.CreatePerpInfo(1, -6, 100, 0)
+			perpInfo.Perpetual.OpenInterest = dtypes.NewInt(1_000)
+			perpInfo.ConcentrationMargin = tc.concentrationMargin
+			subaccount := types.Subaccount{
+				Id: &types.SubaccountId{Owner: "owner", Number: 0},
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(1, big.NewInt(tc.quantums), big.NewInt(0), big.NewInt(0)),
+				},
+			}
+
+			risk, err := lib.GetRiskForSubaccount(subaccount, perptypes.PerpInfos{1: perpInfo})
+			require.NoError(t, err)
+			require.Equal(t, big.NewInt(tc.quantums*100).String(), risk.NC.String())
+			// The initial margin requirement is not affected.
+			require.Equal(t, new(big.Int).Abs(big.NewInt(tc.quantums*100/10)).String(), risk.IMR.String())
+			require.Equal(t, tc.expectedMMR.String(), risk.MMR.String())
+		})
+	}
+}
+
+func TestPositionRisk(t *testing.T) {
+	// Perpetual 1 has a 10% initial margin and a 50% maintenance fraction for both sides. Perpetual 2 has
+	// the same fractions for longs, and a 20% initial margin and a 75% maintenance fraction for shorts.
+	perpInfo := perp_testutil.CreatePerpInfo(1, -6, 100, 0)
+	asymmetricPerpInfo := perp_testutil.CreatePerpInfo(2, -6, 200, 0)
+	asymmetricPerpInfo.LiquidityTier.ShortInitialMarginPpm = 200_000
+	asymmetricPerpInfo.LiquidityTier.ShortMaintenanceFractionPpm = 750_000
+
+	tests := map[string]struct {
+		position *types.PerpetualPosition
+		perpInfo perptypes.PerpInfo
+
+		expectedRisk margin.Risk
+	}{
+		"long": {
+			position: testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(-5_000)),
+			perpInfo: perpInfo,
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(100*100 - 5_000),
+				IMR: big.NewInt(100 * 100 * 0.1),
+				MMR: big.NewInt(100 * 100 * 0.1 * 0.5),
+			},
+		},
+		"short": {
+			position: testutil.CreateSinglePerpetualPosition(1, big.NewInt(-100), big.NewInt(0), big.NewInt(15_000)),
+			perpInfo: perpInfo,
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(-100*100 + 15_000),
+				IMR: big.NewInt(100 * 100 * 0.1),
+				MMR: big.NewInt(100 * 100 * 0.1 * 0.5),
+			},
+		},
+		"long in an asymmetric liquidity tier": {
+			position: testutil.CreateSinglePerpetualPosition(2, big.NewInt(50), big.NewInt(0), big.NewInt(0)),
+			perpInfo: asymmetricPerpInfo,
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(50 * 200),
+				IMR: big.NewInt(50 * 200 * 0.1),
+				MMR: big.NewInt(50 * 200 * 0.1 * 0.5),
+			},
+		},
+		"short in an asymmetric liquidity tier": {
+			position: testutil.CreateSinglePerpetualPosition(2, big.NewInt(-50), big.NewInt(0), big.NewInt(0)),
+			perpInfo: asymmetricPerpInfo,
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(-50 * 200),
+				IMR: big.NewInt(50 * 200 * 0.2),
+				MMR: big.NewInt(50 * 200 * 0.2 * 0.75),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			risk, err := lib.PositionRisk(tc.position, tc.perpInfo)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedRisk, risk)
+		})
+	}
+}
+
+func TestExceedsMaxPositionNotional(t *testing.T) {
+	// Each base quantum is worth 100 quote quantums, so the cap is a position of 100 base quantums.
+	perpInfo := perp_testutil.CreatePerpInfo(1, -6, 100, 0)
+	perpInfo.LiquidityTier.MaxPositionNotionalQuoteQuantums = 10_000
+	uncappedPerpInfo := perp_testutil.CreatePerpInfo(2, -6, 100, 0)
+	perpInfos := perptypes.PerpInfos{1: perpInfo, 2: uncappedPerpInfo}
+
+	tests := map[string]struct {
+		perpetualId     uint32
+		curQuantums     int64
+		quantumsDelta   int64
+		expectedExceeds bool
+	}{
+		"opening a long at the cap": {
+			perpetualId:   1,
+			quantumsDelta: 100,
+		},
+		"opening a long just over the cap": {
+			perpetualId:     1,
+			quantumsDelta:   101,
+			expectedExceeds: true,
+		},
+		"opening a short at the cap": {
+			perpetualId:   1,
+			quantumsDelta: -100,
+		},
+		"opening a short just over the cap": {
+			perpetualId:     1,
+			quantumsDelta:   -101,
+			expectedExceeds: true,
+		},
+		"increasing a position to the cap": {
+			perpetualId:   1,
+			curQuantums:   50,
+			quantumsDelta: 50,
+		},
+		"increasing a position just over the cap": {
+			perpetualId:     1,
+			curQuantums:     50,
+			quantumsDelta:   51,
+			expectedExceeds: true,
+		},
+		"reducing a position that remains over the cap": {
+			perpetualId:   1,
+			curQuantums:   150,
+			quantumsDelta: -10,
+		},
+		"closing a position over the cap": {
+			perpetualId:   1,
+			curQuantums:   -150,
+			quantumsDelta: 150,
+		},
+		"flipping a position to just over the cap": {
+			perpetualId:     1,
+			curQuantums:     50,
+			quantumsDelta:   -151,
+			expectedExceeds: true,
+		},
+		"increasing a position in a perpetual without a cap": {
+			perpetualId:   2,
+			curQuantums:   150,
+			quantumsDelta: 1_000,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			subaccount := types.Subaccount{
+				Id:             &types.SubaccountId{Owner: "test", Number: 0},
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(1_000_000)),
+			}
+			if tc.curQuantums != 0 {
+				subaccount.PerpetualPositions = []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(
+						tc.perpetualId,
+						big.NewInt(tc.curQuantums),
+						big.NewInt(0),
+						big.NewInt(0),
+					),
+				}
+			}
+			settledUpdate := types.SettledUpdate{
+				SettledSubaccount: subaccount,
+				PerpetualUpdates: []types.PerpetualUpdate{
+					{
+						PerpetualId:          tc.perpetualId,
+						BigQuantumsDelta:     big.NewInt(tc.quantumsDelta),
+						BigQuoteBalanceDelta: big.NewInt(0),
+					},
+				},
+			}
+
+			exceeds, err := lib.ExceedsMaxPositionNotional(
+				settledUpdate,
+				lib.CalculateUpdatedSubaccount(settledUpdate, perpInfos),
+				perpInfos,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedExceeds, exceeds)
+		})
+	}
+}
+
+func TestGetRiskForSubaccount_InversePerpetual(t *testing.T) {
+	subaccountId := types.SubaccountId{Owner: "test", Number: 1}
+	// Both perpetuals have an initial margin fraction of 10% and a maintenance fraction of 50%. A linear
+	// position of 100 quantums and an inverse position of 1,000,000 quantums both have a notional of
+	// 10,000 quote quantums at a price of 100.
+	inversePerpInfo := func(price uint64) perptypes.PerpInfo {
+		perpInfo := perp_testutil.CreatePerpInfo(2, -6, price, 0)
+		perpInfo.PerpetualType = perptypes.InversePerpetual
+		return perpInfo
 	}
-	emptyPerpInfos := perptypes.PerpInfos{}
+	tests := map[string]struct {
+		perpetualPositions []*types.PerpetualPosition
+		perpInfos          perptypes.PerpInfos
+		expectedRisk       margin.Risk
+		expectedErr        error
+	}{
+		"linear long": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			},
+			perpInfos: perptypes.PerpInfos{1: perp_testutil.CreatePerpInfo(1, -6, 100, 0)},
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(10_000 - 5_000),
+				IMR: big.NewInt(1_000),
+				MMR: big.NewInt(500),
+			},
+		},
+		"inverse long of equivalent exposure": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(1_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+			perpInfos: perptypes.PerpInfos{2: inversePerpInfo(100)},
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(10_000 - 5_000),
+				IMR: big.NewInt(1_000),
+				MMR: big.NewInt(500),
+			},
+		},
+		"linear short": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(-100), big.NewInt(0), big.NewInt(0)),
+			},
+			perpInfos: perptypes.PerpInfos{1: perp_testutil.CreatePerpInfo(1, -6, 100, 0)},
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(-10_000 - 5_000),
+				IMR: big.NewInt(1_000),
+				MMR: big.NewInt(500),
+			},
+		},
+		"inverse short of equivalent exposure": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(-1_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+			perpInfos: perptypes.PerpInfos{2: inversePerpInfo(100)},
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(-10_000 - 5_000),
+				IMR: big.NewInt(1_000),
+				MMR: big.NewInt(500),
+			},
+		},
+		"inverse long loses notional as the price rises": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(1_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+			perpInfos: perptypes.PerpInfos{2: inversePerpInfo(250)},
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(4_000 - 5_000),
+				IMR: big.NewInt(400),
+				MMR: big.NewInt(200),
+			},
+		},
+		"linear and inverse positions": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(-1_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+			perpInfos: perptypes.PerpInfos{
+				1: perp_testutil.CreatePerpInfo(1, -6, 100, 0),
+				2: inversePerpInfo(100),
+			},
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(10_000 - 10_000 - 5_000),
+				IMR: big.NewInt(2_000),
+				MMR: big.NewInt(1_000),
+			},
+		},
+		"inverse position at a price of zero": {
+			perpetualPositions: []*types.PerpetualPosition{
+				testutil.CreateSinglePerpetualPosition(2, big.NewInt(1_000_000), big.NewInt(0), big.NewInt(0)),
+			},
+			perpInfos:   perptypes.PerpInfos{2: inversePerpInfo(0)},
+			expectedErr: types.ErrPriceMissing,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			subaccount := types.Subaccount{
+				Id:                 &subaccountId,
+				PerpetualPositions: tc.perpetualPositions,
+				AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(-5_000)),
+			}
+			risk, err := lib.GetRiskForSubaccount(subaccount, tc.perpInfos)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedRisk, risk)
+		})
+	}
+}
+
+func TestGetRiskForSubaccount_SettlementMode(t *testing.T) {
+	subaccountId := types.SubaccountId{Owner: "test", Number: 1}
+	// The perpetuals have an initial margin fraction of 10% and a maintenance fraction of 50%.
+	inversePerpInfo := func(price uint64) perptypes.PerpInfo {
+		perpInfo := perp_testutil.CreatePerpInfo(2, -6, price, 0)
+		perpInfo.PerpetualType = perptypes.InversePerpetual
+		return perpInfo
+	}
+	tests := map[string]struct {
+		perpetualPosition *types.PerpetualPosition
+		perpInfo          perptypes.PerpInfo
+		settlementPrice   uint64
+
+		expectedActiveRisk     margin.Risk
+		expectedSettlementRisk margin.Risk
+	}{
+		"long valued at a settlement price below the market price": {
+			perpetualPosition: testutil.CreateSinglePerpetualPosition(
+				1,
+				big.NewInt(100),
+				big.NewInt(0),
+				big.NewInt(0),
+			),
+			perpInfo:        perp_testutil.CreatePerpInfo(1, -6, 100, 0),
+			settlementPrice: 80,
+			expectedActiveRisk: margin.Risk{
+				NC:  big.NewInt(10_000 - 5_000),
+				IMR: big.NewInt(1_000),
+				MMR: big.NewInt(500),
+			},
+			expectedSettlementRisk: margin.Risk{
+				NC:  big.NewInt(8_000 - 5_000),
+				IMR: big.NewInt(800),
+				MMR: big.NewInt(400),
+			},
+		},
+		"short valued at a settlement price above the market price": {
+			perpetualPosition: testutil.CreateSinglePerpetualPosition(
+				1,
+				big.NewInt(-100),
+				big.NewInt(0),
+				big.NewInt(20_000),
+			),
+			perpInfo:        perp_testutil.CreatePerpInfo(1, -6, 100, 0),
+			settlementPrice: 120,
+			expectedActiveRisk: margin.Risk{
+				NC:  big.NewInt(-10_000 + 20_000 - 5_000),
+				IMR: big.NewInt(1_000),
+				MMR: big.NewInt(500),
+			},
+			expectedSettlementRisk: margin.Risk{
+				NC:  big.NewInt(-12_000 + 20_000 - 5_000),
+				IMR: big.NewInt(1_200),
+				MMR: big.NewInt(600),
+			},
+		},
+		"inverse long valued at a settlement price": {
+			perpetualPosition: testutil.CreateSinglePerpetualPosition(
+				2,
+				big.NewInt(1_000_000),
+				big.NewInt(0),
+				big.NewInt(0),
+			),
+			perpInfo:        inversePerpInfo(250),
+			settlementPrice: 100,
+			expectedActiveRisk: margin.Risk{
+				NC:  big.NewInt(4_000 - 5_000),
+				IMR: big.NewInt(400),
+				MMR: big.NewInt(200),
+			},
+			expectedSettlementRisk: margin.Risk{
+				NC:  big.NewInt(10_000 - 5_000),
+				IMR: big.NewInt(1_000),
+				MMR: big.NewInt(500),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			subaccount := types.Subaccount{
+				Id:                 &subaccountId,
+				PerpetualPositions: []*types.PerpetualPosition{tc.perpetualPosition},
+				AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(-5_000)),
+			}
+			perpetualId := tc.perpetualPosition.PerpetualId
+
+			activeRisk, err := lib.GetRiskForSubaccount(subaccount, perptypes.PerpInfos{perpetualId: tc.perpInfo})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedActiveRisk, activeRisk)
+
+			settlementPerpInfo := tc.perpInfo
+			settlementPerpInfo.SettlementPrice = tc.settlementPrice
+			settlementRisk, err := lib.GetRiskForSubaccount(
+				subaccount,
+				perptypes.PerpInfos{perpetualId: settlementPerpInfo},
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedSettlementRisk, settlementRisk)
+		})
+	}
+}
+
+func TestGetRiskForSubaccount_Errors(t *testing.T) {
+	subaccountId := types.SubaccountId{Owner: "test", Number: 1}
+	inversePerpInfo := perp_testutil.CreatePerpInfo(2, -6, 0, 0)
+	inversePerpInfo.PerpetualType = perptypes.InversePerpetual
+	overflowingPerpInfo := perp_testutil.CreatePerpInfo(3, math.MinInt32, 100, 0)
+	overflowingPerpInfo.Price.Exponent = -10
+	perpInfos := perptypes.PerpInfos{
+		1: perp_testutil.CreatePerpInfo(1, -6, 100, 0),
+		2: inversePerpInfo,
+		3: overflowingPerpInfo,
+	}
+	tests := map[string]struct {
+		subaccount  types.Subaccount
+		expectedErr error
+	}{
+		"perpetual info not found": {
+			subaccount: types.Subaccount{
+				Id: &subaccountId,
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(4, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+				},
+			},
+			expectedErr: types.ErrPerpetualInfoNotFound,
+		},
+		"price missing": {
+			subaccount: types.Subaccount{
+				Id: &subaccountId,
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(2, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+				},
+			},
+			expectedErr: types.ErrPriceMissing,
+		},
+		"duplicate perpetual position": {
+			subaccount: types.Subaccount{
+				Id: &subaccountId,
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+					testutil.CreateSinglePerpetualPosition(1, big.NewInt(-50), big.NewInt(0), big.NewInt(0)),
+				},
+			},
+			expectedErr: types.ErrDuplicatePosition,
+		},
+		"duplicate asset position": {
+			subaccount: types.Subaccount{
+				Id: &subaccountId,
+				AssetPositions: append(
+					testutil.CreateUsdcAssetPositions(big.NewInt(100)),
+					testutil.CreateUsdcAssetPositions(big.NewInt(200))...,
+				),
+			},
+			expectedErr: types.ErrDuplicatePosition,
+		},
+		"overflow": {
+			subaccount: types.Subaccount{
+				Id: &subaccountId,
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(3, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+				},
+			},
+			expectedErr: types.ErrOverflow,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := lib.GetRiskForSubaccount(tc.subaccount, perpInfos)
+			require.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestGetRiskForSubaccountAtPrices(t *testing.T) {
+	subaccount := types.Subaccount{
+		Id: &types.SubaccountId{Owner: "test", Number: 1},
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			testutil.CreateSinglePerpetualPosition(2, big.NewInt(-25), big.NewInt(0), big.NewInt(0)),
+		},
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(110)),
+	}
+	perpInfos := perptypes.PerpInfos{
+		1: perp_testutil.CreatePerpInfo(1, -6, 100, 0),
+		2: perp_testutil.CreatePerpInfo(2, -6, 200, 0),
+	}
+
+	risk, err := lib.GetRiskForSubaccountAtPrices(subaccount, perpInfos, map[uint32]uint64{1: 50})
+	require.NoError(t, err)
+	require.Equal(t, margin.Risk{
+		NC:  big.NewInt(100*50 - 25*200 + 110),
+		IMR: big.NewInt((100 * 50 * 0.1) + (25 * 200 * 0.1)),
+		MMR: big.NewInt((100 * 50 * 0.1 * 0.5) + (25 * 200 * 0.1 * 0.5)),
+	}, risk)
+
+	// The prices in the input perpetual infos are not modified.
+	require.Equal(t, uint64(100), perpInfos[1].Price.Price)
+
+	// Replaced prices are non-negative.
+	negativePerpInfo := perpInfos[2]
+	negativePerpInfo.Price.Negative = true
+	negativePerpInfos := perptypes.PerpInfos{1: perpInfos[1], 2: negativePerpInfo}
+	risk, err = lib.GetRiskForSubaccountAtPrices(subaccount, negativePerpInfos, map[uint32]uint64{2: 200})
+	require.NoError(t, err)
+	expectedRisk, err := lib.GetRiskForSubaccount(subaccount, perpInfos)
+	require.NoError(t, err)
+	require.Equal(t, expectedRisk, risk)
+
+	// Perpetuals in settlement mode are valued at their settlement price.
+	settledPerpInfo := perpInfos[1]
+	settledPerpInfo.SettlementPrice = 80
+	settledPerpInfos := perptypes.PerpInfos{1: settledPerpInfo, 2: perpInfos[2]}
+	risk, err = lib.GetRiskForSubaccountAtPrices(subaccount, settledPerpInfos, map[uint32]uint64{1: 50})
+	require.NoError(t, err)
+	expectedRisk, err = lib.GetRiskForSubaccount(subaccount, settledPerpInfos)
+	require.NoError(t, err)
+	require.Equal(t, expectedRisk, risk)
+}
+
+func TestGetRiskForSubaccountSubset(t *testing.T) {
+	perpInfos := perptypes.PerpInfos{
+		1: perp_testutil.CreatePerpInfo(1, -6, 100, 0),
+		2: perp_testutil.CreatePerpInfo(2, -6, 200, 0),
+		3: perp_testutil.CreatePerpInfo(3, -6, 300, 0),
+	}
+	positions := map[uint32]*types.PerpetualPosition{
+		1: testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(-1_000)),
+		2: testutil.CreateSinglePerpetualPosition(2, big.NewInt(-25), big.NewInt(0), big.NewInt(0)),
+		3: testutil.CreateSinglePerpetualPosition(3, big.NewInt(10), big.NewInt(0), big.NewInt(0)),
+	}
+	settledUpdate := types.SettledUpdate{
+		SettledSubaccount: types.Subaccount{
+			Id:                 &types.SubaccountId{Owner: "test", Number: 1},
+			PerpetualPositions: []*types.PerpetualPosition{positions[1], positions[2], positions[3]},
+			AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(5_000)),
+		},
+		PerpetualUpdates: []types.PerpetualUpdate{
+			{PerpetualId: 3, BigQuantumsDelta: big.NewInt(10)},
+		},
+		AssetUpdates: []types.AssetUpdate{
+			{AssetId: assettypes.AssetUsdc.Id, BigQuantumsDelta: big.NewInt(-6_000)},
+		},
+	}
+	fullRisk, err := lib.GetRiskForSubaccount(lib.CalculateUpdatedSubaccount(settledUpdate, perpInfos), perpInfos)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		includePerpetualIds []uint32
+		excludedRisk        margin.Risk
+	}{
+		"all positions": {
+			includePerpetualIds: []uint32{1, 2, 3},
+			excludedRisk:        margin.ZeroRisk(),
+		},
+		"single position": {
+			includePerpetualIds: []uint32{2},
+			excludedRisk: margin.Risk{
+				NC:  big.NewInt((100*100 - 1_000) + 20*300),
+				IMR: big.NewInt((100 * 100 * 0.1) + (20 * 300 * 0.1)),
+				MMR: big.NewInt((100 * 100 * 0.1 * 0.5) + (20 * 300 * 0.1 * 0.5)),
+			},
+		},
+		"position with a pending update": {
+			includePerpetualIds: []uint32{1, 3},
+			excludedRisk: margin.Risk{
+				NC:  big.NewInt(-25 * 200),
+				IMR: big.NewInt(25 * 200 * 0.1),
+				MMR: big.NewInt(25 * 200 * 0.1 * 0.5),
+			},
+		},
+		"perpetual without a position": {
+			includePerpetualIds: []uint32{4},
+			excludedRisk: margin.Risk{
+				NC:  big.NewInt((100*100 - 1_000) - 25*200 + 20*300),
+				IMR: big.NewInt((100 * 100 * 0.1) + (25 * 200 * 0.1) + (20 * 300 * 0.1)),
+				MMR: big.NewInt((100 * 100 * 0.1 * 0.5) + (25 * 200 * 0.1 * 0.5) + (20 * 300 * 0.1 * 0.5)),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			risk, err := lib.GetRiskForSubaccountSubset(settledUpdate, perpInfos, tc.includePerpetualIds)
+			require.NoError(t, err)
+
+			// The subset risk is the full risk minus the risk of the excluded positions.
+			require.Equal(t, 0, new(big.Int).Sub(fullRisk.NC, tc.excludedRisk.NC).Cmp(risk.NC))
+			require.Equal(t, 0, new(big.Int).Sub(fullRisk.IMR, tc.excludedRisk.IMR).Cmp(risk.IMR))
+			require.Equal(t, 0, new(big.Int).Sub(fullRisk.MMR, tc.excludedRisk.MMR).Cmp(risk.MMR))
+		})
+	}
+
+	// The positions of the settled subaccount are not modified.
+	require.Len(t, settledUpdate.SettledSubaccount.PerpetualPositions, 3)
+	require.Equal(t, big.NewInt(10), settledUpdate.SettledSubaccount.PerpetualPositions[2].GetBigQuantums())
+}
+
+func TestGetConservativeRiskForSubaccount(t *testing.T) {
+	perpInfos := perptypes.PerpInfos{
+		1: perp_testutil.CreatePerpInfo(1, -6, 100, 0),
+		2: perp_testutil.CreatePerpInfo(2, -6, 200, 0),
+	}
+	long := testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0))
+	short := testutil.CreateSinglePerpetualPosition(2, big.NewInt(-25), big.NewInt(0), big.NewInt(0))
+
+	tests := map[string]struct {
+		perpetualPositions []*types.PerpetualPosition
+		alternatePrices    map[uint32]uint64
+
+		expectedRisk margin.Risk
+	}{
+		"no alternate prices": {
+			perpetualPositions: []*types.PerpetualPosition{long, short},
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(100*100 - 25*200 + 110),
+				IMR: big.NewInt((100 * 100 * 0.1) + (25 * 200 * 0.1)),
+				MMR: big.NewInt((100 * 100 * 0.1 * 0.5) + (25 * 200 * 0.1 * 0.5)),
+			},
+		},
+		"long uses the lower alternate price": {
+			perpetualPositions: []*types.PerpetualPosition{long},
+			alternatePrices:    map[uint32]uint64{1: 90},
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(100*90 + 110),
+				IMR: big.NewInt(100 * 90 * 0.1),
+				MMR: big.NewInt(100 * 90 * 0.1 * 0.5),
+			},
+		},
+		"long ignores the higher alternate price": {
+			perpetualPositions: []*types.PerpetualPosition{long},
+			alternatePrices:    map[uint32]uint64{1: 110},
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(100*100 + 110),
+				IMR: big.NewInt(100 * 100 * 0.1),
+				MMR: big.NewInt(100 * 100 * 0.1 * 0.5),
+			},
+		},
+		"short uses the higher alternate price": {
+			perpetualPositions: []*types.PerpetualPosition{short},
+			alternatePrices:    map[uint32]uint64{2: 220},
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(-25*220 + 110),
+				IMR: big.NewInt(25 * 220 * 0.1),
+				MMR: big.NewInt(25 * 220 * 0.1 * 0.5),
+			},
+		},
+		"short ignores the lower alternate price": {
+			perpetualPositions: []*types.PerpetualPosition{short},
+			alternatePrices:    map[uint32]uint64{2: 180},
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(-25*200 + 110),
+				IMR: big.NewInt(25 * 200 * 0.1),
+				MMR: big.NewInt(25 * 200 * 0.1 * 0.5),
+			},
+		},
+		"each position uses its own adverse price": {
+			perpetualPositions: []*types.PerpetualPosition{long, short},
+			alternatePrices:    map[uint32]uint64{1: 90, 2: 220},
+			expectedRisk: margin.Risk{
+				NC:  big.NewInt(100*90 - 25*220 + 110),
+				IMR: big.NewInt((100 * 90 * 0.1) + (25 * 220 * 0.1)),
+				MMR: big.NewInt((100 * 90 * 0.1 * 0.5) + (25 * 220 * 0.1 * 0.5)),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			subaccount := types.Subaccount{
+				Id:                 &types.SubaccountId{Owner: "test", Number: 1},
+				PerpetualPositions: tc.perpetualPositions,
+				AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(110)),
+			}
+			risk, err := lib.GetConservativeRiskForSubaccount(subaccount, perpInfos, tc.alternatePrices)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedRisk, risk)
+
+			// Without alternate prices the risk is the same as the risk at the market prices.
+			marketRisk, err := lib.GetRiskForSubaccount(subaccount, perpInfos)
+			require.NoError(t, err)
+			conservativeRisk, err := lib.GetConservativeRiskForSubaccount(subaccount, perpInfos, nil)
+			require.NoError(t, err)
+			require.Equal(t, marketRisk, conservativeRisk)
+		})
+	}
+
+	// The prices in the input perpetual infos are not modified.
+	require.Equal(t, uint64(100), perpInfos[1].Price.Price)
+	require.Equal(t, uint64(200), perpInfos[2].Price.Price)
+
+	// Positions in perpetuals in settlement mode are valued at the settlement price.
+	settledPerpInfo := perpInfos[1]
+	settledPerpInfo.SettlementPrice = 100
+	settledPerpInfos := perptypes.PerpInfos{1: settledPerpInfo}
+	subaccount := types.Subaccount{
+		Id:                 &types.SubaccountId{Owner: "test", Number: 1},
+		PerpetualPositions: []*types.PerpetualPosition{long},
+		AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(110)),
+	}
+	risk, err := lib.GetConservativeRiskForSubaccount(subaccount, settledPerpInfos, map[uint32]uint64{1: 90})
+	require.NoError(t, err)
+	expectedRisk, err := lib.GetRiskForSubaccount(subaccount, settledPerpInfos)
+	require.NoError(t, err)
+	require.Equal(t, expectedRisk, risk)
+}
+
+func TestGetRiskForSubaccountWithCorrelations(t *testing.T) {
+	perpInfos := perptypes.PerpInfos{
+		1: perp_testutil.CreatePerpInfo(1, -6, 100, 0),
+		2: perp_testutil.CreatePerpInfo(2, -6, 200, 0),
+	}
+
+	tests := map[string]struct {
+		quantums2    int64
+		correlations []lib.PerpetualCorrelation
+
+		expectedMMR *big.Int
+	}{
+		"no correlations": {
+			quantums2:   -100,
+			expectedMMR: big.NewInt(1_500),
+		},
+		"hedged pair with positive correlation": {
+			// 80% of the 500 MMR of the long BTC position is offset by the short position.
+			quantums2: -100,
+			correlations: []lib.PerpetualCorrelation{
+				{PerpetualIdA: 1, PerpetualIdB: 2, CorrelationPpm: 800_000},
+			},
+			expectedMMR: big.NewInt(1_100),
+		},
+		"unhedged pair with positive correlation": {
+			quantums2: 100,
+			correlations: []lib.PerpetualCorrelation{
+				{PerpetualIdA: 1, PerpetualIdB: 2, CorrelationPpm: 800_000},
+			},
+			expectedMMR: big.NewInt(1_500),
+		},
+		"hedged pair with negative correlation": {
+			quantums2: 100,
+			correlations: []lib.PerpetualCorrelation{
+				{PerpetualIdA: 1, PerpetualIdB: 2, CorrelationPpm: -800_000},
+			},
+			expectedMMR: big.NewInt(1_100),
+		},
+		"zero correlation": {
+			quantums2: -100,
+			correlations: []lib.PerpetualCorrelation{
+				{PerpetualIdA: 1, PerpetualIdB: 2, CorrelationPpm: 0},
+			},
+			expectedMMR: big.NewInt(1_500),
+		},
+		"correlation with a perpetual without a position": {
+			quantums2: -100,
+			correlations: []lib.PerpetualCorrelation{
+				{PerpetualIdA: 1, PerpetualIdB: 3, CorrelationPpm: 800_000},
+			},
+			expectedMMR: big.NewInt(1_500),
+		},
+		"maintenance margin of a position hedges at most once": {
+			// The second correlation offsets 80% of the remaining 100 MMR of the long BTC position.
+			quantums2: -100,
+			correlations: []lib.PerpetualCorrelation{
+				{PerpetualIdA: 1, PerpetualIdB: 2, CorrelationPpm: 800_000},
+				{PerpetualIdA: 2, PerpetualIdB: 1, CorrelationPpm: 800_000},
+			},
+			expectedMMR: big.NewInt(1_020),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// MMR = 500 for perpetual 1 and 1,000 for perpetual 2.
+			subaccount := types.Subaccount{
+				Id: &types.SubaccountId{Owner: "test", Number: 1},
+				PerpetualPositions: []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+					testutil.CreateSinglePerpetualPosition(2, big.NewInt(tc.quantums2), big.NewInt(0), big.NewInt(0)),
+				},
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(10_000)),
+			}
+			baseRisk, err := lib.GetRiskForSubaccount(subaccount, perpInfos)
+			require.NoError(t, err)
+			require.Equal(t, big.NewInt(1_500), baseRisk.MMR)
+
+			risk, err := lib.GetRiskForSubaccountWithCorrelations(subaccount, perpInfos, tc.correlations)
+			require.NoError(t, err)
+			require.Equal(t, baseRisk.NC, risk.NC)
+			require.Equal(t, baseRisk.IMR, risk.IMR)
+			require.Equal(t, tc.expectedMMR, risk.MMR)
+		})
+	}
+}
+
+func TestGetRiskForSubaccountWithCorrelations_SettledInversePerpetual(t *testing.T) {
+	// The inverse perpetual has no market price, and is valued at its settlement price.
+	inversePerpInfo := perp_testutil.CreatePerpInfo(2, -6, 0, 0)
+	inversePerpInfo.PerpetualType = perptypes.InversePerpetual
+	inversePerpInfo.SettlementPrice = 200
+	perpInfos := perptypes.PerpInfos{
+		1: perp_testutil.CreatePerpInfo(1, -6, 100, 0),
+		2: inversePerpInfo,
+	}
+	subaccount := types.Subaccount{
+		Id: &types.SubaccountId{Owner: "test", Number: 1},
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+			testutil.CreateSinglePerpetualPosition(2, big.NewInt(-100), big.NewInt(0), big.NewInt(0)),
+		},
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(10_000)),
+	}
+	baseRisk, err := lib.GetRiskForSubaccount(subaccount, perpInfos)
+	require.NoError(t, err)
+
+	risk, err := lib.GetRiskForSubaccountWithCorrelations(
+		subaccount,
+		perpInfos,
+		[]lib.PerpetualCorrelation{{PerpetualIdA: 1, PerpetualIdB: 2, CorrelationPpm: 800_000}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, baseRisk.NC, risk.NC)
+	require.Equal(t, baseRisk.IMR, risk.IMR)
+}
+
+func TestGetStressedRiskForSubaccount(t *testing.T) {
+	subaccount := types.Subaccount{
+		Id: &types.SubaccountId{Owner: "test", Number: 1},
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(1, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+		},
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(-9_400)),
+	}
+	perpInfos := perptypes.PerpInfos{
+		1: perp_testutil.CreatePerpInfo(1, -6, 100, 0),
+	}
+	baseRisk, err := lib.GetRiskForSubaccount(subaccount, perpInfos)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		margin.Risk{
+			NC:  big.NewInt(100*100 - 9_400),
+			IMR: big.NewInt(100 * 100 * 0.1),
+			MMR: big.NewInt(100 * 100 * 0.1 * 0.5),
+		},
+		baseRisk,
+	)
+
+	tests := map[string]struct {
+		mmrMultiplierPpm     uint32
+		expectedMMR          *big.Int
+		expectedLiquidatable bool
+	}{
+		"no stress": {
+			mmrMultiplierPpm:     1_000_000,
+			expectedMMR:          big.NewInt(500),
+			expectedLiquidatable: false,
+		},
+		"1.5x stress": {
+			mmrMultiplierPpm:     1_500_000,
+			expectedMMR:          big.NewInt(750),
+			expectedLiquidatable: true,
+		},
+		"0.5x stress": {
+			mmrMultiplierPpm:     500_000,
+			expectedMMR:          big.NewInt(250),
+			expectedLiquidatable: false,
+		},
+		"rounds up": {
+			mmrMultiplierPpm:     1_000_001,
+			expectedMMR:          big.NewInt(501),
+			expectedLiquidatable: false,
+		},
+		"zero multiplier": {
+			mmrMultiplierPpm:     0,
+			expectedMMR:          big.NewInt(0),
+			expectedLiquidatable: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			risk, err := lib.GetStressedRiskForSubaccount(subaccount, perpInfos, tc.mmrMultiplierPpm)
+			require.NoError(t, err)
+			require.Equal(t, baseRisk.NC, risk.NC)
+			require.Equal(t, baseRisk.IMR, risk.IMR)
+			require.Equal(t, tc.expectedMMR, risk.MMR)
+			require.Equal(t, tc.expectedLiquidatable, risk.IsLiquidatable())
+		})
+	}
+}
+
+func TestGetUpdatedCostBasis(t *testing.T) {
+	tests := map[string]struct {
+		quantums          *big.Int
+		costBasis         *big.Int
+		quantumsDelta     *big.Int
+		fillQuoteQuantums *big.Int
+
+		expectedCostBasis *big.Int
+	}{
+		"open long": {
+			quantums:          big.NewInt(0),
+			costBasis:         big.NewInt(0),
+			quantumsDelta:     big.NewInt(100),
+			fillQuoteQuantums: big.NewInt(5_000),
+			expectedCostBasis: big.NewInt(5_000),
+		},
+		"open short": {
+			quantums:          big.NewInt(0),
+			costBasis:         big.NewInt(0),
+			quantumsDelta:     big.NewInt(-100),
+			fillQuoteQuantums: big.NewInt(5_000),
+			expectedCostBasis: big.NewInt(-5_000),
+		},
+		"increase long": {
+			quantums:          big.NewInt(100),
+			costBasis:         big.NewInt(5_000),
+			quantumsDelta:     big.NewInt(100),
+			fillQuoteQuantums: big.NewInt(7_000),
+			expectedCostBasis: big.NewInt(12_000),
+		},
+		"increase short": {
+			quantums:          big.NewInt(-100),
+			costBasis:         big.NewInt(-5_000),
+			quantumsDelta:     big.NewInt(-50),
+			fillQuoteQuantums: big.NewInt(2_000),
+			expectedCostBasis: big.NewInt(-7_000),
+		},
+		"partially close long": {
+			quantums:          big.NewInt(100),
+			costBasis:         big.NewInt(5_000),
+			quantumsDelta:     big.NewInt(-25),
+			fillQuoteQuantums: big.NewInt(10_000),
+			expectedCostBasis: big.NewInt(3_750),
+		},
+		"partially close short without fill": {
+			quantums:          big.NewInt(-100),
+			costBasis:         big.NewInt(-5_000),
+			quantumsDelta:     big.NewInt(40),
+			fillQuoteQuantums: nil,
+			expectedCostBasis: big.NewInt(-3_000),
+		},
+		"fully close": {
+			quantums:          big.NewInt(100),
+			costBasis:         big.NewInt(5_000),
+			quantumsDelta:     big.NewInt(-100),
+			fillQuoteQuantums: big.NewInt(6_000),
+			expectedCostBasis: big.NewInt(0),
+		},
+		"flip long to short": {
+			quantums:          big.NewInt(100),
+			costBasis:         big.NewInt(5_000),
+			quantumsDelta:     big.NewInt(-150),
+			fillQuoteQuantums: big.NewInt(9_000),
+			expectedCostBasis: big.NewInt(-3_000),
+		},
+		"flip short to long": {
+			quantums:          big.NewInt(-100),
+			costBasis:         big.NewInt(-5_000),
+			quantumsDelta:     big.NewInt(300),
+			fillQuoteQuantums: big.NewInt(18_000),
+			expectedCostBasis: big.NewInt(12_000),
+		},
+		"increase without fill makes cost basis unknown": {
+			quantums:          big.NewInt(100),
+			costBasis:         big.NewInt(5_000),
+			quantumsDelta:     big.NewInt(100),
+			fillQuoteQuantums: nil,
+			expectedCostBasis: big.NewInt(0),
+		},
+		"increase with unknown cost basis stays unknown": {
+			quantums:          big.NewInt(100),
+			costBasis:         big.NewInt(0),
+			quantumsDelta:     big.NewInt(100),
+			fillQuoteQuantums: big.NewInt(5_000),
+			expectedCostBasis: big.NewInt(0),
+		},
+		"zero delta": {
+			quantums:          big.NewInt(100),
+			costBasis:         big.NewInt(5_000),
+			quantumsDelta:     big.NewInt(0),
+			fillQuoteQuantums: big.NewInt(0),
+			expectedCostBasis: big.NewInt(5_000),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			costBasis := lib.GetUpdatedCostBasis(
+				tc.quantums,
+				tc.costBasis,
+				tc.quantumsDelta,
+				tc.fillQuoteQuantums,
+			)
+			require.Equal(t, tc.expectedCostBasis, costBasis)
+		})
+	}
+}
+
+func TestCalculateUpdatedPerpetualPositions_CostBasis(t *testing.T) {
+	perpInfos := perptypes.PerpInfos{
+		0: perp_testutil.CreatePerpInfo(0, -6, 100, 0),
+		1: perp_testutil.CreatePerpInfo(1, -6, 100, 0),
+	}
+	positions := []*types.PerpetualPosition{
+		testutil.CreateSinglePerpetualPosition(0, big.NewInt(100), big.NewInt(0), big.NewInt(0)),
+	}
+	positions[0].CostBasis = dtypes.NewInt(5_000)
+
+	updated := lib.CalculateUpdatedPerpetualPositions(
+		positions,
+		[]types.PerpetualUpdate{
+			{
+				PerpetualId:          0,
+				BigQuantumsDelta:     big.NewInt(-150),
+				BigFillQuoteQuantums: big.NewInt(9_000),
+			},
+			{
+				PerpetualId:          1,
+				BigQuantumsDelta:     big.NewInt(200),
+				BigFillQuoteQuantums: big.NewInt(11_000),
+			},
+		},
+		perpInfos,
+	)
 
-	// Panics since relevant perpetual information cannot be found.
-	require.Panics(t, func() {
-		_, _ = lib.GetRiskForSubaccount(subaccount, emptyPerpInfos)
-	})
+	require.Len(t, updated, 2)
+	require.Equal(t, big.NewInt(-50), updated[0].GetBigQuantums())
+	require.Equal(t, big.NewInt(-3_000), updated[0].GetBigCostBasis())
+	require.Equal(t, big.NewInt(200), updated[1].GetBigQuantums())
+	require.Equal(t, big.NewInt(11_000), updated[1].GetBigCostBasis())
 }
@@ -0,0 +1,19 @@
+package lib
+
+import (
+	"math/big"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+)
+
+// MaxWithdrawableQuoteQuantums returns the largest amount of quote quantums that can be withdrawn from an
+// account with the given risk while it remains at or above its initial margin requirement. Withdrawals only
+// reduce the net collateral and do not change the margin requirements, so this is the free collateral of
+// the account, its net collateral minus its initial margin requirement, or zero if it has none.
+func MaxWithdrawableQuoteQuantums(risk margin.Risk) *big.Int {
+	maxWithdrawable := new(big.Int).Sub(risk.NC, risk.IMR)
+	if maxWithdrawable.Sign() < 0 {
+		maxWithdrawable.SetInt64(0)
+	}
+	return maxWithdrawable
+}
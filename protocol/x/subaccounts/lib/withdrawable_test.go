@@ -0,0 +1,41 @@
+package lib_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/lib"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxWithdrawableQuoteQuantums(t *testing.T) {
+	tests := map[string]struct {
+		risk margin.Risk
+
+		expected *big.Int
+	}{
+		"NC > IMR": {
+			risk:     margin.Risk{NC: big.NewInt(300), IMR: big.NewInt(100), MMR: big.NewInt(50)},
+			expected: big.NewInt(200),
+		},
+		"NC = IMR": {
+			risk:     margin.Risk{NC: big.NewInt(100), IMR: big.NewInt(100), MMR: big.NewInt(50)},
+			expected: big.NewInt(0),
+		},
+		"NC < IMR": {
+			risk:     margin.Risk{NC: big.NewInt(75), IMR: big.NewInt(100), MMR: big.NewInt(50)},
+			expected: big.NewInt(0),
+		},
+		"NC < 0, IMR = 0": {
+			risk:     margin.Risk{NC: big.NewInt(-100), IMR: big.NewInt(0), MMR: big.NewInt(0)},
+			expected: big.NewInt(0),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, 0, tc.expected.Cmp(lib.MaxWithdrawableQuoteQuantums(tc.risk)))
+		})
+	}
+}
@@ -71,7 +71,6 @@ func TestAppModuleBasic_RegisterCodecLegacyAmino(t *testing.T) {
 	var buf bytes.Buffer
 	err := cdc.Amino.PrintTypes(&buf)
 	require.NoError(t, err)
-	require.NotContains(t, buf.String(), "Msg") // subaccounts does not support any messages.
 }
 
 func TestAppModuleBasic_RegisterInterfaces(t *testing.T) {
@@ -83,7 +82,7 @@ func TestAppModuleBasic_RegisterInterfaces(t *testing.T) {
 	// due to it using an unexported method on the interface thus we use reflection to access the field
 	// directly that contains the registrations.
 	fv := reflect.ValueOf(registry).Elem().FieldByName("implInterfaces")
-	require.Len(t, fv.MapKeys(), 0)
+	require.Len(t, fv.MapKeys(), 10)
 }
 
 func TestAppModuleBasic_DefaultGenesis(t *testing.T) {
@@ -94,7 +93,10 @@ func TestAppModuleBasic_DefaultGenesis(t *testing.T) {
 	result := am.DefaultGenesis(cdc)
 	json, err := result.MarshalJSON()
 	require.NoError(t, err)
-	require.Equal(t, `{"subaccounts":[]}`, string(json))
+	expected := `{"subaccounts":[],"params":{"trading_halted":false,"liquidation_grace_period_blocks":0,`
+	expected += `"initial_margin_buffer_ppm":0,"max_perpetual_positions":0,"external_collateral_enabled":false,`
+	expected += `"risk_sweep_budget":"10000","dust_nc_threshold":"0"},"max_leverages":[]}`
+	require.Equal(t, expected, string(json))
 }
 
 func TestAppModuleBasic_ValidateGenesisErrInvalidJSON(t *testing.T) {
@@ -196,9 +198,34 @@ func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
 
 	cmd := am.GetQueryCmd()
 	require.Equal(t, "subaccounts", cmd.Use)
-	require.Equal(t, 2, len(cmd.Commands()))
-	require.Equal(t, "list-subaccount", cmd.Commands()[0].Name())
-	require.Equal(t, "show-subaccount", cmd.Commands()[1].Name())
+	require.Equal(t, 27, len(cmd.Commands()))
+	require.Equal(t, "action-to-restore-initial-margin", cmd.Commands()[0].Name())
+	require.Equal(t, "auto-withdrawable-accounts", cmd.Commands()[1].Name())
+	require.Equal(t, "basket-shock-to-liquidate", cmd.Commands()[2].Name())
+	require.Equal(t, "break-even-price", cmd.Commands()[3].Name())
+	require.Equal(t, "close-all-cost", cmd.Commands()[4].Name())
+	require.Equal(t, "exponent-migration-impact", cmd.Commands()[5].Name())
+	require.Equal(t, "flattening-size", cmd.Commands()[6].Name())
+	require.Equal(t, "funding-history", cmd.Commands()[7].Name())
+	require.Equal(t, "health-distribution", cmd.Commands()[8].Name())
+	require.Equal(t, "liquidatable-accounts", cmd.Commands()[9].Name())
+	require.Equal(t, "liquidation-prices", cmd.Commands()[10].Name())
+	require.Equal(t, "liquidation-proceeds-estimate", cmd.Commands()[11].Name())
+	require.Equal(t, "list-subaccount", cmd.Commands()[12].Name())
+	require.Equal(t, "loss-buffer-to-liquidation", cmd.Commands()[13].Name())
+	require.Equal(t, "margin-delta-for-tier-change", cmd.Commands()[14].Name())
+	require.Equal(t, "market-nc-sensitivity", cmd.Commands()[15].Name())
+	require.Equal(t, "market-unrealized-pnl", cmd.Commands()[16].Name())
+	require.Equal(t, "min-liquidating-price-move", cmd.Commands()[17].Name())
+	require.Equal(t, "position-notional", cmd.Commands()[18].Name())
+	require.Equal(t, "protocol-net-delta", cmd.Commands()[19].Name())
+	require.Equal(t, "realized-pnl-on-close", cmd.Commands()[20].Name())
+	require.Equal(t, "risk-at-height", cmd.Commands()[21].Name())
+	require.Equal(t, "risk-under-historical-shocks", cmd.Commands()[22].Name())
+	require.Equal(t, "show-subaccount", cmd.Commands()[23].Name())
+	require.Equal(t, "total-maintenance-margin", cmd.Commands()[24].Name())
+	require.Equal(t, "total-value-locked", cmd.Commands()[25].Name())
+	require.Equal(t, "withdrawable-check", cmd.Commands()[26].Name())
 }
 
 func TestAppModule_Name(t *testing.T) {
@@ -212,9 +239,9 @@ func TestAppModule_RegisterServices(t *testing.T) {
 	mockMsgServer := new(mocks.Server)
 
 	mockConfigurator.On("QueryServer").Return(mockQueryServer)
-	// Since there's no MsgServer for Subaccounts module, configurator does not call `MsgServer`.
+	mockConfigurator.On("MsgServer").Return(mockMsgServer)
 	mockQueryServer.On("RegisterService", mock.Anything, mock.Anything).Return()
-	// Since there's no MsgServer for Subaccounts module, MsgServer does not call `RegisterServer`.
+	mockMsgServer.On("RegisterService", mock.Anything, mock.Anything).Return()
 
 	am := createAppModule(t)
 	am.RegisterServices(mockConfigurator)
@@ -242,7 +269,10 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 	genesisJson := am.ExportGenesis(ctx, cdc)
 	expected := `{"subaccounts":[{"id":{"owner":"foo","number":127},`
 	expected += `"asset_positions":[{"asset_id":0,"quantums":"1000","index":"0"}],`
-	expected += `"perpetual_positions":[],"margin_enabled":false}]}`
+	expected += `"perpetual_positions":[],"margin_enabled":false}],`
+	expected += `"params":{"trading_halted":false,"liquidation_grace_period_blocks":0,"initial_margin_buffer_ppm":0,`
+	expected += `"max_perpetual_positions":0,"external_collateral_enabled":false,"risk_sweep_budget":"10000",`
+	expected += `"dust_nc_threshold":"0"},"max_leverages":[]}`
 	require.Equal(t, expected, string(genesisJson))
 }
 
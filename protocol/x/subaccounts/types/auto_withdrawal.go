@@ -0,0 +1,13 @@
+package types
+
+import (
+	"math/big"
+)
+
+// AutoWithdrawal is an amount of USDC that can be withdrawn from an over-collateralized subaccount while it
+// remains at or above its initial margin requirement.
+type AutoWithdrawal struct {
+	SubaccountId SubaccountId
+	// The amount that can be withdrawn in quote quantums.
+	QuoteQuantums *big.Int
+}
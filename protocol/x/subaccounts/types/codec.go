@@ -3,12 +3,15 @@ package types
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/dydxprotocol/v4-chain/protocol/app/module"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {}
 
-func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {}
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
 
 var (
 	Amino     = codec.NewLegacyAmino()
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StakedTokenCollateralHaircutPpm is the haircut applied to the value of staked tokens that count as
+// external collateral, in parts-per-million.
+const StakedTokenCollateralHaircutPpm uint32 = 500_000
+
+// CollateralSource provides collateral of a subaccount that is held outside of its asset positions, such
+// as tokens staked by its owner, and can count towards its net collateral. Collateral shared by all
+// subaccounts of an owner must only be provided for the owner's subaccount number 0, so that it is not
+// counted more than once.
+type CollateralSource interface {
+	// GetCollateral returns the value of the collateral of the subaccount in quote quantums, after any
+	// haircut applied by the source.
+	GetCollateral(ctx sdk.Context, subaccountId SubaccountId) (*big.Int, error)
+}
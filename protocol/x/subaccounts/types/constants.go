@@ -0,0 +1,8 @@
+package types
+
+const (
+	// DefaultRiskSweepBudget is the maximum number of perpetual positions whose risk is computed by a sweep
+	// over all subaccounts in a single block if no risk sweep budget is set, so that the cost of the sweep
+	// stays bounded as the number of subaccounts grows.
+	DefaultRiskSweepBudget uint64 = 10_000
+)
@@ -29,6 +29,21 @@ var (
 		ModuleName, 101, "multiple updates were specified for the same subaccountId")
 	ErrFailedToUpdateSubaccounts   = errorsmod.Register(ModuleName, 102, "failed to apply subaccount updates")
 	ErrProductPositionNotUpdatable = errorsmod.Register(ModuleName, 103, "product position is not updatable")
+	ErrInvalidHedgedPair           = errorsmod.Register(
+		ModuleName,
+		104,
+		"legs of a hedged pair must be in different perpetuals",
+	)
+	ErrHedgedPairUndercollateralized = errorsmod.Register(
+		ModuleName,
+		105,
+		"hedged pair would leave the subaccount undercollateralized",
+	)
+	ErrHedgedPairLegZeroQuantums = errorsmod.Register(
+		ModuleName,
+		106,
+		"legs of a hedged pair must change the size of their position",
+	)
 
 	// 200 - 299: subaccount id related.
 	ErrInvalidSubaccountIdNumber = errorsmod.Register(
@@ -45,6 +60,8 @@ var (
 	ErrAssetPositionNotSupported      = errorsmod.Register(ModuleName, 302, "asset position is not supported")
 	ErrMultAssetPositionsNotSupported = errorsmod.Register(
 		ModuleName, 303, "having multiple asset positions is not supported")
+	ErrDuplicateAssetPositions = errorsmod.Register(
+		ModuleName, 304, "asset positions contain multiple positions for the same asset")
 
 	// 400 - 499: perpetual position related.
 	ErrPerpPositionsOutOfOrder = errorsmod.Register(ModuleName, 400, "perpetual positions are out of order")
@@ -63,12 +80,27 @@ var (
 		403,
 		"cannot revert perpetual open interest for OIMF calculation",
 	)
+	ErrPerpPositionCostBasisUnknown = errorsmod.Register(
+		ModuleName,
+		404,
+		"perpetual position's cost basis is unknown",
+	)
+	ErrPerpPositionNotFound = errorsmod.Register(
+		ModuleName,
+		405,
+		"perpetual position does not exist",
+	)
 
 	// 500 - 599: transfer related.
 	ErrAssetTransferQuantumsNotPositive = errorsmod.Register(
 		ModuleName, 500, "asset transfer quantums is not positive")
 	ErrAssetTransferThroughBankNotImplemented = errorsmod.Register(
 		ModuleName, 501, "asset transfer (other than USDC) through the bank module is not implemented")
+	ErrInvalidCollateralRebalance = errorsmod.Register(
+		ModuleName,
+		502,
+		"collateral can only be rebalanced between a cross subaccount and an isolated position of the same owner",
+	)
 
 	// 600 - 699: safety heap related.
 	ErrSafetyHeapEmpty                     = errorsmod.Register(ModuleName, 600, "safety heap is empty")
@@ -78,4 +110,68 @@ var (
 		"subaccount not found at index in safety heap",
 	)
 	ErrSafetyHeapSubaccountIndexNotFound = errorsmod.Register(ModuleName, 602, "subaccount index not found")
+
+	// 700 - 799: margin mode related.
+	ErrInvalidMarginMode                   = errorsmod.Register(ModuleName, 700, "margin mode is invalid")
+	ErrMarginModeChangeUndercollateralized = errorsmod.Register(
+		ModuleName,
+		701,
+		"subaccount would be undercollateralized under the new margin mode",
+	)
+	ErrIsolatedMarginModeMultiplePerpetuals = errorsmod.Register(
+		ModuleName,
+		702,
+		"subaccount in isolated margin mode cannot hold positions in multiple perpetuals",
+	)
+
+	// 800 - 899: state encoding related.
+	ErrInvalidSubaccountRecord            = errorsmod.Register(ModuleName, 800, "subaccount record is invalid")
+	ErrUnsupportedSubaccountRecordVersion = errorsmod.Register(
+		ModuleName,
+		801,
+		"subaccount record version is not supported",
+	)
+
+	// 900 - 999: historical state related.
+	ErrHistoricalStateUnavailable = errorsmod.Register(
+		ModuleName,
+		900,
+		"historical state is unavailable at the requested height",
+	)
+	ErrInvalidFundingHistoryEncoding = errorsmod.Register(
+		ModuleName,
+		901,
+		"funding history entry encoding is invalid",
+	)
+	ErrInvalidFundingHistoryRange = errorsmod.Register(
+		ModuleName,
+		902,
+		"funding history epoch range is invalid",
+	)
+
+	// 1000 - 1099: risk computation related.
+	ErrPerpetualInfoNotFound = errorsmod.Register(
+		ModuleName,
+		1000,
+		"perpetual info of a position is not found",
+	)
+	ErrPriceMissing      = errorsmod.Register(ModuleName, 1001, "price of a perpetual is missing")
+	ErrDuplicatePosition = errorsmod.Register(
+		ModuleName,
+		1002,
+		"subaccount contains multiple positions for the same asset or perpetual",
+	)
+	ErrOverflow              = errorsmod.Register(ModuleName, 1003, "quantum conversion exponent overflows int32")
+	ErrInvalidProtectiveStop = errorsmod.Register(ModuleName, 1004, "protective stop quantums is not positive")
+	ErrFeeTiersKeeperNotSet  = errorsmod.Register(ModuleName, 1005, "fee tiers keeper is not set")
+
+	ErrMaintenanceMarginExceedsInitial = errorsmod.Register(
+		ModuleName,
+		1006,
+		"maintenance margin requirement of a position exceeds its initial margin requirement",
+	)
+
+	// 1100 - 1199: governance and params related.
+	ErrInvalidAuthority   = errorsmod.Register(ModuleName, 1100, "Authority is invalid")
+	ErrInvalidMaxLeverage = errorsmod.Register(ModuleName, 1101, "max leverage ppm must be positive")
 )
@@ -5,11 +5,13 @@ import (
 	"math/big"
 	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	blocktimetypes "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
 	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	rewardstypes "github.com/dydxprotocol/v4-chain/protocol/x/rewards/types"
 )
 
 type AssetsKeeper interface {
@@ -82,6 +84,7 @@ type PerpetualsKeeper interface {
 	GetInsuranceFundModuleAddress(ctx sdk.Context, perpetualId uint32) (sdk.AccAddress, error)
 	IsIsolatedPerpetual(ctx sdk.Context, perpetualId uint32) (bool, error)
 	ModifyOpenInterest(ctx sdk.Context, perpetualId uint32, bigQuantums *big.Int) error
+	GetFundingTickEpoch(ctx sdk.Context) (epoch uint32, found bool)
 }
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
@@ -105,3 +108,23 @@ type BankKeeper interface {
 type BlocktimeKeeper interface {
 	GetDowntimeInfoFor(ctx sdk.Context, duration time.Duration) blocktimetypes.AllDowntimeInfo_DowntimeInfo
 }
+
+// StakingKeeper defines the expected interface needed to retrieve the tokens staked by an account.
+type StakingKeeper interface {
+	GetDelegatorBonded(ctx context.Context, delegator sdk.AccAddress) (math.Int, error)
+}
+
+// RewardsKeeper defines the expected interface needed to retrieve the market of the reward token.
+type RewardsKeeper interface {
+	GetParams(ctx sdk.Context) rewardstypes.Params
+}
+
+// PricesKeeper defines the expected interface needed to retrieve market prices.
+type PricesKeeper interface {
+	GetMarketPrice(ctx sdk.Context, id uint32) (pricestypes.MarketPrice, error)
+}
+
+// FeeTiersKeeper defines the expected interface needed to retrieve the trading fee rates of an account.
+type FeeTiersKeeper interface {
+	GetPerpetualFeePpm(ctx sdk.Context, address string, isTaker bool) int32
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+)
+
+// FundingHistoryRetentionEpochs is the number of funding-tick epochs for which the funding history of a
+// perpetual position is retained, in a ring buffer with one slot per epoch. With hourly funding ticks this
+// retains 30 days of funding history.
+const FundingHistoryRetentionEpochs uint32 = 720
+
+// FundingHistoryEntry is the funding settled for a perpetual position of a subaccount during a funding-tick
+// epoch. Funding is settled lazily, so the funding settled during an epoch may have accrued over earlier
+// epochs.
+type FundingHistoryEntry struct {
+	// The funding-tick epoch during which the funding was settled.
+	Epoch uint32
+	// The funding settled in quote quantums. Positive if the subaccount paid funding, and negative if the
+	// subaccount received funding.
+	FundingPaid *big.Int
+}
+
+// MarshalBinary encodes the entry as the big-endian epoch followed by the encoding of the funding paid.
+func (e FundingHistoryEntry) MarshalBinary() ([]byte, error) {
+	fundingPaid, err := dtypes.NewIntFromBigInt(e.FundingPaid).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	bz := make([]byte, 0, 4+len(fundingPaid))
+	bz = binary.BigEndian.AppendUint32(bz, e.Epoch)
+	return append(bz, fundingPaid...), nil
+}
+
+// UnmarshalBinary decodes an entry encoded by `MarshalBinary`.
+func (e *FundingHistoryEntry) UnmarshalBinary(bz []byte) error {
+	if len(bz) < 4 {
+		return errorsmod.Wrapf(ErrInvalidFundingHistoryEncoding, "length = %d", len(bz))
+	}
+	var fundingPaid dtypes.SerializableInt
+	if err := fundingPaid.Unmarshal(bz[4:]); err != nil {
+		return errorsmod.Wrap(ErrInvalidFundingHistoryEncoding, err.Error())
+	}
+	*e = FundingHistoryEntry{
+		Epoch:       binary.BigEndian.Uint32(bz[0:4]),
+		FundingPaid: fundingPaid.BigInt(),
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFundingHistoryEntry_BinaryRoundTrip(t *testing.T) {
+	for _, entry := range []types.FundingHistoryEntry{
+		{Epoch: 0, FundingPaid: big.NewInt(0)},
+		{Epoch: 1, FundingPaid: big.NewInt(1_200_000_000)},
+		{Epoch: 1<<32 - 1, FundingPaid: new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 100))},
+	} {
+		bz, err := entry.MarshalBinary()
+		require.NoError(t, err)
+		var decoded types.FundingHistoryEntry
+		require.NoError(t, decoded.UnmarshalBinary(bz))
+		require.Equal(t, entry.Epoch, decoded.Epoch)
+		require.Equal(t, 0, entry.FundingPaid.Cmp(decoded.FundingPaid))
+	}
+
+	var decoded types.FundingHistoryEntry
+	require.ErrorIs(t, decoded.UnmarshalBinary([]byte{0, 0, 1}), types.ErrInvalidFundingHistoryEncoding)
+}
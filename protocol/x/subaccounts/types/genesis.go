@@ -7,7 +7,9 @@ import (
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Subaccounts: []Subaccount{},
+		Subaccounts:  []Subaccount{},
+		Params:       DefaultParams(),
+		MaxLeverages: []SubaccountMaxLeverage{},
 	}
 }
 
@@ -56,5 +58,22 @@ func (gs GenesisState) Validate() error {
 			}
 		}
 	}
+
+	includedMaxLeverages := make(map[SubaccountId]bool)
+	for _, maxLeverage := range gs.MaxLeverages {
+		if err := maxLeverage.SubaccountId.Validate(); err != nil {
+			return err
+		}
+		if includedMaxLeverages[maxLeverage.SubaccountId] {
+			return errorsmod.Wrapf(ErrDuplicateSubaccountIds,
+				"duplicate max leverage for subaccount id %+v found within genesis state", maxLeverage.SubaccountId)
+		}
+		includedMaxLeverages[maxLeverage.SubaccountId] = true
+
+		if maxLeverage.MaxLeveragePpm == 0 {
+			return errorsmod.Wrapf(ErrInvalidMaxLeverage,
+				"max leverage of subaccount id %+v is zero", maxLeverage.SubaccountId)
+		}
+	}
 	return nil
 }
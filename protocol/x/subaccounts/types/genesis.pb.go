@@ -26,6 +26,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the subaccounts module's genesis state.
 type GenesisState struct {
 	Subaccounts []Subaccount `protobuf:"bytes,1,rep,name=subaccounts,proto3" json:"subaccounts"`
+	// The parameters of the module.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// The self-imposed leverage caps of subaccounts.
+	MaxLeverages []SubaccountMaxLeverage `protobuf:"bytes,3,rep,name=max_leverages,json=maxLeverages,proto3" json:"max_leverages"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,8 +72,78 @@ func (m *GenesisState) GetSubaccounts() []Subaccount {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetMaxLeverages() []SubaccountMaxLeverage {
+	if m != nil {
+		return m.MaxLeverages
+	}
+	return nil
+}
+
+// SubaccountMaxLeverage is the self-imposed leverage cap of a subaccount.
+type SubaccountMaxLeverage struct {
+	// The subaccount the leverage cap applies to.
+	SubaccountId SubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+	// The leverage cap, in parts-per-million.
+	MaxLeveragePpm uint32 `protobuf:"varint,2,opt,name=max_leverage_ppm,json=maxLeveragePpm,proto3" json:"max_leverage_ppm,omitempty"`
+}
+
+func (m *SubaccountMaxLeverage) Reset()         { *m = SubaccountMaxLeverage{} }
+func (m *SubaccountMaxLeverage) String() string { return proto.CompactTextString(m) }
+func (*SubaccountMaxLeverage) ProtoMessage()    {}
+func (*SubaccountMaxLeverage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82521a0c7c1b8867, []int{1}
+}
+func (m *SubaccountMaxLeverage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubaccountMaxLeverage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubaccountMaxLeverage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubaccountMaxLeverage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubaccountMaxLeverage.Merge(m, src)
+}
+func (m *SubaccountMaxLeverage) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubaccountMaxLeverage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubaccountMaxLeverage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubaccountMaxLeverage proto.InternalMessageInfo
+
+func (m *SubaccountMaxLeverage) GetSubaccountId() SubaccountId {
+	if m != nil {
+		return m.SubaccountId
+	}
+	return SubaccountId{}
+}
+
+func (m *SubaccountMaxLeverage) GetMaxLeveragePpm() uint32 {
+	if m != nil {
+		return m.MaxLeveragePpm
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dydxprotocol.subaccounts.GenesisState")
+	proto.RegisterType((*SubaccountMaxLeverage)(nil), "dydxprotocol.subaccounts.SubaccountMaxLeverage")
 }
 
 func init() {
@@ -77,20 +151,28 @@ func init() {
 }
 
 var fileDescriptor_82521a0c7c1b8867 = []byte{
-	// 199 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0xa9, 0x4c, 0xa9,
-	0x28, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x2f, 0x2e, 0x4d, 0x4a, 0x4c, 0x4e, 0xce,
-	0x2f, 0xcd, 0x2b, 0x29, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x03, 0x4b, 0x0a,
-	0x49, 0x20, 0xab, 0xd3, 0x43, 0x52, 0x27, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x96, 0xd1, 0x07,
-	0xb1, 0x20, 0xea, 0xa5, 0x34, 0x71, 0x9a, 0x8b, 0x60, 0x43, 0x94, 0x2a, 0xc5, 0x70, 0xf1, 0xb8,
-	0x43, 0xec, 0x0a, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0xf2, 0xe1, 0xe2, 0x46, 0x52, 0x2f, 0xc1, 0xa8,
-	0xc0, 0xac, 0xc1, 0x6d, 0xa4, 0xa2, 0x87, 0xcb, 0x01, 0x7a, 0xc1, 0x70, 0xb6, 0x13, 0xcb, 0x89,
-	0x7b, 0xf2, 0x0c, 0x41, 0xc8, 0xda, 0x9d, 0xc2, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e,
-	0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58,
-	0x8e, 0x21, 0xca, 0x36, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xc5,
-	0xb5, 0x65, 0x26, 0xba, 0xc9, 0x19, 0x89, 0x99, 0x79, 0xfa, 0x70, 0x91, 0x0a, 0x14, 0x1f, 0x94,
-	0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x65, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd9,
-	0xd9, 0x30, 0xf3, 0x42, 0x01, 0x00, 0x00,
+	// 328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x41, 0x4b, 0x02, 0x41,
+	0x14, 0xc7, 0x77, 0x32, 0x3c, 0x8c, 0x1a, 0x31, 0x14, 0x2c, 0x1e, 0x26, 0x91, 0x12, 0x3b, 0xb4,
+	0x03, 0xd6, 0xb5, 0x0e, 0x5e, 0x22, 0x30, 0x30, 0x3d, 0x04, 0x5e, 0x64, 0xdc, 0x1d, 0xd6, 0x05,
+	0xc7, 0x19, 0x9c, 0x51, 0xd6, 0x6f, 0xd1, 0xa1, 0x0f, 0xe5, 0xd1, 0x63, 0xa7, 0x08, 0xfd, 0x12,
+	0x1d, 0xa3, 0xd9, 0xcd, 0x1d, 0xa1, 0x05, 0x6f, 0x8f, 0xf7, 0xfe, 0xff, 0xff, 0xfb, 0xcd, 0x3c,
+	0xd8, 0x08, 0x96, 0x41, 0x2c, 0x67, 0x42, 0x0b, 0x5f, 0x4c, 0x88, 0x9a, 0x8f, 0xa8, 0xef, 0x8b,
+	0xf9, 0x54, 0x2b, 0x12, 0xb2, 0x29, 0x53, 0x91, 0xf2, 0xcc, 0x10, 0xb9, 0xb6, 0xce, 0xb3, 0x74,
+	0xd5, 0xb3, 0x50, 0x84, 0xc2, 0x4c, 0xc8, 0x6f, 0x95, 0xe8, 0xab, 0x57, 0xb9, 0xb9, 0x92, 0xce,
+	0x28, 0x4f, 0x63, 0xab, 0xd7, 0xb9, 0xb2, 0xac, 0x4e, 0xa4, 0xf5, 0x6f, 0x00, 0xcb, 0x8f, 0x09,
+	0x53, 0x5f, 0x53, 0xcd, 0x50, 0x07, 0x96, 0x2c, 0x83, 0x0b, 0x6a, 0x85, 0x66, 0xa9, 0x75, 0xe9,
+	0xe5, 0x81, 0x7a, 0xfd, 0x5d, 0xdd, 0x3e, 0x5e, 0x7d, 0x5e, 0x38, 0x3d, 0xdb, 0x8e, 0x1e, 0x60,
+	0x31, 0x21, 0x73, 0x8f, 0x6a, 0xa0, 0x59, 0x6a, 0xd5, 0xf2, 0x83, 0xba, 0x46, 0x97, 0x86, 0xa4,
+	0x2e, 0x34, 0x80, 0x15, 0x4e, 0xe3, 0xe1, 0x84, 0x2d, 0xd8, 0x8c, 0x86, 0x4c, 0xb9, 0x05, 0xc3,
+	0x43, 0x0e, 0xe1, 0x79, 0xa6, 0x71, 0x27, 0xf5, 0xa5, 0xa9, 0x65, 0x9e, 0xb5, 0x54, 0xfd, 0x1d,
+	0xc0, 0xf3, 0x7f, 0xd5, 0xe8, 0x05, 0x56, 0xb2, 0xc8, 0x61, 0x14, 0xb8, 0xc0, 0xc0, 0x37, 0x0e,
+	0xd9, 0xfa, 0x14, 0xfc, 0x2d, 0x53, 0x56, 0x0f, 0x35, 0xe1, 0xa9, 0xfd, 0x90, 0xa1, 0x94, 0xdc,
+	0x7c, 0x49, 0xa5, 0x77, 0x62, 0x41, 0x75, 0x25, 0x6f, 0xbf, 0xae, 0x36, 0x18, 0xac, 0x37, 0x18,
+	0x7c, 0x6d, 0x30, 0x78, 0xdb, 0x62, 0x67, 0xbd, 0xc5, 0xce, 0xc7, 0x16, 0x3b, 0x83, 0xfb, 0x30,
+	0xd2, 0xe3, 0xf9, 0xc8, 0xf3, 0x05, 0x27, 0x7b, 0x17, 0x5e, 0xdc, 0xdd, 0xf8, 0x63, 0x1a, 0x4d,
+	0xc9, 0xae, 0x13, 0xef, 0x5d, 0x5d, 0x2f, 0x25, 0x53, 0xa3, 0xa2, 0x99, 0xde, 0xfe, 0x0c, 0x00,
+	0x46, 0x9a, 0x11, 0x1f, 0x9d, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -113,6 +195,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MaxLeverages) > 0 {
+		for iNdEx := len(m.MaxLeverages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxLeverages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Subaccounts) > 0 {
 		for iNdEx := len(m.Subaccounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -130,6 +236,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SubaccountMaxLeverage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubaccountMaxLeverage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubaccountMaxLeverage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxLeveragePpm != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxLeveragePpm))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -153,6 +297,28 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MaxLeverages) > 0 {
+		for _, e := range m.MaxLeverages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubaccountMaxLeverage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.MaxLeveragePpm != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxLeveragePpm))
+	}
 	return n
 }
 
@@ -225,6 +391,175 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeverages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxLeverages = append(m.MaxLeverages, SubaccountMaxLeverage{})
+			if err := m.MaxLeverages[len(m.MaxLeverages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubaccountMaxLeverage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubaccountMaxLeverage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubaccountMaxLeverage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeveragePpm", wireType)
+			}
+			m.MaxLeveragePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLeveragePpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
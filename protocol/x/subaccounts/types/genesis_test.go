@@ -223,6 +223,83 @@ func TestGenesisState_Validate(t *testing.T) {
 			shouldPanic:   false,
 			expectedError: types.ErrPerpPositionZeroQuantum,
 		},
+		"valid: params and max leverages": {
+			genState: &types.GenesisState{
+				Params: types.Params{
+					TradingHalted:                true,
+					LiquidationGracePeriodBlocks: 10,
+					InitialMarginBufferPpm:       100_000,
+					MaxPerpetualPositions:        5,
+					ExternalCollateralEnabled:    true,
+				},
+				MaxLeverages: []types.SubaccountMaxLeverage{
+					{
+						SubaccountId: types.SubaccountId{
+							Owner:  duplicateOwnerId,
+							Number: uint32(0),
+						},
+						MaxLeveragePpm: 5_000_000,
+					},
+					{
+						SubaccountId: types.SubaccountId{
+							Owner:  duplicateOwnerId,
+							Number: uint32(1),
+						},
+						MaxLeveragePpm: 2_000_000,
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		"invalid: max leverage id owner is invalid": {
+			genState: &types.GenesisState{
+				MaxLeverages: []types.SubaccountMaxLeverage{
+					{
+						SubaccountId: types.SubaccountId{
+							Owner:  "this is not a valid bech32 address",
+							Number: uint32(0),
+						},
+						MaxLeveragePpm: 5_000_000,
+					},
+				},
+			},
+			expectedError: types.ErrInvalidSubaccountIdOwner,
+		},
+		"invalid: duplicate max leverage subaccount ids": {
+			genState: &types.GenesisState{
+				MaxLeverages: []types.SubaccountMaxLeverage{
+					{
+						SubaccountId: types.SubaccountId{
+							Owner:  duplicateOwnerId,
+							Number: uint32(0),
+						},
+						MaxLeveragePpm: 5_000_000,
+					},
+					{
+						SubaccountId: types.SubaccountId{
+							Owner:  duplicateOwnerId,
+							Number: uint32(0),
+						},
+						MaxLeveragePpm: 2_000_000,
+					},
+				},
+			},
+			expectedError: types.ErrDuplicateSubaccountIds,
+		},
+		"invalid: max leverage ppm == 0": {
+			genState: &types.GenesisState{
+				MaxLeverages: []types.SubaccountMaxLeverage{
+					{
+						SubaccountId: types.SubaccountId{
+							Owner:  duplicateOwnerId,
+							Number: uint32(0),
+						},
+						MaxLeveragePpm: 0,
+					},
+				},
+			},
+			expectedError: types.ErrInvalidMaxLeverage,
+		},
 	}
 
 	for name, tc := range tests {
@@ -7,6 +7,9 @@ const (
 
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
+
+	// TransientStoreKey defines the transient store key, which is cleared at the end of every block.
+	TransientStoreKey = "tmp_" + ModuleName
 )
 
 // State
@@ -19,6 +22,61 @@ const (
 	// Suffix for the store key to the last block a negative TNC subaccount was seen in state for the
 	// cross collateral pool.
 	CrossCollateralSuffix = "cross"
+	// MarginModeKeyPrefix is the prefix for the store key that stores the margin mode of a subaccount.
+	// Subaccounts without a stored margin mode use cross margin.
+	MarginModeKeyPrefix = "MM:"
+	// TradingHaltedKey is the store key that stores whether trading is halted across all subaccounts.
+	TradingHaltedKey = "TradingHalted"
+	// LiquidationGracePeriodBlocksKey is the store key that stores the number of blocks a subaccount must
+	// remain below its maintenance margin requirement before it can be liquidated.
+	LiquidationGracePeriodBlocksKey = "LiqGracePeriod"
+	// UndercollateralizedSinceBlockKeyPrefix is the prefix for the store key that stores the block at
+	// which a subaccount was first seen below its maintenance margin requirement.
+	UndercollateralizedSinceBlockKeyPrefix = "Undercoll:"
+	// RiskSweepBudgetKey is the store key that stores the maximum number of perpetual positions whose risk
+	// is computed by a sweep over all subaccounts in a single block.
+	RiskSweepBudgetKey = "RiskSweepBudget"
+	// UndercollateralizedSweepNextKeyKey is the store key that stores the state key of the subaccount
+	// from which the sweep tracking undercollateralized subaccounts resumes in the next block.
+	UndercollateralizedSweepNextKeyKey = "UndercollSweepNext"
+	// DustNCThresholdKey is the store key that stores the net collateral threshold below which a subaccount
+	// with no perpetual positions is treated as empty.
+	DustNCThresholdKey = "DustNCThreshold"
+	// DustSweepNextKeyKey is the store key that stores the state key of the subaccount from which the
+	// sweep closing dust subaccounts resumes in the next block.
+	DustSweepNextKeyKey = "DustSweepNext"
+	// FundingSettlementEpochKey is the store key that stores the funding-tick epoch whose funding was last
+	// scheduled to be settled across all positions.
+	FundingSettlementEpochKey = "FundingSettleEpoch"
+	// FundingSettlementPerpetualKey is the store key that stores the id of the perpetual whose positions
+	// are being settled after a funding tick. It is absent once all perpetuals are settled.
+	FundingSettlementPerpetualKey = "FundingSettlePerp"
+	// FundingSettlementNextKeyKey is the store key that stores the state key of the subaccount from which
+	// settling the positions in the perpetual resumes in the next block.
+	FundingSettlementNextKeyKey = "FundingSettleNext"
+	// InitialMarginBufferPpmKey is the store key that stores the buffer, in parts-per-million of the initial
+	// margin requirement, that the net collateral of a subaccount must meet after an opening update.
+	InitialMarginBufferPpmKey = "InitialMarginBufferPpm"
+	// MaxLeveragePpmKeyPrefix is the prefix for the store key that stores the self-imposed leverage cap of a
+	// subaccount, in parts-per-million.
+	MaxLeveragePpmKeyPrefix = "MaxLeverage:"
+	// MaxPerpetualPositionsKey is the store key that stores the maximum number of perpetual positions a
+	// subaccount may hold.
+	MaxPerpetualPositionsKey = "MaxPerpPositions"
+	// FundingHistoryKeyPrefix is the prefix for the store key that stores the funding settled for a
+	// perpetual position of a subaccount during a funding-tick epoch.
+	FundingHistoryKeyPrefix = "FundingHistory:"
+	// ExternalCollateralEnabledKey is the store key that stores whether collateral held outside of the
+	// asset positions of subaccounts, such as staked tokens, counts towards their net collateral.
+	ExternalCollateralEnabledKey = "ExternalCollateralEnabled"
+	// LockedCollateralKeyPrefix is the prefix for the store key that stores the collateral of a subaccount,
+	// in quote quantums, that is locked by pending withdrawals and does not count towards its net collateral.
+	LockedCollateralKeyPrefix = "LockedColl:"
+
+	// Transient store
+	// IdempotencyKeyPrefix is the prefix for the transient store key that records the idempotency keys
+	// of the updates applied during the current block.
+	IdempotencyKeyPrefix = "IdemKey:"
 
 	// Safety Heap
 	SafetyHeapStorePrefix             = "SH"
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"math/big"
+)
+
+// DepthLevel is a price level of a depth model of the order book of a perpetual, on the side that a
+// liquidation of a position in the perpetual would trade against.
+type DepthLevel struct {
+	// The price of the level, in the exponent of the market price of the perpetual.
+	Price uint64
+	// The size available at the level, in base quantums.
+	Quantums uint64
+}
+
+// LiquidationProceedsEstimate is the estimated outcome of liquidating a perpetual position against a
+// depth model.
+type LiquidationProceedsEstimate struct {
+	// The size of the position closed by the liquidation, in base quantums. This is less than the size of
+	// the position if the depth model does not have enough size to close it.
+	FilledQuantums *big.Int
+	// The quote quantums received by the subaccount for the closed size. Negative for short positions,
+	// which pay to close.
+	ProceedsQuoteQuantums *big.Int
+	// The contribution of the liquidation to the insurance fund, in quote quantums. Positive if the
+	// insurance fund gains and negative if it pays.
+	InsuranceFundDeltaQuoteQuantums *big.Int
+}
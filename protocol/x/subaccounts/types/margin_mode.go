@@ -0,0 +1,32 @@
+package types
+
+// MarginMode is the margining mode of a subaccount.
+type MarginMode uint32
+
+const (
+	// CrossMargin allows a subaccount to hold positions in any number of perpetuals, all backed by
+	// the same collateral. This is the default margin mode of a subaccount.
+	CrossMargin MarginMode = iota
+	// IsolatedMargin restricts a subaccount to hold a position in at most one perpetual, so that the
+	// collateral of the subaccount only backs that position.
+	IsolatedMargin
+)
+
+var marginModeStringMap = map[MarginMode]string{
+	CrossMargin:    "CrossMargin",
+	IsolatedMargin: "IsolatedMargin",
+}
+
+func (m MarginMode) String() string {
+	result, exists := marginModeStringMap[m]
+	if !exists {
+		return "UnknownMarginMode"
+	}
+	return result
+}
+
+// IsValid returns true if the margin mode is a known margin mode.
+func (m MarginMode) IsValid() bool {
+	_, exists := marginModeStringMap[m]
+	return exists
+}
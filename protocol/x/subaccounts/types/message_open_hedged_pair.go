@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgOpenHedgedPair{}
+
+// ValidateBasic returns an error if the authority or the subaccount id is invalid, if either leg does not
+// change the size of its position, or if both legs are in the same perpetual.
+func (msg *MsgOpenHedgedPair) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(
+			ErrInvalidAuthority,
+			fmt.Sprintf(
+				"authority '%s' must be a valid bech32 address, but got error '%v'",
+				msg.Authority,
+				err.Error(),
+			),
+		)
+	}
+	if err := msg.SubaccountId.Validate(); err != nil {
+		return err
+	}
+	for _, leg := range []HedgedPairLeg{msg.LegA, msg.LegB} {
+		if leg.QuantumsDelta.IsNil() || leg.QuantumsDelta.BigInt().Sign() == 0 {
+			return errorsmod.Wrapf(ErrHedgedPairLegZeroQuantums, "perpetual id: %d", leg.PerpetualId)
+		}
+	}
+	if msg.LegA.PerpetualId == msg.LegB.PerpetualId {
+		return errorsmod.Wrapf(ErrInvalidHedgedPair, "perpetual id: %d", msg.LegA.PerpetualId)
+	}
+	return nil
+}
+
+// ToPerpetualUpdate returns the perpetual update that applies the leg.
+func (leg HedgedPairLeg) ToPerpetualUpdate() PerpetualUpdate {
+	quoteBalanceDelta := leg.QuoteBalanceDelta.BigInt()
+	if quoteBalanceDelta == nil {
+		quoteBalanceDelta = new(big.Int)
+	}
+	return PerpetualUpdate{
+		PerpetualId:          leg.PerpetualId,
+		BigQuantumsDelta:     leg.QuantumsDelta.BigInt(),
+		BigQuoteBalanceDelta: quoteBalanceDelta,
+	}
+}
@@ -0,0 +1,85 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgOpenHedgedPair_ValidateBasic(t *testing.T) {
+	longBtc := types.HedgedPairLeg{
+		PerpetualId:       0,
+		QuantumsDelta:     dtypes.NewInt(100_000_000),
+		QuoteBalanceDelta: dtypes.NewInt(-39_500_000_000),
+	}
+	shortEth := types.HedgedPairLeg{
+		PerpetualId:       1,
+		QuantumsDelta:     dtypes.NewInt(-1_000_000_000),
+		QuoteBalanceDelta: dtypes.NewInt(4_500_000_000),
+	}
+
+	tests := map[string]struct {
+		msg         types.MsgOpenHedgedPair
+		expectedErr error
+	}{
+		"Success": {
+			msg: types.MsgOpenHedgedPair{
+				Authority:    lib.GovModuleAddress.String(),
+				SubaccountId: constants.Alice_Num0,
+				LegA:         longBtc,
+				LegB:         shortEth,
+			},
+		},
+		"Failure: Invalid authority": {
+			msg: types.MsgOpenHedgedPair{
+				Authority:    "",
+				SubaccountId: constants.Alice_Num0,
+				LegA:         longBtc,
+				LegB:         shortEth,
+			},
+			expectedErr: types.ErrInvalidAuthority,
+		},
+		"Failure: Invalid subaccount owner": {
+			msg: types.MsgOpenHedgedPair{
+				Authority:    lib.GovModuleAddress.String(),
+				SubaccountId: types.SubaccountId{Owner: "invalid"},
+				LegA:         longBtc,
+				LegB:         shortEth,
+			},
+			expectedErr: types.ErrInvalidSubaccountIdOwner,
+		},
+		"Failure: Leg with zero quantums": {
+			msg: types.MsgOpenHedgedPair{
+				Authority:    lib.GovModuleAddress.String(),
+				SubaccountId: constants.Alice_Num0,
+				LegA:         longBtc,
+				LegB:         types.HedgedPairLeg{PerpetualId: 1},
+			},
+			expectedErr: types.ErrHedgedPairLegZeroQuantums,
+		},
+		"Failure: Legs in the same perpetual": {
+			msg: types.MsgOpenHedgedPair{
+				Authority:    lib.GovModuleAddress.String(),
+				SubaccountId: constants.Alice_Num0,
+				LegA:         longBtc,
+				LegB:         types.HedgedPairLeg{PerpetualId: 0, QuantumsDelta: dtypes.NewInt(-50_000_000)},
+			},
+			expectedErr: types.ErrInvalidHedgedPair,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgRebalanceCollateral{}
+
+// ValidateBasic returns an error if either subaccount id is invalid, the subaccounts are the same or have
+// different owners, or the amount to move is zero.
+func (msg *MsgRebalanceCollateral) ValidateBasic() error {
+	if err := msg.CrossSubaccountId.Validate(); err != nil {
+		return err
+	}
+	if err := msg.IsolatedSubaccountId.Validate(); err != nil {
+		return err
+	}
+	if msg.CrossSubaccountId.Owner != msg.IsolatedSubaccountId.Owner ||
+		msg.CrossSubaccountId.Number == msg.IsolatedSubaccountId.Number {
+		return errorsmod.Wrapf(
+			ErrInvalidCollateralRebalance,
+			"cross subaccount %v, isolated subaccount %v",
+			msg.CrossSubaccountId,
+			msg.IsolatedSubaccountId,
+		)
+	}
+	if msg.QuoteQuantums == 0 {
+		return errorsmod.Wrap(ErrInvalidCollateralRebalance, "quote quantums must be positive")
+	}
+	return nil
+}
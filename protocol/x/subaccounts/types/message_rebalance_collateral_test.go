@@ -0,0 +1,83 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRebalanceCollateral_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgRebalanceCollateral
+		expectedErr error
+	}{
+		"Success": {
+			msg: types.MsgRebalanceCollateral{
+				CrossSubaccountId:    constants.Alice_Num0,
+				IsolatedSubaccountId: constants.Alice_Num1,
+				PerpetualId:          3,
+				QuoteQuantums:        1_000_000,
+			},
+		},
+		"Success: move to the cross subaccount": {
+			msg: types.MsgRebalanceCollateral{
+				CrossSubaccountId:    constants.Alice_Num0,
+				IsolatedSubaccountId: constants.Alice_Num1,
+				PerpetualId:          3,
+				QuoteQuantums:        1_000_000,
+				ToCross:              true,
+			},
+		},
+		"Failure: Invalid owner": {
+			msg: types.MsgRebalanceCollateral{
+				CrossSubaccountId: types.SubaccountId{
+					Owner:  "invalid",
+					Number: 0,
+				},
+				IsolatedSubaccountId: constants.Alice_Num1,
+				PerpetualId:          3,
+				QuoteQuantums:        1_000_000,
+			},
+			expectedErr: types.ErrInvalidSubaccountIdOwner,
+		},
+		"Failure: Different owners": {
+			msg: types.MsgRebalanceCollateral{
+				CrossSubaccountId:    constants.Alice_Num0,
+				IsolatedSubaccountId: constants.Bob_Num1,
+				PerpetualId:          3,
+				QuoteQuantums:        1_000_000,
+			},
+			expectedErr: types.ErrInvalidCollateralRebalance,
+		},
+		"Failure: Same subaccount": {
+			msg: types.MsgRebalanceCollateral{
+				CrossSubaccountId:    constants.Alice_Num0,
+				IsolatedSubaccountId: constants.Alice_Num0,
+				PerpetualId:          3,
+				QuoteQuantums:        1_000_000,
+			},
+			expectedErr: types.ErrInvalidCollateralRebalance,
+		},
+		"Failure: Zero quote quantums": {
+			msg: types.MsgRebalanceCollateral{
+				CrossSubaccountId:    constants.Alice_Num0,
+				IsolatedSubaccountId: constants.Alice_Num1,
+				PerpetualId:          3,
+			},
+			expectedErr: types.ErrInvalidCollateralRebalance,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
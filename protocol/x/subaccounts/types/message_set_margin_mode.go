@@ -0,0 +1,19 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgSetMarginMode{}
+
+// ValidateBasic returns an error if the subaccount id or the margin mode is invalid.
+func (msg *MsgSetMarginMode) ValidateBasic() error {
+	if err := msg.SubaccountId.Validate(); err != nil {
+		return err
+	}
+	if !MarginMode(msg.MarginMode).IsValid() {
+		return errorsmod.Wrapf(ErrInvalidMarginMode, "%d", msg.MarginMode)
+	}
+	return nil
+}
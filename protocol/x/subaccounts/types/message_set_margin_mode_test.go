@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetMarginMode_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgSetMarginMode
+		expectedErr error
+	}{
+		"Success: cross margin": {
+			msg: types.MsgSetMarginMode{
+				SubaccountId: constants.Alice_Num0,
+				MarginMode:   uint32(types.CrossMargin),
+			},
+		},
+		"Success: isolated margin": {
+			msg: types.MsgSetMarginMode{
+				SubaccountId: constants.Alice_Num0,
+				MarginMode:   uint32(types.IsolatedMargin),
+			},
+		},
+		"Failure: Invalid owner": {
+			msg: types.MsgSetMarginMode{
+				SubaccountId: types.SubaccountId{
+					Owner:  "invalid",
+					Number: 0,
+				},
+				MarginMode: uint32(types.IsolatedMargin),
+			},
+			expectedErr: types.ErrInvalidSubaccountIdOwner,
+		},
+		"Failure: Invalid margin mode": {
+			msg: types.MsgSetMarginMode{
+				SubaccountId: constants.Alice_Num0,
+				MarginMode:   2,
+			},
+			expectedErr: types.ErrInvalidMarginMode,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgSetMaxLeverage{}
+
+// ValidateBasic returns an error if the subaccount id is invalid.
+func (msg *MsgSetMaxLeverage) ValidateBasic() error {
+	return msg.SubaccountId.Validate()
+}
@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetMaxLeverage_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgSetMaxLeverage
+		expectedErr error
+	}{
+		"Success": {
+			msg: types.MsgSetMaxLeverage{
+				SubaccountId:   constants.Alice_Num0,
+				MaxLeveragePpm: 5_000_000,
+			},
+		},
+		"Success: removes the cap": {
+			msg: types.MsgSetMaxLeverage{
+				SubaccountId:   constants.Alice_Num0,
+				MaxLeveragePpm: 0,
+			},
+		},
+		"Failure: Invalid owner": {
+			msg: types.MsgSetMaxLeverage{
+				SubaccountId: types.SubaccountId{
+					Owner:  "invalid",
+					Number: 0,
+				},
+				MaxLeveragePpm: 5_000_000,
+			},
+			expectedErr: types.ErrInvalidSubaccountIdOwner,
+		},
+		"Failure: Invalid number": {
+			msg: types.MsgSetMaxLeverage{
+				SubaccountId: types.SubaccountId{
+					Owner:  constants.AliceAccAddress.String(),
+					Number: types.MaxSubaccountIdNumber + 1,
+				},
+				MaxLeveragePpm: 5_000_000,
+			},
+			expectedErr: types.ErrInvalidSubaccountIdNumber,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
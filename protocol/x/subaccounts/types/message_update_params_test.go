@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgUpdateParams
+		expectedErr string
+	}{
+		"Success": {
+			msg: types.MsgUpdateParams{
+				Authority: lib.GovModuleAddress.String(),
+				Params: types.Params{
+					TradingHalted:          true,
+					InitialMarginBufferPpm: 100_000,
+				},
+			},
+		},
+		"Failure: Invalid authority": {
+			msg: types.MsgUpdateParams{
+				Authority: "",
+			},
+			expectedErr: "Authority is invalid",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
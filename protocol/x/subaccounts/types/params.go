@@ -0,0 +1,8 @@
+package types
+
+// DefaultParams returns the default parameters of the subaccounts module.
+func DefaultParams() Params {
+	return Params{
+		RiskSweepBudget: DefaultRiskSweepBudget,
+	}
+}
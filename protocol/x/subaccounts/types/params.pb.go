@@ -0,0 +1,557 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dydxprotocol/subaccounts/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for x/subaccounts module.
+type Params struct {
+	// If true, only updates that reduce the risk of a subaccount are allowed
+	// and all withdrawals and transfers are blocked.
+	TradingHalted bool `protobuf:"varint,1,opt,name=trading_halted,json=tradingHalted,proto3" json:"trading_halted,omitempty"`
+	// The number of blocks a subaccount must remain below its maintenance
+	// margin requirement before it can be liquidated. Zero disables the grace
+	// period.
+	LiquidationGracePeriodBlocks uint32 `protobuf:"varint,2,opt,name=liquidation_grace_period_blocks,json=liquidationGracePeriodBlocks,proto3" json:"liquidation_grace_period_blocks,omitempty"`
+	// The buffer, in parts-per-million of the initial margin requirement, that
+	// the net collateral of a subaccount must meet after an update that opens or
+	// increases a perpetual position. Zero disables the buffer.
+	InitialMarginBufferPpm uint32 `protobuf:"varint,3,opt,name=initial_margin_buffer_ppm,json=initialMarginBufferPpm,proto3" json:"initial_margin_buffer_ppm,omitempty"`
+	// The maximum number of perpetual positions a subaccount may hold. Zero
+	// disables the limit.
+	MaxPerpetualPositions uint32 `protobuf:"varint,4,opt,name=max_perpetual_positions,json=maxPerpetualPositions,proto3" json:"max_perpetual_positions,omitempty"`
+	// If true, collateral held outside of the asset positions of subaccounts,
+	// such as staked tokens, counts towards their net collateral.
+	ExternalCollateralEnabled bool `protobuf:"varint,5,opt,name=external_collateral_enabled,json=externalCollateralEnabled,proto3" json:"external_collateral_enabled,omitempty"`
+	// The maximum number of perpetual positions whose risk is computed by a
+	// sweep over all subaccounts in a single block. Sweeps that exceed the budget
+	// resume from where they stopped in the next block. Zero resets the budget
+	// to the default of 10,000.
+	RiskSweepBudget uint64 `protobuf:"varint,6,opt,name=risk_sweep_budget,json=riskSweepBudget,proto3" json:"risk_sweep_budget,omitempty"`
+	// The threshold, in quote quantums, below which the absolute net collateral
+	// of a subaccount with no perpetual positions is treated as dust. Dust
+	// subaccounts are closed at the end of each block. Zero treats no subaccount
+	// as dust.
+	DustNcThreshold uint64 `protobuf:"varint,7,opt,name=dust_nc_threshold,json=dustNcThreshold,proto3" json:"dust_nc_threshold,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69693939806cddf2, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetTradingHalted() bool {
+	if m != nil {
+		return m.TradingHalted
+	}
+	return false
+}
+
+func (m *Params) GetLiquidationGracePeriodBlocks() uint32 {
+	if m != nil {
+		return m.LiquidationGracePeriodBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetInitialMarginBufferPpm() uint32 {
+	if m != nil {
+		return m.InitialMarginBufferPpm
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPerpetualPositions() uint32 {
+	if m != nil {
+		return m.MaxPerpetualPositions
+	}
+	return 0
+}
+
+func (m *Params) GetExternalCollateralEnabled() bool {
+	if m != nil {
+		return m.ExternalCollateralEnabled
+	}
+	return false
+}
+
+func (m *Params) GetRiskSweepBudget() uint64 {
+	if m != nil {
+		return m.RiskSweepBudget
+	}
+	return 0
+}
+
+func (m *Params) GetDustNcThreshold() uint64 {
+	if m != nil {
+		return m.DustNcThreshold
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "dydxprotocol.subaccounts.Params")
+}
+
+func init() {
+	proto.RegisterFile("dydxprotocol/subaccounts/params.proto", fileDescriptor_69693939806cddf2)
+}
+
+var fileDescriptor_69693939806cddf2 = []byte{
+	// 384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0xd1, 0x4d, 0x8b, 0x13, 0x31,
+	0x1c, 0xc7, 0xf1, 0x8e, 0xbb, 0x56, 0x09, 0xac, 0xe2, 0x80, 0x9a, 0x45, 0x19, 0x8b, 0xb0, 0x50,
+	0x04, 0x3b, 0x07, 0x45, 0xf0, 0xa0, 0x87, 0x91, 0x45, 0x2f, 0xca, 0x50, 0x05, 0xc1, 0x4b, 0xf8,
+	0x4f, 0x92, 0xce, 0x84, 0x66, 0x92, 0x98, 0x07, 0x9d, 0x1e, 0x7d, 0x07, 0xbe, 0x2c, 0x8f, 0x3d,
+	0x7a, 0x94, 0xf6, 0x8d, 0xc8, 0xa4, 0x9d, 0xd2, 0x5e, 0x7f, 0xdf, 0xcf, 0x4c, 0x08, 0x41, 0x57,
+	0x6c, 0xc5, 0x3a, 0x63, 0xb5, 0xd7, 0x54, 0xcb, 0xdc, 0x85, 0x0a, 0x28, 0xd5, 0x41, 0x79, 0x97,
+	0x1b, 0xb0, 0xd0, 0xba, 0x59, 0x6c, 0x29, 0x3e, 0x66, 0xb3, 0x23, 0xf6, 0xf4, 0xd7, 0x19, 0x1a,
+	0x97, 0x91, 0xa6, 0x57, 0xe8, 0x8e, 0xb7, 0xc0, 0x84, 0xaa, 0x49, 0x03, 0xd2, 0x73, 0x86, 0x93,
+	0x49, 0x32, 0xbd, 0x3d, 0xbf, 0xd8, 0xaf, 0x1f, 0xe2, 0x98, 0x5e, 0xa3, 0x27, 0x52, 0x7c, 0x0f,
+	0x82, 0x81, 0x17, 0x5a, 0x91, 0xda, 0x02, 0xe5, 0xc4, 0x70, 0x2b, 0x34, 0x23, 0x95, 0xd4, 0x74,
+	0xe9, 0xf0, 0x8d, 0x49, 0x32, 0xbd, 0x98, 0x3f, 0x3e, 0x62, 0xef, 0x7b, 0x55, 0x46, 0x54, 0x44,
+	0x93, 0xbe, 0x46, 0x97, 0x42, 0x09, 0x2f, 0x40, 0x92, 0x16, 0x6c, 0x2d, 0x14, 0xa9, 0xc2, 0x62,
+	0xc1, 0x2d, 0x31, 0xa6, 0xc5, 0x67, 0xf1, 0x07, 0x0f, 0xf6, 0xe0, 0x63, 0xec, 0x45, 0xcc, 0xa5,
+	0x69, 0xd3, 0x57, 0xe8, 0x61, 0x0b, 0x5d, 0x7f, 0xa6, 0xe1, 0x3e, 0x80, 0x24, 0x46, 0x3b, 0xd1,
+	0x9f, 0xe2, 0xf0, 0x79, 0xfc, 0xf0, 0x7e, 0x0b, 0x5d, 0x39, 0xd4, 0x72, 0x88, 0xe9, 0x5b, 0xf4,
+	0x88, 0x77, 0x9e, 0x5b, 0x05, 0x92, 0x50, 0x2d, 0x25, 0x78, 0x6e, 0x41, 0x12, 0xae, 0xa0, 0x92,
+	0x9c, 0xe1, 0x9b, 0xf1, 0xb6, 0x97, 0x03, 0x79, 0x77, 0x10, 0xd7, 0x3b, 0x90, 0x3e, 0x43, 0xf7,
+	0xac, 0x70, 0x4b, 0xe2, 0x7e, 0x72, 0x6e, 0x48, 0x15, 0x58, 0xcd, 0x3d, 0x1e, 0x4f, 0x92, 0xe9,
+	0xf9, 0xfc, 0x6e, 0x1f, 0x3e, 0xf7, 0x7b, 0x11, 0xe7, 0xde, 0xb2, 0xe0, 0x3c, 0x51, 0x94, 0xf8,
+	0xc6, 0x72, 0xd7, 0x68, 0xc9, 0xf0, 0xad, 0x9d, 0xed, 0xc3, 0x27, 0xfa, 0x65, 0x98, 0x8b, 0xaf,
+	0x7f, 0x36, 0x59, 0xb2, 0xde, 0x64, 0xc9, 0xbf, 0x4d, 0x96, 0xfc, 0xde, 0x66, 0xa3, 0xf5, 0x36,
+	0x1b, 0xfd, 0xdd, 0x66, 0xa3, 0x6f, 0x6f, 0x6a, 0xe1, 0x9b, 0x50, 0xcd, 0xa8, 0x6e, 0xf3, 0x93,
+	0x97, 0xfe, 0xf1, 0xf2, 0x39, 0x6d, 0x40, 0xa8, 0xfc, 0xb0, 0x74, 0x27, 0xaf, 0xef, 0x57, 0x86,
+	0xbb, 0x6a, 0x1c, 0xeb, 0x8b, 0xff, 0x03, 0x00, 0x6a, 0xaa, 0x1e, 0x24, 0x26, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DustNcThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DustNcThreshold))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RiskSweepBudget != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RiskSweepBudget))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExternalCollateralEnabled {
+		i--
+		if m.ExternalCollateralEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxPerpetualPositions != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPerpetualPositions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InitialMarginBufferPpm != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.InitialMarginBufferPpm))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LiquidationGracePeriodBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LiquidationGracePeriodBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TradingHalted {
+		i--
+		if m.TradingHalted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradingHalted {
+		n += 2
+	}
+	if m.LiquidationGracePeriodBlocks != 0 {
+		n += 1 + sovParams(uint64(m.LiquidationGracePeriodBlocks))
+	}
+	if m.InitialMarginBufferPpm != 0 {
+		n += 1 + sovParams(uint64(m.InitialMarginBufferPpm))
+	}
+	if m.MaxPerpetualPositions != 0 {
+		n += 1 + sovParams(uint64(m.MaxPerpetualPositions))
+	}
+	if m.ExternalCollateralEnabled {
+		n += 2
+	}
+	if m.RiskSweepBudget != 0 {
+		n += 1 + sovParams(uint64(m.RiskSweepBudget))
+	}
+	if m.DustNcThreshold != 0 {
+		n += 1 + sovParams(uint64(m.DustNcThreshold))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingHalted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TradingHalted = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationGracePeriodBlocks", wireType)
+			}
+			m.LiquidationGracePeriodBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidationGracePeriodBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginBufferPpm", wireType)
+			}
+			m.InitialMarginBufferPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialMarginBufferPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerpetualPositions", wireType)
+			}
+			m.MaxPerpetualPositions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPerpetualPositions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalCollateralEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExternalCollateralEnabled = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskSweepBudget", wireType)
+			}
+			m.RiskSweepBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RiskSweepBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustNcThreshold", wireType)
+			}
+			m.DustNcThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DustNcThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -36,6 +36,11 @@ type PerpetualPosition struct {
 	FundingIndex github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=funding_index,json=fundingIndex,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"funding_index"`
 	// The quote_balance of the `Perpetual`.
 	QuoteBalance github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,4,opt,name=quote_balance,json=quoteBalance,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"quote_balance"`
+	// The signed notional paid to open the position in quote quantums, updated
+	// using a weighted average on each fill. Positive for longs and negative for
+	// shorts. The average entry price of the position is `cost_basis / quantums`.
+	// Zero if the cost basis of the position is unknown.
+	CostBasis github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,5,opt,name=cost_basis,json=costBasis,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"cost_basis"`
 }
 
 func (m *PerpetualPosition) Reset()         { *m = PerpetualPosition{} }
@@ -87,26 +92,27 @@ func init() {
 }
 
 var fileDescriptor_e659838f8a8f5498 = []byte{
-	// 297 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4c, 0xa9, 0x4c, 0xa9,
-	0x28, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x2f, 0x2e, 0x4d, 0x4a, 0x4c, 0x4e, 0xce,
-	0x2f, 0xcd, 0x2b, 0x29, 0xd6, 0x2f, 0x48, 0x2d, 0x2a, 0x48, 0x2d, 0x29, 0x4d, 0xcc, 0x89, 0x2f,
-	0xc8, 0x2f, 0xce, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0xab, 0x13, 0x92, 0x40, 0xd6, 0xa2, 0x87,
-	0xa4, 0x45, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0x2c, 0xa3, 0x0f, 0x62, 0x41, 0xd4, 0x2b, 0xfd,
-	0x62, 0xe2, 0x12, 0x0c, 0x80, 0x19, 0x16, 0x00, 0x35, 0x4b, 0x48, 0x91, 0x8b, 0x07, 0x61, 0x43,
-	0x66, 0x8a, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x6f, 0x10, 0x37, 0x5c, 0xcc, 0x33, 0x45, 0x28, 0x85,
-	0x8b, 0xa3, 0xb0, 0x34, 0x31, 0xaf, 0xa4, 0x34, 0xb7, 0x58, 0x82, 0x49, 0x81, 0x51, 0x83, 0xc7,
-	0xc9, 0xe3, 0xc4, 0x3d, 0x79, 0x86, 0x5b, 0xf7, 0xe4, 0x1d, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93,
-	0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x51, 0x3c, 0x50, 0x66, 0xa2, 0x9b, 0x9c, 0x91, 0x98, 0x99, 0xa7,
-	0x0f, 0x17, 0x49, 0x29, 0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0x0b, 0x4e, 0x2d, 0xca, 0x4c, 0xcc, 0xc9,
-	0xac, 0x4a, 0x4c, 0xca, 0x49, 0xf5, 0xcc, 0x2b, 0x09, 0x82, 0x9b, 0x2c, 0x94, 0xcb, 0xc5, 0x9b,
-	0x56, 0x9a, 0x97, 0x92, 0x99, 0x97, 0x1e, 0x9f, 0x99, 0x97, 0x92, 0x5a, 0x21, 0xc1, 0x4c, 0x65,
-	0xab, 0x78, 0xa0, 0xc6, 0x7b, 0x82, 0x4c, 0x07, 0x59, 0x57, 0x58, 0x9a, 0x5f, 0x92, 0x1a, 0x9f,
-	0x94, 0x98, 0x93, 0x98, 0x97, 0x9c, 0x2a, 0xc1, 0x42, 0x6d, 0xeb, 0xc0, 0xc6, 0x3b, 0x41, 0x4c,
-	0x77, 0x0a, 0x3f, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27,
-	0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x5b, 0xe2, 0x6d,
-	0xaa, 0x40, 0x49, 0x18, 0x60, 0x6b, 0x93, 0xd8, 0xc0, 0xb2, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x63, 0x52, 0xd7, 0x21, 0x41, 0x02, 0x00, 0x00,
+	// 312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xbd, 0x4e, 0xf3, 0x30,
+	0x14, 0x86, 0xe3, 0xaf, 0x1f, 0x08, 0x4c, 0x3b, 0x10, 0x31, 0x58, 0x0c, 0x6e, 0x61, 0xea, 0x42,
+	0x22, 0x04, 0x2b, 0x12, 0xca, 0x44, 0xb7, 0xaa, 0x0c, 0x48, 0x2c, 0x91, 0xff, 0x48, 0x2d, 0xa5,
+	0x76, 0x5a, 0xdb, 0xa8, 0xe5, 0x2a, 0xb8, 0xac, 0x8e, 0x1d, 0x11, 0x43, 0x85, 0x92, 0x1b, 0x41,
+	0x49, 0x4b, 0x68, 0x37, 0x86, 0x6c, 0xd6, 0x39, 0xef, 0xfb, 0x3c, 0xb2, 0x74, 0xe0, 0x35, 0x5f,
+	0xf0, 0x79, 0x36, 0xd3, 0x56, 0x33, 0x9d, 0x86, 0xc6, 0x51, 0xc2, 0x98, 0x76, 0xca, 0x9a, 0x30,
+	0x13, 0xb3, 0x4c, 0x58, 0x47, 0xd2, 0x38, 0xd3, 0x46, 0x5a, 0xa9, 0x55, 0x50, 0xe5, 0x7c, 0xb4,
+	0x5b, 0x09, 0x76, 0x2a, 0xe7, 0x67, 0x89, 0x4e, 0x74, 0xb5, 0x09, 0xcb, 0xd7, 0x26, 0x7f, 0x59,
+	0xb4, 0xe0, 0xe9, 0xf0, 0x07, 0x36, 0xdc, 0xb2, 0xfc, 0x0b, 0xd8, 0xfe, 0x35, 0x48, 0x8e, 0x40,
+	0x0f, 0xf4, 0x3b, 0xa3, 0x93, 0x7a, 0x36, 0xe0, 0x3e, 0x87, 0x47, 0x53, 0x47, 0x94, 0x75, 0x13,
+	0x83, 0xfe, 0xf5, 0x40, 0xbf, 0x1d, 0x3d, 0x2c, 0xd7, 0x5d, 0xef, 0x73, 0xdd, 0xbd, 0x4f, 0xa4,
+	0x1d, 0x3b, 0x1a, 0x30, 0x3d, 0x09, 0xf7, 0x3e, 0xf0, 0x7a, 0x7b, 0xc5, 0xc6, 0x44, 0xaa, 0xb0,
+	0x9e, 0x70, 0xbb, 0xc8, 0x84, 0x09, 0x1e, 0xc5, 0x4c, 0x92, 0x54, 0xbe, 0x11, 0x9a, 0x8a, 0x81,
+	0xb2, 0xa3, 0x9a, 0xec, 0x4f, 0x60, 0xe7, 0xc5, 0x29, 0x2e, 0x55, 0x12, 0x4b, 0xc5, 0xc5, 0x1c,
+	0xb5, 0x1a, 0x56, 0xb5, 0xb7, 0xf8, 0x41, 0x49, 0x2f, 0x75, 0x53, 0xa7, 0xad, 0x88, 0x29, 0x49,
+	0x89, 0x62, 0x02, 0xfd, 0x6f, 0x5a, 0x57, 0xe1, 0xa3, 0x0d, 0xdd, 0x4f, 0x20, 0x64, 0xda, 0xd8,
+	0x98, 0x12, 0x23, 0x0d, 0x3a, 0x68, 0xd8, 0x75, 0x5c, 0xb2, 0xa3, 0x12, 0x1d, 0x3d, 0x2d, 0x73,
+	0x0c, 0x56, 0x39, 0x06, 0x5f, 0x39, 0x06, 0xef, 0x05, 0xf6, 0x56, 0x05, 0xf6, 0x3e, 0x0a, 0xec,
+	0x3d, 0xdf, 0xfd, 0x5d, 0x33, 0xdf, 0xbb, 0xc0, 0xca, 0x49, 0x0f, 0xab, 0xed, 0xcd, 0xf7, 0x00,
+	0xa6, 0x47, 0xdc, 0x5d, 0xaa, 0x02, 0x00, 0x00,
 }
 
 func (m *PerpetualPosition) Marshal() (dAtA []byte, err error) {
@@ -129,6 +135,16 @@ func (m *PerpetualPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.CostBasis.Size()
+		i -= size
+		if _, err := m.CostBasis.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPerpetualPosition(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size := m.QuoteBalance.Size()
 		i -= size
@@ -193,6 +209,8 @@ func (m *PerpetualPosition) Size() (n int) {
 	n += 1 + l + sovPerpetualPosition(uint64(l))
 	l = m.QuoteBalance.Size()
 	n += 1 + l + sovPerpetualPosition(uint64(l))
+	l = m.CostBasis.Size()
+	n += 1 + l + sovPerpetualPosition(uint64(l))
 	return n
 }
 
@@ -349,6 +367,39 @@ func (m *PerpetualPosition) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CostBasis", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetualPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPerpetualPosition
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerpetualPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CostBasis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPerpetualPosition(dAtA[iNdEx:])
@@ -0,0 +1,9 @@
+package types
+
+// PositionLimits are the limits on the perpetual positions of a subaccount that apply to every update in
+// addition to its margin requirements. The per-market notional cap is the `MaxPositionNotionalQuoteQuantums`
+// of the liquidity tier of each perpetual, and is not part of `PositionLimits`.
+type PositionLimits struct {
+	// The maximum number of perpetual positions a subaccount may hold. Zero disables the limit.
+	MaxPerpetualPositions uint32
+}
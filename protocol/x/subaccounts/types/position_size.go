@@ -83,6 +83,15 @@ func (m *PerpetualPosition) GetQuoteBalance() *big.Int {
 	return m.QuoteBalance.BigInt()
 }
 
+// Get the perpetual position cost basis in big.Int.
+func (m *PerpetualPosition) GetBigCostBasis() *big.Int {
+	if m == nil || m.CostBasis.IsNil() {
+		return new(big.Int)
+	}
+
+	return m.CostBasis.BigInt()
+}
+
 func (m *PerpetualPosition) GetIsLong() bool {
 	if m == nil {
 		return false
@@ -90,6 +99,14 @@ func (m *PerpetualPosition) GetIsLong() bool {
 	return m.GetBigQuantums().Sign() > 0
 }
 
+// GetIsShort returns true if and only if the perpetual position size is negative.
+func (m *PerpetualPosition) GetIsShort() bool {
+	if m == nil {
+		return false
+	}
+	return m.GetBigQuantums().Sign() < 0
+}
+
 func (au AssetUpdate) GetIsLong() bool {
 	return au.GetBigQuantums().Sign() > 0
 }
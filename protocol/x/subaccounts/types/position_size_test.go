@@ -23,6 +23,12 @@ func TestGetBigQuantums(t *testing.T) {
 
 	require.Equal(t, big.NewInt(-42), p.GetBigQuantums())
 
+	p = types.PerpetualPosition{
+		Quantums: dtypes.NewInt(0),
+	}
+
+	require.Zero(t, p.GetBigQuantums().Sign())
+
 	nilPosition := (*types.PerpetualPosition)(nil)
 	require.Equal(t, big.NewInt(0), nilPosition.GetBigQuantums())
 }
@@ -53,6 +59,32 @@ func TestPerpetualPosition_GetIsLong(t *testing.T) {
 	)
 }
 
+func TestPerpetualPosition_GetIsShort(t *testing.T) {
+	longPosition := types.PerpetualPosition{
+		Quantums: dtypes.NewInt(1000),
+	}
+	zeroPosition := types.PerpetualPosition{
+		Quantums: dtypes.NewInt(0),
+	}
+	shortPosition := types.PerpetualPosition{
+		Quantums: dtypes.NewInt(-10000000),
+	}
+	nilPosition := (*types.PerpetualPosition)(nil)
+
+	require.False(t,
+		longPosition.GetIsShort(),
+	)
+	require.False(t,
+		zeroPosition.GetIsShort(),
+	)
+	require.True(t,
+		shortPosition.GetIsShort(),
+	)
+	require.False(t,
+		nilPosition.GetIsShort(),
+	)
+}
+
 func TestAssetPosition_GetIsLong(t *testing.T) {
 	longPosition := types.AssetPosition{
 		Quantums: dtypes.NewInt(1000),
@@ -11,6 +11,9 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_dydxprotocol_v4_chain_protocol_dtypes "github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	types "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	types1 "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -438,680 +441,16849 @@ func (m *QueryCollateralPoolAddressResponse) GetCollateralPoolAddress() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryGetSubaccountRequest)(nil), "dydxprotocol.subaccounts.QueryGetSubaccountRequest")
-	proto.RegisterType((*QuerySubaccountResponse)(nil), "dydxprotocol.subaccounts.QuerySubaccountResponse")
-	proto.RegisterType((*QueryAllSubaccountRequest)(nil), "dydxprotocol.subaccounts.QueryAllSubaccountRequest")
-	proto.RegisterType((*QuerySubaccountAllResponse)(nil), "dydxprotocol.subaccounts.QuerySubaccountAllResponse")
-	proto.RegisterType((*QueryGetWithdrawalAndTransfersBlockedInfoRequest)(nil), "dydxprotocol.subaccounts.QueryGetWithdrawalAndTransfersBlockedInfoRequest")
-	proto.RegisterType((*QueryGetWithdrawalAndTransfersBlockedInfoResponse)(nil), "dydxprotocol.subaccounts.QueryGetWithdrawalAndTransfersBlockedInfoResponse")
-	proto.RegisterType((*QueryCollateralPoolAddressRequest)(nil), "dydxprotocol.subaccounts.QueryCollateralPoolAddressRequest")
-	proto.RegisterType((*QueryCollateralPoolAddressResponse)(nil), "dydxprotocol.subaccounts.QueryCollateralPoolAddressResponse")
+// HypotheticalAssetUpdate is a change to an asset position of a hypothetical
+// subaccount.
+type HypotheticalAssetUpdate struct {
+	// The `Id` of the `Asset`.
+	AssetId uint32 `protobuf:"varint,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The signed change in the size of the position in base quantums.
+	QuantumsDelta github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=quantums_delta,json=quantumsDelta,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"quantums_delta"`
 }
 
-func init() {
-	proto.RegisterFile("dydxprotocol/subaccounts/query.proto", fileDescriptor_adc19ff1d5b72954)
+func (m *HypotheticalAssetUpdate) Reset()         { *m = HypotheticalAssetUpdate{} }
+func (m *HypotheticalAssetUpdate) String() string { return proto.CompactTextString(m) }
+func (*HypotheticalAssetUpdate) ProtoMessage()    {}
+func (*HypotheticalAssetUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{8}
 }
-
-var fileDescriptor_adc19ff1d5b72954 = []byte{
-	// 774 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x4f, 0xdb, 0x48,
-	0x18, 0x8d, 0xc3, 0x82, 0xb4, 0xc3, 0x72, 0x19, 0xf1, 0x23, 0x58, 0xab, 0x2c, 0x58, 0x59, 0x60,
-	0x57, 0x60, 0x6f, 0x80, 0xd5, 0x4a, 0xbb, 0x8b, 0xd4, 0xa4, 0x12, 0x14, 0x7a, 0x00, 0x02, 0x08,
-	0xa9, 0x52, 0x65, 0x8d, 0xed, 0xc1, 0x58, 0x35, 0x33, 0xc6, 0x33, 0x0e, 0x20, 0xc4, 0xa5, 0x7f,
-	0x41, 0xa5, 0x5e, 0x7a, 0xeb, 0xb5, 0xd7, 0xaa, 0xfd, 0x23, 0x38, 0xa2, 0xf6, 0xd2, 0x43, 0x55,
-	0x55, 0xa1, 0x7f, 0x48, 0x95, 0xf1, 0x24, 0x76, 0x00, 0x93, 0x80, 0x7a, 0xb3, 0x67, 0xbe, 0xf7,
-	0xbe, 0xf7, 0x9e, 0xfd, 0x7d, 0xa0, 0xe4, 0x9c, 0x38, 0xc7, 0x41, 0x48, 0x39, 0xb5, 0xa9, 0x6f,
-	0xb0, 0xc8, 0x42, 0xb6, 0x4d, 0x23, 0xc2, 0x99, 0x71, 0x18, 0xe1, 0xf0, 0x44, 0x17, 0x57, 0xb0,
-	0x90, 0xae, 0xd2, 0x53, 0x55, 0xea, 0xb8, 0x4d, 0xd9, 0x01, 0x65, 0xa6, 0xb8, 0x34, 0xe2, 0x97,
-	0x18, 0xa4, 0x0e, 0xbb, 0xd4, 0xa5, 0xf1, 0x79, 0xf3, 0x49, 0x9e, 0xfe, 0xea, 0x52, 0xea, 0xfa,
-	0xd8, 0x40, 0x81, 0x67, 0x20, 0x42, 0x28, 0x47, 0xdc, 0xa3, 0xa4, 0x85, 0xf9, 0x33, 0x66, 0x30,
-	0x2c, 0xc4, 0x70, 0xac, 0xc0, 0xa8, 0x97, 0x2d, 0xcc, 0x51, 0xd9, 0x08, 0x90, 0xeb, 0x11, 0x51,
-	0x2c, 0x6b, 0xff, 0xc8, 0x94, 0x9e, 0x3c, 0xc7, 0xa5, 0x9a, 0x0d, 0xc6, 0x37, 0x9b, 0x64, 0x2b,
-	0x98, 0x6f, 0xb5, 0xef, 0x6a, 0xf8, 0x30, 0xc2, 0x8c, 0x43, 0x1d, 0xf4, 0xd3, 0x23, 0x82, 0xc3,
-	0x82, 0x32, 0xa1, 0xcc, 0xfc, 0x5c, 0x2d, 0x7c, 0x78, 0x3f, 0x37, 0x2c, 0x8d, 0x54, 0x1c, 0x27,
-	0xc4, 0x8c, 0x6d, 0xf1, 0xd0, 0x23, 0x6e, 0x2d, 0x2e, 0x83, 0xa3, 0x60, 0x80, 0x44, 0x07, 0x16,
-	0x0e, 0x0b, 0xf9, 0x09, 0x65, 0x66, 0xa8, 0x26, 0xdf, 0x34, 0x0c, 0xc6, 0x44, 0x93, 0x74, 0x07,
-	0x16, 0x50, 0xc2, 0x30, 0x5c, 0x03, 0x20, 0xd1, 0x24, 0xfa, 0x0c, 0xce, 0x97, 0xf4, 0xac, 0x50,
-	0xf5, 0x84, 0xa1, 0xfa, 0xd3, 0xf9, 0x97, 0xdf, 0x72, 0xb5, 0x14, 0xba, 0xed, 0xa5, 0xe2, 0xfb,
-	0xd7, 0xbd, 0x2c, 0x03, 0x90, 0xe4, 0x24, 0x1b, 0x4d, 0xe9, 0xd2, 0x4d, 0x33, 0x54, 0x3d, 0xfe,
-	0xac, 0x32, 0x54, 0x7d, 0x03, 0xb9, 0x58, 0x62, 0x6b, 0x29, 0xa4, 0xf6, 0x56, 0x01, 0xea, 0x15,
-	0x33, 0x15, 0xdf, 0xcf, 0xf4, 0xd3, 0x77, 0x7f, 0x3f, 0x70, 0xa5, 0x43, 0x72, 0x5e, 0x48, 0x9e,
-	0xee, 0x2a, 0x39, 0x16, 0xd2, 0xa1, 0x79, 0x07, 0xfc, 0xd5, 0xfa, 0xc8, 0xbb, 0x1e, 0xdf, 0x77,
-	0x42, 0x74, 0x84, 0xfc, 0x0a, 0x71, 0xb6, 0x43, 0x44, 0xd8, 0x1e, 0x0e, 0x59, 0xd5, 0xa7, 0xf6,
-	0x33, 0xec, 0xac, 0x92, 0x3d, 0xda, 0xca, 0x6b, 0x12, 0xfc, 0x12, 0xe0, 0x30, 0xc0, 0x3c, 0x42,
-	0xbe, 0xe9, 0x39, 0x22, 0xb1, 0xa1, 0xda, 0x60, 0xfb, 0x6c, 0xd5, 0xd1, 0x5e, 0xe7, 0x41, 0xf9,
-	0x0e, 0xbc, 0x32, 0xa1, 0x75, 0xf0, 0x3b, 0xc1, 0x2e, 0xe2, 0x5e, 0x1d, 0x9b, 0x9c, 0xd8, 0x66,
-	0x62, 0xd8, 0x64, 0x18, 0x13, 0x13, 0x71, 0xd3, 0x6a, 0xc2, 0x64, 0xc7, 0x89, 0x56, 0xf1, 0x36,
-	0xb1, 0x93, 0xb4, 0xb6, 0x30, 0x26, 0x15, 0x2e, 0xe8, 0xe1, 0xbf, 0x40, 0xb5, 0xf7, 0x91, 0x47,
-	0x4c, 0x1a, 0x71, 0xe4, 0xe2, 0x2b, 0x2c, 0xf1, 0x9f, 0x38, 0x2a, 0x2a, 0xd6, 0x45, 0x41, 0x1a,
-	0xfb, 0x14, 0xcc, 0x1e, 0xb5, 0x95, 0x33, 0x13, 0x11, 0xc7, 0xe4, 0x2d, 0xf1, 0x66, 0x44, 0xac,
-	0x58, 0x7f, 0xc2, 0xd6, 0x27, 0xd8, 0xa6, 0x53, 0x98, 0xb4, 0xdd, 0x9d, 0x16, 0x40, 0xd2, 0x6b,
-	0xcb, 0x60, 0x52, 0x04, 0xf4, 0x90, 0xfa, 0x3e, 0xe2, 0x38, 0x44, 0xfe, 0x06, 0xa5, 0xbe, 0x9c,
-	0x9d, 0x3b, 0x24, 0x5d, 0x07, 0xda, 0x6d, 0x3c, 0x32, 0xd9, 0x0d, 0x30, 0x66, 0xb7, 0x0b, 0xcc,
-	0x80, 0x52, 0xdf, 0x44, 0x71, 0x49, 0xd7, 0x01, 0x1e, 0xb1, 0x6f, 0x62, 0x9e, 0x6f, 0x0c, 0x80,
-	0x7e, 0xd1, 0x18, 0xbe, 0x53, 0x00, 0x48, 0xe2, 0x87, 0x0b, 0xd9, 0xbf, 0x74, 0xe6, 0x3a, 0x51,
-	0xcb, 0x5d, 0x40, 0xd7, 0xd7, 0x83, 0xb6, 0xf4, 0xfc, 0xe3, 0xb7, 0x97, 0xf9, 0x7f, 0xe0, 0xdf,
-	0x46, 0x0f, 0x2b, 0xcd, 0x38, 0x15, 0x6b, 0xe8, 0xcc, 0x38, 0x8d, 0xf7, 0xce, 0x19, 0x7c, 0xa3,
-	0x80, 0xa1, 0x8e, 0x39, 0xed, 0x2a, 0xfc, 0xa6, 0xdd, 0xa1, 0x2e, 0xf6, 0x2c, 0x3c, 0xb5, 0x0a,
-	0xb4, 0x59, 0xa1, 0x7d, 0x0a, 0x96, 0x7a, 0xd1, 0x0e, 0x5f, 0xe5, 0x41, 0xa9, 0x97, 0x39, 0x82,
-	0x6b, 0xdd, 0xa3, 0xef, 0x75, 0xc8, 0xd5, 0xc7, 0x3f, 0x84, 0x4b, 0xfa, 0xdd, 0x15, 0x7e, 0x37,
-	0xe1, 0x7a, 0xb6, 0xdf, 0xec, 0x59, 0x6b, 0x4d, 0x9a, 0x47, 0xf6, 0xa8, 0x71, 0x9a, 0x9e, 0x87,
-	0x33, 0xf8, 0x59, 0x01, 0x23, 0x37, 0xfe, 0xf9, 0xf0, 0xbf, 0x2e, 0xfa, 0x6f, 0x9b, 0x3b, 0xf5,
-	0xff, 0xfb, 0x81, 0xa5, 0xdb, 0x47, 0xc2, 0x6d, 0x15, 0x3e, 0xc8, 0x76, 0x9b, 0x31, 0x8c, 0x57,
-	0xec, 0x55, 0x77, 0xcf, 0x1b, 0x45, 0xe5, 0xa2, 0x51, 0x54, 0xbe, 0x36, 0x8a, 0xca, 0x8b, 0xcb,
-	0x62, 0xee, 0xe2, 0xb2, 0x98, 0xfb, 0x74, 0x59, 0xcc, 0x3d, 0x59, 0x72, 0x3d, 0xbe, 0x1f, 0x59,
-	0xba, 0x4d, 0x0f, 0x3a, 0xbb, 0xd4, 0x17, 0xe7, 0xc4, 0x42, 0x33, 0xda, 0x27, 0xc7, 0x1d, 0x9d,
-	0xf9, 0x49, 0x80, 0x99, 0x35, 0x20, 0x6e, 0x17, 0xbe, 0x07, 0x00, 0x00, 0xff, 0xff, 0x6d, 0x51,
-	0x19, 0x95, 0xca, 0x08, 0x00, 0x00,
+func (m *HypotheticalAssetUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HypotheticalAssetUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HypotheticalAssetUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HypotheticalAssetUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HypotheticalAssetUpdate.Merge(m, src)
+}
+func (m *HypotheticalAssetUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *HypotheticalAssetUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_HypotheticalAssetUpdate.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_HypotheticalAssetUpdate proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Queries a Subaccount by id
-	Subaccount(ctx context.Context, in *QueryGetSubaccountRequest, opts ...grpc.CallOption) (*QuerySubaccountResponse, error)
-	// Queries a list of Subaccount items.
-	SubaccountAll(ctx context.Context, in *QueryAllSubaccountRequest, opts ...grpc.CallOption) (*QuerySubaccountAllResponse, error)
-	// Queries information about whether withdrawal and transfers are blocked, and
-	// if so which block they are re-enabled on.
-	GetWithdrawalAndTransfersBlockedInfo(ctx context.Context, in *QueryGetWithdrawalAndTransfersBlockedInfoRequest, opts ...grpc.CallOption) (*QueryGetWithdrawalAndTransfersBlockedInfoResponse, error)
-	// Queries the collateral pool account address for a perpetual id.
-	CollateralPoolAddress(ctx context.Context, in *QueryCollateralPoolAddressRequest, opts ...grpc.CallOption) (*QueryCollateralPoolAddressResponse, error)
+func (m *HypotheticalAssetUpdate) GetAssetId() uint32 {
+	if m != nil {
+		return m.AssetId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// HypotheticalPerpetualUpdate is a change to a perpetual position of a
+// hypothetical subaccount.
+type HypotheticalPerpetualUpdate struct {
+	// The `Id` of the `Perpetual`.
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The signed change in the size of the position in base quantums.
+	QuantumsDelta github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=quantums_delta,json=quantumsDelta,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"quantums_delta"`
+	// The signed change in the quote balance of the position in quote quantums.
+	QuoteBalanceDelta github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=quote_balance_delta,json=quoteBalanceDelta,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"quote_balance_delta"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *HypotheticalPerpetualUpdate) Reset()         { *m = HypotheticalPerpetualUpdate{} }
+func (m *HypotheticalPerpetualUpdate) String() string { return proto.CompactTextString(m) }
+func (*HypotheticalPerpetualUpdate) ProtoMessage()    {}
+func (*HypotheticalPerpetualUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{9}
+}
+func (m *HypotheticalPerpetualUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HypotheticalPerpetualUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HypotheticalPerpetualUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HypotheticalPerpetualUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HypotheticalPerpetualUpdate.Merge(m, src)
+}
+func (m *HypotheticalPerpetualUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *HypotheticalPerpetualUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_HypotheticalPerpetualUpdate.DiscardUnknown(m)
 }
 
-func (c *queryClient) Subaccount(ctx context.Context, in *QueryGetSubaccountRequest, opts ...grpc.CallOption) (*QuerySubaccountResponse, error) {
-	out := new(QuerySubaccountResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/Subaccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_HypotheticalPerpetualUpdate proto.InternalMessageInfo
+
+func (m *HypotheticalPerpetualUpdate) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) SubaccountAll(ctx context.Context, in *QueryAllSubaccountRequest, opts ...grpc.CallOption) (*QuerySubaccountAllResponse, error) {
-	out := new(QuerySubaccountAllResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/SubaccountAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryComputeRiskForHypotheticalRequest is the request type for computing the
+// risk of a hypothetical subaccount. The subaccount's positions are treated as
+// settled.
+type QueryComputeRiskForHypotheticalRequest struct {
+	Subaccount       Subaccount                    `protobuf:"bytes,1,opt,name=subaccount,proto3" json:"subaccount"`
+	AssetUpdates     []HypotheticalAssetUpdate     `protobuf:"bytes,2,rep,name=asset_updates,json=assetUpdates,proto3" json:"asset_updates"`
+	PerpetualUpdates []HypotheticalPerpetualUpdate `protobuf:"bytes,3,rep,name=perpetual_updates,json=perpetualUpdates,proto3" json:"perpetual_updates"`
+}
+
+func (m *QueryComputeRiskForHypotheticalRequest) Reset() {
+	*m = QueryComputeRiskForHypotheticalRequest{}
+}
+func (m *QueryComputeRiskForHypotheticalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryComputeRiskForHypotheticalRequest) ProtoMessage()    {}
+func (*QueryComputeRiskForHypotheticalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{10}
+}
+func (m *QueryComputeRiskForHypotheticalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryComputeRiskForHypotheticalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryComputeRiskForHypotheticalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryComputeRiskForHypotheticalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryComputeRiskForHypotheticalRequest.Merge(m, src)
+}
+func (m *QueryComputeRiskForHypotheticalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryComputeRiskForHypotheticalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryComputeRiskForHypotheticalRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetWithdrawalAndTransfersBlockedInfo(ctx context.Context, in *QueryGetWithdrawalAndTransfersBlockedInfoRequest, opts ...grpc.CallOption) (*QueryGetWithdrawalAndTransfersBlockedInfoResponse, error) {
-	out := new(QueryGetWithdrawalAndTransfersBlockedInfoResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/GetWithdrawalAndTransfersBlockedInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryComputeRiskForHypotheticalRequest proto.InternalMessageInfo
+
+func (m *QueryComputeRiskForHypotheticalRequest) GetSubaccount() Subaccount {
+	if m != nil {
+		return m.Subaccount
 	}
-	return out, nil
+	return Subaccount{}
 }
 
-func (c *queryClient) CollateralPoolAddress(ctx context.Context, in *QueryCollateralPoolAddressRequest, opts ...grpc.CallOption) (*QueryCollateralPoolAddressResponse, error) {
-	out := new(QueryCollateralPoolAddressResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/CollateralPoolAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryComputeRiskForHypotheticalRequest) GetAssetUpdates() []HypotheticalAssetUpdate {
+	if m != nil {
+		return m.AssetUpdates
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Queries a Subaccount by id
-	Subaccount(context.Context, *QueryGetSubaccountRequest) (*QuerySubaccountResponse, error)
-	// Queries a list of Subaccount items.
-	SubaccountAll(context.Context, *QueryAllSubaccountRequest) (*QuerySubaccountAllResponse, error)
-	// Queries information about whether withdrawal and transfers are blocked, and
-	// if so which block they are re-enabled on.
-	GetWithdrawalAndTransfersBlockedInfo(context.Context, *QueryGetWithdrawalAndTransfersBlockedInfoRequest) (*QueryGetWithdrawalAndTransfersBlockedInfoResponse, error)
-	// Queries the collateral pool account address for a perpetual id.
-	CollateralPoolAddress(context.Context, *QueryCollateralPoolAddressRequest) (*QueryCollateralPoolAddressResponse, error)
+func (m *QueryComputeRiskForHypotheticalRequest) GetPerpetualUpdates() []HypotheticalPerpetualUpdate {
+	if m != nil {
+		return m.PerpetualUpdates
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryComputeRiskForHypotheticalResponse is the response type for computing
+// the risk of a hypothetical subaccount. All values are in quote quantums.
+type QueryComputeRiskForHypotheticalResponse struct {
+	NetCollateral                github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=net_collateral,json=netCollateral,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"net_collateral"`
+	InitialMarginRequirement     github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=initial_margin_requirement,json=initialMarginRequirement,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"initial_margin_requirement"`
+	MaintenanceMarginRequirement github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=maintenance_margin_requirement,json=maintenanceMarginRequirement,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"maintenance_margin_requirement"`
 }
 
-func (*UnimplementedQueryServer) Subaccount(ctx context.Context, req *QueryGetSubaccountRequest) (*QuerySubaccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Subaccount not implemented")
+func (m *QueryComputeRiskForHypotheticalResponse) Reset() {
+	*m = QueryComputeRiskForHypotheticalResponse{}
 }
-func (*UnimplementedQueryServer) SubaccountAll(ctx context.Context, req *QueryAllSubaccountRequest) (*QuerySubaccountAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubaccountAll not implemented")
+func (m *QueryComputeRiskForHypotheticalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryComputeRiskForHypotheticalResponse) ProtoMessage()    {}
+func (*QueryComputeRiskForHypotheticalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{11}
 }
-func (*UnimplementedQueryServer) GetWithdrawalAndTransfersBlockedInfo(ctx context.Context, req *QueryGetWithdrawalAndTransfersBlockedInfoRequest) (*QueryGetWithdrawalAndTransfersBlockedInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWithdrawalAndTransfersBlockedInfo not implemented")
+func (m *QueryComputeRiskForHypotheticalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) CollateralPoolAddress(ctx context.Context, req *QueryCollateralPoolAddressRequest) (*QueryCollateralPoolAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CollateralPoolAddress not implemented")
+func (m *QueryComputeRiskForHypotheticalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryComputeRiskForHypotheticalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryComputeRiskForHypotheticalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryComputeRiskForHypotheticalResponse.Merge(m, src)
+}
+func (m *QueryComputeRiskForHypotheticalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryComputeRiskForHypotheticalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryComputeRiskForHypotheticalResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryComputeRiskForHypotheticalResponse proto.InternalMessageInfo
+
+// QueryRiskInputsRequest is the request type for fetching the risk inputs of a
+// subaccount.
+type QueryRiskInputsRequest struct {
+	Owner  string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Number uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
 }
 
-func _Query_Subaccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetSubaccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Subaccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.subaccounts.Query/Subaccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Subaccount(ctx, req.(*QueryGetSubaccountRequest))
+func (m *QueryRiskInputsRequest) Reset()         { *m = QueryRiskInputsRequest{} }
+func (m *QueryRiskInputsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRiskInputsRequest) ProtoMessage()    {}
+func (*QueryRiskInputsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{12}
+}
+func (m *QueryRiskInputsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRiskInputsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRiskInputsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRiskInputsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRiskInputsRequest.Merge(m, src)
+}
+func (m *QueryRiskInputsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRiskInputsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRiskInputsRequest.DiscardUnknown(m)
 }
 
-func _Query_SubaccountAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllSubaccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SubaccountAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.subaccounts.Query/SubaccountAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SubaccountAll(ctx, req.(*QueryAllSubaccountRequest))
+var xxx_messageInfo_QueryRiskInputsRequest proto.InternalMessageInfo
+
+func (m *QueryRiskInputsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetWithdrawalAndTransfersBlockedInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetWithdrawalAndTransfersBlockedInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetWithdrawalAndTransfersBlockedInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.subaccounts.Query/GetWithdrawalAndTransfersBlockedInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetWithdrawalAndTransfersBlockedInfo(ctx, req.(*QueryGetWithdrawalAndTransfersBlockedInfoRequest))
+func (m *QueryRiskInputsRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_CollateralPoolAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCollateralPoolAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CollateralPoolAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.subaccounts.Query/CollateralPoolAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CollateralPoolAddress(ctx, req.(*QueryCollateralPoolAddressRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// PerpetualRiskInputs is the state of a perpetual used to compute the risk of
+// a position in it.
+type PerpetualRiskInputs struct {
+	Perpetual     types.Perpetual     `protobuf:"bytes,1,opt,name=perpetual,proto3" json:"perpetual"`
+	MarketPrice   types1.MarketPrice  `protobuf:"bytes,2,opt,name=market_price,json=marketPrice,proto3" json:"market_price"`
+	LiquidityTier types.LiquidityTier `protobuf:"bytes,3,opt,name=liquidity_tier,json=liquidityTier,proto3" json:"liquidity_tier"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dydxprotocol.subaccounts.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Subaccount",
-			Handler:    _Query_Subaccount_Handler,
-		},
-		{
-			MethodName: "SubaccountAll",
-			Handler:    _Query_SubaccountAll_Handler,
-		},
-		{
-			MethodName: "GetWithdrawalAndTransfersBlockedInfo",
-			Handler:    _Query_GetWithdrawalAndTransfersBlockedInfo_Handler,
-		},
-		{
-			MethodName: "CollateralPoolAddress",
-			Handler:    _Query_CollateralPoolAddress_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dydxprotocol/subaccounts/query.proto",
+func (m *PerpetualRiskInputs) Reset()         { *m = PerpetualRiskInputs{} }
+func (m *PerpetualRiskInputs) String() string { return proto.CompactTextString(m) }
+func (*PerpetualRiskInputs) ProtoMessage()    {}
+func (*PerpetualRiskInputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{13}
 }
-
-func (m *QueryGetSubaccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PerpetualRiskInputs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PerpetualRiskInputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PerpetualRiskInputs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryGetSubaccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PerpetualRiskInputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerpetualRiskInputs.Merge(m, src)
+}
+func (m *PerpetualRiskInputs) XXX_Size() int {
+	return m.Size()
+}
+func (m *PerpetualRiskInputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerpetualRiskInputs.DiscardUnknown(m)
 }
 
-func (m *QueryGetSubaccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Number != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_PerpetualRiskInputs proto.InternalMessageInfo
+
+func (m *PerpetualRiskInputs) GetPerpetual() types.Perpetual {
+	if m != nil {
+		return m.Perpetual
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+	return types.Perpetual{}
+}
+
+func (m *PerpetualRiskInputs) GetMarketPrice() types1.MarketPrice {
+	if m != nil {
+		return m.MarketPrice
 	}
-	return len(dAtA) - i, nil
+	return types1.MarketPrice{}
 }
 
-func (m *QuerySubaccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PerpetualRiskInputs) GetLiquidityTier() types.LiquidityTier {
+	if m != nil {
+		return m.LiquidityTier
 	}
-	return dAtA[:n], nil
+	return types.LiquidityTier{}
 }
 
-func (m *QuerySubaccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRiskInputsResponse is the response type for fetching the risk inputs of
+// a subaccount.
+type QueryRiskInputsResponse struct {
+	// The subaccount with all outstanding funding applied to its USDC asset
+	// position.
+	SettledSubaccount Subaccount `protobuf:"bytes,1,opt,name=settled_subaccount,json=settledSubaccount,proto3" json:"settled_subaccount"`
+	// The state of every perpetual the subaccount holds a position in.
+	Perpetuals []PerpetualRiskInputs `protobuf:"bytes,2,rep,name=perpetuals,proto3" json:"perpetuals"`
+	// The collateral provided by sources outside of the subaccount, such as
+	// staked tokens, in quote quantums. Added to the net collateral.
+	ExternalCollateral github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=external_collateral,json=externalCollateral,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"external_collateral"`
+	// The collateral locked by pending withdrawals, in quote quantums.
+	// Subtracted from the net collateral.
+	LockedCollateral uint64 `protobuf:"varint,4,opt,name=locked_collateral,json=lockedCollateral,proto3" json:"locked_collateral,omitempty"`
 }
 
-func (m *QuerySubaccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Subaccount.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRiskInputsResponse) Reset()         { *m = QueryRiskInputsResponse{} }
+func (m *QueryRiskInputsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRiskInputsResponse) ProtoMessage()    {}
+func (*QueryRiskInputsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{14}
+}
+func (m *QueryRiskInputsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRiskInputsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRiskInputsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllSubaccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRiskInputsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRiskInputsResponse.Merge(m, src)
+}
+func (m *QueryRiskInputsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRiskInputsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRiskInputsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllSubaccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryRiskInputsResponse proto.InternalMessageInfo
+
+func (m *QueryRiskInputsResponse) GetSettledSubaccount() Subaccount {
+	if m != nil {
+		return m.SettledSubaccount
+	}
+	return Subaccount{}
 }
 
-func (m *QueryAllSubaccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryRiskInputsResponse) GetPerpetuals() []PerpetualRiskInputs {
+	if m != nil {
+		return m.Perpetuals
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QuerySubaccountAllResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRiskInputsResponse) GetLockedCollateral() uint64 {
+	if m != nil {
+		return m.LockedCollateral
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QuerySubaccountAllResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySubaccountUtilizationRequest is the request type for fetching the
+// utilization of a subaccount.
+type QuerySubaccountUtilizationRequest struct {
+	Owner  string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Number uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
 }
 
-func (m *QuerySubaccountAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySubaccountUtilizationRequest) Reset()         { *m = QuerySubaccountUtilizationRequest{} }
+func (m *QuerySubaccountUtilizationRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubaccountUtilizationRequest) ProtoMessage()    {}
+func (*QuerySubaccountUtilizationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{15}
+}
+func (m *QuerySubaccountUtilizationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubaccountUtilizationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubaccountUtilizationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Subaccount) > 0 {
-		for iNdEx := len(m.Subaccount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Subaccount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QuerySubaccountUtilizationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubaccountUtilizationRequest.Merge(m, src)
+}
+func (m *QuerySubaccountUtilizationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubaccountUtilizationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubaccountUtilizationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubaccountUtilizationRequest proto.InternalMessageInfo
+
+func (m *QuerySubaccountUtilizationRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGetWithdrawalAndTransfersBlockedInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySubaccountUtilizationRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetWithdrawalAndTransfersBlockedInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySubaccountUtilizationResponse is the response type for fetching the
+// utilization of a subaccount.
+type QuerySubaccountUtilizationResponse struct {
+	// The ratio of the initial margin requirement of the subaccount to its net
+	// collateral, including unsettled funding and external collateral, in
+	// parts-per-million rounded up. Zero if the subaccount is over-utilized.
+	UtilizationPpm uint64 `protobuf:"varint,1,opt,name=utilization_ppm,json=utilizationPpm,proto3" json:"utilization_ppm,omitempty"`
+	// True if the subaccount has non-positive net collateral.
+	OverUtilized bool `protobuf:"varint,2,opt,name=over_utilized,json=overUtilized,proto3" json:"over_utilized,omitempty"`
 }
 
-func (m *QueryGetWithdrawalAndTransfersBlockedInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PerpetualId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
-		i--
-		dAtA[i] = 0x8
+func (m *QuerySubaccountUtilizationResponse) Reset()         { *m = QuerySubaccountUtilizationResponse{} }
+func (m *QuerySubaccountUtilizationResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubaccountUtilizationResponse) ProtoMessage()    {}
+func (*QuerySubaccountUtilizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{16}
+}
+func (m *QuerySubaccountUtilizationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubaccountUtilizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubaccountUtilizationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySubaccountUtilizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubaccountUtilizationResponse.Merge(m, src)
+}
+func (m *QuerySubaccountUtilizationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubaccountUtilizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubaccountUtilizationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetWithdrawalAndTransfersBlockedInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySubaccountUtilizationResponse proto.InternalMessageInfo
+
+func (m *QuerySubaccountUtilizationResponse) GetUtilizationPpm() uint64 {
+	if m != nil {
+		return m.UtilizationPpm
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetWithdrawalAndTransfersBlockedInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySubaccountUtilizationResponse) GetOverUtilized() bool {
+	if m != nil {
+		return m.OverUtilized
+	}
+	return false
 }
 
-func (m *QueryGetWithdrawalAndTransfersBlockedInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WithdrawalsAndTransfersUnblockedAtBlock != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.WithdrawalsAndTransfersUnblockedAtBlock))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ChainOutageSeenAtBlock != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainOutageSeenAtBlock))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.NegativeTncSubaccountSeenAtBlock != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NegativeTncSubaccountSeenAtBlock))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// QueryTotalValueLockedRequest is the request type for fetching the total
+// value locked across all subaccounts.
+type QueryTotalValueLockedRequest struct {
 }
 
-func (m *QueryCollateralPoolAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTotalValueLockedRequest) Reset()         { *m = QueryTotalValueLockedRequest{} }
+func (m *QueryTotalValueLockedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalValueLockedRequest) ProtoMessage()    {}
+func (*QueryTotalValueLockedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{17}
+}
+func (m *QueryTotalValueLockedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalValueLockedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalValueLockedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryTotalValueLockedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalValueLockedRequest.Merge(m, src)
+}
+func (m *QueryTotalValueLockedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalValueLockedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalValueLockedRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCollateralPoolAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryTotalValueLockedRequest proto.InternalMessageInfo
+
+// QueryTotalValueLockedResponse is the response type for fetching the total
+// value locked across all subaccounts.
+type QueryTotalValueLockedResponse struct {
+	// The sum of the net collateral of all subaccounts with positive net
+	// collateral, in quote quantums.
+	TotalValueLocked github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=total_value_locked,json=totalValueLocked,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"total_value_locked"`
+	// The sum of the net collateral of all subaccounts with non-positive net
+	// collateral, in quote quantums.
+	TotalNegativeNetCollateral github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=total_negative_net_collateral,json=totalNegativeNetCollateral,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"total_negative_net_collateral"`
 }
 
-func (m *QueryCollateralPoolAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PerpetualId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryTotalValueLockedResponse) Reset()         { *m = QueryTotalValueLockedResponse{} }
+func (m *QueryTotalValueLockedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalValueLockedResponse) ProtoMessage()    {}
+func (*QueryTotalValueLockedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{18}
+}
+func (m *QueryTotalValueLockedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalValueLockedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalValueLockedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTotalValueLockedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalValueLockedResponse.Merge(m, src)
+}
+func (m *QueryTotalValueLockedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalValueLockedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalValueLockedResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCollateralPoolAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTotalValueLockedResponse proto.InternalMessageInfo
+
+// PositionLiquidationPrices holds the oracle prices of a perpetual at which a
+// subaccount becomes liquidatable and bankrupt. Both prices are marginal: each
+// assumes that only the price of this perpetual moves while the prices of all
+// other markets stay fixed.
+type PositionLiquidationPrices struct {
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The price at which the net collateral of the subaccount falls below its
+	// maintenance margin requirement. Only meaningful if
+	// `has_liquidation_price` is true.
+	LiquidationPrice    uint64 `protobuf:"varint,2,opt,name=liquidation_price,json=liquidationPrice,proto3" json:"liquidation_price,omitempty"`
+	HasLiquidationPrice bool   `protobuf:"varint,3,opt,name=has_liquidation_price,json=hasLiquidationPrice,proto3" json:"has_liquidation_price,omitempty"`
+	// The price at which the net collateral of the subaccount becomes
+	// negative. Only meaningful if `has_bankruptcy_price` is true.
+	BankruptcyPrice    uint64 `protobuf:"varint,4,opt,name=bankruptcy_price,json=bankruptcyPrice,proto3" json:"bankruptcy_price,omitempty"`
+	HasBankruptcyPrice bool   `protobuf:"varint,5,opt,name=has_bankruptcy_price,json=hasBankruptcyPrice,proto3" json:"has_bankruptcy_price,omitempty"`
+}
+
+func (m *PositionLiquidationPrices) Reset()         { *m = PositionLiquidationPrices{} }
+func (m *PositionLiquidationPrices) String() string { return proto.CompactTextString(m) }
+func (*PositionLiquidationPrices) ProtoMessage()    {}
+func (*PositionLiquidationPrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{19}
+}
+func (m *PositionLiquidationPrices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionLiquidationPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionLiquidationPrices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *PositionLiquidationPrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionLiquidationPrices.Merge(m, src)
+}
+func (m *PositionLiquidationPrices) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionLiquidationPrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionLiquidationPrices.DiscardUnknown(m)
 }
 
-func (m *QueryCollateralPoolAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PositionLiquidationPrices proto.InternalMessageInfo
+
+func (m *PositionLiquidationPrices) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
 }
 
-func (m *QueryCollateralPoolAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CollateralPoolAddress) > 0 {
-		i -= len(m.CollateralPoolAddress)
-		copy(dAtA[i:], m.CollateralPoolAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralPoolAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *PositionLiquidationPrices) GetLiquidationPrice() uint64 {
+	if m != nil {
+		return m.LiquidationPrice
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PositionLiquidationPrices) GetHasLiquidationPrice() bool {
+	if m != nil {
+		return m.HasLiquidationPrice
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return false
 }
-func (m *QueryGetSubaccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *PositionLiquidationPrices) GetBankruptcyPrice() uint64 {
+	if m != nil {
+		return m.BankruptcyPrice
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *PositionLiquidationPrices) GetHasBankruptcyPrice() bool {
+	if m != nil {
+		return m.HasBankruptcyPrice
 	}
-	if m.Number != 0 {
-		n += 1 + sovQuery(uint64(m.Number))
+	return false
+}
+
+// QuerySubaccountLiquidationPricesRequest is the request type for fetching
+// the liquidation prices of a subaccount.
+type QuerySubaccountLiquidationPricesRequest struct {
+	Owner  string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Number uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (m *QuerySubaccountLiquidationPricesRequest) Reset() {
+	*m = QuerySubaccountLiquidationPricesRequest{}
+}
+func (m *QuerySubaccountLiquidationPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubaccountLiquidationPricesRequest) ProtoMessage()    {}
+func (*QuerySubaccountLiquidationPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{20}
+}
+func (m *QuerySubaccountLiquidationPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubaccountLiquidationPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubaccountLiquidationPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QuerySubaccountLiquidationPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubaccountLiquidationPricesRequest.Merge(m, src)
+}
+func (m *QuerySubaccountLiquidationPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubaccountLiquidationPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubaccountLiquidationPricesRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySubaccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QuerySubaccountLiquidationPricesRequest proto.InternalMessageInfo
+
+func (m *QuerySubaccountLiquidationPricesRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	var l int
-	_ = l
-	l = m.Subaccount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryAllSubaccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySubaccountLiquidationPricesRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+// QuerySubaccountLiquidationPricesResponse is the response type for fetching
+// the liquidation prices of a subaccount.
+type QuerySubaccountLiquidationPricesResponse struct {
+	// The prices of every open perpetual position, ordered by perpetual id.
+	Prices []PositionLiquidationPrices `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
+}
+
+func (m *QuerySubaccountLiquidationPricesResponse) Reset() {
+	*m = QuerySubaccountLiquidationPricesResponse{}
+}
+func (m *QuerySubaccountLiquidationPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubaccountLiquidationPricesResponse) ProtoMessage()    {}
+func (*QuerySubaccountLiquidationPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{21}
+}
+func (m *QuerySubaccountLiquidationPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubaccountLiquidationPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubaccountLiquidationPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QuerySubaccountLiquidationPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubaccountLiquidationPricesResponse.Merge(m, src)
+}
+func (m *QuerySubaccountLiquidationPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubaccountLiquidationPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubaccountLiquidationPricesResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySubaccountAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QuerySubaccountLiquidationPricesResponse proto.InternalMessageInfo
+
+func (m *QuerySubaccountLiquidationPricesResponse) GetPrices() []PositionLiquidationPrices {
+	if m != nil {
+		return m.Prices
 	}
-	var l int
-	_ = l
-	if len(m.Subaccount) > 0 {
-		for _, e := range m.Subaccount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// BasketWeight is the weight of a perpetual in a correlated basket. A shock of
+// `shock_ppm` to the basket moves the oracle price of the perpetual by
+// `shock_ppm * weight_ppm / 1,000,000` parts-per-million.
+type BasketWeight struct {
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	WeightPpm   int32  `protobuf:"varint,2,opt,name=weight_ppm,json=weightPpm,proto3" json:"weight_ppm,omitempty"`
+}
+
+func (m *BasketWeight) Reset()         { *m = BasketWeight{} }
+func (m *BasketWeight) String() string { return proto.CompactTextString(m) }
+func (*BasketWeight) ProtoMessage()    {}
+func (*BasketWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{22}
+}
+func (m *BasketWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BasketWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BasketWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *BasketWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BasketWeight.Merge(m, src)
+}
+func (m *BasketWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *BasketWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_BasketWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BasketWeight proto.InternalMessageInfo
+
+func (m *BasketWeight) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
 	}
-	return n
+	return 0
 }
 
-func (m *QueryGetWithdrawalAndTransfersBlockedInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BasketWeight) GetWeightPpm() int32 {
+	if m != nil {
+		return m.WeightPpm
 	}
-	var l int
-	_ = l
-	if m.PerpetualId != 0 {
-		n += 1 + sovQuery(uint64(m.PerpetualId))
+	return 0
+}
+
+// QueryBasketShockToLiquidateRequest is the request type for computing the
+// smallest basket shock that liquidates a subaccount.
+type QueryBasketShockToLiquidateRequest struct {
+	Owner  string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Number uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	// The weight of each perpetual of the basket. If empty, the basket is every
+	// perpetual the subaccount has a position in, perfectly correlated.
+	Weights []BasketWeight `protobuf:"bytes,3,rep,name=weights,proto3" json:"weights"`
+}
+
+func (m *QueryBasketShockToLiquidateRequest) Reset()         { *m = QueryBasketShockToLiquidateRequest{} }
+func (m *QueryBasketShockToLiquidateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBasketShockToLiquidateRequest) ProtoMessage()    {}
+func (*QueryBasketShockToLiquidateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{23}
+}
+func (m *QueryBasketShockToLiquidateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBasketShockToLiquidateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBasketShockToLiquidateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBasketShockToLiquidateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBasketShockToLiquidateRequest.Merge(m, src)
+}
+func (m *QueryBasketShockToLiquidateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBasketShockToLiquidateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBasketShockToLiquidateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBasketShockToLiquidateRequest proto.InternalMessageInfo
+
+func (m *QueryBasketShockToLiquidateRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryBasketShockToLiquidateRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *QueryBasketShockToLiquidateRequest) GetWeights() []BasketWeight {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
+// QueryBasketShockToLiquidateResponse is the response type for computing the
+// smallest basket shock that liquidates a subaccount. The risk is in quote
+// quantums.
+type QueryBasketShockToLiquidateResponse struct {
+	// False if no shock in either direction makes the subaccount liquidatable.
+	Found bool `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	// The smallest liquidating shock in parts-per-million. Negative shocks move
+	// the prices of perpetuals with positive weights down.
+	ShockPpm int64 `protobuf:"varint,2,opt,name=shock_ppm,json=shockPpm,proto3" json:"shock_ppm,omitempty"`
+	// The risk of the subaccount under the shock.
+	NetCollateral                github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=net_collateral,json=netCollateral,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"net_collateral"`
+	InitialMarginRequirement     github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,4,opt,name=initial_margin_requirement,json=initialMarginRequirement,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"initial_margin_requirement"`
+	MaintenanceMarginRequirement github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,5,opt,name=maintenance_margin_requirement,json=maintenanceMarginRequirement,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"maintenance_margin_requirement"`
+}
+
+func (m *QueryBasketShockToLiquidateResponse) Reset()         { *m = QueryBasketShockToLiquidateResponse{} }
+func (m *QueryBasketShockToLiquidateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBasketShockToLiquidateResponse) ProtoMessage()    {}
+func (*QueryBasketShockToLiquidateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{24}
+}
+func (m *QueryBasketShockToLiquidateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBasketShockToLiquidateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBasketShockToLiquidateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBasketShockToLiquidateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBasketShockToLiquidateResponse.Merge(m, src)
+}
+func (m *QueryBasketShockToLiquidateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBasketShockToLiquidateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBasketShockToLiquidateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBasketShockToLiquidateResponse proto.InternalMessageInfo
+
+func (m *QueryBasketShockToLiquidateResponse) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
+}
+
+func (m *QueryBasketShockToLiquidateResponse) GetShockPpm() int64 {
+	if m != nil {
+		return m.ShockPpm
+	}
+	return 0
+}
+
+// PerpetualMaintenanceMargin is the total maintenance margin requirement of
+// the positions in a perpetual, in quote quantums.
+type PerpetualMaintenanceMargin struct {
+	PerpetualId       uint32                                                           `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	MaintenanceMargin github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=maintenance_margin,json=maintenanceMargin,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"maintenance_margin"`
+}
+
+func (m *PerpetualMaintenanceMargin) Reset()         { *m = PerpetualMaintenanceMargin{} }
+func (m *PerpetualMaintenanceMargin) String() string { return proto.CompactTextString(m) }
+func (*PerpetualMaintenanceMargin) ProtoMessage()    {}
+func (*PerpetualMaintenanceMargin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{25}
+}
+func (m *PerpetualMaintenanceMargin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PerpetualMaintenanceMargin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PerpetualMaintenanceMargin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PerpetualMaintenanceMargin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerpetualMaintenanceMargin.Merge(m, src)
+}
+func (m *PerpetualMaintenanceMargin) XXX_Size() int {
+	return m.Size()
+}
+func (m *PerpetualMaintenanceMargin) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerpetualMaintenanceMargin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PerpetualMaintenanceMargin proto.InternalMessageInfo
+
+func (m *PerpetualMaintenanceMargin) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+// QueryTotalMaintenanceMarginRequest is the request type for fetching the
+// total maintenance margin requirement of all subaccounts.
+type QueryTotalMaintenanceMarginRequest struct {
+	// Whether to also return the total maintenance margin requirement of the
+	// positions in each perpetual.
+	ByPerpetual bool `protobuf:"varint,1,opt,name=by_perpetual,json=byPerpetual,proto3" json:"by_perpetual,omitempty"`
+}
+
+func (m *QueryTotalMaintenanceMarginRequest) Reset()         { *m = QueryTotalMaintenanceMarginRequest{} }
+func (m *QueryTotalMaintenanceMarginRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalMaintenanceMarginRequest) ProtoMessage()    {}
+func (*QueryTotalMaintenanceMarginRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{26}
+}
+func (m *QueryTotalMaintenanceMarginRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalMaintenanceMarginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalMaintenanceMarginRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalMaintenanceMarginRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalMaintenanceMarginRequest.Merge(m, src)
+}
+func (m *QueryTotalMaintenanceMarginRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalMaintenanceMarginRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalMaintenanceMarginRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalMaintenanceMarginRequest proto.InternalMessageInfo
+
+func (m *QueryTotalMaintenanceMarginRequest) GetByPerpetual() bool {
+	if m != nil {
+		return m.ByPerpetual
+	}
+	return false
+}
+
+// QueryTotalMaintenanceMarginResponse is the response type for fetching the
+// total maintenance margin requirement of all subaccounts. All values are in
+// quote quantums.
+type QueryTotalMaintenanceMarginResponse struct {
+	Total github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=total,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"total"`
+	// The total maintenance margin requirement of the positions in each
+	// perpetual, ordered by perpetual id. Only set if `by_perpetual` is true.
+	Perpetuals []PerpetualMaintenanceMargin `protobuf:"bytes,2,rep,name=perpetuals,proto3" json:"perpetuals"`
+}
+
+func (m *QueryTotalMaintenanceMarginResponse) Reset()         { *m = QueryTotalMaintenanceMarginResponse{} }
+func (m *QueryTotalMaintenanceMarginResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalMaintenanceMarginResponse) ProtoMessage()    {}
+func (*QueryTotalMaintenanceMarginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{27}
+}
+func (m *QueryTotalMaintenanceMarginResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalMaintenanceMarginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalMaintenanceMarginResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalMaintenanceMarginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalMaintenanceMarginResponse.Merge(m, src)
+}
+func (m *QueryTotalMaintenanceMarginResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalMaintenanceMarginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalMaintenanceMarginResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalMaintenanceMarginResponse proto.InternalMessageInfo
+
+func (m *QueryTotalMaintenanceMarginResponse) GetPerpetuals() []PerpetualMaintenanceMargin {
+	if m != nil {
+		return m.Perpetuals
+	}
+	return nil
+}
+
+// QueryPositionNotionalRequest is the request type for fetching the notional
+// of a position.
+type QueryPositionNotionalRequest struct {
+	Owner       string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Number      uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	PerpetualId uint32 `protobuf:"varint,3,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+}
+
+func (m *QueryPositionNotionalRequest) Reset()         { *m = QueryPositionNotionalRequest{} }
+func (m *QueryPositionNotionalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionNotionalRequest) ProtoMessage()    {}
+func (*QueryPositionNotionalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{28}
+}
+func (m *QueryPositionNotionalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionNotionalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionNotionalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPositionNotionalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionNotionalRequest.Merge(m, src)
+}
+func (m *QueryPositionNotionalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionNotionalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionNotionalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionNotionalRequest proto.InternalMessageInfo
+
+func (m *QueryPositionNotionalRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryPositionNotionalRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *QueryPositionNotionalRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+// QueryPositionNotionalResponse is the response type for fetching the
+// notional of a position.
+type QueryPositionNotionalResponse struct {
+	// The absolute notional of the position at the current market price, in
+	// quote quantums. Zero if the subaccount has no position in the perpetual.
+	Notional github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=notional,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"notional"`
+}
+
+func (m *QueryPositionNotionalResponse) Reset()         { *m = QueryPositionNotionalResponse{} }
+func (m *QueryPositionNotionalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionNotionalResponse) ProtoMessage()    {}
+func (*QueryPositionNotionalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{29}
+}
+func (m *QueryPositionNotionalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionNotionalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionNotionalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPositionNotionalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionNotionalResponse.Merge(m, src)
+}
+func (m *QueryPositionNotionalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionNotionalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionNotionalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionNotionalResponse proto.InternalMessageInfo
+
+// QueryMarketExponentMigrationImpactRequest is the request type for fetching
+// the impact of a price exponent migration.
+type QueryMarketExponentMigrationImpactRequest struct {
+	MarketId         uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	ProposedExponent int32  `protobuf:"varint,2,opt,name=proposed_exponent,json=proposedExponent,proto3" json:"proposed_exponent,omitempty"`
+}
+
+func (m *QueryMarketExponentMigrationImpactRequest) Reset() {
+	*m = QueryMarketExponentMigrationImpactRequest{}
+}
+func (m *QueryMarketExponentMigrationImpactRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryMarketExponentMigrationImpactRequest) ProtoMessage() {}
+func (*QueryMarketExponentMigrationImpactRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{30}
+}
+func (m *QueryMarketExponentMigrationImpactRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketExponentMigrationImpactRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketExponentMigrationImpactRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarketExponentMigrationImpactRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketExponentMigrationImpactRequest.Merge(m, src)
+}
+func (m *QueryMarketExponentMigrationImpactRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketExponentMigrationImpactRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketExponentMigrationImpactRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarketExponentMigrationImpactRequest proto.InternalMessageInfo
+
+func (m *QueryMarketExponentMigrationImpactRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *QueryMarketExponentMigrationImpactRequest) GetProposedExponent() int32 {
+	if m != nil {
+		return m.ProposedExponent
+	}
+	return 0
+}
+
+// QueryMarketExponentMigrationImpactResponse is the response type for
+// fetching the impact of a price exponent migration.
+type QueryMarketExponentMigrationImpactResponse struct {
+	// The aggregate notional of all positions in perpetuals of the market under
+	// the current exponent, in quote quantums.
+	CurrentNotional github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=current_notional,json=currentNotional,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"current_notional"`
+	// The aggregate notional of the same positions under the proposed exponent
+	// with the same integer price, in quote quantums.
+	ProposedNotional github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=proposed_notional,json=proposedNotional,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"proposed_notional"`
+	// The subaccounts that are not liquidatable under the current exponent but
+	// would be under the proposed exponent.
+	NewlyLiquidatable []SubaccountId `protobuf:"bytes,3,rep,name=newly_liquidatable,json=newlyLiquidatable,proto3" json:"newly_liquidatable"`
+}
+
+func (m *QueryMarketExponentMigrationImpactResponse) Reset() {
+	*m = QueryMarketExponentMigrationImpactResponse{}
+}
+func (m *QueryMarketExponentMigrationImpactResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryMarketExponentMigrationImpactResponse) ProtoMessage() {}
+func (*QueryMarketExponentMigrationImpactResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{31}
+}
+func (m *QueryMarketExponentMigrationImpactResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketExponentMigrationImpactResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketExponentMigrationImpactResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarketExponentMigrationImpactResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketExponentMigrationImpactResponse.Merge(m, src)
+}
+func (m *QueryMarketExponentMigrationImpactResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketExponentMigrationImpactResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketExponentMigrationImpactResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarketExponentMigrationImpactResponse proto.InternalMessageInfo
+
+func (m *QueryMarketExponentMigrationImpactResponse) GetNewlyLiquidatable() []SubaccountId {
+	if m != nil {
+		return m.NewlyLiquidatable
+	}
+	return nil
+}
+
+// QueryRiskAtHeightRequest is the request type for fetching the risk of a
+// subaccount at a height.
+type QueryRiskAtHeightRequest struct {
+	Owner  string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Number uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	// Only heights whose state has not been pruned by the node can be queried.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryRiskAtHeightRequest) Reset()         { *m = QueryRiskAtHeightRequest{} }
+func (m *QueryRiskAtHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRiskAtHeightRequest) ProtoMessage()    {}
+func (*QueryRiskAtHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{32}
+}
+func (m *QueryRiskAtHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRiskAtHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRiskAtHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRiskAtHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRiskAtHeightRequest.Merge(m, src)
+}
+func (m *QueryRiskAtHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRiskAtHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRiskAtHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRiskAtHeightRequest proto.InternalMessageInfo
+
+func (m *QueryRiskAtHeightRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryRiskAtHeightRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *QueryRiskAtHeightRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryRiskAtHeightResponse is the response type for fetching the risk of a
+// subaccount at a height. The risk is in quote quantums.
+type QueryRiskAtHeightResponse struct {
+	NetCollateral                github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=net_collateral,json=netCollateral,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"net_collateral"`
+	InitialMarginRequirement     github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=initial_margin_requirement,json=initialMarginRequirement,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"initial_margin_requirement"`
+	MaintenanceMarginRequirement github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=maintenance_margin_requirement,json=maintenanceMarginRequirement,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"maintenance_margin_requirement"`
+}
+
+func (m *QueryRiskAtHeightResponse) Reset()         { *m = QueryRiskAtHeightResponse{} }
+func (m *QueryRiskAtHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRiskAtHeightResponse) ProtoMessage()    {}
+func (*QueryRiskAtHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{33}
+}
+func (m *QueryRiskAtHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRiskAtHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRiskAtHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRiskAtHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRiskAtHeightResponse.Merge(m, src)
+}
+func (m *QueryRiskAtHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRiskAtHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRiskAtHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRiskAtHeightResponse proto.InternalMessageInfo
+
+// QueryHealthDistributionRequest is the request type for fetching the
+// distribution of the health of all subaccounts.
+type QueryHealthDistributionRequest struct {
+	// The strictly ascending upper bounds of the health buckets, as health in
+	// parts-per-million.
+	Buckets []uint32 `protobuf:"varint,1,rep,packed,name=buckets,proto3" json:"buckets,omitempty"`
+}
+
+func (m *QueryHealthDistributionRequest) Reset()         { *m = QueryHealthDistributionRequest{} }
+func (m *QueryHealthDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHealthDistributionRequest) ProtoMessage()    {}
+func (*QueryHealthDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{34}
+}
+func (m *QueryHealthDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHealthDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHealthDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHealthDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHealthDistributionRequest.Merge(m, src)
+}
+func (m *QueryHealthDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHealthDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHealthDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHealthDistributionRequest proto.InternalMessageInfo
+
+func (m *QueryHealthDistributionRequest) GetBuckets() []uint32 {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+// QueryHealthDistributionResponse is the response type for fetching the
+// distribution of the health of all subaccounts.
+type QueryHealthDistributionResponse struct {
+	// The number of subaccounts in each bucket. The last of the `len(buckets) + 1`
+	// counts is the number of subaccounts with a health of at least the last
+	// bound, including subaccounts with no maintenance margin requirement.
+	Counts []uint64 `protobuf:"varint,1,rep,packed,name=counts,proto3" json:"counts,omitempty"`
+}
+
+func (m *QueryHealthDistributionResponse) Reset()         { *m = QueryHealthDistributionResponse{} }
+func (m *QueryHealthDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHealthDistributionResponse) ProtoMessage()    {}
+func (*QueryHealthDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{35}
+}
+func (m *QueryHealthDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHealthDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHealthDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHealthDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHealthDistributionResponse.Merge(m, src)
+}
+func (m *QueryHealthDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHealthDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHealthDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHealthDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryHealthDistributionResponse) GetCounts() []uint64 {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+// QueryMarketNcSensitivityRequest is the request type for fetching the
+// sensitivity of the total net collateral to the price of a perpetual.
+type QueryMarketNcSensitivityRequest struct {
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+}
+
+func (m *QueryMarketNcSensitivityRequest) Reset()         { *m = QueryMarketNcSensitivityRequest{} }
+func (m *QueryMarketNcSensitivityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketNcSensitivityRequest) ProtoMessage()    {}
+func (*QueryMarketNcSensitivityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{36}
+}
+func (m *QueryMarketNcSensitivityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketNcSensitivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketNcSensitivityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarketNcSensitivityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketNcSensitivityRequest.Merge(m, src)
+}
+func (m *QueryMarketNcSensitivityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketNcSensitivityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketNcSensitivityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarketNcSensitivityRequest proto.InternalMessageInfo
+
+func (m *QueryMarketNcSensitivityRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+// QueryMarketNcSensitivityResponse is the response type for fetching the
+// sensitivity of the total net collateral to the price of a perpetual.
+type QueryMarketNcSensitivityResponse struct {
+	// The net signed quantums of all positions in the perpetual.
+	NetQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=net_quantums,json=netQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"net_quantums"`
+	// The exact change in the total net collateral in quote quantums when the
+	// market price moves up by one unit, formatted as an integer or as a
+	// fraction `a/b`.
+	NcPerPriceUnit string `protobuf:"bytes,2,opt,name=nc_per_price_unit,json=ncPerPriceUnit,proto3" json:"nc_per_price_unit,omitempty"`
+}
+
+func (m *QueryMarketNcSensitivityResponse) Reset()         { *m = QueryMarketNcSensitivityResponse{} }
+func (m *QueryMarketNcSensitivityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketNcSensitivityResponse) ProtoMessage()    {}
+func (*QueryMarketNcSensitivityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{37}
+}
+func (m *QueryMarketNcSensitivityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketNcSensitivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketNcSensitivityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarketNcSensitivityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketNcSensitivityResponse.Merge(m, src)
+}
+func (m *QueryMarketNcSensitivityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketNcSensitivityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketNcSensitivityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarketNcSensitivityResponse proto.InternalMessageInfo
+
+func (m *QueryMarketNcSensitivityResponse) GetNcPerPriceUnit() string {
+	if m != nil {
+		return m.NcPerPriceUnit
+	}
+	return ""
+}
+
+// QueryWithdrawableCheckRequest is the request type for checking a withdrawal
+// from a subaccount.
+type QueryWithdrawableCheckRequest struct {
+	Owner  string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Number uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	// The amount to withdraw in USDC quote quantums.
+	QuoteQuantums uint64 `protobuf:"varint,3,opt,name=quote_quantums,json=quoteQuantums,proto3" json:"quote_quantums,omitempty"`
+}
+
+func (m *QueryWithdrawableCheckRequest) Reset()         { *m = QueryWithdrawableCheckRequest{} }
+func (m *QueryWithdrawableCheckRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawableCheckRequest) ProtoMessage()    {}
+func (*QueryWithdrawableCheckRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{38}
+}
+func (m *QueryWithdrawableCheckRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawableCheckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawableCheckRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWithdrawableCheckRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawableCheckRequest.Merge(m, src)
+}
+func (m *QueryWithdrawableCheckRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawableCheckRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawableCheckRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWithdrawableCheckRequest proto.InternalMessageInfo
+
+func (m *QueryWithdrawableCheckRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryWithdrawableCheckRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *QueryWithdrawableCheckRequest) GetQuoteQuantums() uint64 {
+	if m != nil {
+		return m.QuoteQuantums
+	}
+	return 0
+}
+
+// QueryWithdrawableCheckResponse is the response type for checking a
+// withdrawal from a subaccount.
+type QueryWithdrawableCheckResponse struct {
+	// Whether the withdrawal would leave the subaccount collateralized.
+	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// The maximum amount that can be withdrawn in USDC quote quantums.
+	MaxWithdrawable github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=max_withdrawable,json=maxWithdrawable,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"max_withdrawable"`
+}
+
+func (m *QueryWithdrawableCheckResponse) Reset()         { *m = QueryWithdrawableCheckResponse{} }
+func (m *QueryWithdrawableCheckResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawableCheckResponse) ProtoMessage()    {}
+func (*QueryWithdrawableCheckResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{39}
+}
+func (m *QueryWithdrawableCheckResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawableCheckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawableCheckResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWithdrawableCheckResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawableCheckResponse.Merge(m, src)
+}
+func (m *QueryWithdrawableCheckResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawableCheckResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawableCheckResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWithdrawableCheckResponse proto.InternalMessageInfo
+
+func (m *QueryWithdrawableCheckResponse) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+// QueryRealizedPnlOnCloseRequest is the request type for fetching the PnL of
+// closing a position.
+type QueryRealizedPnlOnCloseRequest struct {
+	Owner       string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Number      uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	PerpetualId uint32 `protobuf:"varint,3,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+}
+
+func (m *QueryRealizedPnlOnCloseRequest) Reset()         { *m = QueryRealizedPnlOnCloseRequest{} }
+func (m *QueryRealizedPnlOnCloseRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRealizedPnlOnCloseRequest) ProtoMessage()    {}
+func (*QueryRealizedPnlOnCloseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{40}
+}
+func (m *QueryRealizedPnlOnCloseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRealizedPnlOnCloseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRealizedPnlOnCloseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRealizedPnlOnCloseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRealizedPnlOnCloseRequest.Merge(m, src)
+}
+func (m *QueryRealizedPnlOnCloseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRealizedPnlOnCloseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRealizedPnlOnCloseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRealizedPnlOnCloseRequest proto.InternalMessageInfo
+
+func (m *QueryRealizedPnlOnCloseRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryRealizedPnlOnCloseRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *QueryRealizedPnlOnCloseRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+// QueryRealizedPnlOnCloseResponse is the response type for fetching the PnL of
+// closing a position.
+type QueryRealizedPnlOnCloseResponse struct {
+	// The PnL of closing the position at the current market price in quote
+	// quantums, negative for a loss. Funding and fees are not included.
+	Pnl github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=pnl,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"pnl"`
+}
+
+func (m *QueryRealizedPnlOnCloseResponse) Reset()         { *m = QueryRealizedPnlOnCloseResponse{} }
+func (m *QueryRealizedPnlOnCloseResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRealizedPnlOnCloseResponse) ProtoMessage()    {}
+func (*QueryRealizedPnlOnCloseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{41}
+}
+func (m *QueryRealizedPnlOnCloseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRealizedPnlOnCloseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRealizedPnlOnCloseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRealizedPnlOnCloseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRealizedPnlOnCloseResponse.Merge(m, src)
+}
+func (m *QueryRealizedPnlOnCloseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRealizedPnlOnCloseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRealizedPnlOnCloseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRealizedPnlOnCloseResponse proto.InternalMessageInfo
+
+// QueryProtocolNetDeltaRequest is the request type for fetching the net
+// position of all subaccounts in a perpetual.
+type QueryProtocolNetDeltaRequest struct {
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+}
+
+func (m *QueryProtocolNetDeltaRequest) Reset()         { *m = QueryProtocolNetDeltaRequest{} }
+func (m *QueryProtocolNetDeltaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProtocolNetDeltaRequest) ProtoMessage()    {}
+func (*QueryProtocolNetDeltaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{42}
+}
+func (m *QueryProtocolNetDeltaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProtocolNetDeltaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtocolNetDeltaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProtocolNetDeltaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtocolNetDeltaRequest.Merge(m, src)
+}
+func (m *QueryProtocolNetDeltaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProtocolNetDeltaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtocolNetDeltaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProtocolNetDeltaRequest proto.InternalMessageInfo
+
+func (m *QueryProtocolNetDeltaRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+// QueryProtocolNetDeltaResponse is the response type for fetching the net
+// position of all subaccounts in a perpetual.
+type QueryProtocolNetDeltaResponse struct {
+	// The sum of the signed base quantums of the positions of all subaccounts in
+	// the perpetual. Nonzero if the protocol has residual exposure.
+	NetDelta github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=net_delta,json=netDelta,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"net_delta"`
+}
+
+func (m *QueryProtocolNetDeltaResponse) Reset()         { *m = QueryProtocolNetDeltaResponse{} }
+func (m *QueryProtocolNetDeltaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProtocolNetDeltaResponse) ProtoMessage()    {}
+func (*QueryProtocolNetDeltaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{43}
+}
+func (m *QueryProtocolNetDeltaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProtocolNetDeltaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtocolNetDeltaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProtocolNetDeltaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtocolNetDeltaResponse.Merge(m, src)
+}
+func (m *QueryProtocolNetDeltaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProtocolNetDeltaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtocolNetDeltaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProtocolNetDeltaResponse proto.InternalMessageInfo
+
+// EpochFunding is the funding settled for a perpetual position of a subaccount
+// during a funding-tick epoch.
+type EpochFunding struct {
+	Epoch uint32 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// The funding settled in quote quantums. Positive if the subaccount paid
+	// funding, and negative if the subaccount received funding.
+	FundingPaid github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=funding_paid,json=fundingPaid,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"funding_paid"`
+}
+
+func (m *EpochFunding) Reset()         { *m = EpochFunding{} }
+func (m *EpochFunding) String() string { return proto.CompactTextString(m) }
+func (*EpochFunding) ProtoMessage()    {}
+func (*EpochFunding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{44}
+}
+func (m *EpochFunding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochFunding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochFunding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochFunding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochFunding.Merge(m, src)
+}
+func (m *EpochFunding) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochFunding) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochFunding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochFunding proto.InternalMessageInfo
+
+func (m *EpochFunding) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// QueryFundingHistoryRequest is the request type for fetching the funding
+// history of a position.
+type QueryFundingHistoryRequest struct {
+	Owner       string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Number      uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	PerpetualId uint32 `protobuf:"varint,3,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The first funding-tick epoch of the range, inclusive.
+	FromEpoch uint32 `protobuf:"varint,4,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	// The last funding-tick epoch of the range, inclusive. The range can span at
+	// most `FundingHistoryRetentionEpochs` epochs.
+	ToEpoch uint32 `protobuf:"varint,5,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
+}
+
+func (m *QueryFundingHistoryRequest) Reset()         { *m = QueryFundingHistoryRequest{} }
+func (m *QueryFundingHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingHistoryRequest) ProtoMessage()    {}
+func (*QueryFundingHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{45}
+}
+func (m *QueryFundingHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundingHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundingHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundingHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingHistoryRequest.Merge(m, src)
+}
+func (m *QueryFundingHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundingHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundingHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryFundingHistoryRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryFundingHistoryRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *QueryFundingHistoryRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *QueryFundingHistoryRequest) GetFromEpoch() uint32 {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *QueryFundingHistoryRequest) GetToEpoch() uint32 {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
+}
+
+// QueryFundingHistoryResponse is the response type for fetching the funding
+// history of a position.
+type QueryFundingHistoryResponse struct {
+	// The funding settled during each epoch of the range, in ascending order of
+	// epoch. Epochs during which no funding was settled are omitted.
+	Entries []EpochFunding `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *QueryFundingHistoryResponse) Reset()         { *m = QueryFundingHistoryResponse{} }
+func (m *QueryFundingHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingHistoryResponse) ProtoMessage()    {}
+func (*QueryFundingHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{46}
+}
+func (m *QueryFundingHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundingHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundingHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundingHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingHistoryResponse.Merge(m, src)
+}
+func (m *QueryFundingHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundingHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundingHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryFundingHistoryResponse) GetEntries() []EpochFunding {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// InitialMarginRestoringClose is a close of a perpetual position at the market
+// price that makes a subaccount initially collateralized.
+type InitialMarginRestoringClose struct {
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The absolute number of base quantums of the position to close.
+	Quantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=quantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"quantums"`
+	// The absolute notional of the closed quantums in quote quantums.
+	AbsNotional github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=abs_notional,json=absNotional,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"abs_notional"`
+}
+
+func (m *InitialMarginRestoringClose) Reset()         { *m = InitialMarginRestoringClose{} }
+func (m *InitialMarginRestoringClose) String() string { return proto.CompactTextString(m) }
+func (*InitialMarginRestoringClose) ProtoMessage()    {}
+func (*InitialMarginRestoringClose) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{47}
+}
+func (m *InitialMarginRestoringClose) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InitialMarginRestoringClose) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InitialMarginRestoringClose.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InitialMarginRestoringClose) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitialMarginRestoringClose.Merge(m, src)
+}
+func (m *InitialMarginRestoringClose) XXX_Size() int {
+	return m.Size()
+}
+func (m *InitialMarginRestoringClose) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitialMarginRestoringClose.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitialMarginRestoringClose proto.InternalMessageInfo
+
+func (m *InitialMarginRestoringClose) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+// QueryActionToRestoreInitialMarginRequest is the request type for fetching
+// the closes that restore the initial margin of a subaccount.
+type QueryActionToRestoreInitialMarginRequest struct {
+	Owner  string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Number uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (m *QueryActionToRestoreInitialMarginRequest) Reset() {
+	*m = QueryActionToRestoreInitialMarginRequest{}
+}
+func (m *QueryActionToRestoreInitialMarginRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActionToRestoreInitialMarginRequest) ProtoMessage()    {}
+func (*QueryActionToRestoreInitialMarginRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{48}
+}
+func (m *QueryActionToRestoreInitialMarginRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActionToRestoreInitialMarginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActionToRestoreInitialMarginRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActionToRestoreInitialMarginRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActionToRestoreInitialMarginRequest.Merge(m, src)
+}
+func (m *QueryActionToRestoreInitialMarginRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActionToRestoreInitialMarginRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActionToRestoreInitialMarginRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActionToRestoreInitialMarginRequest proto.InternalMessageInfo
+
+func (m *QueryActionToRestoreInitialMarginRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryActionToRestoreInitialMarginRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+// QueryActionToRestoreInitialMarginResponse is the response type for fetching
+// the closes that restore the initial margin of a subaccount.
+type QueryActionToRestoreInitialMarginResponse struct {
+	// Whether the subaccount is already initially collateralized, in which
+	// case no closes are needed.
+	InitiallyCollateralized bool `protobuf:"varint,1,opt,name=initially_collateralized,json=initiallyCollateralized,proto3" json:"initially_collateralized,omitempty"`
+	// The smallest close of each position that restores the initial margin of
+	// the subaccount, in ascending order of perpetual id. Positions that do not
+	// restore it even when fully closed are omitted.
+	Closes []InitialMarginRestoringClose `protobuf:"bytes,2,rep,name=closes,proto3" json:"closes"`
+	// The close in `closes` with the smallest notional, unset if there are no
+	// closes.
+	Cheapest *InitialMarginRestoringClose `protobuf:"bytes,3,opt,name=cheapest,proto3" json:"cheapest,omitempty"`
+}
+
+func (m *QueryActionToRestoreInitialMarginResponse) Reset() {
+	*m = QueryActionToRestoreInitialMarginResponse{}
+}
+func (m *QueryActionToRestoreInitialMarginResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryActionToRestoreInitialMarginResponse) ProtoMessage() {}
+func (*QueryActionToRestoreInitialMarginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{49}
+}
+func (m *QueryActionToRestoreInitialMarginResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActionToRestoreInitialMarginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActionToRestoreInitialMarginResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActionToRestoreInitialMarginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActionToRestoreInitialMarginResponse.Merge(m, src)
+}
+func (m *QueryActionToRestoreInitialMarginResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActionToRestoreInitialMarginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActionToRestoreInitialMarginResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActionToRestoreInitialMarginResponse proto.InternalMessageInfo
+
+func (m *QueryActionToRestoreInitialMarginResponse) GetInitiallyCollateralized() bool {
+	if m != nil {
+		return m.InitiallyCollateralized
+	}
+	return false
+}
+
+func (m *QueryActionToRestoreInitialMarginResponse) GetCloses() []InitialMarginRestoringClose {
+	if m != nil {
+		return m.Closes
+	}
+	return nil
+}
+
+func (m *QueryActionToRestoreInitialMarginResponse) GetCheapest() *InitialMarginRestoringClose {
+	if m != nil {
+		return m.Cheapest
+	}
+	return nil
+}
+
+// QueryLossBufferToLiquidationRequest is the request type for fetching the
+// loss buffer of a position to liquidation.
+type QueryLossBufferToLiquidationRequest struct {
+	Owner       string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Number      uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	PerpetualId uint32 `protobuf:"varint,3,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+}
+
+func (m *QueryLossBufferToLiquidationRequest) Reset()         { *m = QueryLossBufferToLiquidationRequest{} }
+func (m *QueryLossBufferToLiquidationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLossBufferToLiquidationRequest) ProtoMessage()    {}
+func (*QueryLossBufferToLiquidationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{50}
+}
+func (m *QueryLossBufferToLiquidationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLossBufferToLiquidationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLossBufferToLiquidationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLossBufferToLiquidationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLossBufferToLiquidationRequest.Merge(m, src)
+}
+func (m *QueryLossBufferToLiquidationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLossBufferToLiquidationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLossBufferToLiquidationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLossBufferToLiquidationRequest proto.InternalMessageInfo
+
+func (m *QueryLossBufferToLiquidationRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryLossBufferToLiquidationRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *QueryLossBufferToLiquidationRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+// QueryLossBufferToLiquidationResponse is the response type for fetching the
+// loss buffer of a position to liquidation.
+type QueryLossBufferToLiquidationResponse struct {
+	// False if the subaccount has no position in the perpetual or if no price of
+	// the perpetual makes the subaccount liquidatable.
+	Found bool `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	// The decrease in the net collateral of the subaccount in quote quantums
+	// when the price moves to the liquidation price.
+	LossBuffer github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=loss_buffer,json=lossBuffer,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"loss_buffer"`
+	// The distance from the current price to the liquidation price.
+	PriceMove uint64 `protobuf:"varint,3,opt,name=price_move,json=priceMove,proto3" json:"price_move,omitempty"`
+}
+
+func (m *QueryLossBufferToLiquidationResponse) Reset()         { *m = QueryLossBufferToLiquidationResponse{} }
+func (m *QueryLossBufferToLiquidationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLossBufferToLiquidationResponse) ProtoMessage()    {}
+func (*QueryLossBufferToLiquidationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{51}
+}
+func (m *QueryLossBufferToLiquidationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLossBufferToLiquidationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLossBufferToLiquidationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLossBufferToLiquidationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLossBufferToLiquidationResponse.Merge(m, src)
+}
+func (m *QueryLossBufferToLiquidationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLossBufferToLiquidationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLossBufferToLiquidationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLossBufferToLiquidationResponse proto.InternalMessageInfo
+
+func (m *QueryLossBufferToLiquidationResponse) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
+}
+
+func (m *QueryLossBufferToLiquidationResponse) GetPriceMove() uint64 {
+	if m != nil {
+		return m.PriceMove
+	}
+	return 0
+}
+
+// QueryMarketUnrealizedPnlRequest is the request type for fetching the
+// unrealized PnL of a market.
+type QueryMarketUnrealizedPnlRequest struct {
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+}
+
+func (m *QueryMarketUnrealizedPnlRequest) Reset()         { *m = QueryMarketUnrealizedPnlRequest{} }
+func (m *QueryMarketUnrealizedPnlRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketUnrealizedPnlRequest) ProtoMessage()    {}
+func (*QueryMarketUnrealizedPnlRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{52}
+}
+func (m *QueryMarketUnrealizedPnlRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketUnrealizedPnlRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketUnrealizedPnlRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarketUnrealizedPnlRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketUnrealizedPnlRequest.Merge(m, src)
+}
+func (m *QueryMarketUnrealizedPnlRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketUnrealizedPnlRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketUnrealizedPnlRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarketUnrealizedPnlRequest proto.InternalMessageInfo
+
+func (m *QueryMarketUnrealizedPnlRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+// QueryMarketUnrealizedPnlResponse is the response type for fetching the
+// unrealized PnL of a market.
+type QueryMarketUnrealizedPnlResponse struct {
+	// The sum of the unrealized PnL of all positions in the perpetual in quote
+	// quantums. Positions whose cost basis is unknown are skipped.
+	Pnl github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=pnl,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"pnl"`
+}
+
+func (m *QueryMarketUnrealizedPnlResponse) Reset()         { *m = QueryMarketUnrealizedPnlResponse{} }
+func (m *QueryMarketUnrealizedPnlResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketUnrealizedPnlResponse) ProtoMessage()    {}
+func (*QueryMarketUnrealizedPnlResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{53}
+}
+func (m *QueryMarketUnrealizedPnlResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketUnrealizedPnlResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketUnrealizedPnlResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarketUnrealizedPnlResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketUnrealizedPnlResponse.Merge(m, src)
+}
+func (m *QueryMarketUnrealizedPnlResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketUnrealizedPnlResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketUnrealizedPnlResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarketUnrealizedPnlResponse proto.InternalMessageInfo
+
+// AutoWithdrawableAccount is a subaccount whose free collateral exceeds an
+// auto-withdrawal threshold.
+type AutoWithdrawableAccount struct {
+	SubaccountId SubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+	// The amount that can be withdrawn in quote quantums.
+	QuoteQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=quote_quantums,json=quoteQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"quote_quantums"`
+}
+
+func (m *AutoWithdrawableAccount) Reset()         { *m = AutoWithdrawableAccount{} }
+func (m *AutoWithdrawableAccount) String() string { return proto.CompactTextString(m) }
+func (*AutoWithdrawableAccount) ProtoMessage()    {}
+func (*AutoWithdrawableAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{54}
+}
+func (m *AutoWithdrawableAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoWithdrawableAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoWithdrawableAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoWithdrawableAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoWithdrawableAccount.Merge(m, src)
+}
+func (m *AutoWithdrawableAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoWithdrawableAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoWithdrawableAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoWithdrawableAccount proto.InternalMessageInfo
+
+func (m *AutoWithdrawableAccount) GetSubaccountId() SubaccountId {
+	if m != nil {
+		return m.SubaccountId
+	}
+	return SubaccountId{}
+}
+
+// QueryAutoWithdrawableAccountsRequest is the request type for fetching the
+// subaccounts that can be auto-withdrawn from.
+type QueryAutoWithdrawableAccountsRequest struct {
+	// Only subaccounts whose free collateral exceeds the threshold are
+	// returned.
+	ThresholdQuoteQuantums uint64 `protobuf:"varint,1,opt,name=threshold_quote_quantums,json=thresholdQuoteQuantums,proto3" json:"threshold_quote_quantums,omitempty"`
+}
+
+func (m *QueryAutoWithdrawableAccountsRequest) Reset()         { *m = QueryAutoWithdrawableAccountsRequest{} }
+func (m *QueryAutoWithdrawableAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoWithdrawableAccountsRequest) ProtoMessage()    {}
+func (*QueryAutoWithdrawableAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{55}
+}
+func (m *QueryAutoWithdrawableAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoWithdrawableAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoWithdrawableAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoWithdrawableAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoWithdrawableAccountsRequest.Merge(m, src)
+}
+func (m *QueryAutoWithdrawableAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoWithdrawableAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoWithdrawableAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoWithdrawableAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryAutoWithdrawableAccountsRequest) GetThresholdQuoteQuantums() uint64 {
+	if m != nil {
+		return m.ThresholdQuoteQuantums
+	}
+	return 0
+}
+
+// QueryAutoWithdrawableAccountsResponse is the response type for fetching the
+// subaccounts that can be auto-withdrawn from.
+type QueryAutoWithdrawableAccountsResponse struct {
+	// The subaccounts in the order they are stored.
+	Accounts []AutoWithdrawableAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+}
+
+func (m *QueryAutoWithdrawableAccountsResponse) Reset()         { *m = QueryAutoWithdrawableAccountsResponse{} }
+func (m *QueryAutoWithdrawableAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoWithdrawableAccountsResponse) ProtoMessage()    {}
+func (*QueryAutoWithdrawableAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{56}
+}
+func (m *QueryAutoWithdrawableAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoWithdrawableAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoWithdrawableAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoWithdrawableAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoWithdrawableAccountsResponse.Merge(m, src)
+}
+func (m *QueryAutoWithdrawableAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoWithdrawableAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoWithdrawableAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoWithdrawableAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryAutoWithdrawableAccountsResponse) GetAccounts() []AutoWithdrawableAccount {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+// QueryCloseAllCostRequest is the request type for fetching the cost of
+// closing all positions of a subaccount.
+type QueryCloseAllCostRequest struct {
+	Owner  string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Number uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (m *QueryCloseAllCostRequest) Reset()         { *m = QueryCloseAllCostRequest{} }
+func (m *QueryCloseAllCostRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCloseAllCostRequest) ProtoMessage()    {}
+func (*QueryCloseAllCostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{57}
+}
+func (m *QueryCloseAllCostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCloseAllCostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCloseAllCostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCloseAllCostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCloseAllCostRequest.Merge(m, src)
+}
+func (m *QueryCloseAllCostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCloseAllCostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCloseAllCostRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCloseAllCostRequest proto.InternalMessageInfo
+
+func (m *QueryCloseAllCostRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryCloseAllCostRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+// QueryCloseAllCostResponse is the response type for fetching the cost of
+// closing all positions of a subaccount.
+type QueryCloseAllCostResponse struct {
+	// The total taker fees in quote quantums of closing all positions at their
+	// market prices.
+	Fees github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=fees,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"fees"`
+	// The net collateral of the subaccount after closing all positions
+	// and paying the fees.
+	PostCloseNetCollateral github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=post_close_net_collateral,json=postCloseNetCollateral,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"post_close_net_collateral"`
+}
+
+func (m *QueryCloseAllCostResponse) Reset()         { *m = QueryCloseAllCostResponse{} }
+func (m *QueryCloseAllCostResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCloseAllCostResponse) ProtoMessage()    {}
+func (*QueryCloseAllCostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{58}
+}
+func (m *QueryCloseAllCostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCloseAllCostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCloseAllCostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCloseAllCostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCloseAllCostResponse.Merge(m, src)
+}
+func (m *QueryCloseAllCostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCloseAllCostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCloseAllCostResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCloseAllCostResponse proto.InternalMessageInfo
+
+// QueryLiquidatableAccountsRequest is the request type for fetching the
+// liquidatable subaccounts.
+type QueryLiquidatableAccountsRequest struct {
+}
+
+func (m *QueryLiquidatableAccountsRequest) Reset()         { *m = QueryLiquidatableAccountsRequest{} }
+func (m *QueryLiquidatableAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidatableAccountsRequest) ProtoMessage()    {}
+func (*QueryLiquidatableAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{59}
+}
+func (m *QueryLiquidatableAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidatableAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidatableAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidatableAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidatableAccountsRequest.Merge(m, src)
+}
+func (m *QueryLiquidatableAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidatableAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidatableAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidatableAccountsRequest proto.InternalMessageInfo
+
+// QueryLiquidatableAccountsResponse is the response type for fetching the
+// liquidatable subaccounts.
+type QueryLiquidatableAccountsResponse struct {
+	// The liquidatable subaccounts from most to least underwater.
+	SubaccountIds []SubaccountId `protobuf:"bytes,1,rep,name=subaccount_ids,json=subaccountIds,proto3" json:"subaccount_ids"`
+}
+
+func (m *QueryLiquidatableAccountsResponse) Reset()         { *m = QueryLiquidatableAccountsResponse{} }
+func (m *QueryLiquidatableAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidatableAccountsResponse) ProtoMessage()    {}
+func (*QueryLiquidatableAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{60}
+}
+func (m *QueryLiquidatableAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidatableAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidatableAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidatableAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidatableAccountsResponse.Merge(m, src)
+}
+func (m *QueryLiquidatableAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidatableAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidatableAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidatableAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryLiquidatableAccountsResponse) GetSubaccountIds() []SubaccountId {
+	if m != nil {
+		return m.SubaccountIds
+	}
+	return nil
+}
+
+// QueryBreakEvenPriceRequest is the request type for fetching the break-even
+// price of a position.
+type QueryBreakEvenPriceRequest struct {
+	Owner       string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Number      uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	PerpetualId uint32 `protobuf:"varint,3,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+}
+
+func (m *QueryBreakEvenPriceRequest) Reset()         { *m = QueryBreakEvenPriceRequest{} }
+func (m *QueryBreakEvenPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBreakEvenPriceRequest) ProtoMessage()    {}
+func (*QueryBreakEvenPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{61}
+}
+func (m *QueryBreakEvenPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBreakEvenPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBreakEvenPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBreakEvenPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBreakEvenPriceRequest.Merge(m, src)
+}
+func (m *QueryBreakEvenPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBreakEvenPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBreakEvenPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBreakEvenPriceRequest proto.InternalMessageInfo
+
+func (m *QueryBreakEvenPriceRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryBreakEvenPriceRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *QueryBreakEvenPriceRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+// QueryBreakEvenPriceResponse is the response type for fetching the break-even
+// price of a position.
+type QueryBreakEvenPriceResponse struct {
+	// The exact break-even price in quote quantums per base quantum, net of the
+	// funding accrued by the position, formatted as an integer or as a fraction
+	// `a/b`. Fees are not included.
+	Price string `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (m *QueryBreakEvenPriceResponse) Reset()         { *m = QueryBreakEvenPriceResponse{} }
+func (m *QueryBreakEvenPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBreakEvenPriceResponse) ProtoMessage()    {}
+func (*QueryBreakEvenPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{62}
+}
+func (m *QueryBreakEvenPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBreakEvenPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBreakEvenPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBreakEvenPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBreakEvenPriceResponse.Merge(m, src)
+}
+func (m *QueryBreakEvenPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBreakEvenPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBreakEvenPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBreakEvenPriceResponse proto.InternalMessageInfo
+
+func (m *QueryBreakEvenPriceResponse) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+// QueryFlatteningSizeRequest is the request type for fetching the size of the
+// trade that flattens the net position of an owner in a perpetual.
+type QueryFlatteningSizeRequest struct {
+	Owner       string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	PerpetualId uint32 `protobuf:"varint,2,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+}
+
+func (m *QueryFlatteningSizeRequest) Reset()         { *m = QueryFlatteningSizeRequest{} }
+func (m *QueryFlatteningSizeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFlatteningSizeRequest) ProtoMessage()    {}
+func (*QueryFlatteningSizeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{63}
+}
+func (m *QueryFlatteningSizeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFlatteningSizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFlatteningSizeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFlatteningSizeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFlatteningSizeRequest.Merge(m, src)
+}
+func (m *QueryFlatteningSizeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFlatteningSizeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFlatteningSizeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFlatteningSizeRequest proto.InternalMessageInfo
+
+func (m *QueryFlatteningSizeRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryFlatteningSizeRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+// QueryFlatteningSizeResponse is the response type for fetching the size of
+// the trade that flattens the net position of an owner in a perpetual.
+type QueryFlatteningSizeResponse struct {
+	// The signed base quantums to trade, negative if the owner is net long.
+	Quantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=quantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"quantums"`
+	// The absolute notional of the trade at the market price in quote
+	// quantums.
+	AbsNotional github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=abs_notional,json=absNotional,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"abs_notional"`
+}
+
+func (m *QueryFlatteningSizeResponse) Reset()         { *m = QueryFlatteningSizeResponse{} }
+func (m *QueryFlatteningSizeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFlatteningSizeResponse) ProtoMessage()    {}
+func (*QueryFlatteningSizeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{64}
+}
+func (m *QueryFlatteningSizeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFlatteningSizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFlatteningSizeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFlatteningSizeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFlatteningSizeResponse.Merge(m, src)
+}
+func (m *QueryFlatteningSizeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFlatteningSizeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFlatteningSizeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFlatteningSizeResponse proto.InternalMessageInfo
+
+// LiquidationDepthLevel is a price level of a depth model of the order book of
+// a perpetual.
+type LiquidationDepthLevel struct {
+	// The price of the level, in the exponent of the market price of the
+	// perpetual.
+	Price uint64 `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
+	// The size available at the level, in base quantums.
+	Quantums uint64 `protobuf:"varint,2,opt,name=quantums,proto3" json:"quantums,omitempty"`
+}
+
+func (m *LiquidationDepthLevel) Reset()         { *m = LiquidationDepthLevel{} }
+func (m *LiquidationDepthLevel) String() string { return proto.CompactTextString(m) }
+func (*LiquidationDepthLevel) ProtoMessage()    {}
+func (*LiquidationDepthLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{65}
+}
+func (m *LiquidationDepthLevel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidationDepthLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidationDepthLevel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidationDepthLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidationDepthLevel.Merge(m, src)
+}
+func (m *LiquidationDepthLevel) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidationDepthLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidationDepthLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidationDepthLevel proto.InternalMessageInfo
+
+func (m *LiquidationDepthLevel) GetPrice() uint64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *LiquidationDepthLevel) GetQuantums() uint64 {
+	if m != nil {
+		return m.Quantums
+	}
+	return 0
+}
+
+// QueryLiquidationProceedsEstimateRequest is the request type for estimating
+// the proceeds of liquidating a position.
+type QueryLiquidationProceedsEstimateRequest struct {
+	Owner       string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Number      uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	PerpetualId uint32 `protobuf:"varint,3,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The price levels of the side of the order book the liquidation would
+	// trade against, ordered from the best price to the worst.
+	Depth []LiquidationDepthLevel `protobuf:"bytes,4,rep,name=depth,proto3" json:"depth"`
+	// The maximum liquidation fee in parts-per-million of the notional of
+	// each fill.
+	MaxLiquidationFeePpm uint32 `protobuf:"varint,5,opt,name=max_liquidation_fee_ppm,json=maxLiquidationFeePpm,proto3" json:"max_liquidation_fee_ppm,omitempty"`
+}
+
+func (m *QueryLiquidationProceedsEstimateRequest) Reset() {
+	*m = QueryLiquidationProceedsEstimateRequest{}
+}
+func (m *QueryLiquidationProceedsEstimateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidationProceedsEstimateRequest) ProtoMessage()    {}
+func (*QueryLiquidationProceedsEstimateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{66}
+}
+func (m *QueryLiquidationProceedsEstimateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidationProceedsEstimateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidationProceedsEstimateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidationProceedsEstimateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidationProceedsEstimateRequest.Merge(m, src)
+}
+func (m *QueryLiquidationProceedsEstimateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidationProceedsEstimateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidationProceedsEstimateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidationProceedsEstimateRequest proto.InternalMessageInfo
+
+func (m *QueryLiquidationProceedsEstimateRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryLiquidationProceedsEstimateRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *QueryLiquidationProceedsEstimateRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *QueryLiquidationProceedsEstimateRequest) GetDepth() []LiquidationDepthLevel {
+	if m != nil {
+		return m.Depth
+	}
+	return nil
+}
+
+func (m *QueryLiquidationProceedsEstimateRequest) GetMaxLiquidationFeePpm() uint32 {
+	if m != nil {
+		return m.MaxLiquidationFeePpm
+	}
+	return 0
+}
+
+// QueryLiquidationProceedsEstimateResponse is the response type for estimating
+// the proceeds of liquidating a position. Amounts are in quote quantums.
+type QueryLiquidationProceedsEstimateResponse struct {
+	// The size of the position closed by the liquidation in base quantums.
+	// This is less than the size of the position if the depth does not have
+	// enough size to close it.
+	FilledQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=filled_quantums,json=filledQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"filled_quantums"`
+	// The quote quantums received by the subaccount for the closed size,
+	// negative for short positions.
+	ProceedsQuoteQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=proceeds_quote_quantums,json=proceedsQuoteQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"proceeds_quote_quantums"`
+	// The contribution of the liquidation to the insurance fund,
+	// negative if the insurance fund pays.
+	InsuranceFundDeltaQuoteQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=insurance_fund_delta_quote_quantums,json=insuranceFundDeltaQuoteQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"insurance_fund_delta_quote_quantums"`
+}
+
+func (m *QueryLiquidationProceedsEstimateResponse) Reset() {
+	*m = QueryLiquidationProceedsEstimateResponse{}
+}
+func (m *QueryLiquidationProceedsEstimateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidationProceedsEstimateResponse) ProtoMessage()    {}
+func (*QueryLiquidationProceedsEstimateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{67}
+}
+func (m *QueryLiquidationProceedsEstimateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidationProceedsEstimateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidationProceedsEstimateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidationProceedsEstimateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidationProceedsEstimateResponse.Merge(m, src)
+}
+func (m *QueryLiquidationProceedsEstimateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidationProceedsEstimateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidationProceedsEstimateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidationProceedsEstimateResponse proto.InternalMessageInfo
+
+// QueryMarginDeltaForTierChangeRequest is the request type for fetching the
+// change in the margin requirements of a subaccount for a liquidity tier
+// change.
+type QueryMarginDeltaForTierChangeRequest struct {
+	Owner        string              `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Number       uint32              `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	PerpetualId  uint32              `protobuf:"varint,3,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	ProposedTier types.LiquidityTier `protobuf:"bytes,4,opt,name=proposed_tier,json=proposedTier,proto3" json:"proposed_tier"`
+}
+
+func (m *QueryMarginDeltaForTierChangeRequest) Reset()         { *m = QueryMarginDeltaForTierChangeRequest{} }
+func (m *QueryMarginDeltaForTierChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMarginDeltaForTierChangeRequest) ProtoMessage()    {}
+func (*QueryMarginDeltaForTierChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{68}
+}
+func (m *QueryMarginDeltaForTierChangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarginDeltaForTierChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarginDeltaForTierChangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarginDeltaForTierChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarginDeltaForTierChangeRequest.Merge(m, src)
+}
+func (m *QueryMarginDeltaForTierChangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarginDeltaForTierChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarginDeltaForTierChangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarginDeltaForTierChangeRequest proto.InternalMessageInfo
+
+func (m *QueryMarginDeltaForTierChangeRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryMarginDeltaForTierChangeRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *QueryMarginDeltaForTierChangeRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *QueryMarginDeltaForTierChangeRequest) GetProposedTier() types.LiquidityTier {
+	if m != nil {
+		return m.ProposedTier
+	}
+	return types.LiquidityTier{}
+}
+
+// QueryMarginDeltaForTierChangeResponse is the response type for fetching the
+// change in the margin requirements of a subaccount for a liquidity tier
+// change. Deltas are in quote quantums and positive if the subaccount would
+// need more margin.
+type QueryMarginDeltaForTierChangeResponse struct {
+	InitialMarginRequirementDelta     github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=initial_margin_requirement_delta,json=initialMarginRequirementDelta,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"initial_margin_requirement_delta"`
+	MaintenanceMarginRequirementDelta github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=maintenance_margin_requirement_delta,json=maintenanceMarginRequirementDelta,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"maintenance_margin_requirement_delta"`
+}
+
+func (m *QueryMarginDeltaForTierChangeResponse) Reset()         { *m = QueryMarginDeltaForTierChangeResponse{} }
+func (m *QueryMarginDeltaForTierChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMarginDeltaForTierChangeResponse) ProtoMessage()    {}
+func (*QueryMarginDeltaForTierChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{69}
+}
+func (m *QueryMarginDeltaForTierChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarginDeltaForTierChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarginDeltaForTierChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarginDeltaForTierChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarginDeltaForTierChangeResponse.Merge(m, src)
+}
+func (m *QueryMarginDeltaForTierChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarginDeltaForTierChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarginDeltaForTierChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarginDeltaForTierChangeResponse proto.InternalMessageInfo
+
+// HistoricalMove is the magnitude of the worst single-day move observed
+// historically in the market of a perpetual. The move is applied against the
+// position of the subaccount in the perpetual.
+type HistoricalMove struct {
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	MovePpm     uint32 `protobuf:"varint,2,opt,name=move_ppm,json=movePpm,proto3" json:"move_ppm,omitempty"`
+}
+
+func (m *HistoricalMove) Reset()         { *m = HistoricalMove{} }
+func (m *HistoricalMove) String() string { return proto.CompactTextString(m) }
+func (*HistoricalMove) ProtoMessage()    {}
+func (*HistoricalMove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{70}
+}
+func (m *HistoricalMove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoricalMove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoricalMove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoricalMove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricalMove.Merge(m, src)
+}
+func (m *HistoricalMove) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoricalMove) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricalMove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoricalMove proto.InternalMessageInfo
+
+func (m *HistoricalMove) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *HistoricalMove) GetMovePpm() uint32 {
+	if m != nil {
+		return m.MovePpm
+	}
+	return 0
+}
+
+// QueryRiskUnderHistoricalShocksRequest is the request type for computing the
+// risk of a subaccount under historical shocks.
+type QueryRiskUnderHistoricalShocksRequest struct {
+	Owner  string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Number uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	// The worst historical move of each perpetual. Perpetuals without a move
+	// are not shocked.
+	WorstMoves []HistoricalMove `protobuf:"bytes,3,rep,name=worst_moves,json=worstMoves,proto3" json:"worst_moves"`
+}
+
+func (m *QueryRiskUnderHistoricalShocksRequest) Reset()         { *m = QueryRiskUnderHistoricalShocksRequest{} }
+func (m *QueryRiskUnderHistoricalShocksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRiskUnderHistoricalShocksRequest) ProtoMessage()    {}
+func (*QueryRiskUnderHistoricalShocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{71}
+}
+func (m *QueryRiskUnderHistoricalShocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRiskUnderHistoricalShocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRiskUnderHistoricalShocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRiskUnderHistoricalShocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRiskUnderHistoricalShocksRequest.Merge(m, src)
+}
+func (m *QueryRiskUnderHistoricalShocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRiskUnderHistoricalShocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRiskUnderHistoricalShocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRiskUnderHistoricalShocksRequest proto.InternalMessageInfo
+
+func (m *QueryRiskUnderHistoricalShocksRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryRiskUnderHistoricalShocksRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *QueryRiskUnderHistoricalShocksRequest) GetWorstMoves() []HistoricalMove {
+	if m != nil {
+		return m.WorstMoves
+	}
+	return nil
+}
+
+// QueryRiskUnderHistoricalShocksResponse is the response type for computing
+// the risk of a subaccount under historical shocks. The risk is in quote
+// quantums.
+type QueryRiskUnderHistoricalShocksResponse struct {
+	NetCollateral                github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=net_collateral,json=netCollateral,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"net_collateral"`
+	InitialMarginRequirement     github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=initial_margin_requirement,json=initialMarginRequirement,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"initial_margin_requirement"`
+	MaintenanceMarginRequirement github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=maintenance_margin_requirement,json=maintenanceMarginRequirement,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"maintenance_margin_requirement"`
+}
+
+func (m *QueryRiskUnderHistoricalShocksResponse) Reset() {
+	*m = QueryRiskUnderHistoricalShocksResponse{}
+}
+func (m *QueryRiskUnderHistoricalShocksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRiskUnderHistoricalShocksResponse) ProtoMessage()    {}
+func (*QueryRiskUnderHistoricalShocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{72}
+}
+func (m *QueryRiskUnderHistoricalShocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRiskUnderHistoricalShocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRiskUnderHistoricalShocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRiskUnderHistoricalShocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRiskUnderHistoricalShocksResponse.Merge(m, src)
+}
+func (m *QueryRiskUnderHistoricalShocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRiskUnderHistoricalShocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRiskUnderHistoricalShocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRiskUnderHistoricalShocksResponse proto.InternalMessageInfo
+
+// QueryMinLiquidatingPriceMoveRequest is the request type for fetching the
+// smallest price move that liquidates a subaccount.
+type QueryMinLiquidatingPriceMoveRequest struct {
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+}
+
+func (m *QueryMinLiquidatingPriceMoveRequest) Reset()         { *m = QueryMinLiquidatingPriceMoveRequest{} }
+func (m *QueryMinLiquidatingPriceMoveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMinLiquidatingPriceMoveRequest) ProtoMessage()    {}
+func (*QueryMinLiquidatingPriceMoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{73}
+}
+func (m *QueryMinLiquidatingPriceMoveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinLiquidatingPriceMoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinLiquidatingPriceMoveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinLiquidatingPriceMoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinLiquidatingPriceMoveRequest.Merge(m, src)
+}
+func (m *QueryMinLiquidatingPriceMoveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinLiquidatingPriceMoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinLiquidatingPriceMoveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinLiquidatingPriceMoveRequest proto.InternalMessageInfo
+
+func (m *QueryMinLiquidatingPriceMoveRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+// QueryMinLiquidatingPriceMoveResponse is the response type for fetching the
+// smallest price move that liquidates a subaccount.
+type QueryMinLiquidatingPriceMoveResponse struct {
+	// False if no move in the price liquidates a subaccount.
+	Found bool `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	// The smallest adverse move in parts-per-million of the current price,
+	// rounded up. Zero if a subaccount is already liquidatable.
+	MovePpm uint64 `protobuf:"varint,2,opt,name=move_ppm,json=movePpm,proto3" json:"move_ppm,omitempty"`
+	// The subaccount that becomes liquidatable first.
+	SubaccountId SubaccountId `protobuf:"bytes,3,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+}
+
+func (m *QueryMinLiquidatingPriceMoveResponse) Reset()         { *m = QueryMinLiquidatingPriceMoveResponse{} }
+func (m *QueryMinLiquidatingPriceMoveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMinLiquidatingPriceMoveResponse) ProtoMessage()    {}
+func (*QueryMinLiquidatingPriceMoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{74}
+}
+func (m *QueryMinLiquidatingPriceMoveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinLiquidatingPriceMoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinLiquidatingPriceMoveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinLiquidatingPriceMoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinLiquidatingPriceMoveResponse.Merge(m, src)
+}
+func (m *QueryMinLiquidatingPriceMoveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinLiquidatingPriceMoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinLiquidatingPriceMoveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinLiquidatingPriceMoveResponse proto.InternalMessageInfo
+
+func (m *QueryMinLiquidatingPriceMoveResponse) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
+}
+
+func (m *QueryMinLiquidatingPriceMoveResponse) GetMovePpm() uint64 {
+	if m != nil {
+		return m.MovePpm
+	}
+	return 0
+}
+
+func (m *QueryMinLiquidatingPriceMoveResponse) GetSubaccountId() SubaccountId {
+	if m != nil {
+		return m.SubaccountId
+	}
+	return SubaccountId{}
+}
+
+func init() {
+	proto.RegisterType((*QueryGetSubaccountRequest)(nil), "dydxprotocol.subaccounts.QueryGetSubaccountRequest")
+	proto.RegisterType((*QuerySubaccountResponse)(nil), "dydxprotocol.subaccounts.QuerySubaccountResponse")
+	proto.RegisterType((*QueryAllSubaccountRequest)(nil), "dydxprotocol.subaccounts.QueryAllSubaccountRequest")
+	proto.RegisterType((*QuerySubaccountAllResponse)(nil), "dydxprotocol.subaccounts.QuerySubaccountAllResponse")
+	proto.RegisterType((*QueryGetWithdrawalAndTransfersBlockedInfoRequest)(nil), "dydxprotocol.subaccounts.QueryGetWithdrawalAndTransfersBlockedInfoRequest")
+	proto.RegisterType((*QueryGetWithdrawalAndTransfersBlockedInfoResponse)(nil), "dydxprotocol.subaccounts.QueryGetWithdrawalAndTransfersBlockedInfoResponse")
+	proto.RegisterType((*QueryCollateralPoolAddressRequest)(nil), "dydxprotocol.subaccounts.QueryCollateralPoolAddressRequest")
+	proto.RegisterType((*QueryCollateralPoolAddressResponse)(nil), "dydxprotocol.subaccounts.QueryCollateralPoolAddressResponse")
+	proto.RegisterType((*HypotheticalAssetUpdate)(nil), "dydxprotocol.subaccounts.HypotheticalAssetUpdate")
+	proto.RegisterType((*HypotheticalPerpetualUpdate)(nil), "dydxprotocol.subaccounts.HypotheticalPerpetualUpdate")
+	proto.RegisterType((*QueryComputeRiskForHypotheticalRequest)(nil), "dydxprotocol.subaccounts.QueryComputeRiskForHypotheticalRequest")
+	proto.RegisterType((*QueryComputeRiskForHypotheticalResponse)(nil), "dydxprotocol.subaccounts.QueryComputeRiskForHypotheticalResponse")
+	proto.RegisterType((*QueryRiskInputsRequest)(nil), "dydxprotocol.subaccounts.QueryRiskInputsRequest")
+	proto.RegisterType((*PerpetualRiskInputs)(nil), "dydxprotocol.subaccounts.PerpetualRiskInputs")
+	proto.RegisterType((*QueryRiskInputsResponse)(nil), "dydxprotocol.subaccounts.QueryRiskInputsResponse")
+	proto.RegisterType((*QuerySubaccountUtilizationRequest)(nil), "dydxprotocol.subaccounts.QuerySubaccountUtilizationRequest")
+	proto.RegisterType((*QuerySubaccountUtilizationResponse)(nil), "dydxprotocol.subaccounts.QuerySubaccountUtilizationResponse")
+	proto.RegisterType((*QueryTotalValueLockedRequest)(nil), "dydxprotocol.subaccounts.QueryTotalValueLockedRequest")
+	proto.RegisterType((*QueryTotalValueLockedResponse)(nil), "dydxprotocol.subaccounts.QueryTotalValueLockedResponse")
+	proto.RegisterType((*PositionLiquidationPrices)(nil), "dydxprotocol.subaccounts.PositionLiquidationPrices")
+	proto.RegisterType((*QuerySubaccountLiquidationPricesRequest)(nil), "dydxprotocol.subaccounts.QuerySubaccountLiquidationPricesRequest")
+	proto.RegisterType((*QuerySubaccountLiquidationPricesResponse)(nil), "dydxprotocol.subaccounts.QuerySubaccountLiquidationPricesResponse")
+	proto.RegisterType((*BasketWeight)(nil), "dydxprotocol.subaccounts.BasketWeight")
+	proto.RegisterType((*QueryBasketShockToLiquidateRequest)(nil), "dydxprotocol.subaccounts.QueryBasketShockToLiquidateRequest")
+	proto.RegisterType((*QueryBasketShockToLiquidateResponse)(nil), "dydxprotocol.subaccounts.QueryBasketShockToLiquidateResponse")
+	proto.RegisterType((*PerpetualMaintenanceMargin)(nil), "dydxprotocol.subaccounts.PerpetualMaintenanceMargin")
+	proto.RegisterType((*QueryTotalMaintenanceMarginRequest)(nil), "dydxprotocol.subaccounts.QueryTotalMaintenanceMarginRequest")
+	proto.RegisterType((*QueryTotalMaintenanceMarginResponse)(nil), "dydxprotocol.subaccounts.QueryTotalMaintenanceMarginResponse")
+	proto.RegisterType((*QueryPositionNotionalRequest)(nil), "dydxprotocol.subaccounts.QueryPositionNotionalRequest")
+	proto.RegisterType((*QueryPositionNotionalResponse)(nil), "dydxprotocol.subaccounts.QueryPositionNotionalResponse")
+	proto.RegisterType((*QueryMarketExponentMigrationImpactRequest)(nil), "dydxprotocol.subaccounts.QueryMarketExponentMigrationImpactRequest")
+	proto.RegisterType((*QueryMarketExponentMigrationImpactResponse)(nil), "dydxprotocol.subaccounts.QueryMarketExponentMigrationImpactResponse")
+	proto.RegisterType((*QueryRiskAtHeightRequest)(nil), "dydxprotocol.subaccounts.QueryRiskAtHeightRequest")
+	proto.RegisterType((*QueryRiskAtHeightResponse)(nil), "dydxprotocol.subaccounts.QueryRiskAtHeightResponse")
+	proto.RegisterType((*QueryHealthDistributionRequest)(nil), "dydxprotocol.subaccounts.QueryHealthDistributionRequest")
+	proto.RegisterType((*QueryHealthDistributionResponse)(nil), "dydxprotocol.subaccounts.QueryHealthDistributionResponse")
+	proto.RegisterType((*QueryMarketNcSensitivityRequest)(nil), "dydxprotocol.subaccounts.QueryMarketNcSensitivityRequest")
+	proto.RegisterType((*QueryMarketNcSensitivityResponse)(nil), "dydxprotocol.subaccounts.QueryMarketNcSensitivityResponse")
+	proto.RegisterType((*QueryWithdrawableCheckRequest)(nil), "dydxprotocol.subaccounts.QueryWithdrawableCheckRequest")
+	proto.RegisterType((*QueryWithdrawableCheckResponse)(nil), "dydxprotocol.subaccounts.QueryWithdrawableCheckResponse")
+	proto.RegisterType((*QueryRealizedPnlOnCloseRequest)(nil), "dydxprotocol.subaccounts.QueryRealizedPnlOnCloseRequest")
+	proto.RegisterType((*QueryRealizedPnlOnCloseResponse)(nil), "dydxprotocol.subaccounts.QueryRealizedPnlOnCloseResponse")
+	proto.RegisterType((*QueryProtocolNetDeltaRequest)(nil), "dydxprotocol.subaccounts.QueryProtocolNetDeltaRequest")
+	proto.RegisterType((*QueryProtocolNetDeltaResponse)(nil), "dydxprotocol.subaccounts.QueryProtocolNetDeltaResponse")
+	proto.RegisterType((*EpochFunding)(nil), "dydxprotocol.subaccounts.EpochFunding")
+	proto.RegisterType((*QueryFundingHistoryRequest)(nil), "dydxprotocol.subaccounts.QueryFundingHistoryRequest")
+	proto.RegisterType((*QueryFundingHistoryResponse)(nil), "dydxprotocol.subaccounts.QueryFundingHistoryResponse")
+	proto.RegisterType((*InitialMarginRestoringClose)(nil), "dydxprotocol.subaccounts.InitialMarginRestoringClose")
+	proto.RegisterType((*QueryActionToRestoreInitialMarginRequest)(nil), "dydxprotocol.subaccounts.QueryActionToRestoreInitialMarginRequest")
+	proto.RegisterType((*QueryActionToRestoreInitialMarginResponse)(nil), "dydxprotocol.subaccounts.QueryActionToRestoreInitialMarginResponse")
+	proto.RegisterType((*QueryLossBufferToLiquidationRequest)(nil), "dydxprotocol.subaccounts.QueryLossBufferToLiquidationRequest")
+	proto.RegisterType((*QueryLossBufferToLiquidationResponse)(nil), "dydxprotocol.subaccounts.QueryLossBufferToLiquidationResponse")
+	proto.RegisterType((*QueryMarketUnrealizedPnlRequest)(nil), "dydxprotocol.subaccounts.QueryMarketUnrealizedPnlRequest")
+	proto.RegisterType((*QueryMarketUnrealizedPnlResponse)(nil), "dydxprotocol.subaccounts.QueryMarketUnrealizedPnlResponse")
+	proto.RegisterType((*AutoWithdrawableAccount)(nil), "dydxprotocol.subaccounts.AutoWithdrawableAccount")
+	proto.RegisterType((*QueryAutoWithdrawableAccountsRequest)(nil), "dydxprotocol.subaccounts.QueryAutoWithdrawableAccountsRequest")
+	proto.RegisterType((*QueryAutoWithdrawableAccountsResponse)(nil), "dydxprotocol.subaccounts.QueryAutoWithdrawableAccountsResponse")
+	proto.RegisterType((*QueryCloseAllCostRequest)(nil), "dydxprotocol.subaccounts.QueryCloseAllCostRequest")
+	proto.RegisterType((*QueryCloseAllCostResponse)(nil), "dydxprotocol.subaccounts.QueryCloseAllCostResponse")
+	proto.RegisterType((*QueryLiquidatableAccountsRequest)(nil), "dydxprotocol.subaccounts.QueryLiquidatableAccountsRequest")
+	proto.RegisterType((*QueryLiquidatableAccountsResponse)(nil), "dydxprotocol.subaccounts.QueryLiquidatableAccountsResponse")
+	proto.RegisterType((*QueryBreakEvenPriceRequest)(nil), "dydxprotocol.subaccounts.QueryBreakEvenPriceRequest")
+	proto.RegisterType((*QueryBreakEvenPriceResponse)(nil), "dydxprotocol.subaccounts.QueryBreakEvenPriceResponse")
+	proto.RegisterType((*QueryFlatteningSizeRequest)(nil), "dydxprotocol.subaccounts.QueryFlatteningSizeRequest")
+	proto.RegisterType((*QueryFlatteningSizeResponse)(nil), "dydxprotocol.subaccounts.QueryFlatteningSizeResponse")
+	proto.RegisterType((*LiquidationDepthLevel)(nil), "dydxprotocol.subaccounts.LiquidationDepthLevel")
+	proto.RegisterType((*QueryLiquidationProceedsEstimateRequest)(nil), "dydxprotocol.subaccounts.QueryLiquidationProceedsEstimateRequest")
+	proto.RegisterType((*QueryLiquidationProceedsEstimateResponse)(nil), "dydxprotocol.subaccounts.QueryLiquidationProceedsEstimateResponse")
+	proto.RegisterType((*QueryMarginDeltaForTierChangeRequest)(nil), "dydxprotocol.subaccounts.QueryMarginDeltaForTierChangeRequest")
+	proto.RegisterType((*QueryMarginDeltaForTierChangeResponse)(nil), "dydxprotocol.subaccounts.QueryMarginDeltaForTierChangeResponse")
+	proto.RegisterType((*HistoricalMove)(nil), "dydxprotocol.subaccounts.HistoricalMove")
+	proto.RegisterType((*QueryRiskUnderHistoricalShocksRequest)(nil), "dydxprotocol.subaccounts.QueryRiskUnderHistoricalShocksRequest")
+	proto.RegisterType((*QueryRiskUnderHistoricalShocksResponse)(nil), "dydxprotocol.subaccounts.QueryRiskUnderHistoricalShocksResponse")
+	proto.RegisterType((*QueryMinLiquidatingPriceMoveRequest)(nil), "dydxprotocol.subaccounts.QueryMinLiquidatingPriceMoveRequest")
+	proto.RegisterType((*QueryMinLiquidatingPriceMoveResponse)(nil), "dydxprotocol.subaccounts.QueryMinLiquidatingPriceMoveResponse")
+}
+
+func init() {
+	proto.RegisterFile("dydxprotocol/subaccounts/query.proto", fileDescriptor_adc19ff1d5b72954)
+}
+
+var fileDescriptor_adc19ff1d5b72954 = []byte{
+	// 4158 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x5b, 0x6c, 0x1c, 0xd7,
+	0x75, 0x9e, 0x5d, 0x52, 0x22, 0x0f, 0x1f, 0x92, 0xae, 0x24, 0x72, 0x39, 0xb4, 0x28, 0x79, 0xac,
+	0xa7, 0x6b, 0x73, 0xad, 0xb7, 0x25, 0x5b, 0xb6, 0x48, 0x4a, 0xb4, 0x68, 0xbd, 0xc8, 0xa5, 0x68,
+	0xa3, 0x8e, 0xdb, 0xc9, 0xec, 0xee, 0xe5, 0xee, 0x80, 0xb3, 0x33, 0xab, 0x99, 0x3b, 0x7c, 0xd8,
+	0x65, 0x51, 0xb4, 0x68, 0x82, 0x22, 0x80, 0x91, 0x20, 0x40, 0x91, 0x7e, 0xf5, 0x2b, 0x45, 0xd1,
+	0x7e, 0x14, 0x41, 0xfc, 0xd1, 0xa4, 0xfd, 0x48, 0x51, 0xa0, 0xf5, 0xa7, 0x93, 0xb6, 0x68, 0x5a,
+	0xb4, 0x46, 0x63, 0x27, 0x40, 0x81, 0xfe, 0xb4, 0x1f, 0xed, 0x57, 0x8a, 0x16, 0xf7, 0x31, 0xaf,
+	0xdd, 0x9d, 0x99, 0xdd, 0xd5, 0xd0, 0xf6, 0x87, 0x7f, 0x16, 0x3b, 0x77, 0xee, 0x79, 0xde, 0x73,
+	0xef, 0x3d, 0xaf, 0x81, 0x93, 0xd5, 0x9d, 0xea, 0x76, 0xd3, 0xb6, 0x88, 0x55, 0xb1, 0x8c, 0xa2,
+	0xe3, 0x96, 0xb5, 0x4a, 0xc5, 0x72, 0x4d, 0xe2, 0x14, 0x1f, 0xbb, 0xd8, 0xde, 0x99, 0x65, 0xaf,
+	0x50, 0x21, 0x3c, 0x6b, 0x36, 0x34, 0x4b, 0x9e, 0xaa, 0x58, 0x4e, 0xc3, 0x72, 0x54, 0xf6, 0xb2,
+	0xc8, 0x1f, 0x38, 0x90, 0x7c, 0xa4, 0x66, 0xd5, 0x2c, 0x3e, 0x4e, 0xff, 0x89, 0xd1, 0xa7, 0x6b,
+	0x96, 0x55, 0x33, 0x70, 0x51, 0x6b, 0xea, 0x45, 0xcd, 0x34, 0x2d, 0xa2, 0x11, 0xdd, 0x32, 0x3d,
+	0x98, 0xe7, 0x38, 0x86, 0x62, 0x59, 0x73, 0x30, 0xe7, 0xa0, 0xb8, 0x79, 0xbe, 0x8c, 0x89, 0x76,
+	0xbe, 0xd8, 0xd4, 0x6a, 0xba, 0xc9, 0x26, 0x8b, 0xb9, 0x67, 0x22, 0xac, 0x37, 0xb1, 0xdd, 0xc4,
+	0xc4, 0xd5, 0x0c, 0x27, 0xf8, 0x2b, 0x26, 0x9e, 0x8e, 0x4e, 0xb4, 0xf5, 0x0a, 0x76, 0x8a, 0x0d,
+	0xcd, 0xde, 0xc0, 0x44, 0x65, 0x4f, 0x62, 0xde, 0xb9, 0x58, 0x5d, 0x04, 0xff, 0xf9, 0x54, 0xa5,
+	0x02, 0x53, 0x2b, 0x94, 0xbb, 0xd7, 0x31, 0x59, 0xf5, 0xdf, 0x95, 0xf0, 0x63, 0x17, 0x3b, 0x04,
+	0xcd, 0xc2, 0xa0, 0xb5, 0x65, 0x62, 0xbb, 0x20, 0x9d, 0x90, 0xce, 0x0e, 0xcf, 0x17, 0x7e, 0xf2,
+	0xc1, 0x0b, 0x47, 0x84, 0x66, 0xe6, 0xaa, 0x55, 0x1b, 0x3b, 0xce, 0x2a, 0xb1, 0x75, 0xb3, 0x56,
+	0xe2, 0xd3, 0xd0, 0x04, 0xec, 0x33, 0xdd, 0x46, 0x19, 0xdb, 0x85, 0xdc, 0x09, 0xe9, 0xec, 0x58,
+	0x49, 0x3c, 0x29, 0x18, 0x26, 0x19, 0x91, 0x30, 0x05, 0xa7, 0x69, 0x99, 0x0e, 0x46, 0x6f, 0x00,
+	0x04, 0x3c, 0x31, 0x3a, 0x23, 0x17, 0x4e, 0xce, 0xc6, 0xad, 0xd2, 0x6c, 0x80, 0x61, 0x7e, 0xe0,
+	0xc3, 0x8f, 0x8f, 0x3f, 0x55, 0x0a, 0x41, 0xfb, 0xb2, 0xcc, 0x19, 0x46, 0xbb, 0x2c, 0x8b, 0x00,
+	0x81, 0xe2, 0x05, 0xa1, 0xd3, 0xb3, 0x42, 0x1a, 0xba, 0x4a, 0xb3, 0xdc, 0x4e, 0xc4, 0x2a, 0xcd,
+	0x2e, 0x6b, 0x35, 0x2c, 0x60, 0x4b, 0x21, 0x48, 0xe5, 0x7b, 0x12, 0xc8, 0x2d, 0xc2, 0xcc, 0x19,
+	0x46, 0xac, 0x3c, 0xf9, 0xfe, 0xe5, 0x41, 0xaf, 0x47, 0x58, 0xce, 0x31, 0x96, 0xcf, 0xa4, 0xb2,
+	0xcc, 0x19, 0x89, 0xf0, 0xbc, 0x06, 0x2f, 0x7a, 0x8b, 0xfc, 0x96, 0x4e, 0xea, 0x55, 0x5b, 0xdb,
+	0xd2, 0x8c, 0x39, 0xb3, 0xfa, 0xc8, 0xd6, 0x4c, 0x67, 0x1d, 0xdb, 0xce, 0xbc, 0x61, 0x55, 0x36,
+	0x70, 0x75, 0xc9, 0x5c, 0xb7, 0x3c, 0x7d, 0x3d, 0x03, 0xa3, 0xbe, 0xf9, 0xa9, 0x7a, 0x95, 0x69,
+	0x6c, 0xac, 0x34, 0xe2, 0x8f, 0x2d, 0x55, 0x95, 0x3f, 0xcc, 0xc1, 0xf9, 0x1e, 0xf0, 0x0a, 0x0d,
+	0x3d, 0x84, 0x53, 0x26, 0xae, 0x69, 0x44, 0xdf, 0xc4, 0x2a, 0x31, 0x2b, 0x6a, 0x20, 0xb0, 0xea,
+	0x60, 0x6c, 0xaa, 0x1a, 0x51, 0xcb, 0x14, 0x4c, 0x50, 0x3c, 0xe1, 0x4d, 0x7e, 0x64, 0x56, 0x02,
+	0x6d, 0xad, 0x62, 0x6c, 0xce, 0x11, 0x86, 0x1e, 0x5d, 0x07, 0xb9, 0x52, 0xd7, 0x74, 0x53, 0xb5,
+	0x5c, 0xa2, 0xd5, 0x70, 0x0b, 0x16, 0x6e, 0x89, 0x13, 0x6c, 0xc6, 0x43, 0x36, 0x21, 0x0c, 0xfb,
+	0x6b, 0xf0, 0xfc, 0x96, 0xcf, 0xb9, 0xa3, 0x6a, 0x66, 0x55, 0x25, 0x1e, 0xf3, 0xaa, 0x6b, 0x96,
+	0x39, 0xff, 0x01, 0xb6, 0x3c, 0xc3, 0x76, 0x26, 0x04, 0x13, 0x16, 0x77, 0xcd, 0x03, 0x10, 0xe8,
+	0x95, 0x45, 0x78, 0x86, 0x29, 0x68, 0xc1, 0x32, 0x0c, 0x8d, 0x60, 0x5b, 0x33, 0x96, 0x2d, 0xcb,
+	0x10, 0x7b, 0xa7, 0x07, 0x4d, 0x6f, 0x82, 0x92, 0x84, 0x47, 0x68, 0x76, 0x19, 0x26, 0x2b, 0xfe,
+	0x04, 0xb5, 0x69, 0x59, 0x86, 0xaa, 0xf1, 0x29, 0xa9, 0x1b, 0xf8, 0x68, 0xa5, 0x13, 0x66, 0xe5,
+	0xbb, 0x12, 0x4c, 0xde, 0xd9, 0x69, 0x5a, 0xa4, 0x8e, 0x89, 0x5e, 0xd1, 0x8c, 0x39, 0xc7, 0xc1,
+	0x64, 0xad, 0x59, 0xd5, 0x08, 0x46, 0x53, 0x30, 0xa4, 0xd1, 0xc7, 0x80, 0xe5, 0xfd, 0xec, 0x79,
+	0xa9, 0x8a, 0x2c, 0x18, 0x7f, 0xec, 0x6a, 0x26, 0x71, 0x1b, 0x8e, 0x5a, 0xc5, 0x06, 0xd1, 0xd8,
+	0x2a, 0x8c, 0xce, 0xdf, 0xa1, 0x26, 0xfe, 0xcf, 0x1f, 0x1f, 0xbf, 0x59, 0xd3, 0x49, 0xdd, 0x2d,
+	0xcf, 0x56, 0xac, 0x46, 0x31, 0x72, 0x54, 0x6d, 0x5e, 0x7a, 0x81, 0x2d, 0x54, 0xd1, 0x1f, 0xa9,
+	0x92, 0x9d, 0x26, 0x76, 0x66, 0x57, 0xb1, 0xad, 0x6b, 0x86, 0xfe, 0xae, 0x56, 0x36, 0xf0, 0x92,
+	0x49, 0x4a, 0x63, 0x1e, 0xfe, 0x5b, 0x14, 0xbd, 0xf2, 0xa7, 0x39, 0x98, 0x0e, 0xf3, 0xb9, 0xec,
+	0xe9, 0x4e, 0xf0, 0x9a, 0xae, 0xe2, 0xcf, 0x9c, 0x67, 0xb4, 0x0d, 0x87, 0x1f, 0xbb, 0x16, 0xc1,
+	0x6a, 0x59, 0x33, 0x34, 0xb3, 0x82, 0x05, 0xd5, 0x7c, 0xc6, 0x54, 0x0f, 0x31, 0x22, 0xf3, 0x9c,
+	0x06, 0xd7, 0xd6, 0x5f, 0xe6, 0xe0, 0xb4, 0x30, 0xa7, 0x46, 0xd3, 0x25, 0xb8, 0xa4, 0x3b, 0x1b,
+	0x8b, 0x96, 0x1d, 0x56, 0xa0, 0x67, 0x9b, 0x19, 0x1e, 0xcf, 0xe8, 0x1d, 0x18, 0xe3, 0x06, 0xe3,
+	0xb2, 0x45, 0x71, 0x0a, 0x39, 0x76, 0x3a, 0x9e, 0x8f, 0x47, 0x17, 0x63, 0x7a, 0x02, 0xf7, 0xa8,
+	0x16, 0x0c, 0x39, 0xa8, 0x0e, 0x87, 0x82, 0x25, 0xf6, 0x28, 0xe4, 0x19, 0x85, 0xcb, 0xdd, 0x51,
+	0x68, 0x31, 0x1a, 0x41, 0xe5, 0x60, 0x33, 0x3a, 0xec, 0x28, 0x1f, 0xe4, 0xe1, 0x4c, 0xaa, 0xfa,
+	0xc4, 0x96, 0xb4, 0x60, 0xdc, 0xc4, 0x44, 0x0d, 0x76, 0x57, 0x41, 0xca, 0x78, 0x7d, 0xc7, 0x4c,
+	0x4c, 0x82, 0x63, 0x01, 0x7d, 0x4d, 0x02, 0x59, 0x37, 0x75, 0xa2, 0x6b, 0x86, 0xda, 0xd0, 0xec,
+	0x9a, 0x6e, 0xaa, 0x36, 0x7e, 0xec, 0xea, 0x36, 0x6e, 0x60, 0x93, 0x64, 0x6e, 0xd3, 0x05, 0x41,
+	0xeb, 0x3e, 0x23, 0x55, 0x0a, 0x28, 0xa1, 0xf7, 0x25, 0x98, 0x69, 0x68, 0xba, 0x49, 0xb0, 0xc9,
+	0xac, 0xbb, 0x03, 0x33, 0x59, 0x9b, 0xfa, 0xd3, 0x21, 0x7a, 0x6d, 0x0c, 0x29, 0x5f, 0x85, 0x09,
+	0xb6, 0x6a, 0x74, 0xb9, 0x96, 0xcc, 0xa6, 0x4b, 0x9c, 0xac, 0xdd, 0x9c, 0xff, 0x95, 0xe0, 0xb0,
+	0x6f, 0x44, 0x01, 0x19, 0xb4, 0x08, 0xc3, 0xbe, 0x11, 0x89, 0x3d, 0xa4, 0x44, 0x4d, 0xd2, 0x7f,
+	0xed, 0xcc, 0xfa, 0x08, 0x84, 0xfd, 0x05, 0xa0, 0x68, 0x09, 0x46, 0xc3, 0xce, 0x9e, 0xf0, 0x08,
+	0x4e, 0xb4, 0xa0, 0xa2, 0xaf, 0x9c, 0xd9, 0xfb, 0x6c, 0xe2, 0x32, 0x7d, 0x10, 0x88, 0x46, 0x1a,
+	0xc1, 0x10, 0x5a, 0x85, 0x71, 0x43, 0x7f, 0xec, 0xea, 0x55, 0x9d, 0xec, 0xa8, 0x44, 0xc7, 0x76,
+	0x21, 0x2f, 0x3c, 0xa2, 0x38, 0xbe, 0xee, 0x79, 0xd3, 0x1f, 0xe9, 0xd8, 0x16, 0x28, 0xc7, 0x8c,
+	0xf0, 0xa0, 0xf2, 0x5f, 0x39, 0xe1, 0xe7, 0x85, 0x55, 0x2c, 0x36, 0xc2, 0xaf, 0x02, 0x72, 0x30,
+	0x21, 0x06, 0xae, 0xaa, 0x4f, 0x74, 0xa0, 0x1c, 0x12, 0x58, 0x82, 0x17, 0x68, 0x15, 0x20, 0xe0,
+	0x53, 0x1c, 0x2a, 0x2f, 0xc4, 0xa3, 0xec, 0xb0, 0x42, 0xde, 0x61, 0x15, 0xa0, 0x41, 0x3b, 0x70,
+	0x18, 0x6f, 0x13, 0x6c, 0x9b, 0x9a, 0x11, 0xde, 0xbd, 0x59, 0x9b, 0x2c, 0xf2, 0x88, 0x84, 0xb6,
+	0xf0, 0xaf, 0xc0, 0x21, 0xe1, 0x76, 0x84, 0x08, 0x0f, 0x9c, 0x90, 0xce, 0x0e, 0x94, 0x0e, 0xf2,
+	0x17, 0xc1, 0x64, 0x65, 0x43, 0x78, 0x18, 0x81, 0x3e, 0xd6, 0x88, 0x4e, 0x09, 0x10, 0xdd, 0x32,
+	0xb3, 0x36, 0x70, 0x1b, 0x94, 0x24, 0x62, 0x62, 0xa9, 0xcf, 0xc0, 0x01, 0x37, 0x18, 0x56, 0x9b,
+	0xcd, 0x06, 0xa3, 0x3b, 0x50, 0x1a, 0x0f, 0x0d, 0x2f, 0x37, 0x1b, 0xe8, 0x59, 0x18, 0xb3, 0x36,
+	0xb1, 0xad, 0xf2, 0x61, 0x5c, 0x65, 0xd4, 0x86, 0x4a, 0xa3, 0x74, 0x70, 0x4d, 0x8c, 0x29, 0x33,
+	0xf0, 0x34, 0xa3, 0xf9, 0xc8, 0x22, 0x9a, 0xf1, 0xa6, 0x66, 0xb8, 0xf8, 0x1e, 0xd3, 0x81, 0x90,
+	0x4d, 0xf9, 0x6e, 0x0e, 0x8e, 0xc5, 0x4c, 0x10, 0xfc, 0x6c, 0x02, 0x22, 0xf4, 0x9d, 0xba, 0x49,
+	0x5f, 0xaa, 0x5c, 0x85, 0x99, 0x9f, 0xc3, 0x07, 0x49, 0x0b, 0x7d, 0xf4, 0x0d, 0x09, 0x8e, 0x71,
+	0xc2, 0xbe, 0xbf, 0xdb, 0x72, 0x17, 0x64, 0x7d, 0x1a, 0xcb, 0x8c, 0xdc, 0x03, 0x41, 0xed, 0x41,
+	0xf8, 0x62, 0x50, 0x7e, 0x29, 0xc1, 0xd4, 0xb2, 0xe5, 0xe8, 0x54, 0xf9, 0x7c, 0x2f, 0xf3, 0x75,
+	0x60, 0xc7, 0x45, 0x37, 0x0e, 0x12, 0x35, 0xcb, 0x00, 0x2e, 0x74, 0x04, 0x51, 0xb3, 0x6c, 0x41,
+	0x88, 0x2e, 0xc0, 0xd1, 0xba, 0xe6, 0xa8, 0xed, 0x00, 0x79, 0xb6, 0xc4, 0x87, 0xeb, 0x9a, 0xd3,
+	0xca, 0x04, 0x3a, 0x07, 0x07, 0xcb, 0x9a, 0xb9, 0x61, 0xbb, 0x4d, 0x52, 0xd9, 0x11, 0xd3, 0xb9,
+	0xd9, 0x1f, 0x08, 0xc6, 0xf9, 0xd4, 0x17, 0xe1, 0x08, 0x45, 0xdf, 0x36, 0x7d, 0x90, 0x61, 0x47,
+	0x75, 0xcd, 0x99, 0x8f, 0x42, 0x28, 0x8f, 0xe1, 0x4c, 0x8b, 0xe9, 0xb6, 0x29, 0x21, 0xeb, 0xdd,
+	0xb2, 0x0b, 0x67, 0xd3, 0x49, 0x0a, 0x1b, 0x5d, 0x81, 0x7d, 0xfc, 0xe0, 0x16, 0x21, 0xe3, 0xc5,
+	0x84, 0xf3, 0x2b, 0x6e, 0x11, 0xc5, 0x29, 0x26, 0x10, 0x29, 0xcb, 0x30, 0x3a, 0xaf, 0x39, 0x1b,
+	0x98, 0xbc, 0x85, 0xf5, 0x5a, 0xbd, 0x9b, 0x30, 0x03, 0x1d, 0x03, 0xd8, 0x62, 0x93, 0xd9, 0xa6,
+	0xa5, 0xd2, 0x0c, 0x96, 0x86, 0xf9, 0xc8, 0x72, 0xb3, 0xa1, 0x7c, 0x20, 0x89, 0xfd, 0xcf, 0xf1,
+	0xae, 0xd6, 0xad, 0xca, 0xc6, 0x23, 0xcb, 0xe3, 0x03, 0x67, 0xac, 0x3f, 0xb4, 0x08, 0xfb, 0x39,
+	0x6d, 0xcf, 0x8f, 0x3b, 0x1d, 0xaf, 0x94, 0xb0, 0xa4, 0x42, 0x0f, 0x1e, 0xb0, 0xf2, 0x69, 0x1e,
+	0x9e, 0x4d, 0x64, 0x5b, 0xac, 0xc1, 0x11, 0x18, 0x5c, 0xb7, 0x5c, 0x93, 0x6b, 0x66, 0xa8, 0xc4,
+	0x1f, 0xd0, 0x34, 0x0c, 0x3b, 0x14, 0xc2, 0x57, 0x49, 0xbe, 0x34, 0xc4, 0x06, 0xe8, 0x09, 0xd6,
+	0xee, 0xde, 0xe5, 0x3f, 0x57, 0xf7, 0x6e, 0xe0, 0x8b, 0xe4, 0xde, 0x0d, 0x7e, 0xa6, 0xee, 0xdd,
+	0x9f, 0x4b, 0x20, 0xfb, 0x57, 0xfb, 0xfd, 0xd6, 0x99, 0xdd, 0x58, 0xff, 0x16, 0xa0, 0x76, 0x89,
+	0x32, 0x3f, 0xa3, 0x0f, 0xb5, 0x49, 0xa1, 0xbc, 0x0e, 0x4a, 0x70, 0x83, 0xdd, 0xef, 0x24, 0xa4,
+	0x48, 0x13, 0x94, 0x77, 0xd4, 0xa8, 0x23, 0x39, 0x54, 0x1a, 0x29, 0xef, 0xf8, 0x52, 0x2b, 0x3f,
+	0x93, 0xe0, 0xd9, 0x44, 0x4c, 0xc2, 0xd2, 0x7f, 0x1d, 0x06, 0xd9, 0x4d, 0x91, 0xf9, 0x25, 0xc8,
+	0xd1, 0xa2, 0xb7, 0x3b, 0x78, 0x64, 0x97, 0xba, 0xf0, 0xc8, 0xda, 0x38, 0x6e, 0x77, 0xcc, 0x94,
+	0xdf, 0x93, 0x84, 0x43, 0xe0, 0x9d, 0x83, 0x0f, 0x2c, 0xfa, 0xab, 0x19, 0x59, 0x1f, 0x3f, 0xad,
+	0x16, 0x93, 0x6f, 0x4f, 0xcb, 0xfc, 0xae, 0x04, 0xc7, 0x62, 0x78, 0x11, 0x9a, 0xae, 0xc2, 0x90,
+	0x29, 0xc6, 0x32, 0x57, 0xb6, 0x8f, 0x59, 0x71, 0xe1, 0x1c, 0x63, 0x83, 0x3b, 0xfd, 0xb7, 0xb7,
+	0x9b, 0x96, 0x89, 0x4d, 0x72, 0x5f, 0xaf, 0xd9, 0xec, 0x7a, 0x58, 0x6a, 0x34, 0xb5, 0x8a, 0x9f,
+	0x08, 0x9d, 0x86, 0x61, 0x11, 0x45, 0xf8, 0xdb, 0x60, 0x88, 0x0f, 0xf0, 0x4b, 0xbe, 0x69, 0x5b,
+	0x4d, 0xcb, 0xc1, 0x55, 0x15, 0x0b, 0x3c, 0xe2, 0x22, 0x38, 0xe8, 0xbd, 0xf0, 0xf0, 0x2b, 0xff,
+	0x9d, 0x83, 0xe7, 0xba, 0xa1, 0x2b, 0x74, 0xe1, 0xc0, 0xc1, 0x8a, 0x6b, 0xdb, 0xd8, 0x24, 0xea,
+	0x9e, 0xe9, 0xe4, 0x80, 0xa0, 0xe0, 0x2d, 0x04, 0x72, 0x43, 0x02, 0xf9, 0x54, 0xb3, 0xde, 0xd3,
+	0xbe, 0x6a, 0x7c, 0xb2, 0x5f, 0x01, 0x64, 0xe2, 0x2d, 0x63, 0xc7, 0xf7, 0x80, 0xe8, 0xd4, 0xf4,
+	0x6b, 0x2c, 0x70, 0x15, 0x96, 0xaa, 0x5e, 0xc0, 0xc3, 0xf0, 0xdc, 0x0b, 0xa1, 0x51, 0xde, 0x85,
+	0x82, 0x1f, 0x66, 0xcd, 0x91, 0x3b, 0xec, 0x9a, 0xcb, 0xda, 0xfa, 0x27, 0x60, 0x5f, 0x9d, 0x21,
+	0x66, 0x76, 0x9f, 0x2f, 0x89, 0x27, 0xe5, 0x8f, 0xf2, 0x30, 0xd5, 0x81, 0xf8, 0x97, 0xe9, 0x8e,
+	0x2f, 0x5a, 0xba, 0xe3, 0x3a, 0xcc, 0xb0, 0x75, 0xba, 0x83, 0x35, 0x83, 0xd4, 0x6f, 0xe9, 0x0e,
+	0xb1, 0xf5, 0xb2, 0x1b, 0x8e, 0x0a, 0x0b, 0xb0, 0xbf, 0xec, 0x56, 0x36, 0x30, 0xe1, 0x4e, 0xe7,
+	0x58, 0xc9, 0x7b, 0x54, 0xae, 0xc1, 0xf1, 0x58, 0x58, 0xb1, 0xd2, 0x13, 0xb0, 0x8f, 0xdb, 0x2c,
+	0x83, 0x1d, 0x28, 0x89, 0x27, 0xe5, 0x16, 0x1c, 0x0f, 0x1d, 0x09, 0x0f, 0x2a, 0xab, 0xd8, 0xa4,
+	0x47, 0xe3, 0xa6, 0x4e, 0x76, 0x7a, 0xc8, 0x77, 0xff, 0x50, 0x82, 0x13, 0xf1, 0x68, 0x04, 0x0b,
+	0x1b, 0x30, 0x4a, 0x8d, 0xcd, 0xcb, 0xaa, 0x66, 0x6e, 0x6a, 0x23, 0x26, 0x26, 0x2b, 0x02, 0x39,
+	0x3a, 0x07, 0x87, 0xcc, 0x0a, 0xbd, 0x7d, 0x79, 0xa4, 0xa1, 0xba, 0xa6, 0xce, 0xcd, 0x6b, 0xb8,
+	0x34, 0x6e, 0x56, 0x96, 0xb1, 0xcd, 0x7c, 0xf0, 0x35, 0x53, 0x27, 0xca, 0xfb, 0xde, 0xad, 0xe0,
+	0xd7, 0x44, 0xca, 0x06, 0x5e, 0xa8, 0xe3, 0xca, 0x46, 0xd6, 0x9b, 0xf4, 0x14, 0x8c, 0xf3, 0x14,
+	0xb2, 0xaf, 0x83, 0x3c, 0x8b, 0x97, 0xc6, 0xd8, 0xa8, 0xc7, 0xbb, 0xf2, 0x67, 0x12, 0xcc, 0xc4,
+	0x31, 0x24, 0x74, 0x59, 0x80, 0xfd, 0x9a, 0x61, 0x58, 0x5b, 0xd8, 0xf3, 0x7e, 0xbd, 0x47, 0x7a,
+	0x6a, 0x37, 0xb4, 0x6d, 0x75, 0x2b, 0x04, 0x9a, 0xf9, 0xb6, 0x3a, 0xd0, 0xd0, 0xb6, 0xc3, 0xbc,
+	0x29, 0xdf, 0xf0, 0x38, 0x2e, 0x61, 0x8d, 0xa5, 0x01, 0x96, 0x4d, 0xe3, 0xa1, 0xb9, 0x60, 0x58,
+	0x0e, 0xfe, 0x1c, 0xae, 0xf9, 0x5d, 0x38, 0x1e, 0xcb, 0x8c, 0xd0, 0xdf, 0xdb, 0x90, 0x6f, 0x9a,
+	0xd9, 0x9f, 0x76, 0x14, 0xa9, 0x32, 0xe7, 0x39, 0x3c, 0x62, 0xf2, 0x03, 0x4c, 0x58, 0x1e, 0xbf,
+	0x87, 0xfd, 0xf4, 0x35, 0xdf, 0x51, 0x69, 0xc3, 0x21, 0x04, 0xc0, 0x30, 0x4c, 0x37, 0x13, 0xaf,
+	0x41, 0x64, 0xef, 0xa9, 0x08, 0x72, 0xca, 0xb7, 0x24, 0x18, 0xbd, 0xdd, 0xb4, 0x2a, 0xf5, 0x45,
+	0xd7, 0xac, 0xea, 0x66, 0x8d, 0x06, 0x5d, 0x98, 0x3e, 0x0b, 0xae, 0xf9, 0x03, 0xdd, 0xda, 0xeb,
+	0x7c, 0x82, 0xda, 0xd4, 0xf4, 0x6a, 0xe6, 0x06, 0x37, 0x22, 0xb0, 0x2f, 0x6b, 0x7a, 0x55, 0xf9,
+	0x2b, 0xaf, 0xa2, 0x2b, 0x78, 0xba, 0xa3, 0x3b, 0xc4, 0xb2, 0x77, 0x3e, 0x7b, 0x43, 0xa3, 0xf1,
+	0xf7, 0xba, 0x6d, 0x35, 0x54, 0xae, 0x91, 0x01, 0x36, 0x61, 0x98, 0x8e, 0x30, 0x95, 0xd1, 0x8a,
+	0x1b, 0xb1, 0xc4, 0xcb, 0x41, 0x5e, 0x71, 0x23, 0x16, 0x7b, 0xa5, 0x60, 0x98, 0xee, 0x28, 0x82,
+	0x58, 0xdd, 0x45, 0xd8, 0x8f, 0x4d, 0x62, 0xeb, 0x7e, 0x7e, 0x21, 0xc1, 0x07, 0x09, 0x2f, 0x8f,
+	0x17, 0x4a, 0x0b, 0x60, 0xe5, 0xdb, 0x39, 0x98, 0x5e, 0x8a, 0x5e, 0x81, 0x94, 0x90, 0x6e, 0xd6,
+	0xd8, 0x76, 0xe8, 0x26, 0xca, 0xaa, 0xc2, 0x90, 0x7f, 0x5a, 0x65, 0xbd, 0xac, 0x3e, 0x66, 0x6a,
+	0x40, 0x5a, 0xd9, 0x09, 0x3c, 0xbe, 0xac, 0xef, 0xde, 0x11, 0xad, 0xec, 0x78, 0xce, 0x9e, 0x62,
+	0x8b, 0x44, 0xcf, 0x5c, 0x85, 0x0e, 0x3c, 0xb2, 0xb8, 0x52, 0xf0, 0x52, 0xab, 0xaf, 0x90, 0x65,
+	0x72, 0xe9, 0xfd, 0x1c, 0x9c, 0xeb, 0x82, 0xa8, 0x58, 0xff, 0x6b, 0xe0, 0x79, 0x2e, 0xc6, 0x4e,
+	0xc8, 0x3b, 0x63, 0x49, 0x57, 0x7e, 0xde, 0x4f, 0xfa, 0xef, 0x17, 0x22, 0xaf, 0xd1, 0x2a, 0xec,
+	0xab, 0xd0, 0xb5, 0xf5, 0xe2, 0xb8, 0x84, 0x62, 0x5a, 0x82, 0x65, 0x78, 0xb9, 0x29, 0x8e, 0x0a,
+	0xad, 0xc0, 0x50, 0xa5, 0x8e, 0xb5, 0x26, 0x76, 0x88, 0x28, 0x3c, 0xf4, 0x87, 0xb6, 0xe4, 0xa3,
+	0x51, 0xbe, 0xe9, 0xc5, 0xbe, 0xf7, 0x2c, 0xc7, 0x99, 0x77, 0xd7, 0xd7, 0xb1, 0x1d, 0x24, 0x79,
+	0xb2, 0xcf, 0x85, 0x77, 0x73, 0x6f, 0xfc, 0x8d, 0x04, 0x27, 0x93, 0x59, 0x4a, 0xcc, 0x3c, 0xe9,
+	0x30, 0x62, 0x58, 0x8e, 0xa3, 0x96, 0x19, 0x64, 0xe6, 0x9b, 0x05, 0x0c, 0x9f, 0x2b, 0x7a, 0xf0,
+	0x70, 0xb7, 0xa6, 0x61, 0x6d, 0x62, 0xe1, 0x44, 0x0c, 0xb3, 0x91, 0xfb, 0xd6, 0x26, 0x6e, 0x71,
+	0xea, 0xd6, 0x4c, 0x3b, 0xb8, 0x08, 0x7b, 0xb8, 0x84, 0x7e, 0x13, 0x4e, 0xc4, 0x63, 0xf9, 0x0c,
+	0xee, 0xd1, 0x7f, 0x95, 0x60, 0x72, 0xce, 0x25, 0x56, 0xd8, 0xd3, 0x98, 0x13, 0x35, 0xa4, 0x15,
+	0x18, 0x0b, 0xf5, 0xa1, 0x08, 0xfe, 0x7b, 0x0d, 0xd5, 0x46, 0x9d, 0xd0, 0x18, 0xb2, 0xda, 0x9c,
+	0xb3, 0x3d, 0x68, 0x28, 0x08, 0xbb, 0x79, 0x5f, 0x15, 0xd6, 0x16, 0x23, 0xa3, 0x9f, 0xdf, 0x7e,
+	0x09, 0x0a, 0xa4, 0x6e, 0x63, 0xa7, 0x6e, 0x19, 0x55, 0xb5, 0x85, 0x45, 0x5e, 0xa8, 0x99, 0xf0,
+	0xdf, 0xaf, 0x44, 0x28, 0xfc, 0x06, 0x9c, 0x4a, 0xa1, 0x20, 0x96, 0x71, 0x15, 0x86, 0x3c, 0x45,
+	0x15, 0xa4, 0xb4, 0x2a, 0x7f, 0x0c, 0x36, 0xa1, 0x54, 0x1f, 0x91, 0x52, 0x16, 0x61, 0x2f, 0xdb,
+	0xf9, 0x73, 0x86, 0xb1, 0x60, 0x39, 0x99, 0x77, 0xaa, 0xfd, 0x9f, 0x04, 0x53, 0x1d, 0x88, 0x08,
+	0xb1, 0xde, 0x81, 0x81, 0x75, 0x8c, 0xb3, 0x8f, 0x34, 0x18, 0x56, 0xf4, 0x3b, 0x12, 0x4c, 0x35,
+	0x2d, 0x87, 0xa8, 0xec, 0x90, 0xdc, 0xeb, 0x5a, 0xd1, 0x04, 0x25, 0xc5, 0xa4, 0x8c, 0xd6, 0x89,
+	0x14, 0xb1, 0x4b, 0xc3, 0x19, 0x87, 0x16, 0x0b, 0x52, 0xb6, 0xe1, 0x99, 0x84, 0x39, 0xbe, 0x0d,
+	0x8c, 0x47, 0xb6, 0x54, 0x17, 0xae, 0x47, 0x87, 0x3d, 0x35, 0x16, 0xde, 0x53, 0x8e, 0xf2, 0x75,
+	0xcf, 0x57, 0x9b, 0xb7, 0xb1, 0xb6, 0x71, 0x7b, 0x13, 0xf3, 0xda, 0xc7, 0xe7, 0x70, 0xb8, 0x5f,
+	0x84, 0xe9, 0x8e, 0x8c, 0x04, 0x47, 0x3a, 0x2f, 0x49, 0x31, 0x4e, 0x4a, 0xfc, 0x41, 0xb1, 0x3c,
+	0x4f, 0xd3, 0xd0, 0x08, 0xc1, 0xa6, 0x6e, 0xd6, 0x56, 0xf5, 0x77, 0xfb, 0xe6, 0xbe, 0x95, 0xcb,
+	0x5c, 0x3b, 0x97, 0xff, 0x29, 0xc1, 0x74, 0x47, 0x8a, 0x41, 0x7e, 0x72, 0xcf, 0xe2, 0xe7, 0x78,
+	0x6f, 0x2c, 0xb7, 0x97, 0xde, 0xd8, 0x12, 0x1c, 0x0d, 0xdd, 0xb1, 0xb7, 0x70, 0x93, 0xd4, 0xef,
+	0xe1, 0x4d, 0x6c, 0x44, 0x97, 0x64, 0x40, 0x2c, 0x09, 0x92, 0x5b, 0xfc, 0xd1, 0x81, 0x80, 0x6f,
+	0xe5, 0x3b, 0x39, 0x51, 0x35, 0x8c, 0xd4, 0xda, 0xac, 0x0a, 0xc6, 0x55, 0xe7, 0xb6, 0x43, 0xf4,
+	0xc6, 0x1e, 0x54, 0xbd, 0xba, 0x08, 0x13, 0xee, 0xc2, 0x60, 0x95, 0x8a, 0x55, 0x18, 0x60, 0x1b,
+	0xaa, 0x18, 0xbf, 0xa1, 0x3a, 0x2a, 0x42, 0xec, 0x2c, 0x8e, 0x03, 0x5d, 0x86, 0x49, 0x1a, 0xdf,
+	0x87, 0x2b, 0xb5, 0xeb, 0x18, 0xb3, 0x6a, 0x17, 0x8f, 0x31, 0x8e, 0x34, 0xb4, 0xed, 0x10, 0x9e,
+	0x45, 0x8c, 0x69, 0x2d, 0xf0, 0x07, 0x79, 0xe1, 0xf4, 0x26, 0xaa, 0x46, 0x58, 0xd9, 0x63, 0x38,
+	0xb0, 0xae, 0x1b, 0xb4, 0xf7, 0x63, 0xcf, 0x8c, 0x6d, 0x9c, 0x13, 0xf0, 0xf3, 0x35, 0xbf, 0x25,
+	0xc1, 0x64, 0x53, 0xf0, 0xa3, 0xee, 0xf1, 0x3d, 0x7c, 0xd4, 0x23, 0x14, 0xb9, 0x2d, 0xd1, 0xef,
+	0x4b, 0xf0, 0xac, 0x6e, 0x3a, 0xae, 0xcd, 0x12, 0x82, 0x34, 0xe2, 0xe4, 0xe1, 0xb5, 0xda, 0x21,
+	0x67, 0x93, 0x25, 0x3b, 0xc7, 0x7d, 0xa2, 0x34, 0x8a, 0x63, 0x71, 0x77, 0xf4, 0x1a, 0xff, 0xb9,
+	0xe7, 0x97, 0x72, 0x97, 0x9a, 0xcd, 0x58, 0xb4, 0x6c, 0xda, 0xc3, 0xb3, 0x50, 0xd7, 0xcc, 0xda,
+	0xe7, 0x61, 0xd3, 0x2b, 0x30, 0xe6, 0xe7, 0xe9, 0x59, 0x3f, 0xd2, 0x40, 0x1f, 0xfd, 0x48, 0xa3,
+	0x1e, 0x0a, 0x3a, 0xa6, 0xfc, 0x63, 0x0e, 0x4e, 0xa5, 0x88, 0x29, 0xec, 0xf3, 0x5b, 0x12, 0x9c,
+	0x88, 0xcf, 0x22, 0xef, 0x51, 0x52, 0xe4, 0x58, 0x5c, 0x2e, 0x99, 0x31, 0x8a, 0xfe, 0x40, 0x82,
+	0x93, 0xc9, 0x09, 0xe5, 0x3d, 0x6a, 0x53, 0x7d, 0x26, 0x29, 0xad, 0xcc, 0xb3, 0x38, 0x0f, 0x60,
+	0x9c, 0x67, 0x18, 0xf4, 0x8a, 0x66, 0xd0, 0x08, 0xa1, 0x9b, 0xc0, 0x7f, 0x0a, 0x86, 0x68, 0x74,
+	0xe1, 0xd7, 0xd1, 0xc7, 0x4a, 0xfb, 0xe9, 0x33, 0x3d, 0x4c, 0x7e, 0x24, 0xc1, 0x29, 0xbf, 0xa8,
+	0xb0, 0x66, 0x56, 0xb1, 0x1d, 0xa0, 0x67, 0xe5, 0xfa, 0xac, 0x7b, 0x33, 0xd0, 0x43, 0x18, 0xd9,
+	0xb2, 0x6c, 0x87, 0xb0, 0x80, 0xc7, 0xeb, 0x2f, 0x38, 0x9b, 0xd0, 0x27, 0x1a, 0x11, 0xd7, 0x2b,
+	0x4b, 0x32, 0x14, 0x74, 0xc0, 0x51, 0xbe, 0x9f, 0x87, 0xd3, 0x69, 0x22, 0x7c, 0x59, 0x24, 0xf9,
+	0xa2, 0x15, 0x49, 0xee, 0x88, 0x9c, 0xc1, 0x7d, 0x3d, 0x68, 0xa7, 0x31, 0x6b, 0xcb, 0x5e, 0xe0,
+	0xdb, 0x43, 0x70, 0xfb, 0x27, 0xfe, 0x99, 0x1a, 0x87, 0x2a, 0x31, 0xd6, 0x6f, 0xdd, 0x1c, 0x03,
+	0xfe, 0xe6, 0x68, 0x0f, 0x4d, 0xf3, 0x4f, 0x1a, 0x9a, 0x5e, 0xf8, 0x8b, 0x2b, 0x30, 0xc8, 0x98,
+	0x45, 0xdf, 0x97, 0x00, 0x82, 0xe9, 0x28, 0xa1, 0xed, 0x28, 0xf6, 0x2b, 0x21, 0xf9, 0x7c, 0x0a,
+	0x50, 0xfb, 0x57, 0x3f, 0xca, 0x8d, 0xdf, 0xfe, 0xbb, 0x9f, 0x7f, 0x3b, 0x77, 0x15, 0x5d, 0x2e,
+	0x76, 0xf1, 0xa5, 0x52, 0xf1, 0x3d, 0xb6, 0x97, 0x77, 0x8b, 0xef, 0xf1, 0xcd, 0xbb, 0x8b, 0xfe,
+	0x58, 0x82, 0xb1, 0xc8, 0xe7, 0x37, 0xa9, 0x8c, 0x77, 0xfa, 0x24, 0x48, 0xbe, 0xd4, 0x35, 0xe3,
+	0xa1, 0x2f, 0x7c, 0x94, 0xe7, 0x19, 0xef, 0xa7, 0xd1, 0xc9, 0x6e, 0x78, 0x47, 0xdf, 0xc9, 0xc1,
+	0xc9, 0x6e, 0x3e, 0x8f, 0x41, 0x6f, 0xa4, 0xab, 0xbe, 0xdb, 0x6f, 0x77, 0xe4, 0xbb, 0x99, 0xe0,
+	0x12, 0xf2, 0xbe, 0xc5, 0xe4, 0x5d, 0x41, 0x0f, 0xe3, 0xe5, 0x8d, 0xff, 0x84, 0xc6, 0xfb, 0x80,
+	0x46, 0x37, 0xd7, 0xad, 0xe2, 0x7b, 0xe1, 0x4d, 0xb4, 0x8b, 0xfe, 0x45, 0x82, 0xa3, 0x1d, 0x3f,
+	0x68, 0x41, 0x2f, 0xa7, 0xf0, 0x9f, 0xf4, 0x39, 0x8d, 0xfc, 0x4a, 0x7f, 0xc0, 0x42, 0xda, 0x3b,
+	0x4c, 0xda, 0x79, 0x74, 0x33, 0x5e, 0xda, 0x98, 0x6f, 0x6c, 0x5a, 0xc5, 0xfb, 0x85, 0x04, 0x72,
+	0xfc, 0x17, 0x02, 0xe8, 0x66, 0x2a, 0x9b, 0x29, 0xdf, 0x66, 0xc8, 0x73, 0x4f, 0x80, 0x41, 0x48,
+	0x3b, 0xcf, 0xa4, 0x7d, 0x45, 0xb9, 0x9a, 0x24, 0x2d, 0xc3, 0xa2, 0xda, 0xba, 0xb3, 0xa1, 0xae,
+	0x5b, 0xb6, 0x5a, 0x0f, 0x21, 0xba, 0x2e, 0x3d, 0x87, 0xbe, 0x27, 0x01, 0x84, 0x9a, 0xdd, 0x5f,
+	0x4c, 0xe1, 0xaa, 0xad, 0xfd, 0x5e, 0x3e, 0xdf, 0x03, 0x84, 0xe0, 0xfb, 0x55, 0xc6, 0xf7, 0x4b,
+	0xe8, 0x4a, 0x3c, 0xdf, 0x8c, 0x5f, 0x9d, 0x81, 0xb5, 0x1f, 0x20, 0x3f, 0x91, 0xe0, 0x68, 0xc7,
+	0x26, 0xe6, 0x54, 0xd3, 0x4b, 0xea, 0xb3, 0x96, 0x5f, 0xe9, 0x0f, 0xb8, 0x7b, 0xa1, 0x42, 0x0d,
+	0xd4, 0xed, 0x42, 0xfd, 0x40, 0x82, 0x83, 0xad, 0x4d, 0xd0, 0xe8, 0x4a, 0x0a, 0x4b, 0x31, 0x6d,
+	0xd5, 0xf2, 0xd5, 0x9e, 0xe1, 0x84, 0x14, 0x97, 0x98, 0x14, 0xb3, 0xe8, 0xf9, 0x78, 0x29, 0xda,
+	0xbb, 0xb1, 0xd1, 0x7f, 0x48, 0x30, 0x9d, 0xd0, 0x27, 0x8b, 0xe6, 0xba, 0xd6, 0x6c, 0x5c, 0x5b,
+	0xaf, 0x3c, 0xff, 0x24, 0x28, 0x84, 0x70, 0xb7, 0x99, 0x70, 0xaf, 0xa1, 0x1b, 0xf1, 0xc2, 0xb5,
+	0xb5, 0x3c, 0x77, 0x30, 0xbf, 0x7f, 0x90, 0x60, 0xa2, 0x73, 0x33, 0x2a, 0x4a, 0x33, 0xa1, 0xc4,
+	0xd6, 0x5b, 0xf9, 0x46, 0x9f, 0xd0, 0x51, 0x0b, 0x54, 0x2e, 0xc6, 0x8b, 0x57, 0x66, 0x18, 0x54,
+	0xde, 0x12, 0x4b, 0x2c, 0x3f, 0x6f, 0x80, 0xe9, 0x51, 0xf0, 0x63, 0x09, 0x26, 0x3a, 0xb7, 0x1e,
+	0xa6, 0xca, 0x95, 0xd8, 0xfb, 0x28, 0xdf, 0xe8, 0x13, 0x5a, 0xc8, 0x75, 0x9d, 0xc9, 0x75, 0x09,
+	0x5d, 0x48, 0xb3, 0xc9, 0x76, 0xe7, 0x14, 0xfd, 0x54, 0x82, 0x83, 0xad, 0xed, 0x7d, 0xa9, 0xbb,
+	0x2a, 0xa6, 0x37, 0x51, 0xbe, 0xda, 0x33, 0x9c, 0x90, 0x60, 0x95, 0x49, 0x70, 0x1f, 0xdd, 0x8d,
+	0x97, 0xa0, 0x29, 0x60, 0xfd, 0x34, 0x5b, 0x9b, 0xdd, 0xb5, 0xde, 0x50, 0x5f, 0xcf, 0xc1, 0xb1,
+	0xc4, 0xd6, 0x3d, 0xb4, 0x90, 0xc2, 0x6f, 0x37, 0x0d, 0x87, 0xf2, 0xad, 0x27, 0x43, 0x22, 0x34,
+	0xf0, 0x15, 0xa6, 0x81, 0x35, 0xb4, 0x1a, 0xaf, 0x01, 0xaf, 0x61, 0x51, 0x6d, 0x78, 0x38, 0x54,
+	0x9d, 0x21, 0x29, 0xbe, 0xe7, 0x77, 0x3c, 0x52, 0x25, 0xb4, 0x36, 0x38, 0xee, 0xa2, 0xbf, 0x96,
+	0x60, 0x34, 0xdc, 0xd0, 0x86, 0x2e, 0x74, 0x71, 0x27, 0xb5, 0xb4, 0xde, 0xc9, 0x17, 0x7b, 0x82,
+	0x11, 0x62, 0xbd, 0xc1, 0xc4, 0xba, 0x85, 0xe6, 0x53, 0x6e, 0x32, 0x8d, 0xa8, 0xbc, 0x03, 0xaf,
+	0xc3, 0xaa, 0xf2, 0x17, 0xbb, 0xe8, 0x47, 0x12, 0xa0, 0xf6, 0x96, 0x2d, 0xf4, 0x52, 0x0a, 0x5f,
+	0xb1, 0x1d, 0x62, 0xf2, 0xb5, 0x3e, 0x20, 0x85, 0x5c, 0x97, 0x99, 0x5c, 0x45, 0xf4, 0x42, 0xbc,
+	0x5c, 0x75, 0x06, 0xad, 0x56, 0xc3, 0xbc, 0xfe, 0xbd, 0x04, 0x87, 0x3b, 0xf4, 0x7c, 0xa1, 0x6b,
+	0x5d, 0xd9, 0x50, 0xa7, 0x76, 0x33, 0xf9, 0x7a, 0x3f, 0xa0, 0x42, 0x8a, 0x45, 0x26, 0xc5, 0x4d,
+	0xf4, 0x6a, 0xbc, 0x14, 0xc2, 0xb2, 0xe8, 0x87, 0xec, 0x01, 0x82, 0xd6, 0x9d, 0xf6, 0xb1, 0x04,
+	0x87, 0xda, 0x9a, 0xaf, 0x50, 0xda, 0x69, 0x10, 0xd7, 0x3f, 0x26, 0xbf, 0xd4, 0x3b, 0xa0, 0x10,
+	0xe8, 0x4d, 0x26, 0xd0, 0x32, 0x7a, 0xd0, 0x85, 0x33, 0x5f, 0x36, 0xb0, 0x5a, 0xa1, 0xd0, 0x1d,
+	0x4c, 0x2e, 0x9a, 0xc2, 0xdc, 0x45, 0x9f, 0x48, 0x80, 0xda, 0xdb, 0xa3, 0x52, 0x4d, 0x2f, 0xb6,
+	0xbd, 0x4b, 0xbe, 0xd6, 0x07, 0x64, 0xf7, 0x01, 0x8b, 0x57, 0x7a, 0x56, 0x9b, 0xa6, 0xa1, 0x5a,
+	0x26, 0xaf, 0xb8, 0xa5, 0x9e, 0x97, 0x1f, 0xd2, 0xab, 0xa0, 0xa5, 0x81, 0x2a, 0xfd, 0x2a, 0xe8,
+	0xdc, 0xb5, 0x25, 0x5f, 0xed, 0x19, 0x4e, 0x88, 0xb7, 0xc0, 0xc4, 0xbb, 0x81, 0x5e, 0x4e, 0xb8,
+	0x0a, 0xc4, 0xa0, 0xea, 0xb7, 0x74, 0xb5, 0x8a, 0xf2, 0x91, 0x04, 0xe3, 0xd1, 0x5e, 0x21, 0x94,
+	0x16, 0x0d, 0x77, 0xec, 0x8e, 0x92, 0x2f, 0xf7, 0x08, 0x25, 0x84, 0x58, 0x61, 0x42, 0xdc, 0x45,
+	0x4b, 0xf1, 0x42, 0x78, 0x0d, 0x60, 0x75, 0x0e, 0x9a, 0xba, 0x3a, 0xbf, 0x94, 0xe0, 0xe9, 0xa4,
+	0x66, 0x18, 0x94, 0xe6, 0x00, 0x76, 0xd1, 0xbe, 0x23, 0x2f, 0x3c, 0x11, 0x8e, 0xee, 0x2f, 0x73,
+	0x8d, 0xe1, 0xa1, 0x0e, 0x96, 0xcd, 0x31, 0xa9, 0xd1, 0x04, 0x5e, 0xbb, 0x4f, 0xf9, 0x3f, 0x12,
+	0x4c, 0xc6, 0xf4, 0x99, 0xa0, 0x34, 0xf7, 0x29, 0xb9, 0x65, 0x46, 0x7e, 0xb5, 0x5f, 0x70, 0x21,
+	0xef, 0x3b, 0x4c, 0xde, 0x37, 0xd1, 0xa3, 0x04, 0xaf, 0x39, 0x68, 0x74, 0x09, 0x7b, 0x95, 0x9d,
+	0xe2, 0x9c, 0xd6, 0x75, 0x0f, 0xae, 0x8c, 0x48, 0x4b, 0x49, 0x97, 0x57, 0x46, 0xa7, 0x66, 0x16,
+	0xf9, 0x7a, 0x3f, 0xa0, 0x3d, 0x5f, 0x19, 0xae, 0x19, 0x3e, 0x86, 0x5a, 0xc5, 0xfa, 0x27, 0x09,
+	0x0a, 0x71, 0x7d, 0x16, 0x28, 0x6d, 0x45, 0x52, 0x5a, 0x40, 0xe4, 0xd7, 0xfa, 0x86, 0x17, 0x52,
+	0xbe, 0xc2, 0xa4, 0xbc, 0x82, 0x2e, 0x25, 0x98, 0xb0, 0x4b, 0xac, 0x48, 0xdb, 0xb0, 0xea, 0xbd,
+	0x42, 0x3f, 0x94, 0x60, 0x34, 0xdc, 0x60, 0x91, 0xea, 0x6e, 0x75, 0x68, 0xf9, 0x90, 0x2f, 0xf6,
+	0x04, 0x23, 0xf8, 0x9e, 0x63, 0x7c, 0xbf, 0x8c, 0xae, 0xc5, 0xf3, 0xcd, 0xbb, 0x2f, 0x34, 0x83,
+	0x7e, 0xf7, 0xed, 0x74, 0x48, 0x3e, 0x7e, 0x28, 0xc1, 0x91, 0x4e, 0x8d, 0x0f, 0x28, 0xcd, 0x6a,
+	0x12, 0x3a, 0x2a, 0xe4, 0x97, 0xfb, 0x82, 0x15, 0x42, 0x5d, 0x65, 0x42, 0x9d, 0x47, 0xc5, 0xf4,
+	0xa8, 0x34, 0xba, 0x0e, 0x3f, 0x96, 0x60, 0x3c, 0xda, 0xbf, 0x90, 0x7a, 0x0b, 0x74, 0xec, 0xbb,
+	0x90, 0x2f, 0xf7, 0x08, 0x25, 0x18, 0x2f, 0x31, 0xc6, 0xef, 0xa1, 0x37, 0x12, 0xe2, 0x4d, 0x0a,
+	0xa9, 0xe2, 0x4d, 0x2c, 0xa2, 0xe9, 0xd4, 0xe3, 0xe0, 0x6f, 0xe9, 0xcd, 0x16, 0x69, 0x76, 0x48,
+	0xbf, 0xd9, 0x3a, 0x75, 0x63, 0xc8, 0x97, 0x7b, 0x84, 0xea, 0x3e, 0x81, 0xb8, 0xee, 0x43, 0xaa,
+	0x8e, 0xfe, 0x6e, 0x48, 0xa4, 0xa8, 0x24, 0xff, 0x2e, 0xc1, 0x74, 0x42, 0x75, 0x3d, 0x35, 0x27,
+	0x92, 0xde, 0xb4, 0x20, 0xcf, 0x3f, 0x09, 0x8a, 0xee, 0x73, 0x88, 0xd1, 0x9c, 0x88, 0x28, 0xca,
+	0x63, 0x81, 0x88, 0x26, 0x0e, 0x3e, 0x96, 0xa0, 0x10, 0x57, 0xa5, 0x4d, 0x3d, 0xec, 0x52, 0xaa,
+	0xd8, 0xf2, 0x6b, 0x7d, 0xc3, 0x47, 0x0f, 0x0d, 0xe5, 0x4a, 0xe2, 0x91, 0x4e, 0x2b, 0x59, 0xbc,
+	0xbe, 0x4f, 0xb3, 0xa4, 0x44, 0xc7, 0xb6, 0x5a, 0x61, 0x78, 0xa8, 0x80, 0x3f, 0x93, 0x60, 0x2a,
+	0xb6, 0x32, 0x88, 0x5e, 0xeb, 0x22, 0x72, 0x4c, 0x2a, 0x8b, 0xca, 0x37, 0xfb, 0x47, 0xd0, 0xbd,
+	0x8c, 0x2c, 0x0e, 0x75, 0x29, 0x16, 0xb5, 0xee, 0xa3, 0xe1, 0x99, 0x20, 0x87, 0xca, 0xf8, 0x0b,
+	0x09, 0x26, 0x63, 0xaa, 0x5f, 0xa9, 0x1e, 0x48, 0x72, 0x01, 0x4e, 0x7e, 0xb5, 0x5f, 0x70, 0x21,
+	0xdd, 0x5d, 0x26, 0xdd, 0x6d, 0xb4, 0x90, 0xb0, 0x82, 0xba, 0x19, 0xb8, 0x1d, 0xf4, 0xbb, 0x03,
+	0xbf, 0x1f, 0xb6, 0x65, 0x5f, 0xce, 0xbf, 0xf5, 0xe1, 0x27, 0x33, 0xd2, 0x47, 0x9f, 0xcc, 0x48,
+	0xff, 0xf6, 0xc9, 0x8c, 0xf4, 0xcd, 0x4f, 0x67, 0x9e, 0xfa, 0xe8, 0xd3, 0x99, 0xa7, 0x7e, 0xfa,
+	0xe9, 0xcc, 0x53, 0x6f, 0xdf, 0xe8, 0xbe, 0x5c, 0xb9, 0x1d, 0x21, 0xce, 0x6a, 0x97, 0xe5, 0x7d,
+	0xec, 0xed, 0xc5, 0xff, 0x1f, 0x00, 0xf6, 0xa5, 0xb5, 0xe7, 0xcb, 0x50, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Queries a Subaccount by id
+	Subaccount(ctx context.Context, in *QueryGetSubaccountRequest, opts ...grpc.CallOption) (*QuerySubaccountResponse, error)
+	// Queries a list of Subaccount items.
+	SubaccountAll(ctx context.Context, in *QueryAllSubaccountRequest, opts ...grpc.CallOption) (*QuerySubaccountAllResponse, error)
+	// Queries information about whether withdrawal and transfers are blocked, and
+	// if so which block they are re-enabled on.
+	GetWithdrawalAndTransfersBlockedInfo(ctx context.Context, in *QueryGetWithdrawalAndTransfersBlockedInfoRequest, opts ...grpc.CallOption) (*QueryGetWithdrawalAndTransfersBlockedInfoResponse, error)
+	// Queries the collateral pool account address for a perpetual id.
+	CollateralPoolAddress(ctx context.Context, in *QueryCollateralPoolAddressRequest, opts ...grpc.CallOption) (*QueryCollateralPoolAddressResponse, error)
+	// Computes the risk of a hypothetical subaccount that is not stored, after
+	// applying a list of updates, at the current oracle prices.
+	ComputeRiskForHypothetical(ctx context.Context, in *QueryComputeRiskForHypotheticalRequest, opts ...grpc.CallOption) (*QueryComputeRiskForHypotheticalResponse, error)
+	// Queries the inputs used to compute the current risk of a subaccount, so
+	// that off-chain tools can reproduce it exactly.
+	RiskInputs(ctx context.Context, in *QueryRiskInputsRequest, opts ...grpc.CallOption) (*QueryRiskInputsResponse, error)
+	// Queries the ratio of the initial margin requirement of a subaccount to its
+	// net collateral.
+	SubaccountUtilization(ctx context.Context, in *QuerySubaccountUtilizationRequest, opts ...grpc.CallOption) (*QuerySubaccountUtilizationResponse, error)
+	// Queries the total value locked across all subaccounts.
+	TotalValueLocked(ctx context.Context, in *QueryTotalValueLockedRequest, opts ...grpc.CallOption) (*QueryTotalValueLockedResponse, error)
+	// Queries the liquidation and bankruptcy prices of every open perpetual
+	// position of a subaccount.
+	SubaccountLiquidationPrices(ctx context.Context, in *QuerySubaccountLiquidationPricesRequest, opts ...grpc.CallOption) (*QuerySubaccountLiquidationPricesResponse, error)
+	// Computes the smallest shock to the prices of a correlated basket of
+	// perpetuals that makes a subaccount liquidatable.
+	BasketShockToLiquidate(ctx context.Context, in *QueryBasketShockToLiquidateRequest, opts ...grpc.CallOption) (*QueryBasketShockToLiquidateResponse, error)
+	// Queries the sum of the maintenance margin requirements of all subaccounts.
+	TotalMaintenanceMargin(ctx context.Context, in *QueryTotalMaintenanceMarginRequest, opts ...grpc.CallOption) (*QueryTotalMaintenanceMarginResponse, error)
+	// Queries the notional of the position of a subaccount in a perpetual.
+	PositionNotional(ctx context.Context, in *QueryPositionNotionalRequest, opts ...grpc.CallOption) (*QueryPositionNotionalResponse, error)
+	// Queries the impact of migrating the price exponent of a market on the
+	// positions in its perpetuals.
+	MarketExponentMigrationImpact(ctx context.Context, in *QueryMarketExponentMigrationImpactRequest, opts ...grpc.CallOption) (*QueryMarketExponentMigrationImpactResponse, error)
+	// Queries the risk of a subaccount as of a past block height.
+	RiskAtHeight(ctx context.Context, in *QueryRiskAtHeightRequest, opts ...grpc.CallOption) (*QueryRiskAtHeightResponse, error)
+	// Queries the number of subaccounts in each health bucket.
+	HealthDistribution(ctx context.Context, in *QueryHealthDistributionRequest, opts ...grpc.CallOption) (*QueryHealthDistributionResponse, error)
+	// Queries the sensitivity of the total net collateral of all subaccounts to
+	// the market price of a perpetual.
+	MarketNcSensitivity(ctx context.Context, in *QueryMarketNcSensitivityRequest, opts ...grpc.CallOption) (*QueryMarketNcSensitivityResponse, error)
+	// Queries whether a withdrawal from a subaccount would be allowed, and the
+	// maximum amount that can be withdrawn.
+	WithdrawableCheck(ctx context.Context, in *QueryWithdrawableCheckRequest, opts ...grpc.CallOption) (*QueryWithdrawableCheckResponse, error)
+	// Queries the PnL a subaccount would realize by closing its position in a
+	// perpetual.
+	RealizedPnlOnClose(ctx context.Context, in *QueryRealizedPnlOnCloseRequest, opts ...grpc.CallOption) (*QueryRealizedPnlOnCloseResponse, error)
+	// Queries the net position of all subaccounts in a perpetual.
+	ProtocolNetDelta(ctx context.Context, in *QueryProtocolNetDeltaRequest, opts ...grpc.CallOption) (*QueryProtocolNetDeltaResponse, error)
+	// Queries the funding settled for the position of a subaccount in a perpetual
+	// during a range of funding-tick epochs.
+	FundingHistory(ctx context.Context, in *QueryFundingHistoryRequest, opts ...grpc.CallOption) (*QueryFundingHistoryResponse, error)
+	// Queries the closes of the positions of a subaccount that each make it
+	// initially collateralized.
+	ActionToRestoreInitialMargin(ctx context.Context, in *QueryActionToRestoreInitialMarginRequest, opts ...grpc.CallOption) (*QueryActionToRestoreInitialMarginResponse, error)
+	// Queries how much a subaccount can lose on its position in a perpetual before
+	// it becomes liquidatable.
+	LossBufferToLiquidation(ctx context.Context, in *QueryLossBufferToLiquidationRequest, opts ...grpc.CallOption) (*QueryLossBufferToLiquidationResponse, error)
+	// Queries the unrealized PnL of the positions of all subaccounts in a
+	// perpetual.
+	MarketUnrealizedPnl(ctx context.Context, in *QueryMarketUnrealizedPnlRequest, opts ...grpc.CallOption) (*QueryMarketUnrealizedPnlResponse, error)
+	// Queries the subaccounts whose free collateral exceeds a threshold.
+	AutoWithdrawableAccounts(ctx context.Context, in *QueryAutoWithdrawableAccountsRequest, opts ...grpc.CallOption) (*QueryAutoWithdrawableAccountsResponse, error)
+	// Queries the taker fees a subaccount would pay to close all of its positions.
+	CloseAllCost(ctx context.Context, in *QueryCloseAllCostRequest, opts ...grpc.CallOption) (*QueryCloseAllCostResponse, error)
+	// Queries the liquidatable subaccounts in the order in which they should be
+	// liquidated.
+	LiquidatableAccounts(ctx context.Context, in *QueryLiquidatableAccountsRequest, opts ...grpc.CallOption) (*QueryLiquidatableAccountsResponse, error)
+	// Queries the price at which a subaccount would realize zero PnL by closing
+	// its position in a perpetual.
+	BreakEvenPrice(ctx context.Context, in *QueryBreakEvenPriceRequest, opts ...grpc.CallOption) (*QueryBreakEvenPriceResponse, error)
+	// Queries the size of the trade that would make the net position of all
+	// subaccounts of an owner in a perpetual zero.
+	FlatteningSize(ctx context.Context, in *QueryFlatteningSizeRequest, opts ...grpc.CallOption) (*QueryFlatteningSizeResponse, error)
+	// Estimates the proceeds of liquidating the position of a subaccount in a
+	// perpetual against a depth model of the order book.
+	LiquidationProceedsEstimate(ctx context.Context, in *QueryLiquidationProceedsEstimateRequest, opts ...grpc.CallOption) (*QueryLiquidationProceedsEstimateResponse, error)
+	// Queries the change in the margin requirements of a subaccount if a perpetual
+	// were migrated to a proposed liquidity tier.
+	MarginDeltaForTierChange(ctx context.Context, in *QueryMarginDeltaForTierChangeRequest, opts ...grpc.CallOption) (*QueryMarginDeltaForTierChangeResponse, error)
+	// Computes the risk of a subaccount with each of its positions shocked by the
+	// worst historical single-day move of its market.
+	RiskUnderHistoricalShocks(ctx context.Context, in *QueryRiskUnderHistoricalShocksRequest, opts ...grpc.CallOption) (*QueryRiskUnderHistoricalShocksResponse, error)
+	// Queries the smallest adverse move in the oracle price of a perpetual that
+	// makes a subaccount liquidatable.
+	MinLiquidatingPriceMove(ctx context.Context, in *QueryMinLiquidatingPriceMoveRequest, opts ...grpc.CallOption) (*QueryMinLiquidatingPriceMoveResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Subaccount(ctx context.Context, in *QueryGetSubaccountRequest, opts ...grpc.CallOption) (*QuerySubaccountResponse, error) {
+	out := new(QuerySubaccountResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/Subaccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SubaccountAll(ctx context.Context, in *QueryAllSubaccountRequest, opts ...grpc.CallOption) (*QuerySubaccountAllResponse, error) {
+	out := new(QuerySubaccountAllResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/SubaccountAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetWithdrawalAndTransfersBlockedInfo(ctx context.Context, in *QueryGetWithdrawalAndTransfersBlockedInfoRequest, opts ...grpc.CallOption) (*QueryGetWithdrawalAndTransfersBlockedInfoResponse, error) {
+	out := new(QueryGetWithdrawalAndTransfersBlockedInfoResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/GetWithdrawalAndTransfersBlockedInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CollateralPoolAddress(ctx context.Context, in *QueryCollateralPoolAddressRequest, opts ...grpc.CallOption) (*QueryCollateralPoolAddressResponse, error) {
+	out := new(QueryCollateralPoolAddressResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/CollateralPoolAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ComputeRiskForHypothetical(ctx context.Context, in *QueryComputeRiskForHypotheticalRequest, opts ...grpc.CallOption) (*QueryComputeRiskForHypotheticalResponse, error) {
+	out := new(QueryComputeRiskForHypotheticalResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/ComputeRiskForHypothetical", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RiskInputs(ctx context.Context, in *QueryRiskInputsRequest, opts ...grpc.CallOption) (*QueryRiskInputsResponse, error) {
+	out := new(QueryRiskInputsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/RiskInputs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SubaccountUtilization(ctx context.Context, in *QuerySubaccountUtilizationRequest, opts ...grpc.CallOption) (*QuerySubaccountUtilizationResponse, error) {
+	out := new(QuerySubaccountUtilizationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/SubaccountUtilization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalValueLocked(ctx context.Context, in *QueryTotalValueLockedRequest, opts ...grpc.CallOption) (*QueryTotalValueLockedResponse, error) {
+	out := new(QueryTotalValueLockedResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/TotalValueLocked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SubaccountLiquidationPrices(ctx context.Context, in *QuerySubaccountLiquidationPricesRequest, opts ...grpc.CallOption) (*QuerySubaccountLiquidationPricesResponse, error) {
+	out := new(QuerySubaccountLiquidationPricesResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/SubaccountLiquidationPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BasketShockToLiquidate(ctx context.Context, in *QueryBasketShockToLiquidateRequest, opts ...grpc.CallOption) (*QueryBasketShockToLiquidateResponse, error) {
+	out := new(QueryBasketShockToLiquidateResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/BasketShockToLiquidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalMaintenanceMargin(ctx context.Context, in *QueryTotalMaintenanceMarginRequest, opts ...grpc.CallOption) (*QueryTotalMaintenanceMarginResponse, error) {
+	out := new(QueryTotalMaintenanceMarginResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/TotalMaintenanceMargin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PositionNotional(ctx context.Context, in *QueryPositionNotionalRequest, opts ...grpc.CallOption) (*QueryPositionNotionalResponse, error) {
+	out := new(QueryPositionNotionalResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/PositionNotional", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketExponentMigrationImpact(ctx context.Context, in *QueryMarketExponentMigrationImpactRequest, opts ...grpc.CallOption) (*QueryMarketExponentMigrationImpactResponse, error) {
+	out := new(QueryMarketExponentMigrationImpactResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/MarketExponentMigrationImpact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RiskAtHeight(ctx context.Context, in *QueryRiskAtHeightRequest, opts ...grpc.CallOption) (*QueryRiskAtHeightResponse, error) {
+	out := new(QueryRiskAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/RiskAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HealthDistribution(ctx context.Context, in *QueryHealthDistributionRequest, opts ...grpc.CallOption) (*QueryHealthDistributionResponse, error) {
+	out := new(QueryHealthDistributionResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/HealthDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketNcSensitivity(ctx context.Context, in *QueryMarketNcSensitivityRequest, opts ...grpc.CallOption) (*QueryMarketNcSensitivityResponse, error) {
+	out := new(QueryMarketNcSensitivityResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/MarketNcSensitivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WithdrawableCheck(ctx context.Context, in *QueryWithdrawableCheckRequest, opts ...grpc.CallOption) (*QueryWithdrawableCheckResponse, error) {
+	out := new(QueryWithdrawableCheckResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/WithdrawableCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RealizedPnlOnClose(ctx context.Context, in *QueryRealizedPnlOnCloseRequest, opts ...grpc.CallOption) (*QueryRealizedPnlOnCloseResponse, error) {
+	out := new(QueryRealizedPnlOnCloseResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/RealizedPnlOnClose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProtocolNetDelta(ctx context.Context, in *QueryProtocolNetDeltaRequest, opts ...grpc.CallOption) (*QueryProtocolNetDeltaResponse, error) {
+	out := new(QueryProtocolNetDeltaResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/ProtocolNetDelta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FundingHistory(ctx context.Context, in *QueryFundingHistoryRequest, opts ...grpc.CallOption) (*QueryFundingHistoryResponse, error) {
+	out := new(QueryFundingHistoryResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/FundingHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ActionToRestoreInitialMargin(ctx context.Context, in *QueryActionToRestoreInitialMarginRequest, opts ...grpc.CallOption) (*QueryActionToRestoreInitialMarginResponse, error) {
+	out := new(QueryActionToRestoreInitialMarginResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/ActionToRestoreInitialMargin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LossBufferToLiquidation(ctx context.Context, in *QueryLossBufferToLiquidationRequest, opts ...grpc.CallOption) (*QueryLossBufferToLiquidationResponse, error) {
+	out := new(QueryLossBufferToLiquidationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/LossBufferToLiquidation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketUnrealizedPnl(ctx context.Context, in *QueryMarketUnrealizedPnlRequest, opts ...grpc.CallOption) (*QueryMarketUnrealizedPnlResponse, error) {
+	out := new(QueryMarketUnrealizedPnlResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/MarketUnrealizedPnl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AutoWithdrawableAccounts(ctx context.Context, in *QueryAutoWithdrawableAccountsRequest, opts ...grpc.CallOption) (*QueryAutoWithdrawableAccountsResponse, error) {
+	out := new(QueryAutoWithdrawableAccountsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/AutoWithdrawableAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CloseAllCost(ctx context.Context, in *QueryCloseAllCostRequest, opts ...grpc.CallOption) (*QueryCloseAllCostResponse, error) {
+	out := new(QueryCloseAllCostResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/CloseAllCost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidatableAccounts(ctx context.Context, in *QueryLiquidatableAccountsRequest, opts ...grpc.CallOption) (*QueryLiquidatableAccountsResponse, error) {
+	out := new(QueryLiquidatableAccountsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/LiquidatableAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BreakEvenPrice(ctx context.Context, in *QueryBreakEvenPriceRequest, opts ...grpc.CallOption) (*QueryBreakEvenPriceResponse, error) {
+	out := new(QueryBreakEvenPriceResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/BreakEvenPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FlatteningSize(ctx context.Context, in *QueryFlatteningSizeRequest, opts ...grpc.CallOption) (*QueryFlatteningSizeResponse, error) {
+	out := new(QueryFlatteningSizeResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/FlatteningSize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidationProceedsEstimate(ctx context.Context, in *QueryLiquidationProceedsEstimateRequest, opts ...grpc.CallOption) (*QueryLiquidationProceedsEstimateResponse, error) {
+	out := new(QueryLiquidationProceedsEstimateResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/LiquidationProceedsEstimate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarginDeltaForTierChange(ctx context.Context, in *QueryMarginDeltaForTierChangeRequest, opts ...grpc.CallOption) (*QueryMarginDeltaForTierChangeResponse, error) {
+	out := new(QueryMarginDeltaForTierChangeResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/MarginDeltaForTierChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RiskUnderHistoricalShocks(ctx context.Context, in *QueryRiskUnderHistoricalShocksRequest, opts ...grpc.CallOption) (*QueryRiskUnderHistoricalShocksResponse, error) {
+	out := new(QueryRiskUnderHistoricalShocksResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/RiskUnderHistoricalShocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinLiquidatingPriceMove(ctx context.Context, in *QueryMinLiquidatingPriceMoveRequest, opts ...grpc.CallOption) (*QueryMinLiquidatingPriceMoveResponse, error) {
+	out := new(QueryMinLiquidatingPriceMoveResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/MinLiquidatingPriceMove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries a Subaccount by id
+	Subaccount(context.Context, *QueryGetSubaccountRequest) (*QuerySubaccountResponse, error)
+	// Queries a list of Subaccount items.
+	SubaccountAll(context.Context, *QueryAllSubaccountRequest) (*QuerySubaccountAllResponse, error)
+	// Queries information about whether withdrawal and transfers are blocked, and
+	// if so which block they are re-enabled on.
+	GetWithdrawalAndTransfersBlockedInfo(context.Context, *QueryGetWithdrawalAndTransfersBlockedInfoRequest) (*QueryGetWithdrawalAndTransfersBlockedInfoResponse, error)
+	// Queries the collateral pool account address for a perpetual id.
+	CollateralPoolAddress(context.Context, *QueryCollateralPoolAddressRequest) (*QueryCollateralPoolAddressResponse, error)
+	// Computes the risk of a hypothetical subaccount that is not stored, after
+	// applying a list of updates, at the current oracle prices.
+	ComputeRiskForHypothetical(context.Context, *QueryComputeRiskForHypotheticalRequest) (*QueryComputeRiskForHypotheticalResponse, error)
+	// Queries the inputs used to compute the current risk of a subaccount, so
+	// that off-chain tools can reproduce it exactly.
+	RiskInputs(context.Context, *QueryRiskInputsRequest) (*QueryRiskInputsResponse, error)
+	// Queries the ratio of the initial margin requirement of a subaccount to its
+	// net collateral.
+	SubaccountUtilization(context.Context, *QuerySubaccountUtilizationRequest) (*QuerySubaccountUtilizationResponse, error)
+	// Queries the total value locked across all subaccounts.
+	TotalValueLocked(context.Context, *QueryTotalValueLockedRequest) (*QueryTotalValueLockedResponse, error)
+	// Queries the liquidation and bankruptcy prices of every open perpetual
+	// position of a subaccount.
+	SubaccountLiquidationPrices(context.Context, *QuerySubaccountLiquidationPricesRequest) (*QuerySubaccountLiquidationPricesResponse, error)
+	// Computes the smallest shock to the prices of a correlated basket of
+	// perpetuals that makes a subaccount liquidatable.
+	BasketShockToLiquidate(context.Context, *QueryBasketShockToLiquidateRequest) (*QueryBasketShockToLiquidateResponse, error)
+	// Queries the sum of the maintenance margin requirements of all subaccounts.
+	TotalMaintenanceMargin(context.Context, *QueryTotalMaintenanceMarginRequest) (*QueryTotalMaintenanceMarginResponse, error)
+	// Queries the notional of the position of a subaccount in a perpetual.
+	PositionNotional(context.Context, *QueryPositionNotionalRequest) (*QueryPositionNotionalResponse, error)
+	// Queries the impact of migrating the price exponent of a market on the
+	// positions in its perpetuals.
+	MarketExponentMigrationImpact(context.Context, *QueryMarketExponentMigrationImpactRequest) (*QueryMarketExponentMigrationImpactResponse, error)
+	// Queries the risk of a subaccount as of a past block height.
+	RiskAtHeight(context.Context, *QueryRiskAtHeightRequest) (*QueryRiskAtHeightResponse, error)
+	// Queries the number of subaccounts in each health bucket.
+	HealthDistribution(context.Context, *QueryHealthDistributionRequest) (*QueryHealthDistributionResponse, error)
+	// Queries the sensitivity of the total net collateral of all subaccounts to
+	// the market price of a perpetual.
+	MarketNcSensitivity(context.Context, *QueryMarketNcSensitivityRequest) (*QueryMarketNcSensitivityResponse, error)
+	// Queries whether a withdrawal from a subaccount would be allowed, and the
+	// maximum amount that can be withdrawn.
+	WithdrawableCheck(context.Context, *QueryWithdrawableCheckRequest) (*QueryWithdrawableCheckResponse, error)
+	// Queries the PnL a subaccount would realize by closing its position in a
+	// perpetual.
+	RealizedPnlOnClose(context.Context, *QueryRealizedPnlOnCloseRequest) (*QueryRealizedPnlOnCloseResponse, error)
+	// Queries the net position of all subaccounts in a perpetual.
+	ProtocolNetDelta(context.Context, *QueryProtocolNetDeltaRequest) (*QueryProtocolNetDeltaResponse, error)
+	// Queries the funding settled for the position of a subaccount in a perpetual
+	// during a range of funding-tick epochs.
+	FundingHistory(context.Context, *QueryFundingHistoryRequest) (*QueryFundingHistoryResponse, error)
+	// Queries the closes of the positions of a subaccount that each make it
+	// initially collateralized.
+	ActionToRestoreInitialMargin(context.Context, *QueryActionToRestoreInitialMarginRequest) (*QueryActionToRestoreInitialMarginResponse, error)
+	// Queries how much a subaccount can lose on its position in a perpetual before
+	// it becomes liquidatable.
+	LossBufferToLiquidation(context.Context, *QueryLossBufferToLiquidationRequest) (*QueryLossBufferToLiquidationResponse, error)
+	// Queries the unrealized PnL of the positions of all subaccounts in a
+	// perpetual.
+	MarketUnrealizedPnl(context.Context, *QueryMarketUnrealizedPnlRequest) (*QueryMarketUnrealizedPnlResponse, error)
+	// Queries the subaccounts whose free collateral exceeds a threshold.
+	AutoWithdrawableAccounts(context.Context, *QueryAutoWithdrawableAccountsRequest) (*QueryAutoWithdrawableAccountsResponse, error)
+	// Queries the taker fees a subaccount would pay to close all of its positions.
+	CloseAllCost(context.Context, *QueryCloseAllCostRequest) (*QueryCloseAllCostResponse, error)
+	// Queries the liquidatable subaccounts in the order in which they should be
+	// liquidated.
+	LiquidatableAccounts(context.Context, *QueryLiquidatableAccountsRequest) (*QueryLiquidatableAccountsResponse, error)
+	// Queries the price at which a subaccount would realize zero PnL by closing
+	// its position in a perpetual.
+	BreakEvenPrice(context.Context, *QueryBreakEvenPriceRequest) (*QueryBreakEvenPriceResponse, error)
+	// Queries the size of the trade that would make the net position of all
+	// subaccounts of an owner in a perpetual zero.
+	FlatteningSize(context.Context, *QueryFlatteningSizeRequest) (*QueryFlatteningSizeResponse, error)
+	// Estimates the proceeds of liquidating the position of a subaccount in a
+	// perpetual against a depth model of the order book.
+	LiquidationProceedsEstimate(context.Context, *QueryLiquidationProceedsEstimateRequest) (*QueryLiquidationProceedsEstimateResponse, error)
+	// Queries the change in the margin requirements of a subaccount if a perpetual
+	// were migrated to a proposed liquidity tier.
+	MarginDeltaForTierChange(context.Context, *QueryMarginDeltaForTierChangeRequest) (*QueryMarginDeltaForTierChangeResponse, error)
+	// Computes the risk of a subaccount with each of its positions shocked by the
+	// worst historical single-day move of its market.
+	RiskUnderHistoricalShocks(context.Context, *QueryRiskUnderHistoricalShocksRequest) (*QueryRiskUnderHistoricalShocksResponse, error)
+	// Queries the smallest adverse move in the oracle price of a perpetual that
+	// makes a subaccount liquidatable.
+	MinLiquidatingPriceMove(context.Context, *QueryMinLiquidatingPriceMoveRequest) (*QueryMinLiquidatingPriceMoveResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Subaccount(ctx context.Context, req *QueryGetSubaccountRequest) (*QuerySubaccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subaccount not implemented")
+}
+func (*UnimplementedQueryServer) SubaccountAll(ctx context.Context, req *QueryAllSubaccountRequest) (*QuerySubaccountAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubaccountAll not implemented")
+}
+func (*UnimplementedQueryServer) GetWithdrawalAndTransfersBlockedInfo(ctx context.Context, req *QueryGetWithdrawalAndTransfersBlockedInfoRequest) (*QueryGetWithdrawalAndTransfersBlockedInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWithdrawalAndTransfersBlockedInfo not implemented")
+}
+func (*UnimplementedQueryServer) CollateralPoolAddress(ctx context.Context, req *QueryCollateralPoolAddressRequest) (*QueryCollateralPoolAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollateralPoolAddress not implemented")
+}
+func (*UnimplementedQueryServer) ComputeRiskForHypothetical(ctx context.Context, req *QueryComputeRiskForHypotheticalRequest) (*QueryComputeRiskForHypotheticalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComputeRiskForHypothetical not implemented")
+}
+func (*UnimplementedQueryServer) RiskInputs(ctx context.Context, req *QueryRiskInputsRequest) (*QueryRiskInputsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RiskInputs not implemented")
+}
+func (*UnimplementedQueryServer) SubaccountUtilization(ctx context.Context, req *QuerySubaccountUtilizationRequest) (*QuerySubaccountUtilizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubaccountUtilization not implemented")
+}
+func (*UnimplementedQueryServer) TotalValueLocked(ctx context.Context, req *QueryTotalValueLockedRequest) (*QueryTotalValueLockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalValueLocked not implemented")
+}
+func (*UnimplementedQueryServer) SubaccountLiquidationPrices(ctx context.Context, req *QuerySubaccountLiquidationPricesRequest) (*QuerySubaccountLiquidationPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubaccountLiquidationPrices not implemented")
+}
+func (*UnimplementedQueryServer) BasketShockToLiquidate(ctx context.Context, req *QueryBasketShockToLiquidateRequest) (*QueryBasketShockToLiquidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BasketShockToLiquidate not implemented")
+}
+func (*UnimplementedQueryServer) TotalMaintenanceMargin(ctx context.Context, req *QueryTotalMaintenanceMarginRequest) (*QueryTotalMaintenanceMarginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalMaintenanceMargin not implemented")
+}
+func (*UnimplementedQueryServer) PositionNotional(ctx context.Context, req *QueryPositionNotionalRequest) (*QueryPositionNotionalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionNotional not implemented")
+}
+func (*UnimplementedQueryServer) MarketExponentMigrationImpact(ctx context.Context, req *QueryMarketExponentMigrationImpactRequest) (*QueryMarketExponentMigrationImpactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketExponentMigrationImpact not implemented")
+}
+func (*UnimplementedQueryServer) RiskAtHeight(ctx context.Context, req *QueryRiskAtHeightRequest) (*QueryRiskAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RiskAtHeight not implemented")
+}
+func (*UnimplementedQueryServer) HealthDistribution(ctx context.Context, req *QueryHealthDistributionRequest) (*QueryHealthDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HealthDistribution not implemented")
+}
+func (*UnimplementedQueryServer) MarketNcSensitivity(ctx context.Context, req *QueryMarketNcSensitivityRequest) (*QueryMarketNcSensitivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketNcSensitivity not implemented")
+}
+func (*UnimplementedQueryServer) WithdrawableCheck(ctx context.Context, req *QueryWithdrawableCheckRequest) (*QueryWithdrawableCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawableCheck not implemented")
+}
+func (*UnimplementedQueryServer) RealizedPnlOnClose(ctx context.Context, req *QueryRealizedPnlOnCloseRequest) (*QueryRealizedPnlOnCloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RealizedPnlOnClose not implemented")
+}
+func (*UnimplementedQueryServer) ProtocolNetDelta(ctx context.Context, req *QueryProtocolNetDeltaRequest) (*QueryProtocolNetDeltaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProtocolNetDelta not implemented")
+}
+func (*UnimplementedQueryServer) FundingHistory(ctx context.Context, req *QueryFundingHistoryRequest) (*QueryFundingHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundingHistory not implemented")
+}
+func (*UnimplementedQueryServer) ActionToRestoreInitialMargin(ctx context.Context, req *QueryActionToRestoreInitialMarginRequest) (*QueryActionToRestoreInitialMarginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActionToRestoreInitialMargin not implemented")
+}
+func (*UnimplementedQueryServer) LossBufferToLiquidation(ctx context.Context, req *QueryLossBufferToLiquidationRequest) (*QueryLossBufferToLiquidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LossBufferToLiquidation not implemented")
+}
+func (*UnimplementedQueryServer) MarketUnrealizedPnl(ctx context.Context, req *QueryMarketUnrealizedPnlRequest) (*QueryMarketUnrealizedPnlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketUnrealizedPnl not implemented")
+}
+func (*UnimplementedQueryServer) AutoWithdrawableAccounts(ctx context.Context, req *QueryAutoWithdrawableAccountsRequest) (*QueryAutoWithdrawableAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoWithdrawableAccounts not implemented")
+}
+func (*UnimplementedQueryServer) CloseAllCost(ctx context.Context, req *QueryCloseAllCostRequest) (*QueryCloseAllCostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseAllCost not implemented")
+}
+func (*UnimplementedQueryServer) LiquidatableAccounts(ctx context.Context, req *QueryLiquidatableAccountsRequest) (*QueryLiquidatableAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidatableAccounts not implemented")
+}
+func (*UnimplementedQueryServer) BreakEvenPrice(ctx context.Context, req *QueryBreakEvenPriceRequest) (*QueryBreakEvenPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BreakEvenPrice not implemented")
+}
+func (*UnimplementedQueryServer) FlatteningSize(ctx context.Context, req *QueryFlatteningSizeRequest) (*QueryFlatteningSizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlatteningSize not implemented")
+}
+func (*UnimplementedQueryServer) LiquidationProceedsEstimate(ctx context.Context, req *QueryLiquidationProceedsEstimateRequest) (*QueryLiquidationProceedsEstimateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidationProceedsEstimate not implemented")
+}
+func (*UnimplementedQueryServer) MarginDeltaForTierChange(ctx context.Context, req *QueryMarginDeltaForTierChangeRequest) (*QueryMarginDeltaForTierChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarginDeltaForTierChange not implemented")
+}
+func (*UnimplementedQueryServer) RiskUnderHistoricalShocks(ctx context.Context, req *QueryRiskUnderHistoricalShocksRequest) (*QueryRiskUnderHistoricalShocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RiskUnderHistoricalShocks not implemented")
+}
+func (*UnimplementedQueryServer) MinLiquidatingPriceMove(ctx context.Context, req *QueryMinLiquidatingPriceMoveRequest) (*QueryMinLiquidatingPriceMoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinLiquidatingPriceMove not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Subaccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSubaccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Subaccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/Subaccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Subaccount(ctx, req.(*QueryGetSubaccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubaccountAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSubaccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SubaccountAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/SubaccountAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubaccountAll(ctx, req.(*QueryAllSubaccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetWithdrawalAndTransfersBlockedInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetWithdrawalAndTransfersBlockedInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetWithdrawalAndTransfersBlockedInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/GetWithdrawalAndTransfersBlockedInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetWithdrawalAndTransfersBlockedInfo(ctx, req.(*QueryGetWithdrawalAndTransfersBlockedInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CollateralPoolAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollateralPoolAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CollateralPoolAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/CollateralPoolAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CollateralPoolAddress(ctx, req.(*QueryCollateralPoolAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ComputeRiskForHypothetical_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryComputeRiskForHypotheticalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ComputeRiskForHypothetical(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/ComputeRiskForHypothetical",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ComputeRiskForHypothetical(ctx, req.(*QueryComputeRiskForHypotheticalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RiskInputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRiskInputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RiskInputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/RiskInputs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RiskInputs(ctx, req.(*QueryRiskInputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubaccountUtilization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubaccountUtilizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SubaccountUtilization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/SubaccountUtilization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubaccountUtilization(ctx, req.(*QuerySubaccountUtilizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalValueLocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalValueLockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalValueLocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/TotalValueLocked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalValueLocked(ctx, req.(*QueryTotalValueLockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubaccountLiquidationPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubaccountLiquidationPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SubaccountLiquidationPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/SubaccountLiquidationPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubaccountLiquidationPrices(ctx, req.(*QuerySubaccountLiquidationPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BasketShockToLiquidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBasketShockToLiquidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BasketShockToLiquidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/BasketShockToLiquidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BasketShockToLiquidate(ctx, req.(*QueryBasketShockToLiquidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalMaintenanceMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalMaintenanceMarginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalMaintenanceMargin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/TotalMaintenanceMargin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalMaintenanceMargin(ctx, req.(*QueryTotalMaintenanceMarginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PositionNotional_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionNotionalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionNotional(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/PositionNotional",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionNotional(ctx, req.(*QueryPositionNotionalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketExponentMigrationImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketExponentMigrationImpactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketExponentMigrationImpact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/MarketExponentMigrationImpact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketExponentMigrationImpact(ctx, req.(*QueryMarketExponentMigrationImpactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RiskAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRiskAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RiskAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/RiskAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RiskAtHeight(ctx, req.(*QueryRiskAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HealthDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHealthDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HealthDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/HealthDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HealthDistribution(ctx, req.(*QueryHealthDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketNcSensitivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketNcSensitivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketNcSensitivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/MarketNcSensitivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketNcSensitivity(ctx, req.(*QueryMarketNcSensitivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WithdrawableCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawableCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawableCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/WithdrawableCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawableCheck(ctx, req.(*QueryWithdrawableCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RealizedPnlOnClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRealizedPnlOnCloseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RealizedPnlOnClose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/RealizedPnlOnClose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RealizedPnlOnClose(ctx, req.(*QueryRealizedPnlOnCloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProtocolNetDelta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProtocolNetDeltaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProtocolNetDelta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/ProtocolNetDelta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProtocolNetDelta(ctx, req.(*QueryProtocolNetDeltaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FundingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FundingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/FundingHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FundingHistory(ctx, req.(*QueryFundingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActionToRestoreInitialMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActionToRestoreInitialMarginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActionToRestoreInitialMargin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/ActionToRestoreInitialMargin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActionToRestoreInitialMargin(ctx, req.(*QueryActionToRestoreInitialMarginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LossBufferToLiquidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLossBufferToLiquidationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LossBufferToLiquidation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/LossBufferToLiquidation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LossBufferToLiquidation(ctx, req.(*QueryLossBufferToLiquidationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketUnrealizedPnl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketUnrealizedPnlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketUnrealizedPnl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/MarketUnrealizedPnl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketUnrealizedPnl(ctx, req.(*QueryMarketUnrealizedPnlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AutoWithdrawableAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoWithdrawableAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoWithdrawableAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/AutoWithdrawableAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoWithdrawableAccounts(ctx, req.(*QueryAutoWithdrawableAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CloseAllCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCloseAllCostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CloseAllCost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/CloseAllCost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CloseAllCost(ctx, req.(*QueryCloseAllCostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidatableAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidatableAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidatableAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/LiquidatableAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidatableAccounts(ctx, req.(*QueryLiquidatableAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BreakEvenPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBreakEvenPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BreakEvenPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/BreakEvenPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BreakEvenPrice(ctx, req.(*QueryBreakEvenPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FlatteningSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFlatteningSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FlatteningSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/FlatteningSize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FlatteningSize(ctx, req.(*QueryFlatteningSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidationProceedsEstimate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidationProceedsEstimateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidationProceedsEstimate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/LiquidationProceedsEstimate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidationProceedsEstimate(ctx, req.(*QueryLiquidationProceedsEstimateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarginDeltaForTierChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarginDeltaForTierChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarginDeltaForTierChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/MarginDeltaForTierChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarginDeltaForTierChange(ctx, req.(*QueryMarginDeltaForTierChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RiskUnderHistoricalShocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRiskUnderHistoricalShocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RiskUnderHistoricalShocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/RiskUnderHistoricalShocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RiskUnderHistoricalShocks(ctx, req.(*QueryRiskUnderHistoricalShocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinLiquidatingPriceMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinLiquidatingPriceMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinLiquidatingPriceMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/MinLiquidatingPriceMove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinLiquidatingPriceMove(ctx, req.(*QueryMinLiquidatingPriceMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dydxprotocol.subaccounts.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Subaccount",
+			Handler:    _Query_Subaccount_Handler,
+		},
+		{
+			MethodName: "SubaccountAll",
+			Handler:    _Query_SubaccountAll_Handler,
+		},
+		{
+			MethodName: "GetWithdrawalAndTransfersBlockedInfo",
+			Handler:    _Query_GetWithdrawalAndTransfersBlockedInfo_Handler,
+		},
+		{
+			MethodName: "CollateralPoolAddress",
+			Handler:    _Query_CollateralPoolAddress_Handler,
+		},
+		{
+			MethodName: "ComputeRiskForHypothetical",
+			Handler:    _Query_ComputeRiskForHypothetical_Handler,
+		},
+		{
+			MethodName: "RiskInputs",
+			Handler:    _Query_RiskInputs_Handler,
+		},
+		{
+			MethodName: "SubaccountUtilization",
+			Handler:    _Query_SubaccountUtilization_Handler,
+		},
+		{
+			MethodName: "TotalValueLocked",
+			Handler:    _Query_TotalValueLocked_Handler,
+		},
+		{
+			MethodName: "SubaccountLiquidationPrices",
+			Handler:    _Query_SubaccountLiquidationPrices_Handler,
+		},
+		{
+			MethodName: "BasketShockToLiquidate",
+			Handler:    _Query_BasketShockToLiquidate_Handler,
+		},
+		{
+			MethodName: "TotalMaintenanceMargin",
+			Handler:    _Query_TotalMaintenanceMargin_Handler,
+		},
+		{
+			MethodName: "PositionNotional",
+			Handler:    _Query_PositionNotional_Handler,
+		},
+		{
+			MethodName: "MarketExponentMigrationImpact",
+			Handler:    _Query_MarketExponentMigrationImpact_Handler,
+		},
+		{
+			MethodName: "RiskAtHeight",
+			Handler:    _Query_RiskAtHeight_Handler,
+		},
+		{
+			MethodName: "HealthDistribution",
+			Handler:    _Query_HealthDistribution_Handler,
+		},
+		{
+			MethodName: "MarketNcSensitivity",
+			Handler:    _Query_MarketNcSensitivity_Handler,
+		},
+		{
+			MethodName: "WithdrawableCheck",
+			Handler:    _Query_WithdrawableCheck_Handler,
+		},
+		{
+			MethodName: "RealizedPnlOnClose",
+			Handler:    _Query_RealizedPnlOnClose_Handler,
+		},
+		{
+			MethodName: "ProtocolNetDelta",
+			Handler:    _Query_ProtocolNetDelta_Handler,
+		},
+		{
+			MethodName: "FundingHistory",
+			Handler:    _Query_FundingHistory_Handler,
+		},
+		{
+			MethodName: "ActionToRestoreInitialMargin",
+			Handler:    _Query_ActionToRestoreInitialMargin_Handler,
+		},
+		{
+			MethodName: "LossBufferToLiquidation",
+			Handler:    _Query_LossBufferToLiquidation_Handler,
+		},
+		{
+			MethodName: "MarketUnrealizedPnl",
+			Handler:    _Query_MarketUnrealizedPnl_Handler,
+		},
+		{
+			MethodName: "AutoWithdrawableAccounts",
+			Handler:    _Query_AutoWithdrawableAccounts_Handler,
+		},
+		{
+			MethodName: "CloseAllCost",
+			Handler:    _Query_CloseAllCost_Handler,
+		},
+		{
+			MethodName: "LiquidatableAccounts",
+			Handler:    _Query_LiquidatableAccounts_Handler,
+		},
+		{
+			MethodName: "BreakEvenPrice",
+			Handler:    _Query_BreakEvenPrice_Handler,
+		},
+		{
+			MethodName: "FlatteningSize",
+			Handler:    _Query_FlatteningSize_Handler,
+		},
+		{
+			MethodName: "LiquidationProceedsEstimate",
+			Handler:    _Query_LiquidationProceedsEstimate_Handler,
+		},
+		{
+			MethodName: "MarginDeltaForTierChange",
+			Handler:    _Query_MarginDeltaForTierChange_Handler,
+		},
+		{
+			MethodName: "RiskUnderHistoricalShocks",
+			Handler:    _Query_RiskUnderHistoricalShocks_Handler,
+		},
+		{
+			MethodName: "MinLiquidatingPriceMove",
+			Handler:    _Query_MinLiquidatingPriceMove_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dydxprotocol/subaccounts/query.proto",
+}
+
+func (m *QueryGetSubaccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSubaccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSubaccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubaccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubaccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubaccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Subaccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSubaccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSubaccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSubaccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubaccountAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubaccountAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubaccountAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subaccount) > 0 {
+		for iNdEx := len(m.Subaccount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subaccount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetWithdrawalAndTransfersBlockedInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetWithdrawalAndTransfersBlockedInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetWithdrawalAndTransfersBlockedInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetWithdrawalAndTransfersBlockedInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetWithdrawalAndTransfersBlockedInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetWithdrawalAndTransfersBlockedInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithdrawalsAndTransfersUnblockedAtBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WithdrawalsAndTransfersUnblockedAtBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChainOutageSeenAtBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainOutageSeenAtBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NegativeTncSubaccountSeenAtBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NegativeTncSubaccountSeenAtBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollateralPoolAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollateralPoolAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollateralPoolAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollateralPoolAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollateralPoolAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollateralPoolAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollateralPoolAddress) > 0 {
+		i -= len(m.CollateralPoolAddress)
+		copy(dAtA[i:], m.CollateralPoolAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralPoolAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HypotheticalAssetUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HypotheticalAssetUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HypotheticalAssetUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.QuantumsDelta.Size()
+		i -= size
+		if _, err := m.QuantumsDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.AssetId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HypotheticalPerpetualUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HypotheticalPerpetualUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HypotheticalPerpetualUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.QuoteBalanceDelta.Size()
+		i -= size
+		if _, err := m.QuoteBalanceDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.QuantumsDelta.Size()
+		i -= size
+		if _, err := m.QuantumsDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryComputeRiskForHypotheticalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryComputeRiskForHypotheticalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryComputeRiskForHypotheticalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PerpetualUpdates) > 0 {
+		for iNdEx := len(m.PerpetualUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PerpetualUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AssetUpdates) > 0 {
+		for iNdEx := len(m.AssetUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Subaccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryComputeRiskForHypotheticalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryComputeRiskForHypotheticalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryComputeRiskForHypotheticalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaintenanceMarginRequirement.Size()
+		i -= size
+		if _, err := m.MaintenanceMarginRequirement.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InitialMarginRequirement.Size()
+		i -= size
+		if _, err := m.InitialMarginRequirement.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.NetCollateral.Size()
+		i -= size
+		if _, err := m.NetCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRiskInputsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRiskInputsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRiskInputsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PerpetualRiskInputs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PerpetualRiskInputs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PerpetualRiskInputs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LiquidityTier.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.MarketPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Perpetual.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRiskInputsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRiskInputsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRiskInputsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockedCollateral != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LockedCollateral))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.ExternalCollateral.Size()
+		i -= size
+		if _, err := m.ExternalCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Perpetuals) > 0 {
+		for iNdEx := len(m.Perpetuals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Perpetuals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.SettledSubaccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubaccountUtilizationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubaccountUtilizationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubaccountUtilizationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubaccountUtilizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubaccountUtilizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubaccountUtilizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OverUtilized {
+		i--
+		if m.OverUtilized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UtilizationPpm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UtilizationPpm))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalValueLockedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalValueLockedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalValueLockedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalValueLockedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalValueLockedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalValueLockedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalNegativeNetCollateral.Size()
+		i -= size
+		if _, err := m.TotalNegativeNetCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TotalValueLocked.Size()
+		i -= size
+		if _, err := m.TotalValueLocked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionLiquidationPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionLiquidationPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionLiquidationPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasBankruptcyPrice {
+		i--
+		if m.HasBankruptcyPrice {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BankruptcyPrice != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BankruptcyPrice))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HasLiquidationPrice {
+		i--
+		if m.HasLiquidationPrice {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LiquidationPrice != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LiquidationPrice))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubaccountLiquidationPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubaccountLiquidationPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubaccountLiquidationPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubaccountLiquidationPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubaccountLiquidationPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubaccountLiquidationPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BasketWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BasketWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BasketWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WeightPpm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WeightPpm))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBasketShockToLiquidateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBasketShockToLiquidateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBasketShockToLiquidateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weights) > 0 {
+		for iNdEx := len(m.Weights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Weights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBasketShockToLiquidateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBasketShockToLiquidateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBasketShockToLiquidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaintenanceMarginRequirement.Size()
+		i -= size
+		if _, err := m.MaintenanceMarginRequirement.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.InitialMarginRequirement.Size()
+		i -= size
+		if _, err := m.InitialMarginRequirement.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.NetCollateral.Size()
+		i -= size
+		if _, err := m.NetCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ShockPpm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ShockPpm))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Found {
+		i--
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PerpetualMaintenanceMargin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PerpetualMaintenanceMargin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PerpetualMaintenanceMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaintenanceMargin.Size()
+		i -= size
+		if _, err := m.MaintenanceMargin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalMaintenanceMarginRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalMaintenanceMarginRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalMaintenanceMarginRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ByPerpetual {
+		i--
+		if m.ByPerpetual {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalMaintenanceMarginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalMaintenanceMarginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalMaintenanceMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Perpetuals) > 0 {
+		for iNdEx := len(m.Perpetuals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Perpetuals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionNotionalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionNotionalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionNotionalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionNotionalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionNotionalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionNotionalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Notional.Size()
+		i -= size
+		if _, err := m.Notional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketExponentMigrationImpactRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketExponentMigrationImpactRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketExponentMigrationImpactRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposedExponent != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposedExponent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketExponentMigrationImpactResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketExponentMigrationImpactResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketExponentMigrationImpactResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewlyLiquidatable) > 0 {
+		for iNdEx := len(m.NewlyLiquidatable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NewlyLiquidatable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.ProposedNotional.Size()
+		i -= size
+		if _, err := m.ProposedNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CurrentNotional.Size()
+		i -= size
+		if _, err := m.CurrentNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRiskAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRiskAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRiskAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRiskAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRiskAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRiskAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaintenanceMarginRequirement.Size()
+		i -= size
+		if _, err := m.MaintenanceMarginRequirement.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InitialMarginRequirement.Size()
+		i -= size
+		if _, err := m.InitialMarginRequirement.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.NetCollateral.Size()
+		i -= size
+		if _, err := m.NetCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHealthDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHealthDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHealthDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		dAtA10 := make([]byte, len(m.Buckets)*10)
+		var j9 int
+		for _, num := range m.Buckets {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintQuery(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHealthDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHealthDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHealthDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Counts) > 0 {
+		dAtA12 := make([]byte, len(m.Counts)*10)
+		var j11 int
+		for _, num := range m.Counts {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintQuery(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketNcSensitivityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketNcSensitivityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketNcSensitivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketNcSensitivityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketNcSensitivityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketNcSensitivityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NcPerPriceUnit) > 0 {
+		i -= len(m.NcPerPriceUnit)
+		copy(dAtA[i:], m.NcPerPriceUnit)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NcPerPriceUnit)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.NetQuantums.Size()
+		i -= size
+		if _, err := m.NetQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawableCheckRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawableCheckRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawableCheckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuoteQuantums != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QuoteQuantums))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawableCheckResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawableCheckResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawableCheckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxWithdrawable.Size()
+		i -= size
+		if _, err := m.MaxWithdrawable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRealizedPnlOnCloseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRealizedPnlOnCloseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRealizedPnlOnCloseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRealizedPnlOnCloseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRealizedPnlOnCloseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRealizedPnlOnCloseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Pnl.Size()
+		i -= size
+		if _, err := m.Pnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProtocolNetDeltaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtocolNetDeltaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtocolNetDeltaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProtocolNetDeltaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtocolNetDeltaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtocolNetDeltaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NetDelta.Size()
+		i -= size
+		if _, err := m.NetDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochFunding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochFunding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochFunding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FundingPaid.Size()
+		i -= size
+		if _, err := m.FundingPaid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundingHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundingHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InitialMarginRestoringClose) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InitialMarginRestoringClose) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InitialMarginRestoringClose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AbsNotional.Size()
+		i -= size
+		if _, err := m.AbsNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Quantums.Size()
+		i -= size
+		if _, err := m.Quantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActionToRestoreInitialMarginRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActionToRestoreInitialMarginRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActionToRestoreInitialMarginRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActionToRestoreInitialMarginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActionToRestoreInitialMarginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActionToRestoreInitialMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cheapest != nil {
+		{
+			size, err := m.Cheapest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Closes) > 0 {
+		for iNdEx := len(m.Closes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Closes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.InitiallyCollateralized {
+		i--
+		if m.InitiallyCollateralized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLossBufferToLiquidationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLossBufferToLiquidationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLossBufferToLiquidationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLossBufferToLiquidationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLossBufferToLiquidationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLossBufferToLiquidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PriceMove != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PriceMove))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.LossBuffer.Size()
+		i -= size
+		if _, err := m.LossBuffer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Found {
+		i--
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketUnrealizedPnlRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketUnrealizedPnlRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketUnrealizedPnlRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketUnrealizedPnlResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketUnrealizedPnlResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketUnrealizedPnlResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Pnl.Size()
+		i -= size
+		if _, err := m.Pnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoWithdrawableAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoWithdrawableAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoWithdrawableAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.QuoteQuantums.Size()
+		i -= size
+		if _, err := m.QuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoWithdrawableAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoWithdrawableAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoWithdrawableAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ThresholdQuoteQuantums != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ThresholdQuoteQuantums))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoWithdrawableAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoWithdrawableAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoWithdrawableAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCloseAllCostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCloseAllCostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCloseAllCostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCloseAllCostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCloseAllCostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCloseAllCostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PostCloseNetCollateral.Size()
+		i -= size
+		if _, err := m.PostCloseNetCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Fees.Size()
+		i -= size
+		if _, err := m.Fees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidatableAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidatableAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidatableAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidatableAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidatableAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidatableAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubaccountIds) > 0 {
+		for iNdEx := len(m.SubaccountIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubaccountIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBreakEvenPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBreakEvenPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBreakEvenPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBreakEvenPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBreakEvenPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBreakEvenPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFlatteningSizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFlatteningSizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFlatteningSizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFlatteningSizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFlatteningSizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFlatteningSizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AbsNotional.Size()
+		i -= size
+		if _, err := m.AbsNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Quantums.Size()
+		i -= size
+		if _, err := m.Quantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidationDepthLevel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidationDepthLevel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidationDepthLevel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Quantums != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Quantums))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Price != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidationProceedsEstimateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidationProceedsEstimateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidationProceedsEstimateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxLiquidationFeePpm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxLiquidationFeePpm))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Depth) > 0 {
+		for iNdEx := len(m.Depth) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Depth[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidationProceedsEstimateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidationProceedsEstimateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidationProceedsEstimateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InsuranceFundDeltaQuoteQuantums.Size()
+		i -= size
+		if _, err := m.InsuranceFundDeltaQuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ProceedsQuoteQuantums.Size()
+		i -= size
+		if _, err := m.ProceedsQuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.FilledQuantums.Size()
+		i -= size
+		if _, err := m.FilledQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarginDeltaForTierChangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarginDeltaForTierChangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarginDeltaForTierChangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProposedTier.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarginDeltaForTierChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarginDeltaForTierChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarginDeltaForTierChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaintenanceMarginRequirementDelta.Size()
+		i -= size
+		if _, err := m.MaintenanceMarginRequirementDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.InitialMarginRequirementDelta.Size()
+		i -= size
+		if _, err := m.InitialMarginRequirementDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoricalMove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalMove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalMove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MovePpm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MovePpm))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRiskUnderHistoricalShocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRiskUnderHistoricalShocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRiskUnderHistoricalShocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WorstMoves) > 0 {
+		for iNdEx := len(m.WorstMoves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WorstMoves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRiskUnderHistoricalShocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRiskUnderHistoricalShocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRiskUnderHistoricalShocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaintenanceMarginRequirement.Size()
+		i -= size
+		if _, err := m.MaintenanceMarginRequirement.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InitialMarginRequirement.Size()
+		i -= size
+		if _, err := m.InitialMarginRequirement.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.NetCollateral.Size()
+		i -= size
+		if _, err := m.NetCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinLiquidatingPriceMoveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinLiquidatingPriceMoveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinLiquidatingPriceMoveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinLiquidatingPriceMoveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinLiquidatingPriceMoveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinLiquidatingPriceMoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MovePpm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MovePpm))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Found {
+		i--
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetSubaccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *QuerySubaccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Subaccount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllSubaccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubaccountAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subaccount) > 0 {
+		for _, e := range m.Subaccount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetWithdrawalAndTransfersBlockedInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	return n
+}
+
+func (m *QueryGetWithdrawalAndTransfersBlockedInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NegativeTncSubaccountSeenAtBlock != 0 {
+		n += 1 + sovQuery(uint64(m.NegativeTncSubaccountSeenAtBlock))
+	}
+	if m.ChainOutageSeenAtBlock != 0 {
+		n += 1 + sovQuery(uint64(m.ChainOutageSeenAtBlock))
+	}
+	if m.WithdrawalsAndTransfersUnblockedAtBlock != 0 {
+		n += 1 + sovQuery(uint64(m.WithdrawalsAndTransfersUnblockedAtBlock))
+	}
+	return n
+}
+
+func (m *QueryCollateralPoolAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	return n
+}
+
+func (m *QueryCollateralPoolAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralPoolAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *HypotheticalAssetUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetId != 0 {
+		n += 1 + sovQuery(uint64(m.AssetId))
+	}
+	l = m.QuantumsDelta.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *HypotheticalPerpetualUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	l = m.QuantumsDelta.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.QuoteBalanceDelta.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryComputeRiskForHypotheticalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Subaccount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.AssetUpdates) > 0 {
+		for _, e := range m.AssetUpdates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PerpetualUpdates) > 0 {
+		for _, e := range m.PerpetualUpdates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryComputeRiskForHypotheticalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InitialMarginRequirement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaintenanceMarginRequirement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRiskInputsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *PerpetualRiskInputs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Perpetual.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MarketPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LiquidityTier.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRiskInputsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SettledSubaccount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Perpetuals) > 0 {
+		for _, e := range m.Perpetuals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.ExternalCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LockedCollateral != 0 {
+		n += 1 + sovQuery(uint64(m.LockedCollateral))
+	}
+	return n
+}
+
+func (m *QuerySubaccountUtilizationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *QuerySubaccountUtilizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UtilizationPpm != 0 {
+		n += 1 + sovQuery(uint64(m.UtilizationPpm))
+	}
+	if m.OverUtilized {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTotalValueLockedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalValueLockedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalValueLocked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalNegativeNetCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PositionLiquidationPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	if m.LiquidationPrice != 0 {
+		n += 1 + sovQuery(uint64(m.LiquidationPrice))
+	}
+	if m.HasLiquidationPrice {
+		n += 2
+	}
+	if m.BankruptcyPrice != 0 {
+		n += 1 + sovQuery(uint64(m.BankruptcyPrice))
+	}
+	if m.HasBankruptcyPrice {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySubaccountLiquidationPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *QuerySubaccountLiquidationPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BasketWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	if m.WeightPpm != 0 {
+		n += 1 + sovQuery(uint64(m.WeightPpm))
+	}
+	return n
+}
+
+func (m *QueryBasketShockToLiquidateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	if len(m.Weights) > 0 {
+		for _, e := range m.Weights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBasketShockToLiquidateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Found {
+		n += 2
+	}
+	if m.ShockPpm != 0 {
+		n += 1 + sovQuery(uint64(m.ShockPpm))
+	}
+	l = m.NetCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InitialMarginRequirement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaintenanceMarginRequirement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PerpetualMaintenanceMargin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	l = m.MaintenanceMargin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalMaintenanceMarginRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ByPerpetual {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTotalMaintenanceMarginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Perpetuals) > 0 {
+		for _, e := range m.Perpetuals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPositionNotionalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	return n
+}
+
+func (m *QueryPositionNotionalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Notional.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMarketExponentMigrationImpactRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	if m.ProposedExponent != 0 {
+		n += 1 + sovQuery(uint64(m.ProposedExponent))
+	}
+	return n
+}
+
+func (m *QueryMarketExponentMigrationImpactResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrentNotional.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProposedNotional.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.NewlyLiquidatable) > 0 {
+		for _, e := range m.NewlyLiquidatable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRiskAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryRiskAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InitialMarginRequirement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaintenanceMarginRequirement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHealthDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		l = 0
+		for _, e := range m.Buckets {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryHealthDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Counts) > 0 {
+		l = 0
+		for _, e := range m.Counts {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryMarketNcSensitivityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	return n
+}
+
+func (m *QueryMarketNcSensitivityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetQuantums.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.NcPerPriceUnit)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawableCheckRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	if m.QuoteQuantums != 0 {
+		n += 1 + sovQuery(uint64(m.QuoteQuantums))
+	}
+	return n
+}
+
+func (m *QueryWithdrawableCheckResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowed {
+		n += 2
+	}
+	l = m.MaxWithdrawable.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRealizedPnlOnCloseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	return n
+}
+
+func (m *QueryRealizedPnlOnCloseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pnl.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProtocolNetDeltaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	return n
+}
+
+func (m *QueryProtocolNetDeltaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetDelta.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EpochFunding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	l = m.FundingPaid.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFundingHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	if m.FromEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.ToEpoch))
+	}
+	return n
+}
+
+func (m *QueryFundingHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InitialMarginRestoringClose) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	l = m.Quantums.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AbsNotional.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryActionToRestoreInitialMarginRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *QueryActionToRestoreInitialMarginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InitiallyCollateralized {
+		n += 2
+	}
+	if len(m.Closes) > 0 {
+		for _, e := range m.Closes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Cheapest != nil {
+		l = m.Cheapest.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLossBufferToLiquidationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	return n
+}
+
+func (m *QueryLossBufferToLiquidationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Found {
+		n += 2
+	}
+	l = m.LossBuffer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PriceMove != 0 {
+		n += 1 + sovQuery(uint64(m.PriceMove))
+	}
+	return n
+}
+
+func (m *QueryMarketUnrealizedPnlRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	return n
+}
+
+func (m *QueryMarketUnrealizedPnlResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pnl.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *AutoWithdrawableAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.QuoteQuantums.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAutoWithdrawableAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ThresholdQuoteQuantums != 0 {
+		n += 1 + sovQuery(uint64(m.ThresholdQuoteQuantums))
+	}
+	return n
+}
+
+func (m *QueryAutoWithdrawableAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCloseAllCostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *QueryCloseAllCostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PostCloseNetCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidatableAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLiquidatableAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SubaccountIds) > 0 {
+		for _, e := range m.SubaccountIds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBreakEvenPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	return n
+}
+
+func (m *QueryBreakEvenPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFlatteningSizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	return n
+}
+
+func (m *QueryFlatteningSizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Quantums.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AbsNotional.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *LiquidationDepthLevel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Price != 0 {
+		n += 1 + sovQuery(uint64(m.Price))
+	}
+	if m.Quantums != 0 {
+		n += 1 + sovQuery(uint64(m.Quantums))
+	}
+	return n
+}
+
+func (m *QueryLiquidationProceedsEstimateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	if len(m.Depth) > 0 {
+		for _, e := range m.Depth {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MaxLiquidationFeePpm != 0 {
+		n += 1 + sovQuery(uint64(m.MaxLiquidationFeePpm))
+	}
+	return n
+}
+
+func (m *QueryLiquidationProceedsEstimateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FilledQuantums.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProceedsQuoteQuantums.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InsuranceFundDeltaQuoteQuantums.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMarginDeltaForTierChangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	l = m.ProposedTier.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMarginDeltaForTierChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InitialMarginRequirementDelta.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaintenanceMarginRequirementDelta.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *HistoricalMove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	if m.MovePpm != 0 {
+		n += 1 + sovQuery(uint64(m.MovePpm))
+	}
+	return n
+}
+
+func (m *QueryRiskUnderHistoricalShocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	if len(m.WorstMoves) > 0 {
+		for _, e := range m.WorstMoves {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRiskUnderHistoricalShocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InitialMarginRequirement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaintenanceMarginRequirement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMinLiquidatingPriceMoveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	return n
+}
+
+func (m *QueryMinLiquidatingPriceMoveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Found {
+		n += 2
+	}
+	if m.MovePpm != 0 {
+		n += 1 + sovQuery(uint64(m.MovePpm))
+	}
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSubaccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSubaccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubaccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubaccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubaccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subaccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Subaccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSubaccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSubaccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSubaccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubaccountAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubaccountAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubaccountAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subaccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subaccount = append(m.Subaccount, Subaccount{})
+			if err := m.Subaccount[len(m.Subaccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetWithdrawalAndTransfersBlockedInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetWithdrawalAndTransfersBlockedInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetWithdrawalAndTransfersBlockedInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetWithdrawalAndTransfersBlockedInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetWithdrawalAndTransfersBlockedInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetWithdrawalAndTransfersBlockedInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NegativeTncSubaccountSeenAtBlock", wireType)
+			}
+			m.NegativeTncSubaccountSeenAtBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NegativeTncSubaccountSeenAtBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainOutageSeenAtBlock", wireType)
+			}
+			m.ChainOutageSeenAtBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainOutageSeenAtBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalsAndTransfersUnblockedAtBlock", wireType)
+			}
+			m.WithdrawalsAndTransfersUnblockedAtBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalsAndTransfersUnblockedAtBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollateralPoolAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollateralPoolAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollateralPoolAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollateralPoolAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollateralPoolAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollateralPoolAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralPoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralPoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HypotheticalAssetUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HypotheticalAssetUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HypotheticalAssetUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			m.AssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantumsDelta", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuantumsDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HypotheticalPerpetualUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HypotheticalPerpetualUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HypotheticalPerpetualUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantumsDelta", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuantumsDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteBalanceDelta", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteBalanceDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryComputeRiskForHypotheticalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryComputeRiskForHypotheticalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryComputeRiskForHypotheticalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subaccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Subaccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetUpdates = append(m.AssetUpdates, HypotheticalAssetUpdate{})
+			if err := m.AssetUpdates[len(m.AssetUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PerpetualUpdates = append(m.PerpetualUpdates, HypotheticalPerpetualUpdate{})
+			if err := m.PerpetualUpdates[len(m.PerpetualUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryComputeRiskForHypotheticalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryComputeRiskForHypotheticalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryComputeRiskForHypotheticalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetCollateral", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginRequirement", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialMarginRequirement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRequirement", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMarginRequirement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRiskInputsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRiskInputsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRiskInputsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PerpetualRiskInputs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PerpetualRiskInputs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PerpetualRiskInputs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Perpetual", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Perpetual.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarketPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityTier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityTier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRiskInputsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRiskInputsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRiskInputsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledSubaccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SettledSubaccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Perpetuals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Perpetuals = append(m.Perpetuals, PerpetualRiskInputs{})
+			if err := m.Perpetuals[len(m.Perpetuals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalCollateral", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExternalCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedCollateral", wireType)
+			}
+			m.LockedCollateral = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockedCollateral |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubaccountUtilizationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubaccountUtilizationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubaccountUtilizationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubaccountUtilizationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubaccountUtilizationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubaccountUtilizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UtilizationPpm", wireType)
+			}
+			m.UtilizationPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UtilizationPpm |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverUtilized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OverUtilized = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalValueLockedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalValueLockedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalValueLockedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalValueLockedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalValueLockedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalValueLockedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValueLocked", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalValueLocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalNegativeNetCollateral", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalNegativeNetCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionLiquidationPrices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionLiquidationPrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionLiquidationPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPrice", wireType)
+			}
+			m.LiquidationPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidationPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasLiquidationPrice", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasLiquidationPrice = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankruptcyPrice", wireType)
+			}
+			m.BankruptcyPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BankruptcyPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasBankruptcyPrice", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasBankruptcyPrice = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubaccountLiquidationPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubaccountLiquidationPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubaccountLiquidationPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubaccountLiquidationPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubaccountLiquidationPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubaccountLiquidationPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, PositionLiquidationPrices{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BasketWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasketWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasketWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightPpm", wireType)
+			}
+			m.WeightPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeightPpm |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBasketShockToLiquidateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBasketShockToLiquidateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBasketShockToLiquidateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weights = append(m.Weights, BasketWeight{})
+			if err := m.Weights[len(m.Weights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBasketShockToLiquidateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBasketShockToLiquidateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBasketShockToLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Found = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShockPpm", wireType)
+			}
+			m.ShockPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShockPpm |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetCollateral", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginRequirement", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialMarginRequirement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRequirement", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMarginRequirement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PerpetualMaintenanceMargin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PerpetualMaintenanceMargin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PerpetualMaintenanceMargin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMargin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalMaintenanceMarginRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalMaintenanceMarginRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalMaintenanceMarginRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByPerpetual", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ByPerpetual = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalMaintenanceMarginResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalMaintenanceMarginResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalMaintenanceMarginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Perpetuals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Perpetuals = append(m.Perpetuals, PerpetualMaintenanceMargin{})
+			if err := m.Perpetuals[len(m.Perpetuals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionNotionalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionNotionalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionNotionalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionNotionalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionNotionalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionNotionalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notional", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Notional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketExponentMigrationImpactRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketExponentMigrationImpactRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketExponentMigrationImpactRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedExponent", wireType)
+			}
+			m.ProposedExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposedExponent |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketExponentMigrationImpactResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketExponentMigrationImpactResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketExponentMigrationImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentNotional", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedNotional", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposedNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewlyLiquidatable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewlyLiquidatable = append(m.NewlyLiquidatable, SubaccountId{})
+			if err := m.NewlyLiquidatable[len(m.NewlyLiquidatable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRiskAtHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRiskAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRiskAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRiskAtHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRiskAtHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRiskAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetCollateral", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginRequirement", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialMarginRequirement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRequirement", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMarginRequirement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHealthDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHealthDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHealthDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Buckets = append(m.Buckets, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Buckets) == 0 {
+					m.Buckets = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Buckets = append(m.Buckets, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHealthDistributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHealthDistributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHealthDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Counts = append(m.Counts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Counts) == 0 {
+					m.Counts = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Counts = append(m.Counts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketNcSensitivityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketNcSensitivityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketNcSensitivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketNcSensitivityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketNcSensitivityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketNcSensitivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetQuantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NcPerPriceUnit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NcPerPriceUnit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawableCheckRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawableCheckRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawableCheckRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteQuantums", wireType)
+			}
+			m.QuoteQuantums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuoteQuantums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawableCheckResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawableCheckResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawableCheckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWithdrawable", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxWithdrawable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRealizedPnlOnCloseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRealizedPnlOnCloseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRealizedPnlOnCloseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRealizedPnlOnCloseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRealizedPnlOnCloseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRealizedPnlOnCloseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pnl", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProtocolNetDeltaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProtocolNetDeltaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProtocolNetDeltaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProtocolNetDeltaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProtocolNetDeltaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProtocolNetDeltaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetDelta", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochFunding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochFunding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochFunding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPaid", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundingHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundingHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, EpochFunding{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InitialMarginRestoringClose) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InitialMarginRestoringClose: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InitialMarginRestoringClose: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbsNotional", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AbsNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActionToRestoreInitialMarginRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActionToRestoreInitialMarginRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActionToRestoreInitialMarginRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActionToRestoreInitialMarginResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActionToRestoreInitialMarginResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActionToRestoreInitialMarginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiallyCollateralized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InitiallyCollateralized = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Closes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Closes = append(m.Closes, InitialMarginRestoringClose{})
+			if err := m.Closes[len(m.Closes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cheapest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cheapest == nil {
+				m.Cheapest = &InitialMarginRestoringClose{}
+			}
+			if err := m.Cheapest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLossBufferToLiquidationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLossBufferToLiquidationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLossBufferToLiquidationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLossBufferToLiquidationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLossBufferToLiquidationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLossBufferToLiquidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Found = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossBuffer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LossBuffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceMove", wireType)
+			}
+			m.PriceMove = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceMove |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketUnrealizedPnlRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketUnrealizedPnlRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketUnrealizedPnlRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketUnrealizedPnlResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketUnrealizedPnlResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketUnrealizedPnlResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pnl", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoWithdrawableAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoWithdrawableAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoWithdrawableAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteQuantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoWithdrawableAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoWithdrawableAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoWithdrawableAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdQuoteQuantums", wireType)
+			}
+			m.ThresholdQuoteQuantums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThresholdQuoteQuantums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoWithdrawableAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoWithdrawableAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoWithdrawableAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, AutoWithdrawableAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCloseAllCostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCloseAllCostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCloseAllCostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCloseAllCostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCloseAllCostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCloseAllCostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostCloseNetCollateral", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PostCloseNetCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidatableAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidatableAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidatableAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidatableAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidatableAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidatableAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubaccountIds = append(m.SubaccountIds, SubaccountId{})
+			if err := m.SubaccountIds[len(m.SubaccountIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBreakEvenPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBreakEvenPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBreakEvenPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBreakEvenPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBreakEvenPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBreakEvenPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFlatteningSizeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFlatteningSizeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFlatteningSizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFlatteningSizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFlatteningSizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFlatteningSizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbsNotional", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AbsNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidationDepthLevel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidationDepthLevel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidationDepthLevel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantums", wireType)
+			}
+			m.Quantums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidationProceedsEstimateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidationProceedsEstimateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidationProceedsEstimateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depth = append(m.Depth, LiquidationDepthLevel{})
+			if err := m.Depth[len(m.Depth)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLiquidationFeePpm", wireType)
+			}
+			m.MaxLiquidationFeePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLiquidationFeePpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryGetWithdrawalAndTransfersBlockedInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.NegativeTncSubaccountSeenAtBlock != 0 {
-		n += 1 + sovQuery(uint64(m.NegativeTncSubaccountSeenAtBlock))
-	}
-	if m.ChainOutageSeenAtBlock != 0 {
-		n += 1 + sovQuery(uint64(m.ChainOutageSeenAtBlock))
-	}
-	if m.WithdrawalsAndTransfersUnblockedAtBlock != 0 {
-		n += 1 + sovQuery(uint64(m.WithdrawalsAndTransfersUnblockedAtBlock))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryCollateralPoolAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PerpetualId != 0 {
-		n += 1 + sovQuery(uint64(m.PerpetualId))
+func (m *QueryLiquidationProceedsEstimateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidationProceedsEstimateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidationProceedsEstimateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledQuantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FilledQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProceedsQuoteQuantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProceedsQuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundDeltaQuoteQuantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InsuranceFundDeltaQuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryCollateralPoolAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CollateralPoolAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMarginDeltaForTierChangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1134,10 +17306,10 @@ func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSubaccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMarginDeltaForTierChangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSubaccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMarginDeltaForTierChangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1191,6 +17363,58 @@ func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedTier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposedTier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1212,7 +17436,7 @@ func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySubaccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMarginDeltaForTierChangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1235,17 +17459,50 @@ func (m *QuerySubaccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySubaccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMarginDeltaForTierChangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySubaccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMarginDeltaForTierChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subaccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginRequirementDelta", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialMarginRequirementDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRequirementDelta", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1255,22 +17512,22 @@ func (m *QuerySubaccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Subaccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaintenanceMarginRequirementDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1295,7 +17552,7 @@ func (m *QuerySubaccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllSubaccountRequest) Unmarshal(dAtA []byte) error {
+func (m *HistoricalMove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1318,17 +17575,17 @@ func (m *QueryAllSubaccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllSubaccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: HistoricalMove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllSubaccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HistoricalMove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
 			}
-			var msglen int
+			m.PerpetualId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1338,28 +17595,30 @@ func (m *QueryAllSubaccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PerpetualId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovePpm", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MovePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MovePpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1381,7 +17640,7 @@ func (m *QueryAllSubaccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySubaccountAllResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRiskUnderHistoricalShocksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1404,17 +17663,17 @@ func (m *QuerySubaccountAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySubaccountAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRiskUnderHistoricalShocksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySubaccountAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRiskUnderHistoricalShocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subaccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1424,29 +17683,46 @@ func (m *QuerySubaccountAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subaccount = append(m.Subaccount, Subaccount{})
-			if err := m.Subaccount[len(m.Subaccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorstMoves", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1473,10 +17749,8 @@ func (m *QuerySubaccountAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorstMoves = append(m.WorstMoves, HistoricalMove{})
+			if err := m.WorstMoves[len(m.WorstMoves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1501,7 +17775,7 @@ func (m *QuerySubaccountAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetWithdrawalAndTransfersBlockedInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRiskUnderHistoricalShocksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1524,17 +17798,17 @@ func (m *QueryGetWithdrawalAndTransfersBlockedInfoRequest) Unmarshal(dAtA []byte
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetWithdrawalAndTransfersBlockedInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRiskUnderHistoricalShocksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetWithdrawalAndTransfersBlockedInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRiskUnderHistoricalShocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetCollateral", wireType)
 			}
-			m.PerpetualId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1544,66 +17818,30 @@ func (m *QueryGetWithdrawalAndTransfersBlockedInfoRequest) Unmarshal(dAtA []byte
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PerpetualId |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetWithdrawalAndTransfersBlockedInfoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.NetCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetWithdrawalAndTransfersBlockedInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetWithdrawalAndTransfersBlockedInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NegativeTncSubaccountSeenAtBlock", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginRequirement", wireType)
 			}
-			m.NegativeTncSubaccountSeenAtBlock = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1613,35 +17851,30 @@ func (m *QueryGetWithdrawalAndTransfersBlockedInfoResponse) Unmarshal(dAtA []byt
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NegativeTncSubaccountSeenAtBlock |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainOutageSeenAtBlock", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.ChainOutageSeenAtBlock = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainOutageSeenAtBlock |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialMarginRequirement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalsAndTransfersUnblockedAtBlock", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRequirement", wireType)
 			}
-			m.WithdrawalsAndTransfersUnblockedAtBlock = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1651,11 +17884,25 @@ func (m *QueryGetWithdrawalAndTransfersBlockedInfoResponse) Unmarshal(dAtA []byt
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WithdrawalsAndTransfersUnblockedAtBlock |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMarginRequirement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1677,7 +17924,7 @@ func (m *QueryGetWithdrawalAndTransfersBlockedInfoResponse) Unmarshal(dAtA []byt
 	}
 	return nil
 }
-func (m *QueryCollateralPoolAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMinLiquidatingPriceMoveRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1700,10 +17947,10 @@ func (m *QueryCollateralPoolAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCollateralPoolAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMinLiquidatingPriceMoveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCollateralPoolAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMinLiquidatingPriceMoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1746,7 +17993,7 @@ func (m *QueryCollateralPoolAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCollateralPoolAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMinLiquidatingPriceMoveResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1769,17 +18016,56 @@ func (m *QueryCollateralPoolAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCollateralPoolAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMinLiquidatingPriceMoveResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCollateralPoolAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMinLiquidatingPriceMoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Found = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovePpm", wireType)
+			}
+			m.MovePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MovePpm |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralPoolAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1789,23 +18075,24 @@ func (m *QueryCollateralPoolAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralPoolAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
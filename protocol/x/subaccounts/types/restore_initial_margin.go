@@ -0,0 +1,26 @@
+package types
+
+import (
+	"math/big"
+)
+
+// PositionClose is a partial or full close of a perpetual position at the market price of the perpetual.
+type PositionClose struct {
+	PerpetualId uint32
+	// The absolute number of base quantums of the position to close.
+	Quantums *big.Int
+	// The absolute notional of the closed quantums in quote quantums.
+	AbsNotional *big.Int
+}
+
+// RestoreInitialMarginAction describes the closes of perpetual positions that each make a subaccount
+// initially collateralized on their own.
+type RestoreInitialMarginAction struct {
+	// Whether the subaccount is already initially collateralized, in which case no closes are needed.
+	InitiallyCollateralized bool
+	// The smallest close of each position that restores the initial margin of the subaccount, in ascending
+	// order of perpetual id. Positions that do not restore it even when fully closed are omitted.
+	Closes []PositionClose
+	// The close in `Closes` with the smallest notional, or nil if there are no closes.
+	Cheapest *PositionClose
+}
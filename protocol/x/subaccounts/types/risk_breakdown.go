@@ -0,0 +1,25 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+)
+
+// RiskBreakdown is the risk of a subaccount broken down by position.
+type RiskBreakdown struct {
+	// The total risk of the subaccount. This is equal to the risk returned by `GetRiskForSubaccount`.
+	Total margin.Risk
+	// The risk of each asset position, keyed by asset id.
+	AssetPositions map[uint32]margin.Risk
+	// The risk of each perpetual position, keyed by perpetual id.
+	PerpetualPositions map[uint32]PerpetualPositionRisk
+}
+
+// PerpetualPositionRisk is the risk of a single perpetual position.
+type PerpetualPositionRisk struct {
+	// The risk of the position. The net collateral includes the quote balance of the position.
+	Risk margin.Risk
+	// The absolute value of the notional of the position in quote quantums.
+	AbsNotional *big.Int
+}
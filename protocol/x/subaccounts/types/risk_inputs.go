@@ -0,0 +1,25 @@
+package types
+
+import (
+	"math/big"
+
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+)
+
+// RiskInputs is a snapshot of every input consumed when computing the risk of a subaccount.
+// Passing `SettledSubaccount` and `PerpInfos` to `GetRiskForSubaccount`, then adding
+// `ExternalCollateral` to and subtracting `LockedCollateral` from the net collateral, reproduces
+// the subaccount's risk exactly.
+type RiskInputs struct {
+	// The subaccount in its settled form, i.e. with all outstanding funding applied
+	// to the USDC asset position.
+	SettledSubaccount Subaccount
+	// The perpetual, market price and liquidity tier of every perpetual the subaccount
+	// holds a position in.
+	PerpInfos perptypes.PerpInfos
+	// The collateral provided by sources outside of the subaccount, such as staked tokens,
+	// in quote quantums. Zero if external collateral is disabled.
+	ExternalCollateral *big.Int
+	// The collateral of the subaccount locked by pending withdrawals, in quote quantums.
+	LockedCollateral uint64
+}
@@ -1,13 +1,18 @@
 package types
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
 	"math/big"
+	"sort"
 
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 )
 
 const (
@@ -47,6 +52,104 @@ func (m *SubaccountId) Validate() error {
 	return nil
 }
 
+// Validate returns an error if the subaccount id is invalid or if the asset positions of the
+// subaccount are invalid.
+func (m *Subaccount) Validate() error {
+	if m.Id == nil {
+		return errorsmod.Wrap(ErrInvalidSubaccountIdOwner, "subaccount id is nil")
+	}
+	if err := m.Id.Validate(); err != nil {
+		return err
+	}
+	return m.ValidateAssetPositions()
+}
+
+// ValidateAssetPositions returns an error if the subaccount has more than one asset position for the
+// same asset, which would otherwise double-count the collateral of the asset.
+func (m *Subaccount) ValidateAssetPositions() error {
+	assetIds := make(map[uint32]struct{}, len(m.AssetPositions))
+	for _, position := range m.AssetPositions {
+		if _, ok := assetIds[position.AssetId]; ok {
+			return errorsmod.Wrapf(
+				ErrDuplicateAssetPositions,
+				"subaccount id: %v, asset id: %d",
+				m.Id,
+				position.AssetId,
+			)
+		}
+		assetIds[position.AssetId] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateFull checks the subaccount against all invariants of a subaccount in state and returns an
+// error joining every violation found, or nil if there are none. The invariants are:
+//   - the subaccount id is valid,
+//   - asset and perpetual positions are sorted by id, without duplicate ids,
+//   - no position has zero quantums,
+//   - every perpetual position references a perpetual in `perpInfos`, and
+//   - the maintenance margin requirement of every perpetual position does not exceed its initial margin
+//     requirement, that is the maintenance fraction of the liquidity tier for the side of the position is
+//     at most 100%.
+//
+// Unlike `Validate`, this also checks positions against the perpetuals they reference. It is intended
+// for fuzzing and for verifying state migrations.
+func (m *Subaccount) ValidateFull(perpInfos perptypes.PerpInfos) error {
+	var errs []error
+	if m.Id == nil {
+		errs = append(errs, errorsmod.Wrap(ErrInvalidSubaccountIdOwner, "subaccount id is nil"))
+	} else if err := m.Id.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	assetIds := make(map[uint32]struct{}, len(m.AssetPositions))
+	for i, position := range m.AssetPositions {
+		if i > 0 && position.AssetId < m.AssetPositions[i-1].AssetId {
+			errs = append(errs, errorsmod.Wrapf(ErrAssetPositionsOutOfOrder, "asset id: %d", position.AssetId))
+		}
+		if _, ok := assetIds[position.AssetId]; ok {
+			errs = append(errs, errorsmod.Wrapf(ErrDuplicateAssetPositions, "asset id: %d", position.AssetId))
+		}
+		assetIds[position.AssetId] = struct{}{}
+		if position.GetBigQuantums().Sign() == 0 {
+			errs = append(errs, errorsmod.Wrapf(ErrAssetPositionZeroQuantum, "asset id: %d", position.AssetId))
+		}
+	}
+
+	perpetualIds := make(map[uint32]struct{}, len(m.PerpetualPositions))
+	for i, position := range m.PerpetualPositions {
+		perpetualId := position.PerpetualId
+		if i > 0 && perpetualId < m.PerpetualPositions[i-1].PerpetualId {
+			errs = append(errs, errorsmod.Wrapf(ErrPerpPositionsOutOfOrder, "perpetual id: %d", perpetualId))
+		}
+		if _, ok := perpetualIds[perpetualId]; ok {
+			errs = append(errs, errorsmod.Wrapf(ErrDuplicatePosition, "perpetual id: %d", perpetualId))
+		}
+		perpetualIds[perpetualId] = struct{}{}
+		quantums := position.GetBigQuantums()
+		if quantums.Sign() == 0 {
+			errs = append(errs, errorsmod.Wrapf(ErrPerpPositionZeroQuantum, "perpetual id: %d", perpetualId))
+		}
+
+		perpInfo, ok := perpInfos[perpetualId]
+		if !ok {
+			errs = append(errs, errorsmod.Wrapf(ErrPerpetualInfoNotFound, "perpetual id: %d", perpetualId))
+			continue
+		}
+		liquidityTier := perpInfo.LiquidityTier.ForPositionSide(quantums.Sign() >= 0)
+		if liquidityTier.MaintenanceFractionPpm > perptypes.MaxMaintenanceFractionPpm {
+			errs = append(errs, errorsmod.Wrapf(
+				ErrMaintenanceMarginExceedsInitial,
+				"perpetual id: %d, maintenance fraction ppm: %d",
+				perpetualId,
+				liquidityTier.MaintenanceFractionPpm,
+			))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func (m *SubaccountId) MustGetAccAddress() sdk.AccAddress {
 	return sdk.MustAccAddressFromBech32(m.Owner)
 }
@@ -63,6 +166,46 @@ func (m *Subaccount) DeepCopy() Subaccount {
 	return newSubaccount
 }
 
+// StateHash returns the SHA-256 hash of the proto encoding of the subaccount. The encoding is deterministic,
+// so subaccounts with equal state have equal hashes.
+func (m *Subaccount) StateHash() [sha256.Size]byte {
+	b, err := m.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return sha256.Sum256(b)
+}
+
+// SortedSubaccounts is type alias for `[]Subaccount` which supports deterministic sorting.
+// Subaccounts are ordered by id as by `SortedSubaccountIds`, followed by byte comparison of their
+// `StateHash`, so that subaccounts with colliding ids but different state still have a total order.
+type SortedSubaccounts []Subaccount
+
+// The below methods are required to implement `sort.Interface` for sorting using the sort package.
+var _ sort.Interface = SortedSubaccounts{}
+
+func (s SortedSubaccounts) Len() int {
+	return len(s)
+}
+
+func (s SortedSubaccounts) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s SortedSubaccounts) Less(i, j int) bool {
+	ids := SortedSubaccountIds{*s[i].Id, *s[j].Id}
+	if ids.Less(0, 1) {
+		return true
+	}
+	if ids.Less(1, 0) {
+		return false
+	}
+
+	hi := s[i].StateHash()
+	hj := s[j].StateHash()
+	return bytes.Compare(hi[:], hj[:]) < 0
+}
+
 // GetPerpetualPositionForId returns the perpetual position with the given
 // perpetual id. Returns nil if subaccount does not have an open position
 // for the perpetual.
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+const (
+	// PerpetualPositionVersion1 is the version of the perpetual positions in subaccount records written
+	// before records were versioned.
+	PerpetualPositionVersion1 uint32 = 1
+	// PerpetualPositionVersion2 adds the cost basis of the position.
+	PerpetualPositionVersion2 uint32 = 2
+
+	// CurrentPerpetualPositionVersion is the version of the perpetual positions in subaccount records
+	// written to state.
+	CurrentPerpetualPositionVersion = PerpetualPositionVersion2
+)
+
+// versionedSubaccountRecordMarker is the first byte of a versioned subaccount record, and is followed
+// by the uvarint version of the record and the protobuf encoding of the subaccount. An encoded protobuf
+// message never starts with a zero byte since zero is not a valid field number, so records without
+// the marker are unversioned records written before records were versioned.
+const versionedSubaccountRecordMarker byte = 0x00
+
+// perpetualPositionUpgrades maps each version of the perpetual positions in subaccount records to the
+// function that upgrades a position from that version to the next. When adding a field to
+// `PerpetualPosition` whose zero value is not a sane default for existing positions, bump
+// `CurrentPerpetualPositionVersion` and register an upgrade from the previous version here.
+//
+// Upgrades are applied in memory when a record is decoded, so they must be deterministic and must only
+// depend on the position itself.
+var perpetualPositionUpgrades = map[uint32]func(position *PerpetualPosition){
+	// Positions written before cost basis tracking decode with a zero cost basis, which marks the cost
+	// basis as unknown.
+	PerpetualPositionVersion1: func(position *PerpetualPosition) {},
+}
+
+// EncodeSubaccountRecord returns the versioned encoding of the subaccount for storing in state.
+func EncodeSubaccountRecord(cdc codec.BinaryCodec, subaccount Subaccount) []byte {
+	b := []byte{versionedSubaccountRecordMarker}
+	b = binary.AppendUvarint(b, uint64(CurrentPerpetualPositionVersion))
+	return append(b, cdc.MustMarshal(&subaccount)...)
+}
+
+// DecodeSubaccountRecord decodes a subaccount record from state and upgrades its perpetual positions
+// to `CurrentPerpetualPositionVersion`. Returns the version the record was encoded with, so that
+// callers can persist upgraded records.
+func DecodeSubaccountRecord(
+	cdc codec.BinaryCodec,
+	b []byte,
+) (
+	subaccount Subaccount,
+	version uint32,
+	err error,
+) {
+	version = PerpetualPositionVersion1
+	if len(b) > 0 && b[0] == versionedSubaccountRecordMarker {
+		v, n := binary.Uvarint(b[1:])
+		if n <= 0 {
+			return Subaccount{}, 0, errorsmod.Wrap(ErrInvalidSubaccountRecord, "invalid version")
+		}
+		if v < uint64(PerpetualPositionVersion1) || v > uint64(CurrentPerpetualPositionVersion) {
+			return Subaccount{}, 0, errorsmod.Wrapf(ErrUnsupportedSubaccountRecordVersion, "version: %d", v)
+		}
+		version = uint32(v)
+		b = b[1+n:]
+	}
+
+	if err := cdc.Unmarshal(b, &subaccount); err != nil {
+		return Subaccount{}, 0, errorsmod.Wrap(ErrInvalidSubaccountRecord, err.Error())
+	}
+
+	for v := version; v < CurrentPerpetualPositionVersion; v++ {
+		upgrade, ok := perpetualPositionUpgrades[v]
+		if !ok {
+			return Subaccount{}, 0, errorsmod.Wrapf(ErrUnsupportedSubaccountRecordVersion, "no upgrade from version: %d", v)
+		}
+		for _, position := range subaccount.PerpetualPositions {
+			upgrade(position)
+		}
+	}
+	return subaccount, version, nil
+}
+
+// MustDecodeSubaccountRecord is like `DecodeSubaccountRecord`, but panics on error.
+func MustDecodeSubaccountRecord(cdc codec.BinaryCodec, b []byte) Subaccount {
+	subaccount, _, err := DecodeSubaccountRecord(cdc, b)
+	if err != nil {
+		panic(err)
+	}
+	return subaccount
+}
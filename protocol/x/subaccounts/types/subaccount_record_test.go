@@ -0,0 +1,92 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecodeSubaccountRecord(t *testing.T) {
+	cdc := constants.TestEncodingCfg.Codec
+	position := testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(0), big.NewInt(0))
+	position.CostBasis = dtypes.NewInt(50_000_000_000)
+	subaccount := types.Subaccount{
+		Id:                 &constants.Alice_Num0,
+		AssetPositions:     testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)),
+		PerpetualPositions: []*types.PerpetualPosition{position},
+	}
+
+	b := types.EncodeSubaccountRecord(cdc, subaccount)
+	decoded, version, err := types.DecodeSubaccountRecord(cdc, b)
+	require.NoError(t, err)
+	require.Equal(t, types.CurrentPerpetualPositionVersion, version)
+	require.Equal(t, subaccount, decoded)
+}
+
+func TestDecodeSubaccountRecord_Version1(t *testing.T) {
+	cdc := constants.TestEncodingCfg.Codec
+	// A record written before records were versioned is the protobuf encoding of the subaccount, with
+	// no cost basis on its positions.
+	subaccount := types.Subaccount{
+		Id:             &constants.Alice_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(10_000_000_000)),
+		PerpetualPositions: []*types.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(0, big.NewInt(100_000_000), big.NewInt(7), big.NewInt(0)),
+		},
+	}
+
+	decoded, version, err := types.DecodeSubaccountRecord(cdc, cdc.MustMarshal(&subaccount))
+	require.NoError(t, err)
+	require.Equal(t, types.PerpetualPositionVersion1, version)
+	require.Equal(t, subaccount, decoded)
+	// The unknown cost basis of the position defaults to zero.
+	require.Len(t, decoded.PerpetualPositions, 1)
+	require.Equal(t, big.NewInt(0), decoded.PerpetualPositions[0].GetBigCostBasis())
+	require.Equal(t, big.NewInt(100_000_000), decoded.PerpetualPositions[0].GetBigQuantums())
+	require.Equal(t, big.NewInt(7), decoded.PerpetualPositions[0].FundingIndex.BigInt())
+
+	// Re-encoding the decoded record writes the current version.
+	_, version, err = types.DecodeSubaccountRecord(cdc, types.EncodeSubaccountRecord(cdc, decoded))
+	require.NoError(t, err)
+	require.Equal(t, types.CurrentPerpetualPositionVersion, version)
+}
+
+func TestDecodeSubaccountRecord_Errors(t *testing.T) {
+	cdc := constants.TestEncodingCfg.Codec
+	tests := map[string]struct {
+		record      []byte
+		expectedErr error
+	}{
+		"missing version": {
+			record:      []byte{0x00},
+			expectedErr: types.ErrInvalidSubaccountRecord,
+		},
+		"version zero": {
+			record:      []byte{0x00, 0x00},
+			expectedErr: types.ErrUnsupportedSubaccountRecordVersion,
+		},
+		"version newer than the current version": {
+			record:      []byte{0x00, byte(types.CurrentPerpetualPositionVersion + 1)},
+			expectedErr: types.ErrUnsupportedSubaccountRecordVersion,
+		},
+		"invalid protobuf": {
+			record:      []byte{0x00, byte(types.CurrentPerpetualPositionVersion), 0xff},
+			expectedErr: types.ErrInvalidSubaccountRecord,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := types.DecodeSubaccountRecord(cdc, tc.record)
+			require.ErrorIs(t, err, tc.expectedErr)
+			require.Panics(t, func() {
+				types.MustDecodeSubaccountRecord(cdc, tc.record)
+			})
+		})
+	}
+}
@@ -1,14 +1,19 @@
 package types_test
 
 import (
+	"errors"
 	"math"
 	"math/big"
+	"sort"
 	"testing"
 
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	perp_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/sample"
 	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
 	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	"github.com/stretchr/testify/require"
 )
@@ -123,6 +128,226 @@ func TestSubaccount_DeepCopy(t *testing.T) {
 	require.NotSame(t, &subaccount, &deepCopy)
 }
 
+func TestSubaccount_Validate(t *testing.T) {
+	usdcPosition := &types.AssetPosition{
+		AssetId:  assettypes.AssetUsdc.Id,
+		Quantums: dtypes.NewInt(1_000_000),
+	}
+	tests := map[string]struct {
+		subaccount    types.Subaccount
+		expectedError error
+	}{
+		"validates successfully": {
+			subaccount: constants.Alice_Num1_1BTC_Long_500_000USD,
+		},
+		"validates successfully with assets of different ids": {
+			subaccount: types.Subaccount{
+				Id: &constants.Alice_Num0,
+				AssetPositions: []*types.AssetPosition{
+					usdcPosition,
+					{AssetId: 1, Quantums: dtypes.NewInt(1_000)},
+				},
+			},
+		},
+		"nil id": {
+			subaccount:    types.Subaccount{},
+			expectedError: types.ErrInvalidSubaccountIdOwner,
+		},
+		"invalid id": {
+			subaccount: types.Subaccount{
+				Id: &types.SubaccountId{Owner: sample.AccAddress(), Number: 128_001},
+			},
+			expectedError: types.ErrInvalidSubaccountIdNumber,
+		},
+		"duplicate USDC asset positions": {
+			subaccount: types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: []*types.AssetPosition{usdcPosition, usdcPosition},
+			},
+			expectedError: types.ErrDuplicateAssetPositions,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.subaccount.Validate()
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+func TestSubaccount_ValidateFull(t *testing.T) {
+	usdcPosition := &types.AssetPosition{
+		AssetId:  assettypes.AssetUsdc.Id,
+		Quantums: dtypes.NewInt(1_000_000),
+	}
+	otherAssetPosition := &types.AssetPosition{AssetId: 1, Quantums: dtypes.NewInt(1_000)}
+	longPosition := func(perpetualId uint32) *types.PerpetualPosition {
+		return testutil.CreateSinglePerpetualPosition(perpetualId, big.NewInt(100), big.NewInt(0), big.NewInt(0))
+	}
+	shortPosition := testutil.CreateSinglePerpetualPosition(1, big.NewInt(-100), big.NewInt(0), big.NewInt(0))
+	zeroPosition := testutil.CreateSinglePerpetualPosition(1, big.NewInt(0), big.NewInt(0), big.NewInt(0))
+
+	// Short positions in perpetual 1 have a maintenance margin requirement of 110% of their initial margin
+	// requirement.
+	perpInfos := perptypes.PerpInfos{
+		0: perp_testutil.CreatePerpInfo(0, -6, 100, 0),
+		1: perp_testutil.CreatePerpInfo(1, -6, 100, 0),
+	}
+	invalidTierInfo := perpInfos[1]
+	invalidTierInfo.LiquidityTier.ShortMaintenanceFractionPpm = 1_100_000
+	perpInfos[1] = invalidTierInfo
+
+	tests := map[string]struct {
+		subaccount types.Subaccount
+
+		expectedErrors []error
+	}{
+		"valid subaccount": {
+			subaccount: types.Subaccount{
+				Id:                 &constants.Alice_Num0,
+				AssetPositions:     []*types.AssetPosition{usdcPosition, otherAssetPosition},
+				PerpetualPositions: []*types.PerpetualPosition{longPosition(0), longPosition(1)},
+			},
+		},
+		"nil id": {
+			subaccount:     types.Subaccount{},
+			expectedErrors: []error{types.ErrInvalidSubaccountIdOwner},
+		},
+		"asset positions out of order": {
+			subaccount: types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: []*types.AssetPosition{otherAssetPosition, usdcPosition},
+			},
+			expectedErrors: []error{types.ErrAssetPositionsOutOfOrder},
+		},
+		"duplicate asset positions": {
+			subaccount: types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: []*types.AssetPosition{usdcPosition, usdcPosition},
+			},
+			expectedErrors: []error{types.ErrDuplicateAssetPositions},
+		},
+		"asset position with zero quantums": {
+			subaccount: types.Subaccount{
+				Id: &constants.Alice_Num0,
+				AssetPositions: []*types.AssetPosition{
+					{AssetId: assettypes.AssetUsdc.Id, Quantums: dtypes.NewInt(0)},
+				},
+			},
+			expectedErrors: []error{types.ErrAssetPositionZeroQuantum},
+		},
+		"perpetual positions out of order": {
+			subaccount: types.Subaccount{
+				Id:                 &constants.Alice_Num0,
+				PerpetualPositions: []*types.PerpetualPosition{longPosition(1), longPosition(0)},
+			},
+			expectedErrors: []error{types.ErrPerpPositionsOutOfOrder},
+		},
+		"duplicate perpetual positions": {
+			subaccount: types.Subaccount{
+				Id:                 &constants.Alice_Num0,
+				PerpetualPositions: []*types.PerpetualPosition{longPosition(0), longPosition(0)},
+			},
+			expectedErrors: []error{types.ErrDuplicatePosition},
+		},
+		"perpetual position with zero quantums": {
+			subaccount: types.Subaccount{
+				Id:                 &constants.Alice_Num0,
+				PerpetualPositions: []*types.PerpetualPosition{zeroPosition},
+			},
+			expectedErrors: []error{types.ErrPerpPositionZeroQuantum},
+		},
+		"perpetual does not exist": {
+			subaccount: types.Subaccount{
+				Id:                 &constants.Alice_Num0,
+				PerpetualPositions: []*types.PerpetualPosition{longPosition(2)},
+			},
+			expectedErrors: []error{types.ErrPerpetualInfoNotFound},
+		},
+		"maintenance margin requirement exceeds initial margin requirement": {
+			subaccount: types.Subaccount{
+				Id:                 &constants.Alice_Num0,
+				PerpetualPositions: []*types.PerpetualPosition{shortPosition},
+			},
+			expectedErrors: []error{types.ErrMaintenanceMarginExceedsInitial},
+		},
+		"all violations are returned": {
+			subaccount: types.Subaccount{
+				Id:                 &types.SubaccountId{Owner: sample.AccAddress(), Number: 128_001},
+				AssetPositions:     []*types.AssetPosition{usdcPosition, usdcPosition},
+				PerpetualPositions: []*types.PerpetualPosition{shortPosition, longPosition(0), longPosition(2)},
+			},
+			expectedErrors: []error{
+				types.ErrInvalidSubaccountIdNumber,
+				types.ErrDuplicateAssetPositions,
+				types.ErrMaintenanceMarginExceedsInitial,
+				types.ErrPerpPositionsOutOfOrder,
+				types.ErrPerpetualInfoNotFound,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.subaccount.ValidateFull(perpInfos)
+			if len(tc.expectedErrors) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			for _, expectedError := range tc.expectedErrors {
+				require.ErrorIs(t, err, expectedError)
+			}
+			var joined interface{ Unwrap() []error }
+			require.True(t, errors.As(err, &joined))
+			require.Len(t, joined.Unwrap(), len(tc.expectedErrors))
+		})
+	}
+}
+
+func TestSortedSubaccounts(t *testing.T) {
+	// Two subaccounts with colliding ids but different state, and a subaccount with a greater id.
+	collidingA := types.Subaccount{
+		Id:             &constants.Alice_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(1_000)),
+	}
+	collidingB := types.Subaccount{
+		Id:             &constants.Alice_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(2_000)),
+	}
+	other := types.Subaccount{
+		Id:             &constants.Alice_Num1,
+		AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(0)),
+	}
+	require.NotEqual(t, collidingA.StateHash(), collidingB.StateHash())
+	copied := collidingA.DeepCopy()
+	require.Equal(t, collidingA.StateHash(), copied.StateHash())
+
+	// Exactly one of the colliding subaccounts is ordered first.
+	colliding := types.SortedSubaccounts{collidingA, collidingB}
+	require.NotEqual(t, colliding.Less(0, 1), colliding.Less(1, 0))
+	require.False(t, colliding.Less(0, 0))
+
+	// The selection is stable regardless of the input order, and ids take precedence over state.
+	var expected types.SortedSubaccounts
+	for _, subaccounts := range []types.SortedSubaccounts{
+		{collidingA, collidingB, other},
+		{collidingB, collidingA, other},
+		{other, collidingB, collidingA},
+		{other, collidingA, collidingB},
+	} {
+		sort.Sort(subaccounts)
+		if expected == nil {
+			expected = subaccounts
+		}
+		require.Equal(t, expected, subaccounts)
+		require.Equal(t, other, subaccounts[2])
+	}
+}
+
 func TestSubaccount_GetPerpetualPositionForId(t *testing.T) {
 	expectedPerpetualPositions := []*types.PerpetualPosition{
 		testutil.CreateSinglePerpetualPosition(
@@ -0,0 +1,2607 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dydxprotocol/subaccounts/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_dydxprotocol_v4_chain_protocol_dtypes "github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The parameters to update. Each field must be set.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16edbf88307684e5, []int{0}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse is the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16edbf88307684e5, []int{1}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgSetMaxLeverage sets the self-imposed leverage cap of a subaccount.
+type MsgSetMaxLeverage struct {
+	// The subaccount to set the leverage cap of.
+	SubaccountId SubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+	// The leverage cap, in parts-per-million. Zero removes the cap.
+	MaxLeveragePpm uint32 `protobuf:"varint,2,opt,name=max_leverage_ppm,json=maxLeveragePpm,proto3" json:"max_leverage_ppm,omitempty"`
+}
+
+func (m *MsgSetMaxLeverage) Reset()         { *m = MsgSetMaxLeverage{} }
+func (m *MsgSetMaxLeverage) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMaxLeverage) ProtoMessage()    {}
+func (*MsgSetMaxLeverage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16edbf88307684e5, []int{2}
+}
+func (m *MsgSetMaxLeverage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMaxLeverage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMaxLeverage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMaxLeverage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMaxLeverage.Merge(m, src)
+}
+func (m *MsgSetMaxLeverage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMaxLeverage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMaxLeverage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMaxLeverage proto.InternalMessageInfo
+
+func (m *MsgSetMaxLeverage) GetSubaccountId() SubaccountId {
+	if m != nil {
+		return m.SubaccountId
+	}
+	return SubaccountId{}
+}
+
+func (m *MsgSetMaxLeverage) GetMaxLeveragePpm() uint32 {
+	if m != nil {
+		return m.MaxLeveragePpm
+	}
+	return 0
+}
+
+// MsgSetMaxLeverageResponse is the Msg/SetMaxLeverage response type.
+type MsgSetMaxLeverageResponse struct {
+}
+
+func (m *MsgSetMaxLeverageResponse) Reset()         { *m = MsgSetMaxLeverageResponse{} }
+func (m *MsgSetMaxLeverageResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMaxLeverageResponse) ProtoMessage()    {}
+func (*MsgSetMaxLeverageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16edbf88307684e5, []int{3}
+}
+func (m *MsgSetMaxLeverageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMaxLeverageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMaxLeverageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMaxLeverageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMaxLeverageResponse.Merge(m, src)
+}
+func (m *MsgSetMaxLeverageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMaxLeverageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMaxLeverageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMaxLeverageResponse proto.InternalMessageInfo
+
+// MsgSetMarginMode switches a subaccount between cross and isolated margin.
+type MsgSetMarginMode struct {
+	// The subaccount to switch the margin mode of.
+	SubaccountId SubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+	// The margin mode to switch to: 0 for cross margin and 1 for isolated
+	// margin.
+	MarginMode uint32 `protobuf:"varint,2,opt,name=margin_mode,json=marginMode,proto3" json:"margin_mode,omitempty"`
+}
+
+func (m *MsgSetMarginMode) Reset()         { *m = MsgSetMarginMode{} }
+func (m *MsgSetMarginMode) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMarginMode) ProtoMessage()    {}
+func (*MsgSetMarginMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16edbf88307684e5, []int{4}
+}
+func (m *MsgSetMarginMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMarginMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMarginMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMarginMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMarginMode.Merge(m, src)
+}
+func (m *MsgSetMarginMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMarginMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMarginMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMarginMode proto.InternalMessageInfo
+
+func (m *MsgSetMarginMode) GetSubaccountId() SubaccountId {
+	if m != nil {
+		return m.SubaccountId
+	}
+	return SubaccountId{}
+}
+
+func (m *MsgSetMarginMode) GetMarginMode() uint32 {
+	if m != nil {
+		return m.MarginMode
+	}
+	return 0
+}
+
+// MsgSetMarginModeResponse is the Msg/SetMarginMode response type.
+type MsgSetMarginModeResponse struct {
+}
+
+func (m *MsgSetMarginModeResponse) Reset()         { *m = MsgSetMarginModeResponse{} }
+func (m *MsgSetMarginModeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMarginModeResponse) ProtoMessage()    {}
+func (*MsgSetMarginModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16edbf88307684e5, []int{5}
+}
+func (m *MsgSetMarginModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMarginModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMarginModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMarginModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMarginModeResponse.Merge(m, src)
+}
+func (m *MsgSetMarginModeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMarginModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMarginModeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMarginModeResponse proto.InternalMessageInfo
+
+// MsgRebalanceCollateral moves USDC between a cross margin subaccount and an
+// isolated position of the same owner.
+type MsgRebalanceCollateral struct {
+	// The cross margin subaccount.
+	CrossSubaccountId SubaccountId `protobuf:"bytes,1,opt,name=cross_subaccount_id,json=crossSubaccountId,proto3" json:"cross_subaccount_id"`
+	// The subaccount holding the isolated position.
+	IsolatedSubaccountId SubaccountId `protobuf:"bytes,2,opt,name=isolated_subaccount_id,json=isolatedSubaccountId,proto3" json:"isolated_subaccount_id"`
+	// The id of the isolated perpetual.
+	PerpetualId uint32 `protobuf:"varint,3,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The amount of USDC to move, in quote quantums. Must be positive.
+	QuoteQuantums uint64 `protobuf:"varint,4,opt,name=quote_quantums,json=quoteQuantums,proto3" json:"quote_quantums,omitempty"`
+	// If true, the USDC is moved from the isolated position to the cross margin
+	// subaccount. Otherwise, it is moved from the cross margin subaccount to the
+	// isolated position.
+	ToCross bool `protobuf:"varint,5,opt,name=to_cross,json=toCross,proto3" json:"to_cross,omitempty"`
+}
+
+func (m *MsgRebalanceCollateral) Reset()         { *m = MsgRebalanceCollateral{} }
+func (m *MsgRebalanceCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgRebalanceCollateral) ProtoMessage()    {}
+func (*MsgRebalanceCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16edbf88307684e5, []int{6}
+}
+func (m *MsgRebalanceCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRebalanceCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRebalanceCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRebalanceCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRebalanceCollateral.Merge(m, src)
+}
+func (m *MsgRebalanceCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRebalanceCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRebalanceCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRebalanceCollateral proto.InternalMessageInfo
+
+func (m *MsgRebalanceCollateral) GetCrossSubaccountId() SubaccountId {
+	if m != nil {
+		return m.CrossSubaccountId
+	}
+	return SubaccountId{}
+}
+
+func (m *MsgRebalanceCollateral) GetIsolatedSubaccountId() SubaccountId {
+	if m != nil {
+		return m.IsolatedSubaccountId
+	}
+	return SubaccountId{}
+}
+
+func (m *MsgRebalanceCollateral) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *MsgRebalanceCollateral) GetQuoteQuantums() uint64 {
+	if m != nil {
+		return m.QuoteQuantums
+	}
+	return 0
+}
+
+func (m *MsgRebalanceCollateral) GetToCross() bool {
+	if m != nil {
+		return m.ToCross
+	}
+	return false
+}
+
+// MsgRebalanceCollateralResponse is the Msg/RebalanceCollateral response type.
+type MsgRebalanceCollateralResponse struct {
+}
+
+func (m *MsgRebalanceCollateralResponse) Reset()         { *m = MsgRebalanceCollateralResponse{} }
+func (m *MsgRebalanceCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRebalanceCollateralResponse) ProtoMessage()    {}
+func (*MsgRebalanceCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16edbf88307684e5, []int{7}
+}
+func (m *MsgRebalanceCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRebalanceCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRebalanceCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRebalanceCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRebalanceCollateralResponse.Merge(m, src)
+}
+func (m *MsgRebalanceCollateralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRebalanceCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRebalanceCollateralResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRebalanceCollateralResponse proto.InternalMessageInfo
+
+// HedgedPairLeg is a change to a perpetual position of a hedged pair.
+type HedgedPairLeg struct {
+	// The id of the perpetual.
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The signed change in the size of the position in base quantums.
+	QuantumsDelta github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=quantums_delta,json=quantumsDelta,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"quantums_delta"`
+	// The signed change in the quote balance of the position in quote quantums.
+	QuoteBalanceDelta github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=quote_balance_delta,json=quoteBalanceDelta,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"quote_balance_delta"`
+}
+
+func (m *HedgedPairLeg) Reset()         { *m = HedgedPairLeg{} }
+func (m *HedgedPairLeg) String() string { return proto.CompactTextString(m) }
+func (*HedgedPairLeg) ProtoMessage()    {}
+func (*HedgedPairLeg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16edbf88307684e5, []int{8}
+}
+func (m *HedgedPairLeg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HedgedPairLeg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HedgedPairLeg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HedgedPairLeg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HedgedPairLeg.Merge(m, src)
+}
+func (m *HedgedPairLeg) XXX_Size() int {
+	return m.Size()
+}
+func (m *HedgedPairLeg) XXX_DiscardUnknown() {
+	xxx_messageInfo_HedgedPairLeg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HedgedPairLeg proto.InternalMessageInfo
+
+func (m *HedgedPairLeg) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+// MsgOpenHedgedPair atomically opens positions in two perpetuals of a
+// subaccount. The legs are applied without a counterparty, so the message can
+// only be submitted by the authority.
+type MsgOpenHedgedPair struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The subaccount to open the positions in.
+	SubaccountId SubaccountId `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+	// The legs of the pair, which must be in different perpetuals.
+	LegA HedgedPairLeg `protobuf:"bytes,3,opt,name=leg_a,json=legA,proto3" json:"leg_a"`
+	LegB HedgedPairLeg `protobuf:"bytes,4,opt,name=leg_b,json=legB,proto3" json:"leg_b"`
+}
+
+func (m *MsgOpenHedgedPair) Reset()         { *m = MsgOpenHedgedPair{} }
+func (m *MsgOpenHedgedPair) String() string { return proto.CompactTextString(m) }
+func (*MsgOpenHedgedPair) ProtoMessage()    {}
+func (*MsgOpenHedgedPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16edbf88307684e5, []int{9}
+}
+func (m *MsgOpenHedgedPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOpenHedgedPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOpenHedgedPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOpenHedgedPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOpenHedgedPair.Merge(m, src)
+}
+func (m *MsgOpenHedgedPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOpenHedgedPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOpenHedgedPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOpenHedgedPair proto.InternalMessageInfo
+
+func (m *MsgOpenHedgedPair) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgOpenHedgedPair) GetSubaccountId() SubaccountId {
+	if m != nil {
+		return m.SubaccountId
+	}
+	return SubaccountId{}
+}
+
+func (m *MsgOpenHedgedPair) GetLegA() HedgedPairLeg {
+	if m != nil {
+		return m.LegA
+	}
+	return HedgedPairLeg{}
+}
+
+func (m *MsgOpenHedgedPair) GetLegB() HedgedPairLeg {
+	if m != nil {
+		return m.LegB
+	}
+	return HedgedPairLeg{}
+}
+
+// MsgOpenHedgedPairResponse is the Msg/OpenHedgedPair response type.
+type MsgOpenHedgedPairResponse struct {
+}
+
+func (m *MsgOpenHedgedPairResponse) Reset()         { *m = MsgOpenHedgedPairResponse{} }
+func (m *MsgOpenHedgedPairResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOpenHedgedPairResponse) ProtoMessage()    {}
+func (*MsgOpenHedgedPairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16edbf88307684e5, []int{10}
+}
+func (m *MsgOpenHedgedPairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOpenHedgedPairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOpenHedgedPairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOpenHedgedPairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOpenHedgedPairResponse.Merge(m, src)
+}
+func (m *MsgOpenHedgedPairResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOpenHedgedPairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOpenHedgedPairResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOpenHedgedPairResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "dydxprotocol.subaccounts.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dydxprotocol.subaccounts.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetMaxLeverage)(nil), "dydxprotocol.subaccounts.MsgSetMaxLeverage")
+	proto.RegisterType((*MsgSetMaxLeverageResponse)(nil), "dydxprotocol.subaccounts.MsgSetMaxLeverageResponse")
+	proto.RegisterType((*MsgSetMarginMode)(nil), "dydxprotocol.subaccounts.MsgSetMarginMode")
+	proto.RegisterType((*MsgSetMarginModeResponse)(nil), "dydxprotocol.subaccounts.MsgSetMarginModeResponse")
+	proto.RegisterType((*MsgRebalanceCollateral)(nil), "dydxprotocol.subaccounts.MsgRebalanceCollateral")
+	proto.RegisterType((*MsgRebalanceCollateralResponse)(nil), "dydxprotocol.subaccounts.MsgRebalanceCollateralResponse")
+	proto.RegisterType((*HedgedPairLeg)(nil), "dydxprotocol.subaccounts.HedgedPairLeg")
+	proto.RegisterType((*MsgOpenHedgedPair)(nil), "dydxprotocol.subaccounts.MsgOpenHedgedPair")
+	proto.RegisterType((*MsgOpenHedgedPairResponse)(nil), "dydxprotocol.subaccounts.MsgOpenHedgedPairResponse")
+}
+
+func init() { proto.RegisterFile("dydxprotocol/subaccounts/tx.proto", fileDescriptor_16edbf88307684e5) }
+
+var fileDescriptor_16edbf88307684e5 = []byte{
+	// 809 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcb, 0x4e, 0xdb, 0x4c,
+	0x18, 0x8d, 0x43, 0xe0, 0x87, 0xc9, 0xe5, 0x07, 0x83, 0xc0, 0xf1, 0x2f, 0x85, 0x10, 0x89, 0xbf,
+	0x81, 0x8a, 0xa4, 0x84, 0xaa, 0xaa, 0x90, 0x5a, 0x95, 0xd0, 0x05, 0x48, 0x44, 0x05, 0x47, 0x55,
+	0xa5, 0xaa, 0x92, 0x35, 0xb1, 0x47, 0xc6, 0x92, 0xed, 0x31, 0x9e, 0x31, 0x0a, 0xdd, 0xb5, 0x4f,
+	0xd0, 0x6d, 0x2b, 0x75, 0xd7, 0x07, 0xe8, 0xa2, 0xea, 0x33, 0xb0, 0x44, 0x5d, 0xb5, 0x5d, 0xa0,
+	0x0a, 0x16, 0x7d, 0x8d, 0xca, 0xe3, 0x4b, 0xe2, 0x5c, 0x28, 0x97, 0x76, 0xc5, 0xcc, 0x37, 0x67,
+	0xce, 0x39, 0xdf, 0x21, 0xf3, 0x25, 0x60, 0x41, 0x3d, 0x52, 0xdb, 0xb6, 0x83, 0x29, 0x56, 0xb0,
+	0x51, 0x25, 0x6e, 0x0b, 0x2a, 0x0a, 0x76, 0x2d, 0x4a, 0xaa, 0xb4, 0x5d, 0x61, 0x75, 0x5e, 0xe8,
+	0x86, 0x54, 0xba, 0x20, 0x62, 0x5e, 0xc1, 0xc4, 0xc4, 0x44, 0x66, 0x87, 0x55, 0x7f, 0xe3, 0x5f,
+	0x12, 0xe7, 0xfc, 0x5d, 0xd5, 0x24, 0x5a, 0xf5, 0x70, 0xd5, 0xfb, 0x13, 0x1c, 0x2c, 0x0e, 0x15,
+	0xb4, 0xa1, 0x03, 0xcd, 0xf0, 0xfe, 0xd2, 0x50, 0x58, 0x67, 0x1d, 0x40, 0x67, 0x34, 0xac, 0x61,
+	0xdf, 0x82, 0xb7, 0xf2, 0xab, 0xa5, 0xb7, 0x1c, 0xf8, 0xb7, 0x41, 0xb4, 0xa7, 0xb6, 0x0a, 0x29,
+	0xda, 0x65, 0xd4, 0xfc, 0x3d, 0x30, 0x01, 0x5d, 0xba, 0x8f, 0x1d, 0x9d, 0x1e, 0x09, 0x5c, 0x91,
+	0x2b, 0x4f, 0xd4, 0x85, 0x2f, 0x9f, 0x56, 0x66, 0x02, 0xe7, 0x1b, 0xaa, 0xea, 0x20, 0x42, 0x9a,
+	0xd4, 0xd1, 0x2d, 0x4d, 0xea, 0x40, 0xf9, 0x87, 0x60, 0xcc, 0x37, 0x27, 0x24, 0x8b, 0x5c, 0x39,
+	0x5d, 0x2b, 0x56, 0x86, 0x45, 0x52, 0xf1, 0x95, 0xea, 0xa9, 0xe3, 0xd3, 0xf9, 0x84, 0x14, 0xdc,
+	0x5a, 0xcf, 0xbd, 0xfe, 0xf9, 0x71, 0xb9, 0xc3, 0x57, 0xca, 0x83, 0xb9, 0x1e, 0x6b, 0x12, 0x22,
+	0x36, 0xb6, 0x08, 0x2a, 0x7d, 0xe0, 0xc0, 0x54, 0x83, 0x68, 0x4d, 0x44, 0x1b, 0xb0, 0xbd, 0x83,
+	0x0e, 0x91, 0x03, 0x35, 0xc4, 0xef, 0x81, 0x6c, 0x47, 0x44, 0xd6, 0x55, 0x66, 0x3e, 0x5d, 0xfb,
+	0x7f, 0xb8, 0x8f, 0x66, 0xb4, 0xde, 0x56, 0x03, 0x37, 0x19, 0xd2, 0x55, 0xe3, 0xcb, 0x60, 0xd2,
+	0x84, 0x6d, 0xd9, 0x08, 0x24, 0x64, 0xdb, 0x36, 0x59, 0x77, 0x59, 0x29, 0x67, 0x76, 0x94, 0x77,
+	0x6d, 0x73, 0x9d, 0xf7, 0xdc, 0xc7, 0xf5, 0x4b, 0xff, 0x81, 0x7c, 0x9f, 0xcb, 0xa8, 0x87, 0x77,
+	0x1c, 0x98, 0x0c, 0x4f, 0x1d, 0x4d, 0xb7, 0x1a, 0x58, 0xfd, 0x2b, 0x2d, 0xcc, 0x83, 0xb4, 0xc9,
+	0x04, 0x64, 0x13, 0xab, 0x28, 0x70, 0x0f, 0xcc, 0x48, 0x73, 0xa0, 0x73, 0x11, 0x08, 0xbd, 0xde,
+	0x22, 0xe3, 0xdf, 0x92, 0x60, 0xb6, 0x41, 0x34, 0x09, 0xb5, 0xa0, 0x01, 0x2d, 0x05, 0x6d, 0x62,
+	0xc3, 0x80, 0x14, 0x39, 0xd0, 0xe0, 0x5f, 0x80, 0x69, 0xc5, 0xc1, 0x84, 0xc8, 0x37, 0x6f, 0x62,
+	0x8a, 0x11, 0x75, 0x1f, 0xf0, 0x2d, 0x30, 0xab, 0x13, 0xec, 0x69, 0xa9, 0x3d, 0x02, 0xc9, 0x6b,
+	0x08, 0xcc, 0x84, 0x5c, 0x31, 0x8d, 0x05, 0x90, 0xb1, 0x91, 0x63, 0x23, 0xea, 0x42, 0xc3, 0x63,
+	0x1e, 0x61, 0x71, 0xa5, 0xa3, 0xda, 0xb6, 0xca, 0x2f, 0x82, 0xdc, 0x81, 0x8b, 0x29, 0x92, 0x0f,
+	0x5c, 0x68, 0x51, 0xd7, 0x24, 0x42, 0xaa, 0xc8, 0x95, 0x53, 0x52, 0x96, 0x55, 0xf7, 0x82, 0x22,
+	0x9f, 0x07, 0xe3, 0x14, 0xcb, 0xac, 0x0b, 0x61, 0xb4, 0xc8, 0x95, 0xc7, 0xa5, 0x7f, 0x28, 0xde,
+	0xf4, 0xb6, 0xeb, 0x82, 0x97, 0xf8, 0xa0, 0xa4, 0x4a, 0x45, 0x50, 0x18, 0x1c, 0x6d, 0x94, 0xfe,
+	0xfb, 0x24, 0xc8, 0x6e, 0x21, 0x55, 0x43, 0xea, 0x2e, 0xd4, 0x9d, 0x1d, 0xa4, 0xf5, 0x59, 0xe6,
+	0xfa, 0x2d, 0x63, 0xcf, 0xb2, 0xef, 0x4b, 0x56, 0x91, 0x41, 0x21, 0x4b, 0x2c, 0x53, 0xdf, 0xf2,
+	0x92, 0xf8, 0x7e, 0x3a, 0xff, 0x48, 0xd3, 0xe9, 0xbe, 0xdb, 0xaa, 0x28, 0xd8, 0xac, 0xc6, 0x46,
+	0xca, 0xe1, 0xdd, 0x15, 0x65, 0x1f, 0xea, 0x56, 0x35, 0xaa, 0xa8, 0xf4, 0xc8, 0x46, 0xa4, 0xd2,
+	0x44, 0x8e, 0x0e, 0x0d, 0xfd, 0x25, 0x6c, 0x19, 0x68, 0xdb, 0xa2, 0x52, 0x36, 0xe4, 0x7f, 0xec,
+	0xd1, 0xf3, 0x6d, 0x30, 0xed, 0x67, 0x14, 0x34, 0x12, 0xa8, 0x8e, 0xfc, 0x61, 0xd5, 0x29, 0x26,
+	0x52, 0xf7, 0x35, 0x98, 0x72, 0xe9, 0x73, 0x92, 0x8d, 0x86, 0x27, 0x36, 0xb2, 0x3a, 0x31, 0x5d,
+	0x7b, 0xa6, 0xf5, 0xbd, 0xc7, 0xe4, 0x8d, 0xdf, 0x63, 0x1d, 0x8c, 0x1a, 0x48, 0x93, 0xfd, 0x30,
+	0xd2, 0xb5, 0x5b, 0xc3, 0xa9, 0x62, 0xff, 0xe6, 0x80, 0x2b, 0x65, 0x20, 0x6d, 0x23, 0xe4, 0x68,
+	0x09, 0xa9, 0xeb, 0x72, 0xd4, 0xfb, 0xc6, 0xad, 0x3f, 0xac, 0xe2, 0xb9, 0x85, 0x9f, 0xba, 0xda,
+	0x49, 0x0a, 0x8c, 0x34, 0x88, 0xc6, 0x1b, 0x20, 0x13, 0xfb, 0xae, 0x58, 0x1a, 0xae, 0xdc, 0x33,
+	0xbb, 0xc5, 0xd5, 0x4b, 0x43, 0x43, 0x55, 0xde, 0x01, 0xb9, 0x9e, 0x11, 0x7f, 0xfb, 0x42, 0x92,
+	0x38, 0x58, 0x5c, 0xbb, 0x02, 0x38, 0xd2, 0xc4, 0x20, 0x1b, 0x1f, 0xc9, 0xcb, 0xbf, 0x67, 0x09,
+	0xb1, 0x62, 0xed, 0xf2, 0xd8, 0x48, 0xf0, 0x15, 0x07, 0xa6, 0x07, 0xcd, 0xd2, 0x3b, 0x17, 0x72,
+	0x0d, 0xb8, 0x21, 0xde, 0xbf, 0xea, 0x8d, 0xee, 0xa0, 0x7b, 0x1e, 0xcc, 0xc5, 0x41, 0xc7, 0xc1,
+	0xe2, 0xda, 0x15, 0xc0, 0xa1, 0x66, 0xfd, 0xd9, 0xf1, 0x59, 0x81, 0x3b, 0x39, 0x2b, 0x70, 0x3f,
+	0xce, 0x0a, 0xdc, 0x9b, 0xf3, 0x42, 0xe2, 0xe4, 0xbc, 0x90, 0xf8, 0x7a, 0x5e, 0x48, 0x3c, 0x7f,
+	0x70, 0xf9, 0xb9, 0xd0, 0x8e, 0xff, 0x18, 0xf3, 0x86, 0x44, 0x6b, 0x8c, 0x9d, 0xae, 0xfd, 0x1a,
+	0x00, 0xb9, 0x86, 0xb5, 0x04, 0xb5, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams updates the Params in state.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetMaxLeverage sets the self-imposed leverage cap of a subaccount.
+	SetMaxLeverage(ctx context.Context, in *MsgSetMaxLeverage, opts ...grpc.CallOption) (*MsgSetMaxLeverageResponse, error)
+	// SetMarginMode switches a subaccount between cross and isolated margin.
+	SetMarginMode(ctx context.Context, in *MsgSetMarginMode, opts ...grpc.CallOption) (*MsgSetMarginModeResponse, error)
+	// RebalanceCollateral moves USDC between a cross margin subaccount and an
+	// isolated position of the same owner.
+	RebalanceCollateral(ctx context.Context, in *MsgRebalanceCollateral, opts ...grpc.CallOption) (*MsgRebalanceCollateralResponse, error)
+	// OpenHedgedPair atomically opens positions in two perpetuals of a
+	// subaccount.
+	OpenHedgedPair(ctx context.Context, in *MsgOpenHedgedPair, opts ...grpc.CallOption) (*MsgOpenHedgedPairResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMaxLeverage(ctx context.Context, in *MsgSetMaxLeverage, opts ...grpc.CallOption) (*MsgSetMaxLeverageResponse, error) {
+	out := new(MsgSetMaxLeverageResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Msg/SetMaxLeverage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMarginMode(ctx context.Context, in *MsgSetMarginMode, opts ...grpc.CallOption) (*MsgSetMarginModeResponse, error) {
+	out := new(MsgSetMarginModeResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Msg/SetMarginMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RebalanceCollateral(ctx context.Context, in *MsgRebalanceCollateral, opts ...grpc.CallOption) (*MsgRebalanceCollateralResponse, error) {
+	out := new(MsgRebalanceCollateralResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Msg/RebalanceCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OpenHedgedPair(ctx context.Context, in *MsgOpenHedgedPair, opts ...grpc.CallOption) (*MsgOpenHedgedPairResponse, error) {
+	out := new(MsgOpenHedgedPairResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Msg/OpenHedgedPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams updates the Params in state.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetMaxLeverage sets the self-imposed leverage cap of a subaccount.
+	SetMaxLeverage(context.Context, *MsgSetMaxLeverage) (*MsgSetMaxLeverageResponse, error)
+	// SetMarginMode switches a subaccount between cross and isolated margin.
+	SetMarginMode(context.Context, *MsgSetMarginMode) (*MsgSetMarginModeResponse, error)
+	// RebalanceCollateral moves USDC between a cross margin subaccount and an
+	// isolated position of the same owner.
+	RebalanceCollateral(context.Context, *MsgRebalanceCollateral) (*MsgRebalanceCollateralResponse, error)
+	// OpenHedgedPair atomically opens positions in two perpetuals of a
+	// subaccount.
+	OpenHedgedPair(context.Context, *MsgOpenHedgedPair) (*MsgOpenHedgedPairResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SetMaxLeverage(ctx context.Context, req *MsgSetMaxLeverage) (*MsgSetMaxLeverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxLeverage not implemented")
+}
+func (*UnimplementedMsgServer) SetMarginMode(ctx context.Context, req *MsgSetMarginMode) (*MsgSetMarginModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMarginMode not implemented")
+}
+func (*UnimplementedMsgServer) RebalanceCollateral(ctx context.Context, req *MsgRebalanceCollateral) (*MsgRebalanceCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalanceCollateral not implemented")
+}
+func (*UnimplementedMsgServer) OpenHedgedPair(ctx context.Context, req *MsgOpenHedgedPair) (*MsgOpenHedgedPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenHedgedPair not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMaxLeverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMaxLeverage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMaxLeverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Msg/SetMaxLeverage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMaxLeverage(ctx, req.(*MsgSetMaxLeverage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMarginMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMarginMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMarginMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Msg/SetMarginMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMarginMode(ctx, req.(*MsgSetMarginMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RebalanceCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRebalanceCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RebalanceCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Msg/RebalanceCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RebalanceCollateral(ctx, req.(*MsgRebalanceCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OpenHedgedPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOpenHedgedPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OpenHedgedPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Msg/OpenHedgedPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OpenHedgedPair(ctx, req.(*MsgOpenHedgedPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dydxprotocol.subaccounts.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetMaxLeverage",
+			Handler:    _Msg_SetMaxLeverage_Handler,
+		},
+		{
+			MethodName: "SetMarginMode",
+			Handler:    _Msg_SetMarginMode_Handler,
+		},
+		{
+			MethodName: "RebalanceCollateral",
+			Handler:    _Msg_RebalanceCollateral_Handler,
+		},
+		{
+			MethodName: "OpenHedgedPair",
+			Handler:    _Msg_OpenHedgedPair_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dydxprotocol/subaccounts/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMaxLeverage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMaxLeverage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMaxLeverage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxLeveragePpm != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxLeveragePpm))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMaxLeverageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMaxLeverageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMaxLeverageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMarginMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMarginMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMarginMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarginMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarginMode))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMarginModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMarginModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMarginModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRebalanceCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRebalanceCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRebalanceCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToCross {
+		i--
+		if m.ToCross {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.QuoteQuantums != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.QuoteQuantums))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.IsolatedSubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CrossSubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRebalanceCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRebalanceCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRebalanceCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *HedgedPairLeg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HedgedPairLeg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HedgedPairLeg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.QuoteBalanceDelta.Size()
+		i -= size
+		if _, err := m.QuoteBalanceDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.QuantumsDelta.Size()
+		i -= size
+		if _, err := m.QuantumsDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PerpetualId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenHedgedPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenHedgedPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenHedgedPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LegB.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.LegA.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenHedgedPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenHedgedPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenHedgedPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMaxLeverage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.MaxLeveragePpm != 0 {
+		n += 1 + sovTx(uint64(m.MaxLeveragePpm))
+	}
+	return n
+}
+
+func (m *MsgSetMaxLeverageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMarginMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.MarginMode != 0 {
+		n += 1 + sovTx(uint64(m.MarginMode))
+	}
+	return n
+}
+
+func (m *MsgSetMarginModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRebalanceCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CrossSubaccountId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.IsolatedSubaccountId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.PerpetualId != 0 {
+		n += 1 + sovTx(uint64(m.PerpetualId))
+	}
+	if m.QuoteQuantums != 0 {
+		n += 1 + sovTx(uint64(m.QuoteQuantums))
+	}
+	if m.ToCross {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRebalanceCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *HedgedPairLeg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovTx(uint64(m.PerpetualId))
+	}
+	l = m.QuantumsDelta.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.QuoteBalanceDelta.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgOpenHedgedPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LegA.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LegB.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgOpenHedgedPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMaxLeverage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMaxLeverage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMaxLeverage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeveragePpm", wireType)
+			}
+			m.MaxLeveragePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLeveragePpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMaxLeverageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMaxLeverageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMaxLeverageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMarginMode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMarginMode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMarginMode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginMode", wireType)
+			}
+			m.MarginMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarginMode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMarginModeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMarginModeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMarginModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRebalanceCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRebalanceCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRebalanceCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossSubaccountId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CrossSubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolatedSubaccountId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IsolatedSubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteQuantums", wireType)
+			}
+			m.QuoteQuantums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuoteQuantums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToCross", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ToCross = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRebalanceCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRebalanceCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRebalanceCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HedgedPairLeg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HedgedPairLeg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HedgedPairLeg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantumsDelta", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuantumsDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteBalanceDelta", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteBalanceDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenHedgedPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenHedgedPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenHedgedPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LegA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LegB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenHedgedPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenHedgedPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenHedgedPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -62,6 +62,12 @@ var updateResultStringMap = map[UpdateResult]string{
 	WithdrawalsAndTransfersBlocked:        "WithdrawalsAndTransfersBlocked",
 	UpdateCausedError:                     "UpdateCausedError",
 	ViolatesIsolatedSubaccountConstraints: "ViolatesIsolatedSubaccountConstraints",
+	NewlyBelowMaintenanceMargin:           "NewlyBelowMaintenanceMargin",
+	ViolatesTradingHalt:                   "ViolatesTradingHalt",
+	ViolatesMaxPositionNotional:           "ViolatesMaxPositionNotional",
+	ViolatesInitialMarginBuffer:           "ViolatesInitialMarginBuffer",
+	ViolatesMaxLeverage:                   "ViolatesMaxLeverage",
+	ViolatesMaxPerpetualPositions:         "ViolatesMaxPerpetualPositions",
 }
 
 const (
@@ -71,6 +77,12 @@ const (
 	WithdrawalsAndTransfersBlocked
 	UpdateCausedError
 	ViolatesIsolatedSubaccountConstraints
+	NewlyBelowMaintenanceMargin
+	ViolatesTradingHalt
+	ViolatesMaxPositionNotional
+	ViolatesInitialMarginBuffer
+	ViolatesMaxLeverage
+	ViolatesMaxPerpetualPositions
 )
 
 // Update is used by the subaccounts keeper to allow other modules
@@ -107,6 +119,9 @@ type PerpetualUpdate struct {
 	BigQuantumsDelta *big.Int
 	// The signed change in the `QuoteBalance` of the `PerpetualPosition`.
 	BigQuoteBalanceDelta *big.Int
+	// The absolute quote quantums exchanged for `BigQuantumsDelta`, used to update the
+	// `CostBasis` of the `PerpetualPosition`. Nil if the update is not the result of a fill.
+	BigFillQuoteQuantums *big.Int
 }
 
 func (u *PerpetualUpdate) DeepCopy() PerpetualUpdate {
@@ -119,6 +134,9 @@ func (u *PerpetualUpdate) DeepCopy() PerpetualUpdate {
 	if u.BigQuoteBalanceDelta != nil {
 		r.BigQuoteBalanceDelta.Set(u.BigQuoteBalanceDelta)
 	}
+	if u.BigFillQuoteQuantums != nil {
+		r.BigFillQuoteQuantums = new(big.Int).Set(u.BigFillQuoteQuantums)
+	}
 	return r
 }
 
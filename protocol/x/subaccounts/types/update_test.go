@@ -92,8 +92,32 @@ func TestUpdateResultString(t *testing.T) {
 			value:          types.ViolatesIsolatedSubaccountConstraints,
 			expectedResult: "ViolatesIsolatedSubaccountConstraints",
 		},
+		"NewlyBelowMaintenanceMargin": {
+			value:          types.NewlyBelowMaintenanceMargin,
+			expectedResult: "NewlyBelowMaintenanceMargin",
+		},
+		"ViolatesTradingHalt": {
+			value:          types.ViolatesTradingHalt,
+			expectedResult: "ViolatesTradingHalt",
+		},
+		"ViolatesMaxPositionNotional": {
+			value:          types.ViolatesMaxPositionNotional,
+			expectedResult: "ViolatesMaxPositionNotional",
+		},
+		"ViolatesInitialMarginBuffer": {
+			value:          types.ViolatesInitialMarginBuffer,
+			expectedResult: "ViolatesInitialMarginBuffer",
+		},
+		"ViolatesMaxLeverage": {
+			value:          types.ViolatesMaxLeverage,
+			expectedResult: "ViolatesMaxLeverage",
+		},
+		"ViolatesMaxPerpetualPositions": {
+			value:          types.ViolatesMaxPerpetualPositions,
+			expectedResult: "ViolatesMaxPerpetualPositions",
+		},
 		"UnexpectedError": {
-			value:          types.UpdateResult(6),
+			value:          types.UpdateResult(12),
 			expectedResult: "UnexpectedError",
 		},
 	}
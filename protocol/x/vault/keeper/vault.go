@@ -13,6 +13,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
@@ -69,6 +70,52 @@ func (k Keeper) GetVaultEquity(
 	return k.GetSubaccountEquity(ctx, *vaultId.ToSubaccountId())
 }
 
+// GetVaultRisk returns the net collateral and margin requirements of a vault (in quote quantums).
+func (k Keeper) GetVaultRisk(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) (margin.Risk, error) {
+	return k.subaccountsKeeper.GetNetCollateralAndMarginRequirements(
+		ctx,
+		satypes.Update{
+			SubaccountId: *vaultId.ToSubaccountId(),
+		},
+	)
+}
+
+// GetMegavaultRisk returns the consolidated net collateral and margin requirements of the megavault
+// (in quote quantums), which is the sum of the risks of the megavault main subaccount and of all vaults
+// regardless of their status. The risk of each subaccount is computed separately, so positions in
+// different subaccounts, such as the isolated positions of vaults, are not netted against each other.
+func (k Keeper) GetMegavaultRisk(ctx sdk.Context) (margin.Risk, error) {
+	megavaultRisk, err := k.subaccountsKeeper.GetNetCollateralAndMarginRequirements(
+		ctx,
+		satypes.Update{
+			SubaccountId: types.MegavaultMainSubaccount,
+		},
+	)
+	if err != nil {
+		return margin.Risk{}, errorsmod.Wrapf(err, "failed to get megavault subaccount risk")
+	}
+
+	vaultParamsIterator := k.getVaultParamsIterator(ctx)
+	defer vaultParamsIterator.Close()
+	for ; vaultParamsIterator.Valid(); vaultParamsIterator.Next() {
+		vaultId, err := types.GetVaultIdFromStateKey(vaultParamsIterator.Key())
+		if err != nil {
+			return margin.Risk{}, err
+		}
+
+		risk, err := k.GetVaultRisk(ctx, *vaultId)
+		if err != nil {
+			return margin.Risk{}, errorsmod.Wrapf(err, "failed to get risk of vault %s", vaultId.ToString())
+		}
+		megavaultRisk.AddInPlace(risk)
+	}
+
+	return megavaultRisk, nil
+}
+
 // GetVaultLeverageAndEquity returns a vault's leverage and equity.
 // - leverage = open notional / equity.
 // Note that an error is returned if equity is non-positive.
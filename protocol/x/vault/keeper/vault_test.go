@@ -11,6 +11,7 @@ import (
 	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
 	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	feetierstypes "github.com/dydxprotocol/v4-chain/protocol/x/feetiers/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
 	"github.com/stretchr/testify/require"
@@ -369,6 +370,91 @@ func TestVaultIsBestFeeTier(t *testing.T) {
 	require.Equal(t, int32(1), makerFee)
 }
 
+func TestGetMegavaultRisk(t *testing.T) {
+	// The megavault main subaccount holds a cross position of 1 BTC worth $20,000 and vault 1 holds an
+	// isolated position of 10,000 ISO worth $7,000, both with a 20% initial margin requirement and a 10%
+	// maintenance margin requirement.
+	mainSubaccount := satypes.Subaccount{
+		Id: &vaulttypes.MegavaultMainSubaccount,
+		AssetPositions: []*satypes.AssetPosition{
+			testutil.CreateSingleAssetPosition(0, big.NewInt(-15_000_000_000)), // -$15,000
+		},
+		PerpetualPositions: []*satypes.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(
+				0,
+				big.NewInt(100_000_000), // 1 BTC
+				big.NewInt(0),
+				big.NewInt(0),
+			),
+		},
+	}
+	vaultSubaccount := satypes.Subaccount{
+		Id: constants.Vault_Clob1.ToSubaccountId(),
+		AssetPositions: []*satypes.AssetPosition{
+			testutil.CreateSingleAssetPosition(0, big.NewInt(-5_000_000_000)), // -$5,000
+		},
+		PerpetualPositions: []*satypes.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(
+				3,
+				big.NewInt(10_000_000_000_000), // 10,000 ISO
+				big.NewInt(0),
+				big.NewInt(0),
+			),
+		},
+	}
+
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *perptypes.GenesisState) {
+				genesisState.Params = constants.PerpetualsGenesisParams
+				genesisState.LiquidityTiers = constants.LiquidityTiers
+				genesisState.Perpetuals = []perptypes.Perpetual{
+					constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+					constants.EthUsd_20PercentInitial_10PercentMaintenance,
+					constants.IsoUsd_IsolatedMarket,
+				}
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{mainSubaccount, vaultSubaccount}
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *vaulttypes.GenesisState) {
+				genesisState.Vaults = []vaulttypes.Vault{
+					{
+						VaultId: constants.Vault_Clob1,
+						VaultParams: vaulttypes.VaultParams{
+							Status: vaulttypes.VaultStatus_VAULT_STATUS_QUOTING,
+						},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.VaultKeeper
+
+	vaultRisk, err := k.GetVaultRisk(ctx, constants.Vault_Clob1)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(2_000_000_000), vaultRisk.NC)
+	require.Equal(t, big.NewInt(1_400_000_000), vaultRisk.IMR)
+	require.Equal(t, big.NewInt(700_000_000), vaultRisk.MMR)
+
+	// The risks of the main subaccount and the vault are summed without netting their positions.
+	megavaultRisk, err := k.GetMegavaultRisk(ctx)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(7_000_000_000), megavaultRisk.NC)
+	require.Equal(t, big.NewInt(5_400_000_000), megavaultRisk.IMR)
+	require.Equal(t, big.NewInt(2_700_000_000), megavaultRisk.MMR)
+}
+
 func TestGetMegavaultEquity(t *testing.T) {
 	tests := map[string]struct {
 		/* --- Setup --- */
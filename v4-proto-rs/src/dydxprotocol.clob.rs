@@ -418,6 +418,10 @@ pub struct GenesisState {
     pub block_rate_limit_config: ::core::option::Option<BlockRateLimitConfiguration>,
     #[prost(message, optional, tag = "4")]
     pub equity_tier_limit_config: ::core::option::Option<EquityTierLimitConfiguration>,
+    /// The maximum amount of quote quantums the insurance fund can pay out for
+    /// liquidations per block. Zero means the outflow is not capped.
+    #[prost(uint64, tag = "5")]
+    pub insurance_fund_outflow_cap: u64,
 }
 impl ::prost::Name for GenesisState {
     const NAME: &'static str = "GenesisState";
@@ -1777,6 +1781,43 @@ impl ::prost::Name for MsgUpdateLiquidationsConfigResponse {
         "/dydxprotocol.clob.MsgUpdateLiquidationsConfigResponse".into()
     }
 }
+/// MsgUpdateInsuranceFundOutflowCap is a request type for updating the maximum
+/// amount of quote quantums the insurance fund can pay out for liquidations per
+/// block.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgUpdateInsuranceFundOutflowCap {
+    /// Authority is the address that may send this message.
+    #[prost(string, tag = "1")]
+    pub authority: ::prost::alloc::string::String,
+    /// The maximum amount of quote quantums the insurance fund can pay out for
+    /// liquidations per block. Zero removes the cap.
+    #[prost(uint64, tag = "2")]
+    pub insurance_fund_outflow_cap: u64,
+}
+impl ::prost::Name for MsgUpdateInsuranceFundOutflowCap {
+    const NAME: &'static str = "MsgUpdateInsuranceFundOutflowCap";
+    const PACKAGE: &'static str = "dydxprotocol.clob";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.clob.MsgUpdateInsuranceFundOutflowCap".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.clob.MsgUpdateInsuranceFundOutflowCap".into()
+    }
+}
+/// MsgUpdateInsuranceFundOutflowCapResponse is the
+/// Msg/UpdateInsuranceFundOutflowCap response type.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct MsgUpdateInsuranceFundOutflowCapResponse {}
+impl ::prost::Name for MsgUpdateInsuranceFundOutflowCapResponse {
+    const NAME: &'static str = "MsgUpdateInsuranceFundOutflowCapResponse";
+    const PACKAGE: &'static str = "dydxprotocol.clob";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.clob.MsgUpdateInsuranceFundOutflowCapResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.clob.MsgUpdateInsuranceFundOutflowCapResponse".into()
+    }
+}
 /// Generated client implementations.
 pub mod msg_client {
     #![allow(
@@ -2115,6 +2156,32 @@ pub mod msg_client {
                 );
             self.inner.unary(req, path, codec).await
         }
+        /// UpdateInsuranceFundOutflowCap updates the maximum amount of quote quantums
+        /// the insurance fund can pay out for liquidations per block.
+        pub async fn update_insurance_fund_outflow_cap(
+            &mut self,
+            request: impl tonic::IntoRequest<super::MsgUpdateInsuranceFundOutflowCap>,
+        ) -> std::result::Result<
+            tonic::Response<super::MsgUpdateInsuranceFundOutflowCapResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.clob.Msg/UpdateInsuranceFundOutflowCap",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("dydxprotocol.clob.Msg", "UpdateInsuranceFundOutflowCap"));
+            self.inner.unary(req, path, codec).await
+        }
     }
 }
 /// Operation represents an operation in the proposed operations. Operation is
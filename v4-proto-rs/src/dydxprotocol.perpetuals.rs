@@ -12,6 +12,23 @@ pub struct Perpetual {
     /// Total size of open long contracts, measured in base_quantums.
     #[prost(bytes = "vec", tag = "3")]
     pub open_interest: ::prost::alloc::vec::Vec<u8>,
+    /// The cap on the absolute difference between the funding index of the
+    /// perpetual and the funding index of a position that is applied when the
+    /// position is settled. Zero means uncapped.
+    #[prost(uint64, tag = "4")]
+    pub max_unsettled_funding_index_delta: u64,
+    /// The settlement price of the perpetual, in the exponent of its market
+    /// price. Zero means the perpetual is not in settlement mode.
+    #[prost(uint64, tag = "5")]
+    pub settlement_price: u64,
+    /// The share of the open interest of the perpetual, in ppm, that the size of
+    /// a position must exceed for the concentration margin add-on to apply.
+    #[prost(uint32, tag = "6")]
+    pub concentration_threshold_ppm: u32,
+    /// The maintenance margin fraction added to concentrated positions, in ppm
+    /// of their notional. Zero means no concentration margin.
+    #[prost(uint32, tag = "7")]
+    pub concentration_add_on_ppm: u32,
 }
 impl ::prost::Name for Perpetual {
     const NAME: &'static str = "Perpetual";
@@ -54,6 +71,10 @@ pub struct PerpetualParams {
     /// The market type specifying if this perpetual is cross or isolated
     #[prost(enumeration = "PerpetualMarketType", tag = "7")]
     pub market_type: i32,
+    /// The contract type of the perpetual, `0` for linear and `1` for inverse.
+    /// Defaults to linear.
+    #[prost(uint32, tag = "8")]
+    pub perpetual_type: u32,
 }
 impl ::prost::Name for PerpetualParams {
     const NAME: &'static str = "PerpetualParams";
@@ -65,6 +86,59 @@ impl ::prost::Name for PerpetualParams {
         "/dydxprotocol.perpetuals.PerpetualParams".into()
     }
 }
+/// FundingIndexSnapshot is the funding index of a perpetual recorded at the start
+/// of a funding-tick epoch.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct FundingIndexSnapshot {
+    /// The block height at which the funding index was recorded.
+    #[prost(uint32, tag = "1")]
+    pub block_height: u32,
+    /// The funding index of the perpetual as of the block height.
+    #[prost(bytes = "vec", tag = "2")]
+    pub funding_index: ::prost::alloc::vec::Vec<u8>,
+}
+impl ::prost::Name for FundingIndexSnapshot {
+    const NAME: &'static str = "FundingIndexSnapshot";
+    const PACKAGE: &'static str = "dydxprotocol.perpetuals";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.perpetuals.FundingIndexSnapshot".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.perpetuals.FundingIndexSnapshot".into()
+    }
+}
+/// MarginFractions are the margin fractions of a perpetual in effect at the
+/// start of a funding-tick epoch. Initial margin fractions include the scaling
+/// by the open interest of the perpetual.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct MarginFractions {
+    /// The funding-tick epoch at the start of which the margin fractions were
+    /// recorded.
+    #[prost(uint32, tag = "1")]
+    pub epoch: u32,
+    /// The initial margin fraction of long positions, in parts-per-million.
+    #[prost(uint32, tag = "2")]
+    pub initial_margin_ppm: u32,
+    /// The maintenance margin fraction of long positions, in parts-per-million.
+    #[prost(uint32, tag = "3")]
+    pub maintenance_margin_ppm: u32,
+    /// The initial margin fraction of short positions, in parts-per-million.
+    #[prost(uint32, tag = "4")]
+    pub short_initial_margin_ppm: u32,
+    /// The maintenance margin fraction of short positions, in parts-per-million.
+    #[prost(uint32, tag = "5")]
+    pub short_maintenance_margin_ppm: u32,
+}
+impl ::prost::Name for MarginFractions {
+    const NAME: &'static str = "MarginFractions";
+    const PACKAGE: &'static str = "dydxprotocol.perpetuals";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.perpetuals.MarginFractions".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.perpetuals.MarginFractions".into()
+    }
+}
 /// MarketPremiums stores a list of premiums for a single perpetual market.
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MarketPremiums {
@@ -161,6 +235,27 @@ pub struct LiquidityTier {
     /// If zero, then the IMF does not scale with OI.
     #[prost(uint64, tag = "8")]
     pub open_interest_upper_cap: u64,
+    /// The margin fraction needed to open a short position, in parts-per-million.
+    /// If zero, short positions use initial_margin_ppm.
+    #[prost(uint32, tag = "9")]
+    pub short_initial_margin_ppm: u32,
+    /// The fraction of the initial-margin that the maintenance-margin is for short
+    /// positions, in parts-per-million. If zero, short positions use
+    /// maintenance_fraction_ppm.
+    #[prost(uint32, tag = "10")]
+    pub short_maintenance_fraction_ppm: u32,
+    /// The maximum notional of a subaccount's position in a perpetual of this
+    /// tier, in quote quantums. Updates that increase a position beyond this
+    /// notional are rejected. If zero, positions are not capped.
+    #[prost(uint64, tag = "11")]
+    pub max_position_notional_quote_quantums: u64,
+    /// The liquidation penalty of a liquidatable subaccount per unit of its
+    /// maintenance margin deficit (its maintenance margin requirement minus its
+    /// net collateral), in parts-per-million. The penalty never exceeds the
+    /// net collateral of the subaccount. If non-zero, the penalty replaces the
+    /// max liquidation fee of the liquidations config of the clob module.
+    #[prost(uint32, tag = "12")]
+    pub liquidation_penalty_ppm: u32,
 }
 impl ::prost::Name for LiquidityTier {
     const NAME: &'static str = "LiquidityTier";
@@ -221,6 +316,11 @@ pub struct Params {
     /// votes is smaller than this number, pad with zeros up to this number.
     #[prost(uint32, tag = "3")]
     pub min_num_votes_per_sample: u32,
+    /// Fee in parts-per-million of the notional of the change in open interest
+    /// of a perpetual caused by a fill, accrued to the open interest imbalance
+    /// pool of the perpetual. Zero disables the fee.
+    #[prost(uint32, tag = "4")]
+    pub open_interest_imbalance_fee_ppm: u32,
 }
 impl ::prost::Name for Params {
     const NAME: &'static str = "Params";
@@ -451,6 +551,79 @@ impl ::prost::Name for QueryParamsResponse {
         "/dydxprotocol.perpetuals.QueryParamsResponse".into()
     }
 }
+/// QueryHistoricalFundingIndexRequest is the request type for the
+/// HistoricalFundingIndex RPC method.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct QueryHistoricalFundingIndexRequest {
+    #[prost(uint32, tag = "1")]
+    pub perpetual_id: u32,
+    #[prost(uint32, tag = "2")]
+    pub height: u32,
+}
+impl ::prost::Name for QueryHistoricalFundingIndexRequest {
+    const NAME: &'static str = "QueryHistoricalFundingIndexRequest";
+    const PACKAGE: &'static str = "dydxprotocol.perpetuals";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.perpetuals.QueryHistoricalFundingIndexRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.perpetuals.QueryHistoricalFundingIndexRequest".into()
+    }
+}
+/// QueryHistoricalFundingIndexResponse is the response type for the
+/// HistoricalFundingIndex RPC method. The snapshot is the latest one recorded at
+/// or before the requested height.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryHistoricalFundingIndexResponse {
+    #[prost(message, optional, tag = "1")]
+    pub snapshot: ::core::option::Option<FundingIndexSnapshot>,
+}
+impl ::prost::Name for QueryHistoricalFundingIndexResponse {
+    const NAME: &'static str = "QueryHistoricalFundingIndexResponse";
+    const PACKAGE: &'static str = "dydxprotocol.perpetuals";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.perpetuals.QueryHistoricalFundingIndexResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.perpetuals.QueryHistoricalFundingIndexResponse".into()
+    }
+}
+/// QueryHistoricalMarginFractionsRequest is the request type for the
+/// HistoricalMarginFractions RPC method.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct QueryHistoricalMarginFractionsRequest {
+    #[prost(uint32, tag = "1")]
+    pub perpetual_id: u32,
+    #[prost(uint32, tag = "2")]
+    pub epoch: u32,
+}
+impl ::prost::Name for QueryHistoricalMarginFractionsRequest {
+    const NAME: &'static str = "QueryHistoricalMarginFractionsRequest";
+    const PACKAGE: &'static str = "dydxprotocol.perpetuals";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.perpetuals.QueryHistoricalMarginFractionsRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.perpetuals.QueryHistoricalMarginFractionsRequest".into()
+    }
+}
+/// QueryHistoricalMarginFractionsResponse is the response type for the
+/// HistoricalMarginFractions RPC method.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryHistoricalMarginFractionsResponse {
+    #[prost(message, optional, tag = "1")]
+    pub margin_fractions: ::core::option::Option<MarginFractions>,
+}
+impl ::prost::Name for QueryHistoricalMarginFractionsResponse {
+    const NAME: &'static str = "QueryHistoricalMarginFractionsResponse";
+    const PACKAGE: &'static str = "dydxprotocol.perpetuals";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.perpetuals.QueryHistoricalMarginFractionsResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.perpetuals.QueryHistoricalMarginFractionsResponse".into()
+    }
+}
 /// Generated client implementations.
 pub mod query_client {
     #![allow(
@@ -702,6 +875,67 @@ pub mod query_client {
                 .insert(GrpcMethod::new("dydxprotocol.perpetuals.Query", "Params"));
             self.inner.unary(req, path, codec).await
         }
+        /// Queries the funding index of a perpetual at a past block height.
+        pub async fn historical_funding_index(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryHistoricalFundingIndexRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryHistoricalFundingIndexResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.perpetuals.Query/HistoricalFundingIndex",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.perpetuals.Query",
+                        "HistoricalFundingIndex",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the margin fractions of a perpetual during a past funding-tick
+        /// epoch.
+        pub async fn historical_margin_fractions(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryHistoricalMarginFractionsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryHistoricalMarginFractionsResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.perpetuals.Query/HistoricalMarginFractions",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.perpetuals.Query",
+                        "HistoricalMarginFractions",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
     }
 }
 /// MsgCreatePerpetual is a message used by x/gov to create a new perpetual.
@@ -890,6 +1124,168 @@ impl ::prost::Name for MsgUpdateParamsResponse {
         "/dydxprotocol.perpetuals.MsgUpdateParamsResponse".into()
     }
 }
+/// MsgSetFundingRateCap is a message used by x/gov to set the cap on the
+/// absolute funding rate of a perpetual.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSetFundingRateCap {
+    /// The address that controls the module.
+    #[prost(string, tag = "1")]
+    pub authority: ::prost::alloc::string::String,
+    /// The id of the perpetual to set the funding rate cap of.
+    #[prost(uint32, tag = "2")]
+    pub perpetual_id: u32,
+    /// The cap on the absolute funding rate, in parts-per-million. Zero removes
+    /// the cap.
+    #[prost(uint32, tag = "3")]
+    pub funding_rate_cap_ppm: u32,
+}
+impl ::prost::Name for MsgSetFundingRateCap {
+    const NAME: &'static str = "MsgSetFundingRateCap";
+    const PACKAGE: &'static str = "dydxprotocol.perpetuals";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.perpetuals.MsgSetFundingRateCap".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.perpetuals.MsgSetFundingRateCap".into()
+    }
+}
+/// MsgSetFundingRateCapResponse defines the SetFundingRateCap response type.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct MsgSetFundingRateCapResponse {}
+impl ::prost::Name for MsgSetFundingRateCapResponse {
+    const NAME: &'static str = "MsgSetFundingRateCapResponse";
+    const PACKAGE: &'static str = "dydxprotocol.perpetuals";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.perpetuals.MsgSetFundingRateCapResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.perpetuals.MsgSetFundingRateCapResponse".into()
+    }
+}
+/// MsgSetMaxUnsettledFundingIndexDelta is a message used by x/gov to set the
+/// cap on the unsettled funding index delta of positions in a perpetual.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSetMaxUnsettledFundingIndexDelta {
+    /// The address that controls the module.
+    #[prost(string, tag = "1")]
+    pub authority: ::prost::alloc::string::String,
+    /// The id of the perpetual to set the cap of.
+    #[prost(uint32, tag = "2")]
+    pub perpetual_id: u32,
+    /// The cap on the absolute difference between the funding index of the
+    /// perpetual and the funding index of a position that is settled. Zero
+    /// removes the cap.
+    #[prost(uint64, tag = "3")]
+    pub max_unsettled_funding_index_delta: u64,
+}
+impl ::prost::Name for MsgSetMaxUnsettledFundingIndexDelta {
+    const NAME: &'static str = "MsgSetMaxUnsettledFundingIndexDelta";
+    const PACKAGE: &'static str = "dydxprotocol.perpetuals";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.perpetuals.MsgSetMaxUnsettledFundingIndexDelta".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.perpetuals.MsgSetMaxUnsettledFundingIndexDelta".into()
+    }
+}
+/// MsgSetMaxUnsettledFundingIndexDeltaResponse defines the
+/// SetMaxUnsettledFundingIndexDelta response type.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct MsgSetMaxUnsettledFundingIndexDeltaResponse {}
+impl ::prost::Name for MsgSetMaxUnsettledFundingIndexDeltaResponse {
+    const NAME: &'static str = "MsgSetMaxUnsettledFundingIndexDeltaResponse";
+    const PACKAGE: &'static str = "dydxprotocol.perpetuals";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.perpetuals.MsgSetMaxUnsettledFundingIndexDeltaResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.perpetuals.MsgSetMaxUnsettledFundingIndexDeltaResponse".into()
+    }
+}
+/// MsgSetSettlementPrice is a message used by x/gov to put a perpetual that is
+/// being wound down into, or take it out of, settlement mode.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSetSettlementPrice {
+    /// The address that controls the module.
+    #[prost(string, tag = "1")]
+    pub authority: ::prost::alloc::string::String,
+    /// The id of the perpetual to set the settlement price of.
+    #[prost(uint32, tag = "2")]
+    pub perpetual_id: u32,
+    /// The price at which positions in the perpetual are valued, in the exponent
+    /// of the market price of the perpetual. Zero takes the perpetual out of
+    /// settlement mode.
+    #[prost(uint64, tag = "3")]
+    pub settlement_price: u64,
+}
+impl ::prost::Name for MsgSetSettlementPrice {
+    const NAME: &'static str = "MsgSetSettlementPrice";
+    const PACKAGE: &'static str = "dydxprotocol.perpetuals";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.perpetuals.MsgSetSettlementPrice".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.perpetuals.MsgSetSettlementPrice".into()
+    }
+}
+/// MsgSetSettlementPriceResponse defines the SetSettlementPrice response type.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct MsgSetSettlementPriceResponse {}
+impl ::prost::Name for MsgSetSettlementPriceResponse {
+    const NAME: &'static str = "MsgSetSettlementPriceResponse";
+    const PACKAGE: &'static str = "dydxprotocol.perpetuals";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.perpetuals.MsgSetSettlementPriceResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.perpetuals.MsgSetSettlementPriceResponse".into()
+    }
+}
+/// MsgSetConcentrationMargin is a message used by x/gov to set the additional
+/// maintenance margin required of positions in a perpetual that make up a large
+/// share of its open interest.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSetConcentrationMargin {
+    /// The address that controls the module.
+    #[prost(string, tag = "1")]
+    pub authority: ::prost::alloc::string::String,
+    /// The id of the perpetual to set the concentration margin of.
+    #[prost(uint32, tag = "2")]
+    pub perpetual_id: u32,
+    /// The share of the open interest of the perpetual, in parts-per-million,
+    /// that the size of a position must exceed for the add-on to apply.
+    #[prost(uint32, tag = "3")]
+    pub threshold_ppm: u32,
+    /// The maintenance margin fraction added to concentrated positions, in
+    /// parts-per-million of their notional. Zero removes the concentration
+    /// margin.
+    #[prost(uint32, tag = "4")]
+    pub add_on_ppm: u32,
+}
+impl ::prost::Name for MsgSetConcentrationMargin {
+    const NAME: &'static str = "MsgSetConcentrationMargin";
+    const PACKAGE: &'static str = "dydxprotocol.perpetuals";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.perpetuals.MsgSetConcentrationMargin".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.perpetuals.MsgSetConcentrationMargin".into()
+    }
+}
+/// MsgSetConcentrationMarginResponse defines the SetConcentrationMargin
+/// response type.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct MsgSetConcentrationMarginResponse {}
+impl ::prost::Name for MsgSetConcentrationMarginResponse {
+    const NAME: &'static str = "MsgSetConcentrationMarginResponse";
+    const PACKAGE: &'static str = "dydxprotocol.perpetuals";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.perpetuals.MsgSetConcentrationMarginResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.perpetuals.MsgSetConcentrationMarginResponse".into()
+    }
+}
 /// Generated client implementations.
 pub mod msg_client {
     #![allow(
@@ -1121,5 +1517,122 @@ pub mod msg_client {
                 .insert(GrpcMethod::new("dydxprotocol.perpetuals.Msg", "UpdateParams"));
             self.inner.unary(req, path, codec).await
         }
+        /// SetFundingRateCap sets the cap on the funding rate of a perpetual.
+        pub async fn set_funding_rate_cap(
+            &mut self,
+            request: impl tonic::IntoRequest<super::MsgSetFundingRateCap>,
+        ) -> std::result::Result<
+            tonic::Response<super::MsgSetFundingRateCapResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.perpetuals.Msg/SetFundingRateCap",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("dydxprotocol.perpetuals.Msg", "SetFundingRateCap"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// SetMaxUnsettledFundingIndexDelta sets the cap on the unsettled funding
+        /// index delta of positions in a perpetual.
+        pub async fn set_max_unsettled_funding_index_delta(
+            &mut self,
+            request: impl tonic::IntoRequest<super::MsgSetMaxUnsettledFundingIndexDelta>,
+        ) -> std::result::Result<
+            tonic::Response<super::MsgSetMaxUnsettledFundingIndexDeltaResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.perpetuals.Msg/SetMaxUnsettledFundingIndexDelta",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.perpetuals.Msg",
+                        "SetMaxUnsettledFundingIndexDelta",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// SetSettlementPrice sets the settlement price of a perpetual that is being
+        /// wound down.
+        pub async fn set_settlement_price(
+            &mut self,
+            request: impl tonic::IntoRequest<super::MsgSetSettlementPrice>,
+        ) -> std::result::Result<
+            tonic::Response<super::MsgSetSettlementPriceResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.perpetuals.Msg/SetSettlementPrice",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("dydxprotocol.perpetuals.Msg", "SetSettlementPrice"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// SetConcentrationMargin sets the additional maintenance margin required of
+        /// concentrated positions in a perpetual.
+        pub async fn set_concentration_margin(
+            &mut self,
+            request: impl tonic::IntoRequest<super::MsgSetConcentrationMargin>,
+        ) -> std::result::Result<
+            tonic::Response<super::MsgSetConcentrationMarginResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.perpetuals.Msg/SetConcentrationMargin",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.perpetuals.Msg",
+                        "SetConcentrationMargin",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
     }
 }
@@ -64,6 +64,19 @@ pub struct MarketPrice {
     /// never been updated, `>0` otherwise.
     #[prost(uint64, tag = "3")]
     pub price: u64,
+    /// Whether the market price is negative. If set, the market price is
+    /// `-price * 10^exponent`. Defaults to `false`, a positive market price.
+    ///
+    /// This value is config-only and can only be set in the genesis state. Oracle
+    /// price updates only update `price` and keep the sign. Markets with a
+    /// negative price cannot be used as the market of an asset.
+    #[prost(bool, tag = "4")]
+    pub negative: bool,
+    /// The confidence interval of the market price, in the same units as
+    /// `price`. The market price is expected to be within `confidence` of the
+    /// reported price. Defaults to `0`, no uncertainty.
+    #[prost(uint64, tag = "5")]
+    pub confidence: u64,
 }
 impl ::prost::Name for MarketPrice {
     const NAME: &'static str = "MarketPrice";
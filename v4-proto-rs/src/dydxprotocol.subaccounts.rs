@@ -42,6 +42,12 @@ pub struct PerpetualPosition {
     /// The quote_balance of the `Perpetual`.
     #[prost(bytes = "vec", tag = "4")]
     pub quote_balance: ::prost::alloc::vec::Vec<u8>,
+    /// The signed notional paid to open the position in quote quantums, updated
+    /// using a weighted average on each fill. Positive for longs and negative for
+    /// shorts. The average entry price of the position is `cost_basis / quantums`.
+    /// Zero if the cost basis of the position is unknown.
+    #[prost(bytes = "vec", tag = "5")]
+    pub cost_basis: ::prost::alloc::vec::Vec<u8>,
 }
 impl ::prost::Name for PerpetualPosition {
     const NAME: &'static str = "PerpetualPosition";
@@ -180,175 +186,2938 @@ impl ::prost::Name for SubaccountAssetPosition {
         "/dydxprotocol.subaccounts.SubaccountAssetPosition".into()
     }
 }
+/// Params defines the parameters for x/subaccounts module.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct Params {
+    /// If true, only updates that reduce the risk of a subaccount are allowed
+    /// and all withdrawals and transfers are blocked.
+    #[prost(bool, tag = "1")]
+    pub trading_halted: bool,
+    /// The number of blocks a subaccount must remain below its maintenance
+    /// margin requirement before it can be liquidated. Zero disables the grace
+    /// period.
+    #[prost(uint32, tag = "2")]
+    pub liquidation_grace_period_blocks: u32,
+    /// The buffer, in parts-per-million of the initial margin requirement, that
+    /// the net collateral of a subaccount must meet after an update that opens or
+    /// increases a perpetual position. Zero disables the buffer.
+    #[prost(uint32, tag = "3")]
+    pub initial_margin_buffer_ppm: u32,
+    /// The maximum number of perpetual positions a subaccount may hold. Zero
+    /// disables the limit.
+    #[prost(uint32, tag = "4")]
+    pub max_perpetual_positions: u32,
+    /// If true, collateral held outside of the asset positions of subaccounts,
+    /// such as staked tokens, counts towards their net collateral.
+    #[prost(bool, tag = "5")]
+    pub external_collateral_enabled: bool,
+    /// The maximum number of perpetual positions whose risk is computed by a
+    /// sweep over all subaccounts in a single block. Sweeps that exceed the budget
+    /// resume from where they stopped in the next block. Zero resets the budget
+    /// to the default of 10,000.
+    #[prost(uint64, tag = "6")]
+    pub risk_sweep_budget: u64,
+    /// The threshold, in quote quantums, below which the absolute net collateral
+    /// of a subaccount with no perpetual positions is treated as dust. Dust
+    /// subaccounts are closed at the end of each block. Zero treats no subaccount
+    /// as dust.
+    #[prost(uint64, tag = "7")]
+    pub dust_nc_threshold: u64,
+}
+impl ::prost::Name for Params {
+    const NAME: &'static str = "Params";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.Params".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.Params".into()
+    }
+}
 /// GenesisState defines the subaccounts module's genesis state.
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct GenesisState {
-    #[prost(message, repeated, tag = "1")]
-    pub subaccounts: ::prost::alloc::vec::Vec<Subaccount>,
+pub struct GenesisState {
+    #[prost(message, repeated, tag = "1")]
+    pub subaccounts: ::prost::alloc::vec::Vec<Subaccount>,
+    /// The parameters of the module.
+    #[prost(message, optional, tag = "2")]
+    pub params: ::core::option::Option<Params>,
+    /// The self-imposed leverage caps of subaccounts.
+    #[prost(message, repeated, tag = "3")]
+    pub max_leverages: ::prost::alloc::vec::Vec<SubaccountMaxLeverage>,
+}
+impl ::prost::Name for GenesisState {
+    const NAME: &'static str = "GenesisState";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.GenesisState".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.GenesisState".into()
+    }
+}
+/// SubaccountMaxLeverage is the self-imposed leverage cap of a subaccount.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct SubaccountMaxLeverage {
+    /// The subaccount the leverage cap applies to.
+    #[prost(message, optional, tag = "1")]
+    pub subaccount_id: ::core::option::Option<SubaccountId>,
+    /// The leverage cap, in parts-per-million.
+    #[prost(uint32, tag = "2")]
+    pub max_leverage_ppm: u32,
+}
+impl ::prost::Name for SubaccountMaxLeverage {
+    const NAME: &'static str = "SubaccountMaxLeverage";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.SubaccountMaxLeverage".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.SubaccountMaxLeverage".into()
+    }
+}
+/// QueryGetSubaccountRequest is request type for the Query RPC method.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryGetSubaccountRequest {
+    #[prost(string, tag = "1")]
+    pub owner: ::prost::alloc::string::String,
+    #[prost(uint32, tag = "2")]
+    pub number: u32,
+}
+impl ::prost::Name for QueryGetSubaccountRequest {
+    const NAME: &'static str = "QueryGetSubaccountRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryGetSubaccountRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryGetSubaccountRequest".into()
+    }
+}
+/// QuerySubaccountResponse is response type for the Query RPC method.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QuerySubaccountResponse {
+    #[prost(message, optional, tag = "1")]
+    pub subaccount: ::core::option::Option<Subaccount>,
+}
+impl ::prost::Name for QuerySubaccountResponse {
+    const NAME: &'static str = "QuerySubaccountResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QuerySubaccountResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QuerySubaccountResponse".into()
+    }
+}
+/// QueryAllSubaccountRequest is request type for the Query RPC method.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryAllSubaccountRequest {
+    #[prost(message, optional, tag = "1")]
+    pub pagination: ::core::option::Option<
+        super::super::cosmos::base::query::v1beta1::PageRequest,
+    >,
+}
+impl ::prost::Name for QueryAllSubaccountRequest {
+    const NAME: &'static str = "QueryAllSubaccountRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryAllSubaccountRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryAllSubaccountRequest".into()
+    }
+}
+/// QuerySubaccountAllResponse is response type for the Query RPC method.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QuerySubaccountAllResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub subaccount: ::prost::alloc::vec::Vec<Subaccount>,
+    #[prost(message, optional, tag = "2")]
+    pub pagination: ::core::option::Option<
+        super::super::cosmos::base::query::v1beta1::PageResponse,
+    >,
+}
+impl ::prost::Name for QuerySubaccountAllResponse {
+    const NAME: &'static str = "QuerySubaccountAllResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QuerySubaccountAllResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QuerySubaccountAllResponse".into()
+    }
+}
+/// QueryGetWithdrawalAndTransfersBlockedInfoRequest is a request type for
+/// fetching information about whether withdrawals and transfers are blocked for
+/// a collateral pool associated with the passed in perpetual id.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct QueryGetWithdrawalAndTransfersBlockedInfoRequest {
+    #[prost(uint32, tag = "1")]
+    pub perpetual_id: u32,
+}
+impl ::prost::Name for QueryGetWithdrawalAndTransfersBlockedInfoRequest {
+    const NAME: &'static str = "QueryGetWithdrawalAndTransfersBlockedInfoRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryGetWithdrawalAndTransfersBlockedInfoRequest"
+            .into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryGetWithdrawalAndTransfersBlockedInfoRequest"
+            .into()
+    }
+}
+/// QueryGetWithdrawalAndTransfersBlockedInfoRequest is a response type for
+/// fetching information about whether withdrawals and transfers are blocked.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct QueryGetWithdrawalAndTransfersBlockedInfoResponse {
+    #[prost(uint32, tag = "1")]
+    pub negative_tnc_subaccount_seen_at_block: u32,
+    #[prost(uint32, tag = "2")]
+    pub chain_outage_seen_at_block: u32,
+    #[prost(uint32, tag = "3")]
+    pub withdrawals_and_transfers_unblocked_at_block: u32,
+}
+impl ::prost::Name for QueryGetWithdrawalAndTransfersBlockedInfoResponse {
+    const NAME: &'static str = "QueryGetWithdrawalAndTransfersBlockedInfoResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryGetWithdrawalAndTransfersBlockedInfoResponse"
+            .into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryGetWithdrawalAndTransfersBlockedInfoResponse"
+            .into()
+    }
+}
+/// QueryCollateralPoolAddressRequest is the request type for fetching the
+/// account address of the collateral pool associated with the passed in
+/// perpetual id.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct QueryCollateralPoolAddressRequest {
+    #[prost(uint32, tag = "1")]
+    pub perpetual_id: u32,
+}
+impl ::prost::Name for QueryCollateralPoolAddressRequest {
+    const NAME: &'static str = "QueryCollateralPoolAddressRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryCollateralPoolAddressRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryCollateralPoolAddressRequest".into()
+    }
+}
+/// QueryCollateralPoolAddressResponse is a response type for fetching the
+/// account address of the collateral pool associated with the passed in
+/// perpetual id.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryCollateralPoolAddressResponse {
+    #[prost(string, tag = "1")]
+    pub collateral_pool_address: ::prost::alloc::string::String,
+}
+impl ::prost::Name for QueryCollateralPoolAddressResponse {
+    const NAME: &'static str = "QueryCollateralPoolAddressResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryCollateralPoolAddressResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryCollateralPoolAddressResponse".into()
+    }
+}
+/// HypotheticalAssetUpdate is a change to an asset position of a hypothetical
+/// subaccount.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct HypotheticalAssetUpdate {
+    /// The `Id` of the `Asset`.
+    #[prost(uint32, tag = "1")]
+    pub asset_id: u32,
+    /// The signed change in the size of the position in base quantums.
+    #[prost(bytes = "vec", tag = "2")]
+    pub quantums_delta: ::prost::alloc::vec::Vec<u8>,
+}
+impl ::prost::Name for HypotheticalAssetUpdate {
+    const NAME: &'static str = "HypotheticalAssetUpdate";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.HypotheticalAssetUpdate".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.HypotheticalAssetUpdate".into()
+    }
+}
+/// HypotheticalPerpetualUpdate is a change to a perpetual position of a
+/// hypothetical subaccount.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct HypotheticalPerpetualUpdate {
+    /// The `Id` of the `Perpetual`.
+    #[prost(uint32, tag = "1")]
+    pub perpetual_id: u32,
+    /// The signed change in the size of the position in base quantums.
+    #[prost(bytes = "vec", tag = "2")]
+    pub quantums_delta: ::prost::alloc::vec::Vec<u8>,
+    /// The signed change in the quote balance of the position in quote quantums.
+    #[prost(bytes = "vec", tag = "3")]
+    pub quote_balance_delta: ::prost::alloc::vec::Vec<u8>,
+}
+impl ::prost::Name for HypotheticalPerpetualUpdate {
+    const NAME: &'static str = "HypotheticalPerpetualUpdate";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.HypotheticalPerpetualUpdate".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.HypotheticalPerpetualUpdate".into()
+    }
+}
+/// QueryComputeRiskForHypotheticalRequest is the request type for computing the
+/// risk of a hypothetical subaccount. The subaccount's positions are treated as
+/// settled.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryComputeRiskForHypotheticalRequest {
+    #[prost(message, optional, tag = "1")]
+    pub subaccount: ::core::option::Option<Subaccount>,
+    #[prost(message, repeated, tag = "2")]
+    pub asset_updates: ::prost::alloc::vec::Vec<HypotheticalAssetUpdate>,
+    #[prost(message, repeated, tag = "3")]
+    pub perpetual_updates: ::prost::alloc::vec::Vec<HypotheticalPerpetualUpdate>,
+}
+impl ::prost::Name for QueryComputeRiskForHypotheticalRequest {
+    const NAME: &'static str = "QueryComputeRiskForHypotheticalRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryComputeRiskForHypotheticalRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryComputeRiskForHypotheticalRequest".into()
+    }
+}
+/// QueryComputeRiskForHypotheticalResponse is the response type for computing
+/// the risk of a hypothetical subaccount. All values are in quote quantums.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryComputeRiskForHypotheticalResponse {
+    #[prost(bytes = "vec", tag = "1")]
+    pub net_collateral: ::prost::alloc::vec::Vec<u8>,
+    #[prost(bytes = "vec", tag = "2")]
+    pub initial_margin_requirement: ::prost::alloc::vec::Vec<u8>,
+    #[prost(bytes = "vec", tag = "3")]
+    pub maintenance_margin_requirement: ::prost::alloc::vec::Vec<u8>,
+}
+impl ::prost::Name for QueryComputeRiskForHypotheticalResponse {
+    const NAME: &'static str = "QueryComputeRiskForHypotheticalResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryComputeRiskForHypotheticalResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryComputeRiskForHypotheticalResponse".into()
+    }
+}
+/// QueryRiskInputsRequest is the request type for fetching the risk inputs of a
+/// subaccount.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryRiskInputsRequest {
+    #[prost(string, tag = "1")]
+    pub owner: ::prost::alloc::string::String,
+    #[prost(uint32, tag = "2")]
+    pub number: u32,
+}
+impl ::prost::Name for QueryRiskInputsRequest {
+    const NAME: &'static str = "QueryRiskInputsRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryRiskInputsRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryRiskInputsRequest".into()
+    }
+}
+/// PerpetualRiskInputs is the state of a perpetual used to compute the risk of
+/// a position in it.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct PerpetualRiskInputs {
+    #[prost(message, optional, tag = "1")]
+    pub perpetual: ::core::option::Option<super::perpetuals::Perpetual>,
+    #[prost(message, optional, tag = "2")]
+    pub market_price: ::core::option::Option<super::prices::MarketPrice>,
+    #[prost(message, optional, tag = "3")]
+    pub liquidity_tier: ::core::option::Option<super::perpetuals::LiquidityTier>,
+}
+impl ::prost::Name for PerpetualRiskInputs {
+    const NAME: &'static str = "PerpetualRiskInputs";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.PerpetualRiskInputs".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.PerpetualRiskInputs".into()
+    }
+}
+/// QueryRiskInputsResponse is the response type for fetching the risk inputs of
+/// a subaccount.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryRiskInputsResponse {
+    /// The subaccount with all outstanding funding applied to its USDC asset
+    /// position.
+    #[prost(message, optional, tag = "1")]
+    pub settled_subaccount: ::core::option::Option<Subaccount>,
+    /// The state of every perpetual the subaccount holds a position in.
+    #[prost(message, repeated, tag = "2")]
+    pub perpetuals: ::prost::alloc::vec::Vec<PerpetualRiskInputs>,
+    /// The collateral provided by sources outside of the subaccount, such as
+    /// staked tokens, in quote quantums. Added to the net collateral.
+    #[prost(bytes = "vec", tag = "3")]
+    pub external_collateral: ::prost::alloc::vec::Vec<u8>,
+    /// The collateral locked by pending withdrawals, in quote quantums.
+    /// Subtracted from the net collateral.
+    #[prost(uint64, tag = "4")]
+    pub locked_collateral: u64,
+}
+impl ::prost::Name for QueryRiskInputsResponse {
+    const NAME: &'static str = "QueryRiskInputsResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryRiskInputsResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryRiskInputsResponse".into()
+    }
+}
+/// QuerySubaccountUtilizationRequest is the request type for fetching the
+/// utilization of a subaccount.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QuerySubaccountUtilizationRequest {
+    #[prost(string, tag = "1")]
+    pub owner: ::prost::alloc::string::String,
+    #[prost(uint32, tag = "2")]
+    pub number: u32,
+}
+impl ::prost::Name for QuerySubaccountUtilizationRequest {
+    const NAME: &'static str = "QuerySubaccountUtilizationRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QuerySubaccountUtilizationRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QuerySubaccountUtilizationRequest".into()
+    }
+}
+/// QuerySubaccountUtilizationResponse is the response type for fetching the
+/// utilization of a subaccount.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct QuerySubaccountUtilizationResponse {
+    /// The ratio of the initial margin requirement of the subaccount to its net
+    /// collateral, including unsettled funding and external collateral, in
+    /// parts-per-million rounded up. Zero if the subaccount is over-utilized.
+    #[prost(uint64, tag = "1")]
+    pub utilization_ppm: u64,
+    /// True if the subaccount has non-positive net collateral.
+    #[prost(bool, tag = "2")]
+    pub over_utilized: bool,
+}
+impl ::prost::Name for QuerySubaccountUtilizationResponse {
+    const NAME: &'static str = "QuerySubaccountUtilizationResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QuerySubaccountUtilizationResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QuerySubaccountUtilizationResponse".into()
+    }
+}
+/// QueryTotalValueLockedRequest is the request type for fetching the total
+/// value locked across all subaccounts.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct QueryTotalValueLockedRequest {}
+impl ::prost::Name for QueryTotalValueLockedRequest {
+    const NAME: &'static str = "QueryTotalValueLockedRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryTotalValueLockedRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryTotalValueLockedRequest".into()
+    }
+}
+/// QueryTotalValueLockedResponse is the response type for fetching the total
+/// value locked across all subaccounts.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryTotalValueLockedResponse {
+    /// The sum of the net collateral of all subaccounts with positive net
+    /// collateral, in quote quantums.
+    #[prost(bytes = "vec", tag = "1")]
+    pub total_value_locked: ::prost::alloc::vec::Vec<u8>,
+    /// The sum of the net collateral of all subaccounts with non-positive net
+    /// collateral, in quote quantums.
+    #[prost(bytes = "vec", tag = "2")]
+    pub total_negative_net_collateral: ::prost::alloc::vec::Vec<u8>,
+}
+impl ::prost::Name for QueryTotalValueLockedResponse {
+    const NAME: &'static str = "QueryTotalValueLockedResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryTotalValueLockedResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryTotalValueLockedResponse".into()
+    }
+}
+/// PositionLiquidationPrices holds the oracle prices of a perpetual at which a
+/// subaccount becomes liquidatable and bankrupt. Both prices are marginal: each
+/// assumes that only the price of this perpetual moves while the prices of all
+/// other markets stay fixed.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct PositionLiquidationPrices {
+    #[prost(uint32, tag = "1")]
+    pub perpetual_id: u32,
+    /// The price at which the net collateral of the subaccount falls below its
+    /// maintenance margin requirement. Only meaningful if
+    /// `has_liquidation_price` is true.
+    #[prost(uint64, tag = "2")]
+    pub liquidation_price: u64,
+    #[prost(bool, tag = "3")]
+    pub has_liquidation_price: bool,
+    /// The price at which the net collateral of the subaccount becomes
+    /// negative. Only meaningful if `has_bankruptcy_price` is true.
+    #[prost(uint64, tag = "4")]
+    pub bankruptcy_price: u64,
+    #[prost(bool, tag = "5")]
+    pub has_bankruptcy_price: bool,
+}
+impl ::prost::Name for PositionLiquidationPrices {
+    const NAME: &'static str = "PositionLiquidationPrices";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.PositionLiquidationPrices".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.PositionLiquidationPrices".into()
+    }
+}
+/// QuerySubaccountLiquidationPricesRequest is the request type for fetching
+/// the liquidation prices of a subaccount.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QuerySubaccountLiquidationPricesRequest {
+    #[prost(string, tag = "1")]
+    pub owner: ::prost::alloc::string::String,
+    #[prost(uint32, tag = "2")]
+    pub number: u32,
+}
+impl ::prost::Name for QuerySubaccountLiquidationPricesRequest {
+    const NAME: &'static str = "QuerySubaccountLiquidationPricesRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QuerySubaccountLiquidationPricesRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QuerySubaccountLiquidationPricesRequest".into()
+    }
+}
+/// QuerySubaccountLiquidationPricesResponse is the response type for fetching
+/// the liquidation prices of a subaccount.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QuerySubaccountLiquidationPricesResponse {
+    /// The prices of every open perpetual position, ordered by perpetual id.
+    #[prost(message, repeated, tag = "1")]
+    pub prices: ::prost::alloc::vec::Vec<PositionLiquidationPrices>,
+}
+impl ::prost::Name for QuerySubaccountLiquidationPricesResponse {
+    const NAME: &'static str = "QuerySubaccountLiquidationPricesResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QuerySubaccountLiquidationPricesResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QuerySubaccountLiquidationPricesResponse".into()
+    }
+}
+/// BasketWeight is the weight of a perpetual in a correlated basket. A shock of
+/// `shock_ppm` to the basket moves the oracle price of the perpetual by
+/// `shock_ppm * weight_ppm / 1,000,000` parts-per-million.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct BasketWeight {
+    #[prost(uint32, tag = "1")]
+    pub perpetual_id: u32,
+    #[prost(int32, tag = "2")]
+    pub weight_ppm: i32,
+}
+impl ::prost::Name for BasketWeight {
+    const NAME: &'static str = "BasketWeight";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.BasketWeight".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.BasketWeight".into()
+    }
+}
+/// QueryBasketShockToLiquidateRequest is the request type for computing the
+/// smallest basket shock that liquidates a subaccount.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBasketShockToLiquidateRequest {
+    #[prost(string, tag = "1")]
+    pub owner: ::prost::alloc::string::String,
+    #[prost(uint32, tag = "2")]
+    pub number: u32,
+    /// The weight of each perpetual of the basket. If empty, the basket is every
+    /// perpetual the subaccount has a position in, perfectly correlated.
+    #[prost(message, repeated, tag = "3")]
+    pub weights: ::prost::alloc::vec::Vec<BasketWeight>,
+}
+impl ::prost::Name for QueryBasketShockToLiquidateRequest {
+    const NAME: &'static str = "QueryBasketShockToLiquidateRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryBasketShockToLiquidateRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryBasketShockToLiquidateRequest".into()
+    }
+}
+/// QueryBasketShockToLiquidateResponse is the response type for computing the
+/// smallest basket shock that liquidates a subaccount. The risk is in quote
+/// quantums.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBasketShockToLiquidateResponse {
+    /// False if no shock in either direction makes the subaccount liquidatable.
+    #[prost(bool, tag = "1")]
+    pub found: bool,
+    /// The smallest liquidating shock in parts-per-million. Negative shocks move
+    /// the prices of perpetuals with positive weights down.
+    #[prost(int64, tag = "2")]
+    pub shock_ppm: i64,
+    /// The risk of the subaccount under the shock.
+    #[prost(bytes = "vec", tag = "3")]
+    pub net_collateral: ::prost::alloc::vec::Vec<u8>,
+    #[prost(bytes = "vec", tag = "4")]
+    pub initial_margin_requirement: ::prost::alloc::vec::Vec<u8>,
+    #[prost(bytes = "vec", tag = "5")]
+    pub maintenance_margin_requirement: ::prost::alloc::vec::Vec<u8>,
+}
+impl ::prost::Name for QueryBasketShockToLiquidateResponse {
+    const NAME: &'static str = "QueryBasketShockToLiquidateResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryBasketShockToLiquidateResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryBasketShockToLiquidateResponse".into()
+    }
+}
+/// PerpetualMaintenanceMargin is the total maintenance margin requirement of
+/// the positions in a perpetual, in quote quantums.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct PerpetualMaintenanceMargin {
+    #[prost(uint32, tag = "1")]
+    pub perpetual_id: u32,
+    #[prost(bytes = "vec", tag = "2")]
+    pub maintenance_margin: ::prost::alloc::vec::Vec<u8>,
+}
+impl ::prost::Name for PerpetualMaintenanceMargin {
+    const NAME: &'static str = "PerpetualMaintenanceMargin";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.PerpetualMaintenanceMargin".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.PerpetualMaintenanceMargin".into()
+    }
+}
+/// QueryTotalMaintenanceMarginRequest is the request type for fetching the
+/// total maintenance margin requirement of all subaccounts.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct QueryTotalMaintenanceMarginRequest {
+    /// Whether to also return the total maintenance margin requirement of the
+    /// positions in each perpetual.
+    #[prost(bool, tag = "1")]
+    pub by_perpetual: bool,
+}
+impl ::prost::Name for QueryTotalMaintenanceMarginRequest {
+    const NAME: &'static str = "QueryTotalMaintenanceMarginRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryTotalMaintenanceMarginRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryTotalMaintenanceMarginRequest".into()
+    }
+}
+/// QueryTotalMaintenanceMarginResponse is the response type for fetching the
+/// total maintenance margin requirement of all subaccounts. All values are in
+/// quote quantums.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryTotalMaintenanceMarginResponse {
+    #[prost(bytes = "vec", tag = "1")]
+    pub total: ::prost::alloc::vec::Vec<u8>,
+    /// The total maintenance margin requirement of the positions in each
+    /// perpetual, ordered by perpetual id. Only set if `by_perpetual` is true.
+    #[prost(message, repeated, tag = "2")]
+    pub perpetuals: ::prost::alloc::vec::Vec<PerpetualMaintenanceMargin>,
+}
+impl ::prost::Name for QueryTotalMaintenanceMarginResponse {
+    const NAME: &'static str = "QueryTotalMaintenanceMarginResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryTotalMaintenanceMarginResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryTotalMaintenanceMarginResponse".into()
+    }
+}
+/// QueryPositionNotionalRequest is the request type for fetching the notional
+/// of a position.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryPositionNotionalRequest {
+    #[prost(string, tag = "1")]
+    pub owner: ::prost::alloc::string::String,
+    #[prost(uint32, tag = "2")]
+    pub number: u32,
+    #[prost(uint32, tag = "3")]
+    pub perpetual_id: u32,
+}
+impl ::prost::Name for QueryPositionNotionalRequest {
+    const NAME: &'static str = "QueryPositionNotionalRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryPositionNotionalRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryPositionNotionalRequest".into()
+    }
+}
+/// QueryPositionNotionalResponse is the response type for fetching the
+/// notional of a position.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryPositionNotionalResponse {
+    /// The absolute notional of the position at the current market price, in
+    /// quote quantums. Zero if the subaccount has no position in the perpetual.
+    #[prost(bytes = "vec", tag = "1")]
+    pub notional: ::prost::alloc::vec::Vec<u8>,
+}
+impl ::prost::Name for QueryPositionNotionalResponse {
+    const NAME: &'static str = "QueryPositionNotionalResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryPositionNotionalResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryPositionNotionalResponse".into()
+    }
+}
+/// QueryMarketExponentMigrationImpactRequest is the request type for fetching
+/// the impact of a price exponent migration.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct QueryMarketExponentMigrationImpactRequest {
+    #[prost(uint32, tag = "1")]
+    pub market_id: u32,
+    #[prost(int32, tag = "2")]
+    pub proposed_exponent: i32,
+}
+impl ::prost::Name for QueryMarketExponentMigrationImpactRequest {
+    const NAME: &'static str = "QueryMarketExponentMigrationImpactRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryMarketExponentMigrationImpactRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryMarketExponentMigrationImpactRequest".into()
+    }
+}
+/// QueryMarketExponentMigrationImpactResponse is the response type for
+/// fetching the impact of a price exponent migration.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryMarketExponentMigrationImpactResponse {
+    /// The aggregate notional of all positions in perpetuals of the market under
+    /// the current exponent, in quote quantums.
+    #[prost(bytes = "vec", tag = "1")]
+    pub current_notional: ::prost::alloc::vec::Vec<u8>,
+    /// The aggregate notional of the same positions under the proposed exponent
+    /// with the same integer price, in quote quantums.
+    #[prost(bytes = "vec", tag = "2")]
+    pub proposed_notional: ::prost::alloc::vec::Vec<u8>,
+    /// The subaccounts that are not liquidatable under the current exponent but
+    /// would be under the proposed exponent.
+    #[prost(message, repeated, tag = "3")]
+    pub newly_liquidatable: ::prost::alloc::vec::Vec<SubaccountId>,
+}
+impl ::prost::Name for QueryMarketExponentMigrationImpactResponse {
+    const NAME: &'static str = "QueryMarketExponentMigrationImpactResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryMarketExponentMigrationImpactResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryMarketExponentMigrationImpactResponse".into()
+    }
+}
+/// QueryRiskAtHeightRequest is the request type for fetching the risk of a
+/// subaccount at a height.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryRiskAtHeightRequest {
+    #[prost(string, tag = "1")]
+    pub owner: ::prost::alloc::string::String,
+    #[prost(uint32, tag = "2")]
+    pub number: u32,
+    /// Only heights whose state has not been pruned by the node can be queried.
+    #[prost(int64, tag = "3")]
+    pub height: i64,
+}
+impl ::prost::Name for QueryRiskAtHeightRequest {
+    const NAME: &'static str = "QueryRiskAtHeightRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryRiskAtHeightRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryRiskAtHeightRequest".into()
+    }
+}
+/// QueryRiskAtHeightResponse is the response type for fetching the risk of a
+/// subaccount at a height. The risk is in quote quantums.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryRiskAtHeightResponse {
+    #[prost(bytes = "vec", tag = "1")]
+    pub net_collateral: ::prost::alloc::vec::Vec<u8>,
+    #[prost(bytes = "vec", tag = "2")]
+    pub initial_margin_requirement: ::prost::alloc::vec::Vec<u8>,
+    #[prost(bytes = "vec", tag = "3")]
+    pub maintenance_margin_requirement: ::prost::alloc::vec::Vec<u8>,
+}
+impl ::prost::Name for QueryRiskAtHeightResponse {
+    const NAME: &'static str = "QueryRiskAtHeightResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryRiskAtHeightResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryRiskAtHeightResponse".into()
+    }
+}
+/// QueryHealthDistributionRequest is the request type for fetching the
+/// distribution of the health of all subaccounts.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryHealthDistributionRequest {
+    /// The strictly ascending upper bounds of the health buckets, as health in
+    /// parts-per-million.
+    #[prost(uint32, repeated, tag = "1")]
+    pub buckets: ::prost::alloc::vec::Vec<u32>,
+}
+impl ::prost::Name for QueryHealthDistributionRequest {
+    const NAME: &'static str = "QueryHealthDistributionRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryHealthDistributionRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryHealthDistributionRequest".into()
+    }
+}
+/// QueryHealthDistributionResponse is the response type for fetching the
+/// distribution of the health of all subaccounts.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryHealthDistributionResponse {
+    /// The number of subaccounts in each bucket. The last of the `len(buckets) + 1`
+    /// counts is the number of subaccounts with a health of at least the last
+    /// bound, including subaccounts with no maintenance margin requirement.
+    #[prost(uint64, repeated, tag = "1")]
+    pub counts: ::prost::alloc::vec::Vec<u64>,
+}
+impl ::prost::Name for QueryHealthDistributionResponse {
+    const NAME: &'static str = "QueryHealthDistributionResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryHealthDistributionResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryHealthDistributionResponse".into()
+    }
+}
+/// QueryMarketNcSensitivityRequest is the request type for fetching the
+/// sensitivity of the total net collateral to the price of a perpetual.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct QueryMarketNcSensitivityRequest {
+    #[prost(uint32, tag = "1")]
+    pub perpetual_id: u32,
+}
+impl ::prost::Name for QueryMarketNcSensitivityRequest {
+    const NAME: &'static str = "QueryMarketNcSensitivityRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryMarketNcSensitivityRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryMarketNcSensitivityRequest".into()
+    }
+}
+/// QueryMarketNcSensitivityResponse is the response type for fetching the
+/// sensitivity of the total net collateral to the price of a perpetual.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryMarketNcSensitivityResponse {
+    /// The net signed quantums of all positions in the perpetual.
+    #[prost(bytes = "vec", tag = "1")]
+    pub net_quantums: ::prost::alloc::vec::Vec<u8>,
+    /// The exact change in the total net collateral in quote quantums when the
+    /// market price moves up by one unit, formatted as an integer or as a
+    /// fraction `a/b`.
+    #[prost(string, tag = "2")]
+    pub nc_per_price_unit: ::prost::alloc::string::String,
+}
+impl ::prost::Name for QueryMarketNcSensitivityResponse {
+    const NAME: &'static str = "QueryMarketNcSensitivityResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryMarketNcSensitivityResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryMarketNcSensitivityResponse".into()
+    }
+}
+/// QueryWithdrawableCheckRequest is the request type for checking a withdrawal
+/// from a subaccount.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryWithdrawableCheckRequest {
+    #[prost(string, tag = "1")]
+    pub owner: ::prost::alloc::string::String,
+    #[prost(uint32, tag = "2")]
+    pub number: u32,
+    /// The amount to withdraw in USDC quote quantums.
+    #[prost(uint64, tag = "3")]
+    pub quote_quantums: u64,
+}
+impl ::prost::Name for QueryWithdrawableCheckRequest {
+    const NAME: &'static str = "QueryWithdrawableCheckRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryWithdrawableCheckRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryWithdrawableCheckRequest".into()
+    }
+}
+/// QueryWithdrawableCheckResponse is the response type for checking a
+/// withdrawal from a subaccount.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryWithdrawableCheckResponse {
+    /// Whether the withdrawal would leave the subaccount collateralized.
+    #[prost(bool, tag = "1")]
+    pub allowed: bool,
+    /// The maximum amount that can be withdrawn in USDC quote quantums.
+    #[prost(bytes = "vec", tag = "2")]
+    pub max_withdrawable: ::prost::alloc::vec::Vec<u8>,
+}
+impl ::prost::Name for QueryWithdrawableCheckResponse {
+    const NAME: &'static str = "QueryWithdrawableCheckResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryWithdrawableCheckResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryWithdrawableCheckResponse".into()
+    }
+}
+/// QueryRealizedPnlOnCloseRequest is the request type for fetching the PnL of
+/// closing a position.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryRealizedPnlOnCloseRequest {
+    #[prost(string, tag = "1")]
+    pub owner: ::prost::alloc::string::String,
+    #[prost(uint32, tag = "2")]
+    pub number: u32,
+    #[prost(uint32, tag = "3")]
+    pub perpetual_id: u32,
+}
+impl ::prost::Name for QueryRealizedPnlOnCloseRequest {
+    const NAME: &'static str = "QueryRealizedPnlOnCloseRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryRealizedPnlOnCloseRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryRealizedPnlOnCloseRequest".into()
+    }
+}
+/// QueryRealizedPnlOnCloseResponse is the response type for fetching the PnL of
+/// closing a position.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryRealizedPnlOnCloseResponse {
+    /// The PnL of closing the position at the current market price in quote
+    /// quantums, negative for a loss. Funding and fees are not included.
+    #[prost(bytes = "vec", tag = "1")]
+    pub pnl: ::prost::alloc::vec::Vec<u8>,
+}
+impl ::prost::Name for QueryRealizedPnlOnCloseResponse {
+    const NAME: &'static str = "QueryRealizedPnlOnCloseResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryRealizedPnlOnCloseResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryRealizedPnlOnCloseResponse".into()
+    }
+}
+/// QueryProtocolNetDeltaRequest is the request type for fetching the net
+/// position of all subaccounts in a perpetual.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct QueryProtocolNetDeltaRequest {
+    #[prost(uint32, tag = "1")]
+    pub perpetual_id: u32,
+}
+impl ::prost::Name for QueryProtocolNetDeltaRequest {
+    const NAME: &'static str = "QueryProtocolNetDeltaRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryProtocolNetDeltaRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryProtocolNetDeltaRequest".into()
+    }
+}
+/// QueryProtocolNetDeltaResponse is the response type for fetching the net
+/// position of all subaccounts in a perpetual.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryProtocolNetDeltaResponse {
+    /// The sum of the signed base quantums of the positions of all subaccounts in
+    /// the perpetual. Nonzero if the protocol has residual exposure.
+    #[prost(bytes = "vec", tag = "1")]
+    pub net_delta: ::prost::alloc::vec::Vec<u8>,
+}
+impl ::prost::Name for QueryProtocolNetDeltaResponse {
+    const NAME: &'static str = "QueryProtocolNetDeltaResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryProtocolNetDeltaResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryProtocolNetDeltaResponse".into()
+    }
+}
+/// EpochFunding is the funding settled for a perpetual position of a subaccount
+/// during a funding-tick epoch.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EpochFunding {
+    #[prost(uint32, tag = "1")]
+    pub epoch: u32,
+    /// The funding settled in quote quantums. Positive if the subaccount paid
+    /// funding, and negative if the subaccount received funding.
+    #[prost(bytes = "vec", tag = "2")]
+    pub funding_paid: ::prost::alloc::vec::Vec<u8>,
+}
+impl ::prost::Name for EpochFunding {
+    const NAME: &'static str = "EpochFunding";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.EpochFunding".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.EpochFunding".into()
+    }
+}
+/// QueryFundingHistoryRequest is the request type for fetching the funding
+/// history of a position.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryFundingHistoryRequest {
+    #[prost(string, tag = "1")]
+    pub owner: ::prost::alloc::string::String,
+    #[prost(uint32, tag = "2")]
+    pub number: u32,
+    #[prost(uint32, tag = "3")]
+    pub perpetual_id: u32,
+    /// The first funding-tick epoch of the range, inclusive.
+    #[prost(uint32, tag = "4")]
+    pub from_epoch: u32,
+    /// The last funding-tick epoch of the range, inclusive. The range can span at
+    /// most `FundingHistoryRetentionEpochs` epochs.
+    #[prost(uint32, tag = "5")]
+    pub to_epoch: u32,
+}
+impl ::prost::Name for QueryFundingHistoryRequest {
+    const NAME: &'static str = "QueryFundingHistoryRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryFundingHistoryRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryFundingHistoryRequest".into()
+    }
+}
+/// QueryFundingHistoryResponse is the response type for fetching the funding
+/// history of a position.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryFundingHistoryResponse {
+    /// The funding settled during each epoch of the range, in ascending order of
+    /// epoch. Epochs during which no funding was settled are omitted.
+    #[prost(message, repeated, tag = "1")]
+    pub entries: ::prost::alloc::vec::Vec<EpochFunding>,
+}
+impl ::prost::Name for QueryFundingHistoryResponse {
+    const NAME: &'static str = "QueryFundingHistoryResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryFundingHistoryResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryFundingHistoryResponse".into()
+    }
+}
+/// InitialMarginRestoringClose is a close of a perpetual position at the market
+/// price that makes a subaccount initially collateralized.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct InitialMarginRestoringClose {
+    #[prost(uint32, tag = "1")]
+    pub perpetual_id: u32,
+    /// The absolute number of base quantums of the position to close.
+    #[prost(bytes = "vec", tag = "2")]
+    pub quantums: ::prost::alloc::vec::Vec<u8>,
+    /// The absolute notional of the closed quantums in quote quantums.
+    #[prost(bytes = "vec", tag = "3")]
+    pub abs_notional: ::prost::alloc::vec::Vec<u8>,
+}
+impl ::prost::Name for InitialMarginRestoringClose {
+    const NAME: &'static str = "InitialMarginRestoringClose";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.InitialMarginRestoringClose".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.InitialMarginRestoringClose".into()
+    }
+}
+/// QueryActionToRestoreInitialMarginRequest is the request type for fetching
+/// the closes that restore the initial margin of a subaccount.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryActionToRestoreInitialMarginRequest {
+    #[prost(string, tag = "1")]
+    pub owner: ::prost::alloc::string::String,
+    #[prost(uint32, tag = "2")]
+    pub number: u32,
+}
+impl ::prost::Name for QueryActionToRestoreInitialMarginRequest {
+    const NAME: &'static str = "QueryActionToRestoreInitialMarginRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryActionToRestoreInitialMarginRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryActionToRestoreInitialMarginRequest".into()
+    }
+}
+/// QueryActionToRestoreInitialMarginResponse is the response type for fetching
+/// the closes that restore the initial margin of a subaccount.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryActionToRestoreInitialMarginResponse {
+    /// Whether the subaccount is already initially collateralized, in which
+    /// case no closes are needed.
+    #[prost(bool, tag = "1")]
+    pub initially_collateralized: bool,
+    /// The smallest close of each position that restores the initial margin of
+    /// the subaccount, in ascending order of perpetual id. Positions that do not
+    /// restore it even when fully closed are omitted.
+    #[prost(message, repeated, tag = "2")]
+    pub closes: ::prost::alloc::vec::Vec<InitialMarginRestoringClose>,
+    /// The close in `closes` with the smallest notional, unset if there are no
+    /// closes.
+    #[prost(message, optional, tag = "3")]
+    pub cheapest: ::core::option::Option<InitialMarginRestoringClose>,
+}
+impl ::prost::Name for QueryActionToRestoreInitialMarginResponse {
+    const NAME: &'static str = "QueryActionToRestoreInitialMarginResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryActionToRestoreInitialMarginResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryActionToRestoreInitialMarginResponse".into()
+    }
+}
+/// QueryLossBufferToLiquidationRequest is the request type for fetching the
+/// loss buffer of a position to liquidation.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryLossBufferToLiquidationRequest {
+    #[prost(string, tag = "1")]
+    pub owner: ::prost::alloc::string::String,
+    #[prost(uint32, tag = "2")]
+    pub number: u32,
+    #[prost(uint32, tag = "3")]
+    pub perpetual_id: u32,
+}
+impl ::prost::Name for QueryLossBufferToLiquidationRequest {
+    const NAME: &'static str = "QueryLossBufferToLiquidationRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryLossBufferToLiquidationRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryLossBufferToLiquidationRequest".into()
+    }
+}
+/// QueryLossBufferToLiquidationResponse is the response type for fetching the
+/// loss buffer of a position to liquidation.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryLossBufferToLiquidationResponse {
+    /// False if the subaccount has no position in the perpetual or if no price of
+    /// the perpetual makes the subaccount liquidatable.
+    #[prost(bool, tag = "1")]
+    pub found: bool,
+    /// The decrease in the net collateral of the subaccount in quote quantums
+    /// when the price moves to the liquidation price.
+    #[prost(bytes = "vec", tag = "2")]
+    pub loss_buffer: ::prost::alloc::vec::Vec<u8>,
+    /// The distance from the current price to the liquidation price.
+    #[prost(uint64, tag = "3")]
+    pub price_move: u64,
+}
+impl ::prost::Name for QueryLossBufferToLiquidationResponse {
+    const NAME: &'static str = "QueryLossBufferToLiquidationResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryLossBufferToLiquidationResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryLossBufferToLiquidationResponse".into()
+    }
+}
+/// QueryMarketUnrealizedPnlRequest is the request type for fetching the
+/// unrealized PnL of a market.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct QueryMarketUnrealizedPnlRequest {
+    #[prost(uint32, tag = "1")]
+    pub perpetual_id: u32,
+}
+impl ::prost::Name for QueryMarketUnrealizedPnlRequest {
+    const NAME: &'static str = "QueryMarketUnrealizedPnlRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryMarketUnrealizedPnlRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryMarketUnrealizedPnlRequest".into()
+    }
+}
+/// QueryMarketUnrealizedPnlResponse is the response type for fetching the
+/// unrealized PnL of a market.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryMarketUnrealizedPnlResponse {
+    /// The sum of the unrealized PnL of all positions in the perpetual in quote
+    /// quantums. Positions whose cost basis is unknown are skipped.
+    #[prost(bytes = "vec", tag = "1")]
+    pub pnl: ::prost::alloc::vec::Vec<u8>,
+}
+impl ::prost::Name for QueryMarketUnrealizedPnlResponse {
+    const NAME: &'static str = "QueryMarketUnrealizedPnlResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryMarketUnrealizedPnlResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryMarketUnrealizedPnlResponse".into()
+    }
+}
+/// AutoWithdrawableAccount is a subaccount whose free collateral exceeds an
+/// auto-withdrawal threshold.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct AutoWithdrawableAccount {
+    #[prost(message, optional, tag = "1")]
+    pub subaccount_id: ::core::option::Option<SubaccountId>,
+    /// The amount that can be withdrawn in quote quantums.
+    #[prost(bytes = "vec", tag = "2")]
+    pub quote_quantums: ::prost::alloc::vec::Vec<u8>,
+}
+impl ::prost::Name for AutoWithdrawableAccount {
+    const NAME: &'static str = "AutoWithdrawableAccount";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.AutoWithdrawableAccount".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.AutoWithdrawableAccount".into()
+    }
+}
+/// QueryAutoWithdrawableAccountsRequest is the request type for fetching the
+/// subaccounts that can be auto-withdrawn from.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct QueryAutoWithdrawableAccountsRequest {
+    /// Only subaccounts whose free collateral exceeds the threshold are
+    /// returned.
+    #[prost(uint64, tag = "1")]
+    pub threshold_quote_quantums: u64,
+}
+impl ::prost::Name for QueryAutoWithdrawableAccountsRequest {
+    const NAME: &'static str = "QueryAutoWithdrawableAccountsRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryAutoWithdrawableAccountsRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryAutoWithdrawableAccountsRequest".into()
+    }
+}
+/// QueryAutoWithdrawableAccountsResponse is the response type for fetching the
+/// subaccounts that can be auto-withdrawn from.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryAutoWithdrawableAccountsResponse {
+    /// The subaccounts in the order they are stored.
+    #[prost(message, repeated, tag = "1")]
+    pub accounts: ::prost::alloc::vec::Vec<AutoWithdrawableAccount>,
+}
+impl ::prost::Name for QueryAutoWithdrawableAccountsResponse {
+    const NAME: &'static str = "QueryAutoWithdrawableAccountsResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryAutoWithdrawableAccountsResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryAutoWithdrawableAccountsResponse".into()
+    }
+}
+/// QueryCloseAllCostRequest is the request type for fetching the cost of
+/// closing all positions of a subaccount.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryCloseAllCostRequest {
+    #[prost(string, tag = "1")]
+    pub owner: ::prost::alloc::string::String,
+    #[prost(uint32, tag = "2")]
+    pub number: u32,
+}
+impl ::prost::Name for QueryCloseAllCostRequest {
+    const NAME: &'static str = "QueryCloseAllCostRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryCloseAllCostRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryCloseAllCostRequest".into()
+    }
+}
+/// QueryCloseAllCostResponse is the response type for fetching the cost of
+/// closing all positions of a subaccount.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryCloseAllCostResponse {
+    /// The total taker fees in quote quantums of closing all positions at their
+    /// market prices.
+    #[prost(bytes = "vec", tag = "1")]
+    pub fees: ::prost::alloc::vec::Vec<u8>,
+    /// The net collateral of the subaccount after closing all positions
+    /// and paying the fees.
+    #[prost(bytes = "vec", tag = "2")]
+    pub post_close_net_collateral: ::prost::alloc::vec::Vec<u8>,
+}
+impl ::prost::Name for QueryCloseAllCostResponse {
+    const NAME: &'static str = "QueryCloseAllCostResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryCloseAllCostResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryCloseAllCostResponse".into()
+    }
+}
+/// QueryLiquidatableAccountsRequest is the request type for fetching the
+/// liquidatable subaccounts.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct QueryLiquidatableAccountsRequest {}
+impl ::prost::Name for QueryLiquidatableAccountsRequest {
+    const NAME: &'static str = "QueryLiquidatableAccountsRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryLiquidatableAccountsRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryLiquidatableAccountsRequest".into()
+    }
+}
+/// QueryLiquidatableAccountsResponse is the response type for fetching the
+/// liquidatable subaccounts.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryLiquidatableAccountsResponse {
+    /// The liquidatable subaccounts from most to least underwater.
+    #[prost(message, repeated, tag = "1")]
+    pub subaccount_ids: ::prost::alloc::vec::Vec<SubaccountId>,
+}
+impl ::prost::Name for QueryLiquidatableAccountsResponse {
+    const NAME: &'static str = "QueryLiquidatableAccountsResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryLiquidatableAccountsResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryLiquidatableAccountsResponse".into()
+    }
+}
+/// QueryBreakEvenPriceRequest is the request type for fetching the break-even
+/// price of a position.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBreakEvenPriceRequest {
+    #[prost(string, tag = "1")]
+    pub owner: ::prost::alloc::string::String,
+    #[prost(uint32, tag = "2")]
+    pub number: u32,
+    #[prost(uint32, tag = "3")]
+    pub perpetual_id: u32,
+}
+impl ::prost::Name for QueryBreakEvenPriceRequest {
+    const NAME: &'static str = "QueryBreakEvenPriceRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryBreakEvenPriceRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryBreakEvenPriceRequest".into()
+    }
+}
+/// QueryBreakEvenPriceResponse is the response type for fetching the break-even
+/// price of a position.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBreakEvenPriceResponse {
+    /// The exact break-even price in quote quantums per base quantum, net of the
+    /// funding accrued by the position, formatted as an integer or as a fraction
+    /// `a/b`. Fees are not included.
+    #[prost(string, tag = "1")]
+    pub price: ::prost::alloc::string::String,
+}
+impl ::prost::Name for QueryBreakEvenPriceResponse {
+    const NAME: &'static str = "QueryBreakEvenPriceResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryBreakEvenPriceResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryBreakEvenPriceResponse".into()
+    }
+}
+/// QueryFlatteningSizeRequest is the request type for fetching the size of the
+/// trade that flattens the net position of an owner in a perpetual.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryFlatteningSizeRequest {
+    #[prost(string, tag = "1")]
+    pub owner: ::prost::alloc::string::String,
+    #[prost(uint32, tag = "2")]
+    pub perpetual_id: u32,
+}
+impl ::prost::Name for QueryFlatteningSizeRequest {
+    const NAME: &'static str = "QueryFlatteningSizeRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryFlatteningSizeRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryFlatteningSizeRequest".into()
+    }
+}
+/// QueryFlatteningSizeResponse is the response type for fetching the size of
+/// the trade that flattens the net position of an owner in a perpetual.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryFlatteningSizeResponse {
+    /// The signed base quantums to trade, negative if the owner is net long.
+    #[prost(bytes = "vec", tag = "1")]
+    pub quantums: ::prost::alloc::vec::Vec<u8>,
+    /// The absolute notional of the trade at the market price in quote
+    /// quantums.
+    #[prost(bytes = "vec", tag = "2")]
+    pub abs_notional: ::prost::alloc::vec::Vec<u8>,
+}
+impl ::prost::Name for QueryFlatteningSizeResponse {
+    const NAME: &'static str = "QueryFlatteningSizeResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryFlatteningSizeResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryFlatteningSizeResponse".into()
+    }
+}
+/// LiquidationDepthLevel is a price level of a depth model of the order book of
+/// a perpetual.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct LiquidationDepthLevel {
+    /// The price of the level, in the exponent of the market price of the
+    /// perpetual.
+    #[prost(uint64, tag = "1")]
+    pub price: u64,
+    /// The size available at the level, in base quantums.
+    #[prost(uint64, tag = "2")]
+    pub quantums: u64,
+}
+impl ::prost::Name for LiquidationDepthLevel {
+    const NAME: &'static str = "LiquidationDepthLevel";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.LiquidationDepthLevel".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.LiquidationDepthLevel".into()
+    }
+}
+/// QueryLiquidationProceedsEstimateRequest is the request type for estimating
+/// the proceeds of liquidating a position.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryLiquidationProceedsEstimateRequest {
+    #[prost(string, tag = "1")]
+    pub owner: ::prost::alloc::string::String,
+    #[prost(uint32, tag = "2")]
+    pub number: u32,
+    #[prost(uint32, tag = "3")]
+    pub perpetual_id: u32,
+    /// The price levels of the side of the order book the liquidation would
+    /// trade against, ordered from the best price to the worst.
+    #[prost(message, repeated, tag = "4")]
+    pub depth: ::prost::alloc::vec::Vec<LiquidationDepthLevel>,
+    /// The maximum liquidation fee in parts-per-million of the notional of
+    /// each fill.
+    #[prost(uint32, tag = "5")]
+    pub max_liquidation_fee_ppm: u32,
+}
+impl ::prost::Name for QueryLiquidationProceedsEstimateRequest {
+    const NAME: &'static str = "QueryLiquidationProceedsEstimateRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryLiquidationProceedsEstimateRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryLiquidationProceedsEstimateRequest".into()
+    }
+}
+/// QueryLiquidationProceedsEstimateResponse is the response type for estimating
+/// the proceeds of liquidating a position. Amounts are in quote quantums.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryLiquidationProceedsEstimateResponse {
+    /// The size of the position closed by the liquidation in base quantums.
+    /// This is less than the size of the position if the depth does not have
+    /// enough size to close it.
+    #[prost(bytes = "vec", tag = "1")]
+    pub filled_quantums: ::prost::alloc::vec::Vec<u8>,
+    /// The quote quantums received by the subaccount for the closed size,
+    /// negative for short positions.
+    #[prost(bytes = "vec", tag = "2")]
+    pub proceeds_quote_quantums: ::prost::alloc::vec::Vec<u8>,
+    /// The contribution of the liquidation to the insurance fund,
+    /// negative if the insurance fund pays.
+    #[prost(bytes = "vec", tag = "3")]
+    pub insurance_fund_delta_quote_quantums: ::prost::alloc::vec::Vec<u8>,
+}
+impl ::prost::Name for QueryLiquidationProceedsEstimateResponse {
+    const NAME: &'static str = "QueryLiquidationProceedsEstimateResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryLiquidationProceedsEstimateResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryLiquidationProceedsEstimateResponse".into()
+    }
+}
+/// QueryMarginDeltaForTierChangeRequest is the request type for fetching the
+/// change in the margin requirements of a subaccount for a liquidity tier
+/// change.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryMarginDeltaForTierChangeRequest {
+    #[prost(string, tag = "1")]
+    pub owner: ::prost::alloc::string::String,
+    #[prost(uint32, tag = "2")]
+    pub number: u32,
+    #[prost(uint32, tag = "3")]
+    pub perpetual_id: u32,
+    #[prost(message, optional, tag = "4")]
+    pub proposed_tier: ::core::option::Option<super::perpetuals::LiquidityTier>,
+}
+impl ::prost::Name for QueryMarginDeltaForTierChangeRequest {
+    const NAME: &'static str = "QueryMarginDeltaForTierChangeRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryMarginDeltaForTierChangeRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryMarginDeltaForTierChangeRequest".into()
+    }
+}
+/// QueryMarginDeltaForTierChangeResponse is the response type for fetching the
+/// change in the margin requirements of a subaccount for a liquidity tier
+/// change. Deltas are in quote quantums and positive if the subaccount would
+/// need more margin.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryMarginDeltaForTierChangeResponse {
+    #[prost(bytes = "vec", tag = "1")]
+    pub initial_margin_requirement_delta: ::prost::alloc::vec::Vec<u8>,
+    #[prost(bytes = "vec", tag = "2")]
+    pub maintenance_margin_requirement_delta: ::prost::alloc::vec::Vec<u8>,
+}
+impl ::prost::Name for QueryMarginDeltaForTierChangeResponse {
+    const NAME: &'static str = "QueryMarginDeltaForTierChangeResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryMarginDeltaForTierChangeResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryMarginDeltaForTierChangeResponse".into()
+    }
+}
+/// HistoricalMove is the magnitude of the worst single-day move observed
+/// historically in the market of a perpetual. The move is applied against the
+/// position of the subaccount in the perpetual.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct HistoricalMove {
+    #[prost(uint32, tag = "1")]
+    pub perpetual_id: u32,
+    #[prost(uint32, tag = "2")]
+    pub move_ppm: u32,
+}
+impl ::prost::Name for HistoricalMove {
+    const NAME: &'static str = "HistoricalMove";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.HistoricalMove".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.HistoricalMove".into()
+    }
+}
+/// QueryRiskUnderHistoricalShocksRequest is the request type for computing the
+/// risk of a subaccount under historical shocks.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryRiskUnderHistoricalShocksRequest {
+    #[prost(string, tag = "1")]
+    pub owner: ::prost::alloc::string::String,
+    #[prost(uint32, tag = "2")]
+    pub number: u32,
+    /// The worst historical move of each perpetual. Perpetuals without a move
+    /// are not shocked.
+    #[prost(message, repeated, tag = "3")]
+    pub worst_moves: ::prost::alloc::vec::Vec<HistoricalMove>,
+}
+impl ::prost::Name for QueryRiskUnderHistoricalShocksRequest {
+    const NAME: &'static str = "QueryRiskUnderHistoricalShocksRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryRiskUnderHistoricalShocksRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryRiskUnderHistoricalShocksRequest".into()
+    }
+}
+/// QueryRiskUnderHistoricalShocksResponse is the response type for computing
+/// the risk of a subaccount under historical shocks. The risk is in quote
+/// quantums.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryRiskUnderHistoricalShocksResponse {
+    #[prost(bytes = "vec", tag = "1")]
+    pub net_collateral: ::prost::alloc::vec::Vec<u8>,
+    #[prost(bytes = "vec", tag = "2")]
+    pub initial_margin_requirement: ::prost::alloc::vec::Vec<u8>,
+    #[prost(bytes = "vec", tag = "3")]
+    pub maintenance_margin_requirement: ::prost::alloc::vec::Vec<u8>,
+}
+impl ::prost::Name for QueryRiskUnderHistoricalShocksResponse {
+    const NAME: &'static str = "QueryRiskUnderHistoricalShocksResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryRiskUnderHistoricalShocksResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryRiskUnderHistoricalShocksResponse".into()
+    }
+}
+/// QueryMinLiquidatingPriceMoveRequest is the request type for fetching the
+/// smallest price move that liquidates a subaccount.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct QueryMinLiquidatingPriceMoveRequest {
+    #[prost(uint32, tag = "1")]
+    pub perpetual_id: u32,
+}
+impl ::prost::Name for QueryMinLiquidatingPriceMoveRequest {
+    const NAME: &'static str = "QueryMinLiquidatingPriceMoveRequest";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryMinLiquidatingPriceMoveRequest".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryMinLiquidatingPriceMoveRequest".into()
+    }
+}
+/// QueryMinLiquidatingPriceMoveResponse is the response type for fetching the
+/// smallest price move that liquidates a subaccount.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryMinLiquidatingPriceMoveResponse {
+    /// False if no move in the price liquidates a subaccount.
+    #[prost(bool, tag = "1")]
+    pub found: bool,
+    /// The smallest adverse move in parts-per-million of the current price,
+    /// rounded up. Zero if a subaccount is already liquidatable.
+    #[prost(uint64, tag = "2")]
+    pub move_ppm: u64,
+    /// The subaccount that becomes liquidatable first.
+    #[prost(message, optional, tag = "3")]
+    pub subaccount_id: ::core::option::Option<SubaccountId>,
+}
+impl ::prost::Name for QueryMinLiquidatingPriceMoveResponse {
+    const NAME: &'static str = "QueryMinLiquidatingPriceMoveResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.QueryMinLiquidatingPriceMoveResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.QueryMinLiquidatingPriceMoveResponse".into()
+    }
+}
+/// Generated client implementations.
+pub mod query_client {
+    #![allow(
+        unused_variables,
+        dead_code,
+        missing_docs,
+        clippy::wildcard_imports,
+        clippy::let_unit_value,
+    )]
+    use tonic::codegen::*;
+    use tonic::codegen::http::Uri;
+    /// Query defines the gRPC querier service.
+    #[derive(Debug, Clone)]
+    pub struct QueryClient<T> {
+        inner: tonic::client::Grpc<T>,
+    }
+    #[cfg(feature = "grpc-transport")]
+    impl QueryClient<tonic::transport::Channel> {
+        /// Attempt to create a new client by connecting to a given endpoint.
+        pub async fn connect<D>(dst: D) -> Result<Self, tonic::transport::Error>
+        where
+            D: TryInto<tonic::transport::Endpoint>,
+            D::Error: Into<StdError>,
+        {
+            let conn = tonic::transport::Endpoint::new(dst)?.connect().await?;
+            Ok(Self::new(conn))
+        }
+    }
+    impl<T> QueryClient<T>
+    where
+        T: tonic::client::GrpcService<tonic::body::BoxBody>,
+        T::Error: Into<StdError>,
+        T::ResponseBody: Body<Data = Bytes> + std::marker::Send + 'static,
+        <T::ResponseBody as Body>::Error: Into<StdError> + std::marker::Send,
+    {
+        pub fn new(inner: T) -> Self {
+            let inner = tonic::client::Grpc::new(inner);
+            Self { inner }
+        }
+        pub fn with_origin(inner: T, origin: Uri) -> Self {
+            let inner = tonic::client::Grpc::with_origin(inner, origin);
+            Self { inner }
+        }
+        pub fn with_interceptor<F>(
+            inner: T,
+            interceptor: F,
+        ) -> QueryClient<InterceptedService<T, F>>
+        where
+            F: tonic::service::Interceptor,
+            T::ResponseBody: Default,
+            T: tonic::codegen::Service<
+                http::Request<tonic::body::BoxBody>,
+                Response = http::Response<
+                    <T as tonic::client::GrpcService<tonic::body::BoxBody>>::ResponseBody,
+                >,
+            >,
+            <T as tonic::codegen::Service<
+                http::Request<tonic::body::BoxBody>,
+            >>::Error: Into<StdError> + std::marker::Send + std::marker::Sync,
+        {
+            QueryClient::new(InterceptedService::new(inner, interceptor))
+        }
+        /// Compress requests with the given encoding.
+        ///
+        /// This requires the server to support it otherwise it might respond with an
+        /// error.
+        #[must_use]
+        pub fn send_compressed(mut self, encoding: CompressionEncoding) -> Self {
+            self.inner = self.inner.send_compressed(encoding);
+            self
+        }
+        /// Enable decompressing responses.
+        #[must_use]
+        pub fn accept_compressed(mut self, encoding: CompressionEncoding) -> Self {
+            self.inner = self.inner.accept_compressed(encoding);
+            self
+        }
+        /// Limits the maximum size of a decoded message.
+        ///
+        /// Default: `4MB`
+        #[must_use]
+        pub fn max_decoding_message_size(mut self, limit: usize) -> Self {
+            self.inner = self.inner.max_decoding_message_size(limit);
+            self
+        }
+        /// Limits the maximum size of an encoded message.
+        ///
+        /// Default: `usize::MAX`
+        #[must_use]
+        pub fn max_encoding_message_size(mut self, limit: usize) -> Self {
+            self.inner = self.inner.max_encoding_message_size(limit);
+            self
+        }
+        /// Queries a Subaccount by id
+        pub async fn subaccount(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryGetSubaccountRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QuerySubaccountResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/Subaccount",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("dydxprotocol.subaccounts.Query", "Subaccount"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries a list of Subaccount items.
+        pub async fn subaccount_all(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryAllSubaccountRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QuerySubaccountAllResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/SubaccountAll",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("dydxprotocol.subaccounts.Query", "SubaccountAll"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries information about whether withdrawal and transfers are blocked, and
+        /// if so which block they are re-enabled on.
+        pub async fn get_withdrawal_and_transfers_blocked_info(
+            &mut self,
+            request: impl tonic::IntoRequest<
+                super::QueryGetWithdrawalAndTransfersBlockedInfoRequest,
+            >,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryGetWithdrawalAndTransfersBlockedInfoResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/GetWithdrawalAndTransfersBlockedInfo",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "GetWithdrawalAndTransfersBlockedInfo",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the collateral pool account address for a perpetual id.
+        pub async fn collateral_pool_address(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryCollateralPoolAddressRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryCollateralPoolAddressResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/CollateralPoolAddress",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "CollateralPoolAddress",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Computes the risk of a hypothetical subaccount that is not stored, after
+        /// applying a list of updates, at the current oracle prices.
+        pub async fn compute_risk_for_hypothetical(
+            &mut self,
+            request: impl tonic::IntoRequest<
+                super::QueryComputeRiskForHypotheticalRequest,
+            >,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryComputeRiskForHypotheticalResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/ComputeRiskForHypothetical",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "ComputeRiskForHypothetical",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the inputs used to compute the current risk of a subaccount, so
+        /// that off-chain tools can reproduce it exactly.
+        pub async fn risk_inputs(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryRiskInputsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryRiskInputsResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/RiskInputs",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("dydxprotocol.subaccounts.Query", "RiskInputs"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the ratio of the initial margin requirement of a subaccount to its
+        /// net collateral.
+        pub async fn subaccount_utilization(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QuerySubaccountUtilizationRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QuerySubaccountUtilizationResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/SubaccountUtilization",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "SubaccountUtilization",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the total value locked across all subaccounts.
+        pub async fn total_value_locked(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryTotalValueLockedRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryTotalValueLockedResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/TotalValueLocked",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "TotalValueLocked",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the liquidation and bankruptcy prices of every open perpetual
+        /// position of a subaccount.
+        pub async fn subaccount_liquidation_prices(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QuerySubaccountLiquidationPricesRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QuerySubaccountLiquidationPricesResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/SubaccountLiquidationPrices",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "SubaccountLiquidationPrices",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Computes the smallest shock to the prices of a correlated basket of
+        /// perpetuals that makes a subaccount liquidatable.
+        pub async fn basket_shock_to_liquidate(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryBasketShockToLiquidateRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryBasketShockToLiquidateResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/BasketShockToLiquidate",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "BasketShockToLiquidate",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the sum of the maintenance margin requirements of all subaccounts.
+        pub async fn total_maintenance_margin(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryTotalMaintenanceMarginRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryTotalMaintenanceMarginResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/TotalMaintenanceMargin",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "TotalMaintenanceMargin",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the notional of the position of a subaccount in a perpetual.
+        pub async fn position_notional(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryPositionNotionalRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryPositionNotionalResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/PositionNotional",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "PositionNotional",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the impact of migrating the price exponent of a market on the
+        /// positions in its perpetuals.
+        pub async fn market_exponent_migration_impact(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryMarketExponentMigrationImpactRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryMarketExponentMigrationImpactResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/MarketExponentMigrationImpact",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "MarketExponentMigrationImpact",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the risk of a subaccount as of a past block height.
+        pub async fn risk_at_height(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryRiskAtHeightRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryRiskAtHeightResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/RiskAtHeight",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("dydxprotocol.subaccounts.Query", "RiskAtHeight"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the number of subaccounts in each health bucket.
+        pub async fn health_distribution(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryHealthDistributionRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryHealthDistributionResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/HealthDistribution",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "HealthDistribution",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the sensitivity of the total net collateral of all subaccounts to
+        /// the market price of a perpetual.
+        pub async fn market_nc_sensitivity(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryMarketNcSensitivityRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryMarketNcSensitivityResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/MarketNcSensitivity",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "MarketNcSensitivity",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries whether a withdrawal from a subaccount would be allowed, and the
+        /// maximum amount that can be withdrawn.
+        pub async fn withdrawable_check(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryWithdrawableCheckRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryWithdrawableCheckResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/WithdrawableCheck",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "WithdrawableCheck",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the PnL a subaccount would realize by closing its position in a
+        /// perpetual.
+        pub async fn realized_pnl_on_close(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryRealizedPnlOnCloseRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryRealizedPnlOnCloseResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/RealizedPnlOnClose",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "RealizedPnlOnClose",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the net position of all subaccounts in a perpetual.
+        pub async fn protocol_net_delta(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryProtocolNetDeltaRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryProtocolNetDeltaResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/ProtocolNetDelta",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "ProtocolNetDelta",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the funding settled for the position of a subaccount in a perpetual
+        /// during a range of funding-tick epochs.
+        pub async fn funding_history(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryFundingHistoryRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryFundingHistoryResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/FundingHistory",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("dydxprotocol.subaccounts.Query", "FundingHistory"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the closes of the positions of a subaccount that each make it
+        /// initially collateralized.
+        pub async fn action_to_restore_initial_margin(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryActionToRestoreInitialMarginRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryActionToRestoreInitialMarginResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/ActionToRestoreInitialMargin",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "ActionToRestoreInitialMargin",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries how much a subaccount can lose on its position in a perpetual before
+        /// it becomes liquidatable.
+        pub async fn loss_buffer_to_liquidation(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryLossBufferToLiquidationRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryLossBufferToLiquidationResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/LossBufferToLiquidation",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "LossBufferToLiquidation",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the unrealized PnL of the positions of all subaccounts in a
+        /// perpetual.
+        pub async fn market_unrealized_pnl(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryMarketUnrealizedPnlRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryMarketUnrealizedPnlResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/MarketUnrealizedPnl",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "MarketUnrealizedPnl",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the subaccounts whose free collateral exceeds a threshold.
+        pub async fn auto_withdrawable_accounts(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryAutoWithdrawableAccountsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryAutoWithdrawableAccountsResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/AutoWithdrawableAccounts",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "AutoWithdrawableAccounts",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the taker fees a subaccount would pay to close all of its positions.
+        pub async fn close_all_cost(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryCloseAllCostRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryCloseAllCostResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/CloseAllCost",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("dydxprotocol.subaccounts.Query", "CloseAllCost"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the liquidatable subaccounts in the order in which they should be
+        /// liquidated.
+        pub async fn liquidatable_accounts(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryLiquidatableAccountsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryLiquidatableAccountsResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/LiquidatableAccounts",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "LiquidatableAccounts",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the price at which a subaccount would realize zero PnL by closing
+        /// its position in a perpetual.
+        pub async fn break_even_price(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryBreakEvenPriceRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryBreakEvenPriceResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/BreakEvenPrice",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("dydxprotocol.subaccounts.Query", "BreakEvenPrice"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the size of the trade that would make the net position of all
+        /// subaccounts of an owner in a perpetual zero.
+        pub async fn flattening_size(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryFlatteningSizeRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryFlatteningSizeResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/FlatteningSize",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("dydxprotocol.subaccounts.Query", "FlatteningSize"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Estimates the proceeds of liquidating the position of a subaccount in a
+        /// perpetual against a depth model of the order book.
+        pub async fn liquidation_proceeds_estimate(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryLiquidationProceedsEstimateRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryLiquidationProceedsEstimateResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/LiquidationProceedsEstimate",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "LiquidationProceedsEstimate",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the change in the margin requirements of a subaccount if a perpetual
+        /// were migrated to a proposed liquidity tier.
+        pub async fn margin_delta_for_tier_change(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryMarginDeltaForTierChangeRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryMarginDeltaForTierChangeResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/MarginDeltaForTierChange",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "MarginDeltaForTierChange",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Computes the risk of a subaccount with each of its positions shocked by the
+        /// worst historical single-day move of its market.
+        pub async fn risk_under_historical_shocks(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryRiskUnderHistoricalShocksRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryRiskUnderHistoricalShocksResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/RiskUnderHistoricalShocks",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "RiskUnderHistoricalShocks",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Queries the smallest adverse move in the oracle price of a perpetual that
+        /// makes a subaccount liquidatable.
+        pub async fn min_liquidating_price_move(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryMinLiquidatingPriceMoveRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::QueryMinLiquidatingPriceMoveResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Query/MinLiquidatingPriceMove",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "dydxprotocol.subaccounts.Query",
+                        "MinLiquidatingPriceMove",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+    }
+}
+/// MsgUpdateParams is the Msg/UpdateParams request type.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgUpdateParams {
+    #[prost(string, tag = "1")]
+    pub authority: ::prost::alloc::string::String,
+    /// The parameters to update. Each field must be set.
+    #[prost(message, optional, tag = "2")]
+    pub params: ::core::option::Option<Params>,
 }
-impl ::prost::Name for GenesisState {
-    const NAME: &'static str = "GenesisState";
+impl ::prost::Name for MsgUpdateParams {
+    const NAME: &'static str = "MsgUpdateParams";
     const PACKAGE: &'static str = "dydxprotocol.subaccounts";
     fn full_name() -> ::prost::alloc::string::String {
-        "dydxprotocol.subaccounts.GenesisState".into()
+        "dydxprotocol.subaccounts.MsgUpdateParams".into()
     }
     fn type_url() -> ::prost::alloc::string::String {
-        "/dydxprotocol.subaccounts.GenesisState".into()
+        "/dydxprotocol.subaccounts.MsgUpdateParams".into()
     }
 }
-/// QueryGetSubaccountRequest is request type for the Query RPC method.
-#[derive(Clone, PartialEq, ::prost::Message)]
-pub struct QueryGetSubaccountRequest {
-    #[prost(string, tag = "1")]
-    pub owner: ::prost::alloc::string::String,
-    #[prost(uint32, tag = "2")]
-    pub number: u32,
-}
-impl ::prost::Name for QueryGetSubaccountRequest {
-    const NAME: &'static str = "QueryGetSubaccountRequest";
+/// MsgUpdateParamsResponse is the Msg/UpdateParams response type.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct MsgUpdateParamsResponse {}
+impl ::prost::Name for MsgUpdateParamsResponse {
+    const NAME: &'static str = "MsgUpdateParamsResponse";
     const PACKAGE: &'static str = "dydxprotocol.subaccounts";
     fn full_name() -> ::prost::alloc::string::String {
-        "dydxprotocol.subaccounts.QueryGetSubaccountRequest".into()
+        "dydxprotocol.subaccounts.MsgUpdateParamsResponse".into()
     }
     fn type_url() -> ::prost::alloc::string::String {
-        "/dydxprotocol.subaccounts.QueryGetSubaccountRequest".into()
+        "/dydxprotocol.subaccounts.MsgUpdateParamsResponse".into()
     }
 }
-/// QuerySubaccountResponse is response type for the Query RPC method.
+/// MsgSetMaxLeverage sets the self-imposed leverage cap of a subaccount.
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct QuerySubaccountResponse {
+pub struct MsgSetMaxLeverage {
+    /// The subaccount to set the leverage cap of.
     #[prost(message, optional, tag = "1")]
-    pub subaccount: ::core::option::Option<Subaccount>,
+    pub subaccount_id: ::core::option::Option<SubaccountId>,
+    /// The leverage cap, in parts-per-million. Zero removes the cap.
+    #[prost(uint32, tag = "2")]
+    pub max_leverage_ppm: u32,
 }
-impl ::prost::Name for QuerySubaccountResponse {
-    const NAME: &'static str = "QuerySubaccountResponse";
+impl ::prost::Name for MsgSetMaxLeverage {
+    const NAME: &'static str = "MsgSetMaxLeverage";
     const PACKAGE: &'static str = "dydxprotocol.subaccounts";
     fn full_name() -> ::prost::alloc::string::String {
-        "dydxprotocol.subaccounts.QuerySubaccountResponse".into()
+        "dydxprotocol.subaccounts.MsgSetMaxLeverage".into()
     }
     fn type_url() -> ::prost::alloc::string::String {
-        "/dydxprotocol.subaccounts.QuerySubaccountResponse".into()
+        "/dydxprotocol.subaccounts.MsgSetMaxLeverage".into()
     }
 }
-/// QueryAllSubaccountRequest is request type for the Query RPC method.
-#[derive(Clone, PartialEq, ::prost::Message)]
-pub struct QueryAllSubaccountRequest {
-    #[prost(message, optional, tag = "1")]
-    pub pagination: ::core::option::Option<
-        super::super::cosmos::base::query::v1beta1::PageRequest,
-    >,
-}
-impl ::prost::Name for QueryAllSubaccountRequest {
-    const NAME: &'static str = "QueryAllSubaccountRequest";
+/// MsgSetMaxLeverageResponse is the Msg/SetMaxLeverage response type.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct MsgSetMaxLeverageResponse {}
+impl ::prost::Name for MsgSetMaxLeverageResponse {
+    const NAME: &'static str = "MsgSetMaxLeverageResponse";
     const PACKAGE: &'static str = "dydxprotocol.subaccounts";
     fn full_name() -> ::prost::alloc::string::String {
-        "dydxprotocol.subaccounts.QueryAllSubaccountRequest".into()
+        "dydxprotocol.subaccounts.MsgSetMaxLeverageResponse".into()
     }
     fn type_url() -> ::prost::alloc::string::String {
-        "/dydxprotocol.subaccounts.QueryAllSubaccountRequest".into()
+        "/dydxprotocol.subaccounts.MsgSetMaxLeverageResponse".into()
     }
 }
-/// QuerySubaccountAllResponse is response type for the Query RPC method.
+/// MsgSetMarginMode switches a subaccount between cross and isolated margin.
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct QuerySubaccountAllResponse {
-    #[prost(message, repeated, tag = "1")]
-    pub subaccount: ::prost::alloc::vec::Vec<Subaccount>,
-    #[prost(message, optional, tag = "2")]
-    pub pagination: ::core::option::Option<
-        super::super::cosmos::base::query::v1beta1::PageResponse,
-    >,
+pub struct MsgSetMarginMode {
+    /// The subaccount to switch the margin mode of.
+    #[prost(message, optional, tag = "1")]
+    pub subaccount_id: ::core::option::Option<SubaccountId>,
+    /// The margin mode to switch to: 0 for cross margin and 1 for isolated
+    /// margin.
+    #[prost(uint32, tag = "2")]
+    pub margin_mode: u32,
 }
-impl ::prost::Name for QuerySubaccountAllResponse {
-    const NAME: &'static str = "QuerySubaccountAllResponse";
+impl ::prost::Name for MsgSetMarginMode {
+    const NAME: &'static str = "MsgSetMarginMode";
     const PACKAGE: &'static str = "dydxprotocol.subaccounts";
     fn full_name() -> ::prost::alloc::string::String {
-        "dydxprotocol.subaccounts.QuerySubaccountAllResponse".into()
+        "dydxprotocol.subaccounts.MsgSetMarginMode".into()
     }
     fn type_url() -> ::prost::alloc::string::String {
-        "/dydxprotocol.subaccounts.QuerySubaccountAllResponse".into()
+        "/dydxprotocol.subaccounts.MsgSetMarginMode".into()
     }
 }
-/// QueryGetWithdrawalAndTransfersBlockedInfoRequest is a request type for
-/// fetching information about whether withdrawals and transfers are blocked for
-/// a collateral pool associated with the passed in perpetual id.
+/// MsgSetMarginModeResponse is the Msg/SetMarginMode response type.
 #[derive(Clone, Copy, PartialEq, ::prost::Message)]
-pub struct QueryGetWithdrawalAndTransfersBlockedInfoRequest {
-    #[prost(uint32, tag = "1")]
-    pub perpetual_id: u32,
-}
-impl ::prost::Name for QueryGetWithdrawalAndTransfersBlockedInfoRequest {
-    const NAME: &'static str = "QueryGetWithdrawalAndTransfersBlockedInfoRequest";
+pub struct MsgSetMarginModeResponse {}
+impl ::prost::Name for MsgSetMarginModeResponse {
+    const NAME: &'static str = "MsgSetMarginModeResponse";
     const PACKAGE: &'static str = "dydxprotocol.subaccounts";
     fn full_name() -> ::prost::alloc::string::String {
-        "dydxprotocol.subaccounts.QueryGetWithdrawalAndTransfersBlockedInfoRequest"
-            .into()
+        "dydxprotocol.subaccounts.MsgSetMarginModeResponse".into()
     }
     fn type_url() -> ::prost::alloc::string::String {
-        "/dydxprotocol.subaccounts.QueryGetWithdrawalAndTransfersBlockedInfoRequest"
-            .into()
+        "/dydxprotocol.subaccounts.MsgSetMarginModeResponse".into()
     }
 }
-/// QueryGetWithdrawalAndTransfersBlockedInfoRequest is a response type for
-/// fetching information about whether withdrawals and transfers are blocked.
-#[derive(Clone, Copy, PartialEq, ::prost::Message)]
-pub struct QueryGetWithdrawalAndTransfersBlockedInfoResponse {
-    #[prost(uint32, tag = "1")]
-    pub negative_tnc_subaccount_seen_at_block: u32,
-    #[prost(uint32, tag = "2")]
-    pub chain_outage_seen_at_block: u32,
+/// MsgRebalanceCollateral moves USDC between a cross margin subaccount and an
+/// isolated position of the same owner.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgRebalanceCollateral {
+    /// The cross margin subaccount.
+    #[prost(message, optional, tag = "1")]
+    pub cross_subaccount_id: ::core::option::Option<SubaccountId>,
+    /// The subaccount holding the isolated position.
+    #[prost(message, optional, tag = "2")]
+    pub isolated_subaccount_id: ::core::option::Option<SubaccountId>,
+    /// The id of the isolated perpetual.
     #[prost(uint32, tag = "3")]
-    pub withdrawals_and_transfers_unblocked_at_block: u32,
+    pub perpetual_id: u32,
+    /// The amount of USDC to move, in quote quantums. Must be positive.
+    #[prost(uint64, tag = "4")]
+    pub quote_quantums: u64,
+    /// If true, the USDC is moved from the isolated position to the cross margin
+    /// subaccount. Otherwise, it is moved from the cross margin subaccount to the
+    /// isolated position.
+    #[prost(bool, tag = "5")]
+    pub to_cross: bool,
 }
-impl ::prost::Name for QueryGetWithdrawalAndTransfersBlockedInfoResponse {
-    const NAME: &'static str = "QueryGetWithdrawalAndTransfersBlockedInfoResponse";
+impl ::prost::Name for MsgRebalanceCollateral {
+    const NAME: &'static str = "MsgRebalanceCollateral";
     const PACKAGE: &'static str = "dydxprotocol.subaccounts";
     fn full_name() -> ::prost::alloc::string::String {
-        "dydxprotocol.subaccounts.QueryGetWithdrawalAndTransfersBlockedInfoResponse"
-            .into()
+        "dydxprotocol.subaccounts.MsgRebalanceCollateral".into()
     }
     fn type_url() -> ::prost::alloc::string::String {
-        "/dydxprotocol.subaccounts.QueryGetWithdrawalAndTransfersBlockedInfoResponse"
-            .into()
+        "/dydxprotocol.subaccounts.MsgRebalanceCollateral".into()
     }
 }
-/// QueryCollateralPoolAddressRequest is the request type for fetching the
-/// account address of the collateral pool associated with the passed in
-/// perpetual id.
+/// MsgRebalanceCollateralResponse is the Msg/RebalanceCollateral response type.
 #[derive(Clone, Copy, PartialEq, ::prost::Message)]
-pub struct QueryCollateralPoolAddressRequest {
+pub struct MsgRebalanceCollateralResponse {}
+impl ::prost::Name for MsgRebalanceCollateralResponse {
+    const NAME: &'static str = "MsgRebalanceCollateralResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.MsgRebalanceCollateralResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.MsgRebalanceCollateralResponse".into()
+    }
+}
+/// HedgedPairLeg is a change to a perpetual position of a hedged pair.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct HedgedPairLeg {
+    /// The id of the perpetual.
     #[prost(uint32, tag = "1")]
     pub perpetual_id: u32,
+    /// The signed change in the size of the position in base quantums.
+    #[prost(bytes = "vec", tag = "2")]
+    pub quantums_delta: ::prost::alloc::vec::Vec<u8>,
+    /// The signed change in the quote balance of the position in quote quantums.
+    #[prost(bytes = "vec", tag = "3")]
+    pub quote_balance_delta: ::prost::alloc::vec::Vec<u8>,
 }
-impl ::prost::Name for QueryCollateralPoolAddressRequest {
-    const NAME: &'static str = "QueryCollateralPoolAddressRequest";
+impl ::prost::Name for HedgedPairLeg {
+    const NAME: &'static str = "HedgedPairLeg";
     const PACKAGE: &'static str = "dydxprotocol.subaccounts";
     fn full_name() -> ::prost::alloc::string::String {
-        "dydxprotocol.subaccounts.QueryCollateralPoolAddressRequest".into()
+        "dydxprotocol.subaccounts.HedgedPairLeg".into()
     }
     fn type_url() -> ::prost::alloc::string::String {
-        "/dydxprotocol.subaccounts.QueryCollateralPoolAddressRequest".into()
+        "/dydxprotocol.subaccounts.HedgedPairLeg".into()
     }
 }
-/// QueryCollateralPoolAddressResponse is a response type for fetching the
-/// account address of the collateral pool associated with the passed in
-/// perpetual id.
+/// MsgOpenHedgedPair atomically opens positions in two perpetuals of a
+/// subaccount. The legs are applied without a counterparty, so the message can
+/// only be submitted by the authority.
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct QueryCollateralPoolAddressResponse {
+pub struct MsgOpenHedgedPair {
     #[prost(string, tag = "1")]
-    pub collateral_pool_address: ::prost::alloc::string::String,
+    pub authority: ::prost::alloc::string::String,
+    /// The subaccount to open the positions in.
+    #[prost(message, optional, tag = "2")]
+    pub subaccount_id: ::core::option::Option<SubaccountId>,
+    /// The legs of the pair, which must be in different perpetuals.
+    #[prost(message, optional, tag = "3")]
+    pub leg_a: ::core::option::Option<HedgedPairLeg>,
+    #[prost(message, optional, tag = "4")]
+    pub leg_b: ::core::option::Option<HedgedPairLeg>,
 }
-impl ::prost::Name for QueryCollateralPoolAddressResponse {
-    const NAME: &'static str = "QueryCollateralPoolAddressResponse";
+impl ::prost::Name for MsgOpenHedgedPair {
+    const NAME: &'static str = "MsgOpenHedgedPair";
     const PACKAGE: &'static str = "dydxprotocol.subaccounts";
     fn full_name() -> ::prost::alloc::string::String {
-        "dydxprotocol.subaccounts.QueryCollateralPoolAddressResponse".into()
+        "dydxprotocol.subaccounts.MsgOpenHedgedPair".into()
     }
     fn type_url() -> ::prost::alloc::string::String {
-        "/dydxprotocol.subaccounts.QueryCollateralPoolAddressResponse".into()
+        "/dydxprotocol.subaccounts.MsgOpenHedgedPair".into()
+    }
+}
+/// MsgOpenHedgedPairResponse is the Msg/OpenHedgedPair response type.
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct MsgOpenHedgedPairResponse {}
+impl ::prost::Name for MsgOpenHedgedPairResponse {
+    const NAME: &'static str = "MsgOpenHedgedPairResponse";
+    const PACKAGE: &'static str = "dydxprotocol.subaccounts";
+    fn full_name() -> ::prost::alloc::string::String {
+        "dydxprotocol.subaccounts.MsgOpenHedgedPairResponse".into()
+    }
+    fn type_url() -> ::prost::alloc::string::String {
+        "/dydxprotocol.subaccounts.MsgOpenHedgedPairResponse".into()
     }
 }
 /// Generated client implementations.
-pub mod query_client {
+pub mod msg_client {
     #![allow(
         unused_variables,
         dead_code,
@@ -358,13 +3127,13 @@ pub mod query_client {
     )]
     use tonic::codegen::*;
     use tonic::codegen::http::Uri;
-    /// Query defines the gRPC querier service.
+    /// Msg defines the Msg service.
     #[derive(Debug, Clone)]
-    pub struct QueryClient<T> {
+    pub struct MsgClient<T> {
         inner: tonic::client::Grpc<T>,
     }
     #[cfg(feature = "grpc-transport")]
-    impl QueryClient<tonic::transport::Channel> {
+    impl MsgClient<tonic::transport::Channel> {
         /// Attempt to create a new client by connecting to a given endpoint.
         pub async fn connect<D>(dst: D) -> Result<Self, tonic::transport::Error>
         where
@@ -375,7 +3144,7 @@ pub mod query_client {
             Ok(Self::new(conn))
         }
     }
-    impl<T> QueryClient<T>
+    impl<T> MsgClient<T>
     where
         T: tonic::client::GrpcService<tonic::body::BoxBody>,
         T::Error: Into<StdError>,
@@ -393,7 +3162,7 @@ pub mod query_client {
         pub fn with_interceptor<F>(
             inner: T,
             interceptor: F,
-        ) -> QueryClient<InterceptedService<T, F>>
+        ) -> MsgClient<InterceptedService<T, F>>
         where
             F: tonic::service::Interceptor,
             T::ResponseBody: Default,
@@ -407,7 +3176,7 @@ pub mod query_client {
                 http::Request<tonic::body::BoxBody>,
             >>::Error: Into<StdError> + std::marker::Send + std::marker::Sync,
         {
-            QueryClient::new(InterceptedService::new(inner, interceptor))
+            MsgClient::new(InterceptedService::new(inner, interceptor))
         }
         /// Compress requests with the given encoding.
         ///
@@ -440,12 +3209,12 @@ pub mod query_client {
             self.inner = self.inner.max_encoding_message_size(limit);
             self
         }
-        /// Queries a Subaccount by id
-        pub async fn subaccount(
+        /// UpdateParams updates the Params in state.
+        pub async fn update_params(
             &mut self,
-            request: impl tonic::IntoRequest<super::QueryGetSubaccountRequest>,
+            request: impl tonic::IntoRequest<super::MsgUpdateParams>,
         ) -> std::result::Result<
-            tonic::Response<super::QuerySubaccountResponse>,
+            tonic::Response<super::MsgUpdateParamsResponse>,
             tonic::Status,
         > {
             self.inner
@@ -458,19 +3227,19 @@ pub mod query_client {
                 })?;
             let codec = tonic::codec::ProstCodec::default();
             let path = http::uri::PathAndQuery::from_static(
-                "/dydxprotocol.subaccounts.Query/Subaccount",
+                "/dydxprotocol.subaccounts.Msg/UpdateParams",
             );
             let mut req = request.into_request();
             req.extensions_mut()
-                .insert(GrpcMethod::new("dydxprotocol.subaccounts.Query", "Subaccount"));
+                .insert(GrpcMethod::new("dydxprotocol.subaccounts.Msg", "UpdateParams"));
             self.inner.unary(req, path, codec).await
         }
-        /// Queries a list of Subaccount items.
-        pub async fn subaccount_all(
+        /// SetMaxLeverage sets the self-imposed leverage cap of a subaccount.
+        pub async fn set_max_leverage(
             &mut self,
-            request: impl tonic::IntoRequest<super::QueryAllSubaccountRequest>,
+            request: impl tonic::IntoRequest<super::MsgSetMaxLeverage>,
         ) -> std::result::Result<
-            tonic::Response<super::QuerySubaccountAllResponse>,
+            tonic::Response<super::MsgSetMaxLeverageResponse>,
             tonic::Status,
         > {
             self.inner
@@ -483,24 +3252,47 @@ pub mod query_client {
                 })?;
             let codec = tonic::codec::ProstCodec::default();
             let path = http::uri::PathAndQuery::from_static(
-                "/dydxprotocol.subaccounts.Query/SubaccountAll",
+                "/dydxprotocol.subaccounts.Msg/SetMaxLeverage",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("dydxprotocol.subaccounts.Msg", "SetMaxLeverage"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// SetMarginMode switches a subaccount between cross and isolated margin.
+        pub async fn set_margin_mode(
+            &mut self,
+            request: impl tonic::IntoRequest<super::MsgSetMarginMode>,
+        ) -> std::result::Result<
+            tonic::Response<super::MsgSetMarginModeResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/dydxprotocol.subaccounts.Msg/SetMarginMode",
             );
             let mut req = request.into_request();
             req.extensions_mut()
                 .insert(
-                    GrpcMethod::new("dydxprotocol.subaccounts.Query", "SubaccountAll"),
+                    GrpcMethod::new("dydxprotocol.subaccounts.Msg", "SetMarginMode"),
                 );
             self.inner.unary(req, path, codec).await
         }
-        /// Queries information about whether withdrawal and transfers are blocked, and
-        /// if so which block they are re-enabled on.
-        pub async fn get_withdrawal_and_transfers_blocked_info(
+        /// RebalanceCollateral moves USDC between a cross margin subaccount and an
+        /// isolated position of the same owner.
+        pub async fn rebalance_collateral(
             &mut self,
-            request: impl tonic::IntoRequest<
-                super::QueryGetWithdrawalAndTransfersBlockedInfoRequest,
-            >,
+            request: impl tonic::IntoRequest<super::MsgRebalanceCollateral>,
         ) -> std::result::Result<
-            tonic::Response<super::QueryGetWithdrawalAndTransfersBlockedInfoResponse>,
+            tonic::Response<super::MsgRebalanceCollateralResponse>,
             tonic::Status,
         > {
             self.inner
@@ -513,24 +3305,25 @@ pub mod query_client {
                 })?;
             let codec = tonic::codec::ProstCodec::default();
             let path = http::uri::PathAndQuery::from_static(
-                "/dydxprotocol.subaccounts.Query/GetWithdrawalAndTransfersBlockedInfo",
+                "/dydxprotocol.subaccounts.Msg/RebalanceCollateral",
             );
             let mut req = request.into_request();
             req.extensions_mut()
                 .insert(
                     GrpcMethod::new(
-                        "dydxprotocol.subaccounts.Query",
-                        "GetWithdrawalAndTransfersBlockedInfo",
+                        "dydxprotocol.subaccounts.Msg",
+                        "RebalanceCollateral",
                     ),
                 );
             self.inner.unary(req, path, codec).await
         }
-        /// Queries the collateral pool account address for a perpetual id.
-        pub async fn collateral_pool_address(
+        /// OpenHedgedPair atomically opens positions in two perpetuals of a
+        /// subaccount.
+        pub async fn open_hedged_pair(
             &mut self,
-            request: impl tonic::IntoRequest<super::QueryCollateralPoolAddressRequest>,
+            request: impl tonic::IntoRequest<super::MsgOpenHedgedPair>,
         ) -> std::result::Result<
-            tonic::Response<super::QueryCollateralPoolAddressResponse>,
+            tonic::Response<super::MsgOpenHedgedPairResponse>,
             tonic::Status,
         > {
             self.inner
@@ -543,15 +3336,12 @@ pub mod query_client {
                 })?;
             let codec = tonic::codec::ProstCodec::default();
             let path = http::uri::PathAndQuery::from_static(
-                "/dydxprotocol.subaccounts.Query/CollateralPoolAddress",
+                "/dydxprotocol.subaccounts.Msg/OpenHedgedPair",
             );
             let mut req = request.into_request();
             req.extensions_mut()
                 .insert(
-                    GrpcMethod::new(
-                        "dydxprotocol.subaccounts.Query",
-                        "CollateralPoolAddress",
-                    ),
+                    GrpcMethod::new("dydxprotocol.subaccounts.Msg", "OpenHedgedPair"),
                 );
             self.inner.unary(req, path, codec).await
         }